@@ -6,11 +6,12 @@ import (
 	"os"
 
 	"github.com/sarulabs/di/v2"
-	"github.com/sarulabs/dingo/v4"
 
+	"gotham/app/container"
 	"gotham/app/container/dic"
 	"gotham/app/flags"
 	"gotham/app/provider"
+	"gotham/config"
 )
 
 var Application *App
@@ -20,8 +21,8 @@ type App struct {
 }
 
 func init() {
-	if !*flags.Production {
-		err := dingo.GenerateContainer((*provider.Provider)(nil), "./app/container")
+	if !*flags.Production && !flags.Testing {
+		err := container.Generate((*provider.Provider)(nil), "./app/container")
 		if err != nil {
 			fmt.Println(err.Error())
 			os.Exit(1)
@@ -40,4 +41,11 @@ func New() {
 		log.Fatal("Error dic.NewContainer")
 	}
 	Application.Container = container
+	Application.ObserveBuilds(container.GetMetrics(), config.Conf.Container.SlowBuildThreshold)
+
+	if *flags.Eager {
+		if err := Application.Validate(); err != nil {
+			log.Fatal(err.Error())
+		}
+	}
 }
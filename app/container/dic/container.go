@@ -1,22 +1,27 @@
 package dic
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/sarulabs/di/v2"
 	"github.com/sarulabs/dingo/v4"
 
+	"gotham/app/container/typed"
 	providerPkg "gotham/app/provider"
 
+	gorm "gorm.io/gorm"
+	logger "gorm.io/gorm/logger"
+
 	controllers "gotham/controllers"
 	infrastructures "gotham/infrastructures"
 	mails "gotham/mails"
 	middlewares "gotham/middlewares"
 	policies "gotham/policies"
 	repositories "gotham/repositories"
+	transactions "gotham/repositories/transactions"
 	services "gotham/services"
+	signing "gotham/signing"
 )
 
 // C retrieves a Container from an interface.
@@ -221,624 +226,6266 @@ func (c *Container) IsClosed() bool {
 	return c.ctn.IsClosed()
 }
 
-// SafeGetAuthController works like SafeGet but only for AuthController.
-// It does not return an interface but a controllers.AuthController.
-func (c *Container) SafeGetAuthController() (controllers.AuthController, error) {
-	i, err := c.ctn.SafeGet("auth-controller")
-	if err != nil {
-		var eo controllers.AuthController
-		return eo, err
-	}
-	o, ok := i.(controllers.AuthController)
-	if !ok {
-		return o, errors.New("could get 'auth-controller' because the object could not be cast to controllers.AuthController")
-	}
-	return o, nil
+// SafeGetAccessLogMiddleware works like SafeGet but only for AccessLogMiddleware.
+// It does not return an interface but a middlewares.AccessLog.
+func (c *Container) SafeGetAccessLogMiddleware() (middlewares.AccessLog, error) {
+	return typed.Get[middlewares.AccessLog](c, "access-log-middleware")
 }
 
-// GetAuthController is similar to SafeGetAuthController but it does not return the error.
+// GetAccessLogMiddleware is similar to SafeGetAccessLogMiddleware but it does not return the error.
 // Instead it panics.
-func (c *Container) GetAuthController() controllers.AuthController {
-	o, err := c.SafeGetAuthController()
+func (c *Container) GetAccessLogMiddleware() middlewares.AccessLog {
+	o, err := c.SafeGetAccessLogMiddleware()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetAuthController works like UnscopedSafeGet but only for AuthController.
-// It does not return an interface but a controllers.AuthController.
-func (c *Container) UnscopedSafeGetAuthController() (controllers.AuthController, error) {
-	i, err := c.ctn.UnscopedSafeGet("auth-controller")
-	if err != nil {
-		var eo controllers.AuthController
-		return eo, err
-	}
-	o, ok := i.(controllers.AuthController)
-	if !ok {
-		return o, errors.New("could get 'auth-controller' because the object could not be cast to controllers.AuthController")
-	}
-	return o, nil
+// UnscopedSafeGetAccessLogMiddleware works like UnscopedSafeGet but only for AccessLogMiddleware.
+// It does not return an interface but a middlewares.AccessLog.
+func (c *Container) UnscopedSafeGetAccessLogMiddleware() (middlewares.AccessLog, error) {
+	return typed.UnscopedGet[middlewares.AccessLog](c, "access-log-middleware")
 }
 
-// UnscopedGetAuthController is similar to UnscopedSafeGetAuthController but it does not return the error.
+// UnscopedGetAccessLogMiddleware is similar to UnscopedSafeGetAccessLogMiddleware but it does not return the error.
 // Instead it panics.
-func (c *Container) UnscopedGetAuthController() controllers.AuthController {
-	o, err := c.UnscopedSafeGetAuthController()
+func (c *Container) UnscopedGetAccessLogMiddleware() middlewares.AccessLog {
+	o, err := c.UnscopedSafeGetAccessLogMiddleware()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// AuthController is similar to GetAuthController.
+// AccessLogMiddleware is similar to GetAccessLogMiddleware.
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it applies the GetAuthController method.
+// If the container can be retrieved, it applies the GetAccessLogMiddleware method.
 // If the container can not be retrieved, it panics.
-func AuthController(i interface{}) controllers.AuthController {
-	return C(i).GetAuthController()
+func AccessLogMiddleware(i interface{}) middlewares.AccessLog {
+	return C(i).GetAccessLogMiddleware()
 }
 
-// SafeGetAuthMiddleware works like SafeGet but only for AuthMiddleware.
-// It does not return an interface but a middlewares.Auth.
-func (c *Container) SafeGetAuthMiddleware() (middlewares.Auth, error) {
-	i, err := c.ctn.SafeGet("auth-middleware")
-	if err != nil {
-		var eo middlewares.Auth
-		return eo, err
-	}
-	o, ok := i.(middlewares.Auth)
-	if !ok {
-		return o, errors.New("could get 'auth-middleware' because the object could not be cast to middlewares.Auth")
-	}
-	return o, nil
+// SafeGetAccessRulesMiddleware works like SafeGet but only for AccessRulesMiddleware.
+// It does not return an interface but a middlewares.AccessRules.
+func (c *Container) SafeGetAccessRulesMiddleware() (middlewares.AccessRules, error) {
+	return typed.Get[middlewares.AccessRules](c, "access-rules-middleware")
 }
 
-// GetAuthMiddleware is similar to SafeGetAuthMiddleware but it does not return the error.
+// GetAccessRulesMiddleware is similar to SafeGetAccessRulesMiddleware but it does not return the error.
 // Instead it panics.
-func (c *Container) GetAuthMiddleware() middlewares.Auth {
-	o, err := c.SafeGetAuthMiddleware()
+func (c *Container) GetAccessRulesMiddleware() middlewares.AccessRules {
+	o, err := c.SafeGetAccessRulesMiddleware()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetAuthMiddleware works like UnscopedSafeGet but only for AuthMiddleware.
-// It does not return an interface but a middlewares.Auth.
-func (c *Container) UnscopedSafeGetAuthMiddleware() (middlewares.Auth, error) {
-	i, err := c.ctn.UnscopedSafeGet("auth-middleware")
-	if err != nil {
-		var eo middlewares.Auth
-		return eo, err
-	}
-	o, ok := i.(middlewares.Auth)
-	if !ok {
-		return o, errors.New("could get 'auth-middleware' because the object could not be cast to middlewares.Auth")
-	}
-	return o, nil
+// UnscopedSafeGetAccessRulesMiddleware works like UnscopedSafeGet but only for AccessRulesMiddleware.
+// It does not return an interface but a middlewares.AccessRules.
+func (c *Container) UnscopedSafeGetAccessRulesMiddleware() (middlewares.AccessRules, error) {
+	return typed.UnscopedGet[middlewares.AccessRules](c, "access-rules-middleware")
 }
 
-// UnscopedGetAuthMiddleware is similar to UnscopedSafeGetAuthMiddleware but it does not return the error.
+// UnscopedGetAccessRulesMiddleware is similar to UnscopedSafeGetAccessRulesMiddleware but it does not return the error.
 // Instead it panics.
-func (c *Container) UnscopedGetAuthMiddleware() middlewares.Auth {
-	o, err := c.UnscopedSafeGetAuthMiddleware()
+func (c *Container) UnscopedGetAccessRulesMiddleware() middlewares.AccessRules {
+	o, err := c.UnscopedSafeGetAccessRulesMiddleware()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// AuthMiddleware is similar to GetAuthMiddleware.
+// AccessRulesMiddleware is similar to GetAccessRulesMiddleware.
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it applies the GetAuthMiddleware method.
+// If the container can be retrieved, it applies the GetAccessRulesMiddleware method.
 // If the container can not be retrieved, it panics.
-func AuthMiddleware(i interface{}) middlewares.Auth {
-	return C(i).GetAuthMiddleware()
+func AccessRulesMiddleware(i interface{}) middlewares.AccessRules {
+	return C(i).GetAccessRulesMiddleware()
 }
 
-// SafeGetAuthService works like SafeGet but only for AuthService.
-// It does not return an interface but a services.IAuthService.
-func (c *Container) SafeGetAuthService() (services.IAuthService, error) {
-	i, err := c.ctn.SafeGet("auth-service")
-	if err != nil {
-		var eo services.IAuthService
-		return eo, err
-	}
-	o, ok := i.(services.IAuthService)
-	if !ok {
-		return o, errors.New("could get 'auth-service' because the object could not be cast to services.IAuthService")
-	}
-	return o, nil
+// SafeGetAccountController works like SafeGet but only for AccountController.
+// It does not return an interface but a controllers.AccountController.
+func (c *Container) SafeGetAccountController() (controllers.AccountController, error) {
+	return typed.Get[controllers.AccountController](c, "account-controller")
 }
 
-// GetAuthService is similar to SafeGetAuthService but it does not return the error.
+// GetAccountController is similar to SafeGetAccountController but it does not return the error.
 // Instead it panics.
-func (c *Container) GetAuthService() services.IAuthService {
-	o, err := c.SafeGetAuthService()
+func (c *Container) GetAccountController() controllers.AccountController {
+	o, err := c.SafeGetAccountController()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetAuthService works like UnscopedSafeGet but only for AuthService.
-// It does not return an interface but a services.IAuthService.
-func (c *Container) UnscopedSafeGetAuthService() (services.IAuthService, error) {
-	i, err := c.ctn.UnscopedSafeGet("auth-service")
-	if err != nil {
-		var eo services.IAuthService
-		return eo, err
-	}
-	o, ok := i.(services.IAuthService)
-	if !ok {
-		return o, errors.New("could get 'auth-service' because the object could not be cast to services.IAuthService")
-	}
-	return o, nil
+// UnscopedSafeGetAccountController works like UnscopedSafeGet but only for AccountController.
+// It does not return an interface but a controllers.AccountController.
+func (c *Container) UnscopedSafeGetAccountController() (controllers.AccountController, error) {
+	return typed.UnscopedGet[controllers.AccountController](c, "account-controller")
 }
 
-// UnscopedGetAuthService is similar to UnscopedSafeGetAuthService but it does not return the error.
+// UnscopedGetAccountController is similar to UnscopedSafeGetAccountController but it does not return the error.
 // Instead it panics.
-func (c *Container) UnscopedGetAuthService() services.IAuthService {
-	o, err := c.UnscopedSafeGetAuthService()
+func (c *Container) UnscopedGetAccountController() controllers.AccountController {
+	o, err := c.UnscopedSafeGetAccountController()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// AuthService is similar to GetAuthService.
+// AccountController is similar to GetAccountController.
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it applies the GetAuthService method.
+// If the container can be retrieved, it applies the GetAccountController method.
 // If the container can not be retrieved, it panics.
-func AuthService(i interface{}) services.IAuthService {
-	return C(i).GetAuthService()
+func AccountController(i interface{}) controllers.AccountController {
+	return C(i).GetAccountController()
 }
 
-// SafeGetDb works like SafeGet but only for Db.
-// It does not return an interface but a infrastructures.IGormDatabase.
-func (c *Container) SafeGetDb() (infrastructures.IGormDatabase, error) {
-	i, err := c.ctn.SafeGet("db")
-	if err != nil {
-		var eo infrastructures.IGormDatabase
-		return eo, err
-	}
-	o, ok := i.(infrastructures.IGormDatabase)
-	if !ok {
-		return o, errors.New("could get 'db' because the object could not be cast to infrastructures.IGormDatabase")
-	}
-	return o, nil
+// SafeGetAdminController works like SafeGet but only for AdminController.
+// It does not return an interface but a controllers.AdminController.
+func (c *Container) SafeGetAdminController() (controllers.AdminController, error) {
+	return typed.Get[controllers.AdminController](c, "admin-controller")
 }
 
-// GetDb is similar to SafeGetDb but it does not return the error.
+// GetAdminController is similar to SafeGetAdminController but it does not return the error.
 // Instead it panics.
-func (c *Container) GetDb() infrastructures.IGormDatabase {
-	o, err := c.SafeGetDb()
+func (c *Container) GetAdminController() controllers.AdminController {
+	o, err := c.SafeGetAdminController()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetDb works like UnscopedSafeGet but only for Db.
-// It does not return an interface but a infrastructures.IGormDatabase.
-func (c *Container) UnscopedSafeGetDb() (infrastructures.IGormDatabase, error) {
-	i, err := c.ctn.UnscopedSafeGet("db")
-	if err != nil {
-		var eo infrastructures.IGormDatabase
-		return eo, err
-	}
-	o, ok := i.(infrastructures.IGormDatabase)
-	if !ok {
-		return o, errors.New("could get 'db' because the object could not be cast to infrastructures.IGormDatabase")
-	}
-	return o, nil
+// UnscopedSafeGetAdminController works like UnscopedSafeGet but only for AdminController.
+// It does not return an interface but a controllers.AdminController.
+func (c *Container) UnscopedSafeGetAdminController() (controllers.AdminController, error) {
+	return typed.UnscopedGet[controllers.AdminController](c, "admin-controller")
 }
 
-// UnscopedGetDb is similar to UnscopedSafeGetDb but it does not return the error.
+// UnscopedGetAdminController is similar to UnscopedSafeGetAdminController but it does not return the error.
 // Instead it panics.
-func (c *Container) UnscopedGetDb() infrastructures.IGormDatabase {
-	o, err := c.UnscopedSafeGetDb()
+func (c *Container) UnscopedGetAdminController() controllers.AdminController {
+	o, err := c.UnscopedSafeGetAdminController()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// Db is similar to GetDb.
+// AdminController is similar to GetAdminController.
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it applies the GetDb method.
+// If the container can be retrieved, it applies the GetAdminController method.
 // If the container can not be retrieved, it panics.
-func Db(i interface{}) infrastructures.IGormDatabase {
-	return C(i).GetDb()
+func AdminController(i interface{}) controllers.AdminController {
+	return C(i).GetAdminController()
 }
 
-// SafeGetDbPool works like SafeGet but only for DbPool.
-// It does not return an interface but a infrastructures.IGormDatabasePool.
-func (c *Container) SafeGetDbPool() (infrastructures.IGormDatabasePool, error) {
-	i, err := c.ctn.SafeGet("db-pool")
-	if err != nil {
-		var eo infrastructures.IGormDatabasePool
-		return eo, err
-	}
-	o, ok := i.(infrastructures.IGormDatabasePool)
-	if !ok {
-		return o, errors.New("could get 'db-pool' because the object could not be cast to infrastructures.IGormDatabasePool")
-	}
-	return o, nil
+// SafeGetAdminResources works like SafeGet but only for AdminResources.
+// It does not return an interface but a map[string]services.IAdminService.
+func (c *Container) SafeGetAdminResources() (map[string]services.IAdminService, error) {
+	return typed.Get[map[string]services.IAdminService](c, "admin-resources")
 }
 
-// GetDbPool is similar to SafeGetDbPool but it does not return the error.
+// GetAdminResources is similar to SafeGetAdminResources but it does not return the error.
 // Instead it panics.
-func (c *Container) GetDbPool() infrastructures.IGormDatabasePool {
-	o, err := c.SafeGetDbPool()
+func (c *Container) GetAdminResources() map[string]services.IAdminService {
+	o, err := c.SafeGetAdminResources()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetDbPool works like UnscopedSafeGet but only for DbPool.
-// It does not return an interface but a infrastructures.IGormDatabasePool.
-func (c *Container) UnscopedSafeGetDbPool() (infrastructures.IGormDatabasePool, error) {
-	i, err := c.ctn.UnscopedSafeGet("db-pool")
-	if err != nil {
-		var eo infrastructures.IGormDatabasePool
-		return eo, err
-	}
-	o, ok := i.(infrastructures.IGormDatabasePool)
-	if !ok {
-		return o, errors.New("could get 'db-pool' because the object could not be cast to infrastructures.IGormDatabasePool")
-	}
-	return o, nil
+// UnscopedSafeGetAdminResources works like UnscopedSafeGet but only for AdminResources.
+// It does not return an interface but a map[string]services.IAdminService.
+func (c *Container) UnscopedSafeGetAdminResources() (map[string]services.IAdminService, error) {
+	return typed.UnscopedGet[map[string]services.IAdminService](c, "admin-resources")
 }
 
-// UnscopedGetDbPool is similar to UnscopedSafeGetDbPool but it does not return the error.
+// UnscopedGetAdminResources is similar to UnscopedSafeGetAdminResources but it does not return the error.
 // Instead it panics.
-func (c *Container) UnscopedGetDbPool() infrastructures.IGormDatabasePool {
-	o, err := c.UnscopedSafeGetDbPool()
+func (c *Container) UnscopedGetAdminResources() map[string]services.IAdminService {
+	o, err := c.UnscopedSafeGetAdminResources()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// DbPool is similar to GetDbPool.
+// AdminResources is similar to GetAdminResources.
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it applies the GetDbPool method.
+// If the container can be retrieved, it applies the GetAdminResources method.
 // If the container can not be retrieved, it panics.
-func DbPool(i interface{}) infrastructures.IGormDatabasePool {
-	return C(i).GetDbPool()
+func AdminResources(i interface{}) map[string]services.IAdminService {
+	return C(i).GetAdminResources()
 }
 
-// SafeGetEmail works like SafeGet but only for Email.
-// It does not return an interface but a infrastructures.IEmailService.
-func (c *Container) SafeGetEmail() (infrastructures.IEmailService, error) {
-	i, err := c.ctn.SafeGet("email")
-	if err != nil {
-		var eo infrastructures.IEmailService
-		return eo, err
-	}
-	o, ok := i.(infrastructures.IEmailService)
-	if !ok {
-		return o, errors.New("could get 'email' because the object could not be cast to infrastructures.IEmailService")
-	}
-	return o, nil
+// SafeGetAdminUiController works like SafeGet but only for AdminUiController.
+// It does not return an interface but a controllers.AdminUIController.
+func (c *Container) SafeGetAdminUiController() (controllers.AdminUIController, error) {
+	return typed.Get[controllers.AdminUIController](c, "admin-ui-controller")
 }
 
-// GetEmail is similar to SafeGetEmail but it does not return the error.
+// GetAdminUiController is similar to SafeGetAdminUiController but it does not return the error.
 // Instead it panics.
-func (c *Container) GetEmail() infrastructures.IEmailService {
-	o, err := c.SafeGetEmail()
+func (c *Container) GetAdminUiController() controllers.AdminUIController {
+	o, err := c.SafeGetAdminUiController()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetEmail works like UnscopedSafeGet but only for Email.
-// It does not return an interface but a infrastructures.IEmailService.
-func (c *Container) UnscopedSafeGetEmail() (infrastructures.IEmailService, error) {
-	i, err := c.ctn.UnscopedSafeGet("email")
-	if err != nil {
-		var eo infrastructures.IEmailService
-		return eo, err
-	}
-	o, ok := i.(infrastructures.IEmailService)
-	if !ok {
-		return o, errors.New("could get 'email' because the object could not be cast to infrastructures.IEmailService")
-	}
-	return o, nil
+// UnscopedSafeGetAdminUiController works like UnscopedSafeGet but only for AdminUiController.
+// It does not return an interface but a controllers.AdminUIController.
+func (c *Container) UnscopedSafeGetAdminUiController() (controllers.AdminUIController, error) {
+	return typed.UnscopedGet[controllers.AdminUIController](c, "admin-ui-controller")
 }
 
-// UnscopedGetEmail is similar to UnscopedSafeGetEmail but it does not return the error.
+// UnscopedGetAdminUiController is similar to UnscopedSafeGetAdminUiController but it does not return the error.
 // Instead it panics.
-func (c *Container) UnscopedGetEmail() infrastructures.IEmailService {
-	o, err := c.UnscopedSafeGetEmail()
+func (c *Container) UnscopedGetAdminUiController() controllers.AdminUIController {
+	o, err := c.UnscopedSafeGetAdminUiController()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// Email is similar to GetEmail.
+// AdminUiController is similar to GetAdminUiController.
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it applies the GetEmail method.
+// If the container can be retrieved, it applies the GetAdminUiController method.
 // If the container can not be retrieved, it panics.
-func Email(i interface{}) infrastructures.IEmailService {
-	return C(i).GetEmail()
+func AdminUiController(i interface{}) controllers.AdminUIController {
+	return C(i).GetAdminUiController()
 }
 
-// SafeGetIsAdminMiddleware works like SafeGet but only for IsAdminMiddleware.
-// It does not return an interface but a middlewares.IsAdmin.
-func (c *Container) SafeGetIsAdminMiddleware() (middlewares.IsAdmin, error) {
-	i, err := c.ctn.SafeGet("is-admin-middleware")
-	if err != nil {
-		var eo middlewares.IsAdmin
-		return eo, err
-	}
-	o, ok := i.(middlewares.IsAdmin)
-	if !ok {
-		return o, errors.New("could get 'is-admin-middleware' because the object could not be cast to middlewares.IsAdmin")
-	}
-	return o, nil
+// SafeGetAnalytics works like SafeGet but only for Analytics.
+// It does not return an interface but a infrastructures.IAnalytics.
+func (c *Container) SafeGetAnalytics() (infrastructures.IAnalytics, error) {
+	return typed.Get[infrastructures.IAnalytics](c, "analytics")
 }
 
-// GetIsAdminMiddleware is similar to SafeGetIsAdminMiddleware but it does not return the error.
+// GetAnalytics is similar to SafeGetAnalytics but it does not return the error.
 // Instead it panics.
-func (c *Container) GetIsAdminMiddleware() middlewares.IsAdmin {
-	o, err := c.SafeGetIsAdminMiddleware()
+func (c *Container) GetAnalytics() infrastructures.IAnalytics {
+	o, err := c.SafeGetAnalytics()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetIsAdminMiddleware works like UnscopedSafeGet but only for IsAdminMiddleware.
-// It does not return an interface but a middlewares.IsAdmin.
-func (c *Container) UnscopedSafeGetIsAdminMiddleware() (middlewares.IsAdmin, error) {
-	i, err := c.ctn.UnscopedSafeGet("is-admin-middleware")
-	if err != nil {
-		var eo middlewares.IsAdmin
-		return eo, err
-	}
-	o, ok := i.(middlewares.IsAdmin)
-	if !ok {
-		return o, errors.New("could get 'is-admin-middleware' because the object could not be cast to middlewares.IsAdmin")
-	}
-	return o, nil
+// UnscopedSafeGetAnalytics works like UnscopedSafeGet but only for Analytics.
+// It does not return an interface but a infrastructures.IAnalytics.
+func (c *Container) UnscopedSafeGetAnalytics() (infrastructures.IAnalytics, error) {
+	return typed.UnscopedGet[infrastructures.IAnalytics](c, "analytics")
 }
 
-// UnscopedGetIsAdminMiddleware is similar to UnscopedSafeGetIsAdminMiddleware but it does not return the error.
+// UnscopedGetAnalytics is similar to UnscopedSafeGetAnalytics but it does not return the error.
 // Instead it panics.
-func (c *Container) UnscopedGetIsAdminMiddleware() middlewares.IsAdmin {
-	o, err := c.UnscopedSafeGetIsAdminMiddleware()
+func (c *Container) UnscopedGetAnalytics() infrastructures.IAnalytics {
+	o, err := c.UnscopedSafeGetAnalytics()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// IsAdminMiddleware is similar to GetIsAdminMiddleware.
+// Analytics is similar to GetAnalytics.
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it applies the GetIsAdminMiddleware method.
+// If the container can be retrieved, it applies the GetAnalytics method.
 // If the container can not be retrieved, it panics.
-func IsAdminMiddleware(i interface{}) middlewares.IsAdmin {
-	return C(i).GetIsAdminMiddleware()
+func Analytics(i interface{}) infrastructures.IAnalytics {
+	return C(i).GetAnalytics()
 }
 
-// SafeGetIsVerifiedMiddleware works like SafeGet but only for IsVerifiedMiddleware.
-// It does not return an interface but a middlewares.IsVerified.
-func (c *Container) SafeGetIsVerifiedMiddleware() (middlewares.IsVerified, error) {
-	i, err := c.ctn.SafeGet("is-verified-middleware")
-	if err != nil {
-		var eo middlewares.IsVerified
-		return eo, err
-	}
-	o, ok := i.(middlewares.IsVerified)
-	if !ok {
-		return o, errors.New("could get 'is-verified-middleware' because the object could not be cast to middlewares.IsVerified")
-	}
-	return o, nil
+// SafeGetAnalyticsMiddleware works like SafeGet but only for AnalyticsMiddleware.
+// It does not return an interface but a middlewares.Analytics.
+func (c *Container) SafeGetAnalyticsMiddleware() (middlewares.Analytics, error) {
+	return typed.Get[middlewares.Analytics](c, "analytics-middleware")
 }
 
-// GetIsVerifiedMiddleware is similar to SafeGetIsVerifiedMiddleware but it does not return the error.
+// GetAnalyticsMiddleware is similar to SafeGetAnalyticsMiddleware but it does not return the error.
 // Instead it panics.
-func (c *Container) GetIsVerifiedMiddleware() middlewares.IsVerified {
-	o, err := c.SafeGetIsVerifiedMiddleware()
+func (c *Container) GetAnalyticsMiddleware() middlewares.Analytics {
+	o, err := c.SafeGetAnalyticsMiddleware()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetIsVerifiedMiddleware works like UnscopedSafeGet but only for IsVerifiedMiddleware.
-// It does not return an interface but a middlewares.IsVerified.
-func (c *Container) UnscopedSafeGetIsVerifiedMiddleware() (middlewares.IsVerified, error) {
-	i, err := c.ctn.UnscopedSafeGet("is-verified-middleware")
-	if err != nil {
-		var eo middlewares.IsVerified
-		return eo, err
-	}
-	o, ok := i.(middlewares.IsVerified)
-	if !ok {
-		return o, errors.New("could get 'is-verified-middleware' because the object could not be cast to middlewares.IsVerified")
-	}
-	return o, nil
+// UnscopedSafeGetAnalyticsMiddleware works like UnscopedSafeGet but only for AnalyticsMiddleware.
+// It does not return an interface but a middlewares.Analytics.
+func (c *Container) UnscopedSafeGetAnalyticsMiddleware() (middlewares.Analytics, error) {
+	return typed.UnscopedGet[middlewares.Analytics](c, "analytics-middleware")
 }
 
-// UnscopedGetIsVerifiedMiddleware is similar to UnscopedSafeGetIsVerifiedMiddleware but it does not return the error.
+// UnscopedGetAnalyticsMiddleware is similar to UnscopedSafeGetAnalyticsMiddleware but it does not return the error.
 // Instead it panics.
-func (c *Container) UnscopedGetIsVerifiedMiddleware() middlewares.IsVerified {
-	o, err := c.UnscopedSafeGetIsVerifiedMiddleware()
+func (c *Container) UnscopedGetAnalyticsMiddleware() middlewares.Analytics {
+	o, err := c.UnscopedSafeGetAnalyticsMiddleware()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// IsVerifiedMiddleware is similar to GetIsVerifiedMiddleware.
+// AnalyticsMiddleware is similar to GetAnalyticsMiddleware.
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it applies the GetIsVerifiedMiddleware method.
+// If the container can be retrieved, it applies the GetAnalyticsMiddleware method.
 // If the container can not be retrieved, it panics.
-func IsVerifiedMiddleware(i interface{}) middlewares.IsVerified {
-	return C(i).GetIsVerifiedMiddleware()
+func AnalyticsMiddleware(i interface{}) middlewares.Analytics {
+	return C(i).GetAnalyticsMiddleware()
 }
 
-// SafeGetUserController works like SafeGet but only for UserController.
-// It does not return an interface but a controllers.UserController.
-func (c *Container) SafeGetUserController() (controllers.UserController, error) {
-	i, err := c.ctn.SafeGet("user-controller")
-	if err != nil {
-		var eo controllers.UserController
-		return eo, err
-	}
-	o, ok := i.(controllers.UserController)
-	if !ok {
-		return o, errors.New("could get 'user-controller' because the object could not be cast to controllers.UserController")
-	}
-	return o, nil
+// SafeGetAnnouncementController works like SafeGet but only for AnnouncementController.
+// It does not return an interface but a controllers.AnnouncementController.
+func (c *Container) SafeGetAnnouncementController() (controllers.AnnouncementController, error) {
+	return typed.Get[controllers.AnnouncementController](c, "announcement-controller")
 }
 
-// GetUserController is similar to SafeGetUserController but it does not return the error.
+// GetAnnouncementController is similar to SafeGetAnnouncementController but it does not return the error.
 // Instead it panics.
-func (c *Container) GetUserController() controllers.UserController {
-	o, err := c.SafeGetUserController()
+func (c *Container) GetAnnouncementController() controllers.AnnouncementController {
+	o, err := c.SafeGetAnnouncementController()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetUserController works like UnscopedSafeGet but only for UserController.
-// It does not return an interface but a controllers.UserController.
-func (c *Container) UnscopedSafeGetUserController() (controllers.UserController, error) {
-	i, err := c.ctn.UnscopedSafeGet("user-controller")
-	if err != nil {
-		var eo controllers.UserController
-		return eo, err
-	}
-	o, ok := i.(controllers.UserController)
-	if !ok {
-		return o, errors.New("could get 'user-controller' because the object could not be cast to controllers.UserController")
-	}
-	return o, nil
+// UnscopedSafeGetAnnouncementController works like UnscopedSafeGet but only for AnnouncementController.
+// It does not return an interface but a controllers.AnnouncementController.
+func (c *Container) UnscopedSafeGetAnnouncementController() (controllers.AnnouncementController, error) {
+	return typed.UnscopedGet[controllers.AnnouncementController](c, "announcement-controller")
 }
 
-// UnscopedGetUserController is similar to UnscopedSafeGetUserController but it does not return the error.
+// UnscopedGetAnnouncementController is similar to UnscopedSafeGetAnnouncementController but it does not return the error.
 // Instead it panics.
-func (c *Container) UnscopedGetUserController() controllers.UserController {
-	o, err := c.UnscopedSafeGetUserController()
+func (c *Container) UnscopedGetAnnouncementController() controllers.AnnouncementController {
+	o, err := c.UnscopedSafeGetAnnouncementController()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UserController is similar to GetUserController.
+// AnnouncementController is similar to GetAnnouncementController.
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it applies the GetUserController method.
+// If the container can be retrieved, it applies the GetAnnouncementController method.
 // If the container can not be retrieved, it panics.
-func UserController(i interface{}) controllers.UserController {
-	return C(i).GetUserController()
+func AnnouncementController(i interface{}) controllers.AnnouncementController {
+	return C(i).GetAnnouncementController()
 }
 
-// SafeGetUserPolicy works like SafeGet but only for UserPolicy.
-// It does not return an interface but a policies.IUserPolicy.
-func (c *Container) SafeGetUserPolicy() (policies.IUserPolicy, error) {
-	i, err := c.ctn.SafeGet("user-policy")
-	if err != nil {
-		var eo policies.IUserPolicy
-		return eo, err
-	}
-	o, ok := i.(policies.IUserPolicy)
-	if !ok {
-		return o, errors.New("could get 'user-policy' because the object could not be cast to policies.IUserPolicy")
-	}
-	return o, nil
+// SafeGetAnnouncementRepository works like SafeGet but only for AnnouncementRepository.
+// It does not return an interface but a repositories.IAnnouncementRepository.
+func (c *Container) SafeGetAnnouncementRepository() (repositories.IAnnouncementRepository, error) {
+	return typed.Get[repositories.IAnnouncementRepository](c, "announcement-repository")
 }
 
-// GetUserPolicy is similar to SafeGetUserPolicy but it does not return the error.
+// GetAnnouncementRepository is similar to SafeGetAnnouncementRepository but it does not return the error.
 // Instead it panics.
-func (c *Container) GetUserPolicy() policies.IUserPolicy {
-	o, err := c.SafeGetUserPolicy()
+func (c *Container) GetAnnouncementRepository() repositories.IAnnouncementRepository {
+	o, err := c.SafeGetAnnouncementRepository()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetUserPolicy works like UnscopedSafeGet but only for UserPolicy.
-// It does not return an interface but a policies.IUserPolicy.
-func (c *Container) UnscopedSafeGetUserPolicy() (policies.IUserPolicy, error) {
-	i, err := c.ctn.UnscopedSafeGet("user-policy")
-	if err != nil {
-		var eo policies.IUserPolicy
-		return eo, err
-	}
-	o, ok := i.(policies.IUserPolicy)
-	if !ok {
-		return o, errors.New("could get 'user-policy' because the object could not be cast to policies.IUserPolicy")
-	}
-	return o, nil
+// UnscopedSafeGetAnnouncementRepository works like UnscopedSafeGet but only for AnnouncementRepository.
+// It does not return an interface but a repositories.IAnnouncementRepository.
+func (c *Container) UnscopedSafeGetAnnouncementRepository() (repositories.IAnnouncementRepository, error) {
+	return typed.UnscopedGet[repositories.IAnnouncementRepository](c, "announcement-repository")
 }
 
-// UnscopedGetUserPolicy is similar to UnscopedSafeGetUserPolicy but it does not return the error.
+// UnscopedGetAnnouncementRepository is similar to UnscopedSafeGetAnnouncementRepository but it does not return the error.
 // Instead it panics.
-func (c *Container) UnscopedGetUserPolicy() policies.IUserPolicy {
-	o, err := c.UnscopedSafeGetUserPolicy()
+func (c *Container) UnscopedGetAnnouncementRepository() repositories.IAnnouncementRepository {
+	o, err := c.UnscopedSafeGetAnnouncementRepository()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UserPolicy is similar to GetUserPolicy.
+// AnnouncementRepository is similar to GetAnnouncementRepository.
 // It tries to find the container with the C method and the given interface.
-// If the container can be retrieved, it applies the GetUserPolicy method.
+// If the container can be retrieved, it applies the GetAnnouncementRepository method.
 // If the container can not be retrieved, it panics.
-func UserPolicy(i interface{}) policies.IUserPolicy {
-	return C(i).GetUserPolicy()
+func AnnouncementRepository(i interface{}) repositories.IAnnouncementRepository {
+	return C(i).GetAnnouncementRepository()
 }
 
-// SafeGetUserRepository works like SafeGet but only for UserRepository.
-// It does not return an interface but a repositories.IUserRepository.
-func (c *Container) SafeGetUserRepository() (repositories.IUserRepository, error) {
-	i, err := c.ctn.SafeGet("user-repository")
+// SafeGetAnnouncementService works like SafeGet but only for AnnouncementService.
+// It does not return an interface but a services.IAnnouncementService.
+func (c *Container) SafeGetAnnouncementService() (services.IAnnouncementService, error) {
+	return typed.Get[services.IAnnouncementService](c, "announcement-service")
+}
+
+// GetAnnouncementService is similar to SafeGetAnnouncementService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetAnnouncementService() services.IAnnouncementService {
+	o, err := c.SafeGetAnnouncementService()
 	if err != nil {
-		var eo repositories.IUserRepository
-		return eo, err
-	}
-	o, ok := i.(repositories.IUserRepository)
-	if !ok {
-		return o, errors.New("could get 'user-repository' because the object could not be cast to repositories.IUserRepository")
+		panic(err)
 	}
-	return o, nil
+	return o
 }
 
-// GetUserRepository is similar to SafeGetUserRepository but it does not return the error.
+// UnscopedSafeGetAnnouncementService works like UnscopedSafeGet but only for AnnouncementService.
+// It does not return an interface but a services.IAnnouncementService.
+func (c *Container) UnscopedSafeGetAnnouncementService() (services.IAnnouncementService, error) {
+	return typed.UnscopedGet[services.IAnnouncementService](c, "announcement-service")
+}
+
+// UnscopedGetAnnouncementService is similar to UnscopedSafeGetAnnouncementService but it does not return the error.
 // Instead it panics.
-func (c *Container) GetUserRepository() repositories.IUserRepository {
-	o, err := c.SafeGetUserRepository()
+func (c *Container) UnscopedGetAnnouncementService() services.IAnnouncementService {
+	o, err := c.UnscopedSafeGetAnnouncementService()
 	if err != nil {
 		panic(err)
 	}
 	return o
 }
 
-// UnscopedSafeGetUserRepository works like UnscopedSafeGet but only for UserRepository.
-// It does not return an interface but a repositories.IUserRepository.
-func (c *Container) UnscopedSafeGetUserRepository() (repositories.IUserRepository, error) {
-	i, err := c.ctn.UnscopedSafeGet("user-repository")
+// AnnouncementService is similar to GetAnnouncementService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetAnnouncementService method.
+// If the container can not be retrieved, it panics.
+func AnnouncementService(i interface{}) services.IAnnouncementService {
+	return C(i).GetAnnouncementService()
+}
+
+// SafeGetApiKeyController works like SafeGet but only for ApiKeyController.
+// It does not return an interface but a controllers.ApiKeyController.
+func (c *Container) SafeGetApiKeyController() (controllers.ApiKeyController, error) {
+	return typed.Get[controllers.ApiKeyController](c, "api-key-controller")
+}
+
+// GetApiKeyController is similar to SafeGetApiKeyController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetApiKeyController() controllers.ApiKeyController {
+	o, err := c.SafeGetApiKeyController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetApiKeyController works like UnscopedSafeGet but only for ApiKeyController.
+// It does not return an interface but a controllers.ApiKeyController.
+func (c *Container) UnscopedSafeGetApiKeyController() (controllers.ApiKeyController, error) {
+	return typed.UnscopedGet[controllers.ApiKeyController](c, "api-key-controller")
+}
+
+// UnscopedGetApiKeyController is similar to UnscopedSafeGetApiKeyController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetApiKeyController() controllers.ApiKeyController {
+	o, err := c.UnscopedSafeGetApiKeyController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ApiKeyController is similar to GetApiKeyController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetApiKeyController method.
+// If the container can not be retrieved, it panics.
+func ApiKeyController(i interface{}) controllers.ApiKeyController {
+	return C(i).GetApiKeyController()
+}
+
+// SafeGetApiKeyMiddleware works like SafeGet but only for ApiKeyMiddleware.
+// It does not return an interface but a middlewares.ApiKey.
+func (c *Container) SafeGetApiKeyMiddleware() (middlewares.ApiKey, error) {
+	return typed.Get[middlewares.ApiKey](c, "api-key-middleware")
+}
+
+// GetApiKeyMiddleware is similar to SafeGetApiKeyMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetApiKeyMiddleware() middlewares.ApiKey {
+	o, err := c.SafeGetApiKeyMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetApiKeyMiddleware works like UnscopedSafeGet but only for ApiKeyMiddleware.
+// It does not return an interface but a middlewares.ApiKey.
+func (c *Container) UnscopedSafeGetApiKeyMiddleware() (middlewares.ApiKey, error) {
+	return typed.UnscopedGet[middlewares.ApiKey](c, "api-key-middleware")
+}
+
+// UnscopedGetApiKeyMiddleware is similar to UnscopedSafeGetApiKeyMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetApiKeyMiddleware() middlewares.ApiKey {
+	o, err := c.UnscopedSafeGetApiKeyMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ApiKeyMiddleware is similar to GetApiKeyMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetApiKeyMiddleware method.
+// If the container can not be retrieved, it panics.
+func ApiKeyMiddleware(i interface{}) middlewares.ApiKey {
+	return C(i).GetApiKeyMiddleware()
+}
+
+// SafeGetApiKeyRepository works like SafeGet but only for ApiKeyRepository.
+// It does not return an interface but a repositories.IApiKeyRepository.
+func (c *Container) SafeGetApiKeyRepository() (repositories.IApiKeyRepository, error) {
+	return typed.Get[repositories.IApiKeyRepository](c, "api-key-repository")
+}
+
+// GetApiKeyRepository is similar to SafeGetApiKeyRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetApiKeyRepository() repositories.IApiKeyRepository {
+	o, err := c.SafeGetApiKeyRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetApiKeyRepository works like UnscopedSafeGet but only for ApiKeyRepository.
+// It does not return an interface but a repositories.IApiKeyRepository.
+func (c *Container) UnscopedSafeGetApiKeyRepository() (repositories.IApiKeyRepository, error) {
+	return typed.UnscopedGet[repositories.IApiKeyRepository](c, "api-key-repository")
+}
+
+// UnscopedGetApiKeyRepository is similar to UnscopedSafeGetApiKeyRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetApiKeyRepository() repositories.IApiKeyRepository {
+	o, err := c.UnscopedSafeGetApiKeyRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ApiKeyRepository is similar to GetApiKeyRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetApiKeyRepository method.
+// If the container can not be retrieved, it panics.
+func ApiKeyRepository(i interface{}) repositories.IApiKeyRepository {
+	return C(i).GetApiKeyRepository()
+}
+
+// SafeGetApiKeyService works like SafeGet but only for ApiKeyService.
+// It does not return an interface but a services.IApiKeyService.
+func (c *Container) SafeGetApiKeyService() (services.IApiKeyService, error) {
+	return typed.Get[services.IApiKeyService](c, "api-key-service")
+}
+
+// GetApiKeyService is similar to SafeGetApiKeyService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetApiKeyService() services.IApiKeyService {
+	o, err := c.SafeGetApiKeyService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetApiKeyService works like UnscopedSafeGet but only for ApiKeyService.
+// It does not return an interface but a services.IApiKeyService.
+func (c *Container) UnscopedSafeGetApiKeyService() (services.IApiKeyService, error) {
+	return typed.UnscopedGet[services.IApiKeyService](c, "api-key-service")
+}
+
+// UnscopedGetApiKeyService is similar to UnscopedSafeGetApiKeyService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetApiKeyService() services.IApiKeyService {
+	o, err := c.UnscopedSafeGetApiKeyService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ApiKeyService is similar to GetApiKeyService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetApiKeyService method.
+// If the container can not be retrieved, it panics.
+func ApiKeyService(i interface{}) services.IApiKeyService {
+	return C(i).GetApiKeyService()
+}
+
+// SafeGetAuthController works like SafeGet but only for AuthController.
+// It does not return an interface but a controllers.AuthController.
+func (c *Container) SafeGetAuthController() (controllers.AuthController, error) {
+	return typed.Get[controllers.AuthController](c, "auth-controller")
+}
+
+// GetAuthController is similar to SafeGetAuthController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetAuthController() controllers.AuthController {
+	o, err := c.SafeGetAuthController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetAuthController works like UnscopedSafeGet but only for AuthController.
+// It does not return an interface but a controllers.AuthController.
+func (c *Container) UnscopedSafeGetAuthController() (controllers.AuthController, error) {
+	return typed.UnscopedGet[controllers.AuthController](c, "auth-controller")
+}
+
+// UnscopedGetAuthController is similar to UnscopedSafeGetAuthController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetAuthController() controllers.AuthController {
+	o, err := c.UnscopedSafeGetAuthController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// AuthController is similar to GetAuthController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetAuthController method.
+// If the container can not be retrieved, it panics.
+func AuthController(i interface{}) controllers.AuthController {
+	return C(i).GetAuthController()
+}
+
+// SafeGetAuthMiddleware works like SafeGet but only for AuthMiddleware.
+// It does not return an interface but a middlewares.Auth.
+func (c *Container) SafeGetAuthMiddleware() (middlewares.Auth, error) {
+	return typed.Get[middlewares.Auth](c, "auth-middleware")
+}
+
+// GetAuthMiddleware is similar to SafeGetAuthMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetAuthMiddleware() middlewares.Auth {
+	o, err := c.SafeGetAuthMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetAuthMiddleware works like UnscopedSafeGet but only for AuthMiddleware.
+// It does not return an interface but a middlewares.Auth.
+func (c *Container) UnscopedSafeGetAuthMiddleware() (middlewares.Auth, error) {
+	return typed.UnscopedGet[middlewares.Auth](c, "auth-middleware")
+}
+
+// UnscopedGetAuthMiddleware is similar to UnscopedSafeGetAuthMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetAuthMiddleware() middlewares.Auth {
+	o, err := c.UnscopedSafeGetAuthMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// AuthMiddleware is similar to GetAuthMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetAuthMiddleware method.
+// If the container can not be retrieved, it panics.
+func AuthMiddleware(i interface{}) middlewares.Auth {
+	return C(i).GetAuthMiddleware()
+}
+
+// SafeGetAuthService works like SafeGet but only for AuthService.
+// It does not return an interface but a services.IAuthService.
+func (c *Container) SafeGetAuthService() (services.IAuthService, error) {
+	return typed.Get[services.IAuthService](c, "auth-service")
+}
+
+// GetAuthService is similar to SafeGetAuthService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetAuthService() services.IAuthService {
+	o, err := c.SafeGetAuthService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetAuthService works like UnscopedSafeGet but only for AuthService.
+// It does not return an interface but a services.IAuthService.
+func (c *Container) UnscopedSafeGetAuthService() (services.IAuthService, error) {
+	return typed.UnscopedGet[services.IAuthService](c, "auth-service")
+}
+
+// UnscopedGetAuthService is similar to UnscopedSafeGetAuthService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetAuthService() services.IAuthService {
+	o, err := c.UnscopedSafeGetAuthService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// AuthService is similar to GetAuthService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetAuthService method.
+// If the container can not be retrieved, it panics.
+func AuthService(i interface{}) services.IAuthService {
+	return C(i).GetAuthService()
+}
+
+// SafeGetBillingController works like SafeGet but only for BillingController.
+// It does not return an interface but a controllers.BillingController.
+func (c *Container) SafeGetBillingController() (controllers.BillingController, error) {
+	return typed.Get[controllers.BillingController](c, "billing-controller")
+}
+
+// GetBillingController is similar to SafeGetBillingController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetBillingController() controllers.BillingController {
+	o, err := c.SafeGetBillingController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBillingController works like UnscopedSafeGet but only for BillingController.
+// It does not return an interface but a controllers.BillingController.
+func (c *Container) UnscopedSafeGetBillingController() (controllers.BillingController, error) {
+	return typed.UnscopedGet[controllers.BillingController](c, "billing-controller")
+}
+
+// UnscopedGetBillingController is similar to UnscopedSafeGetBillingController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetBillingController() controllers.BillingController {
+	o, err := c.UnscopedSafeGetBillingController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BillingController is similar to GetBillingController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetBillingController method.
+// If the container can not be retrieved, it panics.
+func BillingController(i interface{}) controllers.BillingController {
+	return C(i).GetBillingController()
+}
+
+// SafeGetBillingMiddleware works like SafeGet but only for BillingMiddleware.
+// It does not return an interface but a middlewares.Billing.
+func (c *Container) SafeGetBillingMiddleware() (middlewares.Billing, error) {
+	return typed.Get[middlewares.Billing](c, "billing-middleware")
+}
+
+// GetBillingMiddleware is similar to SafeGetBillingMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetBillingMiddleware() middlewares.Billing {
+	o, err := c.SafeGetBillingMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBillingMiddleware works like UnscopedSafeGet but only for BillingMiddleware.
+// It does not return an interface but a middlewares.Billing.
+func (c *Container) UnscopedSafeGetBillingMiddleware() (middlewares.Billing, error) {
+	return typed.UnscopedGet[middlewares.Billing](c, "billing-middleware")
+}
+
+// UnscopedGetBillingMiddleware is similar to UnscopedSafeGetBillingMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetBillingMiddleware() middlewares.Billing {
+	o, err := c.UnscopedSafeGetBillingMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BillingMiddleware is similar to GetBillingMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetBillingMiddleware method.
+// If the container can not be retrieved, it panics.
+func BillingMiddleware(i interface{}) middlewares.Billing {
+	return C(i).GetBillingMiddleware()
+}
+
+// SafeGetBillingRepository works like SafeGet but only for BillingRepository.
+// It does not return an interface but a repositories.IBillingRepository.
+func (c *Container) SafeGetBillingRepository() (repositories.IBillingRepository, error) {
+	return typed.Get[repositories.IBillingRepository](c, "billing-repository")
+}
+
+// GetBillingRepository is similar to SafeGetBillingRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetBillingRepository() repositories.IBillingRepository {
+	o, err := c.SafeGetBillingRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBillingRepository works like UnscopedSafeGet but only for BillingRepository.
+// It does not return an interface but a repositories.IBillingRepository.
+func (c *Container) UnscopedSafeGetBillingRepository() (repositories.IBillingRepository, error) {
+	return typed.UnscopedGet[repositories.IBillingRepository](c, "billing-repository")
+}
+
+// UnscopedGetBillingRepository is similar to UnscopedSafeGetBillingRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetBillingRepository() repositories.IBillingRepository {
+	o, err := c.UnscopedSafeGetBillingRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BillingRepository is similar to GetBillingRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetBillingRepository method.
+// If the container can not be retrieved, it panics.
+func BillingRepository(i interface{}) repositories.IBillingRepository {
+	return C(i).GetBillingRepository()
+}
+
+// SafeGetBillingService works like SafeGet but only for BillingService.
+// It does not return an interface but a services.IBillingService.
+func (c *Container) SafeGetBillingService() (services.IBillingService, error) {
+	return typed.Get[services.IBillingService](c, "billing-service")
+}
+
+// GetBillingService is similar to SafeGetBillingService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetBillingService() services.IBillingService {
+	o, err := c.SafeGetBillingService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBillingService works like UnscopedSafeGet but only for BillingService.
+// It does not return an interface but a services.IBillingService.
+func (c *Container) UnscopedSafeGetBillingService() (services.IBillingService, error) {
+	return typed.UnscopedGet[services.IBillingService](c, "billing-service")
+}
+
+// UnscopedGetBillingService is similar to UnscopedSafeGetBillingService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetBillingService() services.IBillingService {
+	o, err := c.UnscopedSafeGetBillingService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BillingService is similar to GetBillingService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetBillingService method.
+// If the container can not be retrieved, it panics.
+func BillingService(i interface{}) services.IBillingService {
+	return C(i).GetBillingService()
+}
+
+// SafeGetBreadcrumbs works like SafeGet but only for Breadcrumbs.
+// It does not return an interface but a *infrastructures.Breadcrumbs.
+func (c *Container) SafeGetBreadcrumbs() (*infrastructures.Breadcrumbs, error) {
+	return typed.Get[*infrastructures.Breadcrumbs](c, "breadcrumbs")
+}
+
+// GetBreadcrumbs is similar to SafeGetBreadcrumbs but it does not return the error.
+// Instead it panics.
+func (c *Container) GetBreadcrumbs() *infrastructures.Breadcrumbs {
+	o, err := c.SafeGetBreadcrumbs()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBreadcrumbs works like UnscopedSafeGet but only for Breadcrumbs.
+// It does not return an interface but a *infrastructures.Breadcrumbs.
+func (c *Container) UnscopedSafeGetBreadcrumbs() (*infrastructures.Breadcrumbs, error) {
+	return typed.UnscopedGet[*infrastructures.Breadcrumbs](c, "breadcrumbs")
+}
+
+// UnscopedGetBreadcrumbs is similar to UnscopedSafeGetBreadcrumbs but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetBreadcrumbs() *infrastructures.Breadcrumbs {
+	o, err := c.UnscopedSafeGetBreadcrumbs()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Breadcrumbs is similar to GetBreadcrumbs.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetBreadcrumbs method.
+// If the container can not be retrieved, it panics.
+func Breadcrumbs(i interface{}) *infrastructures.Breadcrumbs {
+	return C(i).GetBreadcrumbs()
+}
+
+// SafeGetBudgetMiddleware works like SafeGet but only for BudgetMiddleware.
+// It does not return an interface but a middlewares.Budget.
+func (c *Container) SafeGetBudgetMiddleware() (middlewares.Budget, error) {
+	return typed.Get[middlewares.Budget](c, "budget-middleware")
+}
+
+// GetBudgetMiddleware is similar to SafeGetBudgetMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetBudgetMiddleware() middlewares.Budget {
+	o, err := c.SafeGetBudgetMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetBudgetMiddleware works like UnscopedSafeGet but only for BudgetMiddleware.
+// It does not return an interface but a middlewares.Budget.
+func (c *Container) UnscopedSafeGetBudgetMiddleware() (middlewares.Budget, error) {
+	return typed.UnscopedGet[middlewares.Budget](c, "budget-middleware")
+}
+
+// UnscopedGetBudgetMiddleware is similar to UnscopedSafeGetBudgetMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetBudgetMiddleware() middlewares.Budget {
+	o, err := c.UnscopedSafeGetBudgetMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// BudgetMiddleware is similar to GetBudgetMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetBudgetMiddleware method.
+// If the container can not be retrieved, it panics.
+func BudgetMiddleware(i interface{}) middlewares.Budget {
+	return C(i).GetBudgetMiddleware()
+}
+
+// SafeGetCache works like SafeGet but only for Cache.
+// It does not return an interface but a infrastructures.ICache.
+func (c *Container) SafeGetCache() (infrastructures.ICache, error) {
+	return typed.Get[infrastructures.ICache](c, "cache")
+}
+
+// GetCache is similar to SafeGetCache but it does not return the error.
+// Instead it panics.
+func (c *Container) GetCache() infrastructures.ICache {
+	o, err := c.SafeGetCache()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetCache works like UnscopedSafeGet but only for Cache.
+// It does not return an interface but a infrastructures.ICache.
+func (c *Container) UnscopedSafeGetCache() (infrastructures.ICache, error) {
+	return typed.UnscopedGet[infrastructures.ICache](c, "cache")
+}
+
+// UnscopedGetCache is similar to UnscopedSafeGetCache but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetCache() infrastructures.ICache {
+	o, err := c.UnscopedSafeGetCache()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Cache is similar to GetCache.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetCache method.
+// If the container can not be retrieved, it panics.
+func Cache(i interface{}) infrastructures.ICache {
+	return C(i).GetCache()
+}
+
+// SafeGetCaptcha works like SafeGet but only for Captcha.
+// It does not return an interface but a infrastructures.ICaptchaService.
+func (c *Container) SafeGetCaptcha() (infrastructures.ICaptchaService, error) {
+	return typed.Get[infrastructures.ICaptchaService](c, "captcha")
+}
+
+// GetCaptcha is similar to SafeGetCaptcha but it does not return the error.
+// Instead it panics.
+func (c *Container) GetCaptcha() infrastructures.ICaptchaService {
+	o, err := c.SafeGetCaptcha()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetCaptcha works like UnscopedSafeGet but only for Captcha.
+// It does not return an interface but a infrastructures.ICaptchaService.
+func (c *Container) UnscopedSafeGetCaptcha() (infrastructures.ICaptchaService, error) {
+	return typed.UnscopedGet[infrastructures.ICaptchaService](c, "captcha")
+}
+
+// UnscopedGetCaptcha is similar to UnscopedSafeGetCaptcha but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetCaptcha() infrastructures.ICaptchaService {
+	o, err := c.UnscopedSafeGetCaptcha()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Captcha is similar to GetCaptcha.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetCaptcha method.
+// If the container can not be retrieved, it panics.
+func Captcha(i interface{}) infrastructures.ICaptchaService {
+	return C(i).GetCaptcha()
+}
+
+// SafeGetChallengeService works like SafeGet but only for ChallengeService.
+// It does not return an interface but a services.IChallengeService.
+func (c *Container) SafeGetChallengeService() (services.IChallengeService, error) {
+	return typed.Get[services.IChallengeService](c, "challenge-service")
+}
+
+// GetChallengeService is similar to SafeGetChallengeService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetChallengeService() services.IChallengeService {
+	o, err := c.SafeGetChallengeService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetChallengeService works like UnscopedSafeGet but only for ChallengeService.
+// It does not return an interface but a services.IChallengeService.
+func (c *Container) UnscopedSafeGetChallengeService() (services.IChallengeService, error) {
+	return typed.UnscopedGet[services.IChallengeService](c, "challenge-service")
+}
+
+// UnscopedGetChallengeService is similar to UnscopedSafeGetChallengeService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetChallengeService() services.IChallengeService {
+	o, err := c.UnscopedSafeGetChallengeService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ChallengeService is similar to GetChallengeService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetChallengeService method.
+// If the container can not be retrieved, it panics.
+func ChallengeService(i interface{}) services.IChallengeService {
+	return C(i).GetChallengeService()
+}
+
+// SafeGetClientCertificateMiddleware works like SafeGet but only for ClientCertificateMiddleware.
+// It does not return an interface but a middlewares.ClientCertificate.
+func (c *Container) SafeGetClientCertificateMiddleware() (middlewares.ClientCertificate, error) {
+	return typed.Get[middlewares.ClientCertificate](c, "client-certificate-middleware")
+}
+
+// GetClientCertificateMiddleware is similar to SafeGetClientCertificateMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetClientCertificateMiddleware() middlewares.ClientCertificate {
+	o, err := c.SafeGetClientCertificateMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetClientCertificateMiddleware works like UnscopedSafeGet but only for ClientCertificateMiddleware.
+// It does not return an interface but a middlewares.ClientCertificate.
+func (c *Container) UnscopedSafeGetClientCertificateMiddleware() (middlewares.ClientCertificate, error) {
+	return typed.UnscopedGet[middlewares.ClientCertificate](c, "client-certificate-middleware")
+}
+
+// UnscopedGetClientCertificateMiddleware is similar to UnscopedSafeGetClientCertificateMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetClientCertificateMiddleware() middlewares.ClientCertificate {
+	o, err := c.UnscopedSafeGetClientCertificateMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ClientCertificateMiddleware is similar to GetClientCertificateMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetClientCertificateMiddleware method.
+// If the container can not be retrieved, it panics.
+func ClientCertificateMiddleware(i interface{}) middlewares.ClientCertificate {
+	return C(i).GetClientCertificateMiddleware()
+}
+
+// SafeGetClientController works like SafeGet but only for ClientController.
+// It does not return an interface but a controllers.ClientController.
+func (c *Container) SafeGetClientController() (controllers.ClientController, error) {
+	return typed.Get[controllers.ClientController](c, "client-controller")
+}
+
+// GetClientController is similar to SafeGetClientController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetClientController() controllers.ClientController {
+	o, err := c.SafeGetClientController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetClientController works like UnscopedSafeGet but only for ClientController.
+// It does not return an interface but a controllers.ClientController.
+func (c *Container) UnscopedSafeGetClientController() (controllers.ClientController, error) {
+	return typed.UnscopedGet[controllers.ClientController](c, "client-controller")
+}
+
+// UnscopedGetClientController is similar to UnscopedSafeGetClientController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetClientController() controllers.ClientController {
+	o, err := c.UnscopedSafeGetClientController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ClientController is similar to GetClientController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetClientController method.
+// If the container can not be retrieved, it panics.
+func ClientController(i interface{}) controllers.ClientController {
+	return C(i).GetClientController()
+}
+
+// SafeGetClientMiddleware works like SafeGet but only for ClientMiddleware.
+// It does not return an interface but a middlewares.Client.
+func (c *Container) SafeGetClientMiddleware() (middlewares.Client, error) {
+	return typed.Get[middlewares.Client](c, "client-middleware")
+}
+
+// GetClientMiddleware is similar to SafeGetClientMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetClientMiddleware() middlewares.Client {
+	o, err := c.SafeGetClientMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetClientMiddleware works like UnscopedSafeGet but only for ClientMiddleware.
+// It does not return an interface but a middlewares.Client.
+func (c *Container) UnscopedSafeGetClientMiddleware() (middlewares.Client, error) {
+	return typed.UnscopedGet[middlewares.Client](c, "client-middleware")
+}
+
+// UnscopedGetClientMiddleware is similar to UnscopedSafeGetClientMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetClientMiddleware() middlewares.Client {
+	o, err := c.UnscopedSafeGetClientMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ClientMiddleware is similar to GetClientMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetClientMiddleware method.
+// If the container can not be retrieved, it panics.
+func ClientMiddleware(i interface{}) middlewares.Client {
+	return C(i).GetClientMiddleware()
+}
+
+// SafeGetClientRepository works like SafeGet but only for ClientRepository.
+// It does not return an interface but a repositories.IClientRepository.
+func (c *Container) SafeGetClientRepository() (repositories.IClientRepository, error) {
+	return typed.Get[repositories.IClientRepository](c, "client-repository")
+}
+
+// GetClientRepository is similar to SafeGetClientRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetClientRepository() repositories.IClientRepository {
+	o, err := c.SafeGetClientRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetClientRepository works like UnscopedSafeGet but only for ClientRepository.
+// It does not return an interface but a repositories.IClientRepository.
+func (c *Container) UnscopedSafeGetClientRepository() (repositories.IClientRepository, error) {
+	return typed.UnscopedGet[repositories.IClientRepository](c, "client-repository")
+}
+
+// UnscopedGetClientRepository is similar to UnscopedSafeGetClientRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetClientRepository() repositories.IClientRepository {
+	o, err := c.UnscopedSafeGetClientRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ClientRepository is similar to GetClientRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetClientRepository method.
+// If the container can not be retrieved, it panics.
+func ClientRepository(i interface{}) repositories.IClientRepository {
+	return C(i).GetClientRepository()
+}
+
+// SafeGetClientService works like SafeGet but only for ClientService.
+// It does not return an interface but a services.IClientService.
+func (c *Container) SafeGetClientService() (services.IClientService, error) {
+	return typed.Get[services.IClientService](c, "client-service")
+}
+
+// GetClientService is similar to SafeGetClientService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetClientService() services.IClientService {
+	o, err := c.SafeGetClientService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetClientService works like UnscopedSafeGet but only for ClientService.
+// It does not return an interface but a services.IClientService.
+func (c *Container) UnscopedSafeGetClientService() (services.IClientService, error) {
+	return typed.UnscopedGet[services.IClientService](c, "client-service")
+}
+
+// UnscopedGetClientService is similar to UnscopedSafeGetClientService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetClientService() services.IClientService {
+	o, err := c.UnscopedSafeGetClientService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ClientService is similar to GetClientService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetClientService method.
+// If the container can not be retrieved, it panics.
+func ClientService(i interface{}) services.IClientService {
+	return C(i).GetClientService()
+}
+
+// SafeGetClock works like SafeGet but only for Clock.
+// It does not return an interface but a infrastructures.IClock.
+func (c *Container) SafeGetClock() (infrastructures.IClock, error) {
+	return typed.Get[infrastructures.IClock](c, "clock")
+}
+
+// GetClock is similar to SafeGetClock but it does not return the error.
+// Instead it panics.
+func (c *Container) GetClock() infrastructures.IClock {
+	o, err := c.SafeGetClock()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetClock works like UnscopedSafeGet but only for Clock.
+// It does not return an interface but a infrastructures.IClock.
+func (c *Container) UnscopedSafeGetClock() (infrastructures.IClock, error) {
+	return typed.UnscopedGet[infrastructures.IClock](c, "clock")
+}
+
+// UnscopedGetClock is similar to UnscopedSafeGetClock but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetClock() infrastructures.IClock {
+	o, err := c.UnscopedSafeGetClock()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Clock is similar to GetClock.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetClock method.
+// If the container can not be retrieved, it panics.
+func Clock(i interface{}) infrastructures.IClock {
+	return C(i).GetClock()
+}
+
+// SafeGetCommentController works like SafeGet but only for CommentController.
+// It does not return an interface but a controllers.CommentController.
+func (c *Container) SafeGetCommentController() (controllers.CommentController, error) {
+	return typed.Get[controllers.CommentController](c, "comment-controller")
+}
+
+// GetCommentController is similar to SafeGetCommentController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetCommentController() controllers.CommentController {
+	o, err := c.SafeGetCommentController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetCommentController works like UnscopedSafeGet but only for CommentController.
+// It does not return an interface but a controllers.CommentController.
+func (c *Container) UnscopedSafeGetCommentController() (controllers.CommentController, error) {
+	return typed.UnscopedGet[controllers.CommentController](c, "comment-controller")
+}
+
+// UnscopedGetCommentController is similar to UnscopedSafeGetCommentController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetCommentController() controllers.CommentController {
+	o, err := c.UnscopedSafeGetCommentController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// CommentController is similar to GetCommentController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetCommentController method.
+// If the container can not be retrieved, it panics.
+func CommentController(i interface{}) controllers.CommentController {
+	return C(i).GetCommentController()
+}
+
+// SafeGetCommentRepository works like SafeGet but only for CommentRepository.
+// It does not return an interface but a repositories.ICommentRepository.
+func (c *Container) SafeGetCommentRepository() (repositories.ICommentRepository, error) {
+	return typed.Get[repositories.ICommentRepository](c, "comment-repository")
+}
+
+// GetCommentRepository is similar to SafeGetCommentRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetCommentRepository() repositories.ICommentRepository {
+	o, err := c.SafeGetCommentRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetCommentRepository works like UnscopedSafeGet but only for CommentRepository.
+// It does not return an interface but a repositories.ICommentRepository.
+func (c *Container) UnscopedSafeGetCommentRepository() (repositories.ICommentRepository, error) {
+	return typed.UnscopedGet[repositories.ICommentRepository](c, "comment-repository")
+}
+
+// UnscopedGetCommentRepository is similar to UnscopedSafeGetCommentRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetCommentRepository() repositories.ICommentRepository {
+	o, err := c.UnscopedSafeGetCommentRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// CommentRepository is similar to GetCommentRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetCommentRepository method.
+// If the container can not be retrieved, it panics.
+func CommentRepository(i interface{}) repositories.ICommentRepository {
+	return C(i).GetCommentRepository()
+}
+
+// SafeGetCommentService works like SafeGet but only for CommentService.
+// It does not return an interface but a services.ICommentService.
+func (c *Container) SafeGetCommentService() (services.ICommentService, error) {
+	return typed.Get[services.ICommentService](c, "comment-service")
+}
+
+// GetCommentService is similar to SafeGetCommentService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetCommentService() services.ICommentService {
+	o, err := c.SafeGetCommentService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetCommentService works like UnscopedSafeGet but only for CommentService.
+// It does not return an interface but a services.ICommentService.
+func (c *Container) UnscopedSafeGetCommentService() (services.ICommentService, error) {
+	return typed.UnscopedGet[services.ICommentService](c, "comment-service")
+}
+
+// UnscopedGetCommentService is similar to UnscopedSafeGetCommentService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetCommentService() services.ICommentService {
+	o, err := c.UnscopedSafeGetCommentService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// CommentService is similar to GetCommentService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetCommentService method.
+// If the container can not be retrieved, it panics.
+func CommentService(i interface{}) services.ICommentService {
+	return C(i).GetCommentService()
+}
+
+// SafeGetConfigController works like SafeGet but only for ConfigController.
+// It does not return an interface but a controllers.ConfigController.
+func (c *Container) SafeGetConfigController() (controllers.ConfigController, error) {
+	return typed.Get[controllers.ConfigController](c, "config-controller")
+}
+
+// GetConfigController is similar to SafeGetConfigController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetConfigController() controllers.ConfigController {
+	o, err := c.SafeGetConfigController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetConfigController works like UnscopedSafeGet but only for ConfigController.
+// It does not return an interface but a controllers.ConfigController.
+func (c *Container) UnscopedSafeGetConfigController() (controllers.ConfigController, error) {
+	return typed.UnscopedGet[controllers.ConfigController](c, "config-controller")
+}
+
+// UnscopedGetConfigController is similar to UnscopedSafeGetConfigController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetConfigController() controllers.ConfigController {
+	o, err := c.UnscopedSafeGetConfigController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ConfigController is similar to GetConfigController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetConfigController method.
+// If the container can not be retrieved, it panics.
+func ConfigController(i interface{}) controllers.ConfigController {
+	return C(i).GetConfigController()
+}
+
+// SafeGetConfigService works like SafeGet but only for ConfigService.
+// It does not return an interface but a services.IConfigService.
+func (c *Container) SafeGetConfigService() (services.IConfigService, error) {
+	return typed.Get[services.IConfigService](c, "config-service")
+}
+
+// GetConfigService is similar to SafeGetConfigService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetConfigService() services.IConfigService {
+	o, err := c.SafeGetConfigService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetConfigService works like UnscopedSafeGet but only for ConfigService.
+// It does not return an interface but a services.IConfigService.
+func (c *Container) UnscopedSafeGetConfigService() (services.IConfigService, error) {
+	return typed.UnscopedGet[services.IConfigService](c, "config-service")
+}
+
+// UnscopedGetConfigService is similar to UnscopedSafeGetConfigService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetConfigService() services.IConfigService {
+	o, err := c.UnscopedSafeGetConfigService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ConfigService is similar to GetConfigService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetConfigService method.
+// If the container can not be retrieved, it panics.
+func ConfigService(i interface{}) services.IConfigService {
+	return C(i).GetConfigService()
+}
+
+// SafeGetConfigSnapshotRepository works like SafeGet but only for ConfigSnapshotRepository.
+// It does not return an interface but a repositories.IConfigSnapshotRepository.
+func (c *Container) SafeGetConfigSnapshotRepository() (repositories.IConfigSnapshotRepository, error) {
+	return typed.Get[repositories.IConfigSnapshotRepository](c, "config-snapshot-repository")
+}
+
+// GetConfigSnapshotRepository is similar to SafeGetConfigSnapshotRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetConfigSnapshotRepository() repositories.IConfigSnapshotRepository {
+	o, err := c.SafeGetConfigSnapshotRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetConfigSnapshotRepository works like UnscopedSafeGet but only for ConfigSnapshotRepository.
+// It does not return an interface but a repositories.IConfigSnapshotRepository.
+func (c *Container) UnscopedSafeGetConfigSnapshotRepository() (repositories.IConfigSnapshotRepository, error) {
+	return typed.UnscopedGet[repositories.IConfigSnapshotRepository](c, "config-snapshot-repository")
+}
+
+// UnscopedGetConfigSnapshotRepository is similar to UnscopedSafeGetConfigSnapshotRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetConfigSnapshotRepository() repositories.IConfigSnapshotRepository {
+	o, err := c.UnscopedSafeGetConfigSnapshotRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ConfigSnapshotRepository is similar to GetConfigSnapshotRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetConfigSnapshotRepository method.
+// If the container can not be retrieved, it panics.
+func ConfigSnapshotRepository(i interface{}) repositories.IConfigSnapshotRepository {
+	return C(i).GetConfigSnapshotRepository()
+}
+
+// SafeGetConsentController works like SafeGet but only for ConsentController.
+// It does not return an interface but a controllers.ConsentController.
+func (c *Container) SafeGetConsentController() (controllers.ConsentController, error) {
+	return typed.Get[controllers.ConsentController](c, "consent-controller")
+}
+
+// GetConsentController is similar to SafeGetConsentController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetConsentController() controllers.ConsentController {
+	o, err := c.SafeGetConsentController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetConsentController works like UnscopedSafeGet but only for ConsentController.
+// It does not return an interface but a controllers.ConsentController.
+func (c *Container) UnscopedSafeGetConsentController() (controllers.ConsentController, error) {
+	return typed.UnscopedGet[controllers.ConsentController](c, "consent-controller")
+}
+
+// UnscopedGetConsentController is similar to UnscopedSafeGetConsentController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetConsentController() controllers.ConsentController {
+	o, err := c.UnscopedSafeGetConsentController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ConsentController is similar to GetConsentController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetConsentController method.
+// If the container can not be retrieved, it panics.
+func ConsentController(i interface{}) controllers.ConsentController {
+	return C(i).GetConsentController()
+}
+
+// SafeGetConsentMiddleware works like SafeGet but only for ConsentMiddleware.
+// It does not return an interface but a middlewares.Consent.
+func (c *Container) SafeGetConsentMiddleware() (middlewares.Consent, error) {
+	return typed.Get[middlewares.Consent](c, "consent-middleware")
+}
+
+// GetConsentMiddleware is similar to SafeGetConsentMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetConsentMiddleware() middlewares.Consent {
+	o, err := c.SafeGetConsentMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetConsentMiddleware works like UnscopedSafeGet but only for ConsentMiddleware.
+// It does not return an interface but a middlewares.Consent.
+func (c *Container) UnscopedSafeGetConsentMiddleware() (middlewares.Consent, error) {
+	return typed.UnscopedGet[middlewares.Consent](c, "consent-middleware")
+}
+
+// UnscopedGetConsentMiddleware is similar to UnscopedSafeGetConsentMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetConsentMiddleware() middlewares.Consent {
+	o, err := c.UnscopedSafeGetConsentMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ConsentMiddleware is similar to GetConsentMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetConsentMiddleware method.
+// If the container can not be retrieved, it panics.
+func ConsentMiddleware(i interface{}) middlewares.Consent {
+	return C(i).GetConsentMiddleware()
+}
+
+// SafeGetConsentService works like SafeGet but only for ConsentService.
+// It does not return an interface but a services.IConsentService.
+func (c *Container) SafeGetConsentService() (services.IConsentService, error) {
+	return typed.Get[services.IConsentService](c, "consent-service")
+}
+
+// GetConsentService is similar to SafeGetConsentService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetConsentService() services.IConsentService {
+	o, err := c.SafeGetConsentService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetConsentService works like UnscopedSafeGet but only for ConsentService.
+// It does not return an interface but a services.IConsentService.
+func (c *Container) UnscopedSafeGetConsentService() (services.IConsentService, error) {
+	return typed.UnscopedGet[services.IConsentService](c, "consent-service")
+}
+
+// UnscopedGetConsentService is similar to UnscopedSafeGetConsentService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetConsentService() services.IConsentService {
+	o, err := c.UnscopedSafeGetConsentService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ConsentService is similar to GetConsentService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetConsentService method.
+// If the container can not be retrieved, it panics.
+func ConsentService(i interface{}) services.IConsentService {
+	return C(i).GetConsentService()
+}
+
+// SafeGetContractMiddleware works like SafeGet but only for ContractMiddleware.
+// It does not return an interface but a middlewares.Contract.
+func (c *Container) SafeGetContractMiddleware() (middlewares.Contract, error) {
+	return typed.Get[middlewares.Contract](c, "contract-middleware")
+}
+
+// GetContractMiddleware is similar to SafeGetContractMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetContractMiddleware() middlewares.Contract {
+	o, err := c.SafeGetContractMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetContractMiddleware works like UnscopedSafeGet but only for ContractMiddleware.
+// It does not return an interface but a middlewares.Contract.
+func (c *Container) UnscopedSafeGetContractMiddleware() (middlewares.Contract, error) {
+	return typed.UnscopedGet[middlewares.Contract](c, "contract-middleware")
+}
+
+// UnscopedGetContractMiddleware is similar to UnscopedSafeGetContractMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetContractMiddleware() middlewares.Contract {
+	o, err := c.UnscopedSafeGetContractMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ContractMiddleware is similar to GetContractMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetContractMiddleware method.
+// If the container can not be retrieved, it panics.
+func ContractMiddleware(i interface{}) middlewares.Contract {
+	return C(i).GetContractMiddleware()
+}
+
+// SafeGetConversationController works like SafeGet but only for ConversationController.
+// It does not return an interface but a controllers.ConversationController.
+func (c *Container) SafeGetConversationController() (controllers.ConversationController, error) {
+	return typed.Get[controllers.ConversationController](c, "conversation-controller")
+}
+
+// GetConversationController is similar to SafeGetConversationController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetConversationController() controllers.ConversationController {
+	o, err := c.SafeGetConversationController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetConversationController works like UnscopedSafeGet but only for ConversationController.
+// It does not return an interface but a controllers.ConversationController.
+func (c *Container) UnscopedSafeGetConversationController() (controllers.ConversationController, error) {
+	return typed.UnscopedGet[controllers.ConversationController](c, "conversation-controller")
+}
+
+// UnscopedGetConversationController is similar to UnscopedSafeGetConversationController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetConversationController() controllers.ConversationController {
+	o, err := c.UnscopedSafeGetConversationController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ConversationController is similar to GetConversationController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetConversationController method.
+// If the container can not be retrieved, it panics.
+func ConversationController(i interface{}) controllers.ConversationController {
+	return C(i).GetConversationController()
+}
+
+// SafeGetConversationRepository works like SafeGet but only for ConversationRepository.
+// It does not return an interface but a repositories.IConversationRepository.
+func (c *Container) SafeGetConversationRepository() (repositories.IConversationRepository, error) {
+	return typed.Get[repositories.IConversationRepository](c, "conversation-repository")
+}
+
+// GetConversationRepository is similar to SafeGetConversationRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetConversationRepository() repositories.IConversationRepository {
+	o, err := c.SafeGetConversationRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetConversationRepository works like UnscopedSafeGet but only for ConversationRepository.
+// It does not return an interface but a repositories.IConversationRepository.
+func (c *Container) UnscopedSafeGetConversationRepository() (repositories.IConversationRepository, error) {
+	return typed.UnscopedGet[repositories.IConversationRepository](c, "conversation-repository")
+}
+
+// UnscopedGetConversationRepository is similar to UnscopedSafeGetConversationRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetConversationRepository() repositories.IConversationRepository {
+	o, err := c.UnscopedSafeGetConversationRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ConversationRepository is similar to GetConversationRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetConversationRepository method.
+// If the container can not be retrieved, it panics.
+func ConversationRepository(i interface{}) repositories.IConversationRepository {
+	return C(i).GetConversationRepository()
+}
+
+// SafeGetConversationService works like SafeGet but only for ConversationService.
+// It does not return an interface but a services.IConversationService.
+func (c *Container) SafeGetConversationService() (services.IConversationService, error) {
+	return typed.Get[services.IConversationService](c, "conversation-service")
+}
+
+// GetConversationService is similar to SafeGetConversationService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetConversationService() services.IConversationService {
+	o, err := c.SafeGetConversationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetConversationService works like UnscopedSafeGet but only for ConversationService.
+// It does not return an interface but a services.IConversationService.
+func (c *Container) UnscopedSafeGetConversationService() (services.IConversationService, error) {
+	return typed.UnscopedGet[services.IConversationService](c, "conversation-service")
+}
+
+// UnscopedGetConversationService is similar to UnscopedSafeGetConversationService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetConversationService() services.IConversationService {
+	o, err := c.UnscopedSafeGetConversationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ConversationService is similar to GetConversationService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetConversationService method.
+// If the container can not be retrieved, it panics.
+func ConversationService(i interface{}) services.IConversationService {
+	return C(i).GetConversationService()
+}
+
+// SafeGetCouponController works like SafeGet but only for CouponController.
+// It does not return an interface but a controllers.CouponController.
+func (c *Container) SafeGetCouponController() (controllers.CouponController, error) {
+	return typed.Get[controllers.CouponController](c, "coupon-controller")
+}
+
+// GetCouponController is similar to SafeGetCouponController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetCouponController() controllers.CouponController {
+	o, err := c.SafeGetCouponController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetCouponController works like UnscopedSafeGet but only for CouponController.
+// It does not return an interface but a controllers.CouponController.
+func (c *Container) UnscopedSafeGetCouponController() (controllers.CouponController, error) {
+	return typed.UnscopedGet[controllers.CouponController](c, "coupon-controller")
+}
+
+// UnscopedGetCouponController is similar to UnscopedSafeGetCouponController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetCouponController() controllers.CouponController {
+	o, err := c.UnscopedSafeGetCouponController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// CouponController is similar to GetCouponController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetCouponController method.
+// If the container can not be retrieved, it panics.
+func CouponController(i interface{}) controllers.CouponController {
+	return C(i).GetCouponController()
+}
+
+// SafeGetCouponRepository works like SafeGet but only for CouponRepository.
+// It does not return an interface but a repositories.ICouponRepository.
+func (c *Container) SafeGetCouponRepository() (repositories.ICouponRepository, error) {
+	return typed.Get[repositories.ICouponRepository](c, "coupon-repository")
+}
+
+// GetCouponRepository is similar to SafeGetCouponRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetCouponRepository() repositories.ICouponRepository {
+	o, err := c.SafeGetCouponRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetCouponRepository works like UnscopedSafeGet but only for CouponRepository.
+// It does not return an interface but a repositories.ICouponRepository.
+func (c *Container) UnscopedSafeGetCouponRepository() (repositories.ICouponRepository, error) {
+	return typed.UnscopedGet[repositories.ICouponRepository](c, "coupon-repository")
+}
+
+// UnscopedGetCouponRepository is similar to UnscopedSafeGetCouponRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetCouponRepository() repositories.ICouponRepository {
+	o, err := c.UnscopedSafeGetCouponRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// CouponRepository is similar to GetCouponRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetCouponRepository method.
+// If the container can not be retrieved, it panics.
+func CouponRepository(i interface{}) repositories.ICouponRepository {
+	return C(i).GetCouponRepository()
+}
+
+// SafeGetCouponService works like SafeGet but only for CouponService.
+// It does not return an interface but a services.ICouponService.
+func (c *Container) SafeGetCouponService() (services.ICouponService, error) {
+	return typed.Get[services.ICouponService](c, "coupon-service")
+}
+
+// GetCouponService is similar to SafeGetCouponService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetCouponService() services.ICouponService {
+	o, err := c.SafeGetCouponService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetCouponService works like UnscopedSafeGet but only for CouponService.
+// It does not return an interface but a services.ICouponService.
+func (c *Container) UnscopedSafeGetCouponService() (services.ICouponService, error) {
+	return typed.UnscopedGet[services.ICouponService](c, "coupon-service")
+}
+
+// UnscopedGetCouponService is similar to UnscopedSafeGetCouponService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetCouponService() services.ICouponService {
+	o, err := c.UnscopedSafeGetCouponService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// CouponService is similar to GetCouponService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetCouponService method.
+// If the container can not be retrieved, it panics.
+func CouponService(i interface{}) services.ICouponService {
+	return C(i).GetCouponService()
+}
+
+// SafeGetDataExportRepository works like SafeGet but only for DataExportRepository.
+// It does not return an interface but a repositories.IDataExportRepository.
+func (c *Container) SafeGetDataExportRepository() (repositories.IDataExportRepository, error) {
+	return typed.Get[repositories.IDataExportRepository](c, "data-export-repository")
+}
+
+// GetDataExportRepository is similar to SafeGetDataExportRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDataExportRepository() repositories.IDataExportRepository {
+	o, err := c.SafeGetDataExportRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDataExportRepository works like UnscopedSafeGet but only for DataExportRepository.
+// It does not return an interface but a repositories.IDataExportRepository.
+func (c *Container) UnscopedSafeGetDataExportRepository() (repositories.IDataExportRepository, error) {
+	return typed.UnscopedGet[repositories.IDataExportRepository](c, "data-export-repository")
+}
+
+// UnscopedGetDataExportRepository is similar to UnscopedSafeGetDataExportRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDataExportRepository() repositories.IDataExportRepository {
+	o, err := c.UnscopedSafeGetDataExportRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DataExportRepository is similar to GetDataExportRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDataExportRepository method.
+// If the container can not be retrieved, it panics.
+func DataExportRepository(i interface{}) repositories.IDataExportRepository {
+	return C(i).GetDataExportRepository()
+}
+
+// SafeGetDatabaseSupervisor works like SafeGet but only for DatabaseSupervisor.
+// It does not return an interface but a infrastructures.IDatabaseSupervisor.
+func (c *Container) SafeGetDatabaseSupervisor() (infrastructures.IDatabaseSupervisor, error) {
+	return typed.Get[infrastructures.IDatabaseSupervisor](c, "database-supervisor")
+}
+
+// GetDatabaseSupervisor is similar to SafeGetDatabaseSupervisor but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDatabaseSupervisor() infrastructures.IDatabaseSupervisor {
+	o, err := c.SafeGetDatabaseSupervisor()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDatabaseSupervisor works like UnscopedSafeGet but only for DatabaseSupervisor.
+// It does not return an interface but a infrastructures.IDatabaseSupervisor.
+func (c *Container) UnscopedSafeGetDatabaseSupervisor() (infrastructures.IDatabaseSupervisor, error) {
+	return typed.UnscopedGet[infrastructures.IDatabaseSupervisor](c, "database-supervisor")
+}
+
+// UnscopedGetDatabaseSupervisor is similar to UnscopedSafeGetDatabaseSupervisor but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDatabaseSupervisor() infrastructures.IDatabaseSupervisor {
+	o, err := c.UnscopedSafeGetDatabaseSupervisor()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DatabaseSupervisor is similar to GetDatabaseSupervisor.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDatabaseSupervisor method.
+// If the container can not be retrieved, it panics.
+func DatabaseSupervisor(i interface{}) infrastructures.IDatabaseSupervisor {
+	return C(i).GetDatabaseSupervisor()
+}
+
+// SafeGetDb works like SafeGet but only for Db.
+// It does not return an interface but a infrastructures.IGormDatabase.
+func (c *Container) SafeGetDb() (infrastructures.IGormDatabase, error) {
+	return typed.Get[infrastructures.IGormDatabase](c, "db")
+}
+
+// GetDb is similar to SafeGetDb but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDb() infrastructures.IGormDatabase {
+	o, err := c.SafeGetDb()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDb works like UnscopedSafeGet but only for Db.
+// It does not return an interface but a infrastructures.IGormDatabase.
+func (c *Container) UnscopedSafeGetDb() (infrastructures.IGormDatabase, error) {
+	return typed.UnscopedGet[infrastructures.IGormDatabase](c, "db")
+}
+
+// UnscopedGetDb is similar to UnscopedSafeGetDb but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDb() infrastructures.IGormDatabase {
+	o, err := c.UnscopedSafeGetDb()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Db is similar to GetDb.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDb method.
+// If the container can not be retrieved, it panics.
+func Db(i interface{}) infrastructures.IGormDatabase {
+	return C(i).GetDb()
+}
+
+// SafeGetDbPool works like SafeGet but only for DbPool.
+// It does not return an interface but a infrastructures.IGormDatabasePool.
+func (c *Container) SafeGetDbPool() (infrastructures.IGormDatabasePool, error) {
+	return typed.Get[infrastructures.IGormDatabasePool](c, "db-pool")
+}
+
+// GetDbPool is similar to SafeGetDbPool but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDbPool() infrastructures.IGormDatabasePool {
+	o, err := c.SafeGetDbPool()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDbPool works like UnscopedSafeGet but only for DbPool.
+// It does not return an interface but a infrastructures.IGormDatabasePool.
+func (c *Container) UnscopedSafeGetDbPool() (infrastructures.IGormDatabasePool, error) {
+	return typed.UnscopedGet[infrastructures.IGormDatabasePool](c, "db-pool")
+}
+
+// UnscopedGetDbPool is similar to UnscopedSafeGetDbPool but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDbPool() infrastructures.IGormDatabasePool {
+	o, err := c.UnscopedSafeGetDbPool()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DbPool is similar to GetDbPool.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDbPool method.
+// If the container can not be retrieved, it panics.
+func DbPool(i interface{}) infrastructures.IGormDatabasePool {
+	return C(i).GetDbPool()
+}
+
+// SafeGetDbRetrier works like SafeGet but only for DbRetrier.
+// It does not return an interface but a infrastructures.IDbRetrier.
+func (c *Container) SafeGetDbRetrier() (infrastructures.IDbRetrier, error) {
+	return typed.Get[infrastructures.IDbRetrier](c, "db-retrier")
+}
+
+// GetDbRetrier is similar to SafeGetDbRetrier but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDbRetrier() infrastructures.IDbRetrier {
+	o, err := c.SafeGetDbRetrier()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDbRetrier works like UnscopedSafeGet but only for DbRetrier.
+// It does not return an interface but a infrastructures.IDbRetrier.
+func (c *Container) UnscopedSafeGetDbRetrier() (infrastructures.IDbRetrier, error) {
+	return typed.UnscopedGet[infrastructures.IDbRetrier](c, "db-retrier")
+}
+
+// UnscopedGetDbRetrier is similar to UnscopedSafeGetDbRetrier but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDbRetrier() infrastructures.IDbRetrier {
+	o, err := c.UnscopedSafeGetDbRetrier()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DbRetrier is similar to GetDbRetrier.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDbRetrier method.
+// If the container can not be retrieved, it panics.
+func DbRetrier(i interface{}) infrastructures.IDbRetrier {
+	return C(i).GetDbRetrier()
+}
+
+// SafeGetDeadLetterController works like SafeGet but only for DeadLetterController.
+// It does not return an interface but a controllers.DeadLetterController.
+func (c *Container) SafeGetDeadLetterController() (controllers.DeadLetterController, error) {
+	return typed.Get[controllers.DeadLetterController](c, "dead-letter-controller")
+}
+
+// GetDeadLetterController is similar to SafeGetDeadLetterController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDeadLetterController() controllers.DeadLetterController {
+	o, err := c.SafeGetDeadLetterController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDeadLetterController works like UnscopedSafeGet but only for DeadLetterController.
+// It does not return an interface but a controllers.DeadLetterController.
+func (c *Container) UnscopedSafeGetDeadLetterController() (controllers.DeadLetterController, error) {
+	return typed.UnscopedGet[controllers.DeadLetterController](c, "dead-letter-controller")
+}
+
+// UnscopedGetDeadLetterController is similar to UnscopedSafeGetDeadLetterController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDeadLetterController() controllers.DeadLetterController {
+	o, err := c.UnscopedSafeGetDeadLetterController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DeadLetterController is similar to GetDeadLetterController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDeadLetterController method.
+// If the container can not be retrieved, it panics.
+func DeadLetterController(i interface{}) controllers.DeadLetterController {
+	return C(i).GetDeadLetterController()
+}
+
+// SafeGetDeadLetterRepository works like SafeGet but only for DeadLetterRepository.
+// It does not return an interface but a repositories.IDeadLetterRepository.
+func (c *Container) SafeGetDeadLetterRepository() (repositories.IDeadLetterRepository, error) {
+	return typed.Get[repositories.IDeadLetterRepository](c, "dead-letter-repository")
+}
+
+// GetDeadLetterRepository is similar to SafeGetDeadLetterRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDeadLetterRepository() repositories.IDeadLetterRepository {
+	o, err := c.SafeGetDeadLetterRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDeadLetterRepository works like UnscopedSafeGet but only for DeadLetterRepository.
+// It does not return an interface but a repositories.IDeadLetterRepository.
+func (c *Container) UnscopedSafeGetDeadLetterRepository() (repositories.IDeadLetterRepository, error) {
+	return typed.UnscopedGet[repositories.IDeadLetterRepository](c, "dead-letter-repository")
+}
+
+// UnscopedGetDeadLetterRepository is similar to UnscopedSafeGetDeadLetterRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDeadLetterRepository() repositories.IDeadLetterRepository {
+	o, err := c.UnscopedSafeGetDeadLetterRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DeadLetterRepository is similar to GetDeadLetterRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDeadLetterRepository method.
+// If the container can not be retrieved, it panics.
+func DeadLetterRepository(i interface{}) repositories.IDeadLetterRepository {
+	return C(i).GetDeadLetterRepository()
+}
+
+// SafeGetDeadLetterService works like SafeGet but only for DeadLetterService.
+// It does not return an interface but a services.IDeadLetterService.
+func (c *Container) SafeGetDeadLetterService() (services.IDeadLetterService, error) {
+	return typed.Get[services.IDeadLetterService](c, "dead-letter-service")
+}
+
+// GetDeadLetterService is similar to SafeGetDeadLetterService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDeadLetterService() services.IDeadLetterService {
+	o, err := c.SafeGetDeadLetterService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDeadLetterService works like UnscopedSafeGet but only for DeadLetterService.
+// It does not return an interface but a services.IDeadLetterService.
+func (c *Container) UnscopedSafeGetDeadLetterService() (services.IDeadLetterService, error) {
+	return typed.UnscopedGet[services.IDeadLetterService](c, "dead-letter-service")
+}
+
+// UnscopedGetDeadLetterService is similar to UnscopedSafeGetDeadLetterService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDeadLetterService() services.IDeadLetterService {
+	o, err := c.UnscopedSafeGetDeadLetterService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DeadLetterService is similar to GetDeadLetterService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDeadLetterService method.
+// If the container can not be retrieved, it panics.
+func DeadLetterService(i interface{}) services.IDeadLetterService {
+	return C(i).GetDeadLetterService()
+}
+
+// SafeGetDeprecationController works like SafeGet but only for DeprecationController.
+// It does not return an interface but a controllers.DeprecationController.
+func (c *Container) SafeGetDeprecationController() (controllers.DeprecationController, error) {
+	return typed.Get[controllers.DeprecationController](c, "deprecation-controller")
+}
+
+// GetDeprecationController is similar to SafeGetDeprecationController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDeprecationController() controllers.DeprecationController {
+	o, err := c.SafeGetDeprecationController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDeprecationController works like UnscopedSafeGet but only for DeprecationController.
+// It does not return an interface but a controllers.DeprecationController.
+func (c *Container) UnscopedSafeGetDeprecationController() (controllers.DeprecationController, error) {
+	return typed.UnscopedGet[controllers.DeprecationController](c, "deprecation-controller")
+}
+
+// UnscopedGetDeprecationController is similar to UnscopedSafeGetDeprecationController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDeprecationController() controllers.DeprecationController {
+	o, err := c.UnscopedSafeGetDeprecationController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DeprecationController is similar to GetDeprecationController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDeprecationController method.
+// If the container can not be retrieved, it panics.
+func DeprecationController(i interface{}) controllers.DeprecationController {
+	return C(i).GetDeprecationController()
+}
+
+// SafeGetDeprecationMiddleware works like SafeGet but only for DeprecationMiddleware.
+// It does not return an interface but a middlewares.Deprecation.
+func (c *Container) SafeGetDeprecationMiddleware() (middlewares.Deprecation, error) {
+	return typed.Get[middlewares.Deprecation](c, "deprecation-middleware")
+}
+
+// GetDeprecationMiddleware is similar to SafeGetDeprecationMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDeprecationMiddleware() middlewares.Deprecation {
+	o, err := c.SafeGetDeprecationMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDeprecationMiddleware works like UnscopedSafeGet but only for DeprecationMiddleware.
+// It does not return an interface but a middlewares.Deprecation.
+func (c *Container) UnscopedSafeGetDeprecationMiddleware() (middlewares.Deprecation, error) {
+	return typed.UnscopedGet[middlewares.Deprecation](c, "deprecation-middleware")
+}
+
+// UnscopedGetDeprecationMiddleware is similar to UnscopedSafeGetDeprecationMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDeprecationMiddleware() middlewares.Deprecation {
+	o, err := c.UnscopedSafeGetDeprecationMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DeprecationMiddleware is similar to GetDeprecationMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDeprecationMiddleware method.
+// If the container can not be retrieved, it panics.
+func DeprecationMiddleware(i interface{}) middlewares.Deprecation {
+	return C(i).GetDeprecationMiddleware()
+}
+
+// SafeGetDeprecationRepository works like SafeGet but only for DeprecationRepository.
+// It does not return an interface but a repositories.IDeprecationRepository.
+func (c *Container) SafeGetDeprecationRepository() (repositories.IDeprecationRepository, error) {
+	return typed.Get[repositories.IDeprecationRepository](c, "deprecation-repository")
+}
+
+// GetDeprecationRepository is similar to SafeGetDeprecationRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDeprecationRepository() repositories.IDeprecationRepository {
+	o, err := c.SafeGetDeprecationRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDeprecationRepository works like UnscopedSafeGet but only for DeprecationRepository.
+// It does not return an interface but a repositories.IDeprecationRepository.
+func (c *Container) UnscopedSafeGetDeprecationRepository() (repositories.IDeprecationRepository, error) {
+	return typed.UnscopedGet[repositories.IDeprecationRepository](c, "deprecation-repository")
+}
+
+// UnscopedGetDeprecationRepository is similar to UnscopedSafeGetDeprecationRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDeprecationRepository() repositories.IDeprecationRepository {
+	o, err := c.UnscopedSafeGetDeprecationRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DeprecationRepository is similar to GetDeprecationRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDeprecationRepository method.
+// If the container can not be retrieved, it panics.
+func DeprecationRepository(i interface{}) repositories.IDeprecationRepository {
+	return C(i).GetDeprecationRepository()
+}
+
+// SafeGetDeprecationService works like SafeGet but only for DeprecationService.
+// It does not return an interface but a services.IDeprecationService.
+func (c *Container) SafeGetDeprecationService() (services.IDeprecationService, error) {
+	return typed.Get[services.IDeprecationService](c, "deprecation-service")
+}
+
+// GetDeprecationService is similar to SafeGetDeprecationService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDeprecationService() services.IDeprecationService {
+	o, err := c.SafeGetDeprecationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDeprecationService works like UnscopedSafeGet but only for DeprecationService.
+// It does not return an interface but a services.IDeprecationService.
+func (c *Container) UnscopedSafeGetDeprecationService() (services.IDeprecationService, error) {
+	return typed.UnscopedGet[services.IDeprecationService](c, "deprecation-service")
+}
+
+// UnscopedGetDeprecationService is similar to UnscopedSafeGetDeprecationService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDeprecationService() services.IDeprecationService {
+	o, err := c.UnscopedSafeGetDeprecationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DeprecationService is similar to GetDeprecationService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDeprecationService method.
+// If the container can not be retrieved, it panics.
+func DeprecationService(i interface{}) services.IDeprecationService {
+	return C(i).GetDeprecationService()
+}
+
+// SafeGetDeviceRepository works like SafeGet but only for DeviceRepository.
+// It does not return an interface but a repositories.IDeviceRepository.
+func (c *Container) SafeGetDeviceRepository() (repositories.IDeviceRepository, error) {
+	return typed.Get[repositories.IDeviceRepository](c, "device-repository")
+}
+
+// GetDeviceRepository is similar to SafeGetDeviceRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetDeviceRepository() repositories.IDeviceRepository {
+	o, err := c.SafeGetDeviceRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetDeviceRepository works like UnscopedSafeGet but only for DeviceRepository.
+// It does not return an interface but a repositories.IDeviceRepository.
+func (c *Container) UnscopedSafeGetDeviceRepository() (repositories.IDeviceRepository, error) {
+	return typed.UnscopedGet[repositories.IDeviceRepository](c, "device-repository")
+}
+
+// UnscopedGetDeviceRepository is similar to UnscopedSafeGetDeviceRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetDeviceRepository() repositories.IDeviceRepository {
+	o, err := c.UnscopedSafeGetDeviceRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// DeviceRepository is similar to GetDeviceRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetDeviceRepository method.
+// If the container can not be retrieved, it panics.
+func DeviceRepository(i interface{}) repositories.IDeviceRepository {
+	return C(i).GetDeviceRepository()
+}
+
+// SafeGetEmail works like SafeGet but only for Email.
+// It does not return an interface but a infrastructures.IEmailService.
+func (c *Container) SafeGetEmail() (infrastructures.IEmailService, error) {
+	return typed.Get[infrastructures.IEmailService](c, "email")
+}
+
+// GetEmail is similar to SafeGetEmail but it does not return the error.
+// Instead it panics.
+func (c *Container) GetEmail() infrastructures.IEmailService {
+	o, err := c.SafeGetEmail()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetEmail works like UnscopedSafeGet but only for Email.
+// It does not return an interface but a infrastructures.IEmailService.
+func (c *Container) UnscopedSafeGetEmail() (infrastructures.IEmailService, error) {
+	return typed.UnscopedGet[infrastructures.IEmailService](c, "email")
+}
+
+// UnscopedGetEmail is similar to UnscopedSafeGetEmail but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetEmail() infrastructures.IEmailService {
+	o, err := c.UnscopedSafeGetEmail()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Email is similar to GetEmail.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetEmail method.
+// If the container can not be retrieved, it panics.
+func Email(i interface{}) infrastructures.IEmailService {
+	return C(i).GetEmail()
+}
+
+// SafeGetEmailChangeConfirmationMail works like SafeGet but only for EmailChangeConfirmationMail.
+// It does not return an interface but a mails.IMailRenderer.
+func (c *Container) SafeGetEmailChangeConfirmationMail() (mails.IMailRenderer, error) {
+	return typed.Get[mails.IMailRenderer](c, "email-change-confirmation-mail")
+}
+
+// GetEmailChangeConfirmationMail is similar to SafeGetEmailChangeConfirmationMail but it does not return the error.
+// Instead it panics.
+func (c *Container) GetEmailChangeConfirmationMail() mails.IMailRenderer {
+	o, err := c.SafeGetEmailChangeConfirmationMail()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetEmailChangeConfirmationMail works like UnscopedSafeGet but only for EmailChangeConfirmationMail.
+// It does not return an interface but a mails.IMailRenderer.
+func (c *Container) UnscopedSafeGetEmailChangeConfirmationMail() (mails.IMailRenderer, error) {
+	return typed.UnscopedGet[mails.IMailRenderer](c, "email-change-confirmation-mail")
+}
+
+// UnscopedGetEmailChangeConfirmationMail is similar to UnscopedSafeGetEmailChangeConfirmationMail but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetEmailChangeConfirmationMail() mails.IMailRenderer {
+	o, err := c.UnscopedSafeGetEmailChangeConfirmationMail()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// EmailChangeConfirmationMail is similar to GetEmailChangeConfirmationMail.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetEmailChangeConfirmationMail method.
+// If the container can not be retrieved, it panics.
+func EmailChangeConfirmationMail(i interface{}) mails.IMailRenderer {
+	return C(i).GetEmailChangeConfirmationMail()
+}
+
+// SafeGetEmailChangeNoticeMail works like SafeGet but only for EmailChangeNoticeMail.
+// It does not return an interface but a mails.IMailRenderer.
+func (c *Container) SafeGetEmailChangeNoticeMail() (mails.IMailRenderer, error) {
+	return typed.Get[mails.IMailRenderer](c, "email-change-notice-mail")
+}
+
+// GetEmailChangeNoticeMail is similar to SafeGetEmailChangeNoticeMail but it does not return the error.
+// Instead it panics.
+func (c *Container) GetEmailChangeNoticeMail() mails.IMailRenderer {
+	o, err := c.SafeGetEmailChangeNoticeMail()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetEmailChangeNoticeMail works like UnscopedSafeGet but only for EmailChangeNoticeMail.
+// It does not return an interface but a mails.IMailRenderer.
+func (c *Container) UnscopedSafeGetEmailChangeNoticeMail() (mails.IMailRenderer, error) {
+	return typed.UnscopedGet[mails.IMailRenderer](c, "email-change-notice-mail")
+}
+
+// UnscopedGetEmailChangeNoticeMail is similar to UnscopedSafeGetEmailChangeNoticeMail but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetEmailChangeNoticeMail() mails.IMailRenderer {
+	o, err := c.UnscopedSafeGetEmailChangeNoticeMail()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// EmailChangeNoticeMail is similar to GetEmailChangeNoticeMail.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetEmailChangeNoticeMail method.
+// If the container can not be retrieved, it panics.
+func EmailChangeNoticeMail(i interface{}) mails.IMailRenderer {
+	return C(i).GetEmailChangeNoticeMail()
+}
+
+// SafeGetEmailChangeRepository works like SafeGet but only for EmailChangeRepository.
+// It does not return an interface but a repositories.IEmailChangeRepository.
+func (c *Container) SafeGetEmailChangeRepository() (repositories.IEmailChangeRepository, error) {
+	return typed.Get[repositories.IEmailChangeRepository](c, "email-change-repository")
+}
+
+// GetEmailChangeRepository is similar to SafeGetEmailChangeRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetEmailChangeRepository() repositories.IEmailChangeRepository {
+	o, err := c.SafeGetEmailChangeRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetEmailChangeRepository works like UnscopedSafeGet but only for EmailChangeRepository.
+// It does not return an interface but a repositories.IEmailChangeRepository.
+func (c *Container) UnscopedSafeGetEmailChangeRepository() (repositories.IEmailChangeRepository, error) {
+	return typed.UnscopedGet[repositories.IEmailChangeRepository](c, "email-change-repository")
+}
+
+// UnscopedGetEmailChangeRepository is similar to UnscopedSafeGetEmailChangeRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetEmailChangeRepository() repositories.IEmailChangeRepository {
+	o, err := c.UnscopedSafeGetEmailChangeRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// EmailChangeRepository is similar to GetEmailChangeRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetEmailChangeRepository method.
+// If the container can not be retrieved, it panics.
+func EmailChangeRepository(i interface{}) repositories.IEmailChangeRepository {
+	return C(i).GetEmailChangeRepository()
+}
+
+// SafeGetEmailChangeService works like SafeGet but only for EmailChangeService.
+// It does not return an interface but a services.IEmailChangeService.
+func (c *Container) SafeGetEmailChangeService() (services.IEmailChangeService, error) {
+	return typed.Get[services.IEmailChangeService](c, "email-change-service")
+}
+
+// GetEmailChangeService is similar to SafeGetEmailChangeService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetEmailChangeService() services.IEmailChangeService {
+	o, err := c.SafeGetEmailChangeService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetEmailChangeService works like UnscopedSafeGet but only for EmailChangeService.
+// It does not return an interface but a services.IEmailChangeService.
+func (c *Container) UnscopedSafeGetEmailChangeService() (services.IEmailChangeService, error) {
+	return typed.UnscopedGet[services.IEmailChangeService](c, "email-change-service")
+}
+
+// UnscopedGetEmailChangeService is similar to UnscopedSafeGetEmailChangeService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetEmailChangeService() services.IEmailChangeService {
+	o, err := c.UnscopedSafeGetEmailChangeService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// EmailChangeService is similar to GetEmailChangeService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetEmailChangeService method.
+// If the container can not be retrieved, it panics.
+func EmailChangeService(i interface{}) services.IEmailChangeService {
+	return C(i).GetEmailChangeService()
+}
+
+// SafeGetErrorReporter works like SafeGet but only for ErrorReporter.
+// It does not return an interface but a infrastructures.IErrorReporter.
+func (c *Container) SafeGetErrorReporter() (infrastructures.IErrorReporter, error) {
+	return typed.Get[infrastructures.IErrorReporter](c, "error-reporter")
+}
+
+// GetErrorReporter is similar to SafeGetErrorReporter but it does not return the error.
+// Instead it panics.
+func (c *Container) GetErrorReporter() infrastructures.IErrorReporter {
+	o, err := c.SafeGetErrorReporter()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetErrorReporter works like UnscopedSafeGet but only for ErrorReporter.
+// It does not return an interface but a infrastructures.IErrorReporter.
+func (c *Container) UnscopedSafeGetErrorReporter() (infrastructures.IErrorReporter, error) {
+	return typed.UnscopedGet[infrastructures.IErrorReporter](c, "error-reporter")
+}
+
+// UnscopedGetErrorReporter is similar to UnscopedSafeGetErrorReporter but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetErrorReporter() infrastructures.IErrorReporter {
+	o, err := c.UnscopedSafeGetErrorReporter()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ErrorReporter is similar to GetErrorReporter.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetErrorReporter method.
+// If the container can not be retrieved, it panics.
+func ErrorReporter(i interface{}) infrastructures.IErrorReporter {
+	return C(i).GetErrorReporter()
+}
+
+// SafeGetEvents works like SafeGet but only for Events.
+// It does not return an interface but a infrastructures.IEventBus.
+func (c *Container) SafeGetEvents() (infrastructures.IEventBus, error) {
+	return typed.Get[infrastructures.IEventBus](c, "events")
+}
+
+// GetEvents is similar to SafeGetEvents but it does not return the error.
+// Instead it panics.
+func (c *Container) GetEvents() infrastructures.IEventBus {
+	o, err := c.SafeGetEvents()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetEvents works like UnscopedSafeGet but only for Events.
+// It does not return an interface but a infrastructures.IEventBus.
+func (c *Container) UnscopedSafeGetEvents() (infrastructures.IEventBus, error) {
+	return typed.UnscopedGet[infrastructures.IEventBus](c, "events")
+}
+
+// UnscopedGetEvents is similar to UnscopedSafeGetEvents but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetEvents() infrastructures.IEventBus {
+	o, err := c.UnscopedSafeGetEvents()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Events is similar to GetEvents.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetEvents method.
+// If the container can not be retrieved, it panics.
+func Events(i interface{}) infrastructures.IEventBus {
+	return C(i).GetEvents()
+}
+
+// SafeGetQueue works like SafeGet but only for Queue.
+// It does not return an interface but a infrastructures.IQueue.
+func (c *Container) SafeGetQueue() (infrastructures.IQueue, error) {
+	return typed.Get[infrastructures.IQueue](c, "queue")
+}
+
+// GetQueue is similar to SafeGetQueue but it does not return the error.
+// Instead it panics.
+func (c *Container) GetQueue() infrastructures.IQueue {
+	o, err := c.SafeGetQueue()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetQueue works like UnscopedSafeGet but only for Queue.
+// It does not return an interface but a infrastructures.IQueue.
+func (c *Container) UnscopedSafeGetQueue() (infrastructures.IQueue, error) {
+	return typed.UnscopedGet[infrastructures.IQueue](c, "queue")
+}
+
+// UnscopedGetQueue is similar to UnscopedSafeGetQueue but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetQueue() infrastructures.IQueue {
+	o, err := c.UnscopedSafeGetQueue()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Queue is similar to GetQueue.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetQueue method.
+// If the container can not be retrieved, it panics.
+func Queue(i interface{}) infrastructures.IQueue {
+	return C(i).GetQueue()
+}
+
+// SafeGetReplayMiddleware works like SafeGet but only for ReplayMiddleware.
+// It does not return an interface but a middlewares.Replay.
+func (c *Container) SafeGetReplayMiddleware() (middlewares.Replay, error) {
+	return typed.Get[middlewares.Replay](c, "replay-middleware")
+}
+
+// GetReplayMiddleware is similar to SafeGetReplayMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetReplayMiddleware() middlewares.Replay {
+	o, err := c.SafeGetReplayMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetReplayMiddleware works like UnscopedSafeGet but only for ReplayMiddleware.
+// It does not return an interface but a middlewares.Replay.
+func (c *Container) UnscopedSafeGetReplayMiddleware() (middlewares.Replay, error) {
+	return typed.UnscopedGet[middlewares.Replay](c, "replay-middleware")
+}
+
+// UnscopedGetReplayMiddleware is similar to UnscopedSafeGetReplayMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetReplayMiddleware() middlewares.Replay {
+	o, err := c.UnscopedSafeGetReplayMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ReplayMiddleware is similar to GetReplayMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetReplayMiddleware method.
+// If the container can not be retrieved, it panics.
+func ReplayMiddleware(i interface{}) middlewares.Replay {
+	return C(i).GetReplayMiddleware()
+}
+
+// SafeGetRetentionRepository works like SafeGet but only for RetentionRepository.
+// It does not return an interface but a repositories.IRetentionRepository.
+func (c *Container) SafeGetRetentionRepository() (repositories.IRetentionRepository, error) {
+	return typed.Get[repositories.IRetentionRepository](c, "retention-repository")
+}
+
+// GetRetentionRepository is similar to SafeGetRetentionRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetRetentionRepository() repositories.IRetentionRepository {
+	o, err := c.SafeGetRetentionRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetRetentionRepository works like UnscopedSafeGet but only for RetentionRepository.
+// It does not return an interface but a repositories.IRetentionRepository.
+func (c *Container) UnscopedSafeGetRetentionRepository() (repositories.IRetentionRepository, error) {
+	return typed.UnscopedGet[repositories.IRetentionRepository](c, "retention-repository")
+}
+
+// UnscopedGetRetentionRepository is similar to UnscopedSafeGetRetentionRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetRetentionRepository() repositories.IRetentionRepository {
+	o, err := c.UnscopedSafeGetRetentionRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// RetentionRepository is similar to GetRetentionRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetRetentionRepository method.
+// If the container can not be retrieved, it panics.
+func RetentionRepository(i interface{}) repositories.IRetentionRepository {
+	return C(i).GetRetentionRepository()
+}
+
+// SafeGetRetentionService works like SafeGet but only for RetentionService.
+// It does not return an interface but a services.IRetentionService.
+func (c *Container) SafeGetRetentionService() (services.IRetentionService, error) {
+	return typed.Get[services.IRetentionService](c, "retention-service")
+}
+
+// GetRetentionService is similar to SafeGetRetentionService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetRetentionService() services.IRetentionService {
+	o, err := c.SafeGetRetentionService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetRetentionService works like UnscopedSafeGet but only for RetentionService.
+// It does not return an interface but a services.IRetentionService.
+func (c *Container) UnscopedSafeGetRetentionService() (services.IRetentionService, error) {
+	return typed.UnscopedGet[services.IRetentionService](c, "retention-service")
+}
+
+// UnscopedGetRetentionService is similar to UnscopedSafeGetRetentionService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetRetentionService() services.IRetentionService {
+	o, err := c.UnscopedSafeGetRetentionService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// RetentionService is similar to GetRetentionService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetRetentionService method.
+// If the container can not be retrieved, it panics.
+func RetentionService(i interface{}) services.IRetentionService {
+	return C(i).GetRetentionService()
+}
+
+// SafeGetSagaRepository works like SafeGet but only for SagaRepository.
+// It does not return an interface but a repositories.ISagaRepository.
+func (c *Container) SafeGetSagaRepository() (repositories.ISagaRepository, error) {
+	return typed.Get[repositories.ISagaRepository](c, "saga-repository")
+}
+
+// GetSagaRepository is similar to SafeGetSagaRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSagaRepository() repositories.ISagaRepository {
+	o, err := c.SafeGetSagaRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSagaRepository works like UnscopedSafeGet but only for SagaRepository.
+// It does not return an interface but a repositories.ISagaRepository.
+func (c *Container) UnscopedSafeGetSagaRepository() (repositories.ISagaRepository, error) {
+	return typed.UnscopedGet[repositories.ISagaRepository](c, "saga-repository")
+}
+
+// UnscopedGetSagaRepository is similar to UnscopedSafeGetSagaRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSagaRepository() repositories.ISagaRepository {
+	o, err := c.UnscopedSafeGetSagaRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SagaRepository is similar to GetSagaRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSagaRepository method.
+// If the container can not be retrieved, it panics.
+func SagaRepository(i interface{}) repositories.ISagaRepository {
+	return C(i).GetSagaRepository()
+}
+
+// SafeGetSagaService works like SafeGet but only for SagaService.
+// It does not return an interface but a services.ISagaService.
+func (c *Container) SafeGetSagaService() (services.ISagaService, error) {
+	return typed.Get[services.ISagaService](c, "saga-service")
+}
+
+// GetSagaService is similar to SafeGetSagaService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSagaService() services.ISagaService {
+	o, err := c.SafeGetSagaService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSagaService works like UnscopedSafeGet but only for SagaService.
+// It does not return an interface but a services.ISagaService.
+func (c *Container) UnscopedSafeGetSagaService() (services.ISagaService, error) {
+	return typed.UnscopedGet[services.ISagaService](c, "saga-service")
+}
+
+// UnscopedGetSagaService is similar to UnscopedSafeGetSagaService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSagaService() services.ISagaService {
+	o, err := c.UnscopedSafeGetSagaService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SagaService is similar to GetSagaService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSagaService method.
+// If the container can not be retrieved, it panics.
+func SagaService(i interface{}) services.ISagaService {
+	return C(i).GetSagaService()
+}
+
+// SafeGetShards works like SafeGet but only for Shards.
+// It does not return an interface but a infrastructures.IShards.
+func (c *Container) SafeGetShards() (infrastructures.IShards, error) {
+	return typed.Get[infrastructures.IShards](c, "shards")
+}
+
+// GetShards is similar to SafeGetShards but it does not return the error.
+// Instead it panics.
+func (c *Container) GetShards() infrastructures.IShards {
+	o, err := c.SafeGetShards()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetShards works like UnscopedSafeGet but only for Shards.
+// It does not return an interface but a infrastructures.IShards.
+func (c *Container) UnscopedSafeGetShards() (infrastructures.IShards, error) {
+	return typed.UnscopedGet[infrastructures.IShards](c, "shards")
+}
+
+// UnscopedGetShards is similar to UnscopedSafeGetShards but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetShards() infrastructures.IShards {
+	o, err := c.UnscopedSafeGetShards()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Shards is similar to GetShards.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetShards method.
+// If the container can not be retrieved, it panics.
+func Shards(i interface{}) infrastructures.IShards {
+	return C(i).GetShards()
+}
+
+// SafeGetFeatureFlags works like SafeGet but only for FeatureFlags.
+// It does not return an interface but a infrastructures.IFeatureFlags.
+func (c *Container) SafeGetFeatureFlags() (infrastructures.IFeatureFlags, error) {
+	return typed.Get[infrastructures.IFeatureFlags](c, "feature-flags")
+}
+
+// GetFeatureFlags is similar to SafeGetFeatureFlags but it does not return the error.
+// Instead it panics.
+func (c *Container) GetFeatureFlags() infrastructures.IFeatureFlags {
+	o, err := c.SafeGetFeatureFlags()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetFeatureFlags works like UnscopedSafeGet but only for FeatureFlags.
+// It does not return an interface but a infrastructures.IFeatureFlags.
+func (c *Container) UnscopedSafeGetFeatureFlags() (infrastructures.IFeatureFlags, error) {
+	return typed.UnscopedGet[infrastructures.IFeatureFlags](c, "feature-flags")
+}
+
+// UnscopedGetFeatureFlags is similar to UnscopedSafeGetFeatureFlags but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetFeatureFlags() infrastructures.IFeatureFlags {
+	o, err := c.UnscopedSafeGetFeatureFlags()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// FeatureFlags is similar to GetFeatureFlags.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetFeatureFlags method.
+// If the container can not be retrieved, it panics.
+func FeatureFlags(i interface{}) infrastructures.IFeatureFlags {
+	return C(i).GetFeatureFlags()
+}
+
+// SafeGetFeaturesMiddleware works like SafeGet but only for FeaturesMiddleware.
+// It does not return an interface but a middlewares.Features.
+func (c *Container) SafeGetFeaturesMiddleware() (middlewares.Features, error) {
+	return typed.Get[middlewares.Features](c, "features-middleware")
+}
+
+// GetFeaturesMiddleware is similar to SafeGetFeaturesMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetFeaturesMiddleware() middlewares.Features {
+	o, err := c.SafeGetFeaturesMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetFeaturesMiddleware works like UnscopedSafeGet but only for FeaturesMiddleware.
+// It does not return an interface but a middlewares.Features.
+func (c *Container) UnscopedSafeGetFeaturesMiddleware() (middlewares.Features, error) {
+	return typed.UnscopedGet[middlewares.Features](c, "features-middleware")
+}
+
+// UnscopedGetFeaturesMiddleware is similar to UnscopedSafeGetFeaturesMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetFeaturesMiddleware() middlewares.Features {
+	o, err := c.UnscopedSafeGetFeaturesMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// FeaturesMiddleware is similar to GetFeaturesMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetFeaturesMiddleware method.
+// If the container can not be retrieved, it panics.
+func FeaturesMiddleware(i interface{}) middlewares.Features {
+	return C(i).GetFeaturesMiddleware()
+}
+
+// SafeGetFollowController works like SafeGet but only for FollowController.
+// It does not return an interface but a controllers.FollowController.
+func (c *Container) SafeGetFollowController() (controllers.FollowController, error) {
+	return typed.Get[controllers.FollowController](c, "follow-controller")
+}
+
+// GetFollowController is similar to SafeGetFollowController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetFollowController() controllers.FollowController {
+	o, err := c.SafeGetFollowController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetFollowController works like UnscopedSafeGet but only for FollowController.
+// It does not return an interface but a controllers.FollowController.
+func (c *Container) UnscopedSafeGetFollowController() (controllers.FollowController, error) {
+	return typed.UnscopedGet[controllers.FollowController](c, "follow-controller")
+}
+
+// UnscopedGetFollowController is similar to UnscopedSafeGetFollowController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetFollowController() controllers.FollowController {
+	o, err := c.UnscopedSafeGetFollowController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// FollowController is similar to GetFollowController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetFollowController method.
+// If the container can not be retrieved, it panics.
+func FollowController(i interface{}) controllers.FollowController {
+	return C(i).GetFollowController()
+}
+
+// SafeGetFollowRepository works like SafeGet but only for FollowRepository.
+// It does not return an interface but a repositories.IFollowRepository.
+func (c *Container) SafeGetFollowRepository() (repositories.IFollowRepository, error) {
+	return typed.Get[repositories.IFollowRepository](c, "follow-repository")
+}
+
+// GetFollowRepository is similar to SafeGetFollowRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetFollowRepository() repositories.IFollowRepository {
+	o, err := c.SafeGetFollowRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetFollowRepository works like UnscopedSafeGet but only for FollowRepository.
+// It does not return an interface but a repositories.IFollowRepository.
+func (c *Container) UnscopedSafeGetFollowRepository() (repositories.IFollowRepository, error) {
+	return typed.UnscopedGet[repositories.IFollowRepository](c, "follow-repository")
+}
+
+// UnscopedGetFollowRepository is similar to UnscopedSafeGetFollowRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetFollowRepository() repositories.IFollowRepository {
+	o, err := c.UnscopedSafeGetFollowRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// FollowRepository is similar to GetFollowRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetFollowRepository method.
+// If the container can not be retrieved, it panics.
+func FollowRepository(i interface{}) repositories.IFollowRepository {
+	return C(i).GetFollowRepository()
+}
+
+// SafeGetFollowService works like SafeGet but only for FollowService.
+// It does not return an interface but a services.IFollowService.
+func (c *Container) SafeGetFollowService() (services.IFollowService, error) {
+	return typed.Get[services.IFollowService](c, "follow-service")
+}
+
+// GetFollowService is similar to SafeGetFollowService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetFollowService() services.IFollowService {
+	o, err := c.SafeGetFollowService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetFollowService works like UnscopedSafeGet but only for FollowService.
+// It does not return an interface but a services.IFollowService.
+func (c *Container) UnscopedSafeGetFollowService() (services.IFollowService, error) {
+	return typed.UnscopedGet[services.IFollowService](c, "follow-service")
+}
+
+// UnscopedGetFollowService is similar to UnscopedSafeGetFollowService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetFollowService() services.IFollowService {
+	o, err := c.UnscopedSafeGetFollowService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// FollowService is similar to GetFollowService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetFollowService method.
+// If the container can not be retrieved, it panics.
+func FollowService(i interface{}) services.IFollowService {
+	return C(i).GetFollowService()
+}
+
+// SafeGetGormLogger works like SafeGet but only for GormLogger.
+// It does not return an interface but a logger.Interface.
+func (c *Container) SafeGetGormLogger() (logger.Interface, error) {
+	return typed.Get[logger.Interface](c, "gorm-logger")
+}
+
+// GetGormLogger is similar to SafeGetGormLogger but it does not return the error.
+// Instead it panics.
+func (c *Container) GetGormLogger() logger.Interface {
+	o, err := c.SafeGetGormLogger()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetGormLogger works like UnscopedSafeGet but only for GormLogger.
+// It does not return an interface but a logger.Interface.
+func (c *Container) UnscopedSafeGetGormLogger() (logger.Interface, error) {
+	return typed.UnscopedGet[logger.Interface](c, "gorm-logger")
+}
+
+// UnscopedGetGormLogger is similar to UnscopedSafeGetGormLogger but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetGormLogger() logger.Interface {
+	o, err := c.UnscopedSafeGetGormLogger()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// GormLogger is similar to GetGormLogger.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetGormLogger method.
+// If the container can not be retrieved, it panics.
+func GormLogger(i interface{}) logger.Interface {
+	return C(i).GetGormLogger()
+}
+
+// SafeGetHttpClientFactory works like SafeGet but only for HttpClientFactory.
+// It does not return an interface but a infrastructures.IHttpClientFactory.
+func (c *Container) SafeGetHttpClientFactory() (infrastructures.IHttpClientFactory, error) {
+	return typed.Get[infrastructures.IHttpClientFactory](c, "http-client-factory")
+}
+
+// GetHttpClientFactory is similar to SafeGetHttpClientFactory but it does not return the error.
+// Instead it panics.
+func (c *Container) GetHttpClientFactory() infrastructures.IHttpClientFactory {
+	o, err := c.SafeGetHttpClientFactory()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetHttpClientFactory works like UnscopedSafeGet but only for HttpClientFactory.
+// It does not return an interface but a infrastructures.IHttpClientFactory.
+func (c *Container) UnscopedSafeGetHttpClientFactory() (infrastructures.IHttpClientFactory, error) {
+	return typed.UnscopedGet[infrastructures.IHttpClientFactory](c, "http-client-factory")
+}
+
+// UnscopedGetHttpClientFactory is similar to UnscopedSafeGetHttpClientFactory but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetHttpClientFactory() infrastructures.IHttpClientFactory {
+	o, err := c.UnscopedSafeGetHttpClientFactory()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// HttpClientFactory is similar to GetHttpClientFactory.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetHttpClientFactory method.
+// If the container can not be retrieved, it panics.
+func HttpClientFactory(i interface{}) infrastructures.IHttpClientFactory {
+	return C(i).GetHttpClientFactory()
+}
+
+// SafeGetHub works like SafeGet but only for Hub.
+// It does not return an interface but a infrastructures.IHub.
+func (c *Container) SafeGetHub() (infrastructures.IHub, error) {
+	return typed.Get[infrastructures.IHub](c, "hub")
+}
+
+// GetHub is similar to SafeGetHub but it does not return the error.
+// Instead it panics.
+func (c *Container) GetHub() infrastructures.IHub {
+	o, err := c.SafeGetHub()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetHub works like UnscopedSafeGet but only for Hub.
+// It does not return an interface but a infrastructures.IHub.
+func (c *Container) UnscopedSafeGetHub() (infrastructures.IHub, error) {
+	return typed.UnscopedGet[infrastructures.IHub](c, "hub")
+}
+
+// UnscopedGetHub is similar to UnscopedSafeGetHub but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetHub() infrastructures.IHub {
+	o, err := c.UnscopedSafeGetHub()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Hub is similar to GetHub.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetHub method.
+// If the container can not be retrieved, it panics.
+func Hub(i interface{}) infrastructures.IHub {
+	return C(i).GetHub()
+}
+
+// SafeGetImpersonationController works like SafeGet but only for ImpersonationController.
+// It does not return an interface but a controllers.ImpersonationController.
+func (c *Container) SafeGetImpersonationController() (controllers.ImpersonationController, error) {
+	return typed.Get[controllers.ImpersonationController](c, "impersonation-controller")
+}
+
+// GetImpersonationController is similar to SafeGetImpersonationController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetImpersonationController() controllers.ImpersonationController {
+	o, err := c.SafeGetImpersonationController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetImpersonationController works like UnscopedSafeGet but only for ImpersonationController.
+// It does not return an interface but a controllers.ImpersonationController.
+func (c *Container) UnscopedSafeGetImpersonationController() (controllers.ImpersonationController, error) {
+	return typed.UnscopedGet[controllers.ImpersonationController](c, "impersonation-controller")
+}
+
+// UnscopedGetImpersonationController is similar to UnscopedSafeGetImpersonationController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetImpersonationController() controllers.ImpersonationController {
+	o, err := c.UnscopedSafeGetImpersonationController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ImpersonationController is similar to GetImpersonationController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetImpersonationController method.
+// If the container can not be retrieved, it panics.
+func ImpersonationController(i interface{}) controllers.ImpersonationController {
+	return C(i).GetImpersonationController()
+}
+
+// SafeGetImpersonationService works like SafeGet but only for ImpersonationService.
+// It does not return an interface but a services.IImpersonationService.
+func (c *Container) SafeGetImpersonationService() (services.IImpersonationService, error) {
+	return typed.Get[services.IImpersonationService](c, "impersonation-service")
+}
+
+// GetImpersonationService is similar to SafeGetImpersonationService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetImpersonationService() services.IImpersonationService {
+	o, err := c.SafeGetImpersonationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetImpersonationService works like UnscopedSafeGet but only for ImpersonationService.
+// It does not return an interface but a services.IImpersonationService.
+func (c *Container) UnscopedSafeGetImpersonationService() (services.IImpersonationService, error) {
+	return typed.UnscopedGet[services.IImpersonationService](c, "impersonation-service")
+}
+
+// UnscopedGetImpersonationService is similar to UnscopedSafeGetImpersonationService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetImpersonationService() services.IImpersonationService {
+	o, err := c.UnscopedSafeGetImpersonationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ImpersonationService is similar to GetImpersonationService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetImpersonationService method.
+// If the container can not be retrieved, it panics.
+func ImpersonationService(i interface{}) services.IImpersonationService {
+	return C(i).GetImpersonationService()
+}
+
+// SafeGetInternalController works like SafeGet but only for InternalController.
+// It does not return an interface but a controllers.InternalController.
+func (c *Container) SafeGetInternalController() (controllers.InternalController, error) {
+	return typed.Get[controllers.InternalController](c, "internal-controller")
+}
+
+// GetInternalController is similar to SafeGetInternalController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetInternalController() controllers.InternalController {
+	o, err := c.SafeGetInternalController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetInternalController works like UnscopedSafeGet but only for InternalController.
+// It does not return an interface but a controllers.InternalController.
+func (c *Container) UnscopedSafeGetInternalController() (controllers.InternalController, error) {
+	return typed.UnscopedGet[controllers.InternalController](c, "internal-controller")
+}
+
+// UnscopedGetInternalController is similar to UnscopedSafeGetInternalController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetInternalController() controllers.InternalController {
+	o, err := c.UnscopedSafeGetInternalController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// InternalController is similar to GetInternalController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetInternalController method.
+// If the container can not be retrieved, it panics.
+func InternalController(i interface{}) controllers.InternalController {
+	return C(i).GetInternalController()
+}
+
+// SafeGetInvitationController works like SafeGet but only for InvitationController.
+// It does not return an interface but a controllers.InvitationController.
+func (c *Container) SafeGetInvitationController() (controllers.InvitationController, error) {
+	return typed.Get[controllers.InvitationController](c, "invitation-controller")
+}
+
+// GetInvitationController is similar to SafeGetInvitationController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetInvitationController() controllers.InvitationController {
+	o, err := c.SafeGetInvitationController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetInvitationController works like UnscopedSafeGet but only for InvitationController.
+// It does not return an interface but a controllers.InvitationController.
+func (c *Container) UnscopedSafeGetInvitationController() (controllers.InvitationController, error) {
+	return typed.UnscopedGet[controllers.InvitationController](c, "invitation-controller")
+}
+
+// UnscopedGetInvitationController is similar to UnscopedSafeGetInvitationController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetInvitationController() controllers.InvitationController {
+	o, err := c.UnscopedSafeGetInvitationController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// InvitationController is similar to GetInvitationController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetInvitationController method.
+// If the container can not be retrieved, it panics.
+func InvitationController(i interface{}) controllers.InvitationController {
+	return C(i).GetInvitationController()
+}
+
+// SafeGetInvitationRepository works like SafeGet but only for InvitationRepository.
+// It does not return an interface but a repositories.IInvitationRepository.
+func (c *Container) SafeGetInvitationRepository() (repositories.IInvitationRepository, error) {
+	return typed.Get[repositories.IInvitationRepository](c, "invitation-repository")
+}
+
+// GetInvitationRepository is similar to SafeGetInvitationRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetInvitationRepository() repositories.IInvitationRepository {
+	o, err := c.SafeGetInvitationRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetInvitationRepository works like UnscopedSafeGet but only for InvitationRepository.
+// It does not return an interface but a repositories.IInvitationRepository.
+func (c *Container) UnscopedSafeGetInvitationRepository() (repositories.IInvitationRepository, error) {
+	return typed.UnscopedGet[repositories.IInvitationRepository](c, "invitation-repository")
+}
+
+// UnscopedGetInvitationRepository is similar to UnscopedSafeGetInvitationRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetInvitationRepository() repositories.IInvitationRepository {
+	o, err := c.UnscopedSafeGetInvitationRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// InvitationRepository is similar to GetInvitationRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetInvitationRepository method.
+// If the container can not be retrieved, it panics.
+func InvitationRepository(i interface{}) repositories.IInvitationRepository {
+	return C(i).GetInvitationRepository()
+}
+
+// SafeGetInvitationService works like SafeGet but only for InvitationService.
+// It does not return an interface but a services.IInvitationService.
+func (c *Container) SafeGetInvitationService() (services.IInvitationService, error) {
+	return typed.Get[services.IInvitationService](c, "invitation-service")
+}
+
+// GetInvitationService is similar to SafeGetInvitationService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetInvitationService() services.IInvitationService {
+	o, err := c.SafeGetInvitationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetInvitationService works like UnscopedSafeGet but only for InvitationService.
+// It does not return an interface but a services.IInvitationService.
+func (c *Container) UnscopedSafeGetInvitationService() (services.IInvitationService, error) {
+	return typed.UnscopedGet[services.IInvitationService](c, "invitation-service")
+}
+
+// UnscopedGetInvitationService is similar to UnscopedSafeGetInvitationService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetInvitationService() services.IInvitationService {
+	o, err := c.UnscopedSafeGetInvitationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// InvitationService is similar to GetInvitationService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetInvitationService method.
+// If the container can not be retrieved, it panics.
+func InvitationService(i interface{}) services.IInvitationService {
+	return C(i).GetInvitationService()
+}
+
+// SafeGetInvoiceService works like SafeGet but only for InvoiceService.
+// It does not return an interface but a services.IInvoiceService.
+func (c *Container) SafeGetInvoiceService() (services.IInvoiceService, error) {
+	return typed.Get[services.IInvoiceService](c, "invoice-service")
+}
+
+// GetInvoiceService is similar to SafeGetInvoiceService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetInvoiceService() services.IInvoiceService {
+	o, err := c.SafeGetInvoiceService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetInvoiceService works like UnscopedSafeGet but only for InvoiceService.
+// It does not return an interface but a services.IInvoiceService.
+func (c *Container) UnscopedSafeGetInvoiceService() (services.IInvoiceService, error) {
+	return typed.UnscopedGet[services.IInvoiceService](c, "invoice-service")
+}
+
+// UnscopedGetInvoiceService is similar to UnscopedSafeGetInvoiceService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetInvoiceService() services.IInvoiceService {
+	o, err := c.UnscopedSafeGetInvoiceService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// InvoiceService is similar to GetInvoiceService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetInvoiceService method.
+// If the container can not be retrieved, it panics.
+func InvoiceService(i interface{}) services.IInvoiceService {
+	return C(i).GetInvoiceService()
+}
+
+// SafeGetIsAdminMiddleware works like SafeGet but only for IsAdminMiddleware.
+// It does not return an interface but a middlewares.IsAdmin.
+func (c *Container) SafeGetIsAdminMiddleware() (middlewares.IsAdmin, error) {
+	return typed.Get[middlewares.IsAdmin](c, "is-admin-middleware")
+}
+
+// GetIsAdminMiddleware is similar to SafeGetIsAdminMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetIsAdminMiddleware() middlewares.IsAdmin {
+	o, err := c.SafeGetIsAdminMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetIsAdminMiddleware works like UnscopedSafeGet but only for IsAdminMiddleware.
+// It does not return an interface but a middlewares.IsAdmin.
+func (c *Container) UnscopedSafeGetIsAdminMiddleware() (middlewares.IsAdmin, error) {
+	return typed.UnscopedGet[middlewares.IsAdmin](c, "is-admin-middleware")
+}
+
+// UnscopedGetIsAdminMiddleware is similar to UnscopedSafeGetIsAdminMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetIsAdminMiddleware() middlewares.IsAdmin {
+	o, err := c.UnscopedSafeGetIsAdminMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// IsAdminMiddleware is similar to GetIsAdminMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetIsAdminMiddleware method.
+// If the container can not be retrieved, it panics.
+func IsAdminMiddleware(i interface{}) middlewares.IsAdmin {
+	return C(i).GetIsAdminMiddleware()
+}
+
+// SafeGetIsVerifiedMiddleware works like SafeGet but only for IsVerifiedMiddleware.
+// It does not return an interface but a middlewares.IsVerified.
+func (c *Container) SafeGetIsVerifiedMiddleware() (middlewares.IsVerified, error) {
+	return typed.Get[middlewares.IsVerified](c, "is-verified-middleware")
+}
+
+// GetIsVerifiedMiddleware is similar to SafeGetIsVerifiedMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetIsVerifiedMiddleware() middlewares.IsVerified {
+	o, err := c.SafeGetIsVerifiedMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetIsVerifiedMiddleware works like UnscopedSafeGet but only for IsVerifiedMiddleware.
+// It does not return an interface but a middlewares.IsVerified.
+func (c *Container) UnscopedSafeGetIsVerifiedMiddleware() (middlewares.IsVerified, error) {
+	return typed.UnscopedGet[middlewares.IsVerified](c, "is-verified-middleware")
+}
+
+// UnscopedGetIsVerifiedMiddleware is similar to UnscopedSafeGetIsVerifiedMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetIsVerifiedMiddleware() middlewares.IsVerified {
+	o, err := c.UnscopedSafeGetIsVerifiedMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// IsVerifiedMiddleware is similar to GetIsVerifiedMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetIsVerifiedMiddleware method.
+// If the container can not be retrieved, it panics.
+func IsVerifiedMiddleware(i interface{}) middlewares.IsVerified {
+	return C(i).GetIsVerifiedMiddleware()
+}
+
+// SafeGetLogLevels works like SafeGet but only for LogLevels.
+// It does not return an interface but a *infrastructures.LogLevels.
+func (c *Container) SafeGetLogLevels() (*infrastructures.LogLevels, error) {
+	return typed.Get[*infrastructures.LogLevels](c, "log-levels")
+}
+
+// GetLogLevels is similar to SafeGetLogLevels but it does not return the error.
+// Instead it panics.
+func (c *Container) GetLogLevels() *infrastructures.LogLevels {
+	o, err := c.SafeGetLogLevels()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetLogLevels works like UnscopedSafeGet but only for LogLevels.
+// It does not return an interface but a *infrastructures.LogLevels.
+func (c *Container) UnscopedSafeGetLogLevels() (*infrastructures.LogLevels, error) {
+	return typed.UnscopedGet[*infrastructures.LogLevels](c, "log-levels")
+}
+
+// UnscopedGetLogLevels is similar to UnscopedSafeGetLogLevels but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetLogLevels() *infrastructures.LogLevels {
+	o, err := c.UnscopedSafeGetLogLevels()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// LogLevels is similar to GetLogLevels.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetLogLevels method.
+// If the container can not be retrieved, it panics.
+func LogLevels(i interface{}) *infrastructures.LogLevels {
+	return C(i).GetLogLevels()
+}
+
+// SafeGetLogger works like SafeGet but only for Logger.
+// It does not return an interface but a infrastructures.ILogger.
+func (c *Container) SafeGetLogger() (infrastructures.ILogger, error) {
+	return typed.Get[infrastructures.ILogger](c, "logger")
+}
+
+// GetLogger is similar to SafeGetLogger but it does not return the error.
+// Instead it panics.
+func (c *Container) GetLogger() infrastructures.ILogger {
+	o, err := c.SafeGetLogger()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetLogger works like UnscopedSafeGet but only for Logger.
+// It does not return an interface but a infrastructures.ILogger.
+func (c *Container) UnscopedSafeGetLogger() (infrastructures.ILogger, error) {
+	return typed.UnscopedGet[infrastructures.ILogger](c, "logger")
+}
+
+// UnscopedGetLogger is similar to UnscopedSafeGetLogger but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetLogger() infrastructures.ILogger {
+	o, err := c.UnscopedSafeGetLogger()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Logger is similar to GetLogger.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetLogger method.
+// If the container can not be retrieved, it panics.
+func Logger(i interface{}) infrastructures.ILogger {
+	return C(i).GetLogger()
+}
+
+// SafeGetLoggingController works like SafeGet but only for LoggingController.
+// It does not return an interface but a controllers.LoggingController.
+func (c *Container) SafeGetLoggingController() (controllers.LoggingController, error) {
+	return typed.Get[controllers.LoggingController](c, "logging-controller")
+}
+
+// GetLoggingController is similar to SafeGetLoggingController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetLoggingController() controllers.LoggingController {
+	o, err := c.SafeGetLoggingController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetLoggingController works like UnscopedSafeGet but only for LoggingController.
+// It does not return an interface but a controllers.LoggingController.
+func (c *Container) UnscopedSafeGetLoggingController() (controllers.LoggingController, error) {
+	return typed.UnscopedGet[controllers.LoggingController](c, "logging-controller")
+}
+
+// UnscopedGetLoggingController is similar to UnscopedSafeGetLoggingController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetLoggingController() controllers.LoggingController {
+	o, err := c.UnscopedSafeGetLoggingController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// LoggingController is similar to GetLoggingController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetLoggingController method.
+// If the container can not be retrieved, it panics.
+func LoggingController(i interface{}) controllers.LoggingController {
+	return C(i).GetLoggingController()
+}
+
+// SafeGetMagicLinkMail works like SafeGet but only for MagicLinkMail.
+// It does not return an interface but a mails.IMailRenderer.
+func (c *Container) SafeGetMagicLinkMail() (mails.IMailRenderer, error) {
+	return typed.Get[mails.IMailRenderer](c, "magic-link-mail")
+}
+
+// GetMagicLinkMail is similar to SafeGetMagicLinkMail but it does not return the error.
+// Instead it panics.
+func (c *Container) GetMagicLinkMail() mails.IMailRenderer {
+	o, err := c.SafeGetMagicLinkMail()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetMagicLinkMail works like UnscopedSafeGet but only for MagicLinkMail.
+// It does not return an interface but a mails.IMailRenderer.
+func (c *Container) UnscopedSafeGetMagicLinkMail() (mails.IMailRenderer, error) {
+	return typed.UnscopedGet[mails.IMailRenderer](c, "magic-link-mail")
+}
+
+// UnscopedGetMagicLinkMail is similar to UnscopedSafeGetMagicLinkMail but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetMagicLinkMail() mails.IMailRenderer {
+	o, err := c.UnscopedSafeGetMagicLinkMail()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// MagicLinkMail is similar to GetMagicLinkMail.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetMagicLinkMail method.
+// If the container can not be retrieved, it panics.
+func MagicLinkMail(i interface{}) mails.IMailRenderer {
+	return C(i).GetMagicLinkMail()
+}
+
+// SafeGetMagicLinkRepository works like SafeGet but only for MagicLinkRepository.
+// It does not return an interface but a repositories.IMagicLinkRepository.
+func (c *Container) SafeGetMagicLinkRepository() (repositories.IMagicLinkRepository, error) {
+	return typed.Get[repositories.IMagicLinkRepository](c, "magic-link-repository")
+}
+
+// GetMagicLinkRepository is similar to SafeGetMagicLinkRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetMagicLinkRepository() repositories.IMagicLinkRepository {
+	o, err := c.SafeGetMagicLinkRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetMagicLinkRepository works like UnscopedSafeGet but only for MagicLinkRepository.
+// It does not return an interface but a repositories.IMagicLinkRepository.
+func (c *Container) UnscopedSafeGetMagicLinkRepository() (repositories.IMagicLinkRepository, error) {
+	return typed.UnscopedGet[repositories.IMagicLinkRepository](c, "magic-link-repository")
+}
+
+// UnscopedGetMagicLinkRepository is similar to UnscopedSafeGetMagicLinkRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetMagicLinkRepository() repositories.IMagicLinkRepository {
+	o, err := c.UnscopedSafeGetMagicLinkRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// MagicLinkRepository is similar to GetMagicLinkRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetMagicLinkRepository method.
+// If the container can not be retrieved, it panics.
+func MagicLinkRepository(i interface{}) repositories.IMagicLinkRepository {
+	return C(i).GetMagicLinkRepository()
+}
+
+// SafeGetMagicLinkService works like SafeGet but only for MagicLinkService.
+// It does not return an interface but a services.IMagicLinkService.
+func (c *Container) SafeGetMagicLinkService() (services.IMagicLinkService, error) {
+	return typed.Get[services.IMagicLinkService](c, "magic-link-service")
+}
+
+// GetMagicLinkService is similar to SafeGetMagicLinkService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetMagicLinkService() services.IMagicLinkService {
+	o, err := c.SafeGetMagicLinkService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetMagicLinkService works like UnscopedSafeGet but only for MagicLinkService.
+// It does not return an interface but a services.IMagicLinkService.
+func (c *Container) UnscopedSafeGetMagicLinkService() (services.IMagicLinkService, error) {
+	return typed.UnscopedGet[services.IMagicLinkService](c, "magic-link-service")
+}
+
+// UnscopedGetMagicLinkService is similar to UnscopedSafeGetMagicLinkService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetMagicLinkService() services.IMagicLinkService {
+	o, err := c.UnscopedSafeGetMagicLinkService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// MagicLinkService is similar to GetMagicLinkService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetMagicLinkService method.
+// If the container can not be retrieved, it panics.
+func MagicLinkService(i interface{}) services.IMagicLinkService {
+	return C(i).GetMagicLinkService()
+}
+
+// SafeGetMediaController works like SafeGet but only for MediaController.
+// It does not return an interface but a controllers.MediaController.
+func (c *Container) SafeGetMediaController() (controllers.MediaController, error) {
+	return typed.Get[controllers.MediaController](c, "media-controller")
+}
+
+// GetMediaController is similar to SafeGetMediaController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetMediaController() controllers.MediaController {
+	o, err := c.SafeGetMediaController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetMediaController works like UnscopedSafeGet but only for MediaController.
+// It does not return an interface but a controllers.MediaController.
+func (c *Container) UnscopedSafeGetMediaController() (controllers.MediaController, error) {
+	return typed.UnscopedGet[controllers.MediaController](c, "media-controller")
+}
+
+// UnscopedGetMediaController is similar to UnscopedSafeGetMediaController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetMediaController() controllers.MediaController {
+	o, err := c.UnscopedSafeGetMediaController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// MediaController is similar to GetMediaController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetMediaController method.
+// If the container can not be retrieved, it panics.
+func MediaController(i interface{}) controllers.MediaController {
+	return C(i).GetMediaController()
+}
+
+// SafeGetMediaRepository works like SafeGet but only for MediaRepository.
+// It does not return an interface but a repositories.IMediaRepository.
+func (c *Container) SafeGetMediaRepository() (repositories.IMediaRepository, error) {
+	return typed.Get[repositories.IMediaRepository](c, "media-repository")
+}
+
+// GetMediaRepository is similar to SafeGetMediaRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetMediaRepository() repositories.IMediaRepository {
+	o, err := c.SafeGetMediaRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetMediaRepository works like UnscopedSafeGet but only for MediaRepository.
+// It does not return an interface but a repositories.IMediaRepository.
+func (c *Container) UnscopedSafeGetMediaRepository() (repositories.IMediaRepository, error) {
+	return typed.UnscopedGet[repositories.IMediaRepository](c, "media-repository")
+}
+
+// UnscopedGetMediaRepository is similar to UnscopedSafeGetMediaRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetMediaRepository() repositories.IMediaRepository {
+	o, err := c.UnscopedSafeGetMediaRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// MediaRepository is similar to GetMediaRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetMediaRepository method.
+// If the container can not be retrieved, it panics.
+func MediaRepository(i interface{}) repositories.IMediaRepository {
+	return C(i).GetMediaRepository()
+}
+
+// SafeGetMediaService works like SafeGet but only for MediaService.
+// It does not return an interface but a services.IMediaService.
+func (c *Container) SafeGetMediaService() (services.IMediaService, error) {
+	return typed.Get[services.IMediaService](c, "media-service")
+}
+
+// GetMediaService is similar to SafeGetMediaService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetMediaService() services.IMediaService {
+	o, err := c.SafeGetMediaService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetMediaService works like UnscopedSafeGet but only for MediaService.
+// It does not return an interface but a services.IMediaService.
+func (c *Container) UnscopedSafeGetMediaService() (services.IMediaService, error) {
+	return typed.UnscopedGet[services.IMediaService](c, "media-service")
+}
+
+// UnscopedGetMediaService is similar to UnscopedSafeGetMediaService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetMediaService() services.IMediaService {
+	o, err := c.UnscopedSafeGetMediaService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// MediaService is similar to GetMediaService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetMediaService method.
+// If the container can not be retrieved, it panics.
+func MediaService(i interface{}) services.IMediaService {
+	return C(i).GetMediaService()
+}
+
+// SafeGetMetrics works like SafeGet but only for Metrics.
+// It does not return an interface but a infrastructures.IMetrics.
+func (c *Container) SafeGetMetrics() (infrastructures.IMetrics, error) {
+	return typed.Get[infrastructures.IMetrics](c, "metrics")
+}
+
+// GetMetrics is similar to SafeGetMetrics but it does not return the error.
+// Instead it panics.
+func (c *Container) GetMetrics() infrastructures.IMetrics {
+	o, err := c.SafeGetMetrics()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetMetrics works like UnscopedSafeGet but only for Metrics.
+// It does not return an interface but a infrastructures.IMetrics.
+func (c *Container) UnscopedSafeGetMetrics() (infrastructures.IMetrics, error) {
+	return typed.UnscopedGet[infrastructures.IMetrics](c, "metrics")
+}
+
+// UnscopedGetMetrics is similar to UnscopedSafeGetMetrics but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetMetrics() infrastructures.IMetrics {
+	o, err := c.UnscopedSafeGetMetrics()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Metrics is similar to GetMetrics.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetMetrics method.
+// If the container can not be retrieved, it panics.
+func Metrics(i interface{}) infrastructures.IMetrics {
+	return C(i).GetMetrics()
+}
+
+// SafeGetMetricsController works like SafeGet but only for MetricsController.
+// It does not return an interface but a controllers.MetricsController.
+func (c *Container) SafeGetMetricsController() (controllers.MetricsController, error) {
+	return typed.Get[controllers.MetricsController](c, "metrics-controller")
+}
+
+// GetMetricsController is similar to SafeGetMetricsController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetMetricsController() controllers.MetricsController {
+	o, err := c.SafeGetMetricsController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetMetricsController works like UnscopedSafeGet but only for MetricsController.
+// It does not return an interface but a controllers.MetricsController.
+func (c *Container) UnscopedSafeGetMetricsController() (controllers.MetricsController, error) {
+	return typed.UnscopedGet[controllers.MetricsController](c, "metrics-controller")
+}
+
+// UnscopedGetMetricsController is similar to UnscopedSafeGetMetricsController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetMetricsController() controllers.MetricsController {
+	o, err := c.UnscopedSafeGetMetricsController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// MetricsController is similar to GetMetricsController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetMetricsController method.
+// If the container can not be retrieved, it panics.
+func MetricsController(i interface{}) controllers.MetricsController {
+	return C(i).GetMetricsController()
+}
+
+// SafeGetNPlusOneDetector works like SafeGet but only for NPlusOneDetector.
+// It does not return an interface but a gorm.Plugin.
+func (c *Container) SafeGetNPlusOneDetector() (gorm.Plugin, error) {
+	return typed.Get[gorm.Plugin](c, "n-plus-one-detector")
+}
+
+// GetNPlusOneDetector is similar to SafeGetNPlusOneDetector but it does not return the error.
+// Instead it panics.
+func (c *Container) GetNPlusOneDetector() gorm.Plugin {
+	o, err := c.SafeGetNPlusOneDetector()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetNPlusOneDetector works like UnscopedSafeGet but only for NPlusOneDetector.
+// It does not return an interface but a gorm.Plugin.
+func (c *Container) UnscopedSafeGetNPlusOneDetector() (gorm.Plugin, error) {
+	return typed.UnscopedGet[gorm.Plugin](c, "n-plus-one-detector")
+}
+
+// UnscopedGetNPlusOneDetector is similar to UnscopedSafeGetNPlusOneDetector but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetNPlusOneDetector() gorm.Plugin {
+	o, err := c.UnscopedSafeGetNPlusOneDetector()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// NPlusOneDetector is similar to GetNPlusOneDetector.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetNPlusOneDetector method.
+// If the container can not be retrieved, it panics.
+func NPlusOneDetector(i interface{}) gorm.Plugin {
+	return C(i).GetNPlusOneDetector()
+}
+
+// SafeGetNotificationController works like SafeGet but only for NotificationController.
+// It does not return an interface but a controllers.NotificationController.
+func (c *Container) SafeGetNotificationController() (controllers.NotificationController, error) {
+	return typed.Get[controllers.NotificationController](c, "notification-controller")
+}
+
+// GetNotificationController is similar to SafeGetNotificationController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetNotificationController() controllers.NotificationController {
+	o, err := c.SafeGetNotificationController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetNotificationController works like UnscopedSafeGet but only for NotificationController.
+// It does not return an interface but a controllers.NotificationController.
+func (c *Container) UnscopedSafeGetNotificationController() (controllers.NotificationController, error) {
+	return typed.UnscopedGet[controllers.NotificationController](c, "notification-controller")
+}
+
+// UnscopedGetNotificationController is similar to UnscopedSafeGetNotificationController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetNotificationController() controllers.NotificationController {
+	o, err := c.UnscopedSafeGetNotificationController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// NotificationController is similar to GetNotificationController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetNotificationController method.
+// If the container can not be retrieved, it panics.
+func NotificationController(i interface{}) controllers.NotificationController {
+	return C(i).GetNotificationController()
+}
+
+// SafeGetNotificationService works like SafeGet but only for NotificationService.
+// It does not return an interface but a services.INotificationService.
+func (c *Container) SafeGetNotificationService() (services.INotificationService, error) {
+	return typed.Get[services.INotificationService](c, "notification-service")
+}
+
+// GetNotificationService is similar to SafeGetNotificationService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetNotificationService() services.INotificationService {
+	o, err := c.SafeGetNotificationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetNotificationService works like UnscopedSafeGet but only for NotificationService.
+// It does not return an interface but a services.INotificationService.
+func (c *Container) UnscopedSafeGetNotificationService() (services.INotificationService, error) {
+	return typed.UnscopedGet[services.INotificationService](c, "notification-service")
+}
+
+// UnscopedGetNotificationService is similar to UnscopedSafeGetNotificationService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetNotificationService() services.INotificationService {
+	o, err := c.UnscopedSafeGetNotificationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// NotificationService is similar to GetNotificationService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetNotificationService method.
+// If the container can not be retrieved, it panics.
+func NotificationService(i interface{}) services.INotificationService {
+	return C(i).GetNotificationService()
+}
+
+// SafeGetPageSizesMiddleware works like SafeGet but only for PageSizesMiddleware.
+// It does not return an interface but a middlewares.PageSizes.
+func (c *Container) SafeGetPageSizesMiddleware() (middlewares.PageSizes, error) {
+	return typed.Get[middlewares.PageSizes](c, "page-sizes-middleware")
+}
+
+// GetPageSizesMiddleware is similar to SafeGetPageSizesMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetPageSizesMiddleware() middlewares.PageSizes {
+	o, err := c.SafeGetPageSizesMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetPageSizesMiddleware works like UnscopedSafeGet but only for PageSizesMiddleware.
+// It does not return an interface but a middlewares.PageSizes.
+func (c *Container) UnscopedSafeGetPageSizesMiddleware() (middlewares.PageSizes, error) {
+	return typed.UnscopedGet[middlewares.PageSizes](c, "page-sizes-middleware")
+}
+
+// UnscopedGetPageSizesMiddleware is similar to UnscopedSafeGetPageSizesMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetPageSizesMiddleware() middlewares.PageSizes {
+	o, err := c.UnscopedSafeGetPageSizesMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// PageSizesMiddleware is similar to GetPageSizesMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetPageSizesMiddleware method.
+// If the container can not be retrieved, it panics.
+func PageSizesMiddleware(i interface{}) middlewares.PageSizes {
+	return C(i).GetPageSizesMiddleware()
+}
+
+// SafeGetPaymentGateway works like SafeGet but only for PaymentGateway.
+// It does not return an interface but a infrastructures.IPaymentGateway.
+func (c *Container) SafeGetPaymentGateway() (infrastructures.IPaymentGateway, error) {
+	return typed.Get[infrastructures.IPaymentGateway](c, "payment-gateway")
+}
+
+// GetPaymentGateway is similar to SafeGetPaymentGateway but it does not return the error.
+// Instead it panics.
+func (c *Container) GetPaymentGateway() infrastructures.IPaymentGateway {
+	o, err := c.SafeGetPaymentGateway()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetPaymentGateway works like UnscopedSafeGet but only for PaymentGateway.
+// It does not return an interface but a infrastructures.IPaymentGateway.
+func (c *Container) UnscopedSafeGetPaymentGateway() (infrastructures.IPaymentGateway, error) {
+	return typed.UnscopedGet[infrastructures.IPaymentGateway](c, "payment-gateway")
+}
+
+// UnscopedGetPaymentGateway is similar to UnscopedSafeGetPaymentGateway but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetPaymentGateway() infrastructures.IPaymentGateway {
+	o, err := c.UnscopedSafeGetPaymentGateway()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// PaymentGateway is similar to GetPaymentGateway.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetPaymentGateway method.
+// If the container can not be retrieved, it panics.
+func PaymentGateway(i interface{}) infrastructures.IPaymentGateway {
+	return C(i).GetPaymentGateway()
+}
+
+// SafeGetPdf works like SafeGet but only for Pdf.
+// It does not return an interface but a infrastructures.IPdfService.
+func (c *Container) SafeGetPdf() (infrastructures.IPdfService, error) {
+	return typed.Get[infrastructures.IPdfService](c, "pdf")
+}
+
+// GetPdf is similar to SafeGetPdf but it does not return the error.
+// Instead it panics.
+func (c *Container) GetPdf() infrastructures.IPdfService {
+	o, err := c.SafeGetPdf()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetPdf works like UnscopedSafeGet but only for Pdf.
+// It does not return an interface but a infrastructures.IPdfService.
+func (c *Container) UnscopedSafeGetPdf() (infrastructures.IPdfService, error) {
+	return typed.UnscopedGet[infrastructures.IPdfService](c, "pdf")
+}
+
+// UnscopedGetPdf is similar to UnscopedSafeGetPdf but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetPdf() infrastructures.IPdfService {
+	o, err := c.UnscopedSafeGetPdf()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Pdf is similar to GetPdf.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetPdf method.
+// If the container can not be retrieved, it panics.
+func Pdf(i interface{}) infrastructures.IPdfService {
+	return C(i).GetPdf()
+}
+
+// SafeGetPhoneController works like SafeGet but only for PhoneController.
+// It does not return an interface but a controllers.PhoneController.
+func (c *Container) SafeGetPhoneController() (controllers.PhoneController, error) {
+	return typed.Get[controllers.PhoneController](c, "phone-controller")
+}
+
+// GetPhoneController is similar to SafeGetPhoneController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetPhoneController() controllers.PhoneController {
+	o, err := c.SafeGetPhoneController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetPhoneController works like UnscopedSafeGet but only for PhoneController.
+// It does not return an interface but a controllers.PhoneController.
+func (c *Container) UnscopedSafeGetPhoneController() (controllers.PhoneController, error) {
+	return typed.UnscopedGet[controllers.PhoneController](c, "phone-controller")
+}
+
+// UnscopedGetPhoneController is similar to UnscopedSafeGetPhoneController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetPhoneController() controllers.PhoneController {
+	o, err := c.UnscopedSafeGetPhoneController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// PhoneController is similar to GetPhoneController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetPhoneController method.
+// If the container can not be retrieved, it panics.
+func PhoneController(i interface{}) controllers.PhoneController {
+	return C(i).GetPhoneController()
+}
+
+// SafeGetPhoneService works like SafeGet but only for PhoneService.
+// It does not return an interface but a services.IPhoneService.
+func (c *Container) SafeGetPhoneService() (services.IPhoneService, error) {
+	return typed.Get[services.IPhoneService](c, "phone-service")
+}
+
+// GetPhoneService is similar to SafeGetPhoneService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetPhoneService() services.IPhoneService {
+	o, err := c.SafeGetPhoneService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetPhoneService works like UnscopedSafeGet but only for PhoneService.
+// It does not return an interface but a services.IPhoneService.
+func (c *Container) UnscopedSafeGetPhoneService() (services.IPhoneService, error) {
+	return typed.UnscopedGet[services.IPhoneService](c, "phone-service")
+}
+
+// UnscopedGetPhoneService is similar to UnscopedSafeGetPhoneService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetPhoneService() services.IPhoneService {
+	o, err := c.UnscopedSafeGetPhoneService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// PhoneService is similar to GetPhoneService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetPhoneService method.
+// If the container can not be retrieved, it panics.
+func PhoneService(i interface{}) services.IPhoneService {
+	return C(i).GetPhoneService()
+}
+
+// SafeGetPolicyRepository works like SafeGet but only for PolicyRepository.
+// It does not return an interface but a repositories.IPolicyRepository.
+func (c *Container) SafeGetPolicyRepository() (repositories.IPolicyRepository, error) {
+	return typed.Get[repositories.IPolicyRepository](c, "policy-repository")
+}
+
+// GetPolicyRepository is similar to SafeGetPolicyRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetPolicyRepository() repositories.IPolicyRepository {
+	o, err := c.SafeGetPolicyRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetPolicyRepository works like UnscopedSafeGet but only for PolicyRepository.
+// It does not return an interface but a repositories.IPolicyRepository.
+func (c *Container) UnscopedSafeGetPolicyRepository() (repositories.IPolicyRepository, error) {
+	return typed.UnscopedGet[repositories.IPolicyRepository](c, "policy-repository")
+}
+
+// UnscopedGetPolicyRepository is similar to UnscopedSafeGetPolicyRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetPolicyRepository() repositories.IPolicyRepository {
+	o, err := c.UnscopedSafeGetPolicyRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// PolicyRepository is similar to GetPolicyRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetPolicyRepository method.
+// If the container can not be retrieved, it panics.
+func PolicyRepository(i interface{}) repositories.IPolicyRepository {
+	return C(i).GetPolicyRepository()
+}
+
+// SafeGetPrivacyService works like SafeGet but only for PrivacyService.
+// It does not return an interface but a services.IPrivacyService.
+func (c *Container) SafeGetPrivacyService() (services.IPrivacyService, error) {
+	return typed.Get[services.IPrivacyService](c, "privacy-service")
+}
+
+// GetPrivacyService is similar to SafeGetPrivacyService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetPrivacyService() services.IPrivacyService {
+	o, err := c.SafeGetPrivacyService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetPrivacyService works like UnscopedSafeGet but only for PrivacyService.
+// It does not return an interface but a services.IPrivacyService.
+func (c *Container) UnscopedSafeGetPrivacyService() (services.IPrivacyService, error) {
+	return typed.UnscopedGet[services.IPrivacyService](c, "privacy-service")
+}
+
+// UnscopedGetPrivacyService is similar to UnscopedSafeGetPrivacyService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetPrivacyService() services.IPrivacyService {
+	o, err := c.UnscopedSafeGetPrivacyService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// PrivacyService is similar to GetPrivacyService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetPrivacyService method.
+// If the container can not be retrieved, it panics.
+func PrivacyService(i interface{}) services.IPrivacyService {
+	return C(i).GetPrivacyService()
+}
+
+// SafeGetPublishingService works like SafeGet but only for PublishingService.
+// It does not return an interface but a services.IPublishingService.
+func (c *Container) SafeGetPublishingService() (services.IPublishingService, error) {
+	return typed.Get[services.IPublishingService](c, "publishing-service")
+}
+
+// GetPublishingService is similar to SafeGetPublishingService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetPublishingService() services.IPublishingService {
+	o, err := c.SafeGetPublishingService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetPublishingService works like UnscopedSafeGet but only for PublishingService.
+// It does not return an interface but a services.IPublishingService.
+func (c *Container) UnscopedSafeGetPublishingService() (services.IPublishingService, error) {
+	return typed.UnscopedGet[services.IPublishingService](c, "publishing-service")
+}
+
+// UnscopedGetPublishingService is similar to UnscopedSafeGetPublishingService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetPublishingService() services.IPublishingService {
+	o, err := c.UnscopedSafeGetPublishingService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// PublishingService is similar to GetPublishingService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetPublishingService method.
+// If the container can not be retrieved, it panics.
+func PublishingService(i interface{}) services.IPublishingService {
+	return C(i).GetPublishingService()
+}
+
+// SafeGetPush works like SafeGet but only for Push.
+// It does not return an interface but a infrastructures.IPushService.
+func (c *Container) SafeGetPush() (infrastructures.IPushService, error) {
+	return typed.Get[infrastructures.IPushService](c, "push")
+}
+
+// GetPush is similar to SafeGetPush but it does not return the error.
+// Instead it panics.
+func (c *Container) GetPush() infrastructures.IPushService {
+	o, err := c.SafeGetPush()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetPush works like UnscopedSafeGet but only for Push.
+// It does not return an interface but a infrastructures.IPushService.
+func (c *Container) UnscopedSafeGetPush() (infrastructures.IPushService, error) {
+	return typed.UnscopedGet[infrastructures.IPushService](c, "push")
+}
+
+// UnscopedGetPush is similar to UnscopedSafeGetPush but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetPush() infrastructures.IPushService {
+	o, err := c.UnscopedSafeGetPush()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Push is similar to GetPush.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetPush method.
+// If the container can not be retrieved, it panics.
+func Push(i interface{}) infrastructures.IPushService {
+	return C(i).GetPush()
+}
+
+// SafeGetQuotaMiddleware works like SafeGet but only for QuotaMiddleware.
+// It does not return an interface but a middlewares.Quota.
+func (c *Container) SafeGetQuotaMiddleware() (middlewares.Quota, error) {
+	return typed.Get[middlewares.Quota](c, "quota-middleware")
+}
+
+// GetQuotaMiddleware is similar to SafeGetQuotaMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetQuotaMiddleware() middlewares.Quota {
+	o, err := c.SafeGetQuotaMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetQuotaMiddleware works like UnscopedSafeGet but only for QuotaMiddleware.
+// It does not return an interface but a middlewares.Quota.
+func (c *Container) UnscopedSafeGetQuotaMiddleware() (middlewares.Quota, error) {
+	return typed.UnscopedGet[middlewares.Quota](c, "quota-middleware")
+}
+
+// UnscopedGetQuotaMiddleware is similar to UnscopedSafeGetQuotaMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetQuotaMiddleware() middlewares.Quota {
+	o, err := c.UnscopedSafeGetQuotaMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// QuotaMiddleware is similar to GetQuotaMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetQuotaMiddleware method.
+// If the container can not be retrieved, it panics.
+func QuotaMiddleware(i interface{}) middlewares.Quota {
+	return C(i).GetQuotaMiddleware()
+}
+
+// SafeGetQuotaService works like SafeGet but only for QuotaService.
+// It does not return an interface but a services.IQuotaService.
+func (c *Container) SafeGetQuotaService() (services.IQuotaService, error) {
+	return typed.Get[services.IQuotaService](c, "quota-service")
+}
+
+// GetQuotaService is similar to SafeGetQuotaService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetQuotaService() services.IQuotaService {
+	o, err := c.SafeGetQuotaService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetQuotaService works like UnscopedSafeGet but only for QuotaService.
+// It does not return an interface but a services.IQuotaService.
+func (c *Container) UnscopedSafeGetQuotaService() (services.IQuotaService, error) {
+	return typed.UnscopedGet[services.IQuotaService](c, "quota-service")
+}
+
+// UnscopedGetQuotaService is similar to UnscopedSafeGetQuotaService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetQuotaService() services.IQuotaService {
+	o, err := c.UnscopedSafeGetQuotaService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// QuotaService is similar to GetQuotaService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetQuotaService method.
+// If the container can not be retrieved, it panics.
+func QuotaService(i interface{}) services.IQuotaService {
+	return C(i).GetQuotaService()
+}
+
+// SafeGetRandom works like SafeGet but only for Random.
+// It does not return an interface but a infrastructures.IRandom.
+func (c *Container) SafeGetRandom() (infrastructures.IRandom, error) {
+	return typed.Get[infrastructures.IRandom](c, "random")
+}
+
+// GetRandom is similar to SafeGetRandom but it does not return the error.
+// Instead it panics.
+func (c *Container) GetRandom() infrastructures.IRandom {
+	o, err := c.SafeGetRandom()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetRandom works like UnscopedSafeGet but only for Random.
+// It does not return an interface but a infrastructures.IRandom.
+func (c *Container) UnscopedSafeGetRandom() (infrastructures.IRandom, error) {
+	return typed.UnscopedGet[infrastructures.IRandom](c, "random")
+}
+
+// UnscopedGetRandom is similar to UnscopedSafeGetRandom but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetRandom() infrastructures.IRandom {
+	o, err := c.UnscopedSafeGetRandom()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Random is similar to GetRandom.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetRandom method.
+// If the container can not be retrieved, it panics.
+func Random(i interface{}) infrastructures.IRandom {
+	return C(i).GetRandom()
+}
+
+// SafeGetRecorderMiddleware works like SafeGet but only for RecorderMiddleware.
+// It does not return an interface but a *middlewares.Recorder.
+func (c *Container) SafeGetRecorderMiddleware() (*middlewares.Recorder, error) {
+	return typed.Get[*middlewares.Recorder](c, "recorder-middleware")
+}
+
+// GetRecorderMiddleware is similar to SafeGetRecorderMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetRecorderMiddleware() *middlewares.Recorder {
+	o, err := c.SafeGetRecorderMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetRecorderMiddleware works like UnscopedSafeGet but only for RecorderMiddleware.
+// It does not return an interface but a *middlewares.Recorder.
+func (c *Container) UnscopedSafeGetRecorderMiddleware() (*middlewares.Recorder, error) {
+	return typed.UnscopedGet[*middlewares.Recorder](c, "recorder-middleware")
+}
+
+// UnscopedGetRecorderMiddleware is similar to UnscopedSafeGetRecorderMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetRecorderMiddleware() *middlewares.Recorder {
+	o, err := c.UnscopedSafeGetRecorderMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// RecorderMiddleware is similar to GetRecorderMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetRecorderMiddleware method.
+// If the container can not be retrieved, it panics.
+func RecorderMiddleware(i interface{}) *middlewares.Recorder {
+	return C(i).GetRecorderMiddleware()
+}
+
+// SafeGetRecoveryMiddleware works like SafeGet but only for RecoveryMiddleware.
+// It does not return an interface but a middlewares.Recovery.
+func (c *Container) SafeGetRecoveryMiddleware() (middlewares.Recovery, error) {
+	return typed.Get[middlewares.Recovery](c, "recovery-middleware")
+}
+
+// GetRecoveryMiddleware is similar to SafeGetRecoveryMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetRecoveryMiddleware() middlewares.Recovery {
+	o, err := c.SafeGetRecoveryMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetRecoveryMiddleware works like UnscopedSafeGet but only for RecoveryMiddleware.
+// It does not return an interface but a middlewares.Recovery.
+func (c *Container) UnscopedSafeGetRecoveryMiddleware() (middlewares.Recovery, error) {
+	return typed.UnscopedGet[middlewares.Recovery](c, "recovery-middleware")
+}
+
+// UnscopedGetRecoveryMiddleware is similar to UnscopedSafeGetRecoveryMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetRecoveryMiddleware() middlewares.Recovery {
+	o, err := c.UnscopedSafeGetRecoveryMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// RecoveryMiddleware is similar to GetRecoveryMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetRecoveryMiddleware method.
+// If the container can not be retrieved, it panics.
+func RecoveryMiddleware(i interface{}) middlewares.Recovery {
+	return C(i).GetRecoveryMiddleware()
+}
+
+// SafeGetRegistrationService works like SafeGet but only for RegistrationService.
+// It does not return an interface but a services.IRegistrationService.
+func (c *Container) SafeGetRegistrationService() (services.IRegistrationService, error) {
+	return typed.Get[services.IRegistrationService](c, "registration-service")
+}
+
+// GetRegistrationService is similar to SafeGetRegistrationService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetRegistrationService() services.IRegistrationService {
+	o, err := c.SafeGetRegistrationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetRegistrationService works like UnscopedSafeGet but only for RegistrationService.
+// It does not return an interface but a services.IRegistrationService.
+func (c *Container) UnscopedSafeGetRegistrationService() (services.IRegistrationService, error) {
+	return typed.UnscopedGet[services.IRegistrationService](c, "registration-service")
+}
+
+// UnscopedGetRegistrationService is similar to UnscopedSafeGetRegistrationService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetRegistrationService() services.IRegistrationService {
+	o, err := c.UnscopedSafeGetRegistrationService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// RegistrationService is similar to GetRegistrationService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetRegistrationService method.
+// If the container can not be retrieved, it panics.
+func RegistrationService(i interface{}) services.IRegistrationService {
+	return C(i).GetRegistrationService()
+}
+
+// SafeGetReportController works like SafeGet but only for ReportController.
+// It does not return an interface but a controllers.ReportController.
+func (c *Container) SafeGetReportController() (controllers.ReportController, error) {
+	return typed.Get[controllers.ReportController](c, "report-controller")
+}
+
+// GetReportController is similar to SafeGetReportController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetReportController() controllers.ReportController {
+	o, err := c.SafeGetReportController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetReportController works like UnscopedSafeGet but only for ReportController.
+// It does not return an interface but a controllers.ReportController.
+func (c *Container) UnscopedSafeGetReportController() (controllers.ReportController, error) {
+	return typed.UnscopedGet[controllers.ReportController](c, "report-controller")
+}
+
+// UnscopedGetReportController is similar to UnscopedSafeGetReportController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetReportController() controllers.ReportController {
+	o, err := c.UnscopedSafeGetReportController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ReportController is similar to GetReportController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetReportController method.
+// If the container can not be retrieved, it panics.
+func ReportController(i interface{}) controllers.ReportController {
+	return C(i).GetReportController()
+}
+
+// SafeGetReportRepository works like SafeGet but only for ReportRepository.
+// It does not return an interface but a repositories.IReportRepository.
+func (c *Container) SafeGetReportRepository() (repositories.IReportRepository, error) {
+	return typed.Get[repositories.IReportRepository](c, "report-repository")
+}
+
+// GetReportRepository is similar to SafeGetReportRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetReportRepository() repositories.IReportRepository {
+	o, err := c.SafeGetReportRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetReportRepository works like UnscopedSafeGet but only for ReportRepository.
+// It does not return an interface but a repositories.IReportRepository.
+func (c *Container) UnscopedSafeGetReportRepository() (repositories.IReportRepository, error) {
+	return typed.UnscopedGet[repositories.IReportRepository](c, "report-repository")
+}
+
+// UnscopedGetReportRepository is similar to UnscopedSafeGetReportRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetReportRepository() repositories.IReportRepository {
+	o, err := c.UnscopedSafeGetReportRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ReportRepository is similar to GetReportRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetReportRepository method.
+// If the container can not be retrieved, it panics.
+func ReportRepository(i interface{}) repositories.IReportRepository {
+	return C(i).GetReportRepository()
+}
+
+// SafeGetReportService works like SafeGet but only for ReportService.
+// It does not return an interface but a services.IReportService.
+func (c *Container) SafeGetReportService() (services.IReportService, error) {
+	return typed.Get[services.IReportService](c, "report-service")
+}
+
+// GetReportService is similar to SafeGetReportService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetReportService() services.IReportService {
+	o, err := c.SafeGetReportService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetReportService works like UnscopedSafeGet but only for ReportService.
+// It does not return an interface but a services.IReportService.
+func (c *Container) UnscopedSafeGetReportService() (services.IReportService, error) {
+	return typed.UnscopedGet[services.IReportService](c, "report-service")
+}
+
+// UnscopedGetReportService is similar to UnscopedSafeGetReportService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetReportService() services.IReportService {
+	o, err := c.UnscopedSafeGetReportService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ReportService is similar to GetReportService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetReportService method.
+// If the container can not be retrieved, it panics.
+func ReportService(i interface{}) services.IReportService {
+	return C(i).GetReportService()
+}
+
+// SafeGetResponseCacheMiddleware works like SafeGet but only for ResponseCacheMiddleware.
+// It does not return an interface but a middlewares.ResponseCache.
+func (c *Container) SafeGetResponseCacheMiddleware() (middlewares.ResponseCache, error) {
+	return typed.Get[middlewares.ResponseCache](c, "response-cache-middleware")
+}
+
+// GetResponseCacheMiddleware is similar to SafeGetResponseCacheMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetResponseCacheMiddleware() middlewares.ResponseCache {
+	o, err := c.SafeGetResponseCacheMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetResponseCacheMiddleware works like UnscopedSafeGet but only for ResponseCacheMiddleware.
+// It does not return an interface but a middlewares.ResponseCache.
+func (c *Container) UnscopedSafeGetResponseCacheMiddleware() (middlewares.ResponseCache, error) {
+	return typed.UnscopedGet[middlewares.ResponseCache](c, "response-cache-middleware")
+}
+
+// UnscopedGetResponseCacheMiddleware is similar to UnscopedSafeGetResponseCacheMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetResponseCacheMiddleware() middlewares.ResponseCache {
+	o, err := c.UnscopedSafeGetResponseCacheMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ResponseCacheMiddleware is similar to GetResponseCacheMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetResponseCacheMiddleware method.
+// If the container can not be retrieved, it panics.
+func ResponseCacheMiddleware(i interface{}) middlewares.ResponseCache {
+	return C(i).GetResponseCacheMiddleware()
+}
+
+// SafeGetScheduler works like SafeGet but only for Scheduler.
+// It does not return an interface but a infrastructures.IScheduler.
+func (c *Container) SafeGetScheduler() (infrastructures.IScheduler, error) {
+	return typed.Get[infrastructures.IScheduler](c, "scheduler")
+}
+
+// GetScheduler is similar to SafeGetScheduler but it does not return the error.
+// Instead it panics.
+func (c *Container) GetScheduler() infrastructures.IScheduler {
+	o, err := c.SafeGetScheduler()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetScheduler works like UnscopedSafeGet but only for Scheduler.
+// It does not return an interface but a infrastructures.IScheduler.
+func (c *Container) UnscopedSafeGetScheduler() (infrastructures.IScheduler, error) {
+	return typed.UnscopedGet[infrastructures.IScheduler](c, "scheduler")
+}
+
+// UnscopedGetScheduler is similar to UnscopedSafeGetScheduler but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetScheduler() infrastructures.IScheduler {
+	o, err := c.UnscopedSafeGetScheduler()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Scheduler is similar to GetScheduler.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetScheduler method.
+// If the container can not be retrieved, it panics.
+func Scheduler(i interface{}) infrastructures.IScheduler {
+	return C(i).GetScheduler()
+}
+
+// SafeGetSearch works like SafeGet but only for Search.
+// It does not return an interface but a infrastructures.ISearchEngine.
+func (c *Container) SafeGetSearch() (infrastructures.ISearchEngine, error) {
+	return typed.Get[infrastructures.ISearchEngine](c, "search")
+}
+
+// GetSearch is similar to SafeGetSearch but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSearch() infrastructures.ISearchEngine {
+	o, err := c.SafeGetSearch()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSearch works like UnscopedSafeGet but only for Search.
+// It does not return an interface but a infrastructures.ISearchEngine.
+func (c *Container) UnscopedSafeGetSearch() (infrastructures.ISearchEngine, error) {
+	return typed.UnscopedGet[infrastructures.ISearchEngine](c, "search")
+}
+
+// UnscopedGetSearch is similar to UnscopedSafeGetSearch but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSearch() infrastructures.ISearchEngine {
+	o, err := c.UnscopedSafeGetSearch()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Search is similar to GetSearch.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSearch method.
+// If the container can not be retrieved, it panics.
+func Search(i interface{}) infrastructures.ISearchEngine {
+	return C(i).GetSearch()
+}
+
+// SafeGetSearchService works like SafeGet but only for SearchService.
+// It does not return an interface but a services.ISearchService.
+func (c *Container) SafeGetSearchService() (services.ISearchService, error) {
+	return typed.Get[services.ISearchService](c, "search-service")
+}
+
+// GetSearchService is similar to SafeGetSearchService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSearchService() services.ISearchService {
+	o, err := c.SafeGetSearchService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSearchService works like UnscopedSafeGet but only for SearchService.
+// It does not return an interface but a services.ISearchService.
+func (c *Container) UnscopedSafeGetSearchService() (services.ISearchService, error) {
+	return typed.UnscopedGet[services.ISearchService](c, "search-service")
+}
+
+// UnscopedGetSearchService is similar to UnscopedSafeGetSearchService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSearchService() services.ISearchService {
+	o, err := c.UnscopedSafeGetSearchService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SearchService is similar to GetSearchService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSearchService method.
+// If the container can not be retrieved, it panics.
+func SearchService(i interface{}) services.ISearchService {
+	return C(i).GetSearchService()
+}
+
+// SafeGetSessionRepository works like SafeGet but only for SessionRepository.
+// It does not return an interface but a repositories.ISessionRepository.
+func (c *Container) SafeGetSessionRepository() (repositories.ISessionRepository, error) {
+	return typed.Get[repositories.ISessionRepository](c, "session-repository")
+}
+
+// GetSessionRepository is similar to SafeGetSessionRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSessionRepository() repositories.ISessionRepository {
+	o, err := c.SafeGetSessionRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSessionRepository works like UnscopedSafeGet but only for SessionRepository.
+// It does not return an interface but a repositories.ISessionRepository.
+func (c *Container) UnscopedSafeGetSessionRepository() (repositories.ISessionRepository, error) {
+	return typed.UnscopedGet[repositories.ISessionRepository](c, "session-repository")
+}
+
+// UnscopedGetSessionRepository is similar to UnscopedSafeGetSessionRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSessionRepository() repositories.ISessionRepository {
+	o, err := c.UnscopedSafeGetSessionRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SessionRepository is similar to GetSessionRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSessionRepository method.
+// If the container can not be retrieved, it panics.
+func SessionRepository(i interface{}) repositories.ISessionRepository {
+	return C(i).GetSessionRepository()
+}
+
+// SafeGetSessionService works like SafeGet but only for SessionService.
+// It does not return an interface but a services.ISessionService.
+func (c *Container) SafeGetSessionService() (services.ISessionService, error) {
+	return typed.Get[services.ISessionService](c, "session-service")
+}
+
+// GetSessionService is similar to SafeGetSessionService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSessionService() services.ISessionService {
+	o, err := c.SafeGetSessionService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSessionService works like UnscopedSafeGet but only for SessionService.
+// It does not return an interface but a services.ISessionService.
+func (c *Container) UnscopedSafeGetSessionService() (services.ISessionService, error) {
+	return typed.UnscopedGet[services.ISessionService](c, "session-service")
+}
+
+// UnscopedGetSessionService is similar to UnscopedSafeGetSessionService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSessionService() services.ISessionService {
+	o, err := c.UnscopedSafeGetSessionService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SessionService is similar to GetSessionService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSessionService method.
+// If the container can not be retrieved, it panics.
+func SessionService(i interface{}) services.ISessionService {
+	return C(i).GetSessionService()
+}
+
+// SafeGetSettingController works like SafeGet but only for SettingController.
+// It does not return an interface but a controllers.SettingController.
+func (c *Container) SafeGetSettingController() (controllers.SettingController, error) {
+	return typed.Get[controllers.SettingController](c, "setting-controller")
+}
+
+// GetSettingController is similar to SafeGetSettingController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSettingController() controllers.SettingController {
+	o, err := c.SafeGetSettingController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSettingController works like UnscopedSafeGet but only for SettingController.
+// It does not return an interface but a controllers.SettingController.
+func (c *Container) UnscopedSafeGetSettingController() (controllers.SettingController, error) {
+	return typed.UnscopedGet[controllers.SettingController](c, "setting-controller")
+}
+
+// UnscopedGetSettingController is similar to UnscopedSafeGetSettingController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSettingController() controllers.SettingController {
+	o, err := c.UnscopedSafeGetSettingController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SettingController is similar to GetSettingController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSettingController method.
+// If the container can not be retrieved, it panics.
+func SettingController(i interface{}) controllers.SettingController {
+	return C(i).GetSettingController()
+}
+
+// SafeGetSettingRepository works like SafeGet but only for SettingRepository.
+// It does not return an interface but a repositories.ISettingRepository.
+func (c *Container) SafeGetSettingRepository() (repositories.ISettingRepository, error) {
+	return typed.Get[repositories.ISettingRepository](c, "setting-repository")
+}
+
+// GetSettingRepository is similar to SafeGetSettingRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSettingRepository() repositories.ISettingRepository {
+	o, err := c.SafeGetSettingRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSettingRepository works like UnscopedSafeGet but only for SettingRepository.
+// It does not return an interface but a repositories.ISettingRepository.
+func (c *Container) UnscopedSafeGetSettingRepository() (repositories.ISettingRepository, error) {
+	return typed.UnscopedGet[repositories.ISettingRepository](c, "setting-repository")
+}
+
+// UnscopedGetSettingRepository is similar to UnscopedSafeGetSettingRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSettingRepository() repositories.ISettingRepository {
+	o, err := c.UnscopedSafeGetSettingRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SettingRepository is similar to GetSettingRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSettingRepository method.
+// If the container can not be retrieved, it panics.
+func SettingRepository(i interface{}) repositories.ISettingRepository {
+	return C(i).GetSettingRepository()
+}
+
+// SafeGetSettingService works like SafeGet but only for SettingService.
+// It does not return an interface but a services.ISettingService.
+func (c *Container) SafeGetSettingService() (services.ISettingService, error) {
+	return typed.Get[services.ISettingService](c, "setting-service")
+}
+
+// GetSettingService is similar to SafeGetSettingService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSettingService() services.ISettingService {
+	o, err := c.SafeGetSettingService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSettingService works like UnscopedSafeGet but only for SettingService.
+// It does not return an interface but a services.ISettingService.
+func (c *Container) UnscopedSafeGetSettingService() (services.ISettingService, error) {
+	return typed.UnscopedGet[services.ISettingService](c, "setting-service")
+}
+
+// UnscopedGetSettingService is similar to UnscopedSafeGetSettingService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSettingService() services.ISettingService {
+	o, err := c.UnscopedSafeGetSettingService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SettingService is similar to GetSettingService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSettingService method.
+// If the container can not be retrieved, it panics.
+func SettingService(i interface{}) services.ISettingService {
+	return C(i).GetSettingService()
+}
+
+// SafeGetShadowMiddleware works like SafeGet but only for ShadowMiddleware.
+// It does not return an interface but a middlewares.Shadow.
+func (c *Container) SafeGetShadowMiddleware() (middlewares.Shadow, error) {
+	return typed.Get[middlewares.Shadow](c, "shadow-middleware")
+}
+
+// GetShadowMiddleware is similar to SafeGetShadowMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetShadowMiddleware() middlewares.Shadow {
+	o, err := c.SafeGetShadowMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetShadowMiddleware works like UnscopedSafeGet but only for ShadowMiddleware.
+// It does not return an interface but a middlewares.Shadow.
+func (c *Container) UnscopedSafeGetShadowMiddleware() (middlewares.Shadow, error) {
+	return typed.UnscopedGet[middlewares.Shadow](c, "shadow-middleware")
+}
+
+// UnscopedGetShadowMiddleware is similar to UnscopedSafeGetShadowMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetShadowMiddleware() middlewares.Shadow {
+	o, err := c.UnscopedSafeGetShadowMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// ShadowMiddleware is similar to GetShadowMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetShadowMiddleware method.
+// If the container can not be retrieved, it panics.
+func ShadowMiddleware(i interface{}) middlewares.Shadow {
+	return C(i).GetShadowMiddleware()
+}
+
+// SafeGetSignatureMiddleware works like SafeGet but only for SignatureMiddleware.
+// It does not return an interface but a middlewares.Signature.
+func (c *Container) SafeGetSignatureMiddleware() (middlewares.Signature, error) {
+	return typed.Get[middlewares.Signature](c, "signature-middleware")
+}
+
+// GetSignatureMiddleware is similar to SafeGetSignatureMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSignatureMiddleware() middlewares.Signature {
+	o, err := c.SafeGetSignatureMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSignatureMiddleware works like UnscopedSafeGet but only for SignatureMiddleware.
+// It does not return an interface but a middlewares.Signature.
+func (c *Container) UnscopedSafeGetSignatureMiddleware() (middlewares.Signature, error) {
+	return typed.UnscopedGet[middlewares.Signature](c, "signature-middleware")
+}
+
+// UnscopedGetSignatureMiddleware is similar to UnscopedSafeGetSignatureMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSignatureMiddleware() middlewares.Signature {
+	o, err := c.UnscopedSafeGetSignatureMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SignatureMiddleware is similar to GetSignatureMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSignatureMiddleware method.
+// If the container can not be retrieved, it panics.
+func SignatureMiddleware(i interface{}) middlewares.Signature {
+	return C(i).GetSignatureMiddleware()
+}
+
+// SafeGetSigner works like SafeGet but only for Signer.
+// It does not return an interface but a signing.Signer.
+func (c *Container) SafeGetSigner() (signing.Signer, error) {
+	return typed.Get[signing.Signer](c, "signer")
+}
+
+// GetSigner is similar to SafeGetSigner but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSigner() signing.Signer {
+	o, err := c.SafeGetSigner()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSigner works like UnscopedSafeGet but only for Signer.
+// It does not return an interface but a signing.Signer.
+func (c *Container) UnscopedSafeGetSigner() (signing.Signer, error) {
+	return typed.UnscopedGet[signing.Signer](c, "signer")
+}
+
+// UnscopedGetSigner is similar to UnscopedSafeGetSigner but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSigner() signing.Signer {
+	o, err := c.UnscopedSafeGetSigner()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Signer is similar to GetSigner.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSigner method.
+// If the container can not be retrieved, it panics.
+func Signer(i interface{}) signing.Signer {
+	return C(i).GetSigner()
+}
+
+// SafeGetSloController works like SafeGet but only for SloController.
+// It does not return an interface but a controllers.SloController.
+func (c *Container) SafeGetSloController() (controllers.SloController, error) {
+	return typed.Get[controllers.SloController](c, "slo-controller")
+}
+
+// GetSloController is similar to SafeGetSloController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSloController() controllers.SloController {
+	o, err := c.SafeGetSloController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSloController works like UnscopedSafeGet but only for SloController.
+// It does not return an interface but a controllers.SloController.
+func (c *Container) UnscopedSafeGetSloController() (controllers.SloController, error) {
+	return typed.UnscopedGet[controllers.SloController](c, "slo-controller")
+}
+
+// UnscopedGetSloController is similar to UnscopedSafeGetSloController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSloController() controllers.SloController {
+	o, err := c.UnscopedSafeGetSloController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SloController is similar to GetSloController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSloController method.
+// If the container can not be retrieved, it panics.
+func SloController(i interface{}) controllers.SloController {
+	return C(i).GetSloController()
+}
+
+// SafeGetSloMiddleware works like SafeGet but only for SloMiddleware.
+// It does not return an interface but a middlewares.Slo.
+func (c *Container) SafeGetSloMiddleware() (middlewares.Slo, error) {
+	return typed.Get[middlewares.Slo](c, "slo-middleware")
+}
+
+// GetSloMiddleware is similar to SafeGetSloMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSloMiddleware() middlewares.Slo {
+	o, err := c.SafeGetSloMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSloMiddleware works like UnscopedSafeGet but only for SloMiddleware.
+// It does not return an interface but a middlewares.Slo.
+func (c *Container) UnscopedSafeGetSloMiddleware() (middlewares.Slo, error) {
+	return typed.UnscopedGet[middlewares.Slo](c, "slo-middleware")
+}
+
+// UnscopedGetSloMiddleware is similar to UnscopedSafeGetSloMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSloMiddleware() middlewares.Slo {
+	o, err := c.UnscopedSafeGetSloMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SloMiddleware is similar to GetSloMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSloMiddleware method.
+// If the container can not be retrieved, it panics.
+func SloMiddleware(i interface{}) middlewares.Slo {
+	return C(i).GetSloMiddleware()
+}
+
+// SafeGetSloService works like SafeGet but only for SloService.
+// It does not return an interface but a services.ISloService.
+func (c *Container) SafeGetSloService() (services.ISloService, error) {
+	return typed.Get[services.ISloService](c, "slo-service")
+}
+
+// GetSloService is similar to SafeGetSloService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSloService() services.ISloService {
+	o, err := c.SafeGetSloService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSloService works like UnscopedSafeGet but only for SloService.
+// It does not return an interface but a services.ISloService.
+func (c *Container) UnscopedSafeGetSloService() (services.ISloService, error) {
+	return typed.UnscopedGet[services.ISloService](c, "slo-service")
+}
+
+// UnscopedGetSloService is similar to UnscopedSafeGetSloService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSloService() services.ISloService {
+	o, err := c.UnscopedSafeGetSloService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SloService is similar to GetSloService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSloService method.
+// If the container can not be retrieved, it panics.
+func SloService(i interface{}) services.ISloService {
+	return C(i).GetSloService()
+}
+
+// SafeGetSlowRequestMiddleware works like SafeGet but only for SlowRequestMiddleware.
+// It does not return an interface but a middlewares.SlowRequest.
+func (c *Container) SafeGetSlowRequestMiddleware() (middlewares.SlowRequest, error) {
+	return typed.Get[middlewares.SlowRequest](c, "slow-request-middleware")
+}
+
+// GetSlowRequestMiddleware is similar to SafeGetSlowRequestMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSlowRequestMiddleware() middlewares.SlowRequest {
+	o, err := c.SafeGetSlowRequestMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSlowRequestMiddleware works like UnscopedSafeGet but only for SlowRequestMiddleware.
+// It does not return an interface but a middlewares.SlowRequest.
+func (c *Container) UnscopedSafeGetSlowRequestMiddleware() (middlewares.SlowRequest, error) {
+	return typed.UnscopedGet[middlewares.SlowRequest](c, "slow-request-middleware")
+}
+
+// UnscopedGetSlowRequestMiddleware is similar to UnscopedSafeGetSlowRequestMiddleware but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSlowRequestMiddleware() middlewares.SlowRequest {
+	o, err := c.UnscopedSafeGetSlowRequestMiddleware()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SlowRequestMiddleware is similar to GetSlowRequestMiddleware.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSlowRequestMiddleware method.
+// If the container can not be retrieved, it panics.
+func SlowRequestMiddleware(i interface{}) middlewares.SlowRequest {
+	return C(i).GetSlowRequestMiddleware()
+}
+
+// SafeGetSms works like SafeGet but only for Sms.
+// It does not return an interface but a infrastructures.ISmsService.
+func (c *Container) SafeGetSms() (infrastructures.ISmsService, error) {
+	return typed.Get[infrastructures.ISmsService](c, "sms")
+}
+
+// GetSms is similar to SafeGetSms but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSms() infrastructures.ISmsService {
+	o, err := c.SafeGetSms()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSms works like UnscopedSafeGet but only for Sms.
+// It does not return an interface but a infrastructures.ISmsService.
+func (c *Container) UnscopedSafeGetSms() (infrastructures.ISmsService, error) {
+	return typed.UnscopedGet[infrastructures.ISmsService](c, "sms")
+}
+
+// UnscopedGetSms is similar to UnscopedSafeGetSms but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSms() infrastructures.ISmsService {
+	o, err := c.UnscopedSafeGetSms()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Sms is similar to GetSms.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSms method.
+// If the container can not be retrieved, it panics.
+func Sms(i interface{}) infrastructures.ISmsService {
+	return C(i).GetSms()
+}
+
+// SafeGetSocketController works like SafeGet but only for SocketController.
+// It does not return an interface but a controllers.SocketController.
+func (c *Container) SafeGetSocketController() (controllers.SocketController, error) {
+	return typed.Get[controllers.SocketController](c, "socket-controller")
+}
+
+// GetSocketController is similar to SafeGetSocketController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSocketController() controllers.SocketController {
+	o, err := c.SafeGetSocketController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSocketController works like UnscopedSafeGet but only for SocketController.
+// It does not return an interface but a controllers.SocketController.
+func (c *Container) UnscopedSafeGetSocketController() (controllers.SocketController, error) {
+	return typed.UnscopedGet[controllers.SocketController](c, "socket-controller")
+}
+
+// UnscopedGetSocketController is similar to UnscopedSafeGetSocketController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSocketController() controllers.SocketController {
+	o, err := c.UnscopedSafeGetSocketController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SocketController is similar to GetSocketController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSocketController method.
+// If the container can not be retrieved, it panics.
+func SocketController(i interface{}) controllers.SocketController {
+	return C(i).GetSocketController()
+}
+
+// SafeGetStorage works like SafeGet but only for Storage.
+// It does not return an interface but a infrastructures.IStorageService.
+func (c *Container) SafeGetStorage() (infrastructures.IStorageService, error) {
+	return typed.Get[infrastructures.IStorageService](c, "storage")
+}
+
+// GetStorage is similar to SafeGetStorage but it does not return the error.
+// Instead it panics.
+func (c *Container) GetStorage() infrastructures.IStorageService {
+	o, err := c.SafeGetStorage()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetStorage works like UnscopedSafeGet but only for Storage.
+// It does not return an interface but a infrastructures.IStorageService.
+func (c *Container) UnscopedSafeGetStorage() (infrastructures.IStorageService, error) {
+	return typed.UnscopedGet[infrastructures.IStorageService](c, "storage")
+}
+
+// UnscopedGetStorage is similar to UnscopedSafeGetStorage but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetStorage() infrastructures.IStorageService {
+	o, err := c.UnscopedSafeGetStorage()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// Storage is similar to GetStorage.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetStorage method.
+// If the container can not be retrieved, it panics.
+func Storage(i interface{}) infrastructures.IStorageService {
+	return C(i).GetStorage()
+}
+
+// SafeGetSuspensionController works like SafeGet but only for SuspensionController.
+// It does not return an interface but a controllers.SuspensionController.
+func (c *Container) SafeGetSuspensionController() (controllers.SuspensionController, error) {
+	return typed.Get[controllers.SuspensionController](c, "suspension-controller")
+}
+
+// GetSuspensionController is similar to SafeGetSuspensionController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSuspensionController() controllers.SuspensionController {
+	o, err := c.SafeGetSuspensionController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSuspensionController works like UnscopedSafeGet but only for SuspensionController.
+// It does not return an interface but a controllers.SuspensionController.
+func (c *Container) UnscopedSafeGetSuspensionController() (controllers.SuspensionController, error) {
+	return typed.UnscopedGet[controllers.SuspensionController](c, "suspension-controller")
+}
+
+// UnscopedGetSuspensionController is similar to UnscopedSafeGetSuspensionController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSuspensionController() controllers.SuspensionController {
+	o, err := c.UnscopedSafeGetSuspensionController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SuspensionController is similar to GetSuspensionController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSuspensionController method.
+// If the container can not be retrieved, it panics.
+func SuspensionController(i interface{}) controllers.SuspensionController {
+	return C(i).GetSuspensionController()
+}
+
+// SafeGetSuspensionService works like SafeGet but only for SuspensionService.
+// It does not return an interface but a services.ISuspensionService.
+func (c *Container) SafeGetSuspensionService() (services.ISuspensionService, error) {
+	return typed.Get[services.ISuspensionService](c, "suspension-service")
+}
+
+// GetSuspensionService is similar to SafeGetSuspensionService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetSuspensionService() services.ISuspensionService {
+	o, err := c.SafeGetSuspensionService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetSuspensionService works like UnscopedSafeGet but only for SuspensionService.
+// It does not return an interface but a services.ISuspensionService.
+func (c *Container) UnscopedSafeGetSuspensionService() (services.ISuspensionService, error) {
+	return typed.UnscopedGet[services.ISuspensionService](c, "suspension-service")
+}
+
+// UnscopedGetSuspensionService is similar to UnscopedSafeGetSuspensionService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetSuspensionService() services.ISuspensionService {
+	o, err := c.UnscopedSafeGetSuspensionService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// SuspensionService is similar to GetSuspensionService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetSuspensionService method.
+// If the container can not be retrieved, it panics.
+func SuspensionService(i interface{}) services.ISuspensionService {
+	return C(i).GetSuspensionService()
+}
+
+// SafeGetTagController works like SafeGet but only for TagController.
+// It does not return an interface but a controllers.TagController.
+func (c *Container) SafeGetTagController() (controllers.TagController, error) {
+	return typed.Get[controllers.TagController](c, "tag-controller")
+}
+
+// GetTagController is similar to SafeGetTagController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetTagController() controllers.TagController {
+	o, err := c.SafeGetTagController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetTagController works like UnscopedSafeGet but only for TagController.
+// It does not return an interface but a controllers.TagController.
+func (c *Container) UnscopedSafeGetTagController() (controllers.TagController, error) {
+	return typed.UnscopedGet[controllers.TagController](c, "tag-controller")
+}
+
+// UnscopedGetTagController is similar to UnscopedSafeGetTagController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetTagController() controllers.TagController {
+	o, err := c.UnscopedSafeGetTagController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// TagController is similar to GetTagController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetTagController method.
+// If the container can not be retrieved, it panics.
+func TagController(i interface{}) controllers.TagController {
+	return C(i).GetTagController()
+}
+
+// SafeGetTagRepository works like SafeGet but only for TagRepository.
+// It does not return an interface but a repositories.ITagRepository.
+func (c *Container) SafeGetTagRepository() (repositories.ITagRepository, error) {
+	return typed.Get[repositories.ITagRepository](c, "tag-repository")
+}
+
+// GetTagRepository is similar to SafeGetTagRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetTagRepository() repositories.ITagRepository {
+	o, err := c.SafeGetTagRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetTagRepository works like UnscopedSafeGet but only for TagRepository.
+// It does not return an interface but a repositories.ITagRepository.
+func (c *Container) UnscopedSafeGetTagRepository() (repositories.ITagRepository, error) {
+	return typed.UnscopedGet[repositories.ITagRepository](c, "tag-repository")
+}
+
+// UnscopedGetTagRepository is similar to UnscopedSafeGetTagRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetTagRepository() repositories.ITagRepository {
+	o, err := c.UnscopedSafeGetTagRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// TagRepository is similar to GetTagRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetTagRepository method.
+// If the container can not be retrieved, it panics.
+func TagRepository(i interface{}) repositories.ITagRepository {
+	return C(i).GetTagRepository()
+}
+
+// SafeGetTagService works like SafeGet but only for TagService.
+// It does not return an interface but a services.ITagService.
+func (c *Container) SafeGetTagService() (services.ITagService, error) {
+	return typed.Get[services.ITagService](c, "tag-service")
+}
+
+// GetTagService is similar to SafeGetTagService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetTagService() services.ITagService {
+	o, err := c.SafeGetTagService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetTagService works like UnscopedSafeGet but only for TagService.
+// It does not return an interface but a services.ITagService.
+func (c *Container) UnscopedSafeGetTagService() (services.ITagService, error) {
+	return typed.UnscopedGet[services.ITagService](c, "tag-service")
+}
+
+// UnscopedGetTagService is similar to UnscopedSafeGetTagService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetTagService() services.ITagService {
+	o, err := c.UnscopedSafeGetTagService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// TagService is similar to GetTagService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetTagService method.
+// If the container can not be retrieved, it panics.
+func TagService(i interface{}) services.ITagService {
+	return C(i).GetTagService()
+}
+
+// SafeGetUnitOfWork works like SafeGet but only for UnitOfWork.
+// It does not return an interface but a transactions.IUnitOfWork.
+func (c *Container) SafeGetUnitOfWork() (transactions.IUnitOfWork, error) {
+	return typed.Get[transactions.IUnitOfWork](c, "unit-of-work")
+}
+
+// GetUnitOfWork is similar to SafeGetUnitOfWork but it does not return the error.
+// Instead it panics.
+func (c *Container) GetUnitOfWork() transactions.IUnitOfWork {
+	o, err := c.SafeGetUnitOfWork()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetUnitOfWork works like UnscopedSafeGet but only for UnitOfWork.
+// It does not return an interface but a transactions.IUnitOfWork.
+func (c *Container) UnscopedSafeGetUnitOfWork() (transactions.IUnitOfWork, error) {
+	return typed.UnscopedGet[transactions.IUnitOfWork](c, "unit-of-work")
+}
+
+// UnscopedGetUnitOfWork is similar to UnscopedSafeGetUnitOfWork but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetUnitOfWork() transactions.IUnitOfWork {
+	o, err := c.UnscopedSafeGetUnitOfWork()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnitOfWork is similar to GetUnitOfWork.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetUnitOfWork method.
+// If the container can not be retrieved, it panics.
+func UnitOfWork(i interface{}) transactions.IUnitOfWork {
+	return C(i).GetUnitOfWork()
+}
+
+// SafeGetUsageRepository works like SafeGet but only for UsageRepository.
+// It does not return an interface but a repositories.IUsageRepository.
+func (c *Container) SafeGetUsageRepository() (repositories.IUsageRepository, error) {
+	return typed.Get[repositories.IUsageRepository](c, "usage-repository")
+}
+
+// GetUsageRepository is similar to SafeGetUsageRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetUsageRepository() repositories.IUsageRepository {
+	o, err := c.SafeGetUsageRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetUsageRepository works like UnscopedSafeGet but only for UsageRepository.
+// It does not return an interface but a repositories.IUsageRepository.
+func (c *Container) UnscopedSafeGetUsageRepository() (repositories.IUsageRepository, error) {
+	return typed.UnscopedGet[repositories.IUsageRepository](c, "usage-repository")
+}
+
+// UnscopedGetUsageRepository is similar to UnscopedSafeGetUsageRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetUsageRepository() repositories.IUsageRepository {
+	o, err := c.UnscopedSafeGetUsageRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UsageRepository is similar to GetUsageRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetUsageRepository method.
+// If the container can not be retrieved, it panics.
+func UsageRepository(i interface{}) repositories.IUsageRepository {
+	return C(i).GetUsageRepository()
+}
+
+// SafeGetUserController works like SafeGet but only for UserController.
+// It does not return an interface but a controllers.UserController.
+func (c *Container) SafeGetUserController() (controllers.UserController, error) {
+	return typed.Get[controllers.UserController](c, "user-controller")
+}
+
+// GetUserController is similar to SafeGetUserController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetUserController() controllers.UserController {
+	o, err := c.SafeGetUserController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetUserController works like UnscopedSafeGet but only for UserController.
+// It does not return an interface but a controllers.UserController.
+func (c *Container) UnscopedSafeGetUserController() (controllers.UserController, error) {
+	return typed.UnscopedGet[controllers.UserController](c, "user-controller")
+}
+
+// UnscopedGetUserController is similar to UnscopedSafeGetUserController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetUserController() controllers.UserController {
+	o, err := c.UnscopedSafeGetUserController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UserController is similar to GetUserController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetUserController method.
+// If the container can not be retrieved, it panics.
+func UserController(i interface{}) controllers.UserController {
+	return C(i).GetUserController()
+}
+
+// SafeGetUserHistoryController works like SafeGet but only for UserHistoryController.
+// It does not return an interface but a controllers.UserHistoryController.
+func (c *Container) SafeGetUserHistoryController() (controllers.UserHistoryController, error) {
+	return typed.Get[controllers.UserHistoryController](c, "user-history-controller")
+}
+
+// GetUserHistoryController is similar to SafeGetUserHistoryController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetUserHistoryController() controllers.UserHistoryController {
+	o, err := c.SafeGetUserHistoryController()
 	if err != nil {
-		var eo repositories.IUserRepository
-		return eo, err
+		panic(err)
 	}
-	o, ok := i.(repositories.IUserRepository)
-	if !ok {
-		return o, errors.New("could get 'user-repository' because the object could not be cast to repositories.IUserRepository")
+	return o
+}
+
+// UnscopedSafeGetUserHistoryController works like UnscopedSafeGet but only for UserHistoryController.
+// It does not return an interface but a controllers.UserHistoryController.
+func (c *Container) UnscopedSafeGetUserHistoryController() (controllers.UserHistoryController, error) {
+	return typed.UnscopedGet[controllers.UserHistoryController](c, "user-history-controller")
+}
+
+// UnscopedGetUserHistoryController is similar to UnscopedSafeGetUserHistoryController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetUserHistoryController() controllers.UserHistoryController {
+	o, err := c.UnscopedSafeGetUserHistoryController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UserHistoryController is similar to GetUserHistoryController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetUserHistoryController method.
+// If the container can not be retrieved, it panics.
+func UserHistoryController(i interface{}) controllers.UserHistoryController {
+	return C(i).GetUserHistoryController()
+}
+
+// SafeGetUserHistoryService works like SafeGet but only for UserHistoryService.
+// It does not return an interface but a services.IUserHistoryService.
+func (c *Container) SafeGetUserHistoryService() (services.IUserHistoryService, error) {
+	return typed.Get[services.IUserHistoryService](c, "user-history-service")
+}
+
+// GetUserHistoryService is similar to SafeGetUserHistoryService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetUserHistoryService() services.IUserHistoryService {
+	o, err := c.SafeGetUserHistoryService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetUserHistoryService works like UnscopedSafeGet but only for UserHistoryService.
+// It does not return an interface but a services.IUserHistoryService.
+func (c *Container) UnscopedSafeGetUserHistoryService() (services.IUserHistoryService, error) {
+	return typed.UnscopedGet[services.IUserHistoryService](c, "user-history-service")
+}
+
+// UnscopedGetUserHistoryService is similar to UnscopedSafeGetUserHistoryService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetUserHistoryService() services.IUserHistoryService {
+	o, err := c.UnscopedSafeGetUserHistoryService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UserHistoryService is similar to GetUserHistoryService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetUserHistoryService method.
+// If the container can not be retrieved, it panics.
+func UserHistoryService(i interface{}) services.IUserHistoryService {
+	return C(i).GetUserHistoryService()
+}
+
+// SafeGetUserPolicy works like SafeGet but only for UserPolicy.
+// It does not return an interface but a policies.IUserPolicy.
+func (c *Container) SafeGetUserPolicy() (policies.IUserPolicy, error) {
+	return typed.Get[policies.IUserPolicy](c, "user-policy")
+}
+
+// GetUserPolicy is similar to SafeGetUserPolicy but it does not return the error.
+// Instead it panics.
+func (c *Container) GetUserPolicy() policies.IUserPolicy {
+	o, err := c.SafeGetUserPolicy()
+	if err != nil {
+		panic(err)
 	}
-	return o, nil
+	return o
+}
+
+// UnscopedSafeGetUserPolicy works like UnscopedSafeGet but only for UserPolicy.
+// It does not return an interface but a policies.IUserPolicy.
+func (c *Container) UnscopedSafeGetUserPolicy() (policies.IUserPolicy, error) {
+	return typed.UnscopedGet[policies.IUserPolicy](c, "user-policy")
+}
+
+// UnscopedGetUserPolicy is similar to UnscopedSafeGetUserPolicy but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetUserPolicy() policies.IUserPolicy {
+	o, err := c.UnscopedSafeGetUserPolicy()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UserPolicy is similar to GetUserPolicy.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetUserPolicy method.
+// If the container can not be retrieved, it panics.
+func UserPolicy(i interface{}) policies.IUserPolicy {
+	return C(i).GetUserPolicy()
+}
+
+// SafeGetUserProjectionService works like SafeGet but only for UserProjectionService.
+// It does not return an interface but a services.IUserProjectionService.
+func (c *Container) SafeGetUserProjectionService() (services.IUserProjectionService, error) {
+	return typed.Get[services.IUserProjectionService](c, "user-projection-service")
+}
+
+// GetUserProjectionService is similar to SafeGetUserProjectionService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetUserProjectionService() services.IUserProjectionService {
+	o, err := c.SafeGetUserProjectionService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetUserProjectionService works like UnscopedSafeGet but only for UserProjectionService.
+// It does not return an interface but a services.IUserProjectionService.
+func (c *Container) UnscopedSafeGetUserProjectionService() (services.IUserProjectionService, error) {
+	return typed.UnscopedGet[services.IUserProjectionService](c, "user-projection-service")
+}
+
+// UnscopedGetUserProjectionService is similar to UnscopedSafeGetUserProjectionService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetUserProjectionService() services.IUserProjectionService {
+	o, err := c.UnscopedSafeGetUserProjectionService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UserProjectionService is similar to GetUserProjectionService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetUserProjectionService method.
+// If the container can not be retrieved, it panics.
+func UserProjectionService(i interface{}) services.IUserProjectionService {
+	return C(i).GetUserProjectionService()
+}
+
+// SafeGetUserRepository works like SafeGet but only for UserRepository.
+// It does not return an interface but a repositories.IUserRepository.
+func (c *Container) SafeGetUserRepository() (repositories.IUserRepository, error) {
+	return typed.Get[repositories.IUserRepository](c, "user-repository")
+}
+
+// GetUserRepository is similar to SafeGetUserRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetUserRepository() repositories.IUserRepository {
+	o, err := c.SafeGetUserRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetUserRepository works like UnscopedSafeGet but only for UserRepository.
+// It does not return an interface but a repositories.IUserRepository.
+func (c *Container) UnscopedSafeGetUserRepository() (repositories.IUserRepository, error) {
+	return typed.UnscopedGet[repositories.IUserRepository](c, "user-repository")
 }
 
 // UnscopedGetUserRepository is similar to UnscopedSafeGetUserRepository but it does not return the error.
@@ -859,19 +6506,50 @@ func UserRepository(i interface{}) repositories.IUserRepository {
 	return C(i).GetUserRepository()
 }
 
-// SafeGetUserService works like SafeGet but only for UserService.
-// It does not return an interface but a services.IUserService.
-func (c *Container) SafeGetUserService() (services.IUserService, error) {
-	i, err := c.ctn.SafeGet("user-service")
+// SafeGetUserSearchViewRepository works like SafeGet but only for UserSearchViewRepository.
+// It does not return an interface but a repositories.IUserSearchViewRepository.
+func (c *Container) SafeGetUserSearchViewRepository() (repositories.IUserSearchViewRepository, error) {
+	return typed.Get[repositories.IUserSearchViewRepository](c, "user-search-view-repository")
+}
+
+// GetUserSearchViewRepository is similar to SafeGetUserSearchViewRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetUserSearchViewRepository() repositories.IUserSearchViewRepository {
+	o, err := c.SafeGetUserSearchViewRepository()
 	if err != nil {
-		var eo services.IUserService
-		return eo, err
+		panic(err)
 	}
-	o, ok := i.(services.IUserService)
-	if !ok {
-		return o, errors.New("could get 'user-service' because the object could not be cast to services.IUserService")
+	return o
+}
+
+// UnscopedSafeGetUserSearchViewRepository works like UnscopedSafeGet but only for UserSearchViewRepository.
+// It does not return an interface but a repositories.IUserSearchViewRepository.
+func (c *Container) UnscopedSafeGetUserSearchViewRepository() (repositories.IUserSearchViewRepository, error) {
+	return typed.UnscopedGet[repositories.IUserSearchViewRepository](c, "user-search-view-repository")
+}
+
+// UnscopedGetUserSearchViewRepository is similar to UnscopedSafeGetUserSearchViewRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetUserSearchViewRepository() repositories.IUserSearchViewRepository {
+	o, err := c.UnscopedSafeGetUserSearchViewRepository()
+	if err != nil {
+		panic(err)
 	}
-	return o, nil
+	return o
+}
+
+// UserSearchViewRepository is similar to GetUserSearchViewRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetUserSearchViewRepository method.
+// If the container can not be retrieved, it panics.
+func UserSearchViewRepository(i interface{}) repositories.IUserSearchViewRepository {
+	return C(i).GetUserSearchViewRepository()
+}
+
+// SafeGetUserService works like SafeGet but only for UserService.
+// It does not return an interface but a services.IUserService.
+func (c *Container) SafeGetUserService() (services.IUserService, error) {
+	return typed.Get[services.IUserService](c, "user-service")
 }
 
 // GetUserService is similar to SafeGetUserService but it does not return the error.
@@ -887,16 +6565,7 @@ func (c *Container) GetUserService() services.IUserService {
 // UnscopedSafeGetUserService works like UnscopedSafeGet but only for UserService.
 // It does not return an interface but a services.IUserService.
 func (c *Container) UnscopedSafeGetUserService() (services.IUserService, error) {
-	i, err := c.ctn.UnscopedSafeGet("user-service")
-	if err != nil {
-		var eo services.IUserService
-		return eo, err
-	}
-	o, ok := i.(services.IUserService)
-	if !ok {
-		return o, errors.New("could get 'user-service' because the object could not be cast to services.IUserService")
-	}
-	return o, nil
+	return typed.UnscopedGet[services.IUserService](c, "user-service")
 }
 
 // UnscopedGetUserService is similar to UnscopedSafeGetUserService but it does not return the error.
@@ -917,19 +6586,50 @@ func UserService(i interface{}) services.IUserService {
 	return C(i).GetUserService()
 }
 
-// SafeGetUserWelcomeMail works like SafeGet but only for UserWelcomeMail.
-// It does not return an interface but a mails.IMailRenderer.
-func (c *Container) SafeGetUserWelcomeMail() (mails.IMailRenderer, error) {
-	i, err := c.ctn.SafeGet("user-welcome-mail")
+// SafeGetUserVersionRepository works like SafeGet but only for UserVersionRepository.
+// It does not return an interface but a repositories.IUserVersionRepository.
+func (c *Container) SafeGetUserVersionRepository() (repositories.IUserVersionRepository, error) {
+	return typed.Get[repositories.IUserVersionRepository](c, "user-version-repository")
+}
+
+// GetUserVersionRepository is similar to SafeGetUserVersionRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetUserVersionRepository() repositories.IUserVersionRepository {
+	o, err := c.SafeGetUserVersionRepository()
 	if err != nil {
-		var eo mails.IMailRenderer
-		return eo, err
+		panic(err)
 	}
-	o, ok := i.(mails.IMailRenderer)
-	if !ok {
-		return o, errors.New("could get 'user-welcome-mail' because the object could not be cast to mails.IMailRenderer")
+	return o
+}
+
+// UnscopedSafeGetUserVersionRepository works like UnscopedSafeGet but only for UserVersionRepository.
+// It does not return an interface but a repositories.IUserVersionRepository.
+func (c *Container) UnscopedSafeGetUserVersionRepository() (repositories.IUserVersionRepository, error) {
+	return typed.UnscopedGet[repositories.IUserVersionRepository](c, "user-version-repository")
+}
+
+// UnscopedGetUserVersionRepository is similar to UnscopedSafeGetUserVersionRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetUserVersionRepository() repositories.IUserVersionRepository {
+	o, err := c.UnscopedSafeGetUserVersionRepository()
+	if err != nil {
+		panic(err)
 	}
-	return o, nil
+	return o
+}
+
+// UserVersionRepository is similar to GetUserVersionRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetUserVersionRepository method.
+// If the container can not be retrieved, it panics.
+func UserVersionRepository(i interface{}) repositories.IUserVersionRepository {
+	return C(i).GetUserVersionRepository()
+}
+
+// SafeGetUserWelcomeMail works like SafeGet but only for UserWelcomeMail.
+// It does not return an interface but a mails.IMailRenderer.
+func (c *Container) SafeGetUserWelcomeMail() (mails.IMailRenderer, error) {
+	return typed.Get[mails.IMailRenderer](c, "user-welcome-mail")
 }
 
 // GetUserWelcomeMail is similar to SafeGetUserWelcomeMail but it does not return the error.
@@ -945,16 +6645,7 @@ func (c *Container) GetUserWelcomeMail() mails.IMailRenderer {
 // UnscopedSafeGetUserWelcomeMail works like UnscopedSafeGet but only for UserWelcomeMail.
 // It does not return an interface but a mails.IMailRenderer.
 func (c *Container) UnscopedSafeGetUserWelcomeMail() (mails.IMailRenderer, error) {
-	i, err := c.ctn.UnscopedSafeGet("user-welcome-mail")
-	if err != nil {
-		var eo mails.IMailRenderer
-		return eo, err
-	}
-	o, ok := i.(mails.IMailRenderer)
-	if !ok {
-		return o, errors.New("could get 'user-welcome-mail' because the object could not be cast to mails.IMailRenderer")
-	}
-	return o, nil
+	return typed.UnscopedGet[mails.IMailRenderer](c, "user-welcome-mail")
 }
 
 // UnscopedGetUserWelcomeMail is similar to UnscopedSafeGetUserWelcomeMail but it does not return the error.
@@ -974,3 +6665,123 @@ func (c *Container) UnscopedGetUserWelcomeMail() mails.IMailRenderer {
 func UserWelcomeMail(i interface{}) mails.IMailRenderer {
 	return C(i).GetUserWelcomeMail()
 }
+
+// SafeGetVoteController works like SafeGet but only for VoteController.
+// It does not return an interface but a controllers.VoteController.
+func (c *Container) SafeGetVoteController() (controllers.VoteController, error) {
+	return typed.Get[controllers.VoteController](c, "vote-controller")
+}
+
+// GetVoteController is similar to SafeGetVoteController but it does not return the error.
+// Instead it panics.
+func (c *Container) GetVoteController() controllers.VoteController {
+	o, err := c.SafeGetVoteController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetVoteController works like UnscopedSafeGet but only for VoteController.
+// It does not return an interface but a controllers.VoteController.
+func (c *Container) UnscopedSafeGetVoteController() (controllers.VoteController, error) {
+	return typed.UnscopedGet[controllers.VoteController](c, "vote-controller")
+}
+
+// UnscopedGetVoteController is similar to UnscopedSafeGetVoteController but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetVoteController() controllers.VoteController {
+	o, err := c.UnscopedSafeGetVoteController()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// VoteController is similar to GetVoteController.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetVoteController method.
+// If the container can not be retrieved, it panics.
+func VoteController(i interface{}) controllers.VoteController {
+	return C(i).GetVoteController()
+}
+
+// SafeGetVoteRepository works like SafeGet but only for VoteRepository.
+// It does not return an interface but a repositories.IVoteRepository.
+func (c *Container) SafeGetVoteRepository() (repositories.IVoteRepository, error) {
+	return typed.Get[repositories.IVoteRepository](c, "vote-repository")
+}
+
+// GetVoteRepository is similar to SafeGetVoteRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) GetVoteRepository() repositories.IVoteRepository {
+	o, err := c.SafeGetVoteRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetVoteRepository works like UnscopedSafeGet but only for VoteRepository.
+// It does not return an interface but a repositories.IVoteRepository.
+func (c *Container) UnscopedSafeGetVoteRepository() (repositories.IVoteRepository, error) {
+	return typed.UnscopedGet[repositories.IVoteRepository](c, "vote-repository")
+}
+
+// UnscopedGetVoteRepository is similar to UnscopedSafeGetVoteRepository but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetVoteRepository() repositories.IVoteRepository {
+	o, err := c.UnscopedSafeGetVoteRepository()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// VoteRepository is similar to GetVoteRepository.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetVoteRepository method.
+// If the container can not be retrieved, it panics.
+func VoteRepository(i interface{}) repositories.IVoteRepository {
+	return C(i).GetVoteRepository()
+}
+
+// SafeGetVoteService works like SafeGet but only for VoteService.
+// It does not return an interface but a services.IVoteService.
+func (c *Container) SafeGetVoteService() (services.IVoteService, error) {
+	return typed.Get[services.IVoteService](c, "vote-service")
+}
+
+// GetVoteService is similar to SafeGetVoteService but it does not return the error.
+// Instead it panics.
+func (c *Container) GetVoteService() services.IVoteService {
+	o, err := c.SafeGetVoteService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedSafeGetVoteService works like UnscopedSafeGet but only for VoteService.
+// It does not return an interface but a services.IVoteService.
+func (c *Container) UnscopedSafeGetVoteService() (services.IVoteService, error) {
+	return typed.UnscopedGet[services.IVoteService](c, "vote-service")
+}
+
+// UnscopedGetVoteService is similar to UnscopedSafeGetVoteService but it does not return the error.
+// Instead it panics.
+func (c *Container) UnscopedGetVoteService() services.IVoteService {
+	o, err := c.UnscopedSafeGetVoteService()
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// VoteService is similar to GetVoteService.
+// It tries to find the container with the C method and the given interface.
+// If the container can be retrieved, it applies the GetVoteService method.
+// If the container can not be retrieved, it panics.
+func VoteService(i interface{}) services.IVoteService {
+	return C(i).GetVoteService()
+}
@@ -6,40 +6,45 @@ import (
 	"github.com/sarulabs/di/v2"
 	"github.com/sarulabs/dingo/v4"
 
+	gorm "gorm.io/gorm"
+	logger "gorm.io/gorm/logger"
+
 	controllers "gotham/controllers"
 	infrastructures "gotham/infrastructures"
 	mails "gotham/mails"
 	middlewares "gotham/middlewares"
 	policies "gotham/policies"
 	repositories "gotham/repositories"
+	transactions "gotham/repositories/transactions"
 	services "gotham/services"
+	signing "gotham/signing"
 )
 
 func getDiDefs(provider dingo.Provider) []di.Def {
 	return []di.Def{
 		{
-			Name:  "auth-controller",
+			Name:  "access-log-middleware",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("auth-controller")
+				d, err := provider.Get("access-log-middleware")
 				if err != nil {
-					var eo controllers.AuthController
+					var eo middlewares.AccessLog
 					return eo, err
 				}
-				pi0, err := ctn.SafeGet("auth-service")
+				pi0, err := ctn.SafeGet("logger")
 				if err != nil {
-					var eo controllers.AuthController
+					var eo middlewares.AccessLog
 					return eo, err
 				}
-				p0, ok := pi0.(services.IAuthService)
+				p0, ok := pi0.(infrastructures.ILogger)
 				if !ok {
-					var eo controllers.AuthController
-					return eo, errors.New("could not cast parameter 0 to services.IAuthService")
+					var eo middlewares.AccessLog
+					return eo, errors.New("could not cast parameter 0 to infrastructures.ILogger")
 				}
-				b, ok := d.Build.(func(services.IAuthService) (controllers.AuthController, error))
+				b, ok := d.Build.(func(infrastructures.ILogger) (middlewares.AccessLog, error))
 				if !ok {
-					var eo controllers.AuthController
-					return eo, errors.New("could not cast build function to func(services.IAuthService) (controllers.AuthController, error)")
+					var eo middlewares.AccessLog
+					return eo, errors.New("could not cast build function to func(infrastructures.ILogger) (middlewares.AccessLog, error)")
 				}
 				return b(p0)
 			},
@@ -48,28 +53,28 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 		},
 		{
-			Name:  "auth-middleware",
+			Name:  "access-rules-middleware",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("auth-middleware")
+				d, err := provider.Get("access-rules-middleware")
 				if err != nil {
-					var eo middlewares.Auth
+					var eo middlewares.AccessRules
 					return eo, err
 				}
-				pi0, err := ctn.SafeGet("user-service")
+				pi0, err := ctn.SafeGet("setting-service")
 				if err != nil {
-					var eo middlewares.Auth
+					var eo middlewares.AccessRules
 					return eo, err
 				}
-				p0, ok := pi0.(services.IUserService)
+				p0, ok := pi0.(services.ISettingService)
 				if !ok {
-					var eo middlewares.Auth
-					return eo, errors.New("could not cast parameter 0 to services.IUserService")
+					var eo middlewares.AccessRules
+					return eo, errors.New("could not cast parameter 0 to services.ISettingService")
 				}
-				b, ok := d.Build.(func(services.IUserService) (middlewares.Auth, error))
+				b, ok := d.Build.(func(services.ISettingService) (middlewares.AccessRules, error))
 				if !ok {
-					var eo middlewares.Auth
-					return eo, errors.New("could not cast build function to func(services.IUserService) (middlewares.Auth, error)")
+					var eo middlewares.AccessRules
+					return eo, errors.New("could not cast build function to func(services.ISettingService) (middlewares.AccessRules, error)")
 				}
 				return b(p0)
 			},
@@ -78,90 +83,178 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 		},
 		{
-			Name:  "auth-service",
+			Name:  "account-controller",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("auth-service")
+				d, err := provider.Get("account-controller")
 				if err != nil {
-					var eo services.IAuthService
+					var eo controllers.AccountController
 					return eo, err
 				}
-				pi0, err := ctn.SafeGet("user-repository")
+				pi0, err := ctn.SafeGet("privacy-service")
 				if err != nil {
-					var eo services.IAuthService
+					var eo controllers.AccountController
 					return eo, err
 				}
-				p0, ok := pi0.(repositories.IUserRepository)
+				p0, ok := pi0.(services.IPrivacyService)
 				if !ok {
-					var eo services.IAuthService
-					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+					var eo controllers.AccountController
+					return eo, errors.New("could not cast parameter 0 to services.IPrivacyService")
+				}
+				pi1, err := ctn.SafeGet("quota-service")
+				if err != nil {
+					var eo controllers.AccountController
+					return eo, err
 				}
-				b, ok := d.Build.(func(repositories.IUserRepository) (services.IAuthService, error))
+				p1, ok := pi1.(services.IQuotaService)
 				if !ok {
-					var eo services.IAuthService
-					return eo, errors.New("could not cast build function to func(repositories.IUserRepository) (services.IAuthService, error)")
+					var eo controllers.AccountController
+					return eo, errors.New("could not cast parameter 1 to services.IQuotaService")
 				}
-				return b(p0)
+				pi2, err := ctn.SafeGet("email-change-service")
+				if err != nil {
+					var eo controllers.AccountController
+					return eo, err
+				}
+				p2, ok := pi2.(services.IEmailChangeService)
+				if !ok {
+					var eo controllers.AccountController
+					return eo, errors.New("could not cast parameter 2 to services.IEmailChangeService")
+				}
+				pi3, err := ctn.SafeGet("user-service")
+				if err != nil {
+					var eo controllers.AccountController
+					return eo, err
+				}
+				p3, ok := pi3.(services.IUserService)
+				if !ok {
+					var eo controllers.AccountController
+					return eo, errors.New("could not cast parameter 3 to services.IUserService")
+				}
+				b, ok := d.Build.(func(services.IPrivacyService, services.IQuotaService, services.IEmailChangeService, services.IUserService) (controllers.AccountController, error))
+				if !ok {
+					var eo controllers.AccountController
+					return eo, errors.New("could not cast build function to func(services.IPrivacyService, services.IQuotaService, services.IEmailChangeService, services.IUserService) (controllers.AccountController, error)")
+				}
+				return b(p0, p1, p2, p3)
 			},
 			Close: func(obj interface{}) error {
 				return nil
 			},
 		},
 		{
-			Name:  "db",
+			Name:  "admin-controller",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("db")
+				d, err := provider.Get("admin-controller")
 				if err != nil {
-					var eo infrastructures.IGormDatabase
+					var eo controllers.AdminController
 					return eo, err
 				}
-				pi0, err := ctn.SafeGet("db-pool")
+				pi0, err := ctn.SafeGet("admin-resources")
 				if err != nil {
-					var eo infrastructures.IGormDatabase
+					var eo controllers.AdminController
 					return eo, err
 				}
-				p0, ok := pi0.(infrastructures.IGormDatabasePool)
+				p0, ok := pi0.(map[string]services.IAdminService)
 				if !ok {
-					var eo infrastructures.IGormDatabase
-					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabasePool")
+					var eo controllers.AdminController
+					return eo, errors.New("could not cast parameter 0 to map[string]services.IAdminService")
 				}
-				b, ok := d.Build.(func(infrastructures.IGormDatabasePool) (infrastructures.IGormDatabase, error))
+				b, ok := d.Build.(func(map[string]services.IAdminService) (controllers.AdminController, error))
 				if !ok {
-					var eo infrastructures.IGormDatabase
-					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabasePool) (infrastructures.IGormDatabase, error)")
+					var eo controllers.AdminController
+					return eo, errors.New("could not cast build function to func(map[string]services.IAdminService) (controllers.AdminController, error)")
 				}
 				return b(p0)
 			},
 			Close: func(obj interface{}) error {
-				d, err := provider.Get("db")
+				return nil
+			},
+		},
+		{
+			Name:  "admin-resources",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("admin-resources")
 				if err != nil {
-					return err
+					var eo map[string]services.IAdminService
+					return eo, err
 				}
-				c, ok := d.Close.(func(infrastructures.IGormDatabase) error)
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo map[string]services.IAdminService
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
 				if !ok {
-					return errors.New("could not cast close function to 'func(infrastructures.IGormDatabase) error'")
+					var eo map[string]services.IAdminService
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
 				}
-				o, ok := obj.(infrastructures.IGormDatabase)
+				pi1, err := ctn.SafeGet("billing-repository")
+				if err != nil {
+					var eo map[string]services.IAdminService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IBillingRepository)
 				if !ok {
-					return errors.New("could not cast object to 'infrastructures.IGormDatabase'")
+					var eo map[string]services.IAdminService
+					return eo, errors.New("could not cast parameter 1 to repositories.IBillingRepository")
 				}
-				return c(o)
+				pi2, err := ctn.SafeGet("tag-repository")
+				if err != nil {
+					var eo map[string]services.IAdminService
+					return eo, err
+				}
+				p2, ok := pi2.(repositories.ITagRepository)
+				if !ok {
+					var eo map[string]services.IAdminService
+					return eo, errors.New("could not cast parameter 2 to repositories.ITagRepository")
+				}
+				pi3, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo map[string]services.IAdminService
+					return eo, err
+				}
+				p3, ok := pi3.(services.ISettingService)
+				if !ok {
+					var eo map[string]services.IAdminService
+					return eo, errors.New("could not cast parameter 3 to services.ISettingService")
+				}
+				pi4, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo map[string]services.IAdminService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.ILogger)
+				if !ok {
+					var eo map[string]services.IAdminService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase, repositories.IBillingRepository, repositories.ITagRepository, services.ISettingService, infrastructures.ILogger) (map[string]services.IAdminService, error))
+				if !ok {
+					var eo map[string]services.IAdminService
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase, repositories.IBillingRepository, repositories.ITagRepository, services.ISettingService, infrastructures.ILogger) (map[string]services.IAdminService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
 			},
 		},
 		{
-			Name:  "db-pool",
+			Name:  "admin-ui-controller",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("db-pool")
+				d, err := provider.Get("admin-ui-controller")
 				if err != nil {
-					var eo infrastructures.IGormDatabasePool
+					var eo controllers.AdminUIController
 					return eo, err
 				}
-				b, ok := d.Build.(func() (infrastructures.IGormDatabasePool, error))
+				b, ok := d.Build.(func() (controllers.AdminUIController, error))
 				if !ok {
-					var eo infrastructures.IGormDatabasePool
-					return eo, errors.New("could not cast build function to func() (infrastructures.IGormDatabasePool, error)")
+					var eo controllers.AdminUIController
+					return eo, errors.New("could not cast build function to func() (controllers.AdminUIController, error)")
 				}
 				return b()
 			},
@@ -170,48 +263,60 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 		},
 		{
-			Name:  "email",
+			Name:  "analytics",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("email")
+				d, err := provider.Get("analytics")
 				if err != nil {
-					var eo infrastructures.IEmailService
+					var eo infrastructures.IAnalytics
 					return eo, err
 				}
-				b, ok := d.Build.(func() (infrastructures.IEmailService, error))
+				b, ok := d.Build.(func() (infrastructures.IAnalytics, error))
 				if !ok {
-					var eo infrastructures.IEmailService
-					return eo, errors.New("could not cast build function to func() (infrastructures.IEmailService, error)")
+					var eo infrastructures.IAnalytics
+					return eo, errors.New("could not cast build function to func() (infrastructures.IAnalytics, error)")
 				}
 				return b()
 			},
 			Close: func(obj interface{}) error {
-				return nil
+				d, err := provider.Get("analytics")
+				if err != nil {
+					return err
+				}
+				c, ok := d.Close.(func(infrastructures.IAnalytics) error)
+				if !ok {
+					return errors.New("could not cast close function to 'func(infrastructures.IAnalytics) error'")
+				}
+				o, ok := obj.(infrastructures.IAnalytics)
+				if !ok {
+					return errors.New("could not cast object to 'infrastructures.IAnalytics'")
+				}
+				return c(o)
 			},
 		},
 		{
-			Name:  "is-admin-middleware",
+			Name:  "analytics-middleware",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("is-admin-middleware")
+				d, err := provider.Get("analytics-middleware")
 				if err != nil {
-					var eo middlewares.IsAdmin
+					var eo middlewares.Analytics
 					return eo, err
 				}
-				pi0, err := ctn.SafeGet("user-service")
+				pi0, err := ctn.SafeGet("analytics")
 				if err != nil {
-					var eo middlewares.IsAdmin
+					var eo middlewares.Analytics
 					return eo, err
 				}
-				p0, ok := pi0.(services.IUserService)
+				p0, ok := pi0.(infrastructures.IAnalytics)
 				if !ok {
-					var eo middlewares.IsAdmin
-					return eo, errors.New("could not cast parameter 0 to services.IUserService")
+					var eo middlewares.Analytics
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IAnalytics")
 				}
-				b, ok := d.Build.(func(services.IUserService) (middlewares.IsAdmin, error))
+				b, ok := d.Build.(func(infrastructures.IAnalytics) (middlewares.Analytics, error))
 				if !ok {
-					var eo middlewares.IsAdmin
-					return eo, errors.New("could not cast build function to func(services.IUserService) (middlewares.IsAdmin, error)")
+					var eo middlewares.Analytics
+					return eo, errors.New("could not cast build function to func(infrastructures.IAnalytics) (middlewares.Analytics, error)")
 				}
 				return b(p0)
 			},
@@ -220,28 +325,28 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 		},
 		{
-			Name:  "is-verified-middleware",
+			Name:  "announcement-controller",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("is-verified-middleware")
+				d, err := provider.Get("announcement-controller")
 				if err != nil {
-					var eo middlewares.IsVerified
+					var eo controllers.AnnouncementController
 					return eo, err
 				}
-				pi0, err := ctn.SafeGet("user-service")
+				pi0, err := ctn.SafeGet("announcement-service")
 				if err != nil {
-					var eo middlewares.IsVerified
+					var eo controllers.AnnouncementController
 					return eo, err
 				}
-				p0, ok := pi0.(services.IUserService)
+				p0, ok := pi0.(services.IAnnouncementService)
 				if !ok {
-					var eo middlewares.IsVerified
-					return eo, errors.New("could not cast parameter 0 to services.IUserService")
+					var eo controllers.AnnouncementController
+					return eo, errors.New("could not cast parameter 0 to services.IAnnouncementService")
 				}
-				b, ok := d.Build.(func(services.IUserService) (middlewares.IsVerified, error))
+				b, ok := d.Build.(func(services.IAnnouncementService) (controllers.AnnouncementController, error))
 				if !ok {
-					var eo middlewares.IsVerified
-					return eo, errors.New("could not cast build function to func(services.IUserService) (middlewares.IsVerified, error)")
+					var eo controllers.AnnouncementController
+					return eo, errors.New("could not cast build function to func(services.IAnnouncementService) (controllers.AnnouncementController, error)")
 				}
 				return b(p0)
 			},
@@ -250,38 +355,38 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 		},
 		{
-			Name:  "user-controller",
+			Name:  "announcement-repository",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("user-controller")
+				d, err := provider.Get("announcement-repository")
 				if err != nil {
-					var eo controllers.UserController
+					var eo repositories.IAnnouncementRepository
 					return eo, err
 				}
-				pi0, err := ctn.SafeGet("user-service")
+				pi0, err := ctn.SafeGet("db")
 				if err != nil {
-					var eo controllers.UserController
+					var eo repositories.IAnnouncementRepository
 					return eo, err
 				}
-				p0, ok := pi0.(services.IUserService)
+				p0, ok := pi0.(infrastructures.IGormDatabase)
 				if !ok {
-					var eo controllers.UserController
-					return eo, errors.New("could not cast parameter 0 to services.IUserService")
+					var eo repositories.IAnnouncementRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
 				}
-				pi1, err := ctn.SafeGet("user-policy")
+				pi1, err := ctn.SafeGet("shards")
 				if err != nil {
-					var eo controllers.UserController
+					var eo repositories.IAnnouncementRepository
 					return eo, err
 				}
-				p1, ok := pi1.(policies.IUserPolicy)
+				p1, ok := pi1.(infrastructures.IShards)
 				if !ok {
-					var eo controllers.UserController
-					return eo, errors.New("could not cast parameter 1 to policies.IUserPolicy")
+					var eo repositories.IAnnouncementRepository
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IShards")
 				}
-				b, ok := d.Build.(func(services.IUserService, policies.IUserPolicy) (controllers.UserController, error))
+				b, ok := d.Build.(func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.IAnnouncementRepository, error))
 				if !ok {
-					var eo controllers.UserController
-					return eo, errors.New("could not cast build function to func(services.IUserService, policies.IUserPolicy) (controllers.UserController, error)")
+					var eo repositories.IAnnouncementRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.IAnnouncementRepository, error)")
 				}
 				return b(p0, p1)
 			},
@@ -290,48 +395,118 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 		},
 		{
-			Name:  "user-policy",
+			Name:  "announcement-service",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("user-policy")
+				d, err := provider.Get("announcement-service")
 				if err != nil {
-					var eo policies.IUserPolicy
+					var eo services.IAnnouncementService
 					return eo, err
 				}
-				b, ok := d.Build.(func() (policies.IUserPolicy, error))
+				pi0, err := ctn.SafeGet("announcement-repository")
+				if err != nil {
+					var eo services.IAnnouncementService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IAnnouncementRepository)
 				if !ok {
-					var eo policies.IUserPolicy
-					return eo, errors.New("could not cast build function to func() (policies.IUserPolicy, error)")
+					var eo services.IAnnouncementService
+					return eo, errors.New("could not cast parameter 0 to repositories.IAnnouncementRepository")
 				}
-				return b()
+				pi1, err := ctn.SafeGet("billing-repository")
+				if err != nil {
+					var eo services.IAnnouncementService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IBillingRepository)
+				if !ok {
+					var eo services.IAnnouncementService
+					return eo, errors.New("could not cast parameter 1 to repositories.IBillingRepository")
+				}
+				pi2, err := ctn.SafeGet("notification-service")
+				if err != nil {
+					var eo services.IAnnouncementService
+					return eo, err
+				}
+				p2, ok := pi2.(services.INotificationService)
+				if !ok {
+					var eo services.IAnnouncementService
+					return eo, errors.New("could not cast parameter 2 to services.INotificationService")
+				}
+				pi3, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.IAnnouncementService
+					return eo, err
+				}
+				p3, ok := pi3.(services.ISettingService)
+				if !ok {
+					var eo services.IAnnouncementService
+					return eo, errors.New("could not cast parameter 3 to services.ISettingService")
+				}
+				pi4, err := ctn.SafeGet("hub")
+				if err != nil {
+					var eo services.IAnnouncementService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.IHub)
+				if !ok {
+					var eo services.IAnnouncementService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.IHub")
+				}
+				pi5, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.IAnnouncementService
+					return eo, err
+				}
+				p5, ok := pi5.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.IAnnouncementService
+					return eo, errors.New("could not cast parameter 5 to infrastructures.IEventBus")
+				}
+				pi6, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IAnnouncementService
+					return eo, err
+				}
+				p6, ok := pi6.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IAnnouncementService
+					return eo, errors.New("could not cast parameter 6 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(repositories.IAnnouncementRepository, repositories.IBillingRepository, services.INotificationService, services.ISettingService, infrastructures.IHub, infrastructures.IEventBus, infrastructures.ILogger) (services.IAnnouncementService, error))
+				if !ok {
+					var eo services.IAnnouncementService
+					return eo, errors.New("could not cast build function to func(repositories.IAnnouncementRepository, repositories.IBillingRepository, services.INotificationService, services.ISettingService, infrastructures.IHub, infrastructures.IEventBus, infrastructures.ILogger) (services.IAnnouncementService, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5, p6)
 			},
 			Close: func(obj interface{}) error {
 				return nil
 			},
 		},
 		{
-			Name:  "user-repository",
+			Name:  "api-key-controller",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("user-repository")
+				d, err := provider.Get("api-key-controller")
 				if err != nil {
-					var eo repositories.IUserRepository
+					var eo controllers.ApiKeyController
 					return eo, err
 				}
-				pi0, err := ctn.SafeGet("db")
+				pi0, err := ctn.SafeGet("api-key-service")
 				if err != nil {
-					var eo repositories.IUserRepository
+					var eo controllers.ApiKeyController
 					return eo, err
 				}
-				p0, ok := pi0.(infrastructures.IGormDatabase)
+				p0, ok := pi0.(services.IApiKeyService)
 				if !ok {
-					var eo repositories.IUserRepository
-					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+					var eo controllers.ApiKeyController
+					return eo, errors.New("could not cast parameter 0 to services.IApiKeyService")
 				}
-				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IUserRepository, error))
+				b, ok := d.Build.(func(services.IApiKeyService) (controllers.ApiKeyController, error))
 				if !ok {
-					var eo repositories.IUserRepository
-					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IUserRepository, error)")
+					var eo controllers.ApiKeyController
+					return eo, errors.New("could not cast build function to func(services.IApiKeyService) (controllers.ApiKeyController, error)")
 				}
 				return b(p0)
 			},
@@ -340,28 +515,28 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 		},
 		{
-			Name:  "user-service",
+			Name:  "api-key-middleware",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("user-service")
+				d, err := provider.Get("api-key-middleware")
 				if err != nil {
-					var eo services.IUserService
+					var eo middlewares.ApiKey
 					return eo, err
 				}
-				pi0, err := ctn.SafeGet("user-repository")
+				pi0, err := ctn.SafeGet("api-key-service")
 				if err != nil {
-					var eo services.IUserService
+					var eo middlewares.ApiKey
 					return eo, err
 				}
-				p0, ok := pi0.(repositories.IUserRepository)
+				p0, ok := pi0.(services.IApiKeyService)
 				if !ok {
-					var eo services.IUserService
-					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+					var eo middlewares.ApiKey
+					return eo, errors.New("could not cast parameter 0 to services.IApiKeyService")
 				}
-				b, ok := d.Build.(func(repositories.IUserRepository) (services.IUserService, error))
+				b, ok := d.Build.(func(services.IApiKeyService) (middlewares.ApiKey, error))
 				if !ok {
-					var eo services.IUserService
-					return eo, errors.New("could not cast build function to func(repositories.IUserRepository) (services.IUserService, error)")
+					var eo middlewares.ApiKey
+					return eo, errors.New("could not cast build function to func(services.IApiKeyService) (middlewares.ApiKey, error)")
 				}
 				return b(p0)
 			},
@@ -370,20 +545,6278 @@ func getDiDefs(provider dingo.Provider) []di.Def {
 			},
 		},
 		{
-			Name:  "user-welcome-mail",
+			Name:  "api-key-repository",
 			Scope: "app",
 			Build: func(ctn di.Container) (interface{}, error) {
-				d, err := provider.Get("user-welcome-mail")
+				d, err := provider.Get("api-key-repository")
 				if err != nil {
-					var eo mails.IMailRenderer
+					var eo repositories.IApiKeyRepository
 					return eo, err
 				}
-				b, ok := d.Build.(func() (mails.IMailRenderer, error))
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IApiKeyRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
 				if !ok {
-					var eo mails.IMailRenderer
-					return eo, errors.New("could not cast build function to func() (mails.IMailRenderer, error)")
+					var eo repositories.IApiKeyRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
 				}
-				return b()
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IApiKeyRepository, error))
+				if !ok {
+					var eo repositories.IApiKeyRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IApiKeyRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "api-key-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("api-key-service")
+				if err != nil {
+					var eo services.IApiKeyService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("api-key-repository")
+				if err != nil {
+					var eo services.IApiKeyService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IApiKeyRepository)
+				if !ok {
+					var eo services.IApiKeyService
+					return eo, errors.New("could not cast parameter 0 to repositories.IApiKeyRepository")
+				}
+				pi1, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IApiKeyService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IClock)
+				if !ok {
+					var eo services.IApiKeyService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IClock")
+				}
+				pi2, err := ctn.SafeGet("random")
+				if err != nil {
+					var eo services.IApiKeyService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.IRandom)
+				if !ok {
+					var eo services.IApiKeyService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.IRandom")
+				}
+				b, ok := d.Build.(func(repositories.IApiKeyRepository, infrastructures.IClock, infrastructures.IRandom) (services.IApiKeyService, error))
+				if !ok {
+					var eo services.IApiKeyService
+					return eo, errors.New("could not cast build function to func(repositories.IApiKeyRepository, infrastructures.IClock, infrastructures.IRandom) (services.IApiKeyService, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "auth-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("auth-controller")
+				if err != nil {
+					var eo controllers.AuthController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("auth-service")
+				if err != nil {
+					var eo controllers.AuthController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IAuthService)
+				if !ok {
+					var eo controllers.AuthController
+					return eo, errors.New("could not cast parameter 0 to services.IAuthService")
+				}
+				pi1, err := ctn.SafeGet("registration-service")
+				if err != nil {
+					var eo controllers.AuthController
+					return eo, err
+				}
+				p1, ok := pi1.(services.IRegistrationService)
+				if !ok {
+					var eo controllers.AuthController
+					return eo, errors.New("could not cast parameter 1 to services.IRegistrationService")
+				}
+				pi2, err := ctn.SafeGet("magic-link-service")
+				if err != nil {
+					var eo controllers.AuthController
+					return eo, err
+				}
+				p2, ok := pi2.(services.IMagicLinkService)
+				if !ok {
+					var eo controllers.AuthController
+					return eo, errors.New("could not cast parameter 2 to services.IMagicLinkService")
+				}
+				pi3, err := ctn.SafeGet("session-service")
+				if err != nil {
+					var eo controllers.AuthController
+					return eo, err
+				}
+				p3, ok := pi3.(services.ISessionService)
+				if !ok {
+					var eo controllers.AuthController
+					return eo, errors.New("could not cast parameter 3 to services.ISessionService")
+				}
+				pi4, err := ctn.SafeGet("challenge-service")
+				if err != nil {
+					var eo controllers.AuthController
+					return eo, err
+				}
+				p4, ok := pi4.(services.IChallengeService)
+				if !ok {
+					var eo controllers.AuthController
+					return eo, errors.New("could not cast parameter 4 to services.IChallengeService")
+				}
+				pi5, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo controllers.AuthController
+					return eo, err
+				}
+				p5, ok := pi5.(infrastructures.IClock)
+				if !ok {
+					var eo controllers.AuthController
+					return eo, errors.New("could not cast parameter 5 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(services.IAuthService, services.IRegistrationService, services.IMagicLinkService, services.ISessionService, services.IChallengeService, infrastructures.IClock) (controllers.AuthController, error))
+				if !ok {
+					var eo controllers.AuthController
+					return eo, errors.New("could not cast build function to func(services.IAuthService, services.IRegistrationService, services.IMagicLinkService, services.ISessionService, services.IChallengeService, infrastructures.IClock) (controllers.AuthController, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "auth-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("auth-middleware")
+				if err != nil {
+					var eo middlewares.Auth
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-service")
+				if err != nil {
+					var eo middlewares.Auth
+					return eo, err
+				}
+				p0, ok := pi0.(services.IUserService)
+				if !ok {
+					var eo middlewares.Auth
+					return eo, errors.New("could not cast parameter 0 to services.IUserService")
+				}
+				pi1, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo middlewares.Auth
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.ILogger)
+				if !ok {
+					var eo middlewares.Auth
+					return eo, errors.New("could not cast parameter 1 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(services.IUserService, infrastructures.ILogger) (middlewares.Auth, error))
+				if !ok {
+					var eo middlewares.Auth
+					return eo, errors.New("could not cast build function to func(services.IUserService, infrastructures.ILogger) (middlewares.Auth, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "auth-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("auth-service")
+				if err != nil {
+					var eo services.IAuthService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IAuthService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IAuthService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+				}
+				pi1, err := ctn.SafeGet("session-repository")
+				if err != nil {
+					var eo services.IAuthService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.ISessionRepository)
+				if !ok {
+					var eo services.IAuthService
+					return eo, errors.New("could not cast parameter 1 to repositories.ISessionRepository")
+				}
+				pi2, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.IAuthService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ICache)
+				if !ok {
+					var eo services.IAuthService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ICache")
+				}
+				pi3, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IAuthService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IClock)
+				if !ok {
+					var eo services.IAuthService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(repositories.IUserRepository, repositories.ISessionRepository, infrastructures.ICache, infrastructures.IClock) (services.IAuthService, error))
+				if !ok {
+					var eo services.IAuthService
+					return eo, errors.New("could not cast build function to func(repositories.IUserRepository, repositories.ISessionRepository, infrastructures.ICache, infrastructures.IClock) (services.IAuthService, error)")
+				}
+				return b(p0, p1, p2, p3)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "billing-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("billing-controller")
+				if err != nil {
+					var eo controllers.BillingController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("billing-service")
+				if err != nil {
+					var eo controllers.BillingController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IBillingService)
+				if !ok {
+					var eo controllers.BillingController
+					return eo, errors.New("could not cast parameter 0 to services.IBillingService")
+				}
+				pi1, err := ctn.SafeGet("invoice-service")
+				if err != nil {
+					var eo controllers.BillingController
+					return eo, err
+				}
+				p1, ok := pi1.(services.IInvoiceService)
+				if !ok {
+					var eo controllers.BillingController
+					return eo, errors.New("could not cast parameter 1 to services.IInvoiceService")
+				}
+				b, ok := d.Build.(func(services.IBillingService, services.IInvoiceService) (controllers.BillingController, error))
+				if !ok {
+					var eo controllers.BillingController
+					return eo, errors.New("could not cast build function to func(services.IBillingService, services.IInvoiceService) (controllers.BillingController, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "billing-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("billing-middleware")
+				if err != nil {
+					var eo middlewares.Billing
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("billing-service")
+				if err != nil {
+					var eo middlewares.Billing
+					return eo, err
+				}
+				p0, ok := pi0.(services.IBillingService)
+				if !ok {
+					var eo middlewares.Billing
+					return eo, errors.New("could not cast parameter 0 to services.IBillingService")
+				}
+				b, ok := d.Build.(func(services.IBillingService) (middlewares.Billing, error))
+				if !ok {
+					var eo middlewares.Billing
+					return eo, errors.New("could not cast build function to func(services.IBillingService) (middlewares.Billing, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "billing-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("billing-repository")
+				if err != nil {
+					var eo repositories.IBillingRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IBillingRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IBillingRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IBillingRepository, error))
+				if !ok {
+					var eo repositories.IBillingRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IBillingRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "billing-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("billing-service")
+				if err != nil {
+					var eo services.IBillingService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("billing-repository")
+				if err != nil {
+					var eo services.IBillingService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IBillingRepository)
+				if !ok {
+					var eo services.IBillingService
+					return eo, errors.New("could not cast parameter 0 to repositories.IBillingRepository")
+				}
+				pi1, err := ctn.SafeGet("payment-gateway")
+				if err != nil {
+					var eo services.IBillingService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IPaymentGateway)
+				if !ok {
+					var eo services.IBillingService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IPaymentGateway")
+				}
+				pi2, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IBillingService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IBillingService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ILogger")
+				}
+				pi3, err := ctn.SafeGet("invoice-service")
+				if err != nil {
+					var eo services.IBillingService
+					return eo, err
+				}
+				p3, ok := pi3.(services.IInvoiceService)
+				if !ok {
+					var eo services.IBillingService
+					return eo, errors.New("could not cast parameter 3 to services.IInvoiceService")
+				}
+				pi4, err := ctn.SafeGet("coupon-service")
+				if err != nil {
+					var eo services.IBillingService
+					return eo, err
+				}
+				p4, ok := pi4.(services.ICouponService)
+				if !ok {
+					var eo services.IBillingService
+					return eo, errors.New("could not cast parameter 4 to services.ICouponService")
+				}
+				pi5, err := ctn.SafeGet("dead-letter-service")
+				if err != nil {
+					var eo services.IBillingService
+					return eo, err
+				}
+				p5, ok := pi5.(services.IDeadLetterService)
+				if !ok {
+					var eo services.IBillingService
+					return eo, errors.New("could not cast parameter 5 to services.IDeadLetterService")
+				}
+				pi6, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IBillingService
+					return eo, err
+				}
+				p6, ok := pi6.(infrastructures.IClock)
+				if !ok {
+					var eo services.IBillingService
+					return eo, errors.New("could not cast parameter 6 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(repositories.IBillingRepository, infrastructures.IPaymentGateway, infrastructures.ILogger, services.IInvoiceService, services.ICouponService, services.IDeadLetterService, infrastructures.IClock) (services.IBillingService, error))
+				if !ok {
+					var eo services.IBillingService
+					return eo, errors.New("could not cast build function to func(repositories.IBillingRepository, infrastructures.IPaymentGateway, infrastructures.ILogger, services.IInvoiceService, services.ICouponService, services.IDeadLetterService, infrastructures.IClock) (services.IBillingService, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5, p6)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "breadcrumbs",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("breadcrumbs")
+				if err != nil {
+					var eo *infrastructures.Breadcrumbs
+					return eo, err
+				}
+				b, ok := d.Build.(func() (*infrastructures.Breadcrumbs, error))
+				if !ok {
+					var eo *infrastructures.Breadcrumbs
+					return eo, errors.New("could not cast build function to func() (*infrastructures.Breadcrumbs, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "budget-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("budget-middleware")
+				if err != nil {
+					var eo middlewares.Budget
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("metrics")
+				if err != nil {
+					var eo middlewares.Budget
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IMetrics)
+				if !ok {
+					var eo middlewares.Budget
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IMetrics")
+				}
+				b, ok := d.Build.(func(infrastructures.IMetrics) (middlewares.Budget, error))
+				if !ok {
+					var eo middlewares.Budget
+					return eo, errors.New("could not cast build function to func(infrastructures.IMetrics) (middlewares.Budget, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "cache",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("cache")
+				if err != nil {
+					var eo infrastructures.ICache
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.ICache, error))
+				if !ok {
+					var eo infrastructures.ICache
+					return eo, errors.New("could not cast build function to func() (infrastructures.ICache, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "captcha",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("captcha")
+				if err != nil {
+					var eo infrastructures.ICaptchaService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("http-client-factory")
+				if err != nil {
+					var eo infrastructures.ICaptchaService
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IHttpClientFactory)
+				if !ok {
+					var eo infrastructures.ICaptchaService
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IHttpClientFactory")
+				}
+				b, ok := d.Build.(func(infrastructures.IHttpClientFactory) (infrastructures.ICaptchaService, error))
+				if !ok {
+					var eo infrastructures.ICaptchaService
+					return eo, errors.New("could not cast build function to func(infrastructures.IHttpClientFactory) (infrastructures.ICaptchaService, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "challenge-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("challenge-service")
+				if err != nil {
+					var eo services.IChallengeService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("captcha")
+				if err != nil {
+					var eo services.IChallengeService
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.ICaptchaService)
+				if !ok {
+					var eo services.IChallengeService
+					return eo, errors.New("could not cast parameter 0 to infrastructures.ICaptchaService")
+				}
+				pi1, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.IChallengeService
+					return eo, err
+				}
+				p1, ok := pi1.(services.ISettingService)
+				if !ok {
+					var eo services.IChallengeService
+					return eo, errors.New("could not cast parameter 1 to services.ISettingService")
+				}
+				pi2, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.IChallengeService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ICache)
+				if !ok {
+					var eo services.IChallengeService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ICache")
+				}
+				b, ok := d.Build.(func(infrastructures.ICaptchaService, services.ISettingService, infrastructures.ICache) (services.IChallengeService, error))
+				if !ok {
+					var eo services.IChallengeService
+					return eo, errors.New("could not cast build function to func(infrastructures.ICaptchaService, services.ISettingService, infrastructures.ICache) (services.IChallengeService, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "client-certificate-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("client-certificate-middleware")
+				if err != nil {
+					var eo middlewares.ClientCertificate
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("client-service")
+				if err != nil {
+					var eo middlewares.ClientCertificate
+					return eo, err
+				}
+				p0, ok := pi0.(services.IClientService)
+				if !ok {
+					var eo middlewares.ClientCertificate
+					return eo, errors.New("could not cast parameter 0 to services.IClientService")
+				}
+				b, ok := d.Build.(func(services.IClientService) (middlewares.ClientCertificate, error))
+				if !ok {
+					var eo middlewares.ClientCertificate
+					return eo, errors.New("could not cast build function to func(services.IClientService) (middlewares.ClientCertificate, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "client-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("client-controller")
+				if err != nil {
+					var eo controllers.ClientController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("client-service")
+				if err != nil {
+					var eo controllers.ClientController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IClientService)
+				if !ok {
+					var eo controllers.ClientController
+					return eo, errors.New("could not cast parameter 0 to services.IClientService")
+				}
+				b, ok := d.Build.(func(services.IClientService) (controllers.ClientController, error))
+				if !ok {
+					var eo controllers.ClientController
+					return eo, errors.New("could not cast build function to func(services.IClientService) (controllers.ClientController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "client-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("client-middleware")
+				if err != nil {
+					var eo middlewares.Client
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("client-service")
+				if err != nil {
+					var eo middlewares.Client
+					return eo, err
+				}
+				p0, ok := pi0.(services.IClientService)
+				if !ok {
+					var eo middlewares.Client
+					return eo, errors.New("could not cast parameter 0 to services.IClientService")
+				}
+				b, ok := d.Build.(func(services.IClientService) (middlewares.Client, error))
+				if !ok {
+					var eo middlewares.Client
+					return eo, errors.New("could not cast build function to func(services.IClientService) (middlewares.Client, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "client-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("client-repository")
+				if err != nil {
+					var eo repositories.IClientRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IClientRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IClientRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IClientRepository, error))
+				if !ok {
+					var eo repositories.IClientRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IClientRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "client-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("client-service")
+				if err != nil {
+					var eo services.IClientService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("client-repository")
+				if err != nil {
+					var eo services.IClientService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IClientRepository)
+				if !ok {
+					var eo services.IClientService
+					return eo, errors.New("could not cast parameter 0 to repositories.IClientRepository")
+				}
+				pi1, err := ctn.SafeGet("auth-service")
+				if err != nil {
+					var eo services.IClientService
+					return eo, err
+				}
+				p1, ok := pi1.(services.IAuthService)
+				if !ok {
+					var eo services.IClientService
+					return eo, errors.New("could not cast parameter 1 to services.IAuthService")
+				}
+				pi2, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.IClientService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ICache)
+				if !ok {
+					var eo services.IClientService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ICache")
+				}
+				pi3, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IClientService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IClock)
+				if !ok {
+					var eo services.IClientService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IClock")
+				}
+				pi4, err := ctn.SafeGet("random")
+				if err != nil {
+					var eo services.IClientService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.IRandom)
+				if !ok {
+					var eo services.IClientService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.IRandom")
+				}
+				b, ok := d.Build.(func(repositories.IClientRepository, services.IAuthService, infrastructures.ICache, infrastructures.IClock, infrastructures.IRandom) (services.IClientService, error))
+				if !ok {
+					var eo services.IClientService
+					return eo, errors.New("could not cast build function to func(repositories.IClientRepository, services.IAuthService, infrastructures.ICache, infrastructures.IClock, infrastructures.IRandom) (services.IClientService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "clock",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("clock")
+				if err != nil {
+					var eo infrastructures.IClock
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IClock, error))
+				if !ok {
+					var eo infrastructures.IClock
+					return eo, errors.New("could not cast build function to func() (infrastructures.IClock, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "comment-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("comment-controller")
+				if err != nil {
+					var eo controllers.CommentController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("comment-service")
+				if err != nil {
+					var eo controllers.CommentController
+					return eo, err
+				}
+				p0, ok := pi0.(services.ICommentService)
+				if !ok {
+					var eo controllers.CommentController
+					return eo, errors.New("could not cast parameter 0 to services.ICommentService")
+				}
+				b, ok := d.Build.(func(services.ICommentService) (controllers.CommentController, error))
+				if !ok {
+					var eo controllers.CommentController
+					return eo, errors.New("could not cast build function to func(services.ICommentService) (controllers.CommentController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "comment-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("comment-repository")
+				if err != nil {
+					var eo repositories.ICommentRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.ICommentRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.ICommentRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				pi1, err := ctn.SafeGet("shards")
+				if err != nil {
+					var eo repositories.ICommentRepository
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IShards)
+				if !ok {
+					var eo repositories.ICommentRepository
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IShards")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.ICommentRepository, error))
+				if !ok {
+					var eo repositories.ICommentRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.ICommentRepository, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "comment-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("comment-service")
+				if err != nil {
+					var eo services.ICommentService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("comment-repository")
+				if err != nil {
+					var eo services.ICommentService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.ICommentRepository)
+				if !ok {
+					var eo services.ICommentService
+					return eo, errors.New("could not cast parameter 0 to repositories.ICommentRepository")
+				}
+				pi1, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.ICommentService
+					return eo, err
+				}
+				p1, ok := pi1.(services.ISettingService)
+				if !ok {
+					var eo services.ICommentService
+					return eo, errors.New("could not cast parameter 1 to services.ISettingService")
+				}
+				pi2, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.ICommentService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ILogger)
+				if !ok {
+					var eo services.ICommentService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ILogger")
+				}
+				pi3, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.ICommentService
+					return eo, err
+				}
+				p3, ok := pi3.(repositories.IUserRepository)
+				if !ok {
+					var eo services.ICommentService
+					return eo, errors.New("could not cast parameter 3 to repositories.IUserRepository")
+				}
+				pi4, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.ICommentService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.ICommentService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.IEventBus")
+				}
+				b, ok := d.Build.(func(repositories.ICommentRepository, services.ISettingService, infrastructures.ILogger, repositories.IUserRepository, infrastructures.IEventBus) (services.ICommentService, error))
+				if !ok {
+					var eo services.ICommentService
+					return eo, errors.New("could not cast build function to func(repositories.ICommentRepository, services.ISettingService, infrastructures.ILogger, repositories.IUserRepository, infrastructures.IEventBus) (services.ICommentService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "config-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("config-controller")
+				if err != nil {
+					var eo controllers.ConfigController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("config-service")
+				if err != nil {
+					var eo controllers.ConfigController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IConfigService)
+				if !ok {
+					var eo controllers.ConfigController
+					return eo, errors.New("could not cast parameter 0 to services.IConfigService")
+				}
+				b, ok := d.Build.(func(services.IConfigService) (controllers.ConfigController, error))
+				if !ok {
+					var eo controllers.ConfigController
+					return eo, errors.New("could not cast build function to func(services.IConfigService) (controllers.ConfigController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "config-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("config-service")
+				if err != nil {
+					var eo services.IConfigService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("config-snapshot-repository")
+				if err != nil {
+					var eo services.IConfigService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IConfigSnapshotRepository)
+				if !ok {
+					var eo services.IConfigService
+					return eo, errors.New("could not cast parameter 0 to repositories.IConfigSnapshotRepository")
+				}
+				pi1, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IConfigService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IConfigService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.ILogger")
+				}
+				pi2, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IConfigService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.IClock)
+				if !ok {
+					var eo services.IConfigService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(repositories.IConfigSnapshotRepository, infrastructures.ILogger, infrastructures.IClock) (services.IConfigService, error))
+				if !ok {
+					var eo services.IConfigService
+					return eo, errors.New("could not cast build function to func(repositories.IConfigSnapshotRepository, infrastructures.ILogger, infrastructures.IClock) (services.IConfigService, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "config-snapshot-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("config-snapshot-repository")
+				if err != nil {
+					var eo repositories.IConfigSnapshotRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IConfigSnapshotRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IConfigSnapshotRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IConfigSnapshotRepository, error))
+				if !ok {
+					var eo repositories.IConfigSnapshotRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IConfigSnapshotRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "consent-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("consent-controller")
+				if err != nil {
+					var eo controllers.ConsentController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("consent-service")
+				if err != nil {
+					var eo controllers.ConsentController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IConsentService)
+				if !ok {
+					var eo controllers.ConsentController
+					return eo, errors.New("could not cast parameter 0 to services.IConsentService")
+				}
+				b, ok := d.Build.(func(services.IConsentService) (controllers.ConsentController, error))
+				if !ok {
+					var eo controllers.ConsentController
+					return eo, errors.New("could not cast build function to func(services.IConsentService) (controllers.ConsentController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "consent-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("consent-middleware")
+				if err != nil {
+					var eo middlewares.Consent
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("consent-service")
+				if err != nil {
+					var eo middlewares.Consent
+					return eo, err
+				}
+				p0, ok := pi0.(services.IConsentService)
+				if !ok {
+					var eo middlewares.Consent
+					return eo, errors.New("could not cast parameter 0 to services.IConsentService")
+				}
+				b, ok := d.Build.(func(services.IConsentService) (middlewares.Consent, error))
+				if !ok {
+					var eo middlewares.Consent
+					return eo, errors.New("could not cast build function to func(services.IConsentService) (middlewares.Consent, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "consent-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("consent-service")
+				if err != nil {
+					var eo services.IConsentService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("policy-repository")
+				if err != nil {
+					var eo services.IConsentService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IPolicyRepository)
+				if !ok {
+					var eo services.IConsentService
+					return eo, errors.New("could not cast parameter 0 to repositories.IPolicyRepository")
+				}
+				b, ok := d.Build.(func(repositories.IPolicyRepository) (services.IConsentService, error))
+				if !ok {
+					var eo services.IConsentService
+					return eo, errors.New("could not cast build function to func(repositories.IPolicyRepository) (services.IConsentService, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "contract-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("contract-middleware")
+				if err != nil {
+					var eo middlewares.Contract
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("metrics")
+				if err != nil {
+					var eo middlewares.Contract
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IMetrics)
+				if !ok {
+					var eo middlewares.Contract
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IMetrics")
+				}
+				b, ok := d.Build.(func(infrastructures.IMetrics) (middlewares.Contract, error))
+				if !ok {
+					var eo middlewares.Contract
+					return eo, errors.New("could not cast build function to func(infrastructures.IMetrics) (middlewares.Contract, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "conversation-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("conversation-controller")
+				if err != nil {
+					var eo controllers.ConversationController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("conversation-service")
+				if err != nil {
+					var eo controllers.ConversationController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IConversationService)
+				if !ok {
+					var eo controllers.ConversationController
+					return eo, errors.New("could not cast parameter 0 to services.IConversationService")
+				}
+				b, ok := d.Build.(func(services.IConversationService) (controllers.ConversationController, error))
+				if !ok {
+					var eo controllers.ConversationController
+					return eo, errors.New("could not cast build function to func(services.IConversationService) (controllers.ConversationController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "conversation-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("conversation-repository")
+				if err != nil {
+					var eo repositories.IConversationRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IConversationRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IConversationRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IConversationRepository, error))
+				if !ok {
+					var eo repositories.IConversationRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IConversationRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "conversation-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("conversation-service")
+				if err != nil {
+					var eo services.IConversationService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("conversation-repository")
+				if err != nil {
+					var eo services.IConversationService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IConversationRepository)
+				if !ok {
+					var eo services.IConversationService
+					return eo, errors.New("could not cast parameter 0 to repositories.IConversationRepository")
+				}
+				pi1, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IConversationService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IConversationService
+					return eo, errors.New("could not cast parameter 1 to repositories.IUserRepository")
+				}
+				pi2, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.IConversationService
+					return eo, err
+				}
+				p2, ok := pi2.(services.ISettingService)
+				if !ok {
+					var eo services.IConversationService
+					return eo, errors.New("could not cast parameter 2 to services.ISettingService")
+				}
+				pi3, err := ctn.SafeGet("hub")
+				if err != nil {
+					var eo services.IConversationService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IHub)
+				if !ok {
+					var eo services.IConversationService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IHub")
+				}
+				pi4, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.IConversationService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.IConversationService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.IEventBus")
+				}
+				b, ok := d.Build.(func(repositories.IConversationRepository, repositories.IUserRepository, services.ISettingService, infrastructures.IHub, infrastructures.IEventBus) (services.IConversationService, error))
+				if !ok {
+					var eo services.IConversationService
+					return eo, errors.New("could not cast build function to func(repositories.IConversationRepository, repositories.IUserRepository, services.ISettingService, infrastructures.IHub, infrastructures.IEventBus) (services.IConversationService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "coupon-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("coupon-controller")
+				if err != nil {
+					var eo controllers.CouponController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("coupon-service")
+				if err != nil {
+					var eo controllers.CouponController
+					return eo, err
+				}
+				p0, ok := pi0.(services.ICouponService)
+				if !ok {
+					var eo controllers.CouponController
+					return eo, errors.New("could not cast parameter 0 to services.ICouponService")
+				}
+				b, ok := d.Build.(func(services.ICouponService) (controllers.CouponController, error))
+				if !ok {
+					var eo controllers.CouponController
+					return eo, errors.New("could not cast build function to func(services.ICouponService) (controllers.CouponController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "coupon-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("coupon-repository")
+				if err != nil {
+					var eo repositories.ICouponRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.ICouponRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.ICouponRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.ICouponRepository, error))
+				if !ok {
+					var eo repositories.ICouponRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.ICouponRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "coupon-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("coupon-service")
+				if err != nil {
+					var eo services.ICouponService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("coupon-repository")
+				if err != nil {
+					var eo services.ICouponService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.ICouponRepository)
+				if !ok {
+					var eo services.ICouponService
+					return eo, errors.New("could not cast parameter 0 to repositories.ICouponRepository")
+				}
+				pi1, err := ctn.SafeGet("payment-gateway")
+				if err != nil {
+					var eo services.ICouponService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IPaymentGateway)
+				if !ok {
+					var eo services.ICouponService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IPaymentGateway")
+				}
+				pi2, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.ICouponService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ILogger)
+				if !ok {
+					var eo services.ICouponService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ILogger")
+				}
+				pi3, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.ICouponService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IClock)
+				if !ok {
+					var eo services.ICouponService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(repositories.ICouponRepository, infrastructures.IPaymentGateway, infrastructures.ILogger, infrastructures.IClock) (services.ICouponService, error))
+				if !ok {
+					var eo services.ICouponService
+					return eo, errors.New("could not cast build function to func(repositories.ICouponRepository, infrastructures.IPaymentGateway, infrastructures.ILogger, infrastructures.IClock) (services.ICouponService, error)")
+				}
+				return b(p0, p1, p2, p3)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "data-export-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("data-export-repository")
+				if err != nil {
+					var eo repositories.IDataExportRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IDataExportRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IDataExportRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IDataExportRepository, error))
+				if !ok {
+					var eo repositories.IDataExportRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IDataExportRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "database-supervisor",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("database-supervisor")
+				if err != nil {
+					var eo infrastructures.IDatabaseSupervisor
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo infrastructures.IDatabaseSupervisor
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo infrastructures.IDatabaseSupervisor
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				pi1, err := ctn.SafeGet("metrics")
+				if err != nil {
+					var eo infrastructures.IDatabaseSupervisor
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IMetrics)
+				if !ok {
+					var eo infrastructures.IDatabaseSupervisor
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IMetrics")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase, infrastructures.IMetrics) (infrastructures.IDatabaseSupervisor, error))
+				if !ok {
+					var eo infrastructures.IDatabaseSupervisor
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase, infrastructures.IMetrics) (infrastructures.IDatabaseSupervisor, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "db",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("db")
+				if err != nil {
+					var eo infrastructures.IGormDatabase
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db-pool")
+				if err != nil {
+					var eo infrastructures.IGormDatabase
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabasePool)
+				if !ok {
+					var eo infrastructures.IGormDatabase
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabasePool")
+				}
+				pi1, err := ctn.SafeGet("gorm-logger")
+				if err != nil {
+					var eo infrastructures.IGormDatabase
+					return eo, err
+				}
+				p1, ok := pi1.(logger.Interface)
+				if !ok {
+					var eo infrastructures.IGormDatabase
+					return eo, errors.New("could not cast parameter 1 to logger.Interface")
+				}
+				pi2, err := ctn.SafeGet("n-plus-one-detector")
+				if err != nil {
+					var eo infrastructures.IGormDatabase
+					return eo, err
+				}
+				p2, ok := pi2.(gorm.Plugin)
+				if !ok {
+					var eo infrastructures.IGormDatabase
+					return eo, errors.New("could not cast parameter 2 to gorm.Plugin")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabasePool, logger.Interface, gorm.Plugin) (infrastructures.IGormDatabase, error))
+				if !ok {
+					var eo infrastructures.IGormDatabase
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabasePool, logger.Interface, gorm.Plugin) (infrastructures.IGormDatabase, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Close: func(obj interface{}) error {
+				d, err := provider.Get("db")
+				if err != nil {
+					return err
+				}
+				c, ok := d.Close.(func(infrastructures.IGormDatabase) error)
+				if !ok {
+					return errors.New("could not cast close function to 'func(infrastructures.IGormDatabase) error'")
+				}
+				o, ok := obj.(infrastructures.IGormDatabase)
+				if !ok {
+					return errors.New("could not cast object to 'infrastructures.IGormDatabase'")
+				}
+				return c(o)
+			},
+		},
+		{
+			Name:  "db-pool",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("db-pool")
+				if err != nil {
+					var eo infrastructures.IGormDatabasePool
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IGormDatabasePool, error))
+				if !ok {
+					var eo infrastructures.IGormDatabasePool
+					return eo, errors.New("could not cast build function to func() (infrastructures.IGormDatabasePool, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "db-retrier",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("db-retrier")
+				if err != nil {
+					var eo infrastructures.IDbRetrier
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("metrics")
+				if err != nil {
+					var eo infrastructures.IDbRetrier
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IMetrics)
+				if !ok {
+					var eo infrastructures.IDbRetrier
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IMetrics")
+				}
+				b, ok := d.Build.(func(infrastructures.IMetrics) (infrastructures.IDbRetrier, error))
+				if !ok {
+					var eo infrastructures.IDbRetrier
+					return eo, errors.New("could not cast build function to func(infrastructures.IMetrics) (infrastructures.IDbRetrier, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "dead-letter-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("dead-letter-controller")
+				if err != nil {
+					var eo controllers.DeadLetterController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("dead-letter-service")
+				if err != nil {
+					var eo controllers.DeadLetterController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IDeadLetterService)
+				if !ok {
+					var eo controllers.DeadLetterController
+					return eo, errors.New("could not cast parameter 0 to services.IDeadLetterService")
+				}
+				b, ok := d.Build.(func(services.IDeadLetterService) (controllers.DeadLetterController, error))
+				if !ok {
+					var eo controllers.DeadLetterController
+					return eo, errors.New("could not cast build function to func(services.IDeadLetterService) (controllers.DeadLetterController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "dead-letter-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("dead-letter-repository")
+				if err != nil {
+					var eo repositories.IDeadLetterRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IDeadLetterRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IDeadLetterRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IDeadLetterRepository, error))
+				if !ok {
+					var eo repositories.IDeadLetterRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IDeadLetterRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "dead-letter-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("dead-letter-service")
+				if err != nil {
+					var eo services.IDeadLetterService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("dead-letter-repository")
+				if err != nil {
+					var eo services.IDeadLetterService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IDeadLetterRepository)
+				if !ok {
+					var eo services.IDeadLetterService
+					return eo, errors.New("could not cast parameter 0 to repositories.IDeadLetterRepository")
+				}
+				pi1, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.IDeadLetterService
+					return eo, err
+				}
+				p1, ok := pi1.(services.ISettingService)
+				if !ok {
+					var eo services.IDeadLetterService
+					return eo, errors.New("could not cast parameter 1 to services.ISettingService")
+				}
+				pi2, err := ctn.SafeGet("queue")
+				if err != nil {
+					var eo services.IDeadLetterService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.IQueue)
+				if !ok {
+					var eo services.IDeadLetterService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.IQueue")
+				}
+				pi3, err := ctn.SafeGet("metrics")
+				if err != nil {
+					var eo services.IDeadLetterService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IMetrics)
+				if !ok {
+					var eo services.IDeadLetterService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IMetrics")
+				}
+				pi4, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IDeadLetterService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IDeadLetterService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(repositories.IDeadLetterRepository, services.ISettingService, infrastructures.IQueue, infrastructures.IMetrics, infrastructures.ILogger) (services.IDeadLetterService, error))
+				if !ok {
+					var eo services.IDeadLetterService
+					return eo, errors.New("could not cast build function to func(repositories.IDeadLetterRepository, services.ISettingService, infrastructures.IQueue, infrastructures.IMetrics, infrastructures.ILogger) (services.IDeadLetterService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "deprecation-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("deprecation-controller")
+				if err != nil {
+					var eo controllers.DeprecationController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("deprecation-service")
+				if err != nil {
+					var eo controllers.DeprecationController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IDeprecationService)
+				if !ok {
+					var eo controllers.DeprecationController
+					return eo, errors.New("could not cast parameter 0 to services.IDeprecationService")
+				}
+				b, ok := d.Build.(func(services.IDeprecationService) (controllers.DeprecationController, error))
+				if !ok {
+					var eo controllers.DeprecationController
+					return eo, errors.New("could not cast build function to func(services.IDeprecationService) (controllers.DeprecationController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "deprecation-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("deprecation-middleware")
+				if err != nil {
+					var eo middlewares.Deprecation
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("deprecation-service")
+				if err != nil {
+					var eo middlewares.Deprecation
+					return eo, err
+				}
+				p0, ok := pi0.(services.IDeprecationService)
+				if !ok {
+					var eo middlewares.Deprecation
+					return eo, errors.New("could not cast parameter 0 to services.IDeprecationService")
+				}
+				b, ok := d.Build.(func(services.IDeprecationService) (middlewares.Deprecation, error))
+				if !ok {
+					var eo middlewares.Deprecation
+					return eo, errors.New("could not cast build function to func(services.IDeprecationService) (middlewares.Deprecation, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "deprecation-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("deprecation-repository")
+				if err != nil {
+					var eo repositories.IDeprecationRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IDeprecationRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IDeprecationRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IDeprecationRepository, error))
+				if !ok {
+					var eo repositories.IDeprecationRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IDeprecationRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "deprecation-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("deprecation-service")
+				if err != nil {
+					var eo services.IDeprecationService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("deprecation-repository")
+				if err != nil {
+					var eo services.IDeprecationService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IDeprecationRepository)
+				if !ok {
+					var eo services.IDeprecationService
+					return eo, errors.New("could not cast parameter 0 to repositories.IDeprecationRepository")
+				}
+				pi1, err := ctn.SafeGet("metrics")
+				if err != nil {
+					var eo services.IDeprecationService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IMetrics)
+				if !ok {
+					var eo services.IDeprecationService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IMetrics")
+				}
+				pi2, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IDeprecationService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IDeprecationService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(repositories.IDeprecationRepository, infrastructures.IMetrics, infrastructures.ILogger) (services.IDeprecationService, error))
+				if !ok {
+					var eo services.IDeprecationService
+					return eo, errors.New("could not cast build function to func(repositories.IDeprecationRepository, infrastructures.IMetrics, infrastructures.ILogger) (services.IDeprecationService, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "device-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("device-repository")
+				if err != nil {
+					var eo repositories.IDeviceRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IDeviceRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IDeviceRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IDeviceRepository, error))
+				if !ok {
+					var eo repositories.IDeviceRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IDeviceRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "email",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("email")
+				if err != nil {
+					var eo infrastructures.IEmailService
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IEmailService, error))
+				if !ok {
+					var eo infrastructures.IEmailService
+					return eo, errors.New("could not cast build function to func() (infrastructures.IEmailService, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "email-change-confirmation-mail",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("email-change-confirmation-mail")
+				if err != nil {
+					var eo mails.IMailRenderer
+					return eo, err
+				}
+				b, ok := d.Build.(func() (mails.IMailRenderer, error))
+				if !ok {
+					var eo mails.IMailRenderer
+					return eo, errors.New("could not cast build function to func() (mails.IMailRenderer, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "email-change-notice-mail",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("email-change-notice-mail")
+				if err != nil {
+					var eo mails.IMailRenderer
+					return eo, err
+				}
+				b, ok := d.Build.(func() (mails.IMailRenderer, error))
+				if !ok {
+					var eo mails.IMailRenderer
+					return eo, errors.New("could not cast build function to func() (mails.IMailRenderer, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "email-change-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("email-change-repository")
+				if err != nil {
+					var eo repositories.IEmailChangeRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IEmailChangeRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IEmailChangeRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IEmailChangeRepository, error))
+				if !ok {
+					var eo repositories.IEmailChangeRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IEmailChangeRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "email-change-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("email-change-service")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+				}
+				pi1, err := ctn.SafeGet("email-change-repository")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IEmailChangeRepository)
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast parameter 1 to repositories.IEmailChangeRepository")
+				}
+				pi2, err := ctn.SafeGet("unit-of-work")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				p2, ok := pi2.(transactions.IUnitOfWork)
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast parameter 2 to transactions.IUnitOfWork")
+				}
+				pi3, err := ctn.SafeGet("email")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IEmailService)
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IEmailService")
+				}
+				pi4, err := ctn.SafeGet("email-change-confirmation-mail")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				p4, ok := pi4.(mails.IMailRenderer)
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast parameter 4 to mails.IMailRenderer")
+				}
+				pi5, err := ctn.SafeGet("email-change-notice-mail")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				p5, ok := pi5.(mails.IMailRenderer)
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast parameter 5 to mails.IMailRenderer")
+				}
+				pi6, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				p6, ok := pi6.(infrastructures.ICache)
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast parameter 6 to infrastructures.ICache")
+				}
+				pi7, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				p7, ok := pi7.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast parameter 7 to infrastructures.ILogger")
+				}
+				pi8, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				p8, ok := pi8.(infrastructures.IClock)
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast parameter 8 to infrastructures.IClock")
+				}
+				pi9, err := ctn.SafeGet("random")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				p9, ok := pi9.(infrastructures.IRandom)
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast parameter 9 to infrastructures.IRandom")
+				}
+				pi10, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.IEmailChangeService
+					return eo, err
+				}
+				p10, ok := pi10.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast parameter 10 to infrastructures.IEventBus")
+				}
+				b, ok := d.Build.(func(repositories.IUserRepository, repositories.IEmailChangeRepository, transactions.IUnitOfWork, infrastructures.IEmailService, mails.IMailRenderer, mails.IMailRenderer, infrastructures.ICache, infrastructures.ILogger, infrastructures.IClock, infrastructures.IRandom, infrastructures.IEventBus) (services.IEmailChangeService, error))
+				if !ok {
+					var eo services.IEmailChangeService
+					return eo, errors.New("could not cast build function to func(repositories.IUserRepository, repositories.IEmailChangeRepository, transactions.IUnitOfWork, infrastructures.IEmailService, mails.IMailRenderer, mails.IMailRenderer, infrastructures.ICache, infrastructures.ILogger, infrastructures.IClock, infrastructures.IRandom, infrastructures.IEventBus) (services.IEmailChangeService, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, p10)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "error-reporter",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("error-reporter")
+				if err != nil {
+					var eo infrastructures.IErrorReporter
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("http-client-factory")
+				if err != nil {
+					var eo infrastructures.IErrorReporter
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IHttpClientFactory)
+				if !ok {
+					var eo infrastructures.IErrorReporter
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IHttpClientFactory")
+				}
+				pi1, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo infrastructures.IErrorReporter
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.ILogger)
+				if !ok {
+					var eo infrastructures.IErrorReporter
+					return eo, errors.New("could not cast parameter 1 to infrastructures.ILogger")
+				}
+				pi2, err := ctn.SafeGet("breadcrumbs")
+				if err != nil {
+					var eo infrastructures.IErrorReporter
+					return eo, err
+				}
+				p2, ok := pi2.(*infrastructures.Breadcrumbs)
+				if !ok {
+					var eo infrastructures.IErrorReporter
+					return eo, errors.New("could not cast parameter 2 to *infrastructures.Breadcrumbs")
+				}
+				b, ok := d.Build.(func(infrastructures.IHttpClientFactory, infrastructures.ILogger, *infrastructures.Breadcrumbs) (infrastructures.IErrorReporter, error))
+				if !ok {
+					var eo infrastructures.IErrorReporter
+					return eo, errors.New("could not cast build function to func(infrastructures.IHttpClientFactory, infrastructures.ILogger, *infrastructures.Breadcrumbs) (infrastructures.IErrorReporter, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Close: func(obj interface{}) error {
+				d, err := provider.Get("error-reporter")
+				if err != nil {
+					return err
+				}
+				c, ok := d.Close.(func(infrastructures.IErrorReporter) error)
+				if !ok {
+					return errors.New("could not cast close function to 'func(infrastructures.IErrorReporter) error'")
+				}
+				o, ok := obj.(infrastructures.IErrorReporter)
+				if !ok {
+					return errors.New("could not cast object to 'infrastructures.IErrorReporter'")
+				}
+				return c(o)
+			},
+		},
+		{
+			Name:  "events",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("events")
+				if err != nil {
+					var eo infrastructures.IEventBus
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IEventBus, error))
+				if !ok {
+					var eo infrastructures.IEventBus
+					return eo, errors.New("could not cast build function to func() (infrastructures.IEventBus, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				d, err := provider.Get("events")
+				if err != nil {
+					return err
+				}
+				c, ok := d.Close.(func(infrastructures.IEventBus) error)
+				if !ok {
+					return errors.New("could not cast close function to 'func(infrastructures.IEventBus) error'")
+				}
+				o, ok := obj.(infrastructures.IEventBus)
+				if !ok {
+					return errors.New("could not cast object to 'infrastructures.IEventBus'")
+				}
+				return c(o)
+			},
+		},
+		{
+			Name:  "queue",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("queue")
+				if err != nil {
+					var eo infrastructures.IQueue
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IQueue, error))
+				if !ok {
+					var eo infrastructures.IQueue
+					return eo, errors.New("could not cast build function to func() (infrastructures.IQueue, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				d, err := provider.Get("queue")
+				if err != nil {
+					return err
+				}
+				c, ok := d.Close.(func(infrastructures.IQueue) error)
+				if !ok {
+					return errors.New("could not cast close function to 'func(infrastructures.IQueue) error'")
+				}
+				o, ok := obj.(infrastructures.IQueue)
+				if !ok {
+					return errors.New("could not cast object to 'infrastructures.IQueue'")
+				}
+				return c(o)
+			},
+		},
+		{
+			Name:  "replay-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("replay-middleware")
+				if err != nil {
+					var eo middlewares.Replay
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo middlewares.Replay
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.ICache)
+				if !ok {
+					var eo middlewares.Replay
+					return eo, errors.New("could not cast parameter 0 to infrastructures.ICache")
+				}
+				pi1, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo middlewares.Replay
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IClock)
+				if !ok {
+					var eo middlewares.Replay
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(infrastructures.ICache, infrastructures.IClock) (middlewares.Replay, error))
+				if !ok {
+					var eo middlewares.Replay
+					return eo, errors.New("could not cast build function to func(infrastructures.ICache, infrastructures.IClock) (middlewares.Replay, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "retention-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("retention-repository")
+				if err != nil {
+					var eo repositories.IRetentionRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IRetentionRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IRetentionRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IRetentionRepository, error))
+				if !ok {
+					var eo repositories.IRetentionRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IRetentionRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "retention-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("retention-service")
+				if err != nil {
+					var eo services.IRetentionService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("retention-repository")
+				if err != nil {
+					var eo services.IRetentionService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IRetentionRepository)
+				if !ok {
+					var eo services.IRetentionService
+					return eo, errors.New("could not cast parameter 0 to repositories.IRetentionRepository")
+				}
+				pi1, err := ctn.SafeGet("storage")
+				if err != nil {
+					var eo services.IRetentionService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IStorageService)
+				if !ok {
+					var eo services.IRetentionService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IStorageService")
+				}
+				pi2, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IRetentionService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.IClock)
+				if !ok {
+					var eo services.IRetentionService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.IClock")
+				}
+				pi3, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IRetentionService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IRetentionService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.ILogger")
+				}
+				pi4, err := ctn.SafeGet("metrics")
+				if err != nil {
+					var eo services.IRetentionService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.IMetrics)
+				if !ok {
+					var eo services.IRetentionService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.IMetrics")
+				}
+				b, ok := d.Build.(func(repositories.IRetentionRepository, infrastructures.IStorageService, infrastructures.IClock, infrastructures.ILogger, infrastructures.IMetrics) (services.IRetentionService, error))
+				if !ok {
+					var eo services.IRetentionService
+					return eo, errors.New("could not cast build function to func(repositories.IRetentionRepository, infrastructures.IStorageService, infrastructures.IClock, infrastructures.ILogger, infrastructures.IMetrics) (services.IRetentionService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "saga-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("saga-repository")
+				if err != nil {
+					var eo repositories.ISagaRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.ISagaRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.ISagaRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.ISagaRepository, error))
+				if !ok {
+					var eo repositories.ISagaRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.ISagaRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "saga-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("saga-service")
+				if err != nil {
+					var eo services.ISagaService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("saga-repository")
+				if err != nil {
+					var eo services.ISagaService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.ISagaRepository)
+				if !ok {
+					var eo services.ISagaService
+					return eo, errors.New("could not cast parameter 0 to repositories.ISagaRepository")
+				}
+				pi1, err := ctn.SafeGet("unit-of-work")
+				if err != nil {
+					var eo services.ISagaService
+					return eo, err
+				}
+				p1, ok := pi1.(transactions.IUnitOfWork)
+				if !ok {
+					var eo services.ISagaService
+					return eo, errors.New("could not cast parameter 1 to transactions.IUnitOfWork")
+				}
+				pi2, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.ISagaService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ILogger)
+				if !ok {
+					var eo services.ISagaService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ILogger")
+				}
+				pi3, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.ISagaService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IClock)
+				if !ok {
+					var eo services.ISagaService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(repositories.ISagaRepository, transactions.IUnitOfWork, infrastructures.ILogger, infrastructures.IClock) (services.ISagaService, error))
+				if !ok {
+					var eo services.ISagaService
+					return eo, errors.New("could not cast build function to func(repositories.ISagaRepository, transactions.IUnitOfWork, infrastructures.ILogger, infrastructures.IClock) (services.ISagaService, error)")
+				}
+				return b(p0, p1, p2, p3)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "shards",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("shards")
+				if err != nil {
+					var eo infrastructures.IShards
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo infrastructures.IShards
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo infrastructures.IShards
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				pi1, err := ctn.SafeGet("gorm-logger")
+				if err != nil {
+					var eo infrastructures.IShards
+					return eo, err
+				}
+				p1, ok := pi1.(logger.Interface)
+				if !ok {
+					var eo infrastructures.IShards
+					return eo, errors.New("could not cast parameter 1 to logger.Interface")
+				}
+				pi2, err := ctn.SafeGet("n-plus-one-detector")
+				if err != nil {
+					var eo infrastructures.IShards
+					return eo, err
+				}
+				p2, ok := pi2.(gorm.Plugin)
+				if !ok {
+					var eo infrastructures.IShards
+					return eo, errors.New("could not cast parameter 2 to gorm.Plugin")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase, logger.Interface, gorm.Plugin) (infrastructures.IShards, error))
+				if !ok {
+					var eo infrastructures.IShards
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase, logger.Interface, gorm.Plugin) (infrastructures.IShards, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Close: func(obj interface{}) error {
+				d, err := provider.Get("shards")
+				if err != nil {
+					return err
+				}
+				c, ok := d.Close.(func(infrastructures.IShards) error)
+				if !ok {
+					return errors.New("could not cast close function to 'func(infrastructures.IShards) error'")
+				}
+				o, ok := obj.(infrastructures.IShards)
+				if !ok {
+					return errors.New("could not cast object to 'infrastructures.IShards'")
+				}
+				return c(o)
+			},
+		},
+		{
+			Name:  "feature-flags",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("feature-flags")
+				if err != nil {
+					var eo infrastructures.IFeatureFlags
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IFeatureFlags, error))
+				if !ok {
+					var eo infrastructures.IFeatureFlags
+					return eo, errors.New("could not cast build function to func() (infrastructures.IFeatureFlags, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "features-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("features-middleware")
+				if err != nil {
+					var eo middlewares.Features
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("feature-flags")
+				if err != nil {
+					var eo middlewares.Features
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IFeatureFlags)
+				if !ok {
+					var eo middlewares.Features
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IFeatureFlags")
+				}
+				b, ok := d.Build.(func(infrastructures.IFeatureFlags) (middlewares.Features, error))
+				if !ok {
+					var eo middlewares.Features
+					return eo, errors.New("could not cast build function to func(infrastructures.IFeatureFlags) (middlewares.Features, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "follow-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("follow-controller")
+				if err != nil {
+					var eo controllers.FollowController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("follow-service")
+				if err != nil {
+					var eo controllers.FollowController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IFollowService)
+				if !ok {
+					var eo controllers.FollowController
+					return eo, errors.New("could not cast parameter 0 to services.IFollowService")
+				}
+				b, ok := d.Build.(func(services.IFollowService) (controllers.FollowController, error))
+				if !ok {
+					var eo controllers.FollowController
+					return eo, errors.New("could not cast build function to func(services.IFollowService) (controllers.FollowController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "follow-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("follow-repository")
+				if err != nil {
+					var eo repositories.IFollowRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IFollowRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IFollowRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IFollowRepository, error))
+				if !ok {
+					var eo repositories.IFollowRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IFollowRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "follow-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("follow-service")
+				if err != nil {
+					var eo services.IFollowService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("follow-repository")
+				if err != nil {
+					var eo services.IFollowService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IFollowRepository)
+				if !ok {
+					var eo services.IFollowService
+					return eo, errors.New("could not cast parameter 0 to repositories.IFollowRepository")
+				}
+				pi1, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IFollowService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IFollowService
+					return eo, errors.New("could not cast parameter 1 to repositories.IUserRepository")
+				}
+				pi2, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.IFollowService
+					return eo, err
+				}
+				p2, ok := pi2.(services.ISettingService)
+				if !ok {
+					var eo services.IFollowService
+					return eo, errors.New("could not cast parameter 2 to services.ISettingService")
+				}
+				pi3, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.IFollowService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.IFollowService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IEventBus")
+				}
+				b, ok := d.Build.(func(repositories.IFollowRepository, repositories.IUserRepository, services.ISettingService, infrastructures.IEventBus) (services.IFollowService, error))
+				if !ok {
+					var eo services.IFollowService
+					return eo, errors.New("could not cast build function to func(repositories.IFollowRepository, repositories.IUserRepository, services.ISettingService, infrastructures.IEventBus) (services.IFollowService, error)")
+				}
+				return b(p0, p1, p2, p3)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "gorm-logger",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("gorm-logger")
+				if err != nil {
+					var eo logger.Interface
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo logger.Interface
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.ILogger)
+				if !ok {
+					var eo logger.Interface
+					return eo, errors.New("could not cast parameter 0 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(infrastructures.ILogger) (logger.Interface, error))
+				if !ok {
+					var eo logger.Interface
+					return eo, errors.New("could not cast build function to func(infrastructures.ILogger) (logger.Interface, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "http-client-factory",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("http-client-factory")
+				if err != nil {
+					var eo infrastructures.IHttpClientFactory
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("metrics")
+				if err != nil {
+					var eo infrastructures.IHttpClientFactory
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IMetrics)
+				if !ok {
+					var eo infrastructures.IHttpClientFactory
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IMetrics")
+				}
+				b, ok := d.Build.(func(infrastructures.IMetrics) (infrastructures.IHttpClientFactory, error))
+				if !ok {
+					var eo infrastructures.IHttpClientFactory
+					return eo, errors.New("could not cast build function to func(infrastructures.IMetrics) (infrastructures.IHttpClientFactory, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "hub",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("hub")
+				if err != nil {
+					var eo infrastructures.IHub
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IHub, error))
+				if !ok {
+					var eo infrastructures.IHub
+					return eo, errors.New("could not cast build function to func() (infrastructures.IHub, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				d, err := provider.Get("hub")
+				if err != nil {
+					return err
+				}
+				c, ok := d.Close.(func(infrastructures.IHub) error)
+				if !ok {
+					return errors.New("could not cast close function to 'func(infrastructures.IHub) error'")
+				}
+				o, ok := obj.(infrastructures.IHub)
+				if !ok {
+					return errors.New("could not cast object to 'infrastructures.IHub'")
+				}
+				return c(o)
+			},
+		},
+		{
+			Name:  "impersonation-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("impersonation-controller")
+				if err != nil {
+					var eo controllers.ImpersonationController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("impersonation-service")
+				if err != nil {
+					var eo controllers.ImpersonationController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IImpersonationService)
+				if !ok {
+					var eo controllers.ImpersonationController
+					return eo, errors.New("could not cast parameter 0 to services.IImpersonationService")
+				}
+				b, ok := d.Build.(func(services.IImpersonationService) (controllers.ImpersonationController, error))
+				if !ok {
+					var eo controllers.ImpersonationController
+					return eo, errors.New("could not cast build function to func(services.IImpersonationService) (controllers.ImpersonationController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "impersonation-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("impersonation-service")
+				if err != nil {
+					var eo services.IImpersonationService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IImpersonationService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IImpersonationService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+				}
+				pi1, err := ctn.SafeGet("auth-service")
+				if err != nil {
+					var eo services.IImpersonationService
+					return eo, err
+				}
+				p1, ok := pi1.(services.IAuthService)
+				if !ok {
+					var eo services.IImpersonationService
+					return eo, errors.New("could not cast parameter 1 to services.IAuthService")
+				}
+				pi2, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IImpersonationService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IImpersonationService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(repositories.IUserRepository, services.IAuthService, infrastructures.ILogger) (services.IImpersonationService, error))
+				if !ok {
+					var eo services.IImpersonationService
+					return eo, errors.New("could not cast build function to func(repositories.IUserRepository, services.IAuthService, infrastructures.ILogger) (services.IImpersonationService, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "internal-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("internal-controller")
+				if err != nil {
+					var eo controllers.InternalController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-service")
+				if err != nil {
+					var eo controllers.InternalController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IUserService)
+				if !ok {
+					var eo controllers.InternalController
+					return eo, errors.New("could not cast parameter 0 to services.IUserService")
+				}
+				b, ok := d.Build.(func(services.IUserService) (controllers.InternalController, error))
+				if !ok {
+					var eo controllers.InternalController
+					return eo, errors.New("could not cast build function to func(services.IUserService) (controllers.InternalController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "invitation-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("invitation-controller")
+				if err != nil {
+					var eo controllers.InvitationController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("invitation-service")
+				if err != nil {
+					var eo controllers.InvitationController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IInvitationService)
+				if !ok {
+					var eo controllers.InvitationController
+					return eo, errors.New("could not cast parameter 0 to services.IInvitationService")
+				}
+				b, ok := d.Build.(func(services.IInvitationService) (controllers.InvitationController, error))
+				if !ok {
+					var eo controllers.InvitationController
+					return eo, errors.New("could not cast build function to func(services.IInvitationService) (controllers.InvitationController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "invitation-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("invitation-repository")
+				if err != nil {
+					var eo repositories.IInvitationRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IInvitationRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IInvitationRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IInvitationRepository, error))
+				if !ok {
+					var eo repositories.IInvitationRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IInvitationRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "invitation-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("invitation-service")
+				if err != nil {
+					var eo services.IInvitationService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("invitation-repository")
+				if err != nil {
+					var eo services.IInvitationService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IInvitationRepository)
+				if !ok {
+					var eo services.IInvitationService
+					return eo, errors.New("could not cast parameter 0 to repositories.IInvitationRepository")
+				}
+				pi1, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IInvitationService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IClock)
+				if !ok {
+					var eo services.IInvitationService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IClock")
+				}
+				pi2, err := ctn.SafeGet("random")
+				if err != nil {
+					var eo services.IInvitationService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.IRandom)
+				if !ok {
+					var eo services.IInvitationService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.IRandom")
+				}
+				b, ok := d.Build.(func(repositories.IInvitationRepository, infrastructures.IClock, infrastructures.IRandom) (services.IInvitationService, error))
+				if !ok {
+					var eo services.IInvitationService
+					return eo, errors.New("could not cast build function to func(repositories.IInvitationRepository, infrastructures.IClock, infrastructures.IRandom) (services.IInvitationService, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "invoice-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("invoice-service")
+				if err != nil {
+					var eo services.IInvoiceService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("billing-repository")
+				if err != nil {
+					var eo services.IInvoiceService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IBillingRepository)
+				if !ok {
+					var eo services.IInvoiceService
+					return eo, errors.New("could not cast parameter 0 to repositories.IBillingRepository")
+				}
+				pi1, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IInvoiceService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IInvoiceService
+					return eo, errors.New("could not cast parameter 1 to repositories.IUserRepository")
+				}
+				pi2, err := ctn.SafeGet("pdf")
+				if err != nil {
+					var eo services.IInvoiceService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.IPdfService)
+				if !ok {
+					var eo services.IInvoiceService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.IPdfService")
+				}
+				pi3, err := ctn.SafeGet("storage")
+				if err != nil {
+					var eo services.IInvoiceService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IStorageService)
+				if !ok {
+					var eo services.IInvoiceService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IStorageService")
+				}
+				pi4, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IInvoiceService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IInvoiceService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.ILogger")
+				}
+				pi5, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IInvoiceService
+					return eo, err
+				}
+				p5, ok := pi5.(infrastructures.IClock)
+				if !ok {
+					var eo services.IInvoiceService
+					return eo, errors.New("could not cast parameter 5 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(repositories.IBillingRepository, repositories.IUserRepository, infrastructures.IPdfService, infrastructures.IStorageService, infrastructures.ILogger, infrastructures.IClock) (services.IInvoiceService, error))
+				if !ok {
+					var eo services.IInvoiceService
+					return eo, errors.New("could not cast build function to func(repositories.IBillingRepository, repositories.IUserRepository, infrastructures.IPdfService, infrastructures.IStorageService, infrastructures.ILogger, infrastructures.IClock) (services.IInvoiceService, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "is-admin-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("is-admin-middleware")
+				if err != nil {
+					var eo middlewares.IsAdmin
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-service")
+				if err != nil {
+					var eo middlewares.IsAdmin
+					return eo, err
+				}
+				p0, ok := pi0.(services.IUserService)
+				if !ok {
+					var eo middlewares.IsAdmin
+					return eo, errors.New("could not cast parameter 0 to services.IUserService")
+				}
+				b, ok := d.Build.(func(services.IUserService) (middlewares.IsAdmin, error))
+				if !ok {
+					var eo middlewares.IsAdmin
+					return eo, errors.New("could not cast build function to func(services.IUserService) (middlewares.IsAdmin, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "is-verified-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("is-verified-middleware")
+				if err != nil {
+					var eo middlewares.IsVerified
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-service")
+				if err != nil {
+					var eo middlewares.IsVerified
+					return eo, err
+				}
+				p0, ok := pi0.(services.IUserService)
+				if !ok {
+					var eo middlewares.IsVerified
+					return eo, errors.New("could not cast parameter 0 to services.IUserService")
+				}
+				b, ok := d.Build.(func(services.IUserService) (middlewares.IsVerified, error))
+				if !ok {
+					var eo middlewares.IsVerified
+					return eo, errors.New("could not cast build function to func(services.IUserService) (middlewares.IsVerified, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "log-levels",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("log-levels")
+				if err != nil {
+					var eo *infrastructures.LogLevels
+					return eo, err
+				}
+				b, ok := d.Build.(func() (*infrastructures.LogLevels, error))
+				if !ok {
+					var eo *infrastructures.LogLevels
+					return eo, errors.New("could not cast build function to func() (*infrastructures.LogLevels, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "logger",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("logger")
+				if err != nil {
+					var eo infrastructures.ILogger
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("breadcrumbs")
+				if err != nil {
+					var eo infrastructures.ILogger
+					return eo, err
+				}
+				p0, ok := pi0.(*infrastructures.Breadcrumbs)
+				if !ok {
+					var eo infrastructures.ILogger
+					return eo, errors.New("could not cast parameter 0 to *infrastructures.Breadcrumbs")
+				}
+				pi1, err := ctn.SafeGet("log-levels")
+				if err != nil {
+					var eo infrastructures.ILogger
+					return eo, err
+				}
+				p1, ok := pi1.(*infrastructures.LogLevels)
+				if !ok {
+					var eo infrastructures.ILogger
+					return eo, errors.New("could not cast parameter 1 to *infrastructures.LogLevels")
+				}
+				b, ok := d.Build.(func(*infrastructures.Breadcrumbs, *infrastructures.LogLevels) (infrastructures.ILogger, error))
+				if !ok {
+					var eo infrastructures.ILogger
+					return eo, errors.New("could not cast build function to func(*infrastructures.Breadcrumbs, *infrastructures.LogLevels) (infrastructures.ILogger, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "logging-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("logging-controller")
+				if err != nil {
+					var eo controllers.LoggingController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("log-levels")
+				if err != nil {
+					var eo controllers.LoggingController
+					return eo, err
+				}
+				p0, ok := pi0.(*infrastructures.LogLevels)
+				if !ok {
+					var eo controllers.LoggingController
+					return eo, errors.New("could not cast parameter 0 to *infrastructures.LogLevels")
+				}
+				pi1, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo controllers.LoggingController
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.ILogger)
+				if !ok {
+					var eo controllers.LoggingController
+					return eo, errors.New("could not cast parameter 1 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(*infrastructures.LogLevels, infrastructures.ILogger) (controllers.LoggingController, error))
+				if !ok {
+					var eo controllers.LoggingController
+					return eo, errors.New("could not cast build function to func(*infrastructures.LogLevels, infrastructures.ILogger) (controllers.LoggingController, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "magic-link-mail",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("magic-link-mail")
+				if err != nil {
+					var eo mails.IMailRenderer
+					return eo, err
+				}
+				b, ok := d.Build.(func() (mails.IMailRenderer, error))
+				if !ok {
+					var eo mails.IMailRenderer
+					return eo, errors.New("could not cast build function to func() (mails.IMailRenderer, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "magic-link-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("magic-link-repository")
+				if err != nil {
+					var eo repositories.IMagicLinkRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IMagicLinkRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IMagicLinkRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IMagicLinkRepository, error))
+				if !ok {
+					var eo repositories.IMagicLinkRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IMagicLinkRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "magic-link-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("magic-link-service")
+				if err != nil {
+					var eo services.IMagicLinkService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IMagicLinkService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IMagicLinkService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+				}
+				pi1, err := ctn.SafeGet("magic-link-repository")
+				if err != nil {
+					var eo services.IMagicLinkService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IMagicLinkRepository)
+				if !ok {
+					var eo services.IMagicLinkService
+					return eo, errors.New("could not cast parameter 1 to repositories.IMagicLinkRepository")
+				}
+				pi2, err := ctn.SafeGet("unit-of-work")
+				if err != nil {
+					var eo services.IMagicLinkService
+					return eo, err
+				}
+				p2, ok := pi2.(transactions.IUnitOfWork)
+				if !ok {
+					var eo services.IMagicLinkService
+					return eo, errors.New("could not cast parameter 2 to transactions.IUnitOfWork")
+				}
+				pi3, err := ctn.SafeGet("email")
+				if err != nil {
+					var eo services.IMagicLinkService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IEmailService)
+				if !ok {
+					var eo services.IMagicLinkService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IEmailService")
+				}
+				pi4, err := ctn.SafeGet("magic-link-mail")
+				if err != nil {
+					var eo services.IMagicLinkService
+					return eo, err
+				}
+				p4, ok := pi4.(mails.IMailRenderer)
+				if !ok {
+					var eo services.IMagicLinkService
+					return eo, errors.New("could not cast parameter 4 to mails.IMailRenderer")
+				}
+				pi5, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.IMagicLinkService
+					return eo, err
+				}
+				p5, ok := pi5.(infrastructures.ICache)
+				if !ok {
+					var eo services.IMagicLinkService
+					return eo, errors.New("could not cast parameter 5 to infrastructures.ICache")
+				}
+				pi6, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IMagicLinkService
+					return eo, err
+				}
+				p6, ok := pi6.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IMagicLinkService
+					return eo, errors.New("could not cast parameter 6 to infrastructures.ILogger")
+				}
+				pi7, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IMagicLinkService
+					return eo, err
+				}
+				p7, ok := pi7.(infrastructures.IClock)
+				if !ok {
+					var eo services.IMagicLinkService
+					return eo, errors.New("could not cast parameter 7 to infrastructures.IClock")
+				}
+				pi8, err := ctn.SafeGet("random")
+				if err != nil {
+					var eo services.IMagicLinkService
+					return eo, err
+				}
+				p8, ok := pi8.(infrastructures.IRandom)
+				if !ok {
+					var eo services.IMagicLinkService
+					return eo, errors.New("could not cast parameter 8 to infrastructures.IRandom")
+				}
+				b, ok := d.Build.(func(repositories.IUserRepository, repositories.IMagicLinkRepository, transactions.IUnitOfWork, infrastructures.IEmailService, mails.IMailRenderer, infrastructures.ICache, infrastructures.ILogger, infrastructures.IClock, infrastructures.IRandom) (services.IMagicLinkService, error))
+				if !ok {
+					var eo services.IMagicLinkService
+					return eo, errors.New("could not cast build function to func(repositories.IUserRepository, repositories.IMagicLinkRepository, transactions.IUnitOfWork, infrastructures.IEmailService, mails.IMailRenderer, infrastructures.ICache, infrastructures.ILogger, infrastructures.IClock, infrastructures.IRandom) (services.IMagicLinkService, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "media-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("media-controller")
+				if err != nil {
+					var eo controllers.MediaController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("media-service")
+				if err != nil {
+					var eo controllers.MediaController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IMediaService)
+				if !ok {
+					var eo controllers.MediaController
+					return eo, errors.New("could not cast parameter 0 to services.IMediaService")
+				}
+				b, ok := d.Build.(func(services.IMediaService) (controllers.MediaController, error))
+				if !ok {
+					var eo controllers.MediaController
+					return eo, errors.New("could not cast build function to func(services.IMediaService) (controllers.MediaController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "media-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("media-repository")
+				if err != nil {
+					var eo repositories.IMediaRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IMediaRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IMediaRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				pi1, err := ctn.SafeGet("shards")
+				if err != nil {
+					var eo repositories.IMediaRepository
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IShards)
+				if !ok {
+					var eo repositories.IMediaRepository
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IShards")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.IMediaRepository, error))
+				if !ok {
+					var eo repositories.IMediaRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.IMediaRepository, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "media-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("media-service")
+				if err != nil {
+					var eo services.IMediaService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("media-repository")
+				if err != nil {
+					var eo services.IMediaService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IMediaRepository)
+				if !ok {
+					var eo services.IMediaService
+					return eo, errors.New("could not cast parameter 0 to repositories.IMediaRepository")
+				}
+				pi1, err := ctn.SafeGet("storage")
+				if err != nil {
+					var eo services.IMediaService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IStorageService)
+				if !ok {
+					var eo services.IMediaService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IStorageService")
+				}
+				pi2, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.IMediaService
+					return eo, err
+				}
+				p2, ok := pi2.(services.ISettingService)
+				if !ok {
+					var eo services.IMediaService
+					return eo, errors.New("could not cast parameter 2 to services.ISettingService")
+				}
+				pi3, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IMediaService
+					return eo, err
+				}
+				p3, ok := pi3.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IMediaService
+					return eo, errors.New("could not cast parameter 3 to repositories.IUserRepository")
+				}
+				pi4, err := ctn.SafeGet("comment-repository")
+				if err != nil {
+					var eo services.IMediaService
+					return eo, err
+				}
+				p4, ok := pi4.(repositories.ICommentRepository)
+				if !ok {
+					var eo services.IMediaService
+					return eo, errors.New("could not cast parameter 4 to repositories.ICommentRepository")
+				}
+				b, ok := d.Build.(func(repositories.IMediaRepository, infrastructures.IStorageService, services.ISettingService, repositories.IUserRepository, repositories.ICommentRepository) (services.IMediaService, error))
+				if !ok {
+					var eo services.IMediaService
+					return eo, errors.New("could not cast build function to func(repositories.IMediaRepository, infrastructures.IStorageService, services.ISettingService, repositories.IUserRepository, repositories.ICommentRepository) (services.IMediaService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "metrics",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("metrics")
+				if err != nil {
+					var eo infrastructures.IMetrics
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IMetrics, error))
+				if !ok {
+					var eo infrastructures.IMetrics
+					return eo, errors.New("could not cast build function to func() (infrastructures.IMetrics, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "metrics-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("metrics-controller")
+				if err != nil {
+					var eo controllers.MetricsController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("metrics")
+				if err != nil {
+					var eo controllers.MetricsController
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IMetrics)
+				if !ok {
+					var eo controllers.MetricsController
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IMetrics")
+				}
+				b, ok := d.Build.(func(infrastructures.IMetrics) (controllers.MetricsController, error))
+				if !ok {
+					var eo controllers.MetricsController
+					return eo, errors.New("could not cast build function to func(infrastructures.IMetrics) (controllers.MetricsController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "n-plus-one-detector",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("n-plus-one-detector")
+				if err != nil {
+					var eo gorm.Plugin
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo gorm.Plugin
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.ILogger)
+				if !ok {
+					var eo gorm.Plugin
+					return eo, errors.New("could not cast parameter 0 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(infrastructures.ILogger) (gorm.Plugin, error))
+				if !ok {
+					var eo gorm.Plugin
+					return eo, errors.New("could not cast build function to func(infrastructures.ILogger) (gorm.Plugin, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "notification-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("notification-controller")
+				if err != nil {
+					var eo controllers.NotificationController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("notification-service")
+				if err != nil {
+					var eo controllers.NotificationController
+					return eo, err
+				}
+				p0, ok := pi0.(services.INotificationService)
+				if !ok {
+					var eo controllers.NotificationController
+					return eo, errors.New("could not cast parameter 0 to services.INotificationService")
+				}
+				b, ok := d.Build.(func(services.INotificationService) (controllers.NotificationController, error))
+				if !ok {
+					var eo controllers.NotificationController
+					return eo, errors.New("could not cast build function to func(services.INotificationService) (controllers.NotificationController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "notification-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("notification-service")
+				if err != nil {
+					var eo services.INotificationService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("device-repository")
+				if err != nil {
+					var eo services.INotificationService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IDeviceRepository)
+				if !ok {
+					var eo services.INotificationService
+					return eo, errors.New("could not cast parameter 0 to repositories.IDeviceRepository")
+				}
+				pi1, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.INotificationService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IUserRepository)
+				if !ok {
+					var eo services.INotificationService
+					return eo, errors.New("could not cast parameter 1 to repositories.IUserRepository")
+				}
+				pi2, err := ctn.SafeGet("push")
+				if err != nil {
+					var eo services.INotificationService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.IPushService)
+				if !ok {
+					var eo services.INotificationService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.IPushService")
+				}
+				pi3, err := ctn.SafeGet("hub")
+				if err != nil {
+					var eo services.INotificationService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IHub)
+				if !ok {
+					var eo services.INotificationService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IHub")
+				}
+				pi4, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.INotificationService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.ILogger)
+				if !ok {
+					var eo services.INotificationService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(repositories.IDeviceRepository, repositories.IUserRepository, infrastructures.IPushService, infrastructures.IHub, infrastructures.ILogger) (services.INotificationService, error))
+				if !ok {
+					var eo services.INotificationService
+					return eo, errors.New("could not cast build function to func(repositories.IDeviceRepository, repositories.IUserRepository, infrastructures.IPushService, infrastructures.IHub, infrastructures.ILogger) (services.INotificationService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "page-sizes-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("page-sizes-middleware")
+				if err != nil {
+					var eo middlewares.PageSizes
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo middlewares.PageSizes
+					return eo, err
+				}
+				p0, ok := pi0.(services.ISettingService)
+				if !ok {
+					var eo middlewares.PageSizes
+					return eo, errors.New("could not cast parameter 0 to services.ISettingService")
+				}
+				b, ok := d.Build.(func(services.ISettingService) (middlewares.PageSizes, error))
+				if !ok {
+					var eo middlewares.PageSizes
+					return eo, errors.New("could not cast build function to func(services.ISettingService) (middlewares.PageSizes, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "payment-gateway",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("payment-gateway")
+				if err != nil {
+					var eo infrastructures.IPaymentGateway
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("http-client-factory")
+				if err != nil {
+					var eo infrastructures.IPaymentGateway
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IHttpClientFactory)
+				if !ok {
+					var eo infrastructures.IPaymentGateway
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IHttpClientFactory")
+				}
+				b, ok := d.Build.(func(infrastructures.IHttpClientFactory) (infrastructures.IPaymentGateway, error))
+				if !ok {
+					var eo infrastructures.IPaymentGateway
+					return eo, errors.New("could not cast build function to func(infrastructures.IHttpClientFactory) (infrastructures.IPaymentGateway, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "pdf",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("pdf")
+				if err != nil {
+					var eo infrastructures.IPdfService
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IPdfService, error))
+				if !ok {
+					var eo infrastructures.IPdfService
+					return eo, errors.New("could not cast build function to func() (infrastructures.IPdfService, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "phone-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("phone-controller")
+				if err != nil {
+					var eo controllers.PhoneController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("phone-service")
+				if err != nil {
+					var eo controllers.PhoneController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IPhoneService)
+				if !ok {
+					var eo controllers.PhoneController
+					return eo, errors.New("could not cast parameter 0 to services.IPhoneService")
+				}
+				b, ok := d.Build.(func(services.IPhoneService) (controllers.PhoneController, error))
+				if !ok {
+					var eo controllers.PhoneController
+					return eo, errors.New("could not cast build function to func(services.IPhoneService) (controllers.PhoneController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "phone-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("phone-service")
+				if err != nil {
+					var eo services.IPhoneService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IPhoneService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IPhoneService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+				}
+				pi1, err := ctn.SafeGet("sms")
+				if err != nil {
+					var eo services.IPhoneService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.ISmsService)
+				if !ok {
+					var eo services.IPhoneService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.ISmsService")
+				}
+				pi2, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.IPhoneService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ICache)
+				if !ok {
+					var eo services.IPhoneService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ICache")
+				}
+				pi3, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IPhoneService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IClock)
+				if !ok {
+					var eo services.IPhoneService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IClock")
+				}
+				pi4, err := ctn.SafeGet("random")
+				if err != nil {
+					var eo services.IPhoneService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.IRandom)
+				if !ok {
+					var eo services.IPhoneService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.IRandom")
+				}
+				b, ok := d.Build.(func(repositories.IUserRepository, infrastructures.ISmsService, infrastructures.ICache, infrastructures.IClock, infrastructures.IRandom) (services.IPhoneService, error))
+				if !ok {
+					var eo services.IPhoneService
+					return eo, errors.New("could not cast build function to func(repositories.IUserRepository, infrastructures.ISmsService, infrastructures.ICache, infrastructures.IClock, infrastructures.IRandom) (services.IPhoneService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "policy-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("policy-repository")
+				if err != nil {
+					var eo repositories.IPolicyRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IPolicyRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IPolicyRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IPolicyRepository, error))
+				if !ok {
+					var eo repositories.IPolicyRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IPolicyRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "privacy-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("privacy-service")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+				}
+				pi1, err := ctn.SafeGet("data-export-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IDataExportRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 1 to repositories.IDataExportRepository")
+				}
+				pi2, err := ctn.SafeGet("policy-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p2, ok := pi2.(repositories.IPolicyRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 2 to repositories.IPolicyRepository")
+				}
+				pi3, err := ctn.SafeGet("storage")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IStorageService)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IStorageService")
+				}
+				pi4, err := ctn.SafeGet("unit-of-work")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p4, ok := pi4.(transactions.IUnitOfWork)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 4 to transactions.IUnitOfWork")
+				}
+				pi5, err := ctn.SafeGet("usage-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p5, ok := pi5.(repositories.IUsageRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 5 to repositories.IUsageRepository")
+				}
+				pi6, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p6, ok := pi6.(infrastructures.ICache)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 6 to infrastructures.ICache")
+				}
+				pi7, err := ctn.SafeGet("email-change-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p7, ok := pi7.(repositories.IEmailChangeRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 7 to repositories.IEmailChangeRepository")
+				}
+				pi8, err := ctn.SafeGet("session-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p8, ok := pi8.(repositories.ISessionRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 8 to repositories.ISessionRepository")
+				}
+				pi9, err := ctn.SafeGet("billing-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p9, ok := pi9.(repositories.IBillingRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 9 to repositories.IBillingRepository")
+				}
+				pi10, err := ctn.SafeGet("coupon-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p10, ok := pi10.(repositories.ICouponRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 10 to repositories.ICouponRepository")
+				}
+				pi11, err := ctn.SafeGet("vote-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p11, ok := pi11.(repositories.IVoteRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 11 to repositories.IVoteRepository")
+				}
+				pi12, err := ctn.SafeGet("comment-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p12, ok := pi12.(repositories.ICommentRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 12 to repositories.ICommentRepository")
+				}
+				pi13, err := ctn.SafeGet("report-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p13, ok := pi13.(repositories.IReportRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 13 to repositories.IReportRepository")
+				}
+				pi14, err := ctn.SafeGet("media-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p14, ok := pi14.(repositories.IMediaRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 14 to repositories.IMediaRepository")
+				}
+				pi15, err := ctn.SafeGet("follow-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p15, ok := pi15.(repositories.IFollowRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 15 to repositories.IFollowRepository")
+				}
+				pi16, err := ctn.SafeGet("conversation-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p16, ok := pi16.(repositories.IConversationRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 16 to repositories.IConversationRepository")
+				}
+				pi17, err := ctn.SafeGet("device-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p17, ok := pi17.(repositories.IDeviceRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 17 to repositories.IDeviceRepository")
+				}
+				pi18, err := ctn.SafeGet("announcement-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p18, ok := pi18.(repositories.IAnnouncementRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 18 to repositories.IAnnouncementRepository")
+				}
+				pi19, err := ctn.SafeGet("queue")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p19, ok := pi19.(infrastructures.IQueue)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 19 to infrastructures.IQueue")
+				}
+				pi20, err := ctn.SafeGet("user-version-repository")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p20, ok := pi20.(repositories.IUserVersionRepository)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 20 to repositories.IUserVersionRepository")
+				}
+				pi21, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p21, ok := pi21.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 21 to infrastructures.IEventBus")
+				}
+				pi22, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IPrivacyService
+					return eo, err
+				}
+				p22, ok := pi22.(infrastructures.IClock)
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast parameter 22 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(repositories.IUserRepository, repositories.IDataExportRepository, repositories.IPolicyRepository, infrastructures.IStorageService, transactions.IUnitOfWork, repositories.IUsageRepository, infrastructures.ICache, repositories.IEmailChangeRepository, repositories.ISessionRepository, repositories.IBillingRepository, repositories.ICouponRepository, repositories.IVoteRepository, repositories.ICommentRepository, repositories.IReportRepository, repositories.IMediaRepository, repositories.IFollowRepository, repositories.IConversationRepository, repositories.IDeviceRepository, repositories.IAnnouncementRepository, infrastructures.IQueue, repositories.IUserVersionRepository, infrastructures.IEventBus, infrastructures.IClock) (services.IPrivacyService, error))
+				if !ok {
+					var eo services.IPrivacyService
+					return eo, errors.New("could not cast build function to func(repositories.IUserRepository, repositories.IDataExportRepository, repositories.IPolicyRepository, infrastructures.IStorageService, transactions.IUnitOfWork, repositories.IUsageRepository, infrastructures.ICache, repositories.IEmailChangeRepository, repositories.ISessionRepository, repositories.IBillingRepository, repositories.ICouponRepository, repositories.IVoteRepository, repositories.ICommentRepository, repositories.IReportRepository, repositories.IMediaRepository, repositories.IFollowRepository, repositories.IConversationRepository, repositories.IDeviceRepository, repositories.IAnnouncementRepository, infrastructures.IQueue, repositories.IUserVersionRepository, infrastructures.IEventBus, infrastructures.IClock) (services.IPrivacyService, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, p10, p11, p12, p13, p14, p15, p16, p17, p18, p19, p20, p21, p22)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "publishing-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("publishing-service")
+				if err != nil {
+					var eo services.IPublishingService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.IPublishingService
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.IPublishingService
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IEventBus")
+				}
+				pi1, err := ctn.SafeGet("announcement-repository")
+				if err != nil {
+					var eo services.IPublishingService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IAnnouncementRepository)
+				if !ok {
+					var eo services.IPublishingService
+					return eo, errors.New("could not cast parameter 1 to repositories.IAnnouncementRepository")
+				}
+				pi2, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.IPublishingService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.IClock)
+				if !ok {
+					var eo services.IPublishingService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(infrastructures.IEventBus, repositories.IAnnouncementRepository, infrastructures.IClock) (services.IPublishingService, error))
+				if !ok {
+					var eo services.IPublishingService
+					return eo, errors.New("could not cast build function to func(infrastructures.IEventBus, repositories.IAnnouncementRepository, infrastructures.IClock) (services.IPublishingService, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "push",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("push")
+				if err != nil {
+					var eo infrastructures.IPushService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("http-client-factory")
+				if err != nil {
+					var eo infrastructures.IPushService
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IHttpClientFactory)
+				if !ok {
+					var eo infrastructures.IPushService
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IHttpClientFactory")
+				}
+				b, ok := d.Build.(func(infrastructures.IHttpClientFactory) (infrastructures.IPushService, error))
+				if !ok {
+					var eo infrastructures.IPushService
+					return eo, errors.New("could not cast build function to func(infrastructures.IHttpClientFactory) (infrastructures.IPushService, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "quota-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("quota-middleware")
+				if err != nil {
+					var eo middlewares.Quota
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("quota-service")
+				if err != nil {
+					var eo middlewares.Quota
+					return eo, err
+				}
+				p0, ok := pi0.(services.IQuotaService)
+				if !ok {
+					var eo middlewares.Quota
+					return eo, errors.New("could not cast parameter 0 to services.IQuotaService")
+				}
+				b, ok := d.Build.(func(services.IQuotaService) (middlewares.Quota, error))
+				if !ok {
+					var eo middlewares.Quota
+					return eo, errors.New("could not cast build function to func(services.IQuotaService) (middlewares.Quota, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "quota-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("quota-service")
+				if err != nil {
+					var eo services.IQuotaService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("usage-repository")
+				if err != nil {
+					var eo services.IQuotaService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUsageRepository)
+				if !ok {
+					var eo services.IQuotaService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUsageRepository")
+				}
+				b, ok := d.Build.(func(repositories.IUsageRepository) (services.IQuotaService, error))
+				if !ok {
+					var eo services.IQuotaService
+					return eo, errors.New("could not cast build function to func(repositories.IUsageRepository) (services.IQuotaService, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "random",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("random")
+				if err != nil {
+					var eo infrastructures.IRandom
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IRandom, error))
+				if !ok {
+					var eo infrastructures.IRandom
+					return eo, errors.New("could not cast build function to func() (infrastructures.IRandom, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "recorder-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("recorder-middleware")
+				if err != nil {
+					var eo *middlewares.Recorder
+					return eo, err
+				}
+				b, ok := d.Build.(func() (*middlewares.Recorder, error))
+				if !ok {
+					var eo *middlewares.Recorder
+					return eo, errors.New("could not cast build function to func() (*middlewares.Recorder, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				d, err := provider.Get("recorder-middleware")
+				if err != nil {
+					return err
+				}
+				c, ok := d.Close.(func(*middlewares.Recorder) error)
+				if !ok {
+					return errors.New("could not cast close function to 'func(*middlewares.Recorder) error'")
+				}
+				o, ok := obj.(*middlewares.Recorder)
+				if !ok {
+					return errors.New("could not cast object to '*middlewares.Recorder'")
+				}
+				return c(o)
+			},
+		},
+		{
+			Name:  "recovery-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("recovery-middleware")
+				if err != nil {
+					var eo middlewares.Recovery
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("error-reporter")
+				if err != nil {
+					var eo middlewares.Recovery
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IErrorReporter)
+				if !ok {
+					var eo middlewares.Recovery
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IErrorReporter")
+				}
+				b, ok := d.Build.(func(infrastructures.IErrorReporter) (middlewares.Recovery, error))
+				if !ok {
+					var eo middlewares.Recovery
+					return eo, errors.New("could not cast build function to func(infrastructures.IErrorReporter) (middlewares.Recovery, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "registration-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("registration-service")
+				if err != nil {
+					var eo services.IRegistrationService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IRegistrationService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IRegistrationService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+				}
+				pi1, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.IRegistrationService
+					return eo, err
+				}
+				p1, ok := pi1.(services.ISettingService)
+				if !ok {
+					var eo services.IRegistrationService
+					return eo, errors.New("could not cast parameter 1 to services.ISettingService")
+				}
+				pi2, err := ctn.SafeGet("unit-of-work")
+				if err != nil {
+					var eo services.IRegistrationService
+					return eo, err
+				}
+				p2, ok := pi2.(transactions.IUnitOfWork)
+				if !ok {
+					var eo services.IRegistrationService
+					return eo, errors.New("could not cast parameter 2 to transactions.IUnitOfWork")
+				}
+				pi3, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.IRegistrationService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.ICache)
+				if !ok {
+					var eo services.IRegistrationService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.ICache")
+				}
+				pi4, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.IRegistrationService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.IRegistrationService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.IEventBus")
+				}
+				pi5, err := ctn.SafeGet("saga-service")
+				if err != nil {
+					var eo services.IRegistrationService
+					return eo, err
+				}
+				p5, ok := pi5.(services.ISagaService)
+				if !ok {
+					var eo services.IRegistrationService
+					return eo, errors.New("could not cast parameter 5 to services.ISagaService")
+				}
+				pi6, err := ctn.SafeGet("email")
+				if err != nil {
+					var eo services.IRegistrationService
+					return eo, err
+				}
+				p6, ok := pi6.(infrastructures.IEmailService)
+				if !ok {
+					var eo services.IRegistrationService
+					return eo, errors.New("could not cast parameter 6 to infrastructures.IEmailService")
+				}
+				pi7, err := ctn.SafeGet("user-welcome-mail")
+				if err != nil {
+					var eo services.IRegistrationService
+					return eo, err
+				}
+				p7, ok := pi7.(mails.IMailRenderer)
+				if !ok {
+					var eo services.IRegistrationService
+					return eo, errors.New("could not cast parameter 7 to mails.IMailRenderer")
+				}
+				pi8, err := ctn.SafeGet("random")
+				if err != nil {
+					var eo services.IRegistrationService
+					return eo, err
+				}
+				p8, ok := pi8.(infrastructures.IRandom)
+				if !ok {
+					var eo services.IRegistrationService
+					return eo, errors.New("could not cast parameter 8 to infrastructures.IRandom")
+				}
+				b, ok := d.Build.(func(repositories.IUserRepository, services.ISettingService, transactions.IUnitOfWork, infrastructures.ICache, infrastructures.IEventBus, services.ISagaService, infrastructures.IEmailService, mails.IMailRenderer, infrastructures.IRandom) (services.IRegistrationService, error))
+				if !ok {
+					var eo services.IRegistrationService
+					return eo, errors.New("could not cast build function to func(repositories.IUserRepository, services.ISettingService, transactions.IUnitOfWork, infrastructures.ICache, infrastructures.IEventBus, services.ISagaService, infrastructures.IEmailService, mails.IMailRenderer, infrastructures.IRandom) (services.IRegistrationService, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5, p6, p7, p8)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "report-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("report-controller")
+				if err != nil {
+					var eo controllers.ReportController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("report-service")
+				if err != nil {
+					var eo controllers.ReportController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IReportService)
+				if !ok {
+					var eo controllers.ReportController
+					return eo, errors.New("could not cast parameter 0 to services.IReportService")
+				}
+				b, ok := d.Build.(func(services.IReportService) (controllers.ReportController, error))
+				if !ok {
+					var eo controllers.ReportController
+					return eo, errors.New("could not cast build function to func(services.IReportService) (controllers.ReportController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "report-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("report-repository")
+				if err != nil {
+					var eo repositories.IReportRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IReportRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IReportRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				pi1, err := ctn.SafeGet("shards")
+				if err != nil {
+					var eo repositories.IReportRepository
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IShards)
+				if !ok {
+					var eo repositories.IReportRepository
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IShards")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.IReportRepository, error))
+				if !ok {
+					var eo repositories.IReportRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.IReportRepository, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "report-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("report-service")
+				if err != nil {
+					var eo services.IReportService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("report-repository")
+				if err != nil {
+					var eo services.IReportService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IReportRepository)
+				if !ok {
+					var eo services.IReportService
+					return eo, errors.New("could not cast parameter 0 to repositories.IReportRepository")
+				}
+				pi1, err := ctn.SafeGet("suspension-service")
+				if err != nil {
+					var eo services.IReportService
+					return eo, err
+				}
+				p1, ok := pi1.(services.ISuspensionService)
+				if !ok {
+					var eo services.IReportService
+					return eo, errors.New("could not cast parameter 1 to services.ISuspensionService")
+				}
+				pi2, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.IReportService
+					return eo, err
+				}
+				p2, ok := pi2.(services.ISettingService)
+				if !ok {
+					var eo services.IReportService
+					return eo, errors.New("could not cast parameter 2 to services.ISettingService")
+				}
+				pi3, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IReportService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IReportService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.ILogger")
+				}
+				pi4, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IReportService
+					return eo, err
+				}
+				p4, ok := pi4.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IReportService
+					return eo, errors.New("could not cast parameter 4 to repositories.IUserRepository")
+				}
+				pi5, err := ctn.SafeGet("comment-repository")
+				if err != nil {
+					var eo services.IReportService
+					return eo, err
+				}
+				p5, ok := pi5.(repositories.ICommentRepository)
+				if !ok {
+					var eo services.IReportService
+					return eo, errors.New("could not cast parameter 5 to repositories.ICommentRepository")
+				}
+				b, ok := d.Build.(func(repositories.IReportRepository, services.ISuspensionService, services.ISettingService, infrastructures.ILogger, repositories.IUserRepository, repositories.ICommentRepository) (services.IReportService, error))
+				if !ok {
+					var eo services.IReportService
+					return eo, errors.New("could not cast build function to func(repositories.IReportRepository, services.ISuspensionService, services.ISettingService, infrastructures.ILogger, repositories.IUserRepository, repositories.ICommentRepository) (services.IReportService, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "response-cache-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("response-cache-middleware")
+				if err != nil {
+					var eo middlewares.ResponseCache
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo middlewares.ResponseCache
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.ICache)
+				if !ok {
+					var eo middlewares.ResponseCache
+					return eo, errors.New("could not cast parameter 0 to infrastructures.ICache")
+				}
+				b, ok := d.Build.(func(infrastructures.ICache) (middlewares.ResponseCache, error))
+				if !ok {
+					var eo middlewares.ResponseCache
+					return eo, errors.New("could not cast build function to func(infrastructures.ICache) (middlewares.ResponseCache, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "scheduler",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("scheduler")
+				if err != nil {
+					var eo infrastructures.IScheduler
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IScheduler, error))
+				if !ok {
+					var eo infrastructures.IScheduler
+					return eo, errors.New("could not cast build function to func() (infrastructures.IScheduler, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				d, err := provider.Get("scheduler")
+				if err != nil {
+					return err
+				}
+				c, ok := d.Close.(func(infrastructures.IScheduler) error)
+				if !ok {
+					return errors.New("could not cast close function to 'func(infrastructures.IScheduler) error'")
+				}
+				o, ok := obj.(infrastructures.IScheduler)
+				if !ok {
+					return errors.New("could not cast object to 'infrastructures.IScheduler'")
+				}
+				return c(o)
+			},
+		},
+		{
+			Name:  "search",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("search")
+				if err != nil {
+					var eo infrastructures.ISearchEngine
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("http-client-factory")
+				if err != nil {
+					var eo infrastructures.ISearchEngine
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IHttpClientFactory)
+				if !ok {
+					var eo infrastructures.ISearchEngine
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IHttpClientFactory")
+				}
+				b, ok := d.Build.(func(infrastructures.IHttpClientFactory) (infrastructures.ISearchEngine, error))
+				if !ok {
+					var eo infrastructures.ISearchEngine
+					return eo, errors.New("could not cast build function to func(infrastructures.IHttpClientFactory) (infrastructures.ISearchEngine, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "search-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("search-service")
+				if err != nil {
+					var eo services.ISearchService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("search")
+				if err != nil {
+					var eo services.ISearchService
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.ISearchEngine)
+				if !ok {
+					var eo services.ISearchService
+					return eo, errors.New("could not cast parameter 0 to infrastructures.ISearchEngine")
+				}
+				pi1, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.ISearchService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.ILogger)
+				if !ok {
+					var eo services.ISearchService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.ILogger")
+				}
+				pi2, err := ctn.SafeGet("comment-repository")
+				if err != nil {
+					var eo services.ISearchService
+					return eo, err
+				}
+				p2, ok := pi2.(repositories.ICommentRepository)
+				if !ok {
+					var eo services.ISearchService
+					return eo, errors.New("could not cast parameter 2 to repositories.ICommentRepository")
+				}
+				pi3, err := ctn.SafeGet("announcement-repository")
+				if err != nil {
+					var eo services.ISearchService
+					return eo, err
+				}
+				p3, ok := pi3.(repositories.IAnnouncementRepository)
+				if !ok {
+					var eo services.ISearchService
+					return eo, errors.New("could not cast parameter 3 to repositories.IAnnouncementRepository")
+				}
+				b, ok := d.Build.(func(infrastructures.ISearchEngine, infrastructures.ILogger, repositories.ICommentRepository, repositories.IAnnouncementRepository) (services.ISearchService, error))
+				if !ok {
+					var eo services.ISearchService
+					return eo, errors.New("could not cast build function to func(infrastructures.ISearchEngine, infrastructures.ILogger, repositories.ICommentRepository, repositories.IAnnouncementRepository) (services.ISearchService, error)")
+				}
+				return b(p0, p1, p2, p3)
+			},
+			Close: func(obj interface{}) error {
+				d, err := provider.Get("search-service")
+				if err != nil {
+					return err
+				}
+				c, ok := d.Close.(func(services.ISearchService) error)
+				if !ok {
+					return errors.New("could not cast close function to 'func(services.ISearchService) error'")
+				}
+				o, ok := obj.(services.ISearchService)
+				if !ok {
+					return errors.New("could not cast object to 'services.ISearchService'")
+				}
+				return c(o)
+			},
+		},
+		{
+			Name:  "session-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("session-repository")
+				if err != nil {
+					var eo repositories.ISessionRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.ISessionRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.ISessionRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.ISessionRepository, error))
+				if !ok {
+					var eo repositories.ISessionRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.ISessionRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "session-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("session-service")
+				if err != nil {
+					var eo services.ISessionService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("session-repository")
+				if err != nil {
+					var eo services.ISessionService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.ISessionRepository)
+				if !ok {
+					var eo services.ISessionService
+					return eo, errors.New("could not cast parameter 0 to repositories.ISessionRepository")
+				}
+				pi1, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.ISessionService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IUserRepository)
+				if !ok {
+					var eo services.ISessionService
+					return eo, errors.New("could not cast parameter 1 to repositories.IUserRepository")
+				}
+				pi2, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.ISessionService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ILogger)
+				if !ok {
+					var eo services.ISessionService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ILogger")
+				}
+				pi3, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.ISessionService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IClock)
+				if !ok {
+					var eo services.ISessionService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IClock")
+				}
+				pi4, err := ctn.SafeGet("random")
+				if err != nil {
+					var eo services.ISessionService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.IRandom)
+				if !ok {
+					var eo services.ISessionService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.IRandom")
+				}
+				b, ok := d.Build.(func(repositories.ISessionRepository, repositories.IUserRepository, infrastructures.ILogger, infrastructures.IClock, infrastructures.IRandom) (services.ISessionService, error))
+				if !ok {
+					var eo services.ISessionService
+					return eo, errors.New("could not cast build function to func(repositories.ISessionRepository, repositories.IUserRepository, infrastructures.ILogger, infrastructures.IClock, infrastructures.IRandom) (services.ISessionService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "setting-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("setting-controller")
+				if err != nil {
+					var eo controllers.SettingController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo controllers.SettingController
+					return eo, err
+				}
+				p0, ok := pi0.(services.ISettingService)
+				if !ok {
+					var eo controllers.SettingController
+					return eo, errors.New("could not cast parameter 0 to services.ISettingService")
+				}
+				b, ok := d.Build.(func(services.ISettingService) (controllers.SettingController, error))
+				if !ok {
+					var eo controllers.SettingController
+					return eo, errors.New("could not cast build function to func(services.ISettingService) (controllers.SettingController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "setting-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("setting-repository")
+				if err != nil {
+					var eo repositories.ISettingRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.ISettingRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.ISettingRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.ISettingRepository, error))
+				if !ok {
+					var eo repositories.ISettingRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.ISettingRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "setting-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("setting-service")
+				if err != nil {
+					var eo services.ISettingService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("setting-repository")
+				if err != nil {
+					var eo services.ISettingService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.ISettingRepository)
+				if !ok {
+					var eo services.ISettingService
+					return eo, errors.New("could not cast parameter 0 to repositories.ISettingRepository")
+				}
+				pi1, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.ISettingService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.ICache)
+				if !ok {
+					var eo services.ISettingService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.ICache")
+				}
+				b, ok := d.Build.(func(repositories.ISettingRepository, infrastructures.ICache) (services.ISettingService, error))
+				if !ok {
+					var eo services.ISettingService
+					return eo, errors.New("could not cast build function to func(repositories.ISettingRepository, infrastructures.ICache) (services.ISettingService, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "shadow-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("shadow-middleware")
+				if err != nil {
+					var eo middlewares.Shadow
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("http-client-factory")
+				if err != nil {
+					var eo middlewares.Shadow
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IHttpClientFactory)
+				if !ok {
+					var eo middlewares.Shadow
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IHttpClientFactory")
+				}
+				b, ok := d.Build.(func(infrastructures.IHttpClientFactory) (middlewares.Shadow, error))
+				if !ok {
+					var eo middlewares.Shadow
+					return eo, errors.New("could not cast build function to func(infrastructures.IHttpClientFactory) (middlewares.Shadow, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "signature-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("signature-middleware")
+				if err != nil {
+					var eo middlewares.Signature
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo middlewares.Signature
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.ICache)
+				if !ok {
+					var eo middlewares.Signature
+					return eo, errors.New("could not cast parameter 0 to infrastructures.ICache")
+				}
+				pi1, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo middlewares.Signature
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IClock)
+				if !ok {
+					var eo middlewares.Signature
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(infrastructures.ICache, infrastructures.IClock) (middlewares.Signature, error))
+				if !ok {
+					var eo middlewares.Signature
+					return eo, errors.New("could not cast build function to func(infrastructures.ICache, infrastructures.IClock) (middlewares.Signature, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "signer",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("signer")
+				if err != nil {
+					var eo signing.Signer
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo signing.Signer
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IClock)
+				if !ok {
+					var eo signing.Signer
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(infrastructures.IClock) (signing.Signer, error))
+				if !ok {
+					var eo signing.Signer
+					return eo, errors.New("could not cast build function to func(infrastructures.IClock) (signing.Signer, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "slo-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("slo-controller")
+				if err != nil {
+					var eo controllers.SloController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("slo-service")
+				if err != nil {
+					var eo controllers.SloController
+					return eo, err
+				}
+				p0, ok := pi0.(services.ISloService)
+				if !ok {
+					var eo controllers.SloController
+					return eo, errors.New("could not cast parameter 0 to services.ISloService")
+				}
+				b, ok := d.Build.(func(services.ISloService) (controllers.SloController, error))
+				if !ok {
+					var eo controllers.SloController
+					return eo, errors.New("could not cast build function to func(services.ISloService) (controllers.SloController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "slo-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("slo-middleware")
+				if err != nil {
+					var eo middlewares.Slo
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("slo-service")
+				if err != nil {
+					var eo middlewares.Slo
+					return eo, err
+				}
+				p0, ok := pi0.(services.ISloService)
+				if !ok {
+					var eo middlewares.Slo
+					return eo, errors.New("could not cast parameter 0 to services.ISloService")
+				}
+				b, ok := d.Build.(func(services.ISloService) (middlewares.Slo, error))
+				if !ok {
+					var eo middlewares.Slo
+					return eo, errors.New("could not cast build function to func(services.ISloService) (middlewares.Slo, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "slo-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("slo-service")
+				if err != nil {
+					var eo services.ISloService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.ISloService
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.ISloService
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IEventBus")
+				}
+				pi1, err := ctn.SafeGet("metrics")
+				if err != nil {
+					var eo services.ISloService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IMetrics)
+				if !ok {
+					var eo services.ISloService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IMetrics")
+				}
+				pi2, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.ISloService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ILogger)
+				if !ok {
+					var eo services.ISloService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ILogger")
+				}
+				pi3, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.ISloService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IClock)
+				if !ok {
+					var eo services.ISloService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(infrastructures.IEventBus, infrastructures.IMetrics, infrastructures.ILogger, infrastructures.IClock) (services.ISloService, error))
+				if !ok {
+					var eo services.ISloService
+					return eo, errors.New("could not cast build function to func(infrastructures.IEventBus, infrastructures.IMetrics, infrastructures.ILogger, infrastructures.IClock) (services.ISloService, error)")
+				}
+				return b(p0, p1, p2, p3)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "slow-request-middleware",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("slow-request-middleware")
+				if err != nil {
+					var eo middlewares.SlowRequest
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo middlewares.SlowRequest
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.ILogger)
+				if !ok {
+					var eo middlewares.SlowRequest
+					return eo, errors.New("could not cast parameter 0 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(infrastructures.ILogger) (middlewares.SlowRequest, error))
+				if !ok {
+					var eo middlewares.SlowRequest
+					return eo, errors.New("could not cast build function to func(infrastructures.ILogger) (middlewares.SlowRequest, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "sms",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("sms")
+				if err != nil {
+					var eo infrastructures.ISmsService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("http-client-factory")
+				if err != nil {
+					var eo infrastructures.ISmsService
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IHttpClientFactory)
+				if !ok {
+					var eo infrastructures.ISmsService
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IHttpClientFactory")
+				}
+				b, ok := d.Build.(func(infrastructures.IHttpClientFactory) (infrastructures.ISmsService, error))
+				if !ok {
+					var eo infrastructures.ISmsService
+					return eo, errors.New("could not cast build function to func(infrastructures.IHttpClientFactory) (infrastructures.ISmsService, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "socket-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("socket-controller")
+				if err != nil {
+					var eo controllers.SocketController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("hub")
+				if err != nil {
+					var eo controllers.SocketController
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IHub)
+				if !ok {
+					var eo controllers.SocketController
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IHub")
+				}
+				b, ok := d.Build.(func(infrastructures.IHub) (controllers.SocketController, error))
+				if !ok {
+					var eo controllers.SocketController
+					return eo, errors.New("could not cast build function to func(infrastructures.IHub) (controllers.SocketController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "storage",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("storage")
+				if err != nil {
+					var eo infrastructures.IStorageService
+					return eo, err
+				}
+				b, ok := d.Build.(func() (infrastructures.IStorageService, error))
+				if !ok {
+					var eo infrastructures.IStorageService
+					return eo, errors.New("could not cast build function to func() (infrastructures.IStorageService, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "suspension-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("suspension-controller")
+				if err != nil {
+					var eo controllers.SuspensionController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("suspension-service")
+				if err != nil {
+					var eo controllers.SuspensionController
+					return eo, err
+				}
+				p0, ok := pi0.(services.ISuspensionService)
+				if !ok {
+					var eo controllers.SuspensionController
+					return eo, errors.New("could not cast parameter 0 to services.ISuspensionService")
+				}
+				b, ok := d.Build.(func(services.ISuspensionService) (controllers.SuspensionController, error))
+				if !ok {
+					var eo controllers.SuspensionController
+					return eo, errors.New("could not cast build function to func(services.ISuspensionService) (controllers.SuspensionController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "suspension-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("suspension-service")
+				if err != nil {
+					var eo services.ISuspensionService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.ISuspensionService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUserRepository)
+				if !ok {
+					var eo services.ISuspensionService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+				}
+				pi1, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.ISuspensionService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.ILogger)
+				if !ok {
+					var eo services.ISuspensionService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.ILogger")
+				}
+				pi2, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.ISuspensionService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ICache)
+				if !ok {
+					var eo services.ISuspensionService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ICache")
+				}
+				pi3, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.ISuspensionService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.ISuspensionService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.IEventBus")
+				}
+				pi4, err := ctn.SafeGet("clock")
+				if err != nil {
+					var eo services.ISuspensionService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.IClock)
+				if !ok {
+					var eo services.ISuspensionService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.IClock")
+				}
+				b, ok := d.Build.(func(repositories.IUserRepository, infrastructures.ILogger, infrastructures.ICache, infrastructures.IEventBus, infrastructures.IClock) (services.ISuspensionService, error))
+				if !ok {
+					var eo services.ISuspensionService
+					return eo, errors.New("could not cast build function to func(repositories.IUserRepository, infrastructures.ILogger, infrastructures.ICache, infrastructures.IEventBus, infrastructures.IClock) (services.ISuspensionService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "tag-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("tag-controller")
+				if err != nil {
+					var eo controllers.TagController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("tag-service")
+				if err != nil {
+					var eo controllers.TagController
+					return eo, err
+				}
+				p0, ok := pi0.(services.ITagService)
+				if !ok {
+					var eo controllers.TagController
+					return eo, errors.New("could not cast parameter 0 to services.ITagService")
+				}
+				b, ok := d.Build.(func(services.ITagService) (controllers.TagController, error))
+				if !ok {
+					var eo controllers.TagController
+					return eo, errors.New("could not cast build function to func(services.ITagService) (controllers.TagController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "tag-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("tag-repository")
+				if err != nil {
+					var eo repositories.ITagRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.ITagRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.ITagRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				pi1, err := ctn.SafeGet("shards")
+				if err != nil {
+					var eo repositories.ITagRepository
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IShards)
+				if !ok {
+					var eo repositories.ITagRepository
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IShards")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.ITagRepository, error))
+				if !ok {
+					var eo repositories.ITagRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.ITagRepository, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "tag-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("tag-service")
+				if err != nil {
+					var eo services.ITagService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("tag-repository")
+				if err != nil {
+					var eo services.ITagService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.ITagRepository)
+				if !ok {
+					var eo services.ITagService
+					return eo, errors.New("could not cast parameter 0 to repositories.ITagRepository")
+				}
+				pi1, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.ITagService
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.ICache)
+				if !ok {
+					var eo services.ITagService
+					return eo, errors.New("could not cast parameter 1 to infrastructures.ICache")
+				}
+				pi2, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.ITagService
+					return eo, err
+				}
+				p2, ok := pi2.(repositories.IUserRepository)
+				if !ok {
+					var eo services.ITagService
+					return eo, errors.New("could not cast parameter 2 to repositories.IUserRepository")
+				}
+				pi3, err := ctn.SafeGet("comment-repository")
+				if err != nil {
+					var eo services.ITagService
+					return eo, err
+				}
+				p3, ok := pi3.(repositories.ICommentRepository)
+				if !ok {
+					var eo services.ITagService
+					return eo, errors.New("could not cast parameter 3 to repositories.ICommentRepository")
+				}
+				pi4, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.ITagService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.ITagService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.IEventBus")
+				}
+				b, ok := d.Build.(func(repositories.ITagRepository, infrastructures.ICache, repositories.IUserRepository, repositories.ICommentRepository, infrastructures.IEventBus) (services.ITagService, error))
+				if !ok {
+					var eo services.ITagService
+					return eo, errors.New("could not cast build function to func(repositories.ITagRepository, infrastructures.ICache, repositories.IUserRepository, repositories.ICommentRepository, infrastructures.IEventBus) (services.ITagService, error)")
+				}
+				return b(p0, p1, p2, p3, p4)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "unit-of-work",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("unit-of-work")
+				if err != nil {
+					var eo transactions.IUnitOfWork
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo transactions.IUnitOfWork
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo transactions.IUnitOfWork
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				pi1, err := ctn.SafeGet("db-retrier")
+				if err != nil {
+					var eo transactions.IUnitOfWork
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IDbRetrier)
+				if !ok {
+					var eo transactions.IUnitOfWork
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IDbRetrier")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase, infrastructures.IDbRetrier) (transactions.IUnitOfWork, error))
+				if !ok {
+					var eo transactions.IUnitOfWork
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase, infrastructures.IDbRetrier) (transactions.IUnitOfWork, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "usage-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("usage-repository")
+				if err != nil {
+					var eo repositories.IUsageRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IUsageRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IUsageRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IUsageRepository, error))
+				if !ok {
+					var eo repositories.IUsageRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IUsageRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "user-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("user-controller")
+				if err != nil {
+					var eo controllers.UserController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-service")
+				if err != nil {
+					var eo controllers.UserController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IUserService)
+				if !ok {
+					var eo controllers.UserController
+					return eo, errors.New("could not cast parameter 0 to services.IUserService")
+				}
+				pi1, err := ctn.SafeGet("user-policy")
+				if err != nil {
+					var eo controllers.UserController
+					return eo, err
+				}
+				p1, ok := pi1.(policies.IUserPolicy)
+				if !ok {
+					var eo controllers.UserController
+					return eo, errors.New("could not cast parameter 1 to policies.IUserPolicy")
+				}
+				pi2, err := ctn.SafeGet("user-projection-service")
+				if err != nil {
+					var eo controllers.UserController
+					return eo, err
+				}
+				p2, ok := pi2.(services.IUserProjectionService)
+				if !ok {
+					var eo controllers.UserController
+					return eo, errors.New("could not cast parameter 2 to services.IUserProjectionService")
+				}
+				b, ok := d.Build.(func(services.IUserService, policies.IUserPolicy, services.IUserProjectionService) (controllers.UserController, error))
+				if !ok {
+					var eo controllers.UserController
+					return eo, errors.New("could not cast build function to func(services.IUserService, policies.IUserPolicy, services.IUserProjectionService) (controllers.UserController, error)")
+				}
+				return b(p0, p1, p2)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "user-history-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("user-history-controller")
+				if err != nil {
+					var eo controllers.UserHistoryController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-history-service")
+				if err != nil {
+					var eo controllers.UserHistoryController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IUserHistoryService)
+				if !ok {
+					var eo controllers.UserHistoryController
+					return eo, errors.New("could not cast parameter 0 to services.IUserHistoryService")
+				}
+				b, ok := d.Build.(func(services.IUserHistoryService) (controllers.UserHistoryController, error))
+				if !ok {
+					var eo controllers.UserHistoryController
+					return eo, errors.New("could not cast build function to func(services.IUserHistoryService) (controllers.UserHistoryController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "user-history-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("user-history-service")
+				if err != nil {
+					var eo services.IUserHistoryService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IUserHistoryService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IUserHistoryService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+				}
+				pi1, err := ctn.SafeGet("user-version-repository")
+				if err != nil {
+					var eo services.IUserHistoryService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IUserVersionRepository)
+				if !ok {
+					var eo services.IUserHistoryService
+					return eo, errors.New("could not cast parameter 1 to repositories.IUserVersionRepository")
+				}
+				pi2, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.IUserHistoryService
+					return eo, err
+				}
+				p2, ok := pi2.(services.ISettingService)
+				if !ok {
+					var eo services.IUserHistoryService
+					return eo, errors.New("could not cast parameter 2 to services.ISettingService")
+				}
+				pi3, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.IUserHistoryService
+					return eo, err
+				}
+				p3, ok := pi3.(infrastructures.ICache)
+				if !ok {
+					var eo services.IUserHistoryService
+					return eo, errors.New("could not cast parameter 3 to infrastructures.ICache")
+				}
+				pi4, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IUserHistoryService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IUserHistoryService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.ILogger")
+				}
+				pi5, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.IUserHistoryService
+					return eo, err
+				}
+				p5, ok := pi5.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.IUserHistoryService
+					return eo, errors.New("could not cast parameter 5 to infrastructures.IEventBus")
+				}
+				b, ok := d.Build.(func(repositories.IUserRepository, repositories.IUserVersionRepository, services.ISettingService, infrastructures.ICache, infrastructures.ILogger, infrastructures.IEventBus) (services.IUserHistoryService, error))
+				if !ok {
+					var eo services.IUserHistoryService
+					return eo, errors.New("could not cast build function to func(repositories.IUserRepository, repositories.IUserVersionRepository, services.ISettingService, infrastructures.ICache, infrastructures.ILogger, infrastructures.IEventBus) (services.IUserHistoryService, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "user-policy",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("user-policy")
+				if err != nil {
+					var eo policies.IUserPolicy
+					return eo, err
+				}
+				b, ok := d.Build.(func() (policies.IUserPolicy, error))
+				if !ok {
+					var eo policies.IUserPolicy
+					return eo, errors.New("could not cast build function to func() (policies.IUserPolicy, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "user-projection-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("user-projection-service")
+				if err != nil {
+					var eo services.IUserProjectionService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IUserProjectionService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IUserProjectionService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+				}
+				pi1, err := ctn.SafeGet("user-search-view-repository")
+				if err != nil {
+					var eo services.IUserProjectionService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IUserSearchViewRepository)
+				if !ok {
+					var eo services.IUserProjectionService
+					return eo, errors.New("could not cast parameter 1 to repositories.IUserSearchViewRepository")
+				}
+				pi2, err := ctn.SafeGet("tag-repository")
+				if err != nil {
+					var eo services.IUserProjectionService
+					return eo, err
+				}
+				p2, ok := pi2.(repositories.ITagRepository)
+				if !ok {
+					var eo services.IUserProjectionService
+					return eo, errors.New("could not cast parameter 2 to repositories.ITagRepository")
+				}
+				pi3, err := ctn.SafeGet("follow-repository")
+				if err != nil {
+					var eo services.IUserProjectionService
+					return eo, err
+				}
+				p3, ok := pi3.(repositories.IFollowRepository)
+				if !ok {
+					var eo services.IUserProjectionService
+					return eo, errors.New("could not cast parameter 3 to repositories.IFollowRepository")
+				}
+				pi4, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.IUserProjectionService
+					return eo, err
+				}
+				p4, ok := pi4.(services.ISettingService)
+				if !ok {
+					var eo services.IUserProjectionService
+					return eo, errors.New("could not cast parameter 4 to services.ISettingService")
+				}
+				pi5, err := ctn.SafeGet("queue")
+				if err != nil {
+					var eo services.IUserProjectionService
+					return eo, err
+				}
+				p5, ok := pi5.(infrastructures.IQueue)
+				if !ok {
+					var eo services.IUserProjectionService
+					return eo, errors.New("could not cast parameter 5 to infrastructures.IQueue")
+				}
+				pi6, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IUserProjectionService
+					return eo, err
+				}
+				p6, ok := pi6.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IUserProjectionService
+					return eo, errors.New("could not cast parameter 6 to infrastructures.ILogger")
+				}
+				b, ok := d.Build.(func(repositories.IUserRepository, repositories.IUserSearchViewRepository, repositories.ITagRepository, repositories.IFollowRepository, services.ISettingService, infrastructures.IQueue, infrastructures.ILogger) (services.IUserProjectionService, error))
+				if !ok {
+					var eo services.IUserProjectionService
+					return eo, errors.New("could not cast build function to func(repositories.IUserRepository, repositories.IUserSearchViewRepository, repositories.ITagRepository, repositories.IFollowRepository, services.ISettingService, infrastructures.IQueue, infrastructures.ILogger) (services.IUserProjectionService, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5, p6)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "user-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("user-repository")
+				if err != nil {
+					var eo repositories.IUserRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IUserRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IUserRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				pi1, err := ctn.SafeGet("shards")
+				if err != nil {
+					var eo repositories.IUserRepository
+					return eo, err
+				}
+				p1, ok := pi1.(infrastructures.IShards)
+				if !ok {
+					var eo repositories.IUserRepository
+					return eo, errors.New("could not cast parameter 1 to infrastructures.IShards")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.IUserRepository, error))
+				if !ok {
+					var eo repositories.IUserRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase, infrastructures.IShards) (repositories.IUserRepository, error)")
+				}
+				return b(p0, p1)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "user-search-view-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("user-search-view-repository")
+				if err != nil {
+					var eo repositories.IUserSearchViewRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IUserSearchViewRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IUserSearchViewRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IUserSearchViewRepository, error))
+				if !ok {
+					var eo repositories.IUserSearchViewRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IUserSearchViewRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "user-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("user-service")
+				if err != nil {
+					var eo services.IUserService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IUserService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IUserService
+					return eo, errors.New("could not cast parameter 0 to repositories.IUserRepository")
+				}
+				pi1, err := ctn.SafeGet("setting-service")
+				if err != nil {
+					var eo services.IUserService
+					return eo, err
+				}
+				p1, ok := pi1.(services.ISettingService)
+				if !ok {
+					var eo services.IUserService
+					return eo, errors.New("could not cast parameter 1 to services.ISettingService")
+				}
+				pi2, err := ctn.SafeGet("cache")
+				if err != nil {
+					var eo services.IUserService
+					return eo, err
+				}
+				p2, ok := pi2.(infrastructures.ICache)
+				if !ok {
+					var eo services.IUserService
+					return eo, errors.New("could not cast parameter 2 to infrastructures.ICache")
+				}
+				pi3, err := ctn.SafeGet("unit-of-work")
+				if err != nil {
+					var eo services.IUserService
+					return eo, err
+				}
+				p3, ok := pi3.(transactions.IUnitOfWork)
+				if !ok {
+					var eo services.IUserService
+					return eo, errors.New("could not cast parameter 3 to transactions.IUnitOfWork")
+				}
+				pi4, err := ctn.SafeGet("logger")
+				if err != nil {
+					var eo services.IUserService
+					return eo, err
+				}
+				p4, ok := pi4.(infrastructures.ILogger)
+				if !ok {
+					var eo services.IUserService
+					return eo, errors.New("could not cast parameter 4 to infrastructures.ILogger")
+				}
+				pi5, err := ctn.SafeGet("events")
+				if err != nil {
+					var eo services.IUserService
+					return eo, err
+				}
+				p5, ok := pi5.(infrastructures.IEventBus)
+				if !ok {
+					var eo services.IUserService
+					return eo, errors.New("could not cast parameter 5 to infrastructures.IEventBus")
+				}
+				b, ok := d.Build.(func(repositories.IUserRepository, services.ISettingService, infrastructures.ICache, transactions.IUnitOfWork, infrastructures.ILogger, infrastructures.IEventBus) (services.IUserService, error))
+				if !ok {
+					var eo services.IUserService
+					return eo, errors.New("could not cast build function to func(repositories.IUserRepository, services.ISettingService, infrastructures.ICache, transactions.IUnitOfWork, infrastructures.ILogger, infrastructures.IEventBus) (services.IUserService, error)")
+				}
+				return b(p0, p1, p2, p3, p4, p5)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "user-version-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("user-version-repository")
+				if err != nil {
+					var eo repositories.IUserVersionRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IUserVersionRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IUserVersionRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IUserVersionRepository, error))
+				if !ok {
+					var eo repositories.IUserVersionRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IUserVersionRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "user-welcome-mail",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("user-welcome-mail")
+				if err != nil {
+					var eo mails.IMailRenderer
+					return eo, err
+				}
+				b, ok := d.Build.(func() (mails.IMailRenderer, error))
+				if !ok {
+					var eo mails.IMailRenderer
+					return eo, errors.New("could not cast build function to func() (mails.IMailRenderer, error)")
+				}
+				return b()
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "vote-controller",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("vote-controller")
+				if err != nil {
+					var eo controllers.VoteController
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("vote-service")
+				if err != nil {
+					var eo controllers.VoteController
+					return eo, err
+				}
+				p0, ok := pi0.(services.IVoteService)
+				if !ok {
+					var eo controllers.VoteController
+					return eo, errors.New("could not cast parameter 0 to services.IVoteService")
+				}
+				b, ok := d.Build.(func(services.IVoteService) (controllers.VoteController, error))
+				if !ok {
+					var eo controllers.VoteController
+					return eo, errors.New("could not cast build function to func(services.IVoteService) (controllers.VoteController, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "vote-repository",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("vote-repository")
+				if err != nil {
+					var eo repositories.IVoteRepository
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("db")
+				if err != nil {
+					var eo repositories.IVoteRepository
+					return eo, err
+				}
+				p0, ok := pi0.(infrastructures.IGormDatabase)
+				if !ok {
+					var eo repositories.IVoteRepository
+					return eo, errors.New("could not cast parameter 0 to infrastructures.IGormDatabase")
+				}
+				b, ok := d.Build.(func(infrastructures.IGormDatabase) (repositories.IVoteRepository, error))
+				if !ok {
+					var eo repositories.IVoteRepository
+					return eo, errors.New("could not cast build function to func(infrastructures.IGormDatabase) (repositories.IVoteRepository, error)")
+				}
+				return b(p0)
+			},
+			Close: func(obj interface{}) error {
+				return nil
+			},
+		},
+		{
+			Name:  "vote-service",
+			Scope: "app",
+			Build: func(ctn di.Container) (interface{}, error) {
+				d, err := provider.Get("vote-service")
+				if err != nil {
+					var eo services.IVoteService
+					return eo, err
+				}
+				pi0, err := ctn.SafeGet("vote-repository")
+				if err != nil {
+					var eo services.IVoteService
+					return eo, err
+				}
+				p0, ok := pi0.(repositories.IVoteRepository)
+				if !ok {
+					var eo services.IVoteService
+					return eo, errors.New("could not cast parameter 0 to repositories.IVoteRepository")
+				}
+				pi1, err := ctn.SafeGet("user-repository")
+				if err != nil {
+					var eo services.IVoteService
+					return eo, err
+				}
+				p1, ok := pi1.(repositories.IUserRepository)
+				if !ok {
+					var eo services.IVoteService
+					return eo, errors.New("could not cast parameter 1 to repositories.IUserRepository")
+				}
+				pi2, err := ctn.SafeGet("comment-repository")
+				if err != nil {
+					var eo services.IVoteService
+					return eo, err
+				}
+				p2, ok := pi2.(repositories.ICommentRepository)
+				if !ok {
+					var eo services.IVoteService
+					return eo, errors.New("could not cast parameter 2 to repositories.ICommentRepository")
+				}
+				b, ok := d.Build.(func(repositories.IVoteRepository, repositories.IUserRepository, repositories.ICommentRepository) (services.IVoteService, error))
+				if !ok {
+					var eo services.IVoteService
+					return eo, errors.New("could not cast build function to func(repositories.IVoteRepository, repositories.IUserRepository, repositories.ICommentRepository) (services.IVoteService, error)")
+				}
+				return b(p0, p1, p2)
 			},
 			Close: func(obj interface{}) error {
 				return nil
@@ -0,0 +1,48 @@
+package container
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/sarulabs/dingo/v4"
+	"github.com/sarulabs/dingo/v4/templates"
+)
+
+/**
+ * Generate
+ * writes the container of the provider in outputDirectory/dic like dingo.GenerateContainer, with ContainerTemplate for
+ * the container file. The directory is deleted first, the code of this package is kept out of it
+ *
+ * @param dingo.Provider provider
+ * @param string outputDirectory
+ *
+ * @return error
+ */
+func Generate(provider dingo.Provider, outputDirectory string) error {
+	scanner := &dingo.Scanner{Provider: provider}
+	scan, err := scanner.Scan()
+	if err != nil {
+		return fmt.Errorf("could not scan definitions: %v", err)
+	}
+
+	if err = os.RemoveAll(outputDirectory + "/dic"); err != nil {
+		return fmt.Errorf("could not remove destination directory: %v", err)
+	}
+	err = templates.WriteTemplate(outputDirectory+"/dic/defs.go", templates.DefsTemplate, map[string]interface{}{
+		"Imports": scan.TypeManager.Imports(),
+		"Defs":    scan.Defs,
+	})
+	if err != nil {
+		return fmt.Errorf("could not generate definition file: %v", err)
+	}
+	err = templates.WriteTemplate(outputDirectory+"/dic/container.go", ContainerTemplate, map[string]interface{}{
+		"Imports":         scan.ImportsWithoutParams,
+		"Defs":            scan.Defs,
+		"ProviderPackage": scan.ProviderPackage,
+		"ProviderName":    scan.ProviderName,
+	})
+	if err != nil {
+		return fmt.Errorf("could not generate container file: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package container
+
+import (
+	"fmt"
+
+	"gotham/app/container/dic"
+	providerPkg "gotham/app/provider"
+)
+
+/**
+ * Graph
+ * the dependency graph of the definitions the container is built from. A definition of a scope the container does not
+ * have can not be built by it, it is a violation too
+ *
+ * @param *dic.Container c
+ *
+ * @return providerPkg.Graph, error
+ */
+func Graph(c *dic.Container) (providerPkg.Graph, error) {
+	provider := &providerPkg.Provider{}
+	if err := provider.Load(); err != nil {
+		return providerPkg.Graph{}, err
+	}
+	graph, err := providerPkg.NewGraph(provider)
+	if err != nil {
+		return graph, err
+	}
+
+	scopes := map[string]bool{}
+	for _, scope := range append([]string{c.Scope()}, c.SubScopes()...) {
+		scopes[scope] = true
+	}
+	for _, node := range graph.Nodes {
+		if !scopes[node.Scope] {
+			graph.Violations = append(graph.Violations, providerPkg.GraphViolation{From: node.Name, To: node.Name, Reason: fmt.Sprintf("the container has no %v scope", node.Scope)})
+		}
+	}
+	return graph, nil
+}
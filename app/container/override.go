@@ -0,0 +1,23 @@
+package container
+
+import (
+	"gotham/app/container/dic"
+)
+
+// NewTestContainer creates a Container in the di.App scope where the given definitions are replaced by the provided
+// objects, so tests can swap repositories or mailers with mocks without re-adding definitions by hand. The object must
+// have the type returned by the definition (e.g. a repositories.IUserRepository mock for "user-repository"), every
+// definition depending on it receives the override. It lives outside dic, which is deleted when the container is
+// generated again
+func NewTestContainer(overrides map[string]interface{}) (*dic.Container, error) {
+	b, err := dic.NewBuilder()
+	if err != nil {
+		return nil, err
+	}
+	for name, obj := range overrides {
+		if err = b.Set(name, obj); err != nil {
+			return nil, err
+		}
+	}
+	return b.Build(), nil
+}
@@ -0,0 +1,56 @@
+package typed
+
+// The typed core of the container: the accessors generated in dic go through it, and new definitions can be retrieved
+// with it instead of adding their own accessors.
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Getter is a container, a *dic.Container
+type Getter interface {
+	SafeGet(name string) (interface{}, error)
+}
+
+// UnscopedGetter is a container getting the objects of the more specific scopes, a *dic.Container
+type UnscopedGetter interface {
+	UnscopedSafeGet(name string) (interface{}, error)
+}
+
+// Get retrieves the object of a definition with its concrete type.
+// It returns an error if the object could not be built or if it does not have the type T.
+//
+//	repository, err := typed.Get[repositories.IUserRepository](ctn, "user-repository")
+func Get[T any](c Getter, name string) (T, error) {
+	i, err := c.SafeGet(name)
+	return cast[T](name, i, err)
+}
+
+// MustGet is similar to Get but it does not return the error.
+// Instead it panics.
+func MustGet[T any](c Getter, name string) T {
+	o, err := Get[T](c, name)
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// UnscopedGet works like Get but it retrieves the object with UnscopedSafeGet.
+func UnscopedGet[T any](c UnscopedGetter, name string) (T, error) {
+	i, err := c.UnscopedSafeGet(name)
+	return cast[T](name, i, err)
+}
+
+func cast[T any](name string, i interface{}, err error) (T, error) {
+	var o T
+	if err != nil {
+		return o, err
+	}
+	o, ok := i.(T)
+	if !ok {
+		return o, fmt.Errorf("could get '%v' because the object could not be cast to %v", name, reflect.TypeOf((*T)(nil)).Elem())
+	}
+	return o, nil
+}
@@ -1,38 +1,399 @@
 package defs
 
 import (
+	"io/fs"
+
 	"github.com/sarulabs/di/v2"
 	"github.com/sarulabs/dingo/v4"
+	"gotham/config"
 	"gotham/controllers"
+	"gotham/infrastructures"
 	"gotham/policies"
 	"gotham/services"
+	"gotham/views"
 )
 
 var ControllersDefs = []dingo.Def{
 	{
 		Name:  "user-controller",
 		Scope: di.App,
-		Build: func(service services.IUserService, userPolicy policies.IUserPolicy) (controllers.UserController, error) {
+		Build: func(service services.IUserService, userPolicy policies.IUserPolicy, userProjectionService services.IUserProjectionService) (controllers.UserController, error) {
 			return controllers.UserController{
-				UserService: service,
-				UserPolicy:  userPolicy,
+				UserService:           service,
+				UserProjectionService: userProjectionService,
+				UserPolicy:            userPolicy,
 			}, nil
 		},
 		Params: dingo.Params{
 			"0": dingo.Service("user-service"),
 			"1": dingo.Service("user-policy"),
+			"2": dingo.Service("user-projection-service"),
 		},
 	},
 	{
 		Name:  "auth-controller",
 		Scope: di.App,
-		Build: func(service services.IAuthService) (controllers.AuthController, error) {
+		Build: func(service services.IAuthService, registrationService services.IRegistrationService, magicLinkService services.IMagicLinkService, sessionService services.ISessionService, challengeService services.IChallengeService, clock infrastructures.IClock) (controllers.AuthController, error) {
 			return controllers.AuthController{
-				AuthService: service,
+				AuthService:         service,
+				RegistrationService: registrationService,
+				MagicLinkService:    magicLinkService,
+				SessionService:      sessionService,
+				ChallengeService:    challengeService,
+				Clock:               clock,
+				Config:              &config.Conf.Session,
 			}, nil
 		},
 		Params: dingo.Params{
 			"0": dingo.Service("auth-service"),
+			"1": dingo.Service("registration-service"),
+			"2": dingo.Service("magic-link-service"),
+			"3": dingo.Service("session-service"),
+			"4": dingo.Service("challenge-service"),
+			"5": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "account-controller",
+		Scope: di.App,
+		Build: func(service services.IPrivacyService, quotaService services.IQuotaService, emailChangeService services.IEmailChangeService, userService services.IUserService) (controllers.AccountController, error) {
+			return controllers.AccountController{
+				PrivacyService:     service,
+				QuotaService:       quotaService,
+				EmailChangeService: emailChangeService,
+				UserService:        userService,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("privacy-service"),
+			"1": dingo.Service("quota-service"),
+			"2": dingo.Service("email-change-service"),
+			"3": dingo.Service("user-service"),
+		},
+	},
+	{
+		Name:  "consent-controller",
+		Scope: di.App,
+		Build: func(service services.IConsentService) (controllers.ConsentController, error) {
+			return controllers.ConsentController{
+				ConsentService: service,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("consent-service"),
+		},
+	},
+	{
+		Name:  "setting-controller",
+		Scope: di.App,
+		Build: func(service services.ISettingService) (controllers.SettingController, error) {
+			return controllers.SettingController{
+				SettingService: service,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("setting-service"),
+		},
+	},
+	{
+		Name:  "api-key-controller",
+		Scope: di.App,
+		Build: func(service services.IApiKeyService) (controllers.ApiKeyController, error) {
+			return controllers.ApiKeyController{
+				ApiKeyService: service,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("api-key-service"),
+		},
+	},
+	{
+		Name:  "client-controller",
+		Scope: di.App,
+		Build: func(service services.IClientService) (controllers.ClientController, error) {
+			return controllers.ClientController{
+				ClientService: service,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("client-service"),
+		},
+	},
+	{
+		Name:  "internal-controller",
+		Scope: di.App,
+		Build: func(service services.IUserService) (controllers.InternalController, error) {
+			return controllers.InternalController{
+				UserService: service,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("user-service"),
+		},
+	},
+	{
+		Name:  "invitation-controller",
+		Scope: di.App,
+		Build: func(service services.IInvitationService) (controllers.InvitationController, error) {
+			return controllers.InvitationController{
+				InvitationService: service,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("invitation-service"),
+		},
+	},
+	{
+		Name:  "impersonation-controller",
+		Scope: di.App,
+		Build: func(service services.IImpersonationService) (controllers.ImpersonationController, error) {
+			return controllers.ImpersonationController{
+				ImpersonationService: service,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("impersonation-service"),
+		},
+	},
+	{
+		Name:  "user-history-controller",
+		Scope: di.App,
+		Build: func(service services.IUserHistoryService) (controllers.UserHistoryController, error) {
+			return controllers.UserHistoryController{
+				UserHistoryService: service,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("user-history-service"),
+		},
+	},
+	{
+		Name:  "suspension-controller",
+		Scope: di.App,
+		Build: func(service services.ISuspensionService) (controllers.SuspensionController, error) {
+			return controllers.SuspensionController{
+				SuspensionService: service,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("suspension-service"),
+		},
+	},
+	{
+		Name:  "phone-controller",
+		Scope: di.App,
+		Build: func(service services.IPhoneService) (controllers.PhoneController, error) {
+			return controllers.PhoneController{
+				PhoneService: service,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("phone-service"),
+		},
+	},
+	{
+		Name:  "metrics-controller",
+		Scope: di.App,
+		Build: func(metrics infrastructures.IMetrics) (controllers.MetricsController, error) {
+			return controllers.MetricsController{
+				Metrics: metrics,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("metrics"),
+		},
+	},
+	{
+		Name:  "billing-controller",
+		Scope: di.App,
+		Build: func(service services.IBillingService, invoiceService services.IInvoiceService) (controllers.BillingController, error) {
+			return controllers.BillingController{BillingService: service, InvoiceService: invoiceService}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("billing-service"),
+			"1": dingo.Service("invoice-service"),
+		},
+	},
+	{
+		Name:  "coupon-controller",
+		Scope: di.App,
+		Build: func(service services.ICouponService) (controllers.CouponController, error) {
+			return controllers.CouponController{CouponService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("coupon-service"),
+		},
+	},
+	{
+		Name:  "vote-controller",
+		Scope: di.App,
+		Build: func(service services.IVoteService) (controllers.VoteController, error) {
+			return controllers.VoteController{VoteService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("vote-service"),
+		},
+	},
+	{
+		Name:  "comment-controller",
+		Scope: di.App,
+		Build: func(service services.ICommentService) (controllers.CommentController, error) {
+			return controllers.CommentController{CommentService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("comment-service"),
+		},
+	},
+	{
+		Name:  "dead-letter-controller",
+		Scope: di.App,
+		Build: func(service services.IDeadLetterService) (controllers.DeadLetterController, error) {
+			return controllers.DeadLetterController{DeadLetterService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("dead-letter-service"),
+		},
+	},
+	{
+		Name:  "deprecation-controller",
+		Scope: di.App,
+		Build: func(service services.IDeprecationService) (controllers.DeprecationController, error) {
+			return controllers.DeprecationController{DeprecationService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("deprecation-service"),
+		},
+	},
+	{
+		Name:  "config-controller",
+		Scope: di.App,
+		Build: func(service services.IConfigService) (controllers.ConfigController, error) {
+			return controllers.ConfigController{ConfigService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("config-service"),
+		},
+	},
+	{
+		Name:  "slo-controller",
+		Scope: di.App,
+		Build: func(service services.ISloService) (controllers.SloController, error) {
+			return controllers.SloController{SloService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("slo-service"),
+		},
+	},
+	{
+		Name:  "report-controller",
+		Scope: di.App,
+		Build: func(service services.IReportService) (controllers.ReportController, error) {
+			return controllers.ReportController{ReportService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("report-service"),
+		},
+	},
+	{
+		Name:  "tag-controller",
+		Scope: di.App,
+		Build: func(service services.ITagService) (controllers.TagController, error) {
+			return controllers.TagController{TagService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("tag-service"),
+		},
+	},
+	{
+		Name:  "media-controller",
+		Scope: di.App,
+		Build: func(service services.IMediaService) (controllers.MediaController, error) {
+			return controllers.MediaController{MediaService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("media-service"),
+		},
+	},
+	{
+		Name:  "follow-controller",
+		Scope: di.App,
+		Build: func(service services.IFollowService) (controllers.FollowController, error) {
+			return controllers.FollowController{FollowService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("follow-service"),
+		},
+	},
+	{
+		Name:  "conversation-controller",
+		Scope: di.App,
+		Build: func(service services.IConversationService) (controllers.ConversationController, error) {
+			return controllers.ConversationController{ConversationService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("conversation-service"),
+		},
+	},
+	{
+		Name:  "socket-controller",
+		Scope: di.App,
+		Build: func(hub infrastructures.IHub) (controllers.SocketController, error) {
+			return controllers.SocketController{Hub: hub}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("hub"),
+		},
+	},
+	{
+		Name:  "notification-controller",
+		Scope: di.App,
+		Build: func(service services.INotificationService) (controllers.NotificationController, error) {
+			return controllers.NotificationController{NotificationService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("notification-service"),
+		},
+	},
+	{
+		Name:  "announcement-controller",
+		Scope: di.App,
+		Build: func(service services.IAnnouncementService) (controllers.AnnouncementController, error) {
+			return controllers.AnnouncementController{AnnouncementService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("announcement-service"),
+		},
+	},
+	{
+		Name:  "admin-controller",
+		Scope: di.App,
+		Build: func(resources services.AdminResources) (controllers.AdminController, error) {
+			return controllers.AdminController{Resources: resources}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("admin-resources"),
+		},
+	},
+	{
+		Name:  "admin-ui-controller",
+		Scope: di.App,
+		Build: func() (controllers.AdminUIController, error) {
+			assets, err := fs.Sub(views.Admin, "admin")
+			if err != nil {
+				return controllers.AdminUIController{}, err
+			}
+			return controllers.NewAdminUIController(assets, "/admin/ui", &config.Conf.AdminUI)
+		},
+	},
+	{
+		Name:  "logging-controller",
+		Scope: di.App,
+		Build: func(levels *infrastructures.LogLevels, logger infrastructures.ILogger) (controllers.LoggingController, error) {
+			return controllers.LoggingController{Levels: levels, Logger: logger.With(infrastructures.Fields{"component": "audit"})}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("log-levels"),
+			"1": dingo.Service("logger"),
 		},
 	},
 }
@@ -0,0 +1,69 @@
+package defs
+
+import (
+	"github.com/sarulabs/di/v2"
+	"github.com/sarulabs/dingo/v4"
+	"gotham/config"
+	"gotham/infrastructures"
+)
+
+/**
+ * EnvironmentsDefs
+ * definitions loaded on top of the default ones for an APP_ENV. A definition replacing a default one must keep its
+ * build function type, the generated container casts to it. "prod" uses the default definitions
+ */
+var EnvironmentsDefs = map[string][]dingo.Def{
+	"test": {
+		{
+			Name:  "email",
+			Scope: di.App,
+			Build: func() (emailService infrastructures.IEmailService, err error) {
+				return infrastructures.NewLogEmailService(), nil
+			},
+		},
+		{
+			Name:  "sms",
+			Scope: di.App,
+			Build: func(clients infrastructures.IHttpClientFactory) (infrastructures.ISmsService, error) {
+				return infrastructures.NewLogSmsService(), nil
+			},
+			Params: dingo.Params{
+				"0": dingo.Service("http-client-factory"),
+			},
+		},
+		{
+			Name:  "captcha",
+			Scope: di.App,
+			Build: func(clients infrastructures.IHttpClientFactory) (infrastructures.ICaptchaService, error) {
+				return infrastructures.FakeCaptchaService{Pass: "test-captcha-pass"}, nil
+			},
+			Params: dingo.Params{
+				"0": dingo.Service("http-client-factory"),
+			},
+		},
+		{
+			Name:  "payment-gateway",
+			Scope: di.App,
+			Build: func(clients infrastructures.IHttpClientFactory) (infrastructures.IPaymentGateway, error) {
+				billingConfig := config.Conf.Billing
+				billingConfig.StripeWebhookSecret = "test-webhook-secret"
+				return infrastructures.NewLogPaymentGateway(&billingConfig), nil
+			},
+			Params: dingo.Params{
+				"0": dingo.Service("http-client-factory"),
+			},
+		},
+		{
+			Name:  "error-reporter",
+			Scope: di.App,
+			Build: func(clients infrastructures.IHttpClientFactory, logger infrastructures.ILogger, breadcrumbs *infrastructures.Breadcrumbs) (infrastructures.IErrorReporter, error) {
+				return infrastructures.NewLogErrorReporter(&config.Conf.Errors, logger), nil
+			},
+			Params: dingo.Params{
+				"0": dingo.Service("http-client-factory"),
+				"1": dingo.Service("logger"),
+				"2": dingo.Service("breadcrumbs"),
+			},
+		},
+	},
+}
@@ -3,8 +3,11 @@ package defs
 import (
 	"github.com/sarulabs/di/v2"
 	"github.com/sarulabs/dingo/v4"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
 	"gotham/config"
 	"gotham/infrastructures"
+	"gotham/signing"
 )
 
 var InfrastructuresDefs = []dingo.Def{
@@ -12,24 +15,83 @@ var InfrastructuresDefs = []dingo.Def{
 		Name:  "db-pool",
 		Scope: di.App,
 		Build: func() (infrastructures.IGormDatabasePool, error) {
-			return infrastructures.NewGormDatabasePool(config.GetDbConfig()), nil
+			return infrastructures.NewGormDatabasePool(config.GetDbConfig())
 		},
 		NotForAutoFill: true,
 	},
 	{
 		Name:  "db",
 		Scope: di.App,
-		Build: func(pool infrastructures.IGormDatabasePool) (infrastructures.IGormDatabase, error) {
-			return infrastructures.NewGormDatabase(pool)
+		Build: func(pool infrastructures.IGormDatabasePool, logger gormLogger.Interface, nPlusOne gorm.Plugin) (infrastructures.IGormDatabase, error) {
+			return infrastructures.NewGormDatabase(pool, logger, nPlusOne)
 		},
 		Params: dingo.Params{
 			"0": dingo.Service("db-pool"),
+			"1": dingo.Service("gorm-logger"),
+			"2": dingo.Service("n-plus-one-detector"),
 		},
 		Close: func(db infrastructures.IGormDatabase) error {
 			gormDB, _ := db.DB().DB()
 			return gormDB.Close()
 		},
 	},
+	{
+		Name:  "shards",
+		Scope: di.App,
+		Build: func(db infrastructures.IGormDatabase, logger gormLogger.Interface, nPlusOne gorm.Plugin) (infrastructures.IShards, error) {
+			return infrastructures.NewShards(db, config.GetDbConfig(), &config.Conf.Shards, logger, nPlusOne)
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+			"1": dingo.Service("gorm-logger"),
+			"2": dingo.Service("n-plus-one-detector"),
+		},
+		Close: func(shards infrastructures.IShards) error {
+			return shards.Close()
+		},
+	},
+	{
+		Name:  "logger",
+		Scope: di.App,
+		Build: func(breadcrumbs *infrastructures.Breadcrumbs, levels *infrastructures.LogLevels) (infrastructures.ILogger, error) {
+			return infrastructures.NewBreadcrumbLogger(infrastructures.NewJsonLogger(levels), breadcrumbs), nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("breadcrumbs"),
+			"1": dingo.Service("log-levels"),
+		},
+	},
+	{
+		Name:  "gorm-logger",
+		Scope: di.App,
+		Build: func(logger infrastructures.ILogger) (gormLogger.Interface, error) {
+			return infrastructures.NewGormLogger(logger, &config.Conf.Logger), nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("logger"),
+		},
+	},
+	{
+		Name:  "n-plus-one-detector",
+		Scope: di.App,
+		Build: func(logger infrastructures.ILogger) (gorm.Plugin, error) {
+			return infrastructures.NewNPlusOneDetector(logger, &config.Conf.Logger), nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("logger"),
+		},
+	},
+	{
+		Name:  "cache",
+		Scope: di.App,
+		Build: func() (infrastructures.ICache, error) {
+			cache, err := infrastructures.NewCache(&config.Conf.Cache)
+			if err != nil {
+				return nil, err
+			}
+			return infrastructures.NewTracedCache(cache), nil
+		},
+	},
 	{
 		Name:  "email",
 		Scope: di.App,
@@ -37,4 +99,217 @@ var InfrastructuresDefs = []dingo.Def{
 			return infrastructures.NewEmailService(&config.Conf.Email), nil
 		},
 	},
+	{
+		Name:  "sms",
+		Scope: di.App,
+		Build: func(clients infrastructures.IHttpClientFactory) (infrastructures.ISmsService, error) {
+			return infrastructures.NewSmsService(&config.Conf.Sms, clients)
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("http-client-factory"),
+		},
+	},
+	{
+		Name:  "payment-gateway",
+		Scope: di.App,
+		Build: func(clients infrastructures.IHttpClientFactory) (infrastructures.IPaymentGateway, error) {
+			return infrastructures.NewPaymentGateway(&config.Conf.Billing, clients)
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("http-client-factory"),
+		},
+	},
+	{
+		Name:  "captcha",
+		Scope: di.App,
+		Build: func(clients infrastructures.IHttpClientFactory) (infrastructures.ICaptchaService, error) {
+			return infrastructures.NewCaptchaService(&config.Conf.Captcha, clients)
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("http-client-factory"),
+		},
+	},
+	{
+		Name:  "http-client-factory",
+		Scope: di.App,
+		Build: func(metrics infrastructures.IMetrics) (infrastructures.IHttpClientFactory, error) {
+			return infrastructures.NewHttpClientFactory(&config.Conf.Http, metrics), nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("metrics"),
+		},
+	},
+	{
+		Name:  "storage",
+		Scope: di.App,
+		Build: func() (infrastructures.IStorageService, error) {
+			return infrastructures.NewLocalStorageService(&config.Conf.Storage), nil
+		},
+	},
+	{
+		Name:  "pdf",
+		Scope: di.App,
+		Build: func() (infrastructures.IPdfService, error) {
+			return infrastructures.NewTextPdfService(), nil
+		},
+	},
+	{
+		Name:  "scheduler",
+		Scope: di.App,
+		Build: func() (infrastructures.IScheduler, error) {
+			return infrastructures.NewScheduler(), nil
+		},
+		Close: func(scheduler infrastructures.IScheduler) error {
+			scheduler.Stop()
+			return nil
+		},
+	},
+	{
+		Name:  "feature-flags",
+		Scope: di.App,
+		Build: func() (infrastructures.IFeatureFlags, error) {
+			return infrastructures.NewFeatureFlags(&config.Conf.Features), nil
+		},
+	},
+	{
+		Name:  "analytics",
+		Scope: di.App,
+		Build: func() (infrastructures.IAnalytics, error) {
+			return infrastructures.NewFileAnalytics(&config.Conf.Analytics), nil
+		},
+		Close: func(analytics infrastructures.IAnalytics) error {
+			return analytics.Close()
+		},
+	},
+	{
+		Name:  "metrics",
+		Scope: di.App,
+		Build: func() (infrastructures.IMetrics, error) {
+			return infrastructures.NewMetrics(), nil
+		},
+	},
+	{
+		Name:  "database-supervisor",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase, metrics infrastructures.IMetrics) (infrastructures.IDatabaseSupervisor, error) {
+			return infrastructures.NewDatabaseSupervisor(gormDatabase, metrics, &config.Conf.DbSupervisor), nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+			"1": dingo.Service("metrics"),
+		},
+	},
+	{
+		Name:  "db-retrier",
+		Scope: di.App,
+		Build: func(metrics infrastructures.IMetrics) (infrastructures.IDbRetrier, error) {
+			return infrastructures.NewDbRetrier(metrics, &config.Conf.DbRetry), nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("metrics"),
+		},
+	},
+	{
+		Name:  "events",
+		Scope: di.App,
+		Build: func() (infrastructures.IEventBus, error) {
+			return infrastructures.NewMemoryEventBus(), nil
+		},
+		Close: func(events infrastructures.IEventBus) error {
+			return events.Close()
+		},
+	},
+	{
+		Name:  "queue",
+		Scope: di.App,
+		Build: func() (infrastructures.IQueue, error) {
+			return infrastructures.NewQueue(&config.Conf.Queue, &config.Conf.Cache)
+		},
+		Close: func(queue infrastructures.IQueue) error {
+			return queue.Close()
+		},
+	},
+	{
+		Name:  "hub",
+		Scope: di.App,
+		Build: func() (infrastructures.IHub, error) {
+			return infrastructures.NewWebSocketHub(), nil
+		},
+		Close: func(hub infrastructures.IHub) error {
+			return hub.Close()
+		},
+	},
+	{
+		Name:  "push",
+		Scope: di.App,
+		Build: func(clients infrastructures.IHttpClientFactory) (infrastructures.IPushService, error) {
+			return infrastructures.NewPushService(&config.Conf.Push, clients)
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("http-client-factory"),
+		},
+	},
+	{
+		Name:  "search",
+		Scope: di.App,
+		Build: func(clients infrastructures.IHttpClientFactory) (infrastructures.ISearchEngine, error) {
+			return infrastructures.NewSearchEngine(&config.Conf.Search, clients)
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("http-client-factory"),
+		},
+	},
+	{
+		Name:  "signer",
+		Scope: di.App,
+		Build: func(clock infrastructures.IClock) (signing.Signer, error) {
+			return signing.Signer{KeyID: config.Conf.Signing.KeyID, Secret: []byte(config.Conf.Signing.Secret), Now: clock.Now}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "clock",
+		Scope: di.App,
+		Build: func() (infrastructures.IClock, error) {
+			return infrastructures.NewSystemClock(), nil
+		},
+	},
+	{
+		Name:  "random",
+		Scope: di.App,
+		Build: func() (infrastructures.IRandom, error) {
+			return infrastructures.NewSecureRandom(), nil
+		},
+	},
+	{
+		Name:  "error-reporter",
+		Scope: di.App,
+		Build: func(clients infrastructures.IHttpClientFactory, logger infrastructures.ILogger, breadcrumbs *infrastructures.Breadcrumbs) (infrastructures.IErrorReporter, error) {
+			return infrastructures.NewErrorReporter(&config.Conf.Errors, clients, logger, breadcrumbs)
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("http-client-factory"),
+			"1": dingo.Service("logger"),
+			"2": dingo.Service("breadcrumbs"),
+		},
+		Close: func(reporter infrastructures.IErrorReporter) error {
+			return reporter.Close()
+		},
+	},
+	{
+		Name:  "breadcrumbs",
+		Scope: di.App,
+		Build: func() (*infrastructures.Breadcrumbs, error) {
+			return infrastructures.NewBreadcrumbs(config.Conf.Errors.Breadcrumbs), nil
+		},
+	},
+	{
+		Name:  "log-levels",
+		Scope: di.App,
+		Build: func() (*infrastructures.LogLevels, error) {
+			return infrastructures.NewLogLevels(&config.Conf.Logger), nil
+		},
+	},
 }
@@ -15,4 +15,25 @@ var MailsDefs = []dingo.Def{
 			return mails.NewWelcome(*email.NewEmail()), nil
 		},
 	},
+	{
+		Name:  "email-change-confirmation-mail",
+		Scope: di.App,
+		Build: func() (mail mails.IMailRenderer, err error) {
+			return mails.NewEmailChangeConfirmation(*email.NewEmail()), nil
+		},
+	},
+	{
+		Name:  "email-change-notice-mail",
+		Scope: di.App,
+		Build: func() (mail mails.IMailRenderer, err error) {
+			return mails.NewEmailChangeNotice(*email.NewEmail()), nil
+		},
+	},
+	{
+		Name:  "magic-link-mail",
+		Scope: di.App,
+		Build: func() (mail mails.IMailRenderer, err error) {
+			return mails.NewMagicLink(*email.NewEmail()), nil
+		},
+	},
 }
@@ -1,10 +1,18 @@
 package defs
 
 import (
+	"context"
+	"time"
+
 	"github.com/sarulabs/di/v2"
 	"github.com/sarulabs/dingo/v4"
+	"gotham/config"
+	_ "gotham/docs"
+	"gotham/infrastructures"
 	GMiddleware "gotham/middlewares"
+	"gotham/openapi"
 	"gotham/services"
+	"gotham/signing"
 )
 
 var MiddlewaresDefs = []dingo.Def{
@@ -31,11 +39,262 @@ var MiddlewaresDefs = []dingo.Def{
 	{
 		Name:  "auth-middleware",
 		Scope: di.App,
-		Build: func(repository services.IUserService) (s GMiddleware.Auth, err error) {
-			return GMiddleware.Auth{UserService: repository}, nil
+		Build: func(repository services.IUserService, logger infrastructures.ILogger) (s GMiddleware.Auth, err error) {
+			return GMiddleware.Auth{UserService: repository, Logger: logger.With(infrastructures.Fields{"component": "audit"})}, nil
 		},
 		Params: dingo.Params{
 			"0": dingo.Service("user-service"),
+			"1": dingo.Service("logger"),
+		},
+	},
+	{
+		Name:  "shadow-middleware",
+		Scope: di.App,
+		Build: func(factory infrastructures.IHttpClientFactory) (s GMiddleware.Shadow, err error) {
+			return GMiddleware.NewShadow(factory, &config.Conf.Shadow), nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("http-client-factory"),
+		},
+	},
+	{
+		Name:  "recorder-middleware",
+		Scope: di.App,
+		Build: func() (s *GMiddleware.Recorder, err error) {
+			return GMiddleware.NewRecorder(&config.Conf.Recorder), nil
+		},
+		Close: func(recorder *GMiddleware.Recorder) error {
+			return recorder.Close()
+		},
+	},
+	{
+		Name:  "features-middleware",
+		Scope: di.App,
+		Build: func(featureFlags infrastructures.IFeatureFlags) (s GMiddleware.Features, err error) {
+			return GMiddleware.Features{FeatureFlags: featureFlags, Config: &config.Conf.Features}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("feature-flags"),
+		},
+	},
+	{
+		Name:  "consent-middleware",
+		Scope: di.App,
+		Build: func(service services.IConsentService) (s GMiddleware.Consent, err error) {
+			return GMiddleware.Consent{
+				ConsentService: service,
+				Except: []string{
+					"/v1/restricted/policies/:policy/accept",
+					"/v1/restricted/users/me/data-export",
+					"/v1/restricted/users/me/data-exports/:export",
+					"/v1/restricted/users/me/data-exports/:export/download",
+					"/v1/restricted/users/me",
+					"/v1/restricted/users/me/restore",
+				},
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("consent-service"),
+		},
+	},
+	{
+		Name:  "analytics-middleware",
+		Scope: di.App,
+		Build: func(analytics infrastructures.IAnalytics) (s GMiddleware.Analytics, err error) {
+			return GMiddleware.Analytics{Analytics: analytics, Config: &config.Conf.Analytics}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("analytics"),
+		},
+	},
+	{
+		Name:  "access-log-middleware",
+		Scope: di.App,
+		Build: func(logger infrastructures.ILogger) (s GMiddleware.AccessLog, err error) {
+			return GMiddleware.AccessLog{Logger: logger.With(infrastructures.Fields{"component": "http"})}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("logger"),
+		},
+	},
+	{
+		Name:  "quota-middleware",
+		Scope: di.App,
+		Build: func(service services.IQuotaService) (s GMiddleware.Quota, err error) {
+			return GMiddleware.Quota{QuotaService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("quota-service"),
+		},
+	},
+	{
+		Name:  "response-cache-middleware",
+		Scope: di.App,
+		Build: func(cache infrastructures.ICache) (s GMiddleware.ResponseCache, err error) {
+			return GMiddleware.ResponseCache{Cache: cache, Config: &config.Conf.Cache}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("cache"),
+		},
+	},
+	{
+		Name:  "budget-middleware",
+		Scope: di.App,
+		Build: func(metrics infrastructures.IMetrics) (s GMiddleware.Budget, err error) {
+			return GMiddleware.Budget{Metrics: metrics, Config: &config.Conf.Budget}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("metrics"),
+		},
+	},
+	{
+		Name:  "contract-middleware",
+		Scope: di.App,
+		Build: func(metrics infrastructures.IMetrics) (s GMiddleware.Contract, err error) {
+			spec, err := openapi.ReadRegisteredSpec()
+			if err != nil {
+				return s, err
+			}
+			return GMiddleware.Contract{Spec: spec, Operations: spec.Operations(), Metrics: metrics, Config: &config.Conf.Contract}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("metrics"),
+		},
+	},
+	{
+		Name:  "slo-middleware",
+		Scope: di.App,
+		Build: func(service services.ISloService) (s GMiddleware.Slo, err error) {
+			return GMiddleware.Slo{SloService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("slo-service"),
+		},
+	},
+	{
+		Name:  "billing-middleware",
+		Scope: di.App,
+		Build: func(service services.IBillingService) (s GMiddleware.Billing, err error) {
+			return GMiddleware.Billing{BillingService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("billing-service"),
+		},
+	},
+	{
+		Name:  "signature-middleware",
+		Scope: di.App,
+		Build: func(cache infrastructures.ICache, clock infrastructures.IClock) (s GMiddleware.Signature, err error) {
+			secrets := map[string][]byte{}
+			for key, secret := range config.Conf.Signing.Partners {
+				secrets[key] = []byte(secret)
+			}
+			return GMiddleware.Signature{
+				Verifier: signing.Verifier{
+					Secrets:   secrets,
+					Tolerance: config.Conf.Signing.Tolerance,
+					Remember: func(nonce string, ttl time.Duration) (bool, error) {
+						return cache.Add(context.Background(), "signing:nonce:"+nonce, []byte{1}, ttl)
+					},
+					Now: clock.Now,
+				},
+				MaxBodySize: config.Conf.Signing.MaxBodySize,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("cache"),
+			"1": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "replay-middleware",
+		Scope: di.App,
+		Build: func(cache infrastructures.ICache, clock infrastructures.IClock) (s GMiddleware.Replay, err error) {
+			return GMiddleware.Replay{Cache: cache, Clock: clock, Config: &config.Conf.Replay}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("cache"),
+			"1": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "deprecation-middleware",
+		Scope: di.App,
+		Build: func(service services.IDeprecationService) (s GMiddleware.Deprecation, err error) {
+			return GMiddleware.Deprecation{DeprecationService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("deprecation-service"),
+		},
+	},
+	{
+		Name:  "recovery-middleware",
+		Scope: di.App,
+		Build: func(reporter infrastructures.IErrorReporter) (s GMiddleware.Recovery, err error) {
+			return GMiddleware.Recovery{Reporter: reporter, Config: &config.Conf.Errors}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("error-reporter"),
+		},
+	},
+	{
+		Name:  "slow-request-middleware",
+		Scope: di.App,
+		Build: func(logger infrastructures.ILogger) (s GMiddleware.SlowRequest, err error) {
+			return GMiddleware.SlowRequest{Logger: logger.With(infrastructures.Fields{"component": "http"}), Config: &config.Conf.SlowRequest}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("logger"),
+		},
+	},
+	{
+		Name:  "access-rules-middleware",
+		Scope: di.App,
+		Build: func(service services.ISettingService) (s GMiddleware.AccessRules, err error) {
+			return GMiddleware.AccessRules{SettingService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("setting-service"),
+		},
+	},
+	{
+		Name:  "api-key-middleware",
+		Scope: di.App,
+		Build: func(service services.IApiKeyService) (s GMiddleware.ApiKey, err error) {
+			return GMiddleware.ApiKey{ApiKeyService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("api-key-service"),
+		},
+	},
+	{
+		Name:  "client-middleware",
+		Scope: di.App,
+		Build: func(service services.IClientService) (s GMiddleware.Client, err error) {
+			return GMiddleware.Client{ClientService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("client-service"),
+		},
+	},
+	{
+		Name:  "client-certificate-middleware",
+		Scope: di.App,
+		Build: func(service services.IClientService) (s GMiddleware.ClientCertificate, err error) {
+			return GMiddleware.ClientCertificate{ClientService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("client-service"),
+		},
+	},
+	{
+		Name:  "page-sizes-middleware",
+		Scope: di.App,
+		Build: func(service services.ISettingService) (s GMiddleware.PageSizes, err error) {
+			return GMiddleware.PageSizes{SettingService: service}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("setting-service"),
 		},
 	},
 }
@@ -3,19 +3,319 @@ package defs
 import (
 	"github.com/sarulabs/di/v2"
 	"github.com/sarulabs/dingo/v4"
+	"gotham/config"
 	"gotham/infrastructures"
+	"gotham/models"
 	"gotham/repositories"
+	"gotham/repositories/transactions"
 )
 
 var RepositoriesDefs = []dingo.Def{
 	{
 		Name:  "user-repository",
 		Scope: di.App,
-		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IUserRepository, error) {
-			return &repositories.UserRepository{IGormDatabase: gormDatabase}, nil
+		Build: func(gormDatabase infrastructures.IGormDatabase, shards infrastructures.IShards) (repositories.IUserRepository, error) {
+			return &repositories.UserRepository{BaseRepository: repositories.BaseRepository[models.User]{IGormDatabase: gormDatabase, Shards: shards}}, nil
 		},
 		Params: dingo.Params{
 			"0": dingo.Service("db"),
+			"1": dingo.Service("shards"),
+		},
+	},
+	{
+		Name:  "data-export-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IDataExportRepository, error) {
+			return &repositories.DataExportRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "policy-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IPolicyRepository, error) {
+			return &repositories.PolicyRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "setting-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.ISettingRepository, error) {
+			return &repositories.SettingRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "invitation-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IInvitationRepository, error) {
+			return &repositories.InvitationRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "usage-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IUsageRepository, error) {
+			return &repositories.UsageRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "email-change-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IEmailChangeRepository, error) {
+			return &repositories.EmailChangeRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "magic-link-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IMagicLinkRepository, error) {
+			return &repositories.MagicLinkRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "session-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.ISessionRepository, error) {
+			return &repositories.SessionRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "billing-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IBillingRepository, error) {
+			return &repositories.BillingRepository{IGormDatabase: gormDatabase, ProPriceID: config.Conf.Billing.ProPriceID}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "coupon-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.ICouponRepository, error) {
+			return &repositories.CouponRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "vote-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IVoteRepository, error) {
+			return &repositories.VoteRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "comment-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase, shards infrastructures.IShards) (repositories.ICommentRepository, error) {
+			return &repositories.CommentRepository{BaseRepository: repositories.BaseRepository[models.Comment]{IGormDatabase: gormDatabase, Shards: shards}}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+			"1": dingo.Service("shards"),
+		},
+	},
+	{
+		Name:  "report-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase, shards infrastructures.IShards) (repositories.IReportRepository, error) {
+			return &repositories.ReportRepository{BaseRepository: repositories.BaseRepository[models.Report]{IGormDatabase: gormDatabase, Shards: shards}}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+			"1": dingo.Service("shards"),
+		},
+	},
+	{
+		Name:  "tag-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase, shards infrastructures.IShards) (repositories.ITagRepository, error) {
+			return &repositories.TagRepository{BaseRepository: repositories.BaseRepository[models.Tag]{IGormDatabase: gormDatabase, Shards: shards}}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+			"1": dingo.Service("shards"),
+		},
+	},
+	{
+		Name:  "media-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase, shards infrastructures.IShards) (repositories.IMediaRepository, error) {
+			return &repositories.MediaRepository{BaseRepository: repositories.BaseRepository[models.Media]{IGormDatabase: gormDatabase, Shards: shards}}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+			"1": dingo.Service("shards"),
+		},
+	},
+	{
+		Name:  "follow-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IFollowRepository, error) {
+			return &repositories.FollowRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "conversation-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IConversationRepository, error) {
+			return &repositories.ConversationRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "device-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IDeviceRepository, error) {
+			return &repositories.DeviceRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "announcement-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase, shards infrastructures.IShards) (repositories.IAnnouncementRepository, error) {
+			return &repositories.AnnouncementRepository{BaseRepository: repositories.BaseRepository[models.Announcement]{IGormDatabase: gormDatabase, Shards: shards}}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+			"1": dingo.Service("shards"),
+		},
+	},
+	{
+		Name:  "api-key-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IApiKeyRepository, error) {
+			return &repositories.ApiKeyRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "client-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IClientRepository, error) {
+			return &repositories.ClientRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "user-version-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IUserVersionRepository, error) {
+			return &repositories.UserVersionRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "user-search-view-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IUserSearchViewRepository, error) {
+			return &repositories.UserSearchViewRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "saga-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.ISagaRepository, error) {
+			return &repositories.SagaRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "dead-letter-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IDeadLetterRepository, error) {
+			return &repositories.DeadLetterRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "deprecation-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IDeprecationRepository, error) {
+			return &repositories.DeprecationRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "config-snapshot-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IConfigSnapshotRepository, error) {
+			return &repositories.ConfigSnapshotRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "retention-repository",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase) (repositories.IRetentionRepository, error) {
+			return &repositories.RetentionRepository{IGormDatabase: gormDatabase}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+		},
+	},
+	{
+		Name:  "unit-of-work",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase, retrier infrastructures.IDbRetrier) (transactions.IUnitOfWork, error) {
+			return &transactions.RetryingUnitOfWork{IUnitOfWork: &transactions.UnitOfWork{IGormDatabase: gormDatabase}, Retrier: retrier}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+			"1": dingo.Service("db-retrier"),
 		},
 	},
 }
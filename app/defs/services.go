@@ -3,7 +3,13 @@ package defs
 import (
 	"github.com/sarulabs/di/v2"
 	"github.com/sarulabs/dingo/v4"
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/mails"
+	"gotham/models"
 	"gotham/repositories"
+	"gotham/repositories/transactions"
+	"gotham/requests"
 	"gotham/services"
 )
 
@@ -11,22 +17,863 @@ var ServicesDefs = []dingo.Def{
 	{
 		Name:  "auth-service",
 		Scope: di.App,
-		Build: func(repository repositories.IUserRepository) (s services.IAuthService, err error) {
-			s = &services.AuthService{UserRepository: repository}
+		Build: func(repository repositories.IUserRepository, sessionRepository repositories.ISessionRepository, cache infrastructures.ICache, clock infrastructures.IClock) (s services.IAuthService, err error) {
+			s = &services.AuthService{UserRepository: repository, SessionRepository: sessionRepository, Cache: cache, Clock: clock, Config: &config.Conf.Session}
 			return s, nil
 		},
 		Params: dingo.Params{
 			"0": dingo.Service("user-repository"),
+			"1": dingo.Service("session-repository"),
+			"2": dingo.Service("cache"),
+			"3": dingo.Service("clock"),
 		},
 	},
 	{
 		Name:  "user-service",
 		Scope: di.App,
-		Build: func(repository repositories.IUserRepository) (s services.IUserService, err error) {
-			return &services.UserService{UserRepository: repository}, nil
+		Build: func(repository repositories.IUserRepository, settingService services.ISettingService, cache infrastructures.ICache, unitOfWork transactions.IUnitOfWork, logger infrastructures.ILogger, events infrastructures.IEventBus) (s services.IUserService, err error) {
+			logger = logger.With(infrastructures.Fields{"component": "audit"})
+			return &services.UserService{UserRepository: repository, SettingService: settingService, Cache: cache, UnitOfWork: unitOfWork, Logger: logger, Events: events}, nil
 		},
 		Params: dingo.Params{
 			"0": dingo.Service("user-repository"),
+			"1": dingo.Service("setting-service"),
+			"2": dingo.Service("cache"),
+			"3": dingo.Service("unit-of-work"),
+			"4": dingo.Service("logger"),
+			"5": dingo.Service("events"),
+		},
+	},
+	{
+		Name:  "privacy-service",
+		Scope: di.App,
+		Build: func(userRepository repositories.IUserRepository, dataExportRepository repositories.IDataExportRepository, policyRepository repositories.IPolicyRepository, storage infrastructures.IStorageService, unitOfWork transactions.IUnitOfWork, usageRepository repositories.IUsageRepository, cache infrastructures.ICache, emailChangeRepository repositories.IEmailChangeRepository, sessionRepository repositories.ISessionRepository, billingRepository repositories.IBillingRepository, couponRepository repositories.ICouponRepository, voteRepository repositories.IVoteRepository, commentRepository repositories.ICommentRepository, reportRepository repositories.IReportRepository, mediaRepository repositories.IMediaRepository, followRepository repositories.IFollowRepository, conversationRepository repositories.IConversationRepository, deviceRepository repositories.IDeviceRepository, announcementRepository repositories.IAnnouncementRepository, queue infrastructures.IQueue, userVersionRepository repositories.IUserVersionRepository, events infrastructures.IEventBus, clock infrastructures.IClock) (s services.IPrivacyService, err error) {
+			return &services.PrivacyService{
+				UserRepository:       userRepository,
+				DataExportRepository: dataExportRepository,
+				MediaRepository:      mediaRepository,
+				Storage:              storage,
+				UnitOfWork:           unitOfWork,
+				Cache:                cache,
+				Queue:                queue,
+				Events:               events,
+				Clock:                clock,
+				Config:               &config.Conf.Privacy,
+				Exportables: map[string]repositories.Exportable{
+					"user":          userRepository,
+					"data_exports":  dataExportRepository,
+					"consents":      policyRepository,
+					"api_usages":    usageRepository,
+					"email_changes": emailChangeRepository,
+					"sessions":      sessionRepository,
+					"billing":       billingRepository,
+					"coupons":       couponRepository,
+					"votes":         voteRepository,
+					"comments":      commentRepository,
+					"reports":       reportRepository,
+					"media":         mediaRepository,
+					"follows":       followRepository,
+					"conversations": conversationRepository,
+					"devices":       deviceRepository,
+					"announcements": announcementRepository,
+					"user_versions": userVersionRepository,
+				},
+			}, nil
+		},
+		Params: dingo.Params{
+			"0":  dingo.Service("user-repository"),
+			"1":  dingo.Service("data-export-repository"),
+			"2":  dingo.Service("policy-repository"),
+			"3":  dingo.Service("storage"),
+			"4":  dingo.Service("unit-of-work"),
+			"5":  dingo.Service("usage-repository"),
+			"6":  dingo.Service("cache"),
+			"7":  dingo.Service("email-change-repository"),
+			"8":  dingo.Service("session-repository"),
+			"9":  dingo.Service("billing-repository"),
+			"10": dingo.Service("coupon-repository"),
+			"11": dingo.Service("vote-repository"),
+			"12": dingo.Service("comment-repository"),
+			"13": dingo.Service("report-repository"),
+			"14": dingo.Service("media-repository"),
+			"15": dingo.Service("follow-repository"),
+			"16": dingo.Service("conversation-repository"),
+			"17": dingo.Service("device-repository"),
+			"18": dingo.Service("announcement-repository"),
+			"19": dingo.Service("queue"),
+			"20": dingo.Service("user-version-repository"),
+			"21": dingo.Service("events"),
+			"22": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "consent-service",
+		Scope: di.App,
+		Build: func(repository repositories.IPolicyRepository) (s services.IConsentService, err error) {
+			return &services.ConsentService{PolicyRepository: repository}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("policy-repository"),
+		},
+	},
+	{
+		Name:  "setting-service",
+		Scope: di.App,
+		Build: func(repository repositories.ISettingRepository, cache infrastructures.ICache) (s services.ISettingService, err error) {
+			return &services.SettingService{SettingRepository: repository, Cache: cache, Config: &config.Conf.Settings}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("setting-repository"),
+			"1": dingo.Service("cache"),
+		},
+	},
+	{
+		Name:  "registration-service",
+		Scope: di.App,
+		Build: func(repository repositories.IUserRepository, settingService services.ISettingService, unitOfWork transactions.IUnitOfWork, cache infrastructures.ICache, events infrastructures.IEventBus, sagaService services.ISagaService, emailService infrastructures.IEmailService, welcomeMail mails.IMailRenderer, random infrastructures.IRandom) (s services.IRegistrationService, err error) {
+			return &services.RegistrationService{
+				UserRepository: repository,
+				SettingService: settingService,
+				UnitOfWork:     unitOfWork,
+				Sagas:          sagaService,
+				Email:          emailService,
+				WelcomeMail:    welcomeMail,
+				Cache:          cache,
+				Events:         events,
+				Random:         random,
+				Config:         &config.Conf.Registration,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("user-repository"),
+			"1": dingo.Service("setting-service"),
+			"2": dingo.Service("unit-of-work"),
+			"3": dingo.Service("cache"),
+			"4": dingo.Service("events"),
+			"5": dingo.Service("saga-service"),
+			"6": dingo.Service("email"),
+			"7": dingo.Service("user-welcome-mail"),
+			"8": dingo.Service("random"),
+		},
+	},
+	{
+		Name:  "invitation-service",
+		Scope: di.App,
+		Build: func(repository repositories.IInvitationRepository, clock infrastructures.IClock, random infrastructures.IRandom) (s services.IInvitationService, err error) {
+			return &services.InvitationService{InvitationRepository: repository, Clock: clock, Random: random}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("invitation-repository"),
+			"1": dingo.Service("clock"),
+			"2": dingo.Service("random"),
+		},
+	},
+	{
+		Name:  "api-key-service",
+		Scope: di.App,
+		Build: func(repository repositories.IApiKeyRepository, clock infrastructures.IClock, random infrastructures.IRandom) (s services.IApiKeyService, err error) {
+			return &services.ApiKeyService{ApiKeyRepository: repository, Clock: clock, Random: random}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("api-key-repository"),
+			"1": dingo.Service("clock"),
+			"2": dingo.Service("random"),
+		},
+	},
+	{
+		Name:  "client-service",
+		Scope: di.App,
+		Build: func(repository repositories.IClientRepository, authService services.IAuthService, cache infrastructures.ICache, clock infrastructures.IClock, random infrastructures.IRandom) (s services.IClientService, err error) {
+			return &services.ClientService{
+				ClientRepository: repository,
+				AuthService:      authService,
+				Cache:            cache,
+				Config:           &config.Conf.Clients,
+				Clock:            clock,
+				Random:           random,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("client-repository"),
+			"1": dingo.Service("auth-service"),
+			"2": dingo.Service("cache"),
+			"3": dingo.Service("clock"),
+			"4": dingo.Service("random"),
+		},
+	},
+	{
+		Name:  "retention-service",
+		Scope: di.App,
+		Build: func(repository repositories.IRetentionRepository, storage infrastructures.IStorageService, clock infrastructures.IClock, logger infrastructures.ILogger, metrics infrastructures.IMetrics) (s services.IRetentionService, err error) {
+			return &services.RetentionService{
+				RetentionRepository: repository,
+				Storage:             storage,
+				Clock:               clock,
+				Logger:              logger,
+				Metrics:             metrics,
+				Config:              &config.Conf.Retention,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("retention-repository"),
+			"1": dingo.Service("storage"),
+			"2": dingo.Service("clock"),
+			"3": dingo.Service("logger"),
+			"4": dingo.Service("metrics"),
+		},
+	},
+	{
+		Name:  "dead-letter-service",
+		Scope: di.App,
+		Build: func(repository repositories.IDeadLetterRepository, settingService services.ISettingService, queue infrastructures.IQueue, metrics infrastructures.IMetrics, logger infrastructures.ILogger) (s services.IDeadLetterService, err error) {
+			return &services.DeadLetterService{
+				DeadLetterRepository: repository,
+				SettingService:       settingService,
+				Queue:                queue,
+				Metrics:              metrics,
+				Logger:               logger,
+				Config:               &config.Conf.Queue,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("dead-letter-repository"),
+			"1": dingo.Service("setting-service"),
+			"2": dingo.Service("queue"),
+			"3": dingo.Service("metrics"),
+			"4": dingo.Service("logger"),
+		},
+	},
+	{
+		Name:  "deprecation-service",
+		Scope: di.App,
+		Build: func(repository repositories.IDeprecationRepository, metrics infrastructures.IMetrics, logger infrastructures.ILogger) (s services.IDeprecationService, err error) {
+			return &services.DeprecationService{
+				DeprecationRepository: repository,
+				Metrics:               metrics,
+				Logger:                logger.With(infrastructures.Fields{"component": "deprecations"}),
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("deprecation-repository"),
+			"1": dingo.Service("metrics"),
+			"2": dingo.Service("logger"),
+		},
+	},
+	{
+		Name:  "config-service",
+		Scope: di.App,
+		Build: func(repository repositories.IConfigSnapshotRepository, logger infrastructures.ILogger, clock infrastructures.IClock) (s services.IConfigService, err error) {
+			return &services.ConfigService{
+				ConfigSnapshotRepository: repository,
+				Logger:                   logger.With(infrastructures.Fields{"component": "config"}),
+				Clock:                    clock,
+				Config:                   config.Conf,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("config-snapshot-repository"),
+			"1": dingo.Service("logger"),
+			"2": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "slo-service",
+		Scope: di.App,
+		Build: func(events infrastructures.IEventBus, metrics infrastructures.IMetrics, logger infrastructures.ILogger, clock infrastructures.IClock) (s services.ISloService, err error) {
+			return &services.SloService{
+				Events:  events,
+				Metrics: metrics,
+				Logger:  logger.With(infrastructures.Fields{"component": "slo"}),
+				Clock:   clock,
+				Config:  &config.Conf.Slo,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("events"),
+			"1": dingo.Service("metrics"),
+			"2": dingo.Service("logger"),
+			"3": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "saga-service",
+		Scope: di.App,
+		Build: func(repository repositories.ISagaRepository, unitOfWork transactions.IUnitOfWork, logger infrastructures.ILogger, clock infrastructures.IClock) (s services.ISagaService, err error) {
+			return &services.SagaService{
+				SagaRepository: repository,
+				UnitOfWork:     unitOfWork,
+				Logger:         logger,
+				Clock:          clock,
+				Config:         &config.Conf.Saga,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("saga-repository"),
+			"1": dingo.Service("unit-of-work"),
+			"2": dingo.Service("logger"),
+			"3": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "impersonation-service",
+		Scope: di.App,
+		Build: func(repository repositories.IUserRepository, authService services.IAuthService, logger infrastructures.ILogger) (s services.IImpersonationService, err error) {
+			return &services.ImpersonationService{
+				UserRepository: repository,
+				AuthService:    authService,
+				Logger:         logger.With(infrastructures.Fields{"component": "audit"}),
+				Config:         &config.Conf.Impersonation,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("user-repository"),
+			"1": dingo.Service("auth-service"),
+			"2": dingo.Service("logger"),
+		},
+	},
+	{
+		Name:  "user-history-service",
+		Scope: di.App,
+		Build: func(userRepository repositories.IUserRepository, userVersionRepository repositories.IUserVersionRepository, settingService services.ISettingService, cache infrastructures.ICache, logger infrastructures.ILogger, events infrastructures.IEventBus) (s services.IUserHistoryService, err error) {
+			return &services.UserHistoryService{
+				UserRepository:        userRepository,
+				UserVersionRepository: userVersionRepository,
+				SettingService:        settingService,
+				Cache:                 cache,
+				Logger:                logger.With(infrastructures.Fields{"component": "audit"}),
+				Events:                events,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("user-repository"),
+			"1": dingo.Service("user-version-repository"),
+			"2": dingo.Service("setting-service"),
+			"3": dingo.Service("cache"),
+			"4": dingo.Service("logger"),
+			"5": dingo.Service("events"),
+		},
+	},
+	{
+		Name:  "user-projection-service",
+		Scope: di.App,
+		Build: func(userRepository repositories.IUserRepository, userSearchViewRepository repositories.IUserSearchViewRepository, tagRepository repositories.ITagRepository, followRepository repositories.IFollowRepository, settingService services.ISettingService, queue infrastructures.IQueue, logger infrastructures.ILogger) (s services.IUserProjectionService, err error) {
+			return &services.UserProjectionService{
+				UserRepository:           userRepository,
+				UserSearchViewRepository: userSearchViewRepository,
+				TagRepository:            tagRepository,
+				FollowRepository:         followRepository,
+				SettingService:           settingService,
+				Queue:                    queue,
+				Logger:                   logger,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("user-repository"),
+			"1": dingo.Service("user-search-view-repository"),
+			"2": dingo.Service("tag-repository"),
+			"3": dingo.Service("follow-repository"),
+			"4": dingo.Service("setting-service"),
+			"5": dingo.Service("queue"),
+			"6": dingo.Service("logger"),
+		},
+	},
+	{
+		Name:  "suspension-service",
+		Scope: di.App,
+		Build: func(repository repositories.IUserRepository, logger infrastructures.ILogger, cache infrastructures.ICache, events infrastructures.IEventBus, clock infrastructures.IClock) (s services.ISuspensionService, err error) {
+			return &services.SuspensionService{
+				UserRepository: repository,
+				Cache:          cache,
+				Logger:         logger.With(infrastructures.Fields{"component": "audit"}),
+				Events:         events,
+				Clock:          clock,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("user-repository"),
+			"1": dingo.Service("logger"),
+			"2": dingo.Service("cache"),
+			"3": dingo.Service("events"),
+			"4": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "quota-service",
+		Scope: di.App,
+		Build: func(repository repositories.IUsageRepository) (s services.IQuotaService, err error) {
+			return &services.QuotaService{UsageRepository: repository, Config: &config.Conf.Quota}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("usage-repository"),
+		},
+	},
+	{
+		Name:  "email-change-service",
+		Scope: di.App,
+		Build: func(userRepository repositories.IUserRepository, emailChangeRepository repositories.IEmailChangeRepository, unitOfWork transactions.IUnitOfWork, emailService infrastructures.IEmailService, confirmationMail mails.IMailRenderer, noticeMail mails.IMailRenderer, cache infrastructures.ICache, logger infrastructures.ILogger, clock infrastructures.IClock, random infrastructures.IRandom, events infrastructures.IEventBus) (s services.IEmailChangeService, err error) {
+			return &services.EmailChangeService{
+				UserRepository:        userRepository,
+				EmailChangeRepository: emailChangeRepository,
+				UnitOfWork:            unitOfWork,
+				Email:                 emailService,
+				ConfirmationMail:      confirmationMail,
+				NoticeMail:            noticeMail,
+				Cache:                 cache,
+				Logger:                logger,
+				Events:                events,
+				Config:                &config.Conf.EmailChange,
+				Clock:                 clock,
+				Random:                random,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0":  dingo.Service("user-repository"),
+			"1":  dingo.Service("email-change-repository"),
+			"2":  dingo.Service("unit-of-work"),
+			"3":  dingo.Service("email"),
+			"4":  dingo.Service("email-change-confirmation-mail"),
+			"5":  dingo.Service("email-change-notice-mail"),
+			"6":  dingo.Service("cache"),
+			"7":  dingo.Service("logger"),
+			"8":  dingo.Service("clock"),
+			"9":  dingo.Service("random"),
+			"10": dingo.Service("events"),
+		},
+	},
+	{
+		Name:  "phone-service",
+		Scope: di.App,
+		Build: func(repository repositories.IUserRepository, sms infrastructures.ISmsService, cache infrastructures.ICache, clock infrastructures.IClock, random infrastructures.IRandom) (s services.IPhoneService, err error) {
+			return &services.PhoneService{UserRepository: repository, Sms: sms, Cache: cache, Config: &config.Conf.Sms, Clock: clock, Random: random}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("user-repository"),
+			"1": dingo.Service("sms"),
+			"2": dingo.Service("cache"),
+			"3": dingo.Service("clock"),
+			"4": dingo.Service("random"),
+		},
+	},
+	{
+		Name:  "magic-link-service",
+		Scope: di.App,
+		Build: func(userRepository repositories.IUserRepository, magicLinkRepository repositories.IMagicLinkRepository, unitOfWork transactions.IUnitOfWork, emailService infrastructures.IEmailService, mail mails.IMailRenderer, cache infrastructures.ICache, logger infrastructures.ILogger, clock infrastructures.IClock, random infrastructures.IRandom) (s services.IMagicLinkService, err error) {
+			return &services.MagicLinkService{
+				UserRepository:      userRepository,
+				MagicLinkRepository: magicLinkRepository,
+				UnitOfWork:          unitOfWork,
+				Email:               emailService,
+				Mail:                mail,
+				Cache:               cache,
+				Logger:              logger,
+				Config:              &config.Conf.MagicLink,
+				Clock:               clock,
+				Random:              random,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("user-repository"),
+			"1": dingo.Service("magic-link-repository"),
+			"2": dingo.Service("unit-of-work"),
+			"3": dingo.Service("email"),
+			"4": dingo.Service("magic-link-mail"),
+			"5": dingo.Service("cache"),
+			"6": dingo.Service("logger"),
+			"7": dingo.Service("clock"),
+			"8": dingo.Service("random"),
+		},
+	},
+	{
+		Name:  "session-service",
+		Scope: di.App,
+		Build: func(sessionRepository repositories.ISessionRepository, userRepository repositories.IUserRepository, logger infrastructures.ILogger, clock infrastructures.IClock, random infrastructures.IRandom) (s services.ISessionService, err error) {
+			return &services.SessionService{SessionRepository: sessionRepository, UserRepository: userRepository, Logger: logger, Config: &config.Conf.Session, Clock: clock, Random: random}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("session-repository"),
+			"1": dingo.Service("user-repository"),
+			"2": dingo.Service("logger"),
+			"3": dingo.Service("clock"),
+			"4": dingo.Service("random"),
+		},
+	},
+	{
+		Name:  "challenge-service",
+		Scope: di.App,
+		Build: func(captcha infrastructures.ICaptchaService, settingService services.ISettingService, cache infrastructures.ICache) (s services.IChallengeService, err error) {
+			return &services.ChallengeService{Captcha: captcha, SettingService: settingService, Cache: cache, Config: &config.Conf.Captcha}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("captcha"),
+			"1": dingo.Service("setting-service"),
+			"2": dingo.Service("cache"),
+		},
+	},
+	{
+		Name:  "billing-service",
+		Scope: di.App,
+		Build: func(repository repositories.IBillingRepository, gateway infrastructures.IPaymentGateway, logger infrastructures.ILogger, invoiceService services.IInvoiceService, couponService services.ICouponService, deadLetterService services.IDeadLetterService, clock infrastructures.IClock) (s services.IBillingService, err error) {
+			return &services.BillingService{
+				BillingRepository: repository,
+				Gateway:           gateway,
+				InvoiceService:    invoiceService,
+				CouponService:     couponService,
+				DeadLetters:       deadLetterService,
+				Logger:            logger.With(infrastructures.Fields{"component": "billing"}),
+				Clock:             clock,
+				Config:            &config.Conf.Billing,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("billing-repository"),
+			"1": dingo.Service("payment-gateway"),
+			"2": dingo.Service("logger"),
+			"3": dingo.Service("invoice-service"),
+			"4": dingo.Service("coupon-service"),
+			"5": dingo.Service("dead-letter-service"),
+			"6": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "invoice-service",
+		Scope: di.App,
+		Build: func(repository repositories.IBillingRepository, userRepository repositories.IUserRepository, pdf infrastructures.IPdfService, storage infrastructures.IStorageService, logger infrastructures.ILogger, clock infrastructures.IClock) (s services.IInvoiceService, err error) {
+			return &services.InvoiceService{
+				BillingRepository: repository,
+				UserRepository:    userRepository,
+				Pdf:               pdf,
+				Storage:           storage,
+				Logger:            logger.With(infrastructures.Fields{"component": "billing"}),
+				Clock:             clock,
+				Config:            &config.Conf.Billing,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("billing-repository"),
+			"1": dingo.Service("user-repository"),
+			"2": dingo.Service("pdf"),
+			"3": dingo.Service("storage"),
+			"4": dingo.Service("logger"),
+			"5": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "coupon-service",
+		Scope: di.App,
+		Build: func(repository repositories.ICouponRepository, gateway infrastructures.IPaymentGateway, logger infrastructures.ILogger, clock infrastructures.IClock) (s services.ICouponService, err error) {
+			return &services.CouponService{
+				CouponRepository: repository,
+				Gateway:          gateway,
+				Logger:           logger.With(infrastructures.Fields{"component": "billing"}),
+				Clock:            clock,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("coupon-repository"),
+			"1": dingo.Service("payment-gateway"),
+			"2": dingo.Service("logger"),
+			"3": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "vote-service",
+		Scope: di.App,
+		Build: func(repository repositories.IVoteRepository, userRepository repositories.IUserRepository, commentRepository repositories.ICommentRepository) (s services.IVoteService, err error) {
+			return &services.VoteService{
+				VoteRepository: repository,
+				Votables: map[string]services.Votable{
+					"users": services.RecordExists(func(ID uint) error {
+						_, err := userRepository.GetUserByID(ID)
+						return err
+					}),
+					"comments": services.RecordExists(func(ID uint) error {
+						_, err := commentRepository.GetCommentByID(ID)
+						return err
+					}),
+				},
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("vote-repository"),
+			"1": dingo.Service("user-repository"),
+			"2": dingo.Service("comment-repository"),
+		},
+	},
+	{
+		Name:  "comment-service",
+		Scope: di.App,
+		Build: func(repository repositories.ICommentRepository, settingService services.ISettingService, logger infrastructures.ILogger, userRepository repositories.IUserRepository, events infrastructures.IEventBus) (s services.ICommentService, err error) {
+			return &services.CommentService{
+				CommentRepository: repository,
+				SettingService:    settingService,
+				Events:            events,
+				Logger:            logger.With(infrastructures.Fields{"component": "audit"}),
+				Commentables: map[string]services.Commentable{
+					"users": services.RecordExists(func(ID uint) error {
+						_, err := userRepository.GetUserByID(ID)
+						return err
+					}),
+				},
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("comment-repository"),
+			"1": dingo.Service("setting-service"),
+			"2": dingo.Service("logger"),
+			"3": dingo.Service("user-repository"),
+			"4": dingo.Service("events"),
+		},
+	},
+	{
+		Name:  "report-service",
+		Scope: di.App,
+		Build: func(repository repositories.IReportRepository, suspensionService services.ISuspensionService, settingService services.ISettingService, logger infrastructures.ILogger, userRepository repositories.IUserRepository, commentRepository repositories.ICommentRepository) (s services.IReportService, err error) {
+			return &services.ReportService{
+				ReportRepository:  repository,
+				SuspensionService: suspensionService,
+				SettingService:    settingService,
+				Logger:            logger.With(infrastructures.Fields{"component": "audit"}),
+				Reportables: map[string]services.Reportable{
+					"users": func(ID uint) (uint, error) {
+						user, err := userRepository.GetUserByID(ID)
+						return user.ID, err
+					},
+					"comments": func(ID uint) (uint, error) {
+						comment, err := commentRepository.GetCommentByID(ID)
+						return comment.UserID, err
+					},
+				},
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("report-repository"),
+			"1": dingo.Service("suspension-service"),
+			"2": dingo.Service("setting-service"),
+			"3": dingo.Service("logger"),
+			"4": dingo.Service("user-repository"),
+			"5": dingo.Service("comment-repository"),
+		},
+	},
+	{
+		Name:  "tag-service",
+		Scope: di.App,
+		Build: func(repository repositories.ITagRepository, cache infrastructures.ICache, userRepository repositories.IUserRepository, commentRepository repositories.ICommentRepository, events infrastructures.IEventBus) (s services.ITagService, err error) {
+			return &services.TagService{
+				TagRepository: repository,
+				Cache:         cache,
+				Events:        events,
+				Taggables: map[string]services.Taggable{
+					"users": func(ID uint) (uint, error) {
+						user, err := userRepository.GetUserByID(ID)
+						return user.ID, err
+					},
+					"comments": func(ID uint) (uint, error) {
+						comment, err := commentRepository.GetCommentByID(ID)
+						return comment.UserID, err
+					},
+				},
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("tag-repository"),
+			"1": dingo.Service("cache"),
+			"2": dingo.Service("user-repository"),
+			"3": dingo.Service("comment-repository"),
+			"4": dingo.Service("events"),
+		},
+	},
+	{
+		Name:  "media-service",
+		Scope: di.App,
+		Build: func(repository repositories.IMediaRepository, storage infrastructures.IStorageService, settingService services.ISettingService, userRepository repositories.IUserRepository, commentRepository repositories.ICommentRepository) (s services.IMediaService, err error) {
+			return &services.MediaService{
+				MediaRepository: repository,
+				Storage:         storage,
+				SettingService:  settingService,
+				Config:          &config.Conf.Media,
+				Attachables: map[string]services.Attachable{
+					"users": func(ID uint) (uint, error) {
+						user, err := userRepository.GetUserByID(ID)
+						return user.ID, err
+					},
+					"comments": func(ID uint) (uint, error) {
+						comment, err := commentRepository.GetCommentByID(ID)
+						return comment.UserID, err
+					},
+				},
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("media-repository"),
+			"1": dingo.Service("storage"),
+			"2": dingo.Service("setting-service"),
+			"3": dingo.Service("user-repository"),
+			"4": dingo.Service("comment-repository"),
+		},
+	},
+	{
+		Name:  "follow-service",
+		Scope: di.App,
+		Build: func(repository repositories.IFollowRepository, userRepository repositories.IUserRepository, settingService services.ISettingService, events infrastructures.IEventBus) (s services.IFollowService, err error) {
+			return &services.FollowService{
+				FollowRepository: repository,
+				UserRepository:   userRepository,
+				SettingService:   settingService,
+				Events:           events,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("follow-repository"),
+			"1": dingo.Service("user-repository"),
+			"2": dingo.Service("setting-service"),
+			"3": dingo.Service("events"),
+		},
+	},
+	{
+		Name:  "conversation-service",
+		Scope: di.App,
+		Build: func(repository repositories.IConversationRepository, userRepository repositories.IUserRepository, settingService services.ISettingService, hub infrastructures.IHub, events infrastructures.IEventBus) (s services.IConversationService, err error) {
+			return &services.ConversationService{
+				ConversationRepository: repository,
+				UserRepository:         userRepository,
+				SettingService:         settingService,
+				Hub:                    hub,
+				Events:                 events,
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("conversation-repository"),
+			"1": dingo.Service("user-repository"),
+			"2": dingo.Service("setting-service"),
+			"3": dingo.Service("hub"),
+			"4": dingo.Service("events"),
+		},
+	},
+	{
+		Name:  "notification-service",
+		Scope: di.App,
+		Build: func(repository repositories.IDeviceRepository, userRepository repositories.IUserRepository, push infrastructures.IPushService, hub infrastructures.IHub, logger infrastructures.ILogger) (s services.INotificationService, err error) {
+			return &services.NotificationService{
+				DeviceRepository: repository,
+				UserRepository:   userRepository,
+				PushService:      push,
+				Hub:              hub,
+				Logger:           logger.With(infrastructures.Fields{"component": "push"}),
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("device-repository"),
+			"1": dingo.Service("user-repository"),
+			"2": dingo.Service("push"),
+			"3": dingo.Service("hub"),
+			"4": dingo.Service("logger"),
+		},
+	},
+	{
+		Name:  "announcement-service",
+		Scope: di.App,
+		Build: func(repository repositories.IAnnouncementRepository, billingRepository repositories.IBillingRepository, notificationService services.INotificationService, settingService services.ISettingService, hub infrastructures.IHub, events infrastructures.IEventBus, logger infrastructures.ILogger) (s services.IAnnouncementService, err error) {
+			return &services.AnnouncementService{
+				AnnouncementRepository: repository,
+				BillingRepository:      billingRepository,
+				NotificationService:    notificationService,
+				SettingService:         settingService,
+				Hub:                    hub,
+				Events:                 events,
+				Logger:                 logger.With(infrastructures.Fields{"component": "audit"}),
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("announcement-repository"),
+			"1": dingo.Service("billing-repository"),
+			"2": dingo.Service("notification-service"),
+			"3": dingo.Service("setting-service"),
+			"4": dingo.Service("hub"),
+			"5": dingo.Service("events"),
+			"6": dingo.Service("logger"),
+		},
+	},
+	{
+		Name:  "publishing-service",
+		Scope: di.App,
+		Build: func(events infrastructures.IEventBus, announcementRepository repositories.IAnnouncementRepository, clock infrastructures.IClock) (s services.IPublishingService, err error) {
+			return &services.PublishingService{
+				Events: events,
+				Clock:  clock,
+				Publishables: map[string]services.Publisher{
+					models.Announcement{}.TableName(): services.PublisherOf[models.Announcement](announcementRepository),
+				},
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("events"),
+			"1": dingo.Service("announcement-repository"),
+			"2": dingo.Service("clock"),
+		},
+	},
+	{
+		Name:  "search-service",
+		Scope: di.App,
+		Build: func(engine infrastructures.ISearchEngine, logger infrastructures.ILogger, commentRepository repositories.ICommentRepository, announcementRepository repositories.IAnnouncementRepository) (s services.ISearchService, err error) {
+			return &services.SearchService{
+				Engine: engine,
+				Config: &config.Conf.Search,
+				Logger: logger.With(infrastructures.Fields{"component": "search"}),
+				Searchables: map[string]services.SearchIndex{
+					models.Comment{}.TableName():      services.SearchIndexOf[models.Comment](commentRepository),
+					models.Announcement{}.TableName(): services.SearchIndexOf[models.Announcement](announcementRepository),
+				},
+			}, nil
+		},
+		Close: func(s services.ISearchService) error {
+			return s.Close()
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("search"),
+			"1": dingo.Service("logger"),
+			"2": dingo.Service("comment-repository"),
+			"3": dingo.Service("announcement-repository"),
+		},
+	},
+	{
+		Name:  "admin-resources",
+		Scope: di.App,
+		Build: func(gormDatabase infrastructures.IGormDatabase, billingRepository repositories.IBillingRepository, tagRepository repositories.ITagRepository, settingService services.ISettingService, logger infrastructures.ILogger) (s services.AdminResources, err error) {
+			logger = logger.With(infrastructures.Fields{"component": "audit"})
+			return services.AdminResources{
+				"plans": &services.AdminService[models.Plan]{
+					Name:           "plans",
+					Repository:     &repositories.BaseRepository[models.Plan]{IGormDatabase: gormDatabase},
+					SettingService: settingService,
+					Logger:         logger,
+					FilterFields:   []string{"slug", "interval", "active"},
+					Fields:         []string{"slug", "name", "rank", "price", "interval", "active"},
+					Validate:       requests.ValidatePlan,
+					Deletable: func(plan models.Plan) error {
+						inUse, err := billingRepository.IsPlanInUse(plan.ID)
+						if err == nil && inUse {
+							return services.ErrAdminRecordInUse
+						}
+						return err
+					},
+				},
+				"tags": &services.AdminService[models.Tag]{
+					Name:           "tags",
+					Repository:     tagRepository,
+					SettingService: settingService,
+					Logger:         logger,
+					FilterFields:   []string{"slug"},
+					Fields:         []string{"name", "slug"},
+					Validate:       requests.ValidateTag,
+				},
+			}, nil
+		},
+		Params: dingo.Params{
+			"0": dingo.Service("db"),
+			"1": dingo.Service("billing-repository"),
+			"2": dingo.Service("tag-repository"),
+			"3": dingo.Service("setting-service"),
+			"4": dingo.Service("logger"),
 		},
 	},
 }
@@ -2,17 +2,32 @@ package flags
 
 import (
 	"flag"
+	"os"
+	"strings"
 )
 
 var (
 	Production *bool
 	Migrate    *bool
 	Seed       *bool
+	Eager      *bool
+	Graph      *string
+	Routes     *bool
+	Mock       *bool
+
+	// Testing is set in a test binary, go test passes flags of its own which the testing package parses
+	Testing = strings.HasSuffix(os.Args[0], ".test")
 )
 
 func init() {
 	Production = flag.Bool("production", false, "a bool")
 	Migrate = flag.Bool("migrate", false, "a bool")
 	Seed = flag.Bool("seed", false, "a bool")
-	flag.Parse()
+	Eager = flag.Bool("eager", false, "build every app scoped definition at startup")
+	Graph = flag.String("graph", "", "print the container dependency graph (dot or json) and exit")
+	Routes = flag.Bool("routes", false, "print the registered routes as json and exit")
+	Mock = flag.Bool("mock", false, "serve the examples of the swagger spec without a database")
+	if !Testing {
+		flag.Parse()
+	}
 }
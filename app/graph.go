@@ -0,0 +1,33 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gotham/app/container"
+)
+
+/**
+ * PrintGraph
+ * print the container dependency graph as dot or json
+ */
+func (a *App) PrintGraph(format string) error {
+	graph, err := container.Graph(a.Container)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "dot":
+		fmt.Print(graph.DOT())
+	case "json":
+		out, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	default:
+		return fmt.Errorf("unknown graph format %v, use dot or json", format)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package app
+
+import (
+	"log"
+	"time"
+
+	"github.com/sarulabs/di/v2"
+
+	"gotham/app/provider"
+	"gotham/infrastructures"
+)
+
+/**
+ * ObserveBuilds
+ * record the build count and duration of every definition, request scoped builds slower than threshold are logged
+ */
+func (a *App) ObserveBuilds(metrics infrastructures.IMetrics, threshold time.Duration) {
+	provider.Observe(func(name string, scope string, duration time.Duration, err error) {
+		labels := map[string]string{"definition": name, "scope": scope}
+		metrics.Inc("container_builds_total", labels, 1)
+		metrics.Observe("container_build_duration_seconds", labels, duration.Seconds())
+		if err != nil {
+			metrics.Inc("container_build_errors_total", labels, 1)
+		}
+		if scope != di.App && threshold > 0 && duration > threshold {
+			log.Printf("container: slow build of %v (%v scope) took %v, threshold is %v", name, scope, duration, threshold)
+		}
+	})
+}
@@ -1,8 +1,12 @@
 package provider
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/sarulabs/dingo/v4"
 	"gotham/app/defs"
+	"gotham/config"
 )
 
 type Provider struct {
@@ -14,33 +18,59 @@ type Provider struct {
  * All the definitions are combined and gathered under one provider. When you create a service definition you need to add here like DatabaseServiceDefs
  */
 func (p *Provider) Load() error {
-	if err := p.AddDefSlice(defs.InfrastructuresDefs); err != nil {
+	environment := newEnvironmentDefs(config.Environment(), defs.EnvironmentsDefs[config.Environment()])
+
+	if err := p.AddDefSlice(environment.apply(defs.InfrastructuresDefs)); err != nil {
+		return err
+	}
+
+	if err := p.AddDefSlice(environment.apply(defs.RepositoriesDefs)); err != nil {
+		return err
+	}
+
+	if err := p.AddDefSlice(environment.apply(defs.ServicesDefs)); err != nil {
 		return err
 	}
 
-	if err := p.AddDefSlice(defs.RepositoriesDefs); err != nil {
+	if err := p.AddDefSlice(environment.apply(defs.ControllersDefs)); err != nil {
 		return err
 	}
 
-	if err := p.AddDefSlice(defs.ServicesDefs); err != nil {
+	if err := p.AddDefSlice(environment.apply(defs.MiddlewaresDefs)); err != nil {
 		return err
 	}
 
-	if err := p.AddDefSlice(defs.ControllersDefs); err != nil {
+	if err := p.AddDefSlice(environment.apply(defs.MailsDefs)); err != nil {
 		return err
 	}
 
-	if err := p.AddDefSlice(defs.MiddlewaresDefs); err != nil {
+	if err := p.AddDefSlice(environment.apply(defs.PoliciesDefs)); err != nil {
 		return err
 	}
 
-	if err := p.AddDefSlice(defs.MailsDefs); err != nil {
+	// definitions only the environment declares
+	if err := p.AddDefSlice(environment.remaining()); err != nil {
 		return err
 	}
 
-	if err := p.AddDefSlice(defs.PoliciesDefs); err != nil {
+	if err := environment.err(); err != nil {
 		return err
 	}
 
+	return p.checkCycles()
+}
+
+/**
+ * checkCycles
+ * definitions referencing each other would overflow the stack on the first Get, report the chain instead
+ */
+func (p *Provider) checkCycles() error {
+	graph, err := NewGraph(p)
+	if err != nil {
+		return err
+	}
+	if cycle := graph.Cycle(); cycle != nil {
+		return fmt.Errorf("circular definitions: %v", strings.Join(cycle, " → "))
+	}
 	return nil
 }
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/sarulabs/dingo/v4"
+)
+
+// environmentDefs replaces the default definitions with the ones of an environment
+type environmentDefs struct {
+	name      string
+	defs      map[string]dingo.Def
+	applied   map[string]bool
+	conflicts []string
+}
+
+func newEnvironmentDefs(name string, defs []dingo.Def) *environmentDefs {
+	e := &environmentDefs{name: name, defs: map[string]dingo.Def{}, applied: map[string]bool{}}
+	for _, def := range defs {
+		e.defs[def.Name] = def
+	}
+	return e
+}
+
+/**
+ * apply
+ * the group with the definitions the environment replaces
+ */
+func (e *environmentDefs) apply(group []dingo.Def) []dingo.Def {
+	out := make([]dingo.Def, 0, len(group))
+	for _, def := range group {
+		override, ok := e.defs[def.Name]
+		if !ok {
+			out = append(out, def)
+			continue
+		}
+		e.applied[def.Name] = true
+		if reflect.TypeOf(override.Build) != reflect.TypeOf(def.Build) {
+			e.conflicts = append(e.conflicts, fmt.Sprintf("%v builds %v instead of %v", def.Name, reflect.TypeOf(override.Build), reflect.TypeOf(def.Build)))
+		}
+		out = append(out, override)
+	}
+	return out
+}
+
+/**
+ * remaining
+ * the definitions of the environment that did not replace a default one
+ */
+func (e *environmentDefs) remaining() []dingo.Def {
+	names := make([]string, 0)
+	for name := range e.defs {
+		if !e.applied[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	out := make([]dingo.Def, 0, len(names))
+	for _, name := range names {
+		out = append(out, e.defs[name])
+	}
+	return out
+}
+
+func (e *environmentDefs) err() error {
+	if len(e.conflicts) == 0 {
+		return nil
+	}
+	return fmt.Errorf("definitions of the %v environment must keep the build function they replace: %v", e.name, e.conflicts)
+}
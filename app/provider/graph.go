@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sarulabs/di/v2"
+	"github.com/sarulabs/dingo/v4"
+)
+
+// scopes from the most generic to the most specific, a definition may only depend on the same or a more generic scope
+var scopeRanks = map[string]int{di.App: 0, di.Request: 1, di.SubRequest: 2}
+
+type GraphNode struct {
+	Name         string   `json:"name"`
+	Scope        string   `json:"scope"`
+	Dependencies []string `json:"dependencies"`
+}
+
+type GraphViolation struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Reason string `json:"reason"`
+}
+
+// Graph is the dependency graph of the definitions of a provider
+type Graph struct {
+	Nodes      []GraphNode      `json:"nodes"`
+	Violations []GraphViolation `json:"violations"`
+}
+
+/**
+ * NewGraph
+ * build the graph of a loaded provider, dependencies are the dingo.Service params of every definition
+ */
+func NewGraph(p dingo.Provider) (graph Graph, err error) {
+	names := p.Names()
+	sort.Strings(names)
+
+	scopes := map[string]string{}
+	for _, name := range names {
+		var def *dingo.Def
+		def, err = p.Get(name)
+		if err != nil {
+			return graph, err
+		}
+		scope := def.Scope
+		if scope == "" {
+			scope = di.App
+		}
+		scopes[name] = scope
+
+		node := GraphNode{Name: name, Scope: scope, Dependencies: []string{}}
+		for _, param := range def.Params {
+			if service, ok := param.(dingo.Service); ok {
+				node.Dependencies = append(node.Dependencies, string(service))
+			}
+		}
+		sort.Strings(node.Dependencies)
+		graph.Nodes = append(graph.Nodes, node)
+	}
+
+	for _, node := range graph.Nodes {
+		for _, dependency := range node.Dependencies {
+			scope, ok := scopes[dependency]
+			if !ok {
+				graph.Violations = append(graph.Violations, GraphViolation{From: node.Name, To: dependency, Reason: "undefined dependency"})
+				continue
+			}
+			if scopeRanks[scope] > scopeRanks[node.Scope] {
+				graph.Violations = append(graph.Violations, GraphViolation{From: node.Name, To: dependency, Reason: fmt.Sprintf("%v scoped definition depends on a %v scoped one", node.Scope, scope)})
+			}
+		}
+	}
+	return graph, nil
+}
+
+/**
+ * DOT
+ * graphviz representation, violations are drawn in red
+ */
+func (g Graph) DOT() string {
+	violations := map[string]bool{}
+	for _, violation := range g.Violations {
+		violations[violation.From+"->"+violation.To] = true
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph container {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box];\n")
+	for _, node := range g.Nodes {
+		b.WriteString(fmt.Sprintf("\t%q [label=\"%v\\n(%v)\"];\n", node.Name, node.Name, node.Scope))
+	}
+	for _, node := range g.Nodes {
+		for _, dependency := range node.Dependencies {
+			if violations[node.Name+"->"+dependency] {
+				b.WriteString(fmt.Sprintf("\t%q -> %q [color=red];\n", node.Name, dependency))
+				continue
+			}
+			b.WriteString(fmt.Sprintf("\t%q -> %q;\n", node.Name, dependency))
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+/**
+ * Cycle
+ * first circular chain of definitions found, e.g. [a b a], nil when the graph is acyclic
+ */
+func (g Graph) Cycle() []string {
+	dependencies := map[string][]string{}
+	for _, node := range g.Nodes {
+		dependencies[node.Name] = node.Dependencies
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := map[string]int{}
+	var path []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		states[name] = visiting
+		path = append(path, name)
+		for _, dependency := range dependencies[name] {
+			switch states[dependency] {
+			case visiting:
+				for i, n := range path {
+					if n == dependency {
+						return append(append([]string{}, path[i:]...), dependency)
+					}
+				}
+			case unvisited:
+				if cycle := visit(dependency); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		states[name] = visited
+		return nil
+	}
+
+	for _, node := range g.Nodes {
+		if states[node.Name] == unvisited {
+			if cycle := visit(node.Name); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"github.com/sarulabs/di/v2"
+	"github.com/sarulabs/dingo/v4"
+)
+
+// BuildObserver is notified after every build of a definition
+type BuildObserver func(name string, scope string, duration time.Duration, err error)
+
+var observer atomic.Value
+
+/**
+ * Observe
+ * set the observer of the definition builds, the generated SafeGet methods resolve the build function through Get
+ */
+func Observe(o BuildObserver) {
+	observer.Store(o)
+}
+
+/**
+ * Get
+ * the definition with its build function timed once an observer is set
+ */
+func (p *Provider) Get(name string) (*dingo.Def, error) {
+	def, err := p.BaseProvider.Get(name)
+	if err != nil {
+		return def, err
+	}
+	o, _ := observer.Load().(BuildObserver)
+	if o == nil || def.Build == nil || reflect.TypeOf(def.Build).Kind() != reflect.Func {
+		return def, nil
+	}
+
+	scope := def.Scope
+	if scope == "" {
+		scope = di.App
+	}
+	timed := *def
+	timed.Build = instrument(name, scope, def.Build, o)
+	return &timed, nil
+}
+
+// instrument wraps a build function into a function of the same type reporting its duration
+func instrument(name string, scope string, build interface{}, o BuildObserver) interface{} {
+	fn := reflect.ValueOf(build)
+	return reflect.MakeFunc(fn.Type(), func(args []reflect.Value) []reflect.Value {
+		start := time.Now()
+		out := fn.Call(args)
+		var err error
+		if len(out) > 0 {
+			err, _ = out[len(out)-1].Interface().(error)
+		}
+		o(name, scope, time.Since(start), err)
+		return out
+	}).Interface()
+}
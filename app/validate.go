@@ -0,0 +1,70 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sarulabs/di/v2"
+
+	"gotham/app/provider"
+)
+
+/**
+ * DefinitionFailure
+ *
+ */
+type DefinitionFailure struct {
+	Name string
+	Err  error
+}
+
+/**
+ * ValidationError
+ * every App scoped definition that could not be built
+ */
+type ValidationError struct {
+	Failures []DefinitionFailure
+}
+
+func (v *ValidationError) Error() string {
+	lines := []string{fmt.Sprintf("%d definition(s) could not be built:", len(v.Failures))}
+	for _, failure := range v.Failures {
+		lines = append(lines, fmt.Sprintf("  - %v: %v", failure.Name, failure.Err))
+	}
+	return strings.Join(lines, "\n")
+}
+
+/**
+ * Validate
+ * build every App scoped definition so misconfigurations fail at boot instead of on the first request
+ */
+func (a *App) Validate() error {
+	p := &provider.Provider{}
+	if err := p.Load(); err != nil {
+		return err
+	}
+
+	names := p.Names()
+	sort.Strings(names)
+
+	report := &ValidationError{}
+	for _, name := range names {
+		def, err := p.Get(name)
+		if err != nil {
+			report.Failures = append(report.Failures, DefinitionFailure{Name: name, Err: err})
+			continue
+		}
+		if def.Scope != "" && def.Scope != di.App {
+			continue
+		}
+		if _, err = a.Container.SafeGet(name); err != nil {
+			report.Failures = append(report.Failures, DefinitionFailure{Name: name, Err: err})
+		}
+	}
+
+	if len(report.Failures) > 0 {
+		return report
+	}
+	return nil
+}
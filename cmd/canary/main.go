@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"gotham/testkit"
+)
+
+// canary replays a recorded corpus against two running versions of the api and reports the responses that differ.
+//
+//	go run ./cmd/canary -corpus storage/corpus.jsonl -base http://localhost:8080 -candidate http://localhost:8081 -ignore created_at,updated_at
+func main() {
+	corpus := flag.String("corpus", "./storage/corpus.jsonl", "recorded corpus file")
+	base := flag.String("base", "", "url of the current release")
+	candidate := flag.String("candidate", "", "url of the release under test")
+	ignore := flag.String("ignore", "created_at,updated_at,access_token,access_token_exp", "comma separated json keys ignored when comparing bodies")
+	token := flag.String("token", "", "bearer token sent with every replayed request")
+	asJson := flag.Bool("json", false, "print the report as json")
+	flag.Parse()
+
+	if *base == "" || *candidate == "" {
+		fmt.Println("both -base and -candidate are required")
+		os.Exit(2)
+	}
+
+	entries, err := testkit.ReadCorpus(*corpus)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(2)
+	}
+
+	header := http.Header{}
+	if *token != "" {
+		header.Set("Authorization", "Bearer "+*token)
+	}
+	ignored := strings.Split(*ignore, ",")
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	report := testkit.Report{Total: len(entries)}
+	for _, entry := range entries {
+		baseStatus, baseBody, err := testkit.Replay(client, strings.TrimRight(*base, "/"), entry, header)
+		if err != nil {
+			report.Failed = append(report.Failed, testkit.Mismatch{Entry: entry, Error: err.Error()})
+			continue
+		}
+		candidateStatus, candidateBody, err := testkit.Replay(client, strings.TrimRight(*candidate, "/"), entry, header)
+		if err != nil {
+			report.Failed = append(report.Failed, testkit.Mismatch{Entry: entry, Error: err.Error()})
+			continue
+		}
+		if differences := testkit.CompareResponses(baseStatus, baseBody, candidateStatus, candidateBody, ignored); len(differences) > 0 {
+			report.Failed = append(report.Failed, testkit.Mismatch{Entry: entry, Differences: differences})
+		}
+	}
+
+	if *asJson {
+		out, _ := json.MarshalIndent(report, "", "  ")
+		fmt.Println(string(out))
+	} else {
+		fmt.Print(report.String())
+	}
+
+	if len(report.Failed) > 0 {
+		os.Exit(1)
+	}
+}
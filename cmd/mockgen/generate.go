@@ -0,0 +1,332 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Method is a method of a mocked interface, its types qualified from the mocks package
+type Method struct {
+	Name string
+	Type *ast.FuncType
+}
+
+/**
+ * Generate
+ * a file of mocks per package, named after it
+ */
+func Generate(sources []*Package, mocksPackage string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	owners := map[string]string{}
+	for _, pkg := range sources {
+		imports := map[string]string{}
+		var body bytes.Buffer
+		for _, name := range pkg.Order {
+			used := map[string]string{pkg.Name: pkg.Path}
+			methods, err := pkg.methods(pkg.Interfaces[name], nil, used)
+			if err != nil {
+				return nil, fmt.Errorf("%v.%v: %w", pkg.Name, name, err)
+			}
+			unexported := false
+			for _, method := range methods {
+				unexported = unexported || !ast.IsExported(method.Name)
+			}
+			if unexported {
+				fmt.Fprintf(os.Stderr, "mockgen: %v.%v is skipped, it has unexported methods\n", pkg.Name, name)
+				continue
+			}
+
+			mock := name[1:]
+			if owner, ok := owners[mock]; ok {
+				return nil, fmt.Errorf("%v.%v and %v.%v are both mocked as %v", owner, name, pkg.Name, name, mock)
+			}
+			owners[mock] = pkg.Name
+			for alias, importPath := range used {
+				if known, ok := imports[alias]; ok && known != importPath {
+					return nil, fmt.Errorf("%v refers to both %v and %v", alias, known, importPath)
+				}
+				imports[alias] = importPath
+			}
+			writeMock(&body, pkg.Name+"."+name, mock, methods)
+		}
+		if body.Len() == 0 {
+			continue
+		}
+
+		var file bytes.Buffer
+		file.WriteString("// Code generated by mockgen. DO NOT EDIT.\n\n")
+		file.WriteString("package " + mocksPackage + "\n\n")
+		writeImports(&file, imports, pkg.Path[:strings.Index(pkg.Path, "/")])
+		file.Write(body.Bytes())
+		source, err := format.Source(file.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", pkg.Name, err)
+		}
+		files[strings.ReplaceAll(pkg.Path[strings.Index(pkg.Path, "/")+1:], "/", "_")+".go"] = source
+	}
+	return files, nil
+}
+
+// writeImports groups the imports like the sources, the standard library, the dependencies and the module
+func writeImports(file *bytes.Buffer, imports map[string]string, module string) {
+	groups := make([][]string, 3)
+	for name, importPath := range imports {
+		group := 1
+		if !strings.Contains(strings.SplitN(importPath, "/", 2)[0], ".") {
+			group = 0
+		}
+		if importPath == module || strings.HasPrefix(importPath, module+"/") {
+			group = 2
+		}
+		spec := strconv.Quote(importPath)
+		if packageName(importPath) != name {
+			spec = name + " " + spec
+		}
+		groups[group] = append(groups[group], spec)
+	}
+
+	file.WriteString("import (\n")
+	written := false
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		if written {
+			file.WriteString("\n")
+		}
+		sort.Slice(group, func(i, j int) bool { return importPath(group[i]) < importPath(group[j]) })
+		for _, spec := range group {
+			file.WriteString("\t" + spec + "\n")
+		}
+		written = true
+	}
+	file.WriteString(")\n\n")
+}
+
+func importPath(spec string) string {
+	return spec[strings.Index(spec, `"`):]
+}
+
+func writeMock(body *bytes.Buffer, iface string, mock string, methods []Method) {
+	fmt.Fprintf(body, "// %v is a mock of %v\n", mock, iface)
+	fmt.Fprintf(body, "type %v struct {\n", mock)
+	for _, method := range methods {
+		fmt.Fprintf(body, "\t%vFunc %v\n", method.Name, render(method.Type))
+	}
+	body.WriteString("}\n\n")
+	fmt.Fprintf(body, "var _ %v = (*%v)(nil)\n\n", iface, mock)
+
+	for _, method := range methods {
+		var params, args []string
+		if method.Type.Params != nil {
+			for i, field := range flatten(method.Type.Params) {
+				name := "p" + strconv.Itoa(i)
+				if len(field.Names) == 1 && field.Names[0].Name != "_" && field.Names[0].Name != "mock" {
+					name = field.Names[0].Name
+				}
+				params = append(params, name+" "+render(field.Type))
+				if _, ok := field.Type.(*ast.Ellipsis); ok {
+					name += "..."
+				}
+				args = append(args, name)
+			}
+		}
+		results := ""
+		if method.Type.Results != nil && len(method.Type.Results.List) > 0 {
+			results = " " + render(&ast.FuncType{Params: &ast.FieldList{}, Results: method.Type.Results})[len("func()"):]
+		}
+
+		fmt.Fprintf(body, "func (mock *%v) %v(%v)%v {\n", mock, method.Name, strings.Join(params, ", "), results)
+		fmt.Fprintf(body, "\tif mock.%vFunc == nil {\n", method.Name)
+		fmt.Fprintf(body, "\t\tpanic(%q)\n", "mocks: "+mock+"."+method.Name+" is not mocked")
+		body.WriteString("\t}\n\t")
+		if results != "" {
+			body.WriteString("return ")
+		}
+		fmt.Fprintf(body, "mock.%vFunc(%v)\n}\n\n", method.Name, strings.Join(args, ", "))
+	}
+}
+
+// flatten splits the grouped parameters, `a, b string` in `a string` and `b string`
+func flatten(fields *ast.FieldList) (flat []*ast.Field) {
+	for _, field := range fields.List {
+		if len(field.Names) <= 1 {
+			flat = append(flat, field)
+			continue
+		}
+		for _, name := range field.Names {
+			flat = append(flat, &ast.Field{Names: []*ast.Ident{name}, Type: field.Type})
+		}
+	}
+	return flat
+}
+
+func render(node ast.Node) string {
+	var buffer bytes.Buffer
+	_ = format.Node(&buffer, token.NewFileSet(), node)
+	return buffer.String()
+}
+
+// methods lists the methods of the interface with the ones it embeds, subst are the type arguments of a generic one
+func (pkg *Package) methods(iface *Interface, subst map[string]ast.Expr, imports map[string]string) (methods []Method, err error) {
+	seen := map[string]bool{}
+	add := func(method Method) {
+		if !seen[method.Name] {
+			seen[method.Name] = true
+			methods = append(methods, method)
+		}
+	}
+
+	for _, field := range iface.Spec.Type.(*ast.InterfaceType).Methods.List {
+		if len(field.Names) > 0 {
+			funcType, err := pkg.resolve(field.Type, iface, subst, imports)
+			if err != nil {
+				return nil, err
+			}
+			add(Method{Name: field.Names[0].Name, Type: funcType.(*ast.FuncType)})
+			continue
+		}
+
+		// an embedded interface of the package, generic ones with their type arguments
+		embedded, args := field.Type, []ast.Expr(nil)
+		switch t := field.Type.(type) {
+		case *ast.IndexExpr:
+			embedded, args = t.X, []ast.Expr{t.Index}
+		case *ast.IndexListExpr:
+			embedded, args = t.X, t.Indices
+		}
+		ident, ok := embedded.(*ast.Ident)
+		if !ok || pkg.Interfaces[ident.Name] == nil {
+			return nil, fmt.Errorf("embeds %v which is not an interface of the package", render(field.Type))
+		}
+		inner := pkg.Interfaces[ident.Name]
+		var innerSubst map[string]ast.Expr
+		if inner.Spec.TypeParams != nil {
+			innerSubst = map[string]ast.Expr{}
+			i := 0
+			for _, param := range inner.Spec.TypeParams.List {
+				for _, name := range param.Names {
+					if i >= len(args) {
+						return nil, fmt.Errorf("embeds %v without its type arguments", ident.Name)
+					}
+					if innerSubst[name.Name], err = pkg.resolve(args[i], iface, subst, imports); err != nil {
+						return nil, err
+					}
+					i++
+				}
+			}
+		}
+		innerMethods, err := pkg.methods(inner, innerSubst, imports)
+		if err != nil {
+			return nil, err
+		}
+		for _, method := range innerMethods {
+			add(method)
+		}
+	}
+	return methods, nil
+}
+
+// resolve copies the type expression of the interface, the types of the package are qualified by its name and the
+// packages referred to are added to the imports of the mocks
+func (pkg *Package) resolve(expr ast.Expr, iface *Interface, subst map[string]ast.Expr, imports map[string]string) (ast.Expr, error) {
+	if expr == nil {
+		return nil, nil
+	}
+	var err error
+	each := func(e ast.Expr) ast.Expr {
+		if err != nil {
+			return nil
+		}
+		var resolved ast.Expr
+		resolved, err = pkg.resolve(e, iface, subst, imports)
+		return resolved
+	}
+	fields := func(list *ast.FieldList) *ast.FieldList {
+		if list == nil {
+			return nil
+		}
+		copied := &ast.FieldList{}
+		for _, field := range list.List {
+			var names []*ast.Ident
+			for _, name := range field.Names {
+				names = append(names, ast.NewIdent(name.Name))
+			}
+			copied.List = append(copied.List, &ast.Field{Names: names, Type: each(field.Type)})
+		}
+		return copied
+	}
+
+	var resolved ast.Expr
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if arg, ok := subst[t.Name]; ok {
+			return arg, nil
+		}
+		if pkg.Types[t.Name] {
+			imports[pkg.Name] = pkg.Path
+			return &ast.SelectorExpr{X: ast.NewIdent(pkg.Name), Sel: ast.NewIdent(t.Name)}, nil
+		}
+		return ast.NewIdent(t.Name), nil
+	case *ast.SelectorExpr:
+		name := t.X.(*ast.Ident).Name
+		importPath, ok := iface.Imports[name]
+		if !ok {
+			return nil, fmt.Errorf("the package of %v is not imported by its name", render(t))
+		}
+		if known, ok := imports[name]; ok && known != importPath {
+			return nil, fmt.Errorf("%v refers to both %v and %v", name, known, importPath)
+		}
+		imports[name] = importPath
+		return &ast.SelectorExpr{X: ast.NewIdent(name), Sel: ast.NewIdent(t.Sel.Name)}, nil
+	case *ast.StarExpr:
+		resolved = &ast.StarExpr{X: each(t.X)}
+	case *ast.ParenExpr:
+		resolved = &ast.ParenExpr{X: each(t.X)}
+	case *ast.Ellipsis:
+		resolved = &ast.Ellipsis{Elt: each(t.Elt)}
+	case *ast.ArrayType:
+		var length ast.Expr
+		if lit, ok := t.Len.(*ast.BasicLit); ok {
+			length = &ast.BasicLit{Kind: lit.Kind, Value: lit.Value}
+		} else if t.Len != nil {
+			length = each(t.Len)
+		}
+		resolved = &ast.ArrayType{Len: length, Elt: each(t.Elt)}
+	case *ast.MapType:
+		resolved = &ast.MapType{Key: each(t.Key), Value: each(t.Value)}
+	case *ast.ChanType:
+		resolved = &ast.ChanType{Dir: t.Dir, Value: each(t.Value)}
+	case *ast.FuncType:
+		resolved = &ast.FuncType{Params: fields(t.Params), Results: fields(t.Results)}
+	case *ast.InterfaceType:
+		// the empty ones are written on one line, the printer breaks them without the positions of their braces
+		if len(t.Methods.List) == 0 {
+			return ast.NewIdent("interface{}"), nil
+		}
+		resolved = &ast.InterfaceType{Methods: fields(t.Methods)}
+	case *ast.StructType:
+		if len(t.Fields.List) == 0 {
+			return ast.NewIdent("struct{}"), nil
+		}
+		resolved = &ast.StructType{Fields: fields(t.Fields)}
+	case *ast.IndexExpr:
+		resolved = &ast.IndexExpr{X: each(t.X), Index: each(t.Index)}
+	case *ast.IndexListExpr:
+		var indices []ast.Expr
+		for _, index := range t.Indices {
+			indices = append(indices, each(index))
+		}
+		resolved = &ast.IndexListExpr{X: each(t.X), Indices: indices}
+	default:
+		return nil, fmt.Errorf("unsupported type expression %T", expr)
+	}
+	return resolved, err
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// mockgen generates a mock of every exported interface (IUserRepository, IAuthService, IEmailService...) of the
+// packages into the mocks package. A mock has a func field per method, a method whose func is not set panics so a
+// test fails on the calls it did not expect.
+//
+//	go generate ./mocks
+//	go run ./cmd/mockgen -root . -out mocks
+func main() {
+	root := flag.String("root", ".", "root of the module")
+	out := flag.String("out", "./mocks", "output directory of the mocks")
+	pkg := flag.String("package", "mocks", "package name of the mocks")
+	module := flag.String("module", "gotham", "path of the module")
+	packages := flag.String("packages", "infrastructures,repositories,repositories/transactions,services,policies,mails,utils", "comma separated folders of the interfaces")
+	flag.Parse()
+
+	var sources []*Package
+	for _, dir := range strings.Split(*packages, ",") {
+		source, err := ParsePackage(filepath.Join(*root, dir), *module+"/"+filepath.ToSlash(dir))
+		if err != nil {
+			fail(err)
+		}
+		sources = append(sources, source)
+	}
+
+	files, err := Generate(sources, *pkg)
+	if err != nil {
+		fail(err)
+	}
+	for name, content := range files {
+		if err := write(filepath.Join(*out, name), content); err != nil {
+			fail(err)
+		}
+	}
+}
+
+func write(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "mockgen: "+err.Error())
+	os.Exit(2)
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Package is the parsed source of a package, only its declarations are read so it is parsed without its dependencies
+type Package struct {
+	Name string
+	Path string
+	// Types are the types declared by the package, they are qualified when a mock refers to them
+	Types map[string]bool
+	// Interfaces are by name, the ones to mock are listed in Order in the order of their declaration
+	Interfaces map[string]*Interface
+	Order      []string
+}
+
+// Interface is a declared interface and the imports of its file
+type Interface struct {
+	Spec    *ast.TypeSpec
+	Imports map[string]string
+}
+
+/**
+ * ParsePackage
+ * the declarations of the go files of dir, its tests excluded
+ */
+func ParsePackage(dir string, importPath string) (*Package, error) {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed) != 1 {
+		return nil, fmt.Errorf("%v holds %d packages", dir, len(parsed))
+	}
+
+	pkg := &Package{Path: importPath, Types: map[string]bool{}, Interfaces: map[string]*Interface{}}
+	var names []string
+	for name := range parsed {
+		pkg.Name = name
+		for fileName := range parsed[name].Files {
+			names = append(names, fileName)
+		}
+	}
+	sort.Strings(names)
+
+	for _, fileName := range names {
+		file := parsed[pkg.Name].Files[fileName]
+		imports := map[string]string{}
+		for _, spec := range file.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			name := packageName(importPath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = importPath
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				pkg.Types[typeSpec.Name.Name] = true
+				if _, ok := typeSpec.Type.(*ast.InterfaceType); !ok {
+					continue
+				}
+				pkg.Interfaces[typeSpec.Name.Name] = &Interface{Spec: typeSpec, Imports: imports}
+				if mockable(typeSpec) {
+					pkg.Order = append(pkg.Order, typeSpec.Name.Name)
+				}
+			}
+		}
+	}
+	return pkg, nil
+}
+
+// mockable tells if the interface follows the IXxx naming of the services, generic ones are only embedded
+func mockable(spec *ast.TypeSpec) bool {
+	name := spec.Name.Name
+	return len(name) > 1 && name[0] == 'I' && ast.IsExported(name[1:]) && spec.TypeParams == nil
+}
+
+// packageName is the name a package is imported by without an alias, the last element of its path without its major
+// version
+func packageName(importPath string) string {
+	name := path.Base(importPath)
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = path.Base(path.Dir(importPath))
+	}
+	if i := strings.Index(name, ".v"); i > 0 {
+		name = name[:i]
+	}
+	name = strings.TrimPrefix(name, "go-")
+	return strings.ReplaceAll(name, "-", "")
+}
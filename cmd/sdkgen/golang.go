@@ -0,0 +1,356 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"strings"
+)
+
+const goRuntime = `
+// Client calls the gotham api, the token set with SetToken is sent as a bearer token
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+
+	token string
+}
+
+func New(baseURL string) *Client {
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+func (c *Client) SetToken(token string) {
+	c.token = token
+}
+
+func (c *Client) Token() string {
+	return c.token
+}
+
+// Problem is an application/problem+json error response, branch on Code
+type Problem struct {
+	Type   string                 ` + "`json:\"type\"`" + `
+	Title  string                 ` + "`json:\"title\"`" + `
+	Status int                    ` + "`json:\"status\"`" + `
+	Detail string                 ` + "`json:\"detail,omitempty\"`" + `
+	Code   string                 ` + "`json:\"code\"`" + `
+	Errors map[string]interface{} ` + "`json:\"errors,omitempty\"`" + `
+}
+
+func (p *Problem) Error() string {
+	if p.Detail != "" {
+		return p.Code + ": " + p.Detail
+	}
+	return p.Code + ": " + p.Title
+}
+
+type envelope struct {
+	Data interface{} ` + "`json:\"data\"`" + `
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out interface{}) ([]byte, error) {
+	target := c.BaseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		request.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	response, err := c.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode >= 400 {
+		problem := &Problem{Status: response.StatusCode, Title: http.StatusText(response.StatusCode)}
+		_ = json.Unmarshal(data, problem)
+		return nil, problem
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return nil, fmt.Errorf("decode %v %v: %w", method, path, err)
+		}
+	}
+	return data, nil
+}
+`
+
+// Go emits the go client package
+func Go(api *API, pkg, source string) ([]byte, error) {
+	out := new(strings.Builder)
+	out.WriteString(goRuntime)
+
+	for _, model := range api.Models {
+		out.WriteString("\n")
+		if model.Description != "" {
+			fmt.Fprintf(out, "// %v %v\n", model.Name, model.Description)
+		}
+		fmt.Fprintf(out, "type %v struct {\n", model.Name)
+		for _, field := range model.Fields {
+			tag := field.Name
+			if !field.Required {
+				tag += ",omitempty"
+			}
+			fmt.Fprintf(out, "\t%v %v `json:\"%v\"`\n", exported(field.Name), goType(field.Type), tag)
+		}
+		out.WriteString("}\n")
+	}
+
+	for _, endpoint := range api.Endpoints {
+		if endpoint.ParamsModel != "" {
+			goParams(out, endpoint)
+		}
+		goMethod(out, endpoint)
+		if endpoint.Paginated {
+			goIterator(out, endpoint)
+		}
+	}
+
+	if api.Auth != nil {
+		fmt.Fprintf(out, "\n// Authenticate calls %v and keeps the issued access token for the following calls\n", api.Auth.Name)
+		fmt.Fprintf(out, "func (c *Client) Authenticate(ctx context.Context, body %v) (*%v, error) {\n", goType(api.Auth.Body), api.Auth.Result.Name)
+		fmt.Fprintf(out, "\tresult, err := c.%v(ctx, body)\n", api.Auth.Name)
+		out.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n\tc.SetToken(result.AccessToken)\n\treturn result, nil\n}\n")
+	}
+
+	imports := []string{"bytes", "context", "encoding/json", "fmt", "io", "net/http", "net/url"}
+	if strings.Contains(out.String(), "strconv.") {
+		imports = append(imports, "strconv")
+	}
+	imports = append(imports, "strings")
+
+	header := new(strings.Builder)
+	fmt.Fprintf(header, "// Code generated by sdkgen from %v; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(header, "// Package %v is a typed client of the gotham api.\n", pkg)
+	fmt.Fprintf(header, "package %v\n\nimport (\n", pkg)
+	for _, name := range imports {
+		fmt.Fprintf(header, "\t%q\n", name)
+	}
+	header.WriteString(")\n")
+
+	return format.Source([]byte(header.String() + out.String()))
+}
+
+func goParams(out *strings.Builder, e *Endpoint) {
+	fmt.Fprintf(out, "\n// %v are the query parameters of %v\n", e.ParamsModel, e.Name)
+	fmt.Fprintf(out, "type %v struct {\n", e.ParamsModel)
+	for _, field := range e.Query {
+		fmt.Fprintf(out, "\t%v %v\n", exported(field.Name), goType(field.Type))
+	}
+	out.WriteString("}\n\n")
+
+	fmt.Fprintf(out, "func (p %v) values() url.Values {\n\tvalues := url.Values{}\n", e.ParamsModel)
+	for _, field := range e.Query {
+		name := "p." + exported(field.Name)
+		switch goType(field.Type) {
+		case "string":
+			fmt.Fprintf(out, "\tif %v != \"\" {\n\t\tvalues.Set(%q, %v)\n\t}\n", name, field.Name, name)
+		case "int64":
+			fmt.Fprintf(out, "\tif %v != 0 {\n\t\tvalues.Set(%q, strconv.FormatInt(%v, 10))\n\t}\n", name, field.Name, name)
+		case "float64":
+			fmt.Fprintf(out, "\tif %v != 0 {\n\t\tvalues.Set(%q, strconv.FormatFloat(%v, 'f', -1, 64))\n\t}\n", name, field.Name, name)
+		case "bool":
+			fmt.Fprintf(out, "\tif %v {\n\t\tvalues.Set(%q, \"true\")\n\t}\n", name, field.Name)
+		default:
+			fmt.Fprintf(out, "\tfor _, value := range %v {\n\t\tvalues.Add(%q, fmt.Sprint(value))\n\t}\n", name, field.Name)
+		}
+	}
+	out.WriteString("\treturn values\n}\n")
+}
+
+func goMethod(out *strings.Builder, e *Endpoint) {
+	args := []string{"ctx context.Context"}
+	path := goPath(e.Path)
+	for _, param := range e.PathParams {
+		args = append(args, goIdent(param)+" string")
+	}
+	query, body := "nil", "nil"
+	if e.ParamsModel != "" {
+		args = append(args, "params "+e.ParamsModel)
+		query = "params.values()"
+	}
+	if e.Body != nil {
+		args = append(args, "body "+goType(e.Body))
+		body = "body"
+	}
+
+	out.WriteString("\n")
+	fmt.Fprintf(out, "// %v\n//\n//\t%v %v\n", strings.TrimSpace(e.Name+" "+e.Summary), e.Method, e.Path)
+	if e.Deprecated {
+		out.WriteString("//\n// Deprecated: the route is deprecated, see the Deprecation and Sunset headers of its responses\n")
+	}
+
+	call := fmt.Sprintf("c.do(ctx, %q, %v, %v, %v", e.Method, path, query, body)
+	switch {
+	case e.Raw:
+		fmt.Fprintf(out, "func (c *Client) %v(%v) ([]byte, error) {\n", e.Name, strings.Join(args, ", "))
+		fmt.Fprintf(out, "\treturn %v, nil)\n}\n", call)
+	case e.Result == nil:
+		fmt.Fprintf(out, "func (c *Client) %v(%v) error {\n", e.Name, strings.Join(args, ", "))
+		fmt.Fprintf(out, "\t_, err := %v, nil)\n\treturn err\n}\n", call)
+	default:
+		result, zero, target := goType(e.Result), "nil", "&result"
+		declare := fmt.Sprintf("var result %v", result)
+		if e.Result.Kind == Named {
+			result, target = "*"+result, "result"
+			declare = fmt.Sprintf("result := new(%v)", goType(e.Result))
+		} else if e.Result.Kind == Primitive {
+			zero = goZero(e.Result)
+		}
+		if e.Envelope {
+			target = "&envelope{Data: " + target + "}"
+		}
+		fmt.Fprintf(out, "func (c *Client) %v(%v) (%v, error) {\n", e.Name, strings.Join(args, ", "), result)
+		fmt.Fprintf(out, "\t%v\n\tif _, err := %v, %v); err != nil {\n\t\treturn %v, err\n\t}\n\treturn result, nil\n}\n", declare, call, target, zero)
+	}
+}
+
+func goIterator(out *strings.Builder, e *Endpoint) {
+	iterator, item := e.Name+"Iterator", goType(e.Item)
+	// the path parameters are kept by the iterator for every page
+	var fields, args, init, call string
+	for _, param := range e.PathParams {
+		ident := goIdent(param)
+		fields += fmt.Sprintf("\t%v string\n", ident)
+		args += ident + " string, "
+		init += fmt.Sprintf("%[1]v: %[1]v, ", ident)
+		call += "it." + ident + ", "
+	}
+	fmt.Fprintf(out, `
+// %[1]v walks every page of %[2]v
+type %[1]v struct {
+	client *Client
+%[6]v	params %[3]v
+	page   *%[4]v
+	index  int
+	last   bool
+	err    error
+}
+
+// %[2]vAll iterates over every %[5]v of %[2]v starting at params.Page
+func (c *Client) %[2]vAll(%[7]vparams %[3]v) *%[1]v {
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	return &%[1]v{client: c, %[8]vparams: params}
+}
+
+// Next advances to the next item, the next page is fetched once the current one is exhausted
+func (it *%[1]v) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.page == nil || it.index >= len(it.page.Records) {
+		if it.last {
+			return false
+		}
+		page, err := it.client.%[2]v(ctx, %[9]vit.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+		it.last = len(page.Records) == 0 || page.Page*page.Limit >= page.TotalRecord
+		it.params.Page++
+	}
+	it.index++
+	return true
+}
+
+func (it *%[1]v) Value() %[5]v {
+	return it.page.Records[it.index-1]
+}
+
+func (it *%[1]v) Err() error {
+	return it.err
+}
+`, iterator, e.Name, e.ParamsModel, e.Result.Name, item, fields, args, init, call)
+}
+
+// goPath concatenates the literal parts of a path with its escaped parameters
+func goPath(path string) string {
+	var parts []string
+	literal := ""
+	for i, segment := range strings.Split(path, "/") {
+		if i > 0 {
+			literal += "/"
+		}
+		if strings.HasPrefix(segment, ":") {
+			parts = append(parts, fmt.Sprintf("%q", literal), fmt.Sprintf("url.PathEscape(%v)", goIdent(segment[1:])))
+			literal = ""
+			continue
+		}
+		literal += segment
+	}
+	if literal != "" {
+		parts = append(parts, fmt.Sprintf("%q", literal))
+	}
+	return strings.Join(parts, " + ")
+}
+
+func goType(t *TypeRef) string {
+	switch t.Kind {
+	case Named:
+		return t.Name
+	case Array:
+		return "[]" + goType(t.Elem)
+	case Primitive:
+		switch t.Name {
+		case "integer":
+			return "int64"
+		case "number":
+			return "float64"
+		case "boolean":
+			return "bool"
+		}
+		return "string"
+	}
+	return "json.RawMessage"
+}
+
+func goZero(t *TypeRef) string {
+	switch goType(t) {
+	case "string":
+		return `""`
+	case "bool":
+		return "false"
+	}
+	return "0"
+}
+
+// goIdent is the argument name of a path parameter
+func goIdent(name string) string {
+	ident := camel(name)
+	switch ident {
+	case "type", "func", "range", "map", "default", "select", "case", "go", "chan", "package", "import", "var", "const":
+		return ident + "ID"
+	}
+	return ident
+}
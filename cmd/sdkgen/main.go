@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// sdkgen generates the typed go and typescript clients from the swagger spec. With a route registry
+// printed by `gotham -routes` the operations that are not registered are skipped and the registered
+// routes missing from the spec are reported.
+//
+//	swag init && go run gotham -routes > routes.json
+//	go run ./cmd/sdkgen -spec docs/swagger.json -routes routes.json -out sdk
+//
+// -lang go generates the go client only. The operations annotated @Deprecated and the routes the registry marks
+// deprecated are deprecated in the clients, the ones marked by only one of them are reported.
+func main() {
+	spec := flag.String("spec", "./docs/swagger.json", "swagger spec generated by swag")
+	routes := flag.String("routes", "", "route registry printed by gotham -routes")
+	out := flag.String("out", "./sdk", "output directory of the clients")
+	pkg := flag.String("package", "client", "package name of the go client")
+	strict := flag.Bool("strict", false, "fail when the spec and the route registry disagree")
+	languages := flag.String("lang", "go,ts", "comma separated languages of the clients: go, ts")
+	flag.Parse()
+
+	langs := strings.Split(*languages, ",")
+	for i, language := range langs {
+		if langs[i] = strings.TrimSpace(language); langs[i] != "go" && langs[i] != "ts" {
+			fail(fmt.Errorf("unknown language %q, go or ts", language))
+		}
+	}
+
+	s, err := ReadSpec(*spec)
+	if err != nil {
+		fail(err)
+	}
+
+	var registry []Route
+	if *routes != "" {
+		if registry, err = ReadRoutes(*routes); err != nil {
+			fail(err)
+		}
+	}
+
+	api, warnings, err := Build(s, registry)
+	if err != nil {
+		fail(err)
+	}
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, "sdkgen: "+warning)
+	}
+	if *strict && len(warnings) > 0 {
+		os.Exit(1)
+	}
+
+	source := filepath.ToSlash(filepath.Clean(*spec))
+	for _, language := range langs {
+		switch language {
+		case "go":
+			client, err := Go(api, *pkg, source)
+			if err != nil {
+				fail(err)
+			}
+			if err := write(filepath.Join(*out, "go", "client.go"), client); err != nil {
+				fail(err)
+			}
+		case "ts":
+			if err := write(filepath.Join(*out, "ts", "client.ts"), TypeScript(api, source)); err != nil {
+				fail(err)
+			}
+		}
+	}
+}
+
+func write(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "sdkgen: "+err.Error())
+	os.Exit(2)
+}
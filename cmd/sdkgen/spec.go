@@ -0,0 +1,548 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Schema is the subset of a swagger 2.0 schema swag emits
+type Schema struct {
+	Ref         string             `json:"$ref"`
+	Type        string             `json:"type"`
+	Description string             `json:"description"`
+	Items       *Schema            `json:"items"`
+	Properties  map[string]*Schema `json:"properties"`
+	Required    []string           `json:"required"`
+	AllOf       []*Schema          `json:"allOf"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Type        string  `json:"type"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Items       *Schema `json:"items"`
+	Schema      *Schema `json:"schema"`
+}
+
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema"`
+}
+
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary"`
+	Description string              `json:"description"`
+	Tags        []string            `json:"tags"`
+	Deprecated  bool                `json:"deprecated"`
+	Parameters  []Parameter         `json:"parameters"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+type Spec struct {
+	Paths       map[string]map[string]*Operation `json:"paths"`
+	Definitions map[string]*Schema               `json:"definitions"`
+}
+
+// Route is an entry of the route registry printed by `gotham -routes`
+type Route struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Name   string `json:"name"`
+	// Deprecated is set for the routes marked with deprecated.Deprecate
+	Deprecated bool `json:"deprecated"`
+}
+
+func ReadSpec(path string) (*Spec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	spec := new(Spec)
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+	return spec, nil
+}
+
+func ReadRoutes(path string) ([]Route, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var routes []Route
+	if err := json.Unmarshal(data, &routes); err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+	return routes, nil
+}
+
+type Kind int
+
+const (
+	Any Kind = iota
+	Primitive
+	Named
+	Array
+)
+
+// TypeRef is a language independent type, primitives keep their swagger name
+type TypeRef struct {
+	Kind Kind
+	Name string
+	Elem *TypeRef
+}
+
+type Field struct {
+	Name        string
+	Description string
+	Type        *TypeRef
+	Required    bool
+}
+
+type Model struct {
+	Name        string
+	Description string
+	Fields      []Field
+}
+
+// Endpoint is an operation ready to be emitted as a client method
+type Endpoint struct {
+	Name        string
+	Summary     string
+	Method      string
+	Path        string
+	PathParams  []string
+	Query       []Field
+	Body        *TypeRef
+	Result      *TypeRef
+	Raw         bool
+	Envelope    bool
+	Paginated   bool
+	Item        *TypeRef
+	ParamsModel string
+	// Deprecated is set by the @Deprecated annotation of the operation or the deprecation of its route
+	Deprecated bool
+}
+
+type API struct {
+	Models    []*Model
+	Endpoints []*Endpoint
+
+	// Auth is the endpoint issuing an access token, the clients get an authenticate helper around it
+	Auth *Endpoint
+}
+
+type builder struct {
+	spec   *Spec
+	models map[string]*Model
+	// names are the model names of the definitions
+	names map[string]string
+}
+
+var (
+	pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+	version   = regexp.MustCompile(`^v[0-9]+$`)
+	separator = regexp.MustCompile(`[^A-Za-z0-9]+`)
+)
+
+// Build turns the spec into endpoints, operations missing from the route registry are skipped
+func Build(spec *Spec, routes []Route) (*API, []string, error) {
+	b := &builder{spec: spec, models: map[string]*Model{}, names: map[string]string{}}
+	api := new(API)
+	var warnings []string
+
+	registered, deprecated := map[string]bool{}, map[string]bool{}
+	for _, route := range routes {
+		registered[route.Method+" "+route.Path] = true
+		deprecated[route.Method+" "+route.Path] = route.Deprecated
+	}
+	documented := map[string]bool{}
+
+	paths := make([]string, 0, len(spec.Paths))
+	for path := range spec.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	names := map[string]string{}
+	for _, path := range paths {
+		methods := make([]string, 0, len(spec.Paths[path]))
+		for method := range spec.Paths[path] {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+
+		for _, method := range methods {
+			normalized := pathParam.ReplaceAllString(path, ":$1")
+			key := strings.ToUpper(method) + " " + normalized
+			documented[key] = true
+			if routes != nil && !registered[key] {
+				warnings = append(warnings, fmt.Sprintf("skipping %v, it is not registered", key))
+				continue
+			}
+			// a websocket upgrade is not a call of the clients
+			if _, ok := spec.Paths[path][method].Responses["101"]; ok {
+				warnings = append(warnings, fmt.Sprintf("skipping %v, it switches protocols", key))
+				continue
+			}
+
+			endpoint, err := b.endpoint(strings.ToUpper(method), normalized, spec.Paths[path][method])
+			if err != nil {
+				return nil, nil, fmt.Errorf("%v: %v", key, err)
+			}
+			if other, ok := names[endpoint.Name]; ok {
+				return nil, nil, fmt.Errorf("%v and %v are both named %v, set a distinct @ID", other, key, endpoint.Name)
+			}
+			names[endpoint.Name] = key
+			// the route deprecated by the registry is deprecated in the clients, the spec is told to follow
+			if routes != nil && deprecated[key] != endpoint.Deprecated {
+				if deprecated[key] {
+					warnings = append(warnings, fmt.Sprintf("%v is deprecated but not annotated @Deprecated", key))
+				} else {
+					warnings = append(warnings, fmt.Sprintf("%v is annotated @Deprecated but its route is not deprecated", key))
+				}
+				endpoint.Deprecated = true
+			}
+			api.Endpoints = append(api.Endpoints, endpoint)
+
+			if api.Auth == nil && endpoint.Body != nil && b.hasField(endpoint.Result, "access_token") {
+				api.Auth = endpoint
+			}
+		}
+	}
+
+	for _, route := range routes {
+		key := route.Method + " " + route.Path
+		// a HEAD route answers the headers of its GET, the clients do not call it
+		if route.Method == "HEAD" && documented["GET "+route.Path] {
+			continue
+		}
+		// the handlers of echo are the not found routes of the groups
+		if !documented[key] && !strings.Contains(route.Path, "*") && !strings.HasPrefix(route.Name, "github.com/labstack/echo/") {
+			warnings = append(warnings, fmt.Sprintf("%v is registered but not documented", key))
+		}
+	}
+
+	sort.Slice(api.Endpoints, func(i, j int) bool {
+		return api.Endpoints[i].Name < api.Endpoints[j].Name
+	})
+	for _, model := range b.models {
+		api.Models = append(api.Models, model)
+	}
+	sort.Slice(api.Models, func(i, j int) bool {
+		return api.Models[i].Name < api.Models[j].Name
+	})
+	return api, warnings, nil
+}
+
+func (b *builder) endpoint(method, path string, operation *Operation) (*Endpoint, error) {
+	e := &Endpoint{
+		Name:       operationName(method, path, operation.OperationID),
+		Summary:    strings.TrimSpace(operation.Summary),
+		Method:     method,
+		Path:       path,
+		Deprecated: operation.Deprecated,
+	}
+
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, ":") {
+			e.PathParams = append(e.PathParams, segment[1:])
+		}
+	}
+
+	var body []Field
+	for _, parameter := range operation.Parameters {
+		switch parameter.In {
+		case "query":
+			e.Query = append(e.Query, Field{
+				Name:        parameter.Name,
+				Description: parameter.Description,
+				Type:        b.typeOf(&Schema{Type: parameter.Type, Items: parameter.Items}, ""),
+				Required:    parameter.Required,
+			})
+		case "body", "formData":
+			schema := parameter.Schema
+			if schema == nil {
+				schema = &Schema{Type: parameter.Type, Items: parameter.Items}
+			}
+			// a single body parameter holding an object is the body itself
+			if (schema.Ref != "" || len(schema.Properties) > 0) && countIn(operation.Parameters, parameter.In) == 1 {
+				e.Body = b.typeOf(schema, e.Name+"Body")
+				continue
+			}
+			body = append(body, Field{
+				Name:     parameter.Name,
+				Type:     b.typeOf(schema, e.Name+exported(parameter.Name)),
+				Required: parameter.Required,
+			})
+		}
+		// header parameters are the bearer token, the client sends it
+	}
+	if len(body) > 0 {
+		e.Body = b.model(e.Name+"Body", "", body)
+	}
+	if len(e.Query) > 0 {
+		e.ParamsModel = e.Name + "Params"
+	}
+
+	status, response := success(operation.Responses)
+	if status == 0 {
+		return nil, fmt.Errorf("no success response is documented")
+	}
+	switch {
+	case response.Schema == nil && status != 204:
+		e.Raw = true
+	case response.Schema != nil:
+		if data, ok := b.envelope(response.Schema); ok {
+			e.Envelope = true
+			e.Result = b.typeOf(data, e.Name+"Result")
+		} else {
+			e.Result = b.typeOf(response.Schema, e.Name+"Result")
+		}
+	}
+
+	if hasQuery(e.Query, "page") && hasQuery(e.Query, "limit") {
+		if model := b.named(e.Result); model != nil {
+			records := fieldOf(model, "records")
+			if records != nil && records.Type.Kind == Array && fieldOf(model, "total_record") != nil && fieldOf(model, "page") != nil && fieldOf(model, "limit") != nil {
+				e.Paginated = true
+				e.Item = records.Type.Elem
+			}
+		}
+	}
+	return e, nil
+}
+
+// envelope returns the schema of data when the response is wrapped in viewModels.HTTPSuccessResponse
+func (b *builder) envelope(schema *Schema) (*Schema, bool) {
+	fields := b.properties(schema)
+	data, ok := fields["data"]
+	if !ok || len(fields) != 1 {
+		return nil, false
+	}
+	return data, true
+}
+
+// properties merges the properties of a schema, its reference and its allOf parts
+func (b *builder) properties(schema *Schema) map[string]*Schema {
+	properties := map[string]*Schema{}
+	if schema == nil {
+		return properties
+	}
+	if schema.Ref != "" {
+		if definition, ok := b.spec.Definitions[refName(schema.Ref)]; ok {
+			for name, property := range b.properties(definition) {
+				properties[name] = property
+			}
+		}
+	}
+	for _, part := range schema.AllOf {
+		for name, property := range b.properties(part) {
+			properties[name] = property
+		}
+	}
+	for name, property := range schema.Properties {
+		properties[name] = property
+	}
+	return properties
+}
+
+func (b *builder) typeOf(schema *Schema, hint string) *TypeRef {
+	switch {
+	case schema == nil:
+		return &TypeRef{Kind: Any}
+	case schema.Ref != "" && len(schema.AllOf) == 0 && len(schema.Properties) == 0:
+		return b.definition(refName(schema.Ref))
+	case len(schema.AllOf) > 0 || len(schema.Properties) > 0:
+		return b.inline(schema, hint)
+	case schema.Type == "array":
+		return &TypeRef{Kind: Array, Elem: b.typeOf(schema.Items, hint+"Item")}
+	case schema.Type == "string", schema.Type == "integer", schema.Type == "number", schema.Type == "boolean":
+		return &TypeRef{Kind: Primitive, Name: schema.Type}
+	}
+	return &TypeRef{Kind: Any}
+}
+
+func (b *builder) definition(ref string) *TypeRef {
+	name, ok := b.names[ref]
+	if !ok {
+		definition := b.spec.Definitions[ref]
+		if definition == nil {
+			return &TypeRef{Kind: Any}
+		}
+		name = modelName(ref)
+		// the model of another package with the same name, or a type of the clients, keeps its package
+		if _, taken := b.models[name]; taken || reserved[name] {
+			name = exported(ref)
+		}
+		b.names[ref] = name
+		// registered before its fields are resolved so self references terminate
+		model := &Model{Name: name, Description: definition.Description}
+		b.models[name] = model
+		model.Fields = b.fields(definition, name)
+	}
+	return &TypeRef{Kind: Named, Name: name}
+}
+
+func (b *builder) inline(schema *Schema, name string) *TypeRef {
+	return b.model(name, schema.Description, b.fields(schema, name))
+}
+
+func (b *builder) model(name, description string, fields []Field) *TypeRef {
+	b.models[name] = &Model{Name: name, Description: description, Fields: fields}
+	return &TypeRef{Kind: Named, Name: name}
+}
+
+func (b *builder) fields(schema *Schema, hint string) []Field {
+	properties := b.properties(schema)
+	required := map[string]bool{}
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]Field, 0, len(names))
+	for _, name := range names {
+		fields = append(fields, Field{
+			Name:        name,
+			Description: properties[name].Description,
+			Type:        b.typeOf(properties[name], hint+exported(name)),
+			Required:    required[name],
+		})
+	}
+	return fields
+}
+
+func (b *builder) named(t *TypeRef) *Model {
+	if t == nil || t.Kind != Named {
+		return nil
+	}
+	return b.models[t.Name]
+}
+
+func (b *builder) hasField(t *TypeRef, name string) bool {
+	model := b.named(t)
+	return model != nil && fieldOf(model, name) != nil
+}
+
+func fieldOf(model *Model, name string) *Field {
+	for i := range model.Fields {
+		if model.Fields[i].Name == name {
+			return &model.Fields[i]
+		}
+	}
+	return nil
+}
+
+func hasQuery(fields []Field, name string) bool {
+	for _, field := range fields {
+		if field.Name == name && field.Type.Kind == Primitive && field.Type.Name == "integer" {
+			return true
+		}
+	}
+	return false
+}
+
+func countIn(parameters []Parameter, in string) (count int) {
+	for _, parameter := range parameters {
+		if parameter.In == in {
+			count++
+		}
+	}
+	return count
+}
+
+// success returns the lowest documented 2xx response
+func success(responses map[string]Response) (int, Response) {
+	best := 0
+	var response Response
+	for code, r := range responses {
+		status, err := strconv.Atoi(code)
+		if err != nil || status < 200 || status > 299 {
+			continue
+		}
+		if best == 0 || status < best {
+			best, response = status, r
+		}
+	}
+	return best, response
+}
+
+func refName(ref string) string {
+	return strings.TrimPrefix(ref, "#/definitions/")
+}
+
+// modelName drops the go package of a definition, viewModels.Login becomes Login
+func modelName(definition string) string {
+	if i := strings.LastIndex(definition, "."); i >= 0 {
+		definition = definition[i+1:]
+	}
+	return exported(definition)
+}
+
+// operationName prefers the @ID of the operation and falls back to the method and the path
+func operationName(method, path, id string) string {
+	if id != "" {
+		return exported(id)
+	}
+	name := strings.Title(strings.ToLower(method))
+	for _, segment := range strings.Split(path, "/") {
+		switch {
+		case segment == "" || segment == "restricted" || version.MatchString(segment):
+		case strings.HasPrefix(segment, ":"):
+			name += "By" + exported(segment[1:])
+		default:
+			name += exported(segment)
+		}
+	}
+	return name
+}
+
+// reserved are the types the clients declare besides the models
+var reserved = map[string]bool{"Client": true, "ClientOptions": true, "Problem": true, "ProblemError": true, "Query": true}
+
+var initialisms = map[string]string{"id": "ID", "url": "URL", "uri": "URI", "api": "API", "http": "HTTP", "json": "JSON", "uuid": "UUID", "ip": "IP"}
+
+// exported converts snake, kebab and camel case names to an exported go identifier
+func exported(name string) string {
+	var out strings.Builder
+	for _, word := range separator.Split(name, -1) {
+		if word == "" {
+			continue
+		}
+		if initialism, ok := initialisms[strings.ToLower(word)]; ok {
+			out.WriteString(initialism)
+			continue
+		}
+		out.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return out.String()
+}
+
+// camel converts a name to a lower camel case identifier
+func camel(name string) string {
+	name = exported(name)
+	for initialism := range initialisms {
+		upper := strings.ToUpper(initialism)
+		if strings.HasPrefix(name, upper) {
+			return strings.ToLower(upper) + name[len(upper):]
+		}
+	}
+	if name == "" {
+		return name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
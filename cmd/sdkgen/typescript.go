@@ -0,0 +1,238 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const tsRuntime = `
+export interface Problem {
+  type: string;
+  title: string;
+  status: number;
+  detail?: string;
+  code: string;
+  errors?: Record<string, unknown>;
+}
+
+/** ProblemError is thrown for every application/problem+json response, branch on problem.code */
+export class ProblemError extends Error {
+  constructor(public readonly problem: Problem) {
+    super(problem.detail ? problem.code + ': ' + problem.detail : problem.code + ': ' + problem.title);
+    this.name = 'ProblemError';
+  }
+}
+
+export interface ClientOptions {
+  token?: string;
+  fetch?: typeof fetch;
+}
+
+type Query = Record<string, string | number | boolean | undefined>;
+
+export class Client {
+  private token?: string;
+  private readonly fetcher: typeof fetch;
+
+  constructor(private readonly baseUrl: string, options: ClientOptions = {}) {
+    this.baseUrl = baseUrl.replace(/\/+$/, '');
+    this.token = options.token;
+    this.fetcher = options.fetch ?? fetch.bind(globalThis);
+  }
+
+  /** setToken sets the bearer token sent with every request */
+  setToken(token?: string): void {
+    this.token = token;
+  }
+
+  getToken(): string | undefined {
+    return this.token;
+  }
+
+  private async request(method: string, path: string, query?: Query, body?: unknown): Promise<Response> {
+    let url = this.baseUrl + path;
+    const search = new URLSearchParams();
+    for (const [key, value] of Object.entries(query ?? {})) {
+      if (value !== undefined) {
+        search.set(key, String(value));
+      }
+    }
+    if ([...search.keys()].length > 0) {
+      url += '?' + search.toString();
+    }
+
+    const headers: Record<string, string> = { Accept: 'application/json' };
+    if (body !== undefined) {
+      headers['Content-Type'] = 'application/json';
+    }
+    if (this.token) {
+      headers.Authorization = 'Bearer ' + this.token;
+    }
+
+    const response = await this.fetcher(url, {
+      method,
+      headers,
+      body: body === undefined ? undefined : JSON.stringify(body),
+    });
+    if (response.status >= 400) {
+      let problem: Problem = { type: 'about:blank', title: response.statusText, status: response.status, code: '' };
+      try {
+        problem = { ...problem, ...(await response.json()) };
+      } catch {
+        // the body is not a problem document
+      }
+      throw new ProblemError(problem);
+    }
+    return response;
+  }
+`
+
+// TypeScript emits the typescript client module
+func TypeScript(api *API, source string) []byte {
+	out := new(strings.Builder)
+	fmt.Fprintf(out, "// Code generated by sdkgen from %v; DO NOT EDIT.\n", source)
+
+	for _, model := range api.Models {
+		out.WriteString("\n")
+		if model.Description != "" {
+			fmt.Fprintf(out, "/** %v */\n", model.Description)
+		}
+		fmt.Fprintf(out, "export interface %v {\n", model.Name)
+		for _, field := range model.Fields {
+			optional := "?"
+			if field.Required {
+				optional = ""
+			}
+			fmt.Fprintf(out, "  %v%v: %v;\n", field.Name, optional, tsType(field.Type))
+		}
+		out.WriteString("}\n")
+	}
+
+	for _, endpoint := range api.Endpoints {
+		if endpoint.ParamsModel == "" {
+			continue
+		}
+		fmt.Fprintf(out, "\nexport interface %v {\n", endpoint.ParamsModel)
+		for _, field := range endpoint.Query {
+			optional := "?"
+			if field.Required {
+				optional = ""
+			}
+			fmt.Fprintf(out, "  %v%v: %v;\n", field.Name, optional, tsType(field.Type))
+		}
+		out.WriteString("  [key: string]: string | number | boolean | undefined;\n}\n")
+	}
+
+	out.WriteString(tsRuntime)
+	for _, endpoint := range api.Endpoints {
+		tsMethod(out, endpoint)
+		if endpoint.Paginated {
+			tsIterator(out, endpoint)
+		}
+	}
+
+	if api.Auth != nil {
+		fmt.Fprintf(out, "\n  /** authenticate calls %v and keeps the issued access token for the following calls */\n", camel(api.Auth.Name))
+		fmt.Fprintf(out, "  async authenticate(body: %v): Promise<%v> {\n", tsType(api.Auth.Body), tsType(api.Auth.Result))
+		fmt.Fprintf(out, "    const result = await this.%v(body);\n", camel(api.Auth.Name))
+		out.WriteString("    this.setToken(result.access_token);\n    return result;\n  }\n")
+	}
+	out.WriteString("}\n")
+	return []byte(out.String())
+}
+
+func tsMethod(out *strings.Builder, e *Endpoint) {
+	var args []string
+	for _, param := range e.PathParams {
+		args = append(args, camel(param)+": string")
+	}
+	query, body := "undefined", "undefined"
+	if e.ParamsModel != "" {
+		args = append(args, "params: "+e.ParamsModel+" = {}")
+		query = "params"
+	}
+	if e.Body != nil {
+		args = append(args, "body: "+tsType(e.Body))
+		body = "body"
+	}
+
+	path := "'" + e.Path + "'"
+	if len(e.PathParams) > 0 {
+		segments := strings.Split(e.Path, "/")
+		for i, segment := range segments {
+			if strings.HasPrefix(segment, ":") {
+				segments[i] = "${encodeURIComponent(" + camel(segment[1:]) + ")}"
+			}
+		}
+		path = "`" + strings.Join(segments, "/") + "`"
+	}
+
+	out.WriteString("\n")
+	out.WriteString("  /**\n")
+	if e.Summary != "" {
+		fmt.Fprintf(out, "   * %v\n", e.Summary)
+	}
+	fmt.Fprintf(out, "   * %v %v\n", e.Method, e.Path)
+	if e.Deprecated {
+		out.WriteString("   * @deprecated the route is deprecated, see the Deprecation and Sunset headers of its responses\n")
+	}
+	out.WriteString("   */\n")
+	request := fmt.Sprintf("await this.request('%v', %v, %v, %v)", e.Method, path, query, body)
+	switch {
+	case e.Raw:
+		fmt.Fprintf(out, "  async %v(%v): Promise<ArrayBuffer> {\n", camel(e.Name), strings.Join(args, ", "))
+		fmt.Fprintf(out, "    const response = %v;\n    return response.arrayBuffer();\n  }\n", request)
+	case e.Result == nil:
+		fmt.Fprintf(out, "  async %v(%v): Promise<void> {\n", camel(e.Name), strings.Join(args, ", "))
+		fmt.Fprintf(out, "    %v;\n  }\n", request)
+	default:
+		fmt.Fprintf(out, "  async %v(%v): Promise<%v> {\n", camel(e.Name), strings.Join(args, ", "), tsType(e.Result))
+		fmt.Fprintf(out, "    const response = %v;\n", request)
+		if e.Envelope {
+			fmt.Fprintf(out, "    return (await response.json()).data as %v;\n  }\n", tsType(e.Result))
+		} else {
+			fmt.Fprintf(out, "    return (await response.json()) as %v;\n  }\n", tsType(e.Result))
+		}
+	}
+}
+
+func tsIterator(out *strings.Builder, e *Endpoint) {
+	var args, call string
+	for _, param := range e.PathParams {
+		args += camel(param) + ": string, "
+		call += camel(param) + ", "
+	}
+	fmt.Fprintf(out, `
+  /** %[1]vAll iterates over every %[2]v of %[1]v, the next page is fetched once the current one is exhausted */
+  async *%[1]vAll(%[4]vparams: %[3]v = {}): AsyncGenerator<%[2]v> {
+    let page = params.page && params.page > 0 ? params.page : 1;
+    for (;;) {
+      const result = await this.%[1]v(%[5]v{ ...params, page });
+      const records = result.records ?? [];
+      yield* records;
+      if (records.length === 0 || (result.page ?? page) * (result.limit ?? records.length) >= (result.total_record ?? 0)) {
+        return;
+      }
+      page++;
+    }
+  }
+`, camel(e.Name), tsType(e.Item), e.ParamsModel, args, call)
+}
+
+func tsType(t *TypeRef) string {
+	switch t.Kind {
+	case Named:
+		return t.Name
+	case Array:
+		return tsType(t.Elem) + "[]"
+	case Primitive:
+		switch t.Name {
+		case "integer", "number":
+			return "number"
+		case "boolean":
+			return "boolean"
+		}
+		return "string"
+	}
+	return "unknown"
+}
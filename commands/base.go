@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Command runs with the arguments following its name, go run gotham <name> [arguments]
+type Command func(args []string) error
+
+var registry = map[string]Command{
+	"search:reindex":      SearchReindex,
+	"projections:rebuild": ProjectionsRebuild,
+	"compression:bench":   CompressionBench,
+}
+
+/**
+ * Run
+ * runs the command of the name instead of serving the api
+ */
+func Run(name string, args []string) error {
+	command, ok := registry[name]
+	if !ok {
+		names := make([]string, 0, len(registry))
+		for registered := range registry {
+			names = append(names, registered)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown command %q, the commands are %v", name, strings.Join(names, ", "))
+	}
+	return command(args)
+}
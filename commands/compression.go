@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	GMiddleware "gotham/middlewares"
+)
+
+/**
+ * CompressionBench
+ * benchmarks a json response of each -sizes without the compression middleware, and through it with every encoding,
+ * the responses below COMPRESSION_MIN_SIZE must cost what they cost without it
+ *
+ *	go run gotham compression:bench -sizes=200,1024,65536
+ */
+func CompressionBench(args []string) error {
+	set := flag.NewFlagSet("compression:bench", flag.ContinueOnError)
+	sizes := set.String("sizes", "200,1024,65536", "comma separated sizes of the json bodies in bytes")
+	if err := set.Parse(args); err != nil {
+		return err
+	}
+	testing.Init()
+
+	conf := config.Conf.Compression
+	fmt.Printf("min size %v, level %v\n", conf.MinSize, conf.Level)
+	for _, value := range strings.Split(*sizes, ",") {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 11 {
+			return fmt.Errorf("invalid size %q, at least 11 bytes", value)
+		}
+		body := `{"data":"` + strings.Repeat("gotham ", size/7+1)[:size-11] + `"}`
+		handler := func(c echo.Context) error {
+			return c.JSONBlob(http.StatusOK, []byte(body))
+		}
+
+		runs := []struct {
+			name     string
+			encoding string
+			handler  echo.HandlerFunc
+		}{{name: "none", handler: handler}}
+		for _, encoding := range conf.Encodings {
+			single := conf
+			single.Encodings = []string{encoding}
+			runs = append(runs, struct {
+				name     string
+				encoding string
+				handler  echo.HandlerFunc
+			}{name: encoding, encoding: encoding, handler: (&GMiddleware.Compression{Config: &single}).CompressionMiddleware(handler)})
+		}
+
+		for _, run := range runs {
+			e := echo.New()
+			written := 0
+			result := testing.Benchmark(func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					request := httptest.NewRequest(http.MethodGet, "/", nil)
+					request.Header.Set(echo.HeaderAcceptEncoding, run.encoding)
+					recorder := httptest.NewRecorder()
+					if err := run.handler(e.NewContext(request, recorder)); err != nil {
+						b.Fatal(err)
+					}
+					written = recorder.Body.Len()
+				}
+			})
+			fmt.Printf("%8d bytes %-8s %v %v written %v bytes\n", size, run.name, result.String(), result.MemString(), written)
+		}
+	}
+	return nil
+}
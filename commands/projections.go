@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"gotham/app"
+	"gotham/services"
+)
+
+/**
+ * ProjectionsRebuild
+ * projects every user to its search view again, to fill the read models on their first deployment or after the events
+ * lost while the api was down
+ *
+ *	go run gotham projections:rebuild
+ */
+func ProjectionsRebuild(args []string) error {
+	set := flag.NewFlagSet("projections:rebuild", flag.ContinueOnError)
+	if err := set.Parse(args); err != nil {
+		return err
+	}
+
+	// an interrupt stops the rebuild after the batch being projected, the views of the users gone are kept then
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	return app.Application.Container.GetUserProjectionService().Rebuild(ctx, func(progress services.ProjectionProgress) {
+		fmt.Println(progress.String())
+	})
+}
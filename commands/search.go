@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
+	"gotham/app"
+	"gotham/services"
+)
+
+/**
+ * SearchReindex
+ * indexes the records of every index, or of the comma separated -index, in batches of SEARCH_BATCH_SIZE
+ *
+ *	go run gotham search:reindex -index=comments
+ */
+func SearchReindex(args []string) error {
+	set := flag.NewFlagSet("search:reindex", flag.ContinueOnError)
+	index := set.String("index", "", "comma separated indexes, every index when empty")
+	if err := set.Parse(args); err != nil {
+		return err
+	}
+
+	search := app.Application.Container.GetSearchService()
+	indexes := search.Indexes()
+	if *index != "" {
+		indexes = strings.Split(*index, ",")
+	}
+	// an interrupt stops the reindex after the batch being indexed
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	for _, name := range indexes {
+		err := search.Reindex(ctx, name, func(progress services.SearchProgress) {
+			fmt.Println(progress.String())
+		})
+		if err != nil {
+			return fmt.Errorf("%v: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+type AdminUI struct {
+	// serves the embedded admin frontend under /admin/ui, off in api only deployments
+	Enabled bool
+	// how long browsers keep an asset of its fingerprint, the index is revalidated on every load
+	AssetMaxAge time.Duration
+}
+
+func GetAdminUIConfig() AdminUI {
+	enabled, err := strconv.ParseBool(getenv("ADMIN_UI_ENABLED"))
+	if err != nil {
+		enabled = true
+	}
+	assetMaxAge, err := time.ParseDuration(getenv("ADMIN_UI_ASSET_MAX_AGE"))
+	if err != nil || assetMaxAge < 0 {
+		assetMaxAge = 365 * 24 * time.Hour
+	}
+	return AdminUI{
+		Enabled:     enabled,
+		AssetMaxAge: assetMaxAge,
+	}
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+)
+
+type Analytics struct {
+	Enabled bool
+	Path    string
+	// default share of requests recorded, between 0 and 1
+	SampleRate float64
+	// per route overrides, keyed by "METHOD /route/:param"
+	RouteRates map[string]float64
+	// per tenant overrides, keyed by tenant id
+	TenantRates  map[string]float64
+	OptOut       []string
+	TenantHeader string
+}
+
+func GetAnalyticsConfig() Analytics {
+	enabled, _ := strconv.ParseBool(getenv("ANALYTICS_ENABLED"))
+	path := getenv("ANALYTICS_PATH")
+	if path == "" {
+		path = "./storage/analytics/requests.jsonl"
+	}
+	tenantHeader := getenv("ANALYTICS_TENANT_HEADER")
+	if tenantHeader == "" {
+		tenantHeader = "X-Tenant-ID"
+	}
+	var optOut []string
+	for _, tenant := range strings.Split(getenv("ANALYTICS_OPT_OUT_TENANTS"), ",") {
+		if tenant = strings.TrimSpace(tenant); tenant != "" {
+			optOut = append(optOut, tenant)
+		}
+	}
+	return Analytics{
+		Enabled:      enabled,
+		Path:         path,
+		SampleRate:   parseRate(getenv("ANALYTICS_SAMPLE_RATE")),
+		RouteRates:   parseRates(getenv("ANALYTICS_ROUTE_RATES")),
+		TenantRates:  parseRates(getenv("ANALYTICS_TENANT_RATES")),
+		OptOut:       optOut,
+		TenantHeader: tenantHeader,
+	}
+}
+
+func parseRate(value string) float64 {
+	rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || rate < 0 {
+		return 0
+	}
+	if rate > 1 {
+		return 1
+	}
+	return rate
+}
+
+// parseRates reads "key=0.5;other key=1"
+func parseRates(value string) map[string]float64 {
+	rates := map[string]float64{}
+	for _, item := range strings.Split(value, ";") {
+		i := strings.LastIndex(item, "=")
+		if i <= 0 {
+			continue
+		}
+		rates[strings.TrimSpace(item[:i])] = parseRate(item[i+1:])
+	}
+	return rates
+}
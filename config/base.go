@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -11,9 +12,14 @@ var Conf *Config
 
 func init() {
 	err := godotenv.Load("./.env")
-	if err != nil {
+	// go test runs in the folder of the package, without the .env the tests set what they need
+	if err != nil && !strings.HasSuffix(os.Args[0], ".test") {
 		log.Fatal("Error loading .env file")
 	}
+	// the values of the file, Variables tells them from the ones of the environment
+	if values, err := godotenv.Read("./.env"); err == nil {
+		fileValues = values
+	}
 }
 
 /**
@@ -21,12 +27,55 @@ func init() {
  *
  */
 type Config struct {
-	Port      string
-	BaseUrl   string
-	Db        Database
-	SecretKey string
-	Email     Email
-	Brand     struct {
+	Env           string
+	Port          string
+	BaseUrl       string
+	Db            Database
+	DbSupervisor  DbSupervisor
+	DbRetry       DbRetry
+	SecretKey     string `redact:"true"`
+	Email         Email
+	Http          HttpClient
+	Shadow        Shadow
+	Recorder      Recorder
+	Storage       Storage
+	Privacy       Privacy
+	Features      Features
+	Analytics     Analytics
+	Budget        Budget
+	Contract      Contract
+	Container     Container
+	Logger        Logger
+	Settings      Settings
+	Impersonation Impersonation
+	Quota         Quota
+	Cache         Cache
+	Queue         Queue
+	EmailChange   EmailChange
+	Sms           Sms
+	MagicLink     MagicLink
+	Session       Session
+	Captcha       Captcha
+	Billing       Billing
+	Media         Media
+	Push          Push
+	Search        Search
+	Compression   Compression
+	AdminUI       AdminUI
+	Signing       Signing
+	Replay        Replay
+	Debug         Debug
+	Errors        ErrorReporting
+	SlowRequest   SlowRequest
+	Slo           Slo
+	Clients       Clients
+	TLS           TLS
+	Listen        Listen
+	Shards        Shards
+	Retention     Retention
+	Saga          Saga
+	Registration  Registration
+	Brand         struct {
 		ProjectName   string
 		ProjectUrl    string
 		ProjectApiUrl string
@@ -38,16 +87,59 @@ type Config struct {
  *
  */
 func Configurations() {
-	port := os.Getenv("API_PORT")
+	port := getenv("API_PORT")
 	Conf = &Config{
-		Port:      port,
-		BaseUrl:   os.Getenv("BASE_URL") + ":" + port,
-		SecretKey: os.Getenv("JWT_SECRET_KEY"),
-		Email:     GetEmailConfig(),
+		Env:           Environment(),
+		Port:          port,
+		BaseUrl:       getenv("BASE_URL") + ":" + port,
+		SecretKey:     getsecret("JWT_SECRET_KEY"),
+		DbSupervisor:  GetDbSupervisorConfig(),
+		DbRetry:       GetDbRetryConfig(),
+		Email:         GetEmailConfig(),
+		Http:          GetHttpClientConfig(),
+		Shadow:        GetShadowConfig(),
+		Recorder:      GetRecorderConfig(),
+		Storage:       GetStorageConfig(),
+		Privacy:       GetPrivacyConfig(),
+		Features:      GetFeaturesConfig(),
+		Analytics:     GetAnalyticsConfig(),
+		Budget:        GetBudgetConfig(),
+		Contract:      GetContractConfig(),
+		Container:     GetContainerConfig(),
+		Logger:        GetLoggerConfig(),
+		Settings:      GetSettingsConfig(),
+		Impersonation: GetImpersonationConfig(),
+		Quota:         GetQuotaConfig(),
+		Cache:         GetCacheConfig(),
+		Queue:         GetQueueConfig(),
+		EmailChange:   GetEmailChangeConfig(),
+		Sms:           GetSmsConfig(),
+		MagicLink:     GetMagicLinkConfig(),
+		Session:       GetSessionConfig(),
+		Captcha:       GetCaptchaConfig(),
+		Billing:       GetBillingConfig(),
+		Media:         GetMediaConfig(),
+		Push:          GetPushConfig(),
+		Search:        GetSearchConfig(),
+		Compression:   GetCompressionConfig(),
+		AdminUI:       GetAdminUIConfig(),
+		Signing:       GetSigningConfig(),
+		Replay:        GetReplayConfig(),
+		Debug:         GetDebugConfig(),
+		Errors:        GetErrorReportingConfig(),
+		SlowRequest:   GetSlowRequestConfig(),
+		Slo:           GetSloConfig(),
+		Clients:       GetClientsConfig(),
+		TLS:           GetTLSConfig(),
+		Listen:        GetListenConfig(),
+		Shards:        GetShardsConfig(),
+		Retention:     GetRetentionConfig(),
+		Saga:          GetSagaConfig(),
+		Registration:  GetRegistrationConfig(),
 		Brand: struct {
 			ProjectName   string
 			ProjectUrl    string
 			ProjectApiUrl string
-		}{ProjectName: os.Getenv("PROJECT_NAME"), ProjectUrl: os.Getenv("PROJECT_URL"), ProjectApiUrl: os.Getenv("PROJECT_API_URL")},
+		}{ProjectName: getenv("PROJECT_NAME"), ProjectUrl: getenv("PROJECT_URL"), ProjectApiUrl: getenv("PROJECT_API_URL")},
 	}
 }
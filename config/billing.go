@@ -0,0 +1,48 @@
+package config
+
+import "time"
+
+type Billing struct {
+	// log or stripe
+	Driver string
+
+	StripeSecretKey     string `redact:"true"`
+	StripeWebhookSecret string `redact:"true"`
+	// webhooks signed longer ago than this are refused, so a captured payload can not be replayed
+	WebhookTolerance time.Duration
+
+	// the stripe price of the pro plan, set on the plan by the seeder
+	ProPriceID string
+
+	// where the checkout page sends the user back
+	SuccessURL string
+	CancelURL  string
+
+	// how long a signed invoice download link stays valid
+	InvoiceUrlTTL time.Duration
+}
+
+func GetBillingConfig() Billing {
+	driver := getenv("BILLING_DRIVER")
+	if driver == "" {
+		driver = "log"
+	}
+	tolerance, err := time.ParseDuration(getenv("STRIPE_WEBHOOK_TOLERANCE"))
+	if err != nil || tolerance <= 0 {
+		tolerance = 5 * time.Minute
+	}
+	invoiceUrlTTL, err := time.ParseDuration(getenv("INVOICE_URL_TTL"))
+	if err != nil || invoiceUrlTTL <= 0 {
+		invoiceUrlTTL = 15 * time.Minute
+	}
+	return Billing{
+		Driver:              driver,
+		StripeSecretKey:     getsecret("STRIPE_SECRET_KEY"),
+		StripeWebhookSecret: getsecret("STRIPE_WEBHOOK_SECRET"),
+		WebhookTolerance:    tolerance,
+		ProPriceID:          getenv("STRIPE_PRO_PRICE_ID"),
+		SuccessURL:          getenv("BILLING_SUCCESS_URL"),
+		CancelURL:           getenv("BILLING_CANCEL_URL"),
+		InvoiceUrlTTL:       invoiceUrlTTL,
+	}
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+)
+
+type Budget struct {
+	// budgets in bytes, 0 disables the check
+	DefaultPayload int64
+	Header         int64
+	// per route payload budgets, keyed by "METHOD /route/:param"
+	Routes map[string]int64
+	// respond with an error instead of the oversized body, meant for development and tests
+	Strict bool
+}
+
+func GetBudgetConfig() Budget {
+	strict, _ := strconv.ParseBool(getenv("RESPONSE_BUDGET_STRICT"))
+	return Budget{
+		DefaultPayload: parseSize(getenv("RESPONSE_BUDGET_DEFAULT"), 256*1024),
+		Header:         parseSize(getenv("RESPONSE_BUDGET_HEADER"), 8*1024),
+		Routes:         parseSizes(getenv("RESPONSE_BUDGET_ROUTES")),
+		Strict:         strict,
+	}
+}
+
+func parseSize(value string, fallback int64) int64 {
+	size, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || size < 0 {
+		return fallback
+	}
+	return size
+}
+
+// parseSizes reads "GET /v1/restricted/users=131072;GET /v1/restricted/users/:user=4096"
+func parseSizes(value string) map[string]int64 {
+	sizes := map[string]int64{}
+	for _, item := range strings.Split(value, ";") {
+		i := strings.LastIndex(item, "=")
+		if i <= 0 {
+			continue
+		}
+		sizes[strings.TrimSpace(item[:i])] = parseSize(item[i+1:], 0)
+	}
+	return sizes
+}
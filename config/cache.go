@@ -0,0 +1,60 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Cache struct {
+	// memory or redis
+	Driver string
+
+	RedisAddr     string
+	RedisPassword string `redact:"true"`
+	RedisDB       int
+
+	// response cache
+	ResponseTTL time.Duration
+	// per route ttls, keyed by "METHOD /route/:param"
+	ResponseRoutes map[string]time.Duration
+}
+
+func GetCacheConfig() Cache {
+	driver := getenv("CACHE_DRIVER")
+	if driver == "" {
+		driver = "memory"
+	}
+	addr := getenv("REDIS_ADDR")
+	if addr == "" {
+		addr = "127.0.0.1:6379"
+	}
+	db, _ := strconv.Atoi(getenv("REDIS_DB"))
+	ttl, err := time.ParseDuration(getenv("RESPONSE_CACHE_TTL"))
+	if err != nil || ttl < 0 {
+		ttl = 30 * time.Second
+	}
+	return Cache{
+		Driver:         driver,
+		RedisAddr:      addr,
+		RedisPassword:  getsecret("REDIS_PASSWORD"),
+		RedisDB:        db,
+		ResponseTTL:    ttl,
+		ResponseRoutes: parseDurations(getenv("RESPONSE_CACHE_ROUTES")),
+	}
+}
+
+// parseDurations reads "GET /v1/restricted/users=1m;GET /v1/restricted/settings=5m"
+func parseDurations(value string) map[string]time.Duration {
+	durations := map[string]time.Duration{}
+	for _, item := range strings.Split(value, ";") {
+		index := strings.LastIndex(item, "=")
+		if index <= 0 {
+			continue
+		}
+		if duration, err := time.ParseDuration(strings.TrimSpace(item[index+1:])); err == nil && duration >= 0 {
+			durations[strings.TrimSpace(item[:index])] = duration
+		}
+	}
+	return durations
+}
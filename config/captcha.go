@@ -0,0 +1,27 @@
+package config
+
+import "time"
+
+type Captcha struct {
+	// none, hcaptcha or recaptcha
+	Driver string
+	Secret string `redact:"true"`
+	// suspicious activity (failed logins, registrations) is counted per ip and email over this window
+	Window time.Duration
+}
+
+func GetCaptchaConfig() Captcha {
+	driver := getenv("CAPTCHA_DRIVER")
+	if driver == "" {
+		driver = "none"
+	}
+	window, err := time.ParseDuration(getenv("CAPTCHA_WINDOW"))
+	if err != nil || window <= 0 {
+		window = time.Hour
+	}
+	return Captcha{
+		Driver: driver,
+		Secret: getsecret("CAPTCHA_SECRET"),
+		Window: window,
+	}
+}
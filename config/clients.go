@@ -0,0 +1,27 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+// Clients are the internal services authenticating with the client credentials grant
+type Clients struct {
+	TokenTTL time.Duration
+	// requests a client can make within a window unless it has its own limit, 0 is unlimited. The clients are counted
+	// apart from the quotas of the users
+	RateLimit  int
+	RateWindow time.Duration
+}
+
+func GetClientsConfig() Clients {
+	limit, err := strconv.Atoi(getenv("CLIENT_RATE_LIMIT"))
+	if err != nil || limit < 0 {
+		limit = 6000
+	}
+	return Clients{
+		TokenTTL:   parseDurationOr(getenv("CLIENT_TOKEN_TTL"), time.Hour),
+		RateLimit:  limit,
+		RateWindow: parseDurationOr(getenv("CLIENT_RATE_WINDOW"), time.Minute),
+	}
+}
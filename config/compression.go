@@ -0,0 +1,45 @@
+package config
+
+import (
+	"compress/flate"
+	"strconv"
+	"strings"
+)
+
+type Compression struct {
+	// content codings in the order of preference on equal quality, gzip or deflate, none disables the compression
+	Encodings []string
+	// flate level from 1 (fastest) to 9 (smallest), -1 is the default of compress/flate
+	Level int
+	// smaller bodies are written as they are, compressing them costs more than it saves
+	MinSize int
+	// prefixes of the content types compressed, images and archives are compressed already
+	Types []string
+}
+
+func GetCompressionConfig() Compression {
+	encodings := []string{"gzip", "deflate"}
+	if value := getenv("COMPRESSION_ENCODINGS"); value == "none" {
+		encodings = nil
+	} else if value != "" {
+		encodings = strings.Split(value, ",")
+	}
+	level, err := strconv.Atoi(getenv("COMPRESSION_LEVEL"))
+	if err != nil || level < flate.HuffmanOnly || level > flate.BestCompression {
+		level = flate.DefaultCompression
+	}
+	minSize, err := strconv.Atoi(getenv("COMPRESSION_MIN_SIZE"))
+	if err != nil || minSize < 0 {
+		minSize = 1024
+	}
+	types := []string{"application/json", "application/problem+json", "application/xml", "application/msgpack", "text/"}
+	if value := getenv("COMPRESSION_TYPES"); value != "" {
+		types = strings.Split(value, ",")
+	}
+	return Compression{
+		Encodings: encodings,
+		Level:     level,
+		MinSize:   minSize,
+		Types:     types,
+	}
+}
@@ -0,0 +1,17 @@
+package config
+
+import "time"
+
+type Container struct {
+	SlowBuildThreshold time.Duration
+}
+
+func GetContainerConfig() Container {
+	threshold, err := time.ParseDuration(getenv("CONTAINER_SLOW_BUILD_THRESHOLD"))
+	if err != nil || threshold < 0 {
+		threshold = 100 * time.Millisecond
+	}
+	return Container{
+		SlowBuildThreshold: threshold,
+	}
+}
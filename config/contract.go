@@ -0,0 +1,19 @@
+package config
+
+import "strconv"
+
+type Contract struct {
+	// validate the requests and the responses against the swagger spec, meant for development and staging
+	Enabled bool
+	// respond with an error instead of only logging the violations
+	Strict bool
+}
+
+func GetContractConfig() Contract {
+	enabled, _ := strconv.ParseBool(getenv("CONTRACT_VALIDATION"))
+	strict, _ := strconv.ParseBool(getenv("CONTRACT_STRICT"))
+	return Contract{
+		Enabled: enabled,
+		Strict:  strict,
+	}
+}
@@ -1,6 +1,9 @@
 package config
 
-import "os"
+import (
+	"strconv"
+	"time"
+)
 
 type Database struct {
 	DbConnection string
@@ -8,16 +11,32 @@ type Database struct {
 	DbHost       string
 	DbPort       string
 	DbUserName   string
-	DbPassword   string
+	DbPassword   string `redact:"true"`
+	DbSslMode    string
+
+	// connection pool, zero keeps the database/sql default
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
 }
 
 func GetDbConfig() Database {
+	maxOpen, _ := strconv.Atoi(getenv("DB_MAX_OPEN_CONNS"))
+	maxIdle, _ := strconv.Atoi(getenv("DB_MAX_IDLE_CONNS"))
+	lifetime, _ := time.ParseDuration(getenv("DB_CONN_MAX_LIFETIME"))
+	idleTime, _ := time.ParseDuration(getenv("DB_CONN_MAX_IDLE_TIME"))
 	return Database{
-		DbConnection: os.Getenv("DB_CONNECTION"),
-		DbDatabase:   os.Getenv("DB_DATABASE"),
-		DbHost:       os.Getenv("DB_HOST"),
-		DbPort:       os.Getenv("DB_PORT"),
-		DbUserName:   os.Getenv("DB_USERNAME"),
-		DbPassword:   os.Getenv("DB_PASSWORD"),
+		DbConnection:    getenv("DB_CONNECTION"),
+		DbDatabase:      getenv("DB_DATABASE"),
+		DbHost:          getenv("DB_HOST"),
+		DbPort:          getenv("DB_PORT"),
+		DbUserName:      getenv("DB_USERNAME"),
+		DbPassword:      getsecret("DB_PASSWORD"),
+		DbSslMode:       getenv("DB_SSL_MODE"),
+		MaxOpenConns:    maxOpen,
+		MaxIdleConns:    maxIdle,
+		ConnMaxLifetime: lifetime,
+		ConnMaxIdleTime: idleTime,
 	}
 }
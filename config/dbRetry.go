@@ -0,0 +1,33 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+type DbRetry struct {
+	// Retries are the attempts after the first one, 0 disables the retries
+	Retries    int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+func GetDbRetryConfig() DbRetry {
+	retries, err := strconv.Atoi(getenv("DB_RETRIES"))
+	if err != nil || retries < 0 {
+		retries = 3
+	}
+	backoff, err := time.ParseDuration(getenv("DB_RETRY_BACKOFF"))
+	if err != nil || backoff <= 0 {
+		backoff = 20 * time.Millisecond
+	}
+	maxBackoff, err := time.ParseDuration(getenv("DB_RETRY_MAX_BACKOFF"))
+	if err != nil || maxBackoff < backoff {
+		maxBackoff = time.Second
+	}
+	return DbRetry{
+		Retries:    retries,
+		Backoff:    backoff,
+		MaxBackoff: maxBackoff,
+	}
+}
@@ -0,0 +1,29 @@
+package config
+
+import "time"
+
+type DbSupervisor struct {
+	Interval       time.Duration
+	PingTimeout    time.Duration
+	ReconnectAfter time.Duration
+}
+
+func GetDbSupervisorConfig() DbSupervisor {
+	interval, err := time.ParseDuration(getenv("DB_SUPERVISOR_INTERVAL"))
+	if err != nil || interval <= 0 {
+		interval = 15 * time.Second
+	}
+	timeout, err := time.ParseDuration(getenv("DB_SUPERVISOR_PING_TIMEOUT"))
+	if err != nil || timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+	reconnectAfter, err := time.ParseDuration(getenv("DB_SUPERVISOR_RECONNECT_AFTER"))
+	if err != nil || reconnectAfter < 0 {
+		reconnectAfter = time.Minute
+	}
+	return DbSupervisor{
+		Interval:       interval,
+		PingTimeout:    timeout,
+		ReconnectAfter: reconnectAfter,
+	}
+}
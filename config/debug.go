@@ -0,0 +1,18 @@
+package config
+
+import "strconv"
+
+type Debug struct {
+	// serves /debug/pprof, /debug/vars and /debug/stats to the admins, off unless a deployment is being diagnosed
+	Enabled bool
+}
+
+func GetDebugConfig() Debug {
+	enabled, err := strconv.ParseBool(getenv("DEBUG_ENDPOINTS_ENABLED"))
+	if err != nil {
+		enabled = false
+	}
+	return Debug{
+		Enabled: enabled,
+	}
+}
@@ -1,19 +1,17 @@
 package config
 
-import "os"
-
 type Email struct {
 	From     string
 	Host     string
 	Port     string
-	Password string
+	Password string `redact:"true"`
 }
 
 func GetEmailConfig() Email {
 	return Email{
-		From:     os.Getenv("FROM"),
-		Host:     os.Getenv("HOST"),
-		Port:     os.Getenv("PORT"),
-		Password: os.Getenv("PASSWORD"),
+		From:     getenv("FROM"),
+		Host:     getenv("HOST"),
+		Port:     getenv("PORT"),
+		Password: getsecret("PASSWORD"),
 	}
 }
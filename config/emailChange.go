@@ -0,0 +1,19 @@
+package config
+
+import "time"
+
+type EmailChange struct {
+	TTL        time.Duration
+	ConfirmUrl string
+}
+
+func GetEmailChangeConfig() EmailChange {
+	ttl, err := time.ParseDuration(getenv("EMAIL_CHANGE_TTL"))
+	if err != nil || ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+	return EmailChange{
+		TTL:        ttl,
+		ConfirmUrl: getenv("EMAIL_CHANGE_CONFIRM_URL"),
+	}
+}
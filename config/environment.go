@@ -0,0 +1,12 @@
+package config
+
+/**
+ * Environment
+ * APP_ENV selects the definition set of the provider, it is read directly because the provider is loaded before Configurations
+ */
+func Environment() string {
+	if env := getenv("APP_ENV"); env != "" {
+		return env
+	}
+	return "prod"
+}
@@ -0,0 +1,47 @@
+package config
+
+import "strconv"
+
+type ErrorReporting struct {
+	// log, sentry, rollbar or none. sentry and rollbar report nothing until their dsn or token is set
+	Driver string
+	// https://<public key>@<host>/<project id>
+	SentryDSN string `redact:"true"`
+	// a post_server_item token of the rollbar project
+	RollbarToken string `redact:"true"`
+	// the reports are tagged with them, the release is the VERSION of /status/version
+	Environment string
+	Release     string
+	// share of the reports sent by sentry and rollbar, between 0 and 1
+	SampleRate float64
+	// the last log entries sent with a report
+	Breadcrumbs int
+	// writes the panic and its stack trace in the 500 response, never in production
+	ExposeStack bool
+}
+
+func GetErrorReportingConfig() ErrorReporting {
+	driver := getenv("ERROR_REPORTER_DRIVER")
+	if driver == "" {
+		driver = "log"
+	}
+	sampleRate := 1.0
+	if value := getenv("ERROR_SAMPLE_RATE"); value != "" {
+		sampleRate = parseRate(value)
+	}
+	breadcrumbs, err := strconv.Atoi(getenv("ERROR_BREADCRUMBS"))
+	if err != nil || breadcrumbs < 0 {
+		breadcrumbs = 30
+	}
+	exposeStack, _ := strconv.ParseBool(getenv("ERROR_EXPOSE_STACK"))
+	return ErrorReporting{
+		Driver:       driver,
+		SentryDSN:    getsecret("SENTRY_DSN"),
+		RollbarToken: getsecret("ROLLBAR_ACCESS_TOKEN"),
+		Environment:  Environment(),
+		Release:      getenv("VERSION"),
+		SampleRate:   sampleRate,
+		Breadcrumbs:  breadcrumbs,
+		ExposeStack:  exposeStack,
+	}
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+)
+
+type Features struct {
+	// every known flag and its global state
+	Flags map[string]bool
+	// allow admins to override flags per request with the X-Feature-Overrides header
+	OverridesEnabled bool
+}
+
+// GetFeaturesConfig reads FEATURES=flag,other-flag:off (flags without a state are on)
+func GetFeaturesConfig() Features {
+	flags := map[string]bool{}
+	for _, item := range strings.Split(getenv("FEATURES"), ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, state := item, "on"
+		if i := strings.Index(item, ":"); i > 0 {
+			name, state = item[:i], item[i+1:]
+		}
+		flags[name] = state != "off" && state != "false"
+	}
+	overrides, _ := strconv.ParseBool(getenv("FEATURE_OVERRIDES_ENABLED"))
+	return Features{
+		Flags:            flags,
+		OverridesEnabled: overrides,
+	}
+}
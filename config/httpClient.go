@@ -0,0 +1,70 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+type HttpClient struct {
+	// the longest an attempt of a request waits for its response, body included
+	Timeout time.Duration
+	// per host timeouts overriding Timeout, like HTTP_CLIENT_HOST_TIMEOUTS=api.stripe.com:30s,fcm.googleapis.com:5s
+	HostTimeouts map[string]time.Duration
+
+	// attempts after the first of the idempotent requests failing on the network or with 429, 502, 503 or 504
+	Retries int
+	// the first retry waits up to RetryBackoff, then twice as long each time up to RetryMaxBackoff, with full jitter
+	RetryBackoff    time.Duration
+	RetryMaxBackoff time.Duration
+
+	// consecutive failures of a host opening its circuit, the requests to it fail at once until BreakerCooldown passed
+	BreakerFailures int
+	BreakerCooldown time.Duration
+}
+
+func GetHttpClientConfig() HttpClient {
+	timeout, err := time.ParseDuration(getenv("HTTP_CLIENT_TIMEOUT"))
+	if err != nil || timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	hostTimeouts := map[string]time.Duration{}
+	for _, item := range strings.Split(getenv("HTTP_CLIENT_HOST_TIMEOUTS"), ",") {
+		i := strings.LastIndex(item, ":")
+		if i <= 0 {
+			continue
+		}
+		if hostTimeout, err := time.ParseDuration(item[i+1:]); err == nil && hostTimeout > 0 {
+			hostTimeouts[strings.TrimSpace(item[:i])] = hostTimeout
+		}
+	}
+	retries, err := strconv.Atoi(getenv("HTTP_CLIENT_RETRIES"))
+	if err != nil || retries < 0 {
+		retries = 2
+	}
+	retryBackoff, err := time.ParseDuration(getenv("HTTP_CLIENT_RETRY_BACKOFF"))
+	if err != nil || retryBackoff <= 0 {
+		retryBackoff = 100 * time.Millisecond
+	}
+	retryMaxBackoff, err := time.ParseDuration(getenv("HTTP_CLIENT_RETRY_MAX_BACKOFF"))
+	if err != nil || retryMaxBackoff < retryBackoff {
+		retryMaxBackoff = 2 * time.Second
+	}
+	breakerFailures, err := strconv.Atoi(getenv("HTTP_CLIENT_BREAKER_FAILURES"))
+	if err != nil || breakerFailures < 0 {
+		breakerFailures = 5
+	}
+	breakerCooldown, err := time.ParseDuration(getenv("HTTP_CLIENT_BREAKER_COOLDOWN"))
+	if err != nil || breakerCooldown <= 0 {
+		breakerCooldown = 30 * time.Second
+	}
+	return HttpClient{
+		Timeout:         timeout,
+		HostTimeouts:    hostTimeouts,
+		Retries:         retries,
+		RetryBackoff:    retryBackoff,
+		RetryMaxBackoff: retryMaxBackoff,
+		BreakerFailures: breakerFailures,
+		BreakerCooldown: breakerCooldown,
+	}
+}
@@ -0,0 +1,17 @@
+package config
+
+import "time"
+
+type Impersonation struct {
+	TTL time.Duration
+}
+
+func GetImpersonationConfig() Impersonation {
+	ttl, err := time.ParseDuration(getenv("IMPERSONATION_TTL"))
+	if err != nil || ttl <= 0 {
+		ttl = 15 * time.Minute
+	}
+	return Impersonation{
+		TTL: ttl,
+	}
+}
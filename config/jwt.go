@@ -6,5 +6,13 @@ import (
 
 type JwtCustomClaims struct {
 	AuthID uint `json:"auth_id"`
+	// ImpersonatorID is the admin acting as the auth user, zero for a regular token
+	ImpersonatorID uint `json:"impersonator_id,omitempty"`
+	// AuthAt is the unix time the password was confirmed, zero for tokens that did not confirm it (refresh, magic link)
+	AuthAt int64 `json:"auth_at,omitempty"`
+	// Scopes limit the routes of the token, an api key carries the ones it was given
+	Scopes []string `json:"scopes,omitempty"`
+	// ClientID is the internal service of a client credentials token, such a token has no auth user
+	ClientID uint `json:"client_id,omitempty"`
 	jwt.StandardClaims
 }
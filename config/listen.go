@@ -0,0 +1,47 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// ListenTCP listens on the API_PORT
+	ListenTCP = "tcp"
+	// ListenUnix listens on the unix socket of ListenSocket
+	ListenUnix = "unix"
+	// ListenSystemd inherits the sockets of systemd socket activation, LISTEN_FDS
+	ListenSystemd = "systemd"
+)
+
+type Listen struct {
+	// Network is tcp, unix or systemd, see the Listen constants
+	Network string
+	// Socket is the path of the unix socket and SocketMode its permissions, the proxy in front must be able to write it
+	Socket     string
+	SocketMode os.FileMode
+	// RestartTimeout is how long a graceful restart waits for the new process to be ready before keeping the old one
+	RestartTimeout time.Duration
+}
+
+func GetListenConfig() Listen {
+	network := getenv("LISTEN_NETWORK")
+	if network != ListenUnix && network != ListenSystemd {
+		network = ListenTCP
+	}
+	socket := getenv("LISTEN_SOCKET")
+	if socket == "" {
+		socket = "./storage/gotham.sock"
+	}
+	mode, err := strconv.ParseUint(getenv("LISTEN_SOCKET_MODE"), 8, 32)
+	if err != nil {
+		mode = 0660
+	}
+	return Listen{
+		Network:        network,
+		Socket:         socket,
+		SocketMode:     os.FileMode(mode),
+		RestartTimeout: parseDurationOr(getenv("RESTART_TIMEOUT"), time.Minute),
+	}
+}
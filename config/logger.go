@@ -0,0 +1,62 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Logger struct {
+	Level string
+	// levels of the components of the entries, like gorm=warn,http=info
+	Modules map[string]string
+
+	// queries
+	SlowQueryThreshold time.Duration
+	LogQueries         bool
+	RedactQueries      bool
+
+	// n+1 detection, on by default outside prod
+	DetectNPlusOne    bool
+	NPlusOneThreshold int
+	NPlusOneStrict    bool
+}
+
+func GetLoggerConfig() Logger {
+	level := getenv("LOG_LEVEL")
+	if level == "" {
+		level = "info"
+	}
+	modules := map[string]string{}
+	for _, item := range strings.Split(getenv("LOG_MODULE_LEVELS"), ",") {
+		if i := strings.Index(item, "="); i > 0 {
+			modules[strings.TrimSpace(item[:i])] = strings.TrimSpace(item[i+1:])
+		}
+	}
+	threshold, err := time.ParseDuration(getenv("DB_SLOW_QUERY_THRESHOLD"))
+	if err != nil || threshold < 0 {
+		threshold = 200 * time.Millisecond
+	}
+	nPlusOneThreshold, err := strconv.Atoi(getenv("DB_N_PLUS_ONE_THRESHOLD"))
+	if err != nil || nPlusOneThreshold < 2 {
+		nPlusOneThreshold = 5
+	}
+	detect := Environment() != "prod"
+	if value := getenv("DB_DETECT_N_PLUS_ONE"); value != "" {
+		detect = value == "true"
+	}
+	strict := Environment() == "test"
+	if value := getenv("DB_N_PLUS_ONE_STRICT"); value != "" {
+		strict = value == "true"
+	}
+	return Logger{
+		Level:              level,
+		Modules:            modules,
+		SlowQueryThreshold: threshold,
+		LogQueries:         getenv("DB_LOG_QUERIES") == "true",
+		RedactQueries:      getenv("DB_REDACT_QUERIES") != "false",
+		DetectNPlusOne:     detect,
+		NPlusOneThreshold:  nPlusOneThreshold,
+		NPlusOneStrict:     strict,
+	}
+}
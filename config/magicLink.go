@@ -0,0 +1,36 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+type MagicLink struct {
+	TTL time.Duration
+	// the callback the mailed link points to, the token is appended as a query parameter
+	Url string
+	// links a single email can ask for within a window
+	MaxPerWindow int
+	Window       time.Duration
+}
+
+func GetMagicLinkConfig() MagicLink {
+	ttl, err := time.ParseDuration(getenv("MAGIC_LINK_TTL"))
+	if err != nil || ttl <= 0 {
+		ttl = 15 * time.Minute
+	}
+	max, err := strconv.Atoi(getenv("MAGIC_LINK_MAX_PER_WINDOW"))
+	if err != nil || max <= 0 {
+		max = 3
+	}
+	window, err := time.ParseDuration(getenv("MAGIC_LINK_WINDOW"))
+	if err != nil || window <= 0 {
+		window = time.Hour
+	}
+	return MagicLink{
+		TTL:          ttl,
+		Url:          getenv("MAGIC_LINK_URL"),
+		MaxPerWindow: max,
+		Window:       window,
+	}
+}
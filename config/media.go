@@ -0,0 +1,43 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Media struct {
+	// the largest upload in bytes
+	MaxSize int64
+	// mime types accepted, detected from the content and not from the client
+	AllowedTypes []string
+	// unattached uploads older than this are deleted by the cleanup job
+	OrphanTTL time.Duration
+	// how long clients and, for public media, shared caches keep a download
+	CacheMaxAge time.Duration
+}
+
+func GetMediaConfig() Media {
+	maxSize, err := strconv.ParseInt(getenv("MEDIA_MAX_SIZE"), 10, 64)
+	if err != nil || maxSize <= 0 {
+		maxSize = 10 << 20
+	}
+	allowedTypes := []string{"image/jpeg", "image/png", "image/gif", "image/webp", "application/pdf"}
+	if types := getenv("MEDIA_ALLOWED_TYPES"); types != "" {
+		allowedTypes = strings.Split(types, ",")
+	}
+	orphanTTL, err := time.ParseDuration(getenv("MEDIA_ORPHAN_TTL"))
+	if err != nil || orphanTTL <= 0 {
+		orphanTTL = 24 * time.Hour
+	}
+	cacheMaxAge, err := time.ParseDuration(getenv("MEDIA_CACHE_MAX_AGE"))
+	if err != nil || cacheMaxAge < 0 {
+		cacheMaxAge = time.Hour
+	}
+	return Media{
+		MaxSize:      maxSize,
+		AllowedTypes: allowedTypes,
+		OrphanTTL:    orphanTTL,
+		CacheMaxAge:  cacheMaxAge,
+	}
+}
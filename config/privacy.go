@@ -0,0 +1,23 @@
+package config
+
+import "time"
+
+type Privacy struct {
+	DeletionGracePeriod time.Duration
+	ExportTTL           time.Duration
+}
+
+func GetPrivacyConfig() Privacy {
+	grace, err := time.ParseDuration(getenv("PRIVACY_DELETION_GRACE_PERIOD"))
+	if err != nil || grace < 0 {
+		grace = 30 * 24 * time.Hour
+	}
+	ttl, err := time.ParseDuration(getenv("PRIVACY_EXPORT_TTL"))
+	if err != nil || ttl <= 0 {
+		ttl = 7 * 24 * time.Hour
+	}
+	return Privacy{
+		DeletionGracePeriod: grace,
+		ExportTTL:           ttl,
+	}
+}
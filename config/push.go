@@ -0,0 +1,20 @@
+package config
+
+type Push struct {
+	// log or fcm
+	Driver string
+
+	// server key of the firebase cloud messaging legacy http api, it reaches android, ios and web devices
+	FcmServerKey string `redact:"true"`
+}
+
+func GetPushConfig() Push {
+	driver := getenv("PUSH_DRIVER")
+	if driver == "" {
+		driver = "log"
+	}
+	return Push{
+		Driver:       driver,
+		FcmServerKey: getsecret("FCM_SERVER_KEY"),
+	}
+}
@@ -0,0 +1,32 @@
+package config
+
+import "strconv"
+
+type Queue struct {
+	// memory or redis, the redis queue uses the redis server of the cache
+	Driver string
+	// Buffer is the jobs a memory queue holds before a push fails
+	Buffer int
+	// MaxAttempts is how many times a job is run before it is dropped
+	MaxAttempts int
+}
+
+func GetQueueConfig() Queue {
+	driver := getenv("QUEUE_DRIVER")
+	if driver == "" {
+		driver = "memory"
+	}
+	buffer, err := strconv.Atoi(getenv("QUEUE_BUFFER"))
+	if err != nil || buffer <= 0 {
+		buffer = 1000
+	}
+	attempts, err := strconv.Atoi(getenv("QUEUE_MAX_ATTEMPTS"))
+	if err != nil || attempts <= 0 {
+		attempts = 3
+	}
+	return Queue{
+		Driver:      driver,
+		Buffer:      buffer,
+		MaxAttempts: attempts,
+	}
+}
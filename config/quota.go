@@ -0,0 +1,24 @@
+package config
+
+import "strconv"
+
+type Quota struct {
+	// defaults of the users without their own quota, 0 is unlimited
+	Daily   int
+	Monthly int
+}
+
+func GetQuotaConfig() Quota {
+	daily, _ := strconv.Atoi(getenv("QUOTA_DAILY"))
+	monthly, _ := strconv.Atoi(getenv("QUOTA_MONTHLY"))
+	if daily < 0 {
+		daily = 0
+	}
+	if monthly < 0 {
+		monthly = 0
+	}
+	return Quota{
+		Daily:   daily,
+		Monthly: monthly,
+	}
+}
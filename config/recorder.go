@@ -0,0 +1,27 @@
+package config
+
+import "strconv"
+
+type Recorder struct {
+	Enabled bool
+	Path    string
+	// a request with a larger body is not recorded, a truncated body can not be replayed
+	MaxBodySize int64
+}
+
+func GetRecorderConfig() Recorder {
+	enabled, _ := strconv.ParseBool(getenv("RECORDER_ENABLED"))
+	path := getenv("RECORDER_PATH")
+	if path == "" {
+		path = "./storage/corpus.jsonl"
+	}
+	maxBodySize, err := strconv.ParseInt(getenv("RECORDER_MAX_BODY_SIZE"), 10, 64)
+	if err != nil || maxBodySize <= 0 {
+		maxBodySize = 64 << 10
+	}
+	return Recorder{
+		Enabled:     enabled,
+		Path:        path,
+		MaxBodySize: maxBodySize,
+	}
+}
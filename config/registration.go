@@ -0,0 +1,12 @@
+package config
+
+type Registration struct {
+	// VerifyUrl is the link of the verification mail, the token is added to its query
+	VerifyUrl string
+}
+
+func GetRegistrationConfig() Registration {
+	return Registration{
+		VerifyUrl: getenv("REGISTRATION_VERIFY_URL"),
+	}
+}
@@ -0,0 +1,21 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+type Replay struct {
+	// Enabled asks the machine requests for a nonce and a timestamp, each nonce is accepted once
+	Enabled bool
+	// Tolerance is how far the timestamp of a request may be from now, in both directions
+	Tolerance time.Duration
+}
+
+func GetReplayConfig() Replay {
+	enabled, _ := strconv.ParseBool(getenv("REPLAY_PROTECTION_ENABLED"))
+	return Replay{
+		Enabled:   enabled,
+		Tolerance: parseDurationOr(getenv("REPLAY_TOLERANCE"), 5*time.Minute),
+	}
+}
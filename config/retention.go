@@ -0,0 +1,31 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+type Retention struct {
+	// BatchSize is the number of rows archived and deleted at once
+	BatchSize int
+	// ArchivePath is the directory of the archives in the storage
+	ArchivePath string
+	// Keep overrides the retention of the policies by their names, like RETENTION_KEEP=sessions=720h;api_usages=9600h
+	Keep map[string]time.Duration
+}
+
+func GetRetentionConfig() Retention {
+	batchSize, err := strconv.Atoi(getenv("RETENTION_BATCH_SIZE"))
+	if err != nil || batchSize <= 0 {
+		batchSize = 1000
+	}
+	archivePath := getenv("RETENTION_ARCHIVE_PATH")
+	if archivePath == "" {
+		archivePath = "archives"
+	}
+	return Retention{
+		BatchSize:   batchSize,
+		ArchivePath: archivePath,
+		Keep:        parseDurations(getenv("RETENTION_KEEP")),
+	}
+}
@@ -0,0 +1,24 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+type Saga struct {
+	// StallAfter is how long a running saga goes without progress before it is taken as stopped by a crash and resumed
+	StallAfter time.Duration
+	// MaxAttempts is the number of resumes after which a saga is marked failed
+	MaxAttempts int
+}
+
+func GetSagaConfig() Saga {
+	maxAttempts, err := strconv.Atoi(getenv("SAGA_MAX_ATTEMPTS"))
+	if err != nil || maxAttempts <= 0 {
+		maxAttempts = 5
+	}
+	return Saga{
+		StallAfter:  parseDurationOr(getenv("SAGA_STALL_AFTER"), 5*time.Minute),
+		MaxAttempts: maxAttempts,
+	}
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+type Search struct {
+	// log or meilisearch
+	Driver string
+	Url    string
+	ApiKey string `redact:"true"`
+
+	// goroutines indexing the changed records
+	Workers int
+	// records indexed per request to the engine, by the workers and the reindex command
+	BatchSize int
+	// the longest a change waits in a worker before its batch is indexed
+	FlushInterval time.Duration
+}
+
+func GetSearchConfig() Search {
+	driver := getenv("SEARCH_DRIVER")
+	if driver == "" {
+		driver = "log"
+	}
+	workers, err := strconv.Atoi(getenv("SEARCH_WORKERS"))
+	if err != nil || workers <= 0 {
+		workers = 2
+	}
+	batchSize, err := strconv.Atoi(getenv("SEARCH_BATCH_SIZE"))
+	if err != nil || batchSize <= 0 {
+		batchSize = 500
+	}
+	flushInterval, err := time.ParseDuration(getenv("SEARCH_FLUSH_INTERVAL"))
+	if err != nil || flushInterval <= 0 {
+		flushInterval = time.Second
+	}
+	return Search{
+		Driver:        driver,
+		Url:           getenv("SEARCH_URL"),
+		ApiKey:        getsecret("SEARCH_API_KEY"),
+		Workers:       workers,
+		BatchSize:     batchSize,
+		FlushInterval: flushInterval,
+	}
+}
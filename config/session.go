@@ -0,0 +1,41 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+type Session struct {
+	AccessTTL time.Duration
+	// remember me refresh tokens expire after RefreshTTL without use, and after MaxLifetime whatever the use
+	RefreshTTL  time.Duration
+	MaxLifetime time.Duration
+	// how long a password confirmation allows sensitive operations
+	SudoTTL time.Duration
+	// a user confirming a wrong password SudoMaxAttempts times can not confirm it again for SudoLockout
+	SudoMaxAttempts int
+	SudoLockout     time.Duration
+}
+
+func GetSessionConfig() Session {
+	session := Session{
+		AccessTTL:   parseDurationOr(getenv("SESSION_ACCESS_TTL"), 720*time.Hour),
+		RefreshTTL:  parseDurationOr(getenv("SESSION_REFRESH_TTL"), 14*24*time.Hour),
+		MaxLifetime: parseDurationOr(getenv("SESSION_MAX_LIFETIME"), 90*24*time.Hour),
+		SudoTTL:     parseDurationOr(getenv("SUDO_TTL"), 10*time.Minute),
+		SudoLockout: parseDurationOr(getenv("SUDO_LOCKOUT"), 15*time.Minute),
+	}
+	session.SudoMaxAttempts, _ = strconv.Atoi(getenv("SUDO_MAX_ATTEMPTS"))
+	if session.SudoMaxAttempts <= 0 {
+		session.SudoMaxAttempts = 5
+	}
+	return session
+}
+
+func parseDurationOr(value string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return fallback
+	}
+	return d
+}
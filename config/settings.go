@@ -0,0 +1,17 @@
+package config
+
+import "time"
+
+type Settings struct {
+	CacheTTL time.Duration
+}
+
+func GetSettingsConfig() Settings {
+	ttl, err := time.ParseDuration(getenv("SETTINGS_CACHE_TTL"))
+	if err != nil || ttl < 0 {
+		ttl = time.Minute
+	}
+	return Settings{
+		CacheTTL: ttl,
+	}
+}
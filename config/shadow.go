@@ -0,0 +1,38 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+)
+
+type Shadow struct {
+	Enabled      bool
+	Url          string
+	SampleRate   float64
+	ScrubHeaders []string
+}
+
+func GetShadowConfig() Shadow {
+	enabled, _ := strconv.ParseBool(getenv("SHADOW_ENABLED"))
+	sampleRate, err := strconv.ParseFloat(getenv("SHADOW_SAMPLE_RATE"), 64)
+	if err != nil || sampleRate < 0 {
+		sampleRate = 0
+	}
+	if sampleRate > 1 {
+		sampleRate = 1
+	}
+
+	scrubHeaders := []string{"Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+	for _, header := range strings.Split(getenv("SHADOW_SCRUB_HEADERS"), ",") {
+		if header = strings.TrimSpace(header); header != "" {
+			scrubHeaders = append(scrubHeaders, header)
+		}
+	}
+
+	return Shadow{
+		Enabled:      enabled,
+		Url:          strings.TrimRight(getenv("SHADOW_URL"), "/"),
+		SampleRate:   sampleRate,
+		ScrubHeaders: scrubHeaders,
+	}
+}
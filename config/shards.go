@@ -0,0 +1,33 @@
+package config
+
+import "strings"
+
+// the keys the records are spread among the shards by
+const (
+	ShardByUser   = "user"
+	ShardByTenant = "tenant"
+)
+
+type Shards struct {
+	// Databases are the shards after the main database, host:port/database on its server with its credentials
+	Databases []string
+	// By is the key of the shard resolver, user or tenant
+	By string
+}
+
+func GetShardsConfig() Shards {
+	var databases []string
+	for _, database := range strings.Split(getenv("DB_SHARDS"), ",") {
+		if database = strings.TrimSpace(database); database != "" {
+			databases = append(databases, database)
+		}
+	}
+	by := getenv("DB_SHARD_BY")
+	if by != ShardByTenant {
+		by = ShardByUser
+	}
+	return Shards{
+		Databases: databases,
+		By:        by,
+	}
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"strings"
+	"time"
+)
+
+type Signing struct {
+	// the key and secret the requests sent to partners are signed with
+	KeyID  string
+	Secret string `redact:"true"`
+	// the secrets of the partners calling the signed endpoints, by their key
+	Partners map[string]string `redact:"true"`
+	// how far the timestamp of a signed request may be from now, in both directions
+	Tolerance time.Duration
+	// the largest body read to verify a request
+	MaxBodySize int64
+}
+
+// GetSigningConfig reads SIGNING_PARTNERS=key:secret,other-key:other-secret
+func GetSigningConfig() Signing {
+	partners := map[string]string{}
+	for _, item := range strings.Split(getsecret("SIGNING_PARTNERS"), ",") {
+		if i := strings.Index(item, ":"); i > 0 {
+			partners[strings.TrimSpace(item[:i])] = strings.TrimSpace(item[i+1:])
+		}
+	}
+	tolerance, err := time.ParseDuration(getenv("SIGNING_TOLERANCE"))
+	if err != nil || tolerance <= 0 {
+		tolerance = 5 * time.Minute
+	}
+	return Signing{
+		KeyID:       getenv("SIGNING_KEY_ID"),
+		Secret:      getsecret("SIGNING_SECRET"),
+		Partners:    partners,
+		Tolerance:   tolerance,
+		MaxBodySize: 1 << 20,
+	}
+}
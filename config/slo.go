@@ -0,0 +1,67 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SloObjective is the share of the requests of a route answered under the latency without a server error
+type SloObjective struct {
+	Latency time.Duration
+	// Objective is a ratio, 0.99 for 99%
+	Objective float64
+}
+
+type Slo struct {
+	// the objectives by route, keyed by "METHOD /route/:param"
+	Routes map[string]SloObjective
+	// Window is the period of the compliance and of the error budget
+	Window time.Duration
+	// BurnRate is the rate of the error budget burning, over the last hour and the last 5 minutes, alerted. 1 burns
+	// the budget in exactly the window
+	BurnRate float64
+	// MinRequests are the requests of the last 5 minutes below which a route is not alerted, a few errors of a quiet
+	// route are not an incident
+	MinRequests int64
+}
+
+func GetSloConfig() Slo {
+	burnRate, err := strconv.ParseFloat(getenv("SLO_BURN_RATE"), 64)
+	if err != nil || burnRate <= 0 {
+		burnRate = 14.4
+	}
+	minRequests, err := strconv.ParseInt(getenv("SLO_MIN_REQUESTS"), 10, 64)
+	if err != nil || minRequests < 0 {
+		minRequests = 20
+	}
+	return Slo{
+		Routes:      parseSloRoutes(getenv("SLO_ROUTES")),
+		Window:      parseDurationOr(getenv("SLO_WINDOW"), 24*time.Hour),
+		BurnRate:    burnRate,
+		MinRequests: minRequests,
+	}
+}
+
+// parseSloRoutes reads "GET /v1/restricted/users=300ms@99;GET /v1/restricted/users/:user=200ms@99.9", the objectives
+// are percents
+func parseSloRoutes(value string) map[string]SloObjective {
+	routes := map[string]SloObjective{}
+	for _, item := range strings.Split(value, ";") {
+		i := strings.LastIndex(item, "=")
+		j := strings.LastIndex(item, "@")
+		if i <= 0 || j < i {
+			continue
+		}
+		latency, err := time.ParseDuration(strings.TrimSpace(item[i+1 : j]))
+		if err != nil || latency <= 0 {
+			continue
+		}
+		objective, err := strconv.ParseFloat(strings.TrimSpace(item[j+1:]), 64)
+		if err != nil || objective <= 0 || objective >= 100 {
+			continue
+		}
+		routes[strings.TrimSpace(item[:i])] = SloObjective{Latency: latency, Objective: objective / 100}
+	}
+	return routes
+}
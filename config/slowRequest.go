@@ -0,0 +1,28 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+type SlowRequest struct {
+	// a request slower than the threshold is logged with the breakdown of its spans, 0 turns the detection off
+	Threshold time.Duration
+	// the slowest spans listed in the entry
+	Spans int
+}
+
+func GetSlowRequestConfig() SlowRequest {
+	threshold, err := time.ParseDuration(getenv("SLOW_REQUEST_THRESHOLD"))
+	if err != nil || threshold < 0 {
+		threshold = time.Second
+	}
+	spans, err := strconv.Atoi(getenv("SLOW_REQUEST_SPANS"))
+	if err != nil || spans < 0 {
+		spans = 20
+	}
+	return SlowRequest{
+		Threshold: threshold,
+		Spans:     spans,
+	}
+}
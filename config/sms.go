@@ -0,0 +1,48 @@
+package config
+
+import (
+	"strconv"
+	"time"
+)
+
+type Sms struct {
+	// log or twilio
+	Driver string
+
+	TwilioAccountSid string
+	TwilioAuthToken  string `redact:"true"`
+	TwilioFrom       string
+
+	// phone verification codes
+	CodeTTL            time.Duration
+	CodeMaxAttempts    int
+	CodeResendInterval time.Duration
+}
+
+func GetSmsConfig() Sms {
+	driver := getenv("SMS_DRIVER")
+	if driver == "" {
+		driver = "log"
+	}
+	ttl, err := time.ParseDuration(getenv("PHONE_CODE_TTL"))
+	if err != nil || ttl <= 0 {
+		ttl = 10 * time.Minute
+	}
+	attempts, err := strconv.Atoi(getenv("PHONE_CODE_MAX_ATTEMPTS"))
+	if err != nil || attempts <= 0 {
+		attempts = 5
+	}
+	interval, err := time.ParseDuration(getenv("PHONE_CODE_RESEND_INTERVAL"))
+	if err != nil || interval < 0 {
+		interval = time.Minute
+	}
+	return Sms{
+		Driver:             driver,
+		TwilioAccountSid:   getenv("TWILIO_ACCOUNT_SID"),
+		TwilioAuthToken:    getsecret("TWILIO_AUTH_TOKEN"),
+		TwilioFrom:         getenv("TWILIO_FROM"),
+		CodeTTL:            ttl,
+		CodeMaxAttempts:    attempts,
+		CodeResendInterval: interval,
+	}
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// the sources of the value of a variable
+const (
+	SourceEnv     = "env"
+	SourceFile    = "file"
+	SourceDefault = "default"
+)
+
+// Redacted replaces the value of a secret field in Redact
+const Redacted = "[redacted]"
+
+// SecretSet is the value of a secret in Variables when it can not be fingerprinted
+const SecretSet = "[set]"
+
+// FingerprintKeyEnv is the variable keying the fingerprints of the secrets, the same for every instance so their
+// snapshots compare. It is never fingerprinted itself
+const FingerprintKeyEnv = "CONFIG_FINGERPRINT_KEY"
+
+// Variable is an environment variable read by the configuration, a secret one has the fingerprint of its value
+type Variable struct {
+	Name string `json:"name"`
+	// Value is empty for the default. A secret is hmac:<hex> keyed by CONFIG_FINGERPRINT_KEY so a change is seen
+	// without showing it, [set] without the key
+	Value  string `json:"value"`
+	Source string `json:"source"`
+	Secret bool   `json:"secret"`
+}
+
+var (
+	// the values of the .env file when it was loaded, a variable of the environment wins over it
+	fileValues = map[string]string{}
+	// the variables read, true for the secrets
+	read   = map[string]bool{}
+	readMu sync.Mutex
+)
+
+// getenv reads the variable of the configuration, it is listed by Variables
+func getenv(key string) string {
+	readMu.Lock()
+	if _, ok := read[key]; !ok {
+		read[key] = false
+	}
+	readMu.Unlock()
+	return os.Getenv(key)
+}
+
+// getsecret reads a variable holding a password, a key or a token, Variables shows its fingerprint only
+func getsecret(key string) string {
+	readMu.Lock()
+	read[key] = true
+	readMu.Unlock()
+	return os.Getenv(key)
+}
+
+/**
+ * Variables
+ * the variables read by the configuration so far with their values, sorted by name. A variable is from the .env file
+ * when it holds the value of the file, from the environment when it is set otherwise, and the default when it is empty.
+ * The secrets are fingerprinted with CONFIG_FINGERPRINT_KEY, without it they are only shown set
+ *
+ * @return []Variable
+ */
+func Variables() []Variable {
+	key := getsecret(FingerprintKeyEnv)
+	readMu.Lock()
+	defer readMu.Unlock()
+	variables := make([]Variable, 0, len(read))
+	for name, secret := range read {
+		variable := Variable{Name: name, Value: os.Getenv(name), Source: SourceEnv, Secret: secret}
+		switch file, ok := fileValues[name]; {
+		case variable.Value == "":
+			variable.Source = SourceDefault
+		case ok && file == variable.Value:
+			variable.Source = SourceFile
+		}
+		if secret && variable.Value != "" {
+			variable.Value = fingerprint(key, name, variable.Value)
+		}
+		variables = append(variables, variable)
+	}
+	sort.Slice(variables, func(i, j int) bool { return variables[i].Name < variables[j].Name })
+	return variables
+}
+
+// fingerprint is the hmac of a secret, it can not be guessed from the snapshots without the key. The key itself and
+// the secrets read without a key are only SecretSet
+func fingerprint(key string, name string, value string) string {
+	if key == "" || name == FingerprintKeyEnv {
+		return SecretSet
+	}
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(value))
+	return "hmac:" + hex.EncodeToString(mac.Sum(nil))
+}
+
+/**
+ * Redact
+ * the value as maps, slices and scalars for json with the fields tagged redact:"true" replaced by Redacted when they
+ * are set and the passwords of the urls removed. The durations are written as 1m30s
+ *
+ * @param interface{} value
+ *
+ * @return interface{}
+ */
+func Redact(value interface{}) interface{} {
+	return redact(reflect.ValueOf(value))
+}
+
+func redact(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if d, ok := v.Interface().(time.Duration); ok {
+		return d.String()
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return redact(v.Elem())
+	case reflect.Struct:
+		fields := map[string]interface{}{}
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			if field.Tag.Get("redact") == "true" && !v.Field(i).IsZero() {
+				fields[field.Name] = Redacted
+				continue
+			}
+			fields[field.Name] = redact(v.Field(i))
+		}
+		return fields
+	case reflect.Map:
+		values := map[string]interface{}{}
+		iter := v.MapRange()
+		for iter.Next() {
+			values[fmt.Sprint(iter.Key().Interface())] = redact(iter.Value())
+		}
+		return values
+	case reflect.Slice, reflect.Array:
+		values := make([]interface{}, v.Len())
+		for i := range values {
+			values[i] = redact(v.Index(i))
+		}
+		return values
+	case reflect.String:
+		return redactURL(v.String())
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	}
+	return v.Interface()
+}
+
+// redactURL removes the password of a url, redis://:password@host
+func redactURL(value string) string {
+	u, err := url.Parse(value)
+	if err != nil || u.User == nil {
+		return value
+	}
+	if _, ok := u.User.Password(); !ok {
+		return value
+	}
+	u.User = url.UserPassword(u.User.Username(), "xxxxx")
+	return u.String()
+}
@@ -0,0 +1,15 @@
+package config
+
+type Storage struct {
+	Root string
+}
+
+func GetStorageConfig() Storage {
+	root := getenv("STORAGE_PATH")
+	if root == "" {
+		root = "./storage/app"
+	}
+	return Storage{
+		Root: root,
+	}
+}
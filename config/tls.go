@@ -0,0 +1,79 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// ClientAuthNone does not ask for client certificates
+	ClientAuthNone = "none"
+	// ClientAuthOptional verifies the client certificates that are sent, the others authenticate as usual
+	ClientAuthOptional = "optional"
+	// ClientAuthRequire refuses the connections without a verified client certificate
+	ClientAuthRequire = "require"
+)
+
+type TLS struct {
+	// the server listens with TLS when both are set or when autocert has domains
+	CertFile string
+	KeyFile  string
+	// AutocertDomains get their certificates from Let's Encrypt instead of the files, they are kept in AutocertCacheDir
+	AutocertDomains  []string
+	AutocertEmail    string
+	AutocertCacheDir string
+	// RedirectPort serves the redirects of http to https and the HTTP-01 challenges of autocert, empty to not listen
+	RedirectPort string
+	// MinVersion is 1.2 or 1.3
+	MinVersion string
+	HTTP2      bool
+	// ClientCAFile holds the PEM certificates of the authorities the client certificates are verified against
+	ClientCAFile string
+	// ClientAuth is none, optional or require, see the ClientAuth constants
+	ClientAuth string
+}
+
+func (t TLS) Enabled() bool {
+	return t.CertFile != "" && t.KeyFile != "" || t.Autocert()
+}
+
+func (t TLS) Autocert() bool {
+	return len(t.AutocertDomains) > 0
+}
+
+func GetTLSConfig() TLS {
+	var domains []string
+	for _, domain := range strings.Split(getenv("TLS_AUTOCERT_DOMAINS"), ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	cacheDir := getenv("TLS_AUTOCERT_CACHE_DIR")
+	if cacheDir == "" {
+		cacheDir = "./storage/certs"
+	}
+	minVersion := getenv("TLS_MIN_VERSION")
+	if minVersion != "1.3" {
+		minVersion = "1.2"
+	}
+	http2, err := strconv.ParseBool(getenv("TLS_HTTP2"))
+	if err != nil {
+		http2 = true
+	}
+	clientAuth := strings.ToLower(getenv("MTLS_CLIENT_AUTH"))
+	if clientAuth != ClientAuthOptional && clientAuth != ClientAuthRequire {
+		clientAuth = ClientAuthNone
+	}
+	return TLS{
+		CertFile:         getenv("TLS_CERT_FILE"),
+		KeyFile:          getenv("TLS_KEY_FILE"),
+		AutocertDomains:  domains,
+		AutocertEmail:    getenv("TLS_AUTOCERT_EMAIL"),
+		AutocertCacheDir: cacheDir,
+		RedirectPort:     getenv("TLS_REDIRECT_PORT"),
+		MinVersion:       minVersion,
+		HTTP2:            http2,
+		ClientCAFile:     getenv("MTLS_CLIENT_CA_FILE"),
+		ClientAuth:       clientAuth,
+	}
+}
@@ -0,0 +1,349 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type AccountController struct {
+	PrivacyService     services.IPrivacyService
+	QuotaService       services.IQuotaService
+	EmailChangeService services.IEmailChangeService
+	UserService        services.IUserService
+}
+
+// RequestDataExport godoc
+// @Summary Export all data of the authenticated user
+// @ID requestDataExport
+// @Description The archive is built in the background, poll the returned export until its status is ready
+// @Tags Account
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 202 {object} viewModels.HTTPSuccessResponse{data=models.DataExport}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/data-export [post]
+func (a AccountController) RequestDataExport(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var dataExport models.DataExport
+	dataExport, err = a.PrivacyService.RequestDataExport(auth)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusAccepted, viewModels.SuccessResponse(dataExport))
+}
+
+// ShowDataExport godoc
+// @Summary Get a data export of the authenticated user
+// @ID showDataExport
+// @Description
+// @Tags Account
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.DataExport}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/data-exports/:export [get]
+func (a AccountController) ShowDataExport(c echo.Context) (err error) {
+	dataExport, err := a.ownedDataExport(c)
+	if err != nil {
+		return err
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(dataExport))
+}
+
+// DownloadDataExport godoc
+// @Summary Download a data export archive
+// @ID downloadDataExport
+// @Description honors Range with 206 partial contents so a large archive is resumed, and HEAD
+// @Tags Account
+// @Produce application/zip
+// @Param token header string true "Bearer Token"
+// @Param Range header string false "Byte ranges, like bytes=0-1023"
+// @Success 200
+// @Success 206
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/data-exports/:export/download [get]
+func (a AccountController) DownloadDataExport(c echo.Context) (err error) {
+	dataExport, err := a.ownedDataExport(c)
+	if err != nil {
+		return err
+	}
+
+	if !dataExport.IsReady() {
+		return problems.New(problems.DataExportNotReady)
+	}
+
+	file, err := a.PrivacyService.GetDataExportFile(dataExport)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return viewModels.File{
+		Name:         fmt.Sprintf("data-export-%d.zip", dataExport.ID),
+		ContentType:  "application/zip",
+		Disposition:  "attachment",
+		CacheControl: "private, no-cache",
+	}.Serve(c, file)
+}
+
+// Destroy godoc
+// @Summary Schedule the deletion of the authenticated user
+// @ID deleteAccount
+// @Description The account is anonymized and deleted once the grace period is over, until then it can be restored
+// @Tags Account
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 202 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me [delete]
+func (a AccountController) Destroy(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var user models.User
+	user, err = a.PrivacyService.ScheduleDeletion(auth)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusAccepted, viewModels.SuccessResponse(user))
+}
+
+// Restore godoc
+// @Summary Cancel the scheduled deletion of the authenticated user
+// @ID restoreAccount
+// @Description
+// @Tags Account
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/restore [post]
+func (a AccountController) Restore(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var user models.User
+	user, err = a.PrivacyService.CancelDeletion(auth)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
+
+func (a AccountController) ownedDataExport(c echo.Context) (dataExport models.DataExport, err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.DataExportShowRequest)
+	if err = (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return dataExport, err
+	}
+
+	dataExport, err = a.PrivacyService.GetDataExportByID(request.PathParams.Export)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dataExport, problems.New(problems.DataExportNotFound)
+		}
+		return dataExport, echo.ErrInternalServerError
+	}
+
+	// Policy Control
+	if dataExport.UserID != auth.ID {
+		return dataExport, problems.New(problems.DataExportNotFound)
+	}
+	return dataExport, nil
+}
+
+// Usage godoc
+// @Summary Requests of the authenticated user by month
+// @ID showUsage
+// @Description defaults to the current year, with the quotas of the user (0 is unlimited)
+// @Tags Account
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param year query int false "Year"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.Usage}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/usage [get]
+func (a AccountController) Usage(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.UsageShowRequest)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	year := request.QueryParams.Year
+	if year == 0 {
+		year = time.Now().UTC().Year()
+	}
+
+	var usage services.Usage
+	usage, err = a.QuotaService.GetUsage(auth, year)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(usage))
+}
+
+// ChangeEmail godoc
+// @Summary Request a change of the email of the authenticated user
+// @ID changeEmail
+// @Description a confirmation link is sent to the new address and a notice to the current one, the email is only changed once the link is opened
+// @Tags Account
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param email body string true "<code>required</code> <code>email</code> <code>min:4</code> <code>max:50</code>" minlength(4) maxlength(50)
+// @Param current_password body string true "<code>required</code>"
+// @Success 202 {object} viewModels.HTTPSuccessResponse{data=models.EmailChange}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/email [post]
+func (a AccountController) ChangeEmail(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.EmailChangeRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var emailChange models.EmailChange
+	emailChange, err = a.EmailChangeService.Request(auth, request.Body.CurrentPassword, request.Body.Email)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrPasswordMismatch):
+			return problems.New(problems.InvalidCredentials).With("errors", map[string]string{
+				"current_password": "the current password is incorrect",
+			})
+		case errors.Is(err, services.ErrEmailTaken):
+			return problems.New(problems.EmailTaken).With("errors", map[string]string{
+				"email": "the email is already registered",
+			})
+		case errors.Is(err, services.ErrEmailUnchanged):
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusAccepted, viewModels.SuccessResponse(emailChange))
+}
+
+// ConfirmEmailChange godoc
+// @Summary Confirm a change of email
+// @ID confirmEmailChange
+// @Description the link sent to the new address, the new email is marked as verified
+// @Tags Account
+// @Produce json
+// @Param token query string true "Confirmation token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/email-changes/confirm [get]
+func (a AccountController) ConfirmEmailChange(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.EmailChangeConfirmRequest)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var user models.User
+	user, err = a.EmailChangeService.Confirm(c.Request().Context(), request.QueryParams.Token)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrEmailChangeInvalid):
+			return err
+		case errors.Is(err, services.ErrEmailTaken):
+			return problems.New(problems.EmailTaken)
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
+
+// UpdatePreferences godoc
+// @Summary Update the preferences of the authenticated user
+// @ID updatePreferences
+// @Description the times of the responses to the user are written in the timezone, they are stored in UTC
+// @Tags Account
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param X-Dry-Run header bool false "runs the request in a transaction rolled back and answers what would have happened"
+// @Param timezone body string true "<code>required</code> <code>IANA timezone</code> <code>max:64</code>" maxlength(64)
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/preferences [put]
+func (a AccountController) UpdatePreferences(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.PreferencesUpdateRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var user models.User
+	user, err = a.UserService.UpdatePreferences(c.Request().Context(), auth, request.Body.Timezone)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
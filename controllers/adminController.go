@@ -0,0 +1,232 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+// AdminController serves the standard admin endpoints of the models of the resources
+type AdminController struct {
+	Resources services.AdminResources
+}
+
+// Index godoc
+// @Summary List the records of a resource
+// @ID listAdminRecords
+// @Description the records whose fields equal the filters of the resource sent in the query, the latest first
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param resource path string true "Resource, like plans or tags"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param lite query bool false "has_next instead of total_record, the records are not counted"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/resources/:resource [get]
+func (a AdminController) Index(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.AdminIndexRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+	resource, err := a.resource(request.PathParams.Resource)
+	if err != nil {
+		return err
+	}
+
+	filters := map[string]string{}
+	for _, filter := range resource.Filters() {
+		if values, ok := c.QueryParams()[filter]; ok && len(values) > 0 {
+			filters[filter] = values[0]
+		}
+	}
+
+	records, count, err := resource.List(filters, &request.QueryParams.Pagination)
+	if err != nil {
+		return a.problem(err)
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.NewPaginator(c, &request.QueryParams.Pagination, records, count)))
+}
+
+// Show godoc
+// @Summary Show a record of a resource
+// @ID showAdminRecord
+// @Description
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param resource path string true "Resource, like plans or tags"
+// @Param id path int true "Record ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/resources/:resource/:id [get]
+func (a AdminController) Show(c echo.Context) (err error) {
+	return a.record(c, http.StatusOK, func(resource services.IAdminService, ID uint) (interface{}, error) {
+		return resource.Show(ID)
+	})
+}
+
+// Store godoc
+// @Summary Create a record of a resource
+// @ID createAdminRecord
+// @Description the body sets the fields of the record, a field the resource does not let admins set is refused
+// @Tags Admin
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param resource path string true "Resource, like plans or tags"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/resources/:resource [post]
+func (a AdminController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.AdminIndexRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	resource, err := a.resource(request.PathParams.Resource)
+	if err != nil {
+		return err
+	}
+	fields := map[string]interface{}{}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &fields); err != nil {
+		return err
+	}
+
+	record, err := resource.Create(auth, fields)
+	if err != nil {
+		return a.problem(err)
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(record))
+}
+
+// Update godoc
+// @Summary Update a record of a resource
+// @ID updateAdminRecord
+// @Description the fields sent are set, the others are left as they are
+// @Tags Admin
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param resource path string true "Resource, like plans or tags"
+// @Param id path int true "Record ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/resources/:resource/:id [put]
+func (a AdminController) Update(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+	fields := map[string]interface{}{}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &fields); err != nil {
+		return err
+	}
+	return a.record(c, http.StatusOK, func(resource services.IAdminService, ID uint) (interface{}, error) {
+		return resource.Update(auth, ID, fields)
+	})
+}
+
+// Destroy godoc
+// @Summary Delete a record of a resource
+// @ID deleteAdminRecord
+// @Description
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param resource path string true "Resource, like plans or tags"
+// @Param id path int true "Record ID"
+// @Success 204
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/resources/:resource/:id [delete]
+func (a AdminController) Destroy(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+	return a.record(c, http.StatusNoContent, func(resource services.IAdminService, ID uint) (interface{}, error) {
+		return nil, resource.Delete(auth, ID)
+	})
+}
+
+// record runs fn on the record of the path and responds with what it returns
+func (a AdminController) record(c echo.Context, status int, fn func(resource services.IAdminService, ID uint) (interface{}, error)) error {
+	// Request Bind And Validation
+	request := new(requests.AdminShowRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+	resource, err := a.resource(request.PathParams.Resource)
+	if err != nil {
+		return err
+	}
+
+	record, err := fn(resource, request.PathParams.ID)
+	if err != nil {
+		return a.problem(err)
+	}
+
+	// Response
+	if status == http.StatusNoContent {
+		return c.NoContent(status)
+	}
+	return c.JSON(status, viewModels.SuccessResponse(viewModels.Resource{
+		Record: record,
+		Links:  viewModels.NewLinks(c).Route("self", "admin.resources.show", resource.Resource(), request.PathParams.ID).Links(),
+	}))
+}
+
+func (a AdminController) resource(name string) (services.IAdminService, error) {
+	resource, ok := a.Resources[name]
+	if !ok {
+		return nil, services.ErrAdminResourceNotFound
+	}
+	return resource, nil
+}
+
+func (a AdminController) problem(err error) error {
+	var v validation.Errors
+	switch {
+	case errors.As(err, &v):
+		return problems.Validation(v)
+	case errors.Is(err, services.ErrAdminRecordNotFound), errors.Is(err, services.ErrAdminRecordInUse):
+		return err
+	}
+	return echo.ErrInternalServerError
+}
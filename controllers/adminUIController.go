@@ -0,0 +1,122 @@
+package controllers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+)
+
+// adminUIIndex is the page of every route of the frontend, its assets are linked by their fingerprint
+const adminUIIndex = "index.html"
+
+type adminUIFile struct {
+	content     []byte
+	contentType string
+	// version is the fingerprint of the content, an asset requested with it is cached until it changes
+	version string
+}
+
+type AdminUIController struct {
+	Config *config.AdminUI
+	Base   string
+	files  map[string]adminUIFile
+}
+
+/**
+ * NewAdminUIController
+ * reads the frontend of the root of the assets and links the assets of its index by their fingerprint
+ */
+func NewAdminUIController(assets fs.FS, base string, conf *config.AdminUI) (AdminUIController, error) {
+	controller := AdminUIController{Config: conf, Base: base, files: map[string]adminUIFile{}}
+	err := fs.WalkDir(assets, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(assets, name)
+		if err != nil {
+			return err
+		}
+		controller.files[name] = controller.file(name, content)
+		return nil
+	})
+	if err != nil {
+		return controller, err
+	}
+
+	index, ok := controller.files[adminUIIndex]
+	if !ok {
+		return controller, fmt.Errorf("admin ui: %v is missing", adminUIIndex)
+	}
+	page, err := template.New(adminUIIndex).Funcs(template.FuncMap{
+		"asset": func(name string) (string, error) {
+			asset, ok := controller.files[name]
+			if !ok {
+				return "", fmt.Errorf("admin ui: unknown asset %q", name)
+			}
+			return base + "/" + name + "?v=" + asset.version, nil
+		},
+	}).Parse(string(index.content))
+	if err != nil {
+		return controller, err
+	}
+	var rendered bytes.Buffer
+	if err = page.Execute(&rendered, nil); err != nil {
+		return controller, err
+	}
+	controller.files[adminUIIndex] = controller.file(adminUIIndex, rendered.Bytes())
+	return controller, nil
+}
+
+func (a AdminUIController) file(name string, content []byte) adminUIFile {
+	sum := sha256.Sum256(content)
+	contentType := mime.TypeByExtension(path.Ext(name))
+	if contentType == "" {
+		contentType = http.DetectContentType(content)
+	}
+	return adminUIFile{content: content, contentType: contentType, version: hex.EncodeToString(sum[:])[:12]}
+}
+
+/**
+ * Serve
+ * the file of the path, or the index for the paths without an extension which are routed by the frontend. The index
+ * is revalidated on every load so a deployment is picked up at once, an asset requested with its fingerprint is
+ * immutable.
+ */
+func (a AdminUIController) Serve(c echo.Context) (err error) {
+	name := strings.Trim(c.Param("*"), "/")
+	file, ok := a.files[name]
+	if !ok {
+		if path.Ext(name) != "" {
+			return echo.ErrNotFound
+		}
+		name, file = adminUIIndex, a.files[adminUIIndex]
+	}
+
+	// Response
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, file.contentType)
+	header.Set("ETag", `"`+file.version+`"`)
+	header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+	if name == adminUIIndex {
+		header.Set(echo.HeaderContentSecurityPolicy, "default-src 'self'; frame-ancestors 'none'")
+	}
+	if name != adminUIIndex && c.QueryParam("v") == file.version {
+		header.Set("Cache-Control", fmt.Sprintf("public, max-age=%d, immutable", int(a.Config.AssetMaxAge/time.Second)))
+	} else {
+		header.Set("Cache-Control", "no-cache")
+	}
+	http.ServeContent(c.Response(), c.Request(), name, time.Time{}, bytes.NewReader(file.content))
+	return nil
+}
@@ -0,0 +1,244 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/utils"
+	"gotham/viewModels"
+)
+
+type AnnouncementController struct {
+	AnnouncementService services.IAnnouncementService
+}
+
+// Index godoc
+// @Summary Announcements of the user
+// @ID listAnnouncements
+// @Description the published announcements of the audiences of the authenticated user, the latest first
+// @Tags Announcement
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param lite query bool false "has_next instead of total_record, the records are not counted"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.Announcement}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/announcements [get]
+func (a AnnouncementController) Index(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+	return a.list(c, func(pagination utils.IPagination) ([]models.Announcement, int64, error) {
+		return a.AnnouncementService.UserAnnouncements(auth, pagination)
+	})
+}
+
+// Read godoc
+// @Summary Mark an announcement read
+// @ID readAnnouncement
+// @Description
+// @Tags Announcement
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param announcement path int true "Announcement ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Announcement}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/announcements/:announcement/read [post]
+func (a AnnouncementController) Read(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.AnnouncementShowRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var announcement models.Announcement
+	announcement, err = a.AnnouncementService.Read(auth, request.PathParams.Announcement)
+	if err != nil {
+		if errors.Is(err, services.ErrAnnouncementNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(announcement))
+}
+
+// AdminIndex godoc
+// @Summary Every announcement
+// @ID listAllAnnouncements
+// @Description the drafts, the scheduled and the published announcements with the number of users who read them, the latest first
+// @Tags Announcement
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param lite query bool false "has_next instead of total_record, the records are not counted"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.Announcement}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/announcements [get]
+func (a AnnouncementController) AdminIndex(c echo.Context) (err error) {
+	return a.list(c, a.AnnouncementService.Announcements)
+}
+
+// Store godoc
+// @Summary Create an announcement
+// @ID createAnnouncement
+// @Description published and delivered to the sockets and the devices of the audience at publish_at, right away without it, a draft is kept until it is published
+// @Tags Announcement
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param title body string true "<code>required|max:150</code>"
+// @Param body body string true "<code>required|max:10000</code>"
+// @Param audience body string true "<code>in:all,verified,plan</code>"
+// @Param plan body string false "slug of the plan, <code>required</code> for the plan audience"
+// @Param publish_at body string false "ISO 8601 time"
+// @Param draft body bool false "saved without being scheduled"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=models.Announcement}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/announcements [post]
+func (a AnnouncementController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.AnnouncementStoreRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var announcement models.Announcement
+	announcement, err = a.AnnouncementService.Create(auth, request.Announcement())
+	if err != nil {
+		if errors.Is(err, services.ErrAnnouncementPlanNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(announcement))
+}
+
+// Publish godoc
+// @Summary Publish an announcement
+// @ID publishAnnouncement
+// @Description schedules a draft or reschedules an announcement at publish_at, publishes it right away without it
+// @Tags Announcement
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param announcement path int true "Announcement ID"
+// @Param publish_at body string false "ISO 8601 time"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Announcement}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/announcements/:announcement/publish [post]
+func (a AnnouncementController) Publish(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.AnnouncementPublishRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var announcement models.Announcement
+	announcement, err = a.AnnouncementService.Publish(auth, request.PathParams.Announcement, request.Body.PublishAt)
+	if err != nil {
+		if errors.Is(err, services.ErrAnnouncementNotFound) || errors.Is(err, services.ErrAnnouncementPublished) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(announcement))
+}
+
+// Destroy godoc
+// @Summary Delete an announcement
+// @ID deleteAnnouncement
+// @Description a draft or a scheduled announcement is not published, a published one is no longer listed
+// @Tags Announcement
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param announcement path int true "Announcement ID"
+// @Success 204
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/announcements/:announcement [delete]
+func (a AnnouncementController) Destroy(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.AnnouncementShowRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	if err = a.AnnouncementService.Delete(auth, request.PathParams.Announcement); err != nil {
+		if errors.Is(err, services.ErrAnnouncementNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (a AnnouncementController) list(c echo.Context, fn func(pagination utils.IPagination) ([]models.Announcement, int64, error)) error {
+	// Request Bind And Validation
+	request := new(requests.AnnouncementIndexRequest)
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+
+	announcements, count, err := fn(&request.QueryParams.Pagination)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.NewPaginator(c, &request.QueryParams.Pagination, announcements, count)))
+}
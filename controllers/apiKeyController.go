@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type ApiKeyController struct {
+	ApiKeyService services.IApiKeyService
+}
+
+// Index godoc
+// @Summary Api keys of the user
+// @ID listApiKeys
+// @Description the api keys of the authenticated user with their prefix, scopes and last use, not the keys themselves
+// @Tags ApiKey
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.ApiKey}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/api-keys [get]
+func (a ApiKeyController) Index(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var keys []models.ApiKey
+	keys, err = a.ApiKeyService.GetApiKeys(auth)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(keys))
+}
+
+// Store godoc
+// @Summary Create an api key
+// @ID createApiKey
+// @Description a key sent in the X-API-Key header authenticates a machine client as the user, limited to the scopes. The key is only returned in this response, without ttl_hours it never expires. The admin scope is granted by the admins only
+// @Tags ApiKey
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param name body string true "<code>required|max:100</code>"
+// @Param scopes body []string true "<code>required</code> users:read, users:write, comments:read, comments:write, media:read, media:write, account:read, account:write, social:read, social:write, conversations:read, conversations:write, billing:read, billing:write or admin"
+// @Param ttl_hours body int false "<code>min:0</code> <code>max:8760</code>"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=viewModels.NewApiKey{record=models.ApiKey}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/api-keys [post]
+func (a ApiKeyController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.ApiKeyStoreRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	apiKey, key, err := a.ApiKeyService.Create(auth, request.Body.Name, request.Body.Scopes, time.Duration(request.Body.TTLHours)*time.Hour)
+	if err != nil {
+		if errors.Is(err, services.ErrScopeNotGrantable) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(viewModels.NewApiKey{Record: apiKey, Key: key}))
+}
+
+// Destroy godoc
+// @Summary Revoke an api key
+// @ID revokeApiKey
+// @Description the key stops authenticating at once
+// @Tags ApiKey
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param key path int true "Api key ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.ApiKey}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/api-keys/:key [delete]
+func (a ApiKeyController) Destroy(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.ApiKeyDestroyRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var apiKey models.ApiKey
+	apiKey, err = a.ApiKeyService.Revoke(auth, request.PathParams.ApiKey)
+	if err != nil {
+		if errors.Is(err, services.ErrApiKeyNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(apiKey))
+}
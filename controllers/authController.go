@@ -3,25 +3,39 @@ package controllers
 import (
 	"errors"
 	"net/http"
-	"time"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/labstack/echo/v4"
 	"gorm.io/gorm"
 
 	"gotham/config"
+	"gotham/infrastructures"
 	"gotham/models"
+	"gotham/problems"
 	"gotham/requests"
 	"gotham/services"
 	"gotham/viewModels"
 )
 
 type AuthController struct {
-	AuthService services.IAuthService
+	AuthService         services.IAuthService
+	RegistrationService services.IRegistrationService
+	MagicLinkService    services.IMagicLinkService
+	SessionService      services.ISessionService
+	ChallengeService    services.IChallengeService
+	Clock               infrastructures.IClock
+	Config              *config.Session
+}
+
+// tokenOptions what comes with the access token, a sudo token or the refresh token of a remember me session
+type tokenOptions struct {
+	sudo         bool
+	session      models.Session
+	refreshToken string
 }
 
 // Login godoc
 // @Summary
+// @ID login
 // @Description
 // @Tags Auth
 // @Accept  json
@@ -30,11 +44,15 @@ type AuthController struct {
 // @Produce json
 // @Param email body string true "<code>required</code>  <code>min:4</code> <code>max:50</code> <code>must be email</code>" minlength(4) maxlength(50)
 // @Param password body string true "<code>required</code>  <code>min:8</code> <code>max:50</code>" minlength(8) maxlength(50)
+// @Param remember_me body bool false "returns a refresh token bound to the device"
+// @Param device_id body string false "<code>required with remember_me</code> <code>max:100</code>" maxlength(100)
 // @Param platform body string true "<code>required</code>  <code>In('panel', 'web', 'mobile')/code>"
+// @Param captcha_token body string false "<code>required after failed logins</code>, the failures answer <code>captcha_required</code>"
 // @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Login}
-// @Failure 422 {object} viewModels.HTTPErrorResponse{}
-// @Failure 400 {object} viewModels.Message{}
-// @Failure 500 {object} viewModels.Message{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 400 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
 // @Router /v1/login [post]
 func (a AuthController) Login(c echo.Context) (err error) {
 	// Request Bind And Validation
@@ -44,16 +62,19 @@ func (a AuthController) Login(c echo.Context) (err error) {
 	}
 	v := request.Validate()
 	if v != nil {
-		return c.JSON(http.StatusUnprocessableEntity, viewModels.ValidationResponse(v))
+		return problems.Validation(v)
+	}
+
+	subject := services.ChallengeSubject{IP: c.RealIP(), Email: request.Body.Email}
+	if err = a.ChallengeService.Check(c.Request().Context(), services.ChallengeLogin, subject, request.Body.CaptchaToken); err != nil {
+		return a.challengeError(err)
 	}
 
 	var user models.User
 	user, err = a.AuthService.GetUserByEmail(request.Body.Email)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return c.JSON(http.StatusUnprocessableEntity, viewModels.ValidationResponse(map[string]string{
-				"email": "email or password is incorrect",
-			}))
+			return a.failedLogin(c, subject)
 		} else {
 			return echo.ErrInternalServerError
 		}
@@ -62,32 +83,369 @@ func (a AuthController) Login(c echo.Context) (err error) {
 	var verify bool
 	verify, err = a.AuthService.Check(request.Body.Email, request.Body.Password)
 	if !verify {
-		return c.JSON(http.StatusUnprocessableEntity, viewModels.ValidationResponse(map[string]string{
-			"email": "email or password is incorrect",
-		}))
+		return a.failedLogin(c, subject)
+	}
+	_ = a.ChallengeService.Forget(c.Request().Context(), services.ChallengeLogin, subject)
+
+	options := tokenOptions{sudo: true}
+	if request.Body.RememberMe {
+		options.session, options.refreshToken, err = a.SessionService.Start(user, request.Body.DeviceID, c.Request().UserAgent())
+		if err != nil {
+			return echo.ErrInternalServerError
+		}
+	}
+	return a.respondWithToken(c, http.StatusOK, user, options)
+}
+
+// Register godoc
+// @Summary
+// @ID register
+// @Description depends on the registration mode, an invitation code is required while it is invite only
+// @Tags Auth
+// @Accept  json
+// @Accept  multipart/form-data
+// @Accept  application/x-www-form-urlencoded
+// @Produce json
+// @Param name body string true "<code>required</code>  <code>min:2</code> <code>max:255</code>" minlength(2) maxlength(255)
+// @Param email body string true "<code>required</code>  <code>min:4</code> <code>max:50</code> <code>must be email</code>" minlength(4) maxlength(50)
+// @Param password body string true "<code>required</code>  <code>min:8</code> <code>max:50</code>" minlength(8) maxlength(50)
+// @Param invitation_code body string false "<code>max:64</code>" maxlength(64)
+// @Param captcha_token body string false "<code>required after many registrations from the ip</code>"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=viewModels.Login}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 400 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/register [post]
+func (a AuthController) Register(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.RegisterRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	subject := services.ChallengeSubject{IP: c.RealIP()}
+	if err = a.ChallengeService.Check(c.Request().Context(), services.ChallengeRegistration, subject, request.Body.CaptchaToken); err != nil {
+		return a.challengeError(err)
+	}
+
+	var user models.User
+	user, err = a.RegistrationService.Register(c.Request().Context(), request.Body.Name, request.Body.Email, request.Body.Password, request.Body.InvitationCode)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrRegistrationClosed), errors.Is(err, services.ErrInvitationRequired), errors.Is(err, services.ErrInvitationInvalid):
+			return err
+		case errors.Is(err, services.ErrEmailTaken):
+			return problems.New(problems.EmailTaken).With("errors", map[string]string{
+				"email": "the email is already registered",
+			})
+		}
+		return echo.ErrInternalServerError
+	}
+	_ = a.ChallengeService.Record(c.Request().Context(), services.ChallengeRegistration, subject)
+
+	return a.respondWithToken(c, http.StatusCreated, user, tokenOptions{sudo: true})
+}
+
+// Verify godoc
+// @Summary Verify the email of a new user
+// @ID verifyEmail
+// @Description the link mailed on registration, it works once
+// @Tags Auth
+// @Produce json
+// @Param token query string true "Verification token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/verifications/confirm [get]
+func (a AuthController) Verify(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.VerificationConfirmRequest)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
 	}
 
-	accessTokenExp := time.Now().Add(time.Hour * 720).Unix()
+	var user models.User
+	user, err = a.RegistrationService.Verify(c.Request().Context(), request.QueryParams.Token)
+	if err != nil {
+		if errors.Is(err, services.ErrVerificationInvalid) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
 
-	claims := &config.JwtCustomClaims{
-		AuthID: user.ID,
-		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: accessTokenExp,
-		},
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
+
+// MagicLink godoc
+// @Summary Mail a sign in link
+// @ID magicLink
+// @Description the link works once, the answer is the same whether the email is registered or not
+// @Tags Auth
+// @Accept  json
+// @Produce json
+// @Param email body string true "<code>required</code>  <code>min:4</code> <code>max:50</code> <code>must be email</code>" minlength(4) maxlength(50)
+// @Success 202 {object} viewModels.HTTPSuccessResponse{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 429 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/auth/magic-link [post]
+func (a AuthController) MagicLink(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.MagicLinkRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	if err = a.MagicLinkService.Send(c.Request().Context(), request.Body.Email); err != nil {
+		if errors.Is(err, services.ErrMagicLinkThrottled) {
+			return err
+		}
+		return echo.ErrInternalServerError
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	// Response
+	return c.JSON(http.StatusAccepted, viewModels.SuccessResponse(nil))
+}
 
-	var accessToken string
-	accessToken, err = token.SignedString([]byte(config.Conf.SecretKey))
+// MagicLinkCallback godoc
+// @Summary Sign in with a mailed link
+// @ID magicLinkCallback
+// @Description exchanges the token of the link for an access token
+// @Tags Auth
+// @Produce json
+// @Param token query string true "Link token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Login}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/auth/magic-link/callback [get]
+func (a AuthController) MagicLinkCallback(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.MagicLinkCallbackRequest)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var user models.User
+	user, err = a.MagicLinkService.Exchange(c.Request().Context(), request.QueryParams.Token)
+	if err != nil {
+		if errors.Is(err, services.ErrMagicLinkInvalid) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	return a.respondWithToken(c, http.StatusOK, user, tokenOptions{})
+}
+
+// Refresh godoc
+// @Summary Exchange a remember me refresh token for a new access token
+// @ID refresh
+// @Description the refresh token rotates, the returned one replaces it and the session expiry slides. Tokens from a refresh never allow sensitive operations
+// @Tags Auth
+// @Accept  json
+// @Produce json
+// @Param refresh_token body string true "<code>required</code>"
+// @Param device_id body string true "<code>required</code> the device of the login"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Login}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/auth/refresh [post]
+func (a AuthController) Refresh(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.RefreshRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	options := tokenOptions{}
+	var user models.User
+	user, options.session, options.refreshToken, err = a.SessionService.Refresh(request.Body.RefreshToken, request.Body.DeviceID)
+	if err != nil {
+		if errors.Is(err, services.ErrRefreshTokenInvalid) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	return a.respondWithToken(c, http.StatusOK, user, options)
+}
+
+// Logout godoc
+// @Summary End a remember me session
+// @ID logout
+// @Description
+// @Tags Auth
+// @Accept  json
+// @Produce json
+// @Param refresh_token body string true "<code>required</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/auth/logout [post]
+func (a AuthController) Logout(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.LogoutRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	if err = a.SessionService.Revoke(request.Body.RefreshToken); err != nil {
+		if errors.Is(err, services.ErrRefreshTokenInvalid) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(nil))
+}
+
+// Sudo godoc
+// @Summary Confirm the password to allow sensitive operations
+// @ID sudo
+// @Description returns an access token allowing sensitive operations for a few minutes
+// @Tags Auth
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param password body string true "<code>required</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Login}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/auth/sudo [post]
+func (a AuthController) Sudo(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.SudoRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var confirmed bool
+	confirmed, err = a.AuthService.ConfirmPassword(c.Request().Context(), auth, request.Body.Password)
+	if err != nil {
+		if errors.Is(err, services.ErrSudoLocked) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+	if !confirmed {
+		return problems.New(problems.InvalidCredentials).With("errors", map[string]string{
+			"password": "the password is incorrect",
+		})
+	}
+
+	return a.respondWithToken(c, http.StatusOK, auth, tokenOptions{sudo: true})
+}
+
+// ChangePassword godoc
+// @Summary Change the password of the authenticated user
+// @ID changePassword
+// @Description needs a password confirmed within the sudo window, the remember me sessions of the user are ended
+// @Tags Auth
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param password body string true "<code>required</code>  <code>min:8</code> <code>max:50</code>" minlength(8) maxlength(50)
+// @Param password_confirmation body string true "<code>required</code> <code>same as password</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/password [put]
+func (a AuthController) ChangePassword(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.PasswordChangeRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var user models.User
+	user, err = a.AuthService.ChangePassword(auth, request.Body.Password)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
+
+// failedLogin counts the failure and tells the client when the next attempt needs a captcha
+func (a AuthController) failedLogin(c echo.Context, subject services.ChallengeSubject) error {
+	ctx := c.Request().Context()
+	_ = a.ChallengeService.Record(ctx, services.ChallengeLogin, subject)
+	required, _ := a.ChallengeService.Required(ctx, services.ChallengeLogin, subject)
+	return problems.New(problems.InvalidCredentials).With("errors", map[string]string{
+		"email": "email or password is incorrect",
+	}).With("captcha_required", required)
+}
+
+func (a AuthController) challengeError(err error) error {
+	if errors.Is(err, services.ErrCaptchaRequired) || errors.Is(err, services.ErrCaptchaInvalid) {
+		return err
+	}
+	return echo.ErrInternalServerError
+}
+
+// respondWithToken issues an access token for the user
+func (a AuthController) respondWithToken(c echo.Context, status int, user models.User, options tokenOptions) (err error) {
+	login := viewModels.Login{User: user}
+	if options.sudo {
+		now := a.Clock.Now()
+		login.AccessToken, login.AccessTokenExp, err = a.AuthService.IssueSudoToken(user.ID, a.Config.AccessTTL, now)
+		login.SudoUntil = now.Add(a.Config.SudoTTL).Unix()
+	} else {
+		login.AccessToken, login.AccessTokenExp, err = a.AuthService.IssueToken(user.ID, 0, a.Config.AccessTTL)
+	}
 	if err != nil {
 		return
 	}
+	if options.refreshToken != "" {
+		login.RefreshToken, login.RefreshTokenExp = options.refreshToken, options.session.ExpiresAt.Unix()
+	}
 
 	// Response
-	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.Login{
-		AccessToken:    accessToken,
-		AccessTokenExp: accessTokenExp,
-		User:           user,
-	}))
+	return c.JSON(status, viewModels.SuccessResponse(login))
 }
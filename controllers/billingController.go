@@ -0,0 +1,294 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+// maxWebhookSize bounds the body read from the payment provider
+const maxWebhookSize = 1 << 20
+
+type BillingController struct {
+	BillingService services.IBillingService
+	InvoiceService services.IInvoiceService
+}
+
+// Plans godoc
+// @Summary Plans users can subscribe to
+// @ID billingPlans
+// @Description
+// @Tags Billing
+// @Produce json
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Plan}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/billing/plans [get]
+func (b BillingController) Plans(c echo.Context) (err error) {
+	var plans []models.Plan
+	plans, err = b.BillingService.Plans()
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(plans))
+}
+
+// Subscription godoc
+// @Summary Subscription of the authenticated user
+// @ID billingSubscription
+// @Description
+// @Tags Billing
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Subscription}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/billing/subscription [get]
+func (b BillingController) Subscription(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var subscription models.Subscription
+	subscription, err = b.BillingService.Subscription(auth)
+	if err != nil {
+		if errors.Is(err, services.ErrSubscriptionNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(subscription))
+}
+
+// Checkout godoc
+// @Summary Start the subscription to a plan
+// @ID billingCheckout
+// @Description returns the payment page of the plan, the subscription is active once the payment provider confirms it
+// @Tags Billing
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param plan body string true "<code>required</code> <code>max:50</code>" maxlength(50)
+// @Param coupon body string false "<code>max:50</code>" maxlength(50)
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=viewModels.Checkout}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/billing/checkout [post]
+func (b BillingController) Checkout(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.CheckoutRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var url string
+	url, err = b.BillingService.Checkout(c.Request().Context(), auth, request.Body.Plan, request.Body.Coupon)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrPlanNotFound), errors.Is(err, services.ErrPlanNotPurchasable), errors.Is(err, services.ErrAlreadySubscribed),
+			errors.Is(err, services.ErrCouponInvalid), errors.Is(err, services.ErrCouponNotApplicable):
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(viewModels.Checkout{Url: url}))
+}
+
+// ValidateCoupon godoc
+// @Summary Check a coupon for a plan
+// @ID billingValidateCoupon
+// @Description returns the price of the plan with the discount of the coupon
+// @Tags Billing
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param code body string true "<code>required</code> <code>max:50</code>" maxlength(50)
+// @Param plan body string true "<code>required</code> <code>max:50</code>" maxlength(50)
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.CouponQuote}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/billing/coupons/validate [post]
+func (b BillingController) ValidateCoupon(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.CouponValidateRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var quote services.CouponQuote
+	quote, err = b.BillingService.QuoteCoupon(auth, request.Body.Code, request.Body.Plan)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrPlanNotFound), errors.Is(err, services.ErrPlanNotPurchasable),
+			errors.Is(err, services.ErrCouponInvalid), errors.Is(err, services.ErrCouponNotApplicable):
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(quote))
+}
+
+// Cancel godoc
+// @Summary Cancel the subscription of the authenticated user
+// @ID billingCancel
+// @Description the subscription is not renewed, its plan is kept until the end of the paid period
+// @Tags Billing
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Subscription}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/billing/subscription [delete]
+func (b BillingController) Cancel(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var subscription models.Subscription
+	subscription, err = b.BillingService.Cancel(c.Request().Context(), auth)
+	if err != nil {
+		if errors.Is(err, services.ErrSubscriptionNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(subscription))
+}
+
+// Webhook godoc
+// @Summary Events of the payment provider
+// @ID billingWebhook
+// @Description called by stripe, the body is verified with the Stripe-Signature header
+// @Tags Billing
+// @Accept  json
+// @Param Stripe-Signature header string true "t=<unix time>,v1=<signature>"
+// @Success 204
+// @Failure 400 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/billing/webhook [post]
+func (b BillingController) Webhook(c echo.Context) (err error) {
+	// the signature covers the exact bytes, the body is not bound
+	var payload []byte
+	payload, err = ioutil.ReadAll(io.LimitReader(c.Request().Body, maxWebhookSize))
+	if err != nil {
+		return echo.ErrBadRequest
+	}
+
+	if err = b.BillingService.HandleWebhook(payload, c.Request().Header.Get("Stripe-Signature")); err != nil {
+		if errors.Is(err, services.ErrWebhookInvalid) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Invoices godoc
+// @Summary Invoices of the authenticated user
+// @ID billingInvoices
+// @Description the rendered invoices carry a signed download_url valid for INVOICE_URL_TTL
+// @Tags Billing
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Invoice}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/billing/invoices [get]
+func (b BillingController) Invoices(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var invoices []models.Invoice
+	invoices, err = b.InvoiceService.Invoices(auth)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(invoices))
+}
+
+// DownloadInvoice godoc
+// @Summary Download the pdf of an invoice
+// @ID billingDownloadInvoice
+// @Description the link comes from the download_url of the invoices, it needs no token. Honors Range with 206 partial contents, and HEAD
+// @Tags Billing
+// @Produce application/pdf
+// @Param invoice path int true "Invoice ID"
+// @Param expires query int true "Expiry of the link"
+// @Param signature query string true "Signature of the link"
+// @Param Range header string false "Byte ranges, like bytes=0-1023"
+// @Success 200 {file} binary
+// @Success 206 {file} binary
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/billing/invoices/:invoice/download [get]
+func (b BillingController) DownloadInvoice(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.InvoiceDownloadRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	invoice, file, err := b.InvoiceService.Download(request.PathParams.Invoice, request.QueryParams.Expires, request.QueryParams.Signature)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvoiceLinkInvalid), errors.Is(err, services.ErrInvoiceNotFound), errors.Is(err, services.ErrInvoiceNotReady):
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return viewModels.File{
+		Name:         fmt.Sprintf("invoice-%d.pdf", invoice.ID),
+		ContentType:  "application/pdf",
+		Disposition:  "attachment",
+		CacheControl: "private, no-cache",
+	}.Serve(c, file)
+}
@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type ClientController struct {
+	ClientService services.IClientService
+}
+
+// Index godoc
+// @Summary Clients
+// @ID listClients
+// @Description the internal services registered for the client credentials grant, not their secrets
+// @Tags Client
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Client}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/clients [get]
+func (a ClientController) Index(c echo.Context) (err error) {
+	var clients []models.Client
+	clients, err = a.ClientService.GetClients()
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(clients))
+}
+
+// Store godoc
+// @Summary Register a client
+// @ID createClient
+// @Description an internal service exchanges its client_id and client_secret for a token of its scopes at /v1/oauth/token. The secret is only returned in this response, without rate_limit the CLIENT_RATE_LIMIT applies and 0 is unlimited. With a certificate_identity the client also authenticates on the internal routes with a client certificate of that URI, DNS name or common name
+// @Tags Client
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param name body string true "<code>required|max:100</code>"
+// @Param scopes body []string true "<code>required</code> users:read, users:write, comments:read, comments:write, media:read, media:write, account:read, account:write, social:read, social:write, conversations:read, conversations:write, billing:read or billing:write"
+// @Param rate_limit body int false "<code>min:0</code>"
+// @Param certificate_identity body string false "<code>max:255</code> like spiffe://cluster.local/ns/billing/sa/worker"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=viewModels.NewClient{record=models.Client}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/clients [post]
+func (a ClientController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.ClientStoreRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	client, secret, err := a.ClientService.Register(auth, request.Body.Name, request.Body.Scopes, request.Body.RateLimit, request.Body.CertificateIdentity)
+	if err != nil {
+		if errors.Is(err, services.ErrIdentityTaken) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(viewModels.NewClient{Record: client, Secret: secret}))
+}
+
+// Destroy godoc
+// @Summary Revoke a client
+// @ID revokeClient
+// @Description the client can no longer get tokens and its tokens stop working at once
+// @Tags Client
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param client path int true "Client ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Client}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/clients/:client [delete]
+func (a ClientController) Destroy(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.ClientDestroyRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var client models.Client
+	client, err = a.ClientService.Revoke(request.PathParams.Client)
+	if err != nil {
+		if errors.Is(err, services.ErrClientNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(client))
+}
+
+// Token godoc
+// @Summary Client credentials grant
+// @ID clientToken
+// @Description the OAuth2 client credentials grant of the internal services. The credentials are sent with HTTP Basic or as client_id and client_secret, the scope is space separated and defaults to every scope of the client. The token is answered as RFC 6749 asks, not in a data envelope, and the problems carry the OAuth2 error
+// @Tags Client
+// @Accept  application/x-www-form-urlencoded
+// @Accept  json
+// @Produce json
+// @Param grant_type body string true "<code>required</code> client_credentials"
+// @Param scope body string false "<code>max:500</code>"
+// @Param client_id body string false "without HTTP Basic"
+// @Param client_secret body string false "without HTTP Basic"
+// @Success 200 {object} services.ClientToken{}
+// @Failure 400 {object} problems.Problem{}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/oauth/token [post]
+func (a ClientController) Token(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.ClientTokenRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v).With("error", "invalid_request")
+	}
+	if request.Body.GrantType != "client_credentials" {
+		return problems.New(problems.GrantTypeUnsupported).With("error", "unsupported_grant_type")
+	}
+
+	clientID, secret, basic := c.Request().BasicAuth()
+	if !basic {
+		clientID, secret = request.Body.ClientID, request.Body.ClientSecret
+	}
+
+	var token services.ClientToken
+	token, err = a.ClientService.Grant(clientID, secret, strings.Fields(request.Body.Scope))
+	if err != nil {
+		if errors.Is(err, services.ErrClientInvalid) {
+			if basic {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="oauth"`)
+			}
+			return problems.New(problems.ClientInvalid).With("error", "invalid_client")
+		}
+		if errors.Is(err, services.ErrClientScopeInvalid) {
+			return problems.New(problems.ClientScopeInvalid).With("error", "invalid_scope")
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	c.Response().Header().Set("Cache-Control", "no-store")
+	c.Response().Header().Set("Pragma", "no-cache")
+	return c.JSON(http.StatusOK, token)
+}
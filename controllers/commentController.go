@@ -0,0 +1,329 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type CommentController struct {
+	CommentService services.ICommentService
+}
+
+// Index godoc
+// @Summary Comments of a commentable
+// @ID listComments
+// @Description a page of the top level comments with their replies, deleted and removed comments stay in the thread without a body
+// @Tags Comment
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type path string true "Commentable type, like users"
+// @Param id path int true "Commentable ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.Comment}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/threads/:type/:id/comments [get]
+func (co CommentController) Index(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.CommentIndexRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var count int64
+	var comments []models.Comment
+	comments, count, err = co.CommentService.Threads(auth, request.PathParams.Type, request.PathParams.ID, &request.QueryParams.Pagination, &request.QueryParams.Order)
+	if err != nil {
+		if errors.Is(err, services.ErrCommentableNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.NewPaginator(c, &request.QueryParams.Pagination, comments, count)))
+}
+
+// Store godoc
+// @Summary Comment a commentable
+// @ID createComment
+// @Description replies to a comment of the same commentable when parent_id is set
+// @Tags Comment
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type path string true "Commentable type, like users"
+// @Param id path int true "Commentable ID"
+// @Param body body string true "<code>required</code> <code>max:5000</code>" maxlength(5000)
+// @Param parent_id body int false "the comment replied to"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=models.Comment}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/threads/:type/:id/comments [post]
+func (co CommentController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.CommentStoreRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var comment models.Comment
+	comment, err = co.CommentService.Create(auth, request.PathParams.Type, request.PathParams.ID, request.Body.ParentID, request.Body.Body)
+	if err != nil {
+		if errors.Is(err, services.ErrCommentableNotFound) || errors.Is(err, services.ErrCommentNotFound) || errors.Is(err, services.ErrCommentTooDeep) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(comment))
+}
+
+// Update godoc
+// @Summary Edit a comment
+// @ID updateComment
+// @Description only the author edits a comment, removed comments can not be edited
+// @Tags Comment
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param comment path int true "Comment ID"
+// @Param body body string true "<code>required</code> <code>max:5000</code>" maxlength(5000)
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Comment}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/comments/:comment [put]
+func (co CommentController) Update(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.CommentUpdateRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var comment models.Comment
+	comment, err = co.CommentService.Update(auth, request.PathParams.Comment, request.Body.Body)
+	if err != nil {
+		if errors.Is(err, services.ErrCommentNotFound) || errors.Is(err, services.ErrCommentNotEditable) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(comment))
+}
+
+// Destroy godoc
+// @Summary Delete a comment
+// @ID deleteComment
+// @Description the author or an admin deletes a comment, its replies stay in the thread
+// @Tags Comment
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param comment path int true "Comment ID"
+// @Success 204
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/comments/:comment [delete]
+func (co CommentController) Destroy(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.CommentModerateRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	if err = co.CommentService.Delete(auth, request.PathParams.Comment); err != nil {
+		if errors.Is(err, services.ErrCommentNotFound) || errors.Is(err, services.ErrCommentNotEditable) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Flag godoc
+// @Summary Flag a comment
+// @ID flagComment
+// @Description reports the comment to the moderators, a comment flagged often enough is hidden until it is reviewed
+// @Tags Comment
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param comment path int true "Comment ID"
+// @Param reason body string false "<code>max:255</code>" maxlength(255)
+// @Success 202 {object} viewModels.HTTPSuccessResponse{}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/comments/:comment/flag [post]
+func (co CommentController) Flag(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.CommentFlagRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	if err = co.CommentService.Flag(auth, request.PathParams.Comment, request.Body.Reason); err != nil {
+		if errors.Is(err, services.ErrCommentNotFound) || errors.Is(err, services.ErrCommentFlagged) || errors.Is(err, services.ErrCommentNotFlaggable) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusAccepted, viewModels.SuccessResponse(nil))
+}
+
+// Queue godoc
+// @Summary Moderation queue of comments
+// @ID listFlaggedComments
+// @Description the hidden comments and the flagged ones not reviewed since, the most flagged first
+// @Tags Comment
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param lite query bool false "true by default, has_next instead of total_record as the comments are not counted"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.Comment}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/comments [get]
+func (co CommentController) Queue(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.CommentQueueRequest)
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+
+	var count int64
+	var comments []models.Comment
+	comments, count, err = co.CommentService.ModerationQueue(&request.QueryParams.Pagination)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.NewPaginator(c, &request.QueryParams.Pagination, comments, count)))
+}
+
+// Approve godoc
+// @Summary Approve a comment
+// @ID approveComment
+// @Description makes the comment visible and clears its flags
+// @Tags Comment
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param comment path int true "Comment ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Comment}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/comments/:comment/approve [post]
+func (co CommentController) Approve(c echo.Context) (err error) {
+	return co.moderate(c, co.CommentService.Approve)
+}
+
+// Remove godoc
+// @Summary Remove a comment
+// @ID removeComment
+// @Description takes the comment down, it stays in its thread without a body
+// @Tags Comment
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param comment path int true "Comment ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Comment}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/comments/:comment/remove [post]
+func (co CommentController) Remove(c echo.Context) (err error) {
+	return co.moderate(c, co.CommentService.Remove)
+}
+
+func (co CommentController) moderate(c echo.Context, fn func(admin models.User, commentID uint) (models.Comment, error)) error {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.CommentModerateRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	comment, err := fn(auth, request.PathParams.Comment)
+	if err != nil {
+		if errors.Is(err, services.ErrCommentNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(comment))
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type ConfigController struct {
+	ConfigService services.IConfigService
+}
+
+// Show godoc
+// @Summary Runtime configuration
+// @ID showConfig
+// @Description the effective configuration of the instance answering and the variables it read with their source (env, file or default), the secrets redacted and the variables holding them as a fingerprint. The diff compares the snapshot the instance loaded at its start with the one loaded last by other instances, the other color of a blue/green deployment or the previous deployment
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.ConfigReport}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/config [get]
+func (s ConfigController) Show(c echo.Context) (err error) {
+	var report services.ConfigReport
+	report, err = s.ConfigService.Report()
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(report))
+}
@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type ConsentController struct {
+	ConsentService services.IConsentService
+}
+
+// Current godoc
+// @Summary Current version of every policy
+// @ID currentPolicies
+// @Description
+// @Tags Policy
+// @Produce json
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Policy}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/policies/current [get]
+func (p ConsentController) Current(c echo.Context) (err error) {
+	var policies []models.Policy
+	policies, err = p.ConsentService.GetCurrentPolicies()
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(policies))
+}
+
+// Accept godoc
+// @Summary Accept the current version of a policy
+// @ID acceptPolicy
+// @Description
+// @Tags Policy
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Consent}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/policies/:policy/accept [post]
+func (p ConsentController) Accept(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.PolicyAcceptRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var consent models.Consent
+	consent, err = p.ConsentService.Accept(auth, request.PathParams.Policy, c.RealIP())
+	if err != nil {
+		if errors.Is(err, services.ErrPolicyNotCurrent) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(consent))
+}
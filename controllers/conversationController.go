@@ -0,0 +1,259 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type ConversationController struct {
+	ConversationService services.IConversationService
+}
+
+// Index godoc
+// @Summary Conversations of the user
+// @ID listConversations
+// @Description the latest messaged first, with their participants and unread count
+// @Tags Conversation
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param lite query bool false "has_next instead of total_record, the records are not counted"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.Conversation}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/conversations [get]
+func (cc ConversationController) Index(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.ConversationIndexRequest)
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+
+	var count int64
+	var conversations []models.Conversation
+	conversations, count, err = cc.ConversationService.Conversations(auth, &request.QueryParams.Pagination)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.NewPaginator(c, &request.QueryParams.Pagination, conversations, count)))
+}
+
+// Store godoc
+// @Summary Start a conversation
+// @ID startConversation
+// @Description with a single recipient the direct conversation of the two users is returned when it exists
+// @Tags Conversation
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param user_ids body []int true "<code>required|max:20</code> recipients"
+// @Param body body string false "<code>max:5000</code> first message"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=models.Conversation}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/conversations [post]
+func (cc ConversationController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.ConversationStoreRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var conversation models.Conversation
+	conversation, err = cc.ConversationService.Start(auth, request.Body.UserIDs, request.Body.Body)
+	if err != nil {
+		if errors.Is(err, services.ErrConversationInvalidRecipients) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(conversation))
+}
+
+// Unread godoc
+// @Summary Unread counters
+// @ID unreadMessages
+// @Description the unread messages of the user, in total and per conversation
+// @Tags Conversation
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.UnreadCounters}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/conversations/unread [get]
+func (cc ConversationController) Unread(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var counters services.UnreadCounters
+	counters, err = cc.ConversationService.Unread(auth)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(counters))
+}
+
+// Messages godoc
+// @Summary Messages of a conversation
+// @ID listMessages
+// @Description the latest first, pass the next_cursor as before to get the older ones
+// @Tags Conversation
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param conversation path int true "Conversation ID"
+// @Param before query int false "Messages sent before this message"
+// @Param limit query int false "<code>max:100</code>, 50 by default"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.CursorPaginator{records=[]models.Message}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/conversations/:conversation/messages [get]
+func (cc ConversationController) Messages(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.MessageIndexRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var messages []models.Message
+	messages, err = cc.ConversationService.Messages(auth, request.PathParams.Conversation, request.QueryParams.Before, request.GetLimit())
+	if err != nil {
+		if errors.Is(err, services.ErrConversationNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	page := viewModels.CursorPaginator{Records: messages, Limit: request.GetLimit()}
+	links := viewModels.NewLinks(c)
+	if len(messages) == request.GetLimit() {
+		page.NextCursor = messages[len(messages)-1].ID
+		links.Query("next", "before", strconv.FormatUint(uint64(page.NextCursor), 10))
+	}
+	page.Links = links.Links()
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(page))
+}
+
+// Send godoc
+// @Summary Send a message
+// @ID sendMessage
+// @Description the message is delivered to the sockets of the participants
+// @Tags Conversation
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param conversation path int true "Conversation ID"
+// @Param body body string true "<code>required|max:5000</code>"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=models.Message}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/conversations/:conversation/messages [post]
+func (cc ConversationController) Send(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.MessageStoreRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var message models.Message
+	message, err = cc.ConversationService.Send(auth, request.PathParams.Conversation, request.Body.Body)
+	if err != nil {
+		if errors.Is(err, services.ErrConversationNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(message))
+}
+
+// Read godoc
+// @Summary Mark a conversation read
+// @ID readConversation
+// @Description marks the messages read up to message_id, every message when it is empty, and returns the unread counters
+// @Tags Conversation
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param conversation path int true "Conversation ID"
+// @Param message_id body int false "Last message read"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.UnreadCounters}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/conversations/:conversation/read [post]
+func (cc ConversationController) Read(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.ConversationReadRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var counters services.UnreadCounters
+	counters, err = cc.ConversationService.Read(auth, request.PathParams.Conversation, request.Body.MessageID)
+	if err != nil {
+		if errors.Is(err, services.ErrConversationNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(counters))
+}
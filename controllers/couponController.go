@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type CouponController struct {
+	CouponService services.ICouponService
+}
+
+// Index godoc
+// @Summary List of coupons
+// @ID listCoupons
+// @Description
+// @Tags Coupon
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Coupon}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/coupons [get]
+func (co CouponController) Index(c echo.Context) (err error) {
+	var coupons []models.Coupon
+	coupons, err = co.CouponService.GetCoupons()
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(coupons))
+}
+
+// Store godoc
+// @Summary Create a coupon
+// @ID createCoupon
+// @Description either percent_off or amount_off with its currency, max_redemptions of 0 is unlimited, max_per_user defaults to 1 (0 is unlimited)
+// @Tags Coupon
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param code body string true "<code>required</code> <code>min:3</code> <code>max:50</code>" minlength(3) maxlength(50)
+// @Param percent_off body int false "<code>min:1</code> <code>max:100</code>"
+// @Param amount_off body string false "decimal amount, like 5.00"
+// @Param currency body string false "ISO 4217 code of amount_off"
+// @Param plan_id body int false "the only plan the coupon applies to"
+// @Param max_redemptions body int false "<code>min:0</code> <code>max:1000000</code>"
+// @Param max_per_user body int false "<code>min:0</code> <code>max:1000</code>"
+// @Param expires_at body string false "ISO 8601 time in the future"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=models.Coupon}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/coupons [post]
+func (co CouponController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.CouponStoreRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	input := services.CouponInput{
+		Code:           request.Body.Code,
+		PercentOff:     request.Body.PercentOff,
+		AmountOff:      request.Amount(),
+		PlanID:         request.Body.PlanID,
+		MaxRedemptions: request.Body.MaxRedemptions,
+		MaxPerUser:     1,
+		ExpiresAt:      request.Body.ExpiresAt,
+	}
+	if request.Body.MaxPerUser != nil {
+		input.MaxPerUser = *request.Body.MaxPerUser
+	}
+
+	var coupon models.Coupon
+	coupon, err = co.CouponService.Create(c.Request().Context(), auth, input)
+	if err != nil {
+		if errors.Is(err, services.ErrCouponCodeTaken) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(coupon))
+}
+
+// Update godoc
+// @Summary Update the limits of a coupon
+// @ID updateCoupon
+// @Description the discount of a coupon can not be changed
+// @Tags Coupon
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param max_redemptions body int false "<code>min:0</code> <code>max:1000000</code>"
+// @Param max_per_user body int false "<code>min:0</code> <code>max:1000</code>"
+// @Param expires_at body string false "ISO 8601 time"
+// @Param active body bool false "active"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Coupon}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/coupons/:coupon [put]
+func (co CouponController) Update(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.CouponUpdateRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var coupon models.Coupon
+	coupon, err = co.CouponService.Update(request.PathParams.Coupon, request.Updates())
+	if err != nil {
+		if errors.Is(err, services.ErrCouponNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(coupon))
+}
+
+// Destroy godoc
+// @Summary Deactivate a coupon
+// @ID deactivateCoupon
+// @Description its redemptions are kept
+// @Tags Coupon
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Coupon}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/coupons/:coupon [delete]
+func (co CouponController) Destroy(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.CouponDestroyRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var coupon models.Coupon
+	coupon, err = co.CouponService.Deactivate(request.PathParams.Coupon)
+	if err != nil {
+		if errors.Is(err, services.ErrCouponNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(coupon))
+}
@@ -0,0 +1,255 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type DeadLetterController struct {
+	DeadLetterService services.IDeadLetterService
+}
+
+// Index godoc
+// @Summary Dead letters
+// @ID listDeadLetters
+// @Description the jobs dropped after their last attempt and the webhooks that could not be applied, the latest first
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param kind query string false "<code>in:job,webhook</code>"
+// @Param source query string false "the queue of the jobs, the provider of the webhooks"
+// @Param before query string false "<code>date:RFC3339</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.DeadLetter}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/dead-letters [get]
+func (d DeadLetterController) Index(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.DeadLetterIndexRequest)
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var count int64
+	var letters []models.DeadLetter
+	letters, count, err = d.DeadLetterService.List(request.QueryParams.GetFilter(), &request.QueryParams.Pagination)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.NewPaginator(c, &request.QueryParams.Pagination, letters, count)))
+}
+
+// Show godoc
+// @Summary Get a dead letter
+// @ID showDeadLetter
+// @Description the payload of the job or of the event of the webhook, with the error of its last run
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param dead_letter path int true "Dead letter ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.DeadLetter}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/dead-letters/:dead_letter [get]
+func (d DeadLetterController) Show(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.DeadLetterShowRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var letter models.DeadLetter
+	letter, err = d.DeadLetterService.Get(request.PathParams.DeadLetter)
+	if err != nil {
+		if errors.Is(err, services.ErrDeadLetterNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(letter))
+}
+
+// Replay godoc
+// @Summary Replay a dead letter
+// @ID replayDeadLetter
+// @Description a job is pushed again to its queue with its attempts, a webhook is applied again. The dead letter replayed is deleted, one failing again keeps the new error
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param dead_letter path int true "Dead letter ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.DeadLetter}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{} "the replay failed again"
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/dead-letters/:dead_letter/replay [post]
+func (d DeadLetterController) Replay(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.DeadLetterShowRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var letter models.DeadLetter
+	letter, err = d.DeadLetterService.Replay(c.Request().Context(), request.PathParams.DeadLetter)
+	if err != nil {
+		if errors.Is(err, services.ErrDeadLetterNotFound) || errors.Is(err, services.ErrDeadLetterReplayFailed) || errors.Is(err, services.ErrDeadLetterNotReplayable) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(letter))
+}
+
+// BulkReplay godoc
+// @Summary Replay dead letters in bulk
+// @ID bulkReplayDeadLetters
+// @Description up to 100 dead letters, the ones of the ids or without them the oldest of the kind and the source. Each one has its own status, 200 with the dead letter replayed or the one of its problem
+// @Tags Admin
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param ids body []int false "<code>max:100</code>"
+// @Param kind body string false "<code>in:job,webhook</code>"
+// @Param source body string false "the queue of the jobs, the provider of the webhooks"
+// @Success 207 {object} viewModels.HTTPSuccessResponse{data=viewModels.Bulk{results=[]viewModels.BulkResult{record=models.DeadLetter}}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/dead-letters/replay [post]
+func (d DeadLetterController) BulkReplay(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.DeadLetterReplayRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var replays []services.DeadLetterReplay
+	replays, err = d.DeadLetterService.ReplayMany(c.Request().Context(), request.Body.IDs, request.GetFilter(), requests.DeadLetterReplayMax)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+	var bulk viewModels.Bulk
+	for _, replay := range replays {
+		if replay.Err != nil {
+			p := problems.FromError(replay.Err)
+			bulk.Add(viewModels.BulkResult{ID: replay.DeadLetter.ID, Status: p.Status, Error: p})
+			continue
+		}
+		bulk.Add(viewModels.BulkResult{ID: replay.DeadLetter.ID, Status: http.StatusOK, Record: replay.DeadLetter})
+	}
+
+	// Response
+	return c.JSON(http.StatusMultiStatus, viewModels.SuccessResponse(bulk))
+}
+
+// Destroy godoc
+// @Summary Delete a dead letter
+// @ID deleteDeadLetter
+// @Description without replaying it
+// @Tags Admin
+// @Param token header string true "Bearer Token"
+// @Param dead_letter path int true "Dead letter ID"
+// @Success 204
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/dead-letters/:dead_letter [delete]
+func (d DeadLetterController) Destroy(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.DeadLetterShowRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	if err = d.DeadLetterService.Delete(request.PathParams.DeadLetter); err != nil {
+		if errors.Is(err, services.ErrDeadLetterNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Purge godoc
+// @Summary Purge dead letters
+// @ID purgeDeadLetters
+// @Description deletes the dead letters of the kind, the source and created before the time without replaying them, every one without filter
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param kind query string false "<code>in:job,webhook</code>"
+// @Param source query string false "the queue of the jobs, the provider of the webhooks"
+// @Param before query string false "<code>date:RFC3339</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.DeadLetterPurge}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/dead-letters [delete]
+func (d DeadLetterController) Purge(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.DeadLetterPurgeRequest)
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var deleted int64
+	deleted, err = d.DeadLetterService.Purge(request.QueryParams.GetFilter())
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.DeadLetterPurge{Deleted: deleted}))
+}
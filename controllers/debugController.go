@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/viewModels"
+)
+
+// the gc pauses returned by Stats, the runtime remembers 256 of them
+const debugPauses = 16
+
+var startedAt = time.Now()
+
+type DebugController struct{}
+
+/**
+ * Pprof
+ * the profiles of net/http/pprof under /debug/pprof, the index lists them. The cpu profile and the trace run for the
+ * seconds of the query (30 and 1 by default), go tool pprof reads them from a file downloaded with the admin token
+ */
+func (DebugController) Pprof(c echo.Context) (err error) {
+	switch c.Param("*") {
+	case "cmdline":
+		pprof.Cmdline(c.Response(), c.Request())
+	case "profile":
+		pprof.Profile(c.Response(), c.Request())
+	case "symbol":
+		pprof.Symbol(c.Response(), c.Request())
+	case "trace":
+		pprof.Trace(c.Response(), c.Request())
+	default:
+		// the index serves the named profiles (heap, goroutine, allocs...) of the path too
+		pprof.Index(c.Response(), c.Request())
+	}
+	return nil
+}
+
+// Vars the variables published with expvar, the memstats and the command line included
+func (DebugController) Vars(c echo.Context) (err error) {
+	expvar.Handler().ServeHTTP(c.Response(), c.Request())
+	return nil
+}
+
+// Stats godoc
+// @Summary Runtime stats of the instance
+// @ID debugStats
+// @Description the goroutines, the heap and the last gc pauses of the instance serving the request, for diagnosing a deployment under load. Served when DEBUG_ENDPOINTS_ENABLED is set
+// @Tags Server
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.RuntimeStats}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Router /debug/stats [get]
+func (DebugController) Stats(c echo.Context) (err error) {
+	var memory runtime.MemStats
+	runtime.ReadMemStats(&memory)
+
+	pauses := make([]float64, 0, debugPauses)
+	for i := 0; i < debugPauses && uint32(i) < memory.NumGC; i++ {
+		// PauseNs is a circular buffer, the most recent pause at (NumGC+255)%256
+		pause := memory.PauseNs[(int(memory.NumGC)-1-i+len(memory.PauseNs))%len(memory.PauseNs)]
+		pauses = append(pauses, time.Duration(pause).Seconds())
+	}
+	var lastAt *time.Time
+	if memory.LastGC > 0 {
+		last := time.Unix(0, int64(memory.LastGC)).UTC()
+		lastAt = &last
+	}
+
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.RuntimeStats{
+		GoVersion:  runtime.Version(),
+		Goroutines: runtime.NumGoroutine(),
+		CPUs:       runtime.NumCPU(),
+		Heap: viewModels.HeapStats{
+			Alloc:    memory.HeapAlloc,
+			Sys:      memory.HeapSys,
+			Idle:     memory.HeapIdle,
+			InUse:    memory.HeapInuse,
+			Released: memory.HeapReleased,
+			Objects:  memory.HeapObjects,
+		},
+		GC: viewModels.GCStats{
+			Cycles:      memory.NumGC,
+			NextTarget:  memory.NextGC,
+			PauseTotal:  time.Duration(memory.PauseTotalNs).Seconds(),
+			Pauses:      pauses,
+			LastAt:      lastAt,
+			CPUFraction: memory.GCCPUFraction,
+		},
+		Uptime: time.Since(startedAt).Seconds(),
+		At:     time.Now().UTC(),
+	}))
+}
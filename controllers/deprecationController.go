@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type DeprecationController struct {
+	DeprecationService services.IDeprecationService
+}
+
+// Index godoc
+// @Summary Deprecated routes
+// @ID listDeprecations
+// @Description the deprecated routes with their sunset and the clients, api keys and users still calling them, the closest sunset first
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param since query string false "<code>date:RFC3339</code> the callers calling since, 30 days ago by default"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]services.DeprecationReport}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/deprecations [get]
+func (d DeprecationController) Index(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.DeprecationIndexRequest)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var reports []services.DeprecationReport
+	reports, err = d.DeprecationService.Report(request.GetSince(time.Now()))
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(reports))
+}
@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/utils"
+	"gotham/viewModels"
+)
+
+type FollowController struct {
+	FollowService services.IFollowService
+}
+
+// Follow godoc
+// @Summary Follow a user
+// @ID follow
+// @Description following twice is a no-op
+// @Tags Follow
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param user path int true "User ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.Relationship}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/:user/follow [post]
+func (f FollowController) Follow(c echo.Context) (err error) {
+	return f.relationship(c, f.FollowService.Follow)
+}
+
+// Unfollow godoc
+// @Summary Unfollow a user
+// @ID unfollow
+// @Description
+// @Tags Follow
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param user path int true "User ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.Relationship}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/:user/follow [delete]
+func (f FollowController) Unfollow(c echo.Context) (err error) {
+	return f.relationship(c, f.FollowService.Unfollow)
+}
+
+// Relationship godoc
+// @Summary Relationship with a user
+// @ID relationship
+// @Description whether the authenticated user follows the user, is followed back, and the counters of the user
+// @Tags Follow
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param user path int true "User ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.Relationship}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/:user/relationship [get]
+func (f FollowController) Relationship(c echo.Context) (err error) {
+	return f.relationship(c, f.FollowService.Relationship)
+}
+
+// Followers godoc
+// @Summary Followers of a user
+// @ID followers
+// @Description the latest followers first
+// @Tags Follow
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param user path int true "User ID"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param lite query bool false "has_next instead of total_record, the records are not counted"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.User}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/:user/followers [get]
+func (f FollowController) Followers(c echo.Context) (err error) {
+	return f.list(c, f.FollowService.Followers)
+}
+
+// Following godoc
+// @Summary Users followed by a user
+// @ID following
+// @Description the latest followed first
+// @Tags Follow
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param user path int true "User ID"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param lite query bool false "has_next instead of total_record, the records are not counted"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.User}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/:user/following [get]
+func (f FollowController) Following(c echo.Context) (err error) {
+	return f.list(c, f.FollowService.Following)
+}
+
+func (f FollowController) relationship(c echo.Context, fn func(user models.User, userID uint) (services.Relationship, error)) error {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.FollowRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	relationship, err := fn(auth, request.PathParams.User)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) || errors.Is(err, services.ErrNotFollowable) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(relationship))
+}
+
+func (f FollowController) list(c echo.Context, fn func(userID uint, pagination utils.IPagination) ([]models.User, int64, error)) error {
+	// Request Bind And Validation
+	request := new(requests.FollowIndexRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	users, count, err := fn(request.PathParams.User, &request.QueryParams.Pagination)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.NewPaginator(c, &request.QueryParams.Pagination, users, count)))
+}
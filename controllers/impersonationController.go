@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type ImpersonationController struct {
+	ImpersonationService services.IImpersonationService
+}
+
+// Store godoc
+// @Summary Impersonate a user
+// @ID impersonateUser
+// @Description issues a short lived token acting as the user, the responses to its requests carry X-Impersonated-By
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=viewModels.Impersonation}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/users/:user/impersonate [post]
+func (i ImpersonationController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.UserImpersonateRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var impersonation services.Impersonation
+	impersonation, err = i.ImpersonationService.Impersonate(auth, request.PathParams.User, c.RealIP())
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) || errors.Is(err, services.ErrNotImpersonable) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(viewModels.Impersonation{
+		AccessToken:    impersonation.AccessToken,
+		AccessTokenExp: impersonation.AccessTokenExp,
+		User:           impersonation.User,
+		ImpersonatedBy: auth.ID,
+	}))
+}
@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+// InternalController serves the internal services authenticated by the client credentials grant, they see the records
+// whole as they act for no user
+type InternalController struct {
+	UserService services.IUserService
+}
+
+// User godoc
+// @Summary Get User for an internal service
+// @ID internalShowUser
+// @Description needs a client token with the users:read scope
+// @Tags Internal
+// @Produce json
+// @Param token header string true "Bearer Token of a client"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 429 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/internal/users/:user [get]
+func (i InternalController) User(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.UserShowRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var user models.User
+	user, err = i.UserService.GetUserByID(request.PathParams.User)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return problems.New(problems.UserNotFound)
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type InvitationController struct {
+	InvitationService services.IInvitationService
+}
+
+// Index godoc
+// @Summary List of invitations
+// @ID listInvitations
+// @Description
+// @Tags Invitation
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Invitation}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/invitations [get]
+func (i InvitationController) Index(c echo.Context) (err error) {
+	var invitations []models.Invitation
+	invitations, err = i.InvitationService.GetInvitations()
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(invitations))
+}
+
+// Store godoc
+// @Summary Create an invitation
+// @ID createInvitation
+// @Description max_uses defaults to 1 (0 is unlimited), without ttl_hours the invitation never expires, with an email only that email can use it
+// @Tags Invitation
+// @Accept  json
+// @Accept  multipart/form-data
+// @Accept  application/x-www-form-urlencoded
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param max_uses body int false "<code>min:0</code> <code>max:10000</code>"
+// @Param ttl_hours body int false "<code>min:0</code> <code>max:8760</code>"
+// @Param email body string false "<code>must be email</code>"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=models.Invitation}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/invitations [post]
+func (i InvitationController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.InvitationStoreRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	maxUses := 1
+	if request.Body.MaxUses != nil {
+		maxUses = *request.Body.MaxUses
+	}
+
+	var invitation models.Invitation
+	invitation, err = i.InvitationService.Create(auth, maxUses, time.Duration(request.Body.TTLHours)*time.Hour, request.Body.Email)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(invitation))
+}
+
+// Destroy godoc
+// @Summary Revoke an invitation
+// @ID revokeInvitation
+// @Description
+// @Tags Invitation
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Invitation}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/invitations/:invitation [delete]
+func (i InvitationController) Destroy(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.InvitationDestroyRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var invitation models.Invitation
+	invitation, err = i.InvitationService.Revoke(request.PathParams.Invitation)
+	if err != nil {
+		if errors.Is(err, services.ErrInvitationNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(invitation))
+}
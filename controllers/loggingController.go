@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/viewModels"
+)
+
+type LoggingController struct {
+	Levels *infrastructures.LogLevels
+	Logger infrastructures.ILogger
+}
+
+// Show godoc
+// @Summary Levels of the logger
+// @ID loggingLevel
+// @Description the level of the entries written and the levels of their components (gorm, http, audit...), of the instance serving the request
+// @Tags Logging
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.LogLevels}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Router /v1/restricted/admin/logging/level [get]
+func (l LoggingController) Show(c echo.Context) (err error) {
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.LogLevels{Level: l.Levels.Level(), Modules: l.Levels.Modules()}))
+}
+
+// Update godoc
+// @Summary Change the levels of the logger
+// @ID updateLoggingLevel
+// @Description replaces the levels without a restart, the components missing from modules get the level. Only the instance serving the request changes, until its restart
+// @Tags Logging
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param level body string true "<code>in:debug,info,warn,error</code>"
+// @Param modules body object false "the levels of the components keyed by component, <code>in:debug,info,warn,error</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.LogLevels}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Router /v1/restricted/admin/logging/level [put]
+func (l LoggingController) Update(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.LoggingLevelUpdateRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	previous := viewModels.LogLevels{Level: l.Levels.Level(), Modules: l.Levels.Modules()}
+	if err = l.Levels.Set(request.Body.Level, request.Body.Modules); err != nil {
+		return problems.New(problems.InvalidInput, err.Error())
+	}
+	current := viewModels.LogLevels{Level: l.Levels.Level(), Modules: l.Levels.Modules()}
+
+	auth := models.ConvertUser(c.Get("auth"))
+	l.Logger.Warn("log levels changed", infrastructures.Fields{
+		"audit":    true,
+		"admin_id": auth.ID,
+		"previous": previous,
+		"current":  current,
+	})
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(current))
+}
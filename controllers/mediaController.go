@@ -0,0 +1,346 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type MediaController struct {
+	MediaService services.IMediaService
+}
+
+// Index godoc
+// @Summary Media of the user
+// @ID listMedia
+// @Description the uploads of the authenticated user, newest first
+// @Tags Media
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param lite query bool false "has_next instead of total_record, the records are not counted"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.Media}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/media [get]
+func (m MediaController) Index(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.MediaIndexRequest)
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+
+	var count int64
+	var media []models.Media
+	media, count, err = m.MediaService.MediaOfUser(auth, &request.QueryParams.Pagination)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.NewPaginator(c, &request.QueryParams.Pagination, media, count)))
+}
+
+// Store godoc
+// @Summary Upload a file
+// @ID uploadMedia
+// @Description the type is detected from the content, unattached uploads are deleted after MEDIA_ORPHAN_TTL
+// @Tags Media
+// @Accept  multipart/form-data
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param file formData file true "<code>required</code>, at most MEDIA_MAX_SIZE bytes of MEDIA_ALLOWED_TYPES"
+// @Param visibility formData string false "<code>in:public,private</code>, private by default"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=models.Media}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 413 {object} problems.Problem{}
+// @Failure 415 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/media [post]
+func (m MediaController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.MediaStoreRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+	header, err := c.FormFile("file")
+	if err != nil {
+		return problems.Validation(validation.Errors{"file": errors.New("cannot be blank")})
+	}
+	file, err := header.Open()
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+	defer file.Close()
+
+	var media models.Media
+	media, err = m.MediaService.Upload(auth, header.Filename, file, request.GetVisibility())
+	if err != nil {
+		if errors.Is(err, services.ErrMediaTooLarge) || errors.Is(err, services.ErrMediaTypeNotAllowed) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(m.resource(c, media)))
+}
+
+// Show godoc
+// @Summary Get a media
+// @ID showMedia
+// @Description public media are readable by every user, private ones by their owner and admins
+// @Tags Media
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param media path int true "Media ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Media}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/media/:media [get]
+func (m MediaController) Show(c echo.Context) (err error) {
+	return m.handle(c, m.MediaService.Get)
+}
+
+// Download godoc
+// @Summary Download a media
+// @ID downloadMedia
+// @Description honors Range with 206 partial contents, If-None-Match and If-Modified-Since with 304, and HEAD. Public media are cached for MEDIA_CACHE_MAX_AGE by shared caches too
+// @Tags Media
+// @Produce octet-stream
+// @Param token header string true "Bearer Token"
+// @Param media path int true "Media ID"
+// @Param Range header string false "Byte ranges, like bytes=0-1023"
+// @Success 200 {file} file
+// @Success 206 {file} file
+// @Success 304
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 416
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/media/:media/download [get]
+func (m MediaController) Download(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.MediaShowRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	media, file, err := m.MediaService.Content(auth, request.PathParams.Media)
+	if err != nil {
+		if errors.Is(err, services.ErrMediaNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	cacheControl := "private"
+	if media.IsPublic() {
+		cacheControl = "public"
+	}
+	return viewModels.File{
+		Name:         media.Name,
+		ContentType:  media.Mime,
+		Disposition:  "inline",
+		CacheControl: fmt.Sprintf("%s, max-age=%d", cacheControl, int(config.Conf.Media.CacheMaxAge.Seconds())),
+	}.Serve(c, file)
+}
+
+// Attach godoc
+// @Summary Attach a media
+// @ID attachMedia
+// @Description attaches the media to an attachable of the user, a media has one attachable
+// @Tags Media
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param media path int true "Media ID"
+// @Param type body string true "Attachable type, like users"
+// @Param id body int true "Attachable ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Media}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/media/:media/attachment [put]
+func (m MediaController) Attach(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.MediaAttachRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var media models.Media
+	media, err = m.MediaService.Attach(auth, request.PathParams.Media, request.Body.Type, request.Body.ID)
+	if err != nil {
+		if errors.Is(err, services.ErrMediaNotFound) || errors.Is(err, services.ErrMediaAttachableNotFound) || errors.Is(err, services.ErrMediaForbidden) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(m.resource(c, media)))
+}
+
+// Detach godoc
+// @Summary Detach a media
+// @ID detachMedia
+// @Description the media is an orphan again and is deleted after MEDIA_ORPHAN_TTL unless it is attached
+// @Tags Media
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param media path int true "Media ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Media}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/media/:media/attachment [delete]
+func (m MediaController) Detach(c echo.Context) (err error) {
+	return m.handle(c, m.MediaService.Detach)
+}
+
+// Destroy godoc
+// @Summary Delete a media
+// @ID deleteMedia
+// @Description the owner or an admin deletes the media and its file
+// @Tags Media
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param media path int true "Media ID"
+// @Success 204
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/media/:media [delete]
+func (m MediaController) Destroy(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.MediaShowRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	if err = m.MediaService.Delete(auth, request.PathParams.Media); err != nil {
+		if errors.Is(err, services.ErrMediaNotFound) || errors.Is(err, services.ErrMediaForbidden) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Usage godoc
+// @Summary Media usage report
+// @ID mediaUsage
+// @Description the files and bytes stored, of the orphans, per mime type and of the users storing the most
+// @Tags Media
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param limit query int false "<code>max:100</code> users, 20 by default"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.MediaUsageReport}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/media/usage [get]
+func (m MediaController) Usage(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.MediaUsageRequest)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var report services.MediaUsageReport
+	report, err = m.MediaService.Usage(request.GetLimit())
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(report))
+}
+
+func (m MediaController) handle(c echo.Context, fn func(user models.User, mediaID uint) (models.Media, error)) error {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.MediaShowRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	media, err := fn(auth, request.PathParams.Media)
+	if err != nil {
+		if errors.Is(err, services.ErrMediaNotFound) || errors.Is(err, services.ErrMediaForbidden) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(m.resource(c, media)))
+}
+
+// resource is the media with the links to itself and its download
+func (m MediaController) resource(c echo.Context, media models.Media) viewModels.Resource {
+	return viewModels.Resource{
+		Record: media,
+		Links:  viewModels.NewLinks(c).Route("self", "media.show", media.ID).Route("download", "media.download", media.ID).Links(),
+	}
+}
@@ -0,0 +1,24 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/infrastructures"
+)
+
+type MetricsController struct {
+	Metrics infrastructures.IMetrics
+}
+
+// Index godoc
+// @Summary Metrics in the prometheus text format
+// @ID metrics
+// @Tags Server
+// @Produce plain
+// @Success 200
+// @Router /status/metrics [get]
+func (m MetricsController) Index(c echo.Context) (err error) {
+	return c.Blob(http.StatusOK, "text/plain; version=0.0.4", []byte(m.Metrics.Render()))
+}
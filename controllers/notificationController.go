@@ -0,0 +1,178 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type NotificationController struct {
+	NotificationService services.INotificationService
+}
+
+// Devices godoc
+// @Summary Devices of the user
+// @ID listDevices
+// @Description the devices receiving the push notifications of the authenticated user
+// @Tags Notification
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Device}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/devices [get]
+func (n NotificationController) Devices(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var devices []models.Device
+	devices, err = n.NotificationService.Devices(auth)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(devices))
+}
+
+// StoreDevice godoc
+// @Summary Register a device
+// @ID registerDevice
+// @Description registers the push token of a device, a token registered by another user moves to the authenticated user
+// @Tags Notification
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param token body string true "<code>required|max:255</code> push token of the device"
+// @Param platform body string true "<code>in:android,ios,web</code>"
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=models.Device}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/devices [post]
+func (n NotificationController) StoreDevice(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.DeviceStoreRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var device models.Device
+	device, err = n.NotificationService.RegisterDevice(auth, request.Body.Token, request.Body.Platform)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(device))
+}
+
+// DestroyDevice godoc
+// @Summary Unregister a device
+// @ID unregisterDevice
+// @Description the device stops receiving push notifications
+// @Tags Notification
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param device path int true "Device ID"
+// @Success 204
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/devices/:device [delete]
+func (n NotificationController) DestroyDevice(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.DeviceDestroyRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	if err = n.NotificationService.UnregisterDevice(auth, request.PathParams.Device); err != nil {
+		if errors.Is(err, services.ErrDeviceNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.NoContent(http.StatusNoContent)
+}
+
+// PushPreferences godoc
+// @Summary Push preferences
+// @ID pushPreferences
+// @Description whether the authenticated user receives each category of push notifications
+// @Tags Notification
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.PushPreferences}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/push-preferences [get]
+func (n NotificationController) PushPreferences(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var preferences services.PushPreferences
+	preferences, err = n.NotificationService.PushPreferences(auth)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(preferences))
+}
+
+// UpdatePushPreferences godoc
+// @Summary Update the push preferences
+// @ID updatePushPreferences
+// @Description opts in or out of categories of push notifications, the categories left out keep their preference
+// @Tags Notification
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param categories body services.PushPreferences true "<code>required</code> messages, follows and announcements, true to receive them"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.PushPreferences}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/push-preferences [put]
+func (n NotificationController) UpdatePushPreferences(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.PushPreferencesUpdateRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var preferences services.PushPreferences
+	preferences, err = n.NotificationService.UpdatePushPreferences(auth, request.Body.Categories)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(preferences))
+}
@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type PhoneController struct {
+	PhoneService services.IPhoneService
+}
+
+// Update godoc
+// @Summary Set the phone number of the authenticated user
+// @ID updatePhone
+// @Description the number is normalized to E.164 and stays unverified until the code sent by sms is verified
+// @Tags Account
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param phone body string true "<code>required</code> <code>E.164</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/phone [put]
+func (p PhoneController) Update(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.PhoneUpdateRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	request.Normalize()
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var user models.User
+	user, err = p.PhoneService.Update(auth, request.Body.Phone)
+	if err != nil {
+		if errors.Is(err, services.ErrPhoneTaken) {
+			return problems.New(problems.PhoneTaken).With("errors", map[string]string{
+				"phone": "the phone number is already used by another account",
+			})
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
+
+// SendCode godoc
+// @Summary Send a new verification code to the phone of the authenticated user
+// @ID sendPhoneCode
+// @Description the previous code stops working
+// @Tags Account
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 202 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{}
+// @Failure 429 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/phone/code [post]
+func (p PhoneController) SendCode(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var user models.User
+	user, err = p.PhoneService.SendCode(auth)
+	if err != nil {
+		if errors.Is(err, services.ErrPhoneNotPending) || errors.Is(err, services.ErrPhoneCodeTooSoon) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusAccepted, viewModels.SuccessResponse(user))
+}
+
+// Verify godoc
+// @Summary Verify the phone of the authenticated user
+// @ID verifyPhone
+// @Description
+// @Tags Account
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param code body string true "<code>required</code> <code>6 digits</code>" minlength(6) maxlength(6)
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/phone/verify [post]
+func (p PhoneController) Verify(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.PhoneVerifyRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var user models.User
+	user, err = p.PhoneService.Verify(auth, request.Body.Code)
+	if err != nil {
+		if errors.Is(err, services.ErrPhoneNotPending) || errors.Is(err, services.ErrPhoneCodeInvalid) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
+
+// Destroy godoc
+// @Summary Remove the phone of the authenticated user
+// @ID deletePhone
+// @Description
+// @Tags Account
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/me/phone [delete]
+func (p PhoneController) Destroy(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	var user models.User
+	user, err = p.PhoneService.Remove(auth)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
@@ -0,0 +1,172 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type ReportController struct {
+	ReportService services.IReportService
+}
+
+// Store godoc
+// @Summary Report a user or a content
+// @ID createReport
+// @Description a user reports a reportable once until its report is closed
+// @Tags Report
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type path string true "Reportable type, like users or comments"
+// @Param id path int true "Reportable ID"
+// @Param reason body string true "<code>required</code> <code>in:spam,harassment,hate,inappropriate,other</code>"
+// @Param details body string false "<code>max:1000</code>" maxlength(1000)
+// @Success 201 {object} viewModels.HTTPSuccessResponse{data=models.Report}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/reports/:type/:id [post]
+func (r ReportController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.ReportStoreRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var report models.Report
+	report, err = r.ReportService.Report(auth, request.PathParams.Type, request.PathParams.ID, request.Body.Reason, request.Body.Details)
+	if err != nil {
+		if errors.Is(err, services.ErrReportableNotFound) || errors.Is(err, services.ErrAlreadyReported) || errors.Is(err, services.ErrNotReportable) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusCreated, viewModels.SuccessResponse(report))
+}
+
+// Index godoc
+// @Summary Moderation queue of reports
+// @ID listReports
+// @Description the reports with the status, open by default, oldest first
+// @Tags Report
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param status query string false "<code>in:open,resolved,dismissed</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.Report}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/reports [get]
+func (r ReportController) Index(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.ReportIndexRequest)
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var count int64
+	var reports []models.Report
+	reports, count, err = r.ReportService.Queue(request.GetStatus(), &request.QueryParams.Pagination)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.NewPaginator(c, &request.QueryParams.Pagination, reports, count)))
+}
+
+// Resolve godoc
+// @Summary Resolve a report
+// @ID resolveReport
+// @Description upholds the report against the offender, repeat offenders are suspended automatically
+// @Tags Report
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param report path int true "Report ID"
+// @Param note body string false "<code>max:1000</code>" maxlength(1000)
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Report}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/reports/:report/resolve [post]
+func (r ReportController) Resolve(c echo.Context) (err error) {
+	return r.close(c, r.ReportService.Resolve)
+}
+
+// Dismiss godoc
+// @Summary Dismiss a report
+// @ID dismissReport
+// @Description closes the report without holding it against the offender
+// @Tags Report
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param report path int true "Report ID"
+// @Param note body string false "<code>max:1000</code>" maxlength(1000)
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Report}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/reports/:report/dismiss [post]
+func (r ReportController) Dismiss(c echo.Context) (err error) {
+	return r.close(c, r.ReportService.Dismiss)
+}
+
+func (r ReportController) close(c echo.Context, fn func(admin models.User, reportID uint, note string) (models.Report, error)) error {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.ReportCloseRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	report, err := fn(auth, request.PathParams.Report, request.Body.Note)
+	if err != nil {
+		if errors.Is(err, services.ErrReportNotFound) || errors.Is(err, services.ErrReportClosed) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(report))
+}
@@ -6,12 +6,14 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"gotham/problems"
 	"gotham/viewModels"
 )
 
 type ServerController struct{}
 
 // Ping godoc
+// @ID ping
 // @Tags Server
 // @Success 200 {object} viewModels.Message{}
 // @Failure 500
@@ -21,6 +23,7 @@ func (ServerController) Ping(c echo.Context) (err error) {
 }
 
 // Version godoc
+// @ID version
 // @Tags Server
 // @Success 200 {object} viewModels.Message{}
 // @Failure 500
@@ -30,3 +33,14 @@ func (ServerController) Version(c echo.Context) (err error) {
 		"version": os.Getenv("VERSION"),
 	})
 }
+
+// Errors godoc
+// @Summary Catalog of every error code
+// @ID errors
+// @Description Every problem+json response carries one of these codes, clients should branch on the code instead of the message
+// @Tags Server
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]problems.Code}
+// @Router /errors [get]
+func (ServerController) Errors(c echo.Context) (err error) {
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(problems.Catalog()))
+}
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type SettingController struct {
+	SettingService services.ISettingService
+}
+
+// Index godoc
+// @Summary List of settings
+// @ID listSettings
+// @Description
+// @Tags Setting
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Setting}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/settings [get]
+func (s SettingController) Index(c echo.Context) (err error) {
+	var settings []models.Setting
+	settings, err = s.SettingService.GetSettings()
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(settings))
+}
+
+// Update godoc
+// @Summary Update a setting
+// @ID updateSetting
+// @Description the value is validated against the type of the setting
+// @Tags Setting
+// @Accept  json
+// @Accept  multipart/form-data
+// @Accept  application/x-www-form-urlencoded
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param value body string true "Value"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.Setting}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/settings/:setting [put]
+func (s SettingController) Update(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.SettingUpdateRequest)
+
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var setting models.Setting
+	setting, err = s.SettingService.Update(request.PathParams.Setting, request.Body.Value)
+	if err != nil {
+		if errors.Is(err, services.ErrSettingNotFound) || errors.Is(err, services.ErrSettingInvalid) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(setting))
+}
@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type SloController struct {
+	SloService services.ISloService
+}
+
+// Index godoc
+// @Summary Service level objectives
+// @ID listSlos
+// @Description the compliance of the routes with an objective over SLO_WINDOW, their error budget left and their burn rates over the last hour and 5 minutes. The requests are counted by each instance since its start, the one answering reports its own
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]services.SloReport}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/slo [get]
+func (s SloController) Index(c echo.Context) (err error) {
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(s.SloService.Report()))
+}
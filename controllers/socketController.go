@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"github.com/labstack/echo/v4"
+	"golang.org/x/net/websocket"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type SocketController struct {
+	Hub infrastructures.IHub
+}
+
+// Connect godoc
+// @Summary Real time events
+// @ID connectSocket
+// @Description upgrades to a websocket receiving the events of the user as json {type, data}, like message.created
+// @Tags Socket
+// @Param token header string true "Bearer Token"
+// @Success 101
+// @Failure 401 {object} problems.Problem{}
+// @Router /v1/restricted/ws [get]
+func (s SocketController) Connect(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// the token authenticates the handshake, the origin is left to the cors middleware
+	server := websocket.Server{Handler: func(conn *websocket.Conn) {
+		s.Hub.Serve(auth.ID, conn)
+	}}
+	server.ServeHTTP(c.Response(), c.Request())
+	return nil
+}
@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type SuspensionController struct {
+	SuspensionService services.ISuspensionService
+}
+
+// Store godoc
+// @Summary Suspend a user
+// @ID suspendUser
+// @Description without until the suspension lasts until the user is unsuspended
+// @Tags Admin
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param until body string false "<code>RFC3339</code> <code>in the future</code>"
+// @Param reason body string false "<code>max:500</code>" maxlength(500)
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/users/:user/suspension [post]
+func (s SuspensionController) Store(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.UserSuspendRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var user models.User
+	user, err = s.SuspensionService.Suspend(auth, request.PathParams.User, request.Body.Until, request.Body.Reason)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) || errors.Is(err, services.ErrNotSuspendable) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
+
+// Destroy godoc
+// @Summary Unsuspend a user
+// @ID unsuspendUser
+// @Description
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/users/:user/suspension [delete]
+func (s SuspensionController) Destroy(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.UserUnsuspendRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var user models.User
+	user, err = s.SuspensionService.Unsuspend(auth, request.PathParams.User)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type TagController struct {
+	TagService services.ITagService
+}
+
+// Popular godoc
+// @Summary Popular tags
+// @ID popularTags
+// @Description the most used tags with the number of taggables, of every type when type is empty
+// @Tags Tag
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type query string false "Taggable type, like users"
+// @Param limit query int false "<code>max:100</code>, 20 by default"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.PopularTag}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/tags/popular [get]
+func (t TagController) Popular(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.TagPopularRequest)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var tags []models.PopularTag
+	tags, err = t.TagService.Popular(request.QueryParams.Type, request.GetLimit())
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(tags))
+}
+
+// Show godoc
+// @Summary Tags of a taggable
+// @ID showTags
+// @Description
+// @Tags Tag
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type path string true "Taggable type, like users"
+// @Param id path int true "Taggable ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Tag}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/tags/:type/:id [get]
+func (t TagController) Show(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.TagIndexRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var tags []models.Tag
+	tags, err = t.TagService.Tags(request.PathParams.Type, request.PathParams.ID)
+	if err != nil {
+		if errors.Is(err, services.ErrTaggableNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(tags))
+}
+
+// Attach godoc
+// @Summary Attach tags
+// @ID attachTags
+// @Description the owner of the taggable or an admin adds the tags, unknown tags are created
+// @Tags Tag
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type path string true "Taggable type, like users"
+// @Param id path int true "Taggable ID"
+// @Param tags body []string true "<code>max:20</code> names of <code>max:50</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Tag}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/tags/:type/:id [post]
+func (t TagController) Attach(c echo.Context) (err error) {
+	return t.change(c, t.TagService.Attach)
+}
+
+// Detach godoc
+// @Summary Detach tags
+// @ID detachTags
+// @Description the owner of the taggable or an admin removes the tags
+// @Tags Tag
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type path string true "Taggable type, like users"
+// @Param id path int true "Taggable ID"
+// @Param tags body []string true "<code>max:20</code> names of <code>max:50</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Tag}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/tags/:type/:id [delete]
+func (t TagController) Detach(c echo.Context) (err error) {
+	return t.change(c, t.TagService.Detach)
+}
+
+// Sync godoc
+// @Summary Replace the tags
+// @ID syncTags
+// @Description the owner of the taggable or an admin replaces its tags, an empty list removes them all
+// @Tags Tag
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type path string true "Taggable type, like users"
+// @Param id path int true "Taggable ID"
+// @Param tags body []string true "<code>max:20</code> names of <code>max:50</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]models.Tag}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/tags/:type/:id [put]
+func (t TagController) Sync(c echo.Context) (err error) {
+	return t.change(c, t.TagService.Sync)
+}
+
+func (t TagController) change(c echo.Context, fn func(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error)) error {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.TagUpdateRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	tags, err := fn(auth, request.PathParams.Type, request.PathParams.ID, request.Body.Tags)
+	if err != nil {
+		if errors.Is(err, services.ErrTaggableNotFound) || errors.Is(err, services.ErrNotTaggable) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(tags))
+}
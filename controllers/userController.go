@@ -1,25 +1,40 @@
 package controllers
 
 import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
 	"net/http"
+	"sort"
+	"strings"
 
+	"github.com/go-ozzo/ozzo-validation"
 	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
 
+	"gotham/config"
 	"gotham/models"
+	"gotham/pagination"
+	"gotham/patch"
 	"gotham/policies"
+	"gotham/problems"
+	"gotham/repositories"
 	"gotham/requests"
 	"gotham/services"
 	"gotham/viewModels"
 )
 
 type UserController struct {
-	UserService services.IUserService
+	UserService           services.IUserService
+	UserProjectionService services.IUserProjectionService
 
 	UserPolicy policies.IUserPolicy
 }
 
 // Index godoc
 // @Summary List of users
+// @ID listUsers
 // @Description
 // @Tags User
 // @Accept  json
@@ -27,43 +42,423 @@ type UserController struct {
 // @Accept  application/x-www-form-urlencoded
 // @Produce json
 // @Param token header string true "Bearer Token"
-// @Success 200 {object} viewModels.Paginator{data=[]models.User}
-// @Failure 400 {object} viewModels.Message{}
-// @Failure 401 {object} viewModels.Message{}
-// @Failure 500 {object} viewModels.Message{}
-// @Router /v1/r/users [get]
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param lite query bool false "has_next instead of total_record, the records are not counted"
+// @Param cursor query string false "next_cursor of the previous page, the users after it by (created_at, id)"
+// @Param tags query string false "comma separated tags, the users have every tag"
+// @Param ids query string false "comma separated ids, a batch of the users of the ids instead of a page, see batchUsers"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.User}} "a viewModels.KeysetPaginator with a cursor"
+// @Failure 400 {object} problems.Problem{}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users [get]
 func (u UserController) Index(c echo.Context) (err error) {
 	auth := models.ConvertUser(c.Get("auth"))
 
+	// Batch of the users of the ids
+	if _, ok := c.QueryParams()["ids"]; ok {
+		return u.Batch(c)
+	}
+
 	// Request Bind And Validation
 	request := new(requests.UserIndexRequest)
-	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
 		return err
 	}
 
 	// Policy Control
 	if !u.UserPolicy.Index(auth) {
-		return c.JSON(http.StatusForbidden, viewModels.MResponse("unauthorized transaction detected "))
+		return problems.New(problems.Forbidden)
+	}
+
+	// Keyset pages, the cost of a page does not grow with its depth
+	if request.QueryParams.Pagination.Cursor != "" {
+		cursor, err := pagination.Decode(request.QueryParams.Pagination.Cursor, []byte(config.Conf.SecretKey), usersCursorScope(request.GetTags()))
+		if err != nil {
+			return problems.Validation(validation.Errors{"cursor": errors.New("must be a cursor returned by the api")})
+		}
+		users, next, err := u.UserService.PaginateUsers(pagination.After(cursor, request.QueryParams.Pagination.GetLimit()), request.GetTags())
+		if err != nil {
+			return echo.ErrInternalServerError
+		}
+		page := viewModels.KeysetPaginator{Records: u.visible(auth, users...), Limit: request.QueryParams.Pagination.GetLimit()}
+		links := viewModels.NewLinks(c)
+		if next != nil {
+			page.NextCursor = next.Encode([]byte(config.Conf.SecretKey), usersCursorScope(request.GetTags()))
+			links.Query("next", "cursor", page.NextCursor)
+		}
+		page.Links = links.Links()
+		return c.JSON(http.StatusOK, viewModels.SuccessResponse(page))
 	}
 
 	var count int64
 	var users []models.User
-	users, count, err = u.UserService.GetUsersWithPaginationAndOrder(&request.QueryParams.Pagination, &request.QueryParams.Order)
+	users, count, err = u.UserService.GetUsersWithPaginationAndOrder(&request.QueryParams.Pagination, &request.QueryParams.Order, request.GetTags())
 	if err != nil {
 		return echo.ErrInternalServerError
 	}
 
 	// Response
-	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.Paginator{
-		TotalRecord: count,
-		Records:     users,
-		Limit:       request.QueryParams.Pagination.GetLimit(),
-		Page:        request.QueryParams.Pagination.GetPage(),
-	}))
+	page := viewModels.NewPaginator(c, &request.QueryParams.Pagination, u.visible(auth, users...), count)
+	if descending, ok := pagination.Ordering(&request.QueryParams.Order); ok && len(users) > 0 && request.QueryParams.Pagination.More(count) {
+		last := users[len(users)-1]
+		page.NextCursor = pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.ID, Descending: descending}.Encode([]byte(config.Conf.SecretKey), usersCursorScope(request.GetTags()))
+	}
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(page))
+}
+
+// usersCursorScope binds the cursors of the user index to its filters, a cursor of a listing of other tags is invalid
+func usersCursorScope(tags []string) string {
+	return "users?tags=" + strings.Join(tags, ",")
+}
+
+// Search godoc
+// @Summary Search the users
+// @ID searchUsers
+// @Description the users from their search views, kept up to date from the user, tag and follow events so the views can lag behind the users for a moment. The latest users first
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param q query string false "part of the name or of the email, <code>max:100</code>"
+// @Param tag query string false "tag of the users"
+// @Param verified query bool false "verified users, unverified ones when false"
+// @Param suspended query bool false "suspended users, the others when false"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param lite query bool false "has_next instead of total_record, the records are not counted"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.UserSearchView}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/users [get]
+func (u UserController) Search(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.UserSearchRequest)
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var count int64
+	var views []models.UserSearchView
+	views, count, err = u.UserProjectionService.Search(repositories.UserViewFilter{
+		Query:     request.QueryParams.Q,
+		Tag:       request.GetTag(),
+		Verified:  request.GetVerified(),
+		Suspended: request.GetSuspended(),
+	}, &request.QueryParams.Pagination)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.NewPaginator(c, &request.QueryParams.Pagination, views, count)))
+}
+
+// Batch godoc
+// @Summary Get users by their ids
+// @ID batchUsers
+// @Description the users of the ids in one query, in the order of the ids without their duplicates. The ids of the users that were not found are missing, the response of the others is a 200 still. Up to 100 ids in the url of GET /v1/restricted/users?ids=1,2,3, a longer list up to 1000 is posted
+// @Tags User
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param ids body []int true "<code>required|max:1000</code>"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Batch{records=[]models.User}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/batch [post]
+func (u UserController) Batch(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.UserBatchRequest)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+		return err
+	}
+	if c.Request().Method == http.MethodPost {
+		if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+			return err
+		}
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	// Policy Control
+	if !u.UserPolicy.Index(auth) {
+		return problems.New(problems.Forbidden)
+	}
+
+	users, missing, err := u.UserService.GetUsersByIDs(request.GetIDs())
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.Batch{Records: u.visible(auth, users...), Missing: missing}))
+}
+
+// BulkUpdate godoc
+// @Summary Update users in bulk
+// @ID bulkUpdateUsers
+// @Description up to 100 operations changing the name, verified, timezone and quotas of the users, the fields left out are not changed. The operations are run in one transaction, a failed one is rolled back alone: each one has its own status and the user or the problem in the results
+// @Tags User
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param X-Dry-Run header bool false "runs the request in a transaction rolled back and answers what would have happened"
+// @Param operations body []requests.UserBulkUpdateOperation true "<code>required|max:100</code>"
+// @Success 207 {object} viewModels.HTTPSuccessResponse{data=viewModels.Bulk{results=[]viewModels.BulkResult{record=models.User}}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/bulk [patch]
+func (u UserController) BulkUpdate(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.UserBulkUpdateRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	bulk, err := runBulk(request.Body.Operations,
+		func(operation requests.UserBulkUpdateOperation) uint { return operation.ID },
+		func(operations []requests.UserBulkUpdateOperation) ([]services.BulkResult, error) {
+			updates := make([]services.UserUpdate, 0, len(operations))
+			for _, operation := range operations {
+				updates = append(updates, services.UserUpdate{
+					ID:           operation.ID,
+					Name:         operation.Name,
+					Verified:     operation.Verified,
+					Timezone:     operation.Timezone,
+					DailyQuota:   operation.DailyQuota,
+					MonthlyQuota: operation.MonthlyQuota,
+				})
+			}
+			return u.UserService.BulkUpdate(c.Request().Context(), auth, updates)
+		},
+		func(user models.User) viewModels.BulkResult {
+			return viewModels.BulkResult{ID: user.ID, Status: http.StatusOK, Record: user}
+		},
+	)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusMultiStatus, viewModels.SuccessResponse(bulk))
+}
+
+// BulkDestroy godoc
+// @Summary Delete users in bulk
+// @ID bulkDeleteUsers
+// @Description up to 100 operations deleting users, the admins can not be deleted. The operations are run in one transaction, a failed one is rolled back alone: each one has its own status, 204 or the one of its problem
+// @Tags User
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param X-Dry-Run header bool false "runs the request in a transaction rolled back and answers what would have happened"
+// @Param operations body []requests.UserBulkDeleteOperation true "<code>required|max:100</code>"
+// @Success 207 {object} viewModels.HTTPSuccessResponse{data=viewModels.Bulk}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/bulk [delete]
+func (u UserController) BulkDestroy(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.UserBulkDeleteRequest)
+	if err := (&echo.DefaultBinder{}).BindBody(c, &request.Body); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	bulk, err := runBulk(request.Body.Operations,
+		func(operation requests.UserBulkDeleteOperation) uint { return operation.ID },
+		func(operations []requests.UserBulkDeleteOperation) ([]services.BulkResult, error) {
+			ids := make([]uint, 0, len(operations))
+			for _, operation := range operations {
+				ids = append(ids, operation.ID)
+			}
+			return u.UserService.BulkDelete(c.Request().Context(), auth, ids)
+		},
+		func(user models.User) viewModels.BulkResult {
+			return viewModels.BulkResult{ID: user.ID, Status: http.StatusNoContent}
+		},
+	)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusMultiStatus, viewModels.SuccessResponse(bulk))
+}
+
+// maxPatchSize is the largest patch document read
+const maxPatchSize = 64 << 10
+
+// Patch godoc
+// @Summary Patch a user
+// @ID patchUser
+// @Description a merge patch (RFC 7386, application/merge-patch+json or application/json) or a json patch (RFC 6902, application/json-patch+json) of the name, image, timezone, verified, admin, daily_quota and monthly_quota of the user. A user patches its name, image and timezone, the other fields are patched by the admins
+// @Tags User
+// @Accept  application/merge-patch+json
+// @Accept  application/json-patch+json
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param X-Dry-Run header bool false "runs the request in a transaction rolled back and answers what would have happened"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{} "PATCH_004_FORBIDDEN_FIELDS with the fields in fields"
+// @Failure 404 {object} problems.Problem{}
+// @Failure 409 {object} problems.Problem{} "a test operation failed or a path is missing"
+// @Failure 413 {object} problems.Problem{} "a patch document larger than 64KB"
+// @Failure 415 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/:user [patch]
+func (u UserController) Patch(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.UserPatchRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxPatchSize+1))
+	if err != nil {
+		return echo.ErrBadRequest
+	}
+	if len(body) > maxPatchSize {
+		return problems.New(problems.PatchTooLarge)
+	}
+
+	user, err := u.UserService.GetUserByID(request.PathParams.User)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return services.ErrUserNotFound
+	} else if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Policy Control
+	if !u.UserPolicy.Patch(auth, user) {
+		return problems.New(problems.Forbidden)
+	}
+
+	// Patch the document of the user
+	before := requests.NewUserPatch(user)
+	document, _ := json.Marshal(before)
+	patched, err := patch.Patch(mediaType, document, body)
+	switch {
+	case errors.Is(err, patch.ErrUnsupported):
+		return problems.New(problems.PatchUnsupported)
+	case errors.Is(err, patch.ErrConflict):
+		return problems.New(problems.PatchConflict, err.Error())
+	case err != nil:
+		return problems.New(problems.PatchInvalid, err.Error())
+	}
+	after, err := requests.DecodeUserPatch(patched)
+	if err != nil {
+		return problems.Validation(err)
+	}
+	changes := before.Changes(after)
+
+	// Field Policy Control
+	fields := make([]string, 0, len(changes))
+	for field := range changes {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	if forbidden := u.UserPolicy.PatchFields(auth, user, fields); len(forbidden) > 0 {
+		return problems.New(problems.PatchForbidden).With("fields", forbidden)
+	}
+
+	user, err = u.UserService.PatchUser(c.Request().Context(), auth, user, changes)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(u.visible(auth, user)[0]))
+}
+
+// visible are the users without the fields the policy hides from the auth user
+func (u UserController) visible(auth models.User, users ...models.User) []viewModels.Visible {
+	records := make([]viewModels.Visible, len(users))
+	for i, user := range users {
+		records[i] = viewModels.Visible{Record: user, Hidden: u.UserPolicy.HiddenFields(auth, user)}
+	}
+	return records
+}
+
+/**
+ * runBulk
+ * the multi-status of the operations of a bulk request: an invalid operation fails with its validation problem, the
+ * valid ones are run together and succeed or fail with the problem of their error
+ */
+func runBulk[O validation.Validatable](operations []O, id func(O) uint, run func([]O) ([]services.BulkResult, error), succeeded func(models.User) viewModels.BulkResult) (bulk viewModels.Bulk, err error) {
+	results := make([]viewModels.BulkResult, len(operations))
+	var valid []O
+	var positions []int
+	for i, operation := range operations {
+		if v := operation.Validate(); v != nil {
+			results[i] = viewModels.BulkResult{ID: id(operation), Status: http.StatusUnprocessableEntity, Error: problems.Validation(v)}
+			continue
+		}
+		valid = append(valid, operation)
+		positions = append(positions, i)
+	}
+
+	if len(valid) > 0 {
+		ran, err := run(valid)
+		if err != nil {
+			return bulk, err
+		}
+		for j, result := range ran {
+			if result.Err != nil {
+				p := problems.FromError(result.Err)
+				results[positions[j]] = viewModels.BulkResult{ID: id(valid[j]), Status: p.Status, Error: p}
+				continue
+			}
+			results[positions[j]] = succeeded(result.User)
+		}
+	}
+
+	for _, result := range results {
+		bulk.Add(result)
+	}
+	return bulk, nil
 }
 
 // Show godoc
 // @Summary Get User
+// @ID showUser
 // @Description
 // @Tags User
 // @Accept  json
@@ -71,13 +466,13 @@ func (u UserController) Index(c echo.Context) (err error) {
 // @Accept  application/x-www-form-urlencoded
 // @Produce json
 // @Param token header string true "Bearer Token"
-// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
-// @Failure 404 {object} viewModels.Message{}
-// @Failure 401 {object} viewModels.Message{}
-// @Failure 400 {object} viewModels.Message{}
-// @Failure 403 {object} viewModels.Message{}
-// @Failure 500 {object} viewModels.Message{}
-// @Router /v1/r/users/:user [get]
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User} "with the _links of its followers, following, relationship and comments"
+// @Failure 404 {object} problems.Problem{}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 400 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/users/:user [get]
 func (u UserController) Show(c echo.Context) (err error) {
 	auth := models.ConvertUser(c.Get("auth"))
 
@@ -95,7 +490,7 @@ func (u UserController) Show(c echo.Context) (err error) {
 
 	v := request.Validate()
 	if v != nil {
-		return c.JSON(http.StatusUnprocessableEntity, viewModels.ValidationResponse(v))
+		return problems.Validation(v)
 	}
 
 	var user models.User
@@ -106,9 +501,17 @@ func (u UserController) Show(c echo.Context) (err error) {
 
 	// Policy Control
 	if !u.UserPolicy.Show(auth, user) {
-		return c.JSON(http.StatusForbidden, viewModels.MResponse("unauthorized transaction detected "))
+		return problems.New(problems.Forbidden)
 	}
 
 	// Response
-	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.Resource{
+		Record: u.visible(auth, user)[0],
+		Links: viewModels.NewLinks(c).
+			Route("followers", "users.followers", user.ID).
+			Route("following", "users.following", user.ID).
+			Route("relationship", "users.relationship", user.ID).
+			Route("comments", "comments.index", "users", user.ID).
+			Links(),
+	}))
 }
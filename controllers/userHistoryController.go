@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type UserHistoryController struct {
+	UserHistoryService services.IUserHistoryService
+}
+
+// Index godoc
+// @Summary History of a user
+// @ID listUserVersions
+// @Description the state of the user after each of its changes, the latest first
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param user path int true "User ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=viewModels.Paginator{records=[]models.UserVersion}}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/users/:user/versions [get]
+func (h UserHistoryController) Index(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.UserVersionIndexRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := requests.BindQuery(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var count int64
+	var versions []models.UserVersion
+	versions, count, err = h.UserHistoryService.Versions(request.PathParams.User, &request.QueryParams.Pagination)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(viewModels.NewPaginator(c, &request.QueryParams.Pagination, versions, count)))
+}
+
+// Diff godoc
+// @Summary Changes of a user since a version
+// @ID diffUserVersion
+// @Description the fields changed from the version to the version with, to the current user without it
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param user path int true "User ID"
+// @Param version path int true "Version ID"
+// @Param with query int false "Version ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=[]services.UserChange}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/users/:user/versions/:version/diff [get]
+func (h UserHistoryController) Diff(c echo.Context) (err error) {
+	// Request Bind And Validation
+	request := new(requests.UserVersionDiffRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &request.QueryParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var with *uint
+	if request.QueryParams.With != 0 {
+		with = &request.QueryParams.With
+	}
+	var changes []services.UserChange
+	changes, err = h.UserHistoryService.Diff(request.PathParams.User, request.PathParams.Version, with)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) || errors.Is(err, services.ErrUserVersionNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(changes))
+}
+
+// Restore godoc
+// @Summary Restore a user to a version
+// @ID restoreUserVersion
+// @Description sets the name, image, timezone, verified, admin and quotas of the user back to the version, the restore is a new version
+// @Tags Admin
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param user path int true "User ID"
+// @Param version path int true "Version ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=models.User}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 403 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 422 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/admin/users/:user/versions/:version/restore [post]
+func (h UserHistoryController) Restore(c echo.Context) (err error) {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.UserVersionRestoreRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	v := request.Validate()
+	if v != nil {
+		return problems.Validation(v)
+	}
+
+	var user models.User
+	user, err = h.UserHistoryService.Restore(auth, request.PathParams.User, request.PathParams.Version)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) || errors.Is(err, services.ErrUserVersionNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(user))
+}
@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/requests"
+	"gotham/services"
+	"gotham/viewModels"
+)
+
+type VoteController struct {
+	VoteService services.IVoteService
+}
+
+// Show godoc
+// @Summary Votes of a votable
+// @ID showVotes
+// @Description the counters of the votable and the vote of the authenticated user (1 like, -1 dislike, 0 none)
+// @Tags Vote
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type path string true "Votable type, like users"
+// @Param id path int true "Votable ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.VoteSummary}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/votes/:type/:id [get]
+func (v VoteController) Show(c echo.Context) (err error) {
+	return v.handle(c, func(auth models.User, request *requests.VoteRequest) (services.VoteSummary, error) {
+		return v.VoteService.Summary(auth, request.PathParams.Type, request.PathParams.ID)
+	})
+}
+
+// Like godoc
+// @Summary Like a votable
+// @ID like
+// @Description replaces a dislike of the user
+// @Tags Vote
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type path string true "Votable type, like users"
+// @Param id path int true "Votable ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.VoteSummary}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/votes/:type/:id/like [put]
+func (v VoteController) Like(c echo.Context) (err error) {
+	return v.vote(c, models.VoteLike)
+}
+
+// Dislike godoc
+// @Summary Dislike a votable
+// @ID dislike
+// @Description replaces a like of the user
+// @Tags Vote
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type path string true "Votable type, like users"
+// @Param id path int true "Votable ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.VoteSummary}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/votes/:type/:id/dislike [put]
+func (v VoteController) Dislike(c echo.Context) (err error) {
+	return v.vote(c, models.VoteDislike)
+}
+
+// Destroy godoc
+// @Summary Remove the vote on a votable
+// @ID unvote
+// @Description
+// @Tags Vote
+// @Produce json
+// @Param token header string true "Bearer Token"
+// @Param type path string true "Votable type, like users"
+// @Param id path int true "Votable ID"
+// @Success 200 {object} viewModels.HTTPSuccessResponse{data=services.VoteSummary}
+// @Failure 401 {object} problems.Problem{}
+// @Failure 404 {object} problems.Problem{}
+// @Failure 500 {object} problems.Problem{}
+// @Router /v1/restricted/votes/:type/:id [delete]
+func (v VoteController) Destroy(c echo.Context) (err error) {
+	return v.vote(c, 0)
+}
+
+func (v VoteController) vote(c echo.Context, value int) error {
+	return v.handle(c, func(auth models.User, request *requests.VoteRequest) (services.VoteSummary, error) {
+		return v.VoteService.Vote(auth, request.PathParams.Type, request.PathParams.ID, value)
+	})
+}
+
+func (v VoteController) handle(c echo.Context, fn func(auth models.User, request *requests.VoteRequest) (services.VoteSummary, error)) error {
+	auth := models.ConvertUser(c.Get("auth"))
+
+	// Request Bind And Validation
+	request := new(requests.VoteRequest)
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &request.PathParams); err != nil {
+		return err
+	}
+	valid := request.Validate()
+	if valid != nil {
+		return problems.Validation(valid)
+	}
+
+	summary, err := fn(auth, request)
+	if err != nil {
+		if errors.Is(err, services.ErrVotableNotFound) {
+			return err
+		}
+		return echo.ErrInternalServerError
+	}
+
+	// Response
+	return c.JSON(http.StatusOK, viewModels.SuccessResponse(summary))
+}
@@ -8,5 +8,32 @@ import (
 func Initialize() {
 	if *flags.Migrate {
 		_ = app.Application.Container.GetUserRepository().Migrate()
+		_ = app.Application.Container.GetUserVersionRepository().Migrate()
+		_ = app.Application.Container.GetDataExportRepository().Migrate()
+		_ = app.Application.Container.GetPolicyRepository().Migrate()
+		_ = app.Application.Container.GetSettingRepository().Migrate()
+		_ = app.Application.Container.GetInvitationRepository().Migrate()
+		_ = app.Application.Container.GetUsageRepository().Migrate()
+		_ = app.Application.Container.GetEmailChangeRepository().Migrate()
+		_ = app.Application.Container.GetMagicLinkRepository().Migrate()
+		_ = app.Application.Container.GetSessionRepository().Migrate()
+		_ = app.Application.Container.GetApiKeyRepository().Migrate()
+		_ = app.Application.Container.GetClientRepository().Migrate()
+		_ = app.Application.Container.GetBillingRepository().Migrate()
+		_ = app.Application.Container.GetCouponRepository().Migrate()
+		_ = app.Application.Container.GetVoteRepository().Migrate()
+		_ = app.Application.Container.GetCommentRepository().Migrate()
+		_ = app.Application.Container.GetReportRepository().Migrate()
+		_ = app.Application.Container.GetTagRepository().Migrate()
+		_ = app.Application.Container.GetMediaRepository().Migrate()
+		_ = app.Application.Container.GetFollowRepository().Migrate()
+		_ = app.Application.Container.GetConversationRepository().Migrate()
+		_ = app.Application.Container.GetDeviceRepository().Migrate()
+		_ = app.Application.Container.GetAnnouncementRepository().Migrate()
+		_ = app.Application.Container.GetUserSearchViewRepository().Migrate()
+		_ = app.Application.Container.GetSagaRepository().Migrate()
+		_ = app.Application.Container.GetDeadLetterRepository().Migrate()
+		_ = app.Application.Container.GetDeprecationRepository().Migrate()
+		_ = app.Application.Container.GetConfigSnapshotRepository().Migrate()
 	}
 }
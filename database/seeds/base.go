@@ -8,5 +8,8 @@ import (
 func Initialize() {
 	if *flags.Seed {
 		_ = app.Application.Container.GetUserRepository().Seed()
+		_ = app.Application.Container.GetPolicyRepository().Seed()
+		_ = app.Application.Container.GetSettingRepository().Seed()
+		_ = app.Application.Container.GetBillingRepository().Seed()
 	}
 }
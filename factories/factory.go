@@ -0,0 +1,72 @@
+package factories
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/helpers"
+)
+
+// Password is the password of the users made by the factories
+const Password = "password"
+
+var (
+	firstNames = []string{"Alfred", "Barbara", "Bruce", "Dick", "Harvey", "James", "Jason", "Leslie", "Lucius", "Oswald", "Pamela", "Renee", "Selina", "Stephanie", "Tim", "Victor"}
+	lastNames  = []string{"Bullock", "Cobblepot", "Dent", "Fox", "Fries", "Gordon", "Grayson", "Isley", "Kyle", "Montoya", "Pennyworth", "Thompkins", "Todd", "Wayne"}
+)
+
+/**
+ * Factory
+ * makes the records of the models with fake but valid values. The values are drawn from its random source, the same
+ * seed makes the same records, and the unique ones are numbered by the factory
+ */
+type Factory struct {
+	DB   *gorm.DB
+	Rand *rand.Rand
+	Now  func() time.Time
+
+	sequence int
+	password string
+	mu       sync.Mutex
+}
+
+/**
+ * New
+ * a factory inserting into db, rand.NewSource(seed) for records that do not change between runs
+ */
+func New(db *gorm.DB, source rand.Source) *Factory {
+	return &Factory{DB: db, Rand: rand.New(source), Now: time.Now}
+}
+
+// next numbers the unique values, the emails of the users
+func (f *Factory) next() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sequence++
+	return f.sequence
+}
+
+// pick is a random element of values
+func (f *Factory) pick(values []string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return values[f.Rand.Intn(len(values))]
+}
+
+// hashedPassword hashes Password once, bcrypt is slow on purpose
+func (f *Factory) hashedPassword() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.password == "" {
+		hashed, err := helpers.Hash(Password)
+		if err != nil {
+			return "", fmt.Errorf("factories: %w", err)
+		}
+		f.password = string(hashed)
+	}
+	return f.password, nil
+}
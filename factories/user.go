@@ -0,0 +1,162 @@
+package factories
+
+import (
+	"fmt"
+	"strings"
+
+	"gotham/models"
+)
+
+/**
+ * UserFactory
+ * makes users, its traits and relationships are chained and apply to every user it makes
+ *
+ *	admin, err := factory.User().Admin().Verified().Create()
+ *	users, err := factory.User().Followers(3).CreateMany(10)
+ */
+type UserFactory struct {
+	factory *Factory
+	states  []func(user *models.User)
+	after   []func(factory *Factory, user models.User) error
+}
+
+func (f *Factory) User() *UserFactory {
+	return &UserFactory{factory: f}
+}
+
+// clone keeps the factory it is chained from unchanged, so a base factory is shared by several chains
+func (u *UserFactory) clone() *UserFactory {
+	return &UserFactory{
+		factory: u.factory,
+		states:  append([]func(user *models.User){}, u.states...),
+		after:   append([]func(factory *Factory, user models.User) error{}, u.after...),
+	}
+}
+
+// State changes the made users, after the traits chained before it
+func (u *UserFactory) State(state func(user *models.User)) *UserFactory {
+	clone := u.clone()
+	clone.states = append(clone.states, state)
+	return clone
+}
+
+func (u *UserFactory) Admin() *UserFactory {
+	return u.State(func(user *models.User) {
+		user.Admin = true
+	})
+}
+
+func (u *UserFactory) Verified() *UserFactory {
+	return u.State(func(user *models.User) {
+		user.Verified = true
+		user.VerificationToken = nil
+	})
+}
+
+// Suspended suspends the users indefinitely
+func (u *UserFactory) Suspended() *UserFactory {
+	return u.State(func(user *models.User) {
+		now := u.factory.Now()
+		reason := "suspended by the factory"
+		user.SuspendedAt = &now
+		user.SuspendedUntil = nil
+		user.SuspensionReason = &reason
+	})
+}
+
+// After runs once a user is created, with the factory to create its related records
+func (u *UserFactory) After(after func(factory *Factory, user models.User) error) *UserFactory {
+	clone := u.clone()
+	clone.after = append(clone.after, after)
+	return clone
+}
+
+// Followers creates n users following every created user
+func (u *UserFactory) Followers(n int) *UserFactory {
+	return u.After(func(factory *Factory, user models.User) error {
+		followers, err := factory.User().Verified().CreateMany(n)
+		if err != nil {
+			return err
+		}
+		for _, follower := range followers {
+			if err = factory.DB.Create(&models.Follow{FollowerID: follower.ID, FollowedID: user.ID}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Following creates n users every created user follows
+func (u *UserFactory) Following(n int) *UserFactory {
+	return u.After(func(factory *Factory, user models.User) error {
+		followed, err := factory.User().Verified().CreateMany(n)
+		if err != nil {
+			return err
+		}
+		for _, other := range followed {
+			if err = factory.DB.Create(&models.Follow{FollowerID: user.ID, FollowedID: other.ID}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+/**
+ * Make
+ * a user with its traits without inserting it, nor its relationships
+ */
+func (u *UserFactory) Make() (models.User, error) {
+	password, err := u.factory.hashedPassword()
+	if err != nil {
+		return models.User{}, err
+	}
+	first, last := u.factory.pick(firstNames), u.factory.pick(lastNames)
+	sequence := u.factory.next()
+	token := fmt.Sprintf("factory-verification-%d", sequence)
+	image := fmt.Sprintf("https://robohash.org/%d.jpg?size=100x200", sequence)
+	user := models.User{
+		Name:              first + " " + last,
+		Email:             fmt.Sprintf("%v.%v.%d@gotham.test", strings.ToLower(first), strings.ToLower(last), sequence),
+		Password:          password,
+		VerificationToken: &token,
+		Image:             &image,
+		Timezone:          "UTC",
+	}
+	for _, state := range u.states {
+		state(&user)
+	}
+	return user, nil
+}
+
+/**
+ * Create
+ * inserts a user with its traits, then its relationships
+ */
+func (u *UserFactory) Create() (models.User, error) {
+	user, err := u.Make()
+	if err != nil {
+		return user, err
+	}
+	if err = u.factory.DB.Create(&user).Error; err != nil {
+		return user, fmt.Errorf("factories: creating %v: %w", user.Email, err)
+	}
+	for _, after := range u.after {
+		if err = after(u.factory, user); err != nil {
+			return user, fmt.Errorf("factories: relationships of %v: %w", user.Email, err)
+		}
+	}
+	return user, nil
+}
+
+func (u *UserFactory) CreateMany(n int) (users []models.User, err error) {
+	for i := 0; i < n; i++ {
+		user, err := u.Create()
+		if err != nil {
+			return users, err
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// ISO8601 is the format of every time the api reads or writes, with the offset of its location
+const ISO8601 = time.RFC3339
+
+// layouts ParseInLocation accepts without an offset, the time is read in the given location
+var localLayouts = []string{"2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02"}
+
+// LoadLocation is time.LoadLocation with UTC for an empty name
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(name)
+}
+
+// ParseInLocation reads an ISO 8601 time, a value with an offset keeps it and one without is read in loc
+func ParseInLocation(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range localLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not an ISO 8601 time", value)
+}
+
+// FormatInLocation writes t in ISO 8601 with the offset of loc
+func FormatInLocation(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(ISO8601)
+}
+
+// StartOfDay is midnight of the day of t in loc
+func StartOfDay(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.In(loc).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+// StartOfMonth is the first instant of the month in loc
+func StartOfMonth(year int, month MonthlyEnum, loc *time.Location) time.Time {
+	return time.Date(year, time.Month(month.GetMonthId()), 1, 0, 0, 0, 0, loc)
+}
+
+// EndOfMonth is the last instant of the month in loc
+func EndOfMonth(year int, month MonthlyEnum, loc *time.Location) time.Time {
+	return StartOfMonth(year, month, loc).AddDate(0, 1, 0).Add(-time.Nanosecond)
+}
+
+// MonthOf is the MonthlyEnum of the month of t in loc
+func MonthOf(t time.Time, loc *time.Location) MonthlyEnum {
+	return MonthlyEnum(GetMonthNameWithId(int(t.In(loc).Month())))
+}
+
+// a json string holding nothing but an RFC 3339 time, as encoding/json writes time.Time
+var jsonTimePattern = regexp.MustCompile(`"\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})"`)
+
+// LocalizeJSON rewrites the times of an encoded json document in loc, the instants do not change only their offset
+func LocalizeJSON(body []byte, loc *time.Location) []byte {
+	return jsonTimePattern.ReplaceAllFunc(body, func(match []byte) []byte {
+		t, err := time.Parse(time.RFC3339Nano, string(match[1:len(match)-1]))
+		if err != nil {
+			return match
+		}
+		return []byte(`"` + t.In(loc).Format(time.RFC3339Nano) + `"`)
+	})
+}
@@ -3,8 +3,10 @@ package helpers
 import (
 	"crypto/hmac"
 	"crypto/md5"
+	cryptoRand "crypto/rand"
 	"crypto/sha1"
 	"encoding/hex"
+	"io"
 	"math/rand"
 
 	"golang.org/x/crypto/bcrypt"
@@ -14,13 +16,48 @@ func Hash(password string) ([]byte, error) {
 	return bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 }
 
-func RandomString(n int) string {
-	letter := []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
-	b := make([]rune, n)
-	for i := range b {
-		b[i] = letter[rand.Intn(len(letter))]
+// RandomLetters are the letters of RandomString
+const RandomLetters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// RandomString is n random letters and digits read from crypto/rand, for codes that must not be guessed
+func RandomString(n int) (string, error) {
+	return secureString(cryptoRand.Reader, n, RandomLetters)
+}
+
+// SecureToken is a random hex string of n bytes, for codes that must not be guessed
+func SecureToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := cryptoRand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// SecureDigits is a random code of n decimal digits, for codes typed by people
+func SecureDigits(n int) (string, error) {
+	return secureString(cryptoRand.Reader, n, "0123456789")
+}
+
+// secureString is n characters of the alphabet drawn from the bytes of the reader. The bytes past the last multiple of
+// the alphabet are dropped, so every character is as likely
+func secureString(reader io.Reader, n int, alphabet string) (string, error) {
+	b := make([]byte, n)
+	buffer := make([]byte, n)
+	for i := 0; i < n; {
+		if _, err := io.ReadFull(reader, buffer); err != nil {
+			return "", err
+		}
+		for _, r := range buffer {
+			if int(r) >= 256-256%len(alphabet) {
+				continue
+			}
+			b[i] = alphabet[int(r)%len(alphabet)]
+			if i++; i == n {
+				break
+			}
+		}
 	}
-	return string(b)
+	return string(b), nil
 }
 
 func MD5Hash(text string) string {
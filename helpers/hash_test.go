@@ -0,0 +1,40 @@
+package helpers
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestSecureString(t *testing.T) {
+	// the bytes from 250 are dropped, 256 is not a multiple of 10 and they would make the low digits likelier
+	reader := bytes.NewReader([]byte{250, 251, 9, 19, 255, 249, 0, 100})
+	code, err := secureString(reader, 4, "0123456789")
+	if err != nil || code != "9990" {
+		t.Fatalf("%q %v", code, err)
+	}
+
+	// every digit is drawn from as many bytes
+	counts := map[rune]int{}
+	all := make([]byte, 256)
+	for i := range all {
+		all[i] = byte(i)
+	}
+	code, err = secureString(bytes.NewReader(append(all, all[:6]...)), 250, "0123456789")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, digit := range code {
+		counts[digit]++
+	}
+	for _, digit := range "0123456789" {
+		if counts[digit] != 25 {
+			t.Errorf("%c drawn %d times, expected 25", digit, counts[digit])
+		}
+	}
+
+	if _, err = secureString(strings.NewReader(""), 4, "0123456789"); err != io.EOF {
+		t.Fatalf("an exhausted reader: %v", err)
+	}
+}
@@ -0,0 +1,261 @@
+package helpers
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("money: currencies do not match")
+	ErrUnknownCurrency  = errors.New("money: unknown currency")
+	ErrMoneyOverflow    = errors.New("money: amount overflows")
+	ErrInvalidAmount    = errors.New("money: invalid amount")
+)
+
+// Currencies maps the ISO 4217 codes the api accepts to the number of their minor unit digits
+var Currencies = map[string]int{
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"TRY": 2,
+	"CHF": 2,
+	"CAD": 2,
+	"AUD": 2,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"BHD": 3,
+}
+
+// Money is an amount in the minor units (cents) of its currency, amounts are never floats
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// NewMoney returns amount minor units of currency
+func NewMoney(amount int64, currency string) (Money, error) {
+	currency = strings.ToUpper(currency)
+	if _, ok := Currencies[currency]; !ok {
+		return Money{}, ErrUnknownCurrency
+	}
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// ParseMoney reads a decimal amount like "12.34" or "-0.5" in currency, more digits than the currency has are refused
+func ParseMoney(value string, currency string) (Money, error) {
+	m, err := NewMoney(0, currency)
+	if err != nil {
+		return m, err
+	}
+	digits := Currencies[m.Currency]
+
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "-"), "+")
+	whole, fraction := value, ""
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		whole, fraction = value[:i], value[i+1:]
+	}
+	if whole == "" && fraction == "" || len(fraction) > digits || strings.ContainsAny(whole+fraction, "+-") {
+		return Money{}, ErrInvalidAmount
+	}
+	fraction += strings.Repeat("0", digits-len(fraction))
+
+	amount, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return Money{}, ErrMoneyOverflow
+		}
+		return Money{}, ErrInvalidAmount
+	}
+	if negative {
+		amount = -amount
+	}
+	m.Amount = amount
+	return m, nil
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Add returns m + o, both in the same currency
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	if o.Amount > 0 && m.Amount > math.MaxInt64-o.Amount || o.Amount < 0 && m.Amount < math.MinInt64-o.Amount {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: m.Amount + o.Amount, Currency: m.Currency}, nil
+}
+
+// Sub returns m - o, both in the same currency
+func (m Money) Sub(o Money) (Money, error) {
+	if o.Amount == math.MinInt64 {
+		return Money{}, ErrMoneyOverflow
+	}
+	return m.Add(Money{Amount: -o.Amount, Currency: o.Currency})
+}
+
+// Multiply returns m times n, for quantities
+func (m Money) Multiply(n int64) (Money, error) {
+	if m.Amount == 0 || n == 0 {
+		return Money{Amount: 0, Currency: m.Currency}, nil
+	}
+	product := m.Amount * n
+	if product/n != m.Amount || m.Amount == -1 && n == math.MinInt64 || n == -1 && m.Amount == math.MinInt64 {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: product, Currency: m.Currency}, nil
+}
+
+// Percent returns basisPoints hundredths of a percent of m (1050 is 10.5%), a half rounded to the even minor unit so
+// the roundings of many amounts do not drift up
+func (m Money) Percent(basisPoints int64) (Money, error) {
+	product, err := m.Multiply(basisPoints)
+	if err != nil {
+		return Money{}, err
+	}
+	amount, remainder := product.Amount/10000, product.Amount%10000
+	switch {
+	case remainder > 5000 || remainder == 5000 && amount%2 != 0:
+		amount++
+	case remainder < -5000 || remainder == -5000 && amount%2 != 0:
+		amount--
+	}
+	return Money{Amount: amount, Currency: m.Currency}, nil
+}
+
+// Allocate splits m by ratios without losing a minor unit, the remainder goes to the first shares
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	var total int64
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrInvalidAmount
+		}
+		total += ratio
+	}
+	if total == 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	shares := make([]Money, len(ratios))
+	remainder := m.Amount
+	for i, ratio := range ratios {
+		share, err := m.Multiply(ratio)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = Money{Amount: share.Amount / total, Currency: m.Currency}
+		remainder -= shares[i].Amount
+	}
+	unit := int64(1)
+	if remainder < 0 {
+		unit = -1
+	}
+	for i := 0; remainder != 0; i++ {
+		if ratios[i%len(ratios)] == 0 {
+			continue
+		}
+		shares[i%len(ratios)].Amount += unit
+		remainder -= unit
+	}
+	return shares, nil
+}
+
+// Cmp returns -1, 0 or +1 as m is less than, equal to or greater than o
+func (m Money) Cmp(o Money) (int, error) {
+	if m.Currency != o.Currency {
+		return 0, ErrCurrencyMismatch
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Decimal writes the amount in major units, like "12.34"
+func (m Money) Decimal() string {
+	digits := Currencies[m.Currency]
+	sign, amount := "", strconv.FormatUint(uint64(m.Amount), 10)
+	if m.Amount < 0 {
+		sign, amount = "-", strconv.FormatUint(uint64(-m.Amount), 10)
+	}
+	if digits == 0 {
+		return sign + amount
+	}
+	if len(amount) <= digits {
+		amount = strings.Repeat("0", digits-len(amount)+1) + amount
+	}
+	return sign + amount[:len(amount)-digits] + "." + amount[len(amount)-digits:]
+}
+
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+// MarshalJSON writes the minor units with the currency and the decimal amount for display
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Amount   int64  `json:"amount"`
+		Currency string `json:"currency"`
+		Decimal  string `json:"decimal"`
+	}{m.Amount, m.Currency, m.Decimal()})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var value struct {
+		Amount   int64  `json:"amount"`
+		Currency string `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	money, err := NewMoney(value.Amount, value.Currency)
+	if err != nil {
+		return err
+	}
+	*m = money
+	return nil
+}
+
+// Value stores the money in one column as its String, like "12.34 USD"
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (m *Money) Scan(value interface{}) error {
+	var text string
+	switch v := value.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return fmt.Errorf("money: cannot scan %T", value)
+	}
+	parts := strings.Fields(text)
+	if len(parts) != 2 {
+		return ErrInvalidAmount
+	}
+	money, err := ParseMoney(parts[0], parts[1])
+	if err != nil {
+		return err
+	}
+	*m = money
+	return nil
+}
@@ -0,0 +1,202 @@
+package helpers
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		value    string
+		currency string
+		amount   int64
+		err      error
+	}{
+		{"12.34", "USD", 1234, nil},
+		{"-0.5", "USD", -50, nil},
+		{"+1", "usd", 100, nil},
+		{" 1.00 ", "EUR", 100, nil},
+		{".5", "USD", 50, nil},
+		{"5.", "USD", 500, nil},
+		{"1500", "JPY", 1500, nil},
+		{"-1500", "JPY", -1500, nil},
+		{"1.234", "KWD", 1234, nil},
+		{"1.5", "JPY", 0, ErrInvalidAmount},
+		{"1.234", "USD", 0, ErrInvalidAmount},
+		{"", "USD", 0, ErrInvalidAmount},
+		{"-", "USD", 0, ErrInvalidAmount},
+		{".", "USD", 0, ErrInvalidAmount},
+		{"--1", "USD", 0, ErrInvalidAmount},
+		{"1-2", "USD", 0, ErrInvalidAmount},
+		{"1.-5", "USD", 0, ErrInvalidAmount},
+		{"1,5", "USD", 0, ErrInvalidAmount},
+		{"abc", "USD", 0, ErrInvalidAmount},
+		{"1e3", "USD", 0, ErrInvalidAmount},
+		{"99999999999999999999", "USD", 0, ErrMoneyOverflow},
+		{"1", "XYZ", 0, ErrUnknownCurrency},
+	}
+	for _, test := range tests {
+		m, err := ParseMoney(test.value, test.currency)
+		if !errors.Is(err, test.err) {
+			t.Errorf("ParseMoney(%q, %v): error %v, expected %v", test.value, test.currency, err, test.err)
+			continue
+		}
+		if err == nil && m.Amount != test.amount {
+			t.Errorf("ParseMoney(%q, %v): %v, expected %v", test.value, test.currency, m.Amount, test.amount)
+		}
+	}
+}
+
+func TestMoneyDecimal(t *testing.T) {
+	tests := []struct {
+		money   Money
+		decimal string
+	}{
+		{Money{Amount: 1234, Currency: "USD"}, "12.34"},
+		{Money{Amount: -5, Currency: "USD"}, "-0.05"},
+		{Money{Amount: 0, Currency: "USD"}, "0.00"},
+		{Money{Amount: 1500, Currency: "JPY"}, "1500"},
+		{Money{Amount: -1500, Currency: "JPY"}, "-1500"},
+		{Money{Amount: 1, Currency: "KWD"}, "0.001"},
+	}
+	for _, test := range tests {
+		if decimal := test.money.Decimal(); decimal != test.decimal {
+			t.Errorf("%v %v: %q, expected %q", test.money.Amount, test.money.Currency, decimal, test.decimal)
+		}
+		parsed, err := ParseMoney(test.decimal, test.money.Currency)
+		if err != nil || parsed != test.money {
+			t.Errorf("%q does not parse back: %v %v", test.decimal, parsed, err)
+		}
+	}
+}
+
+func TestMoneyPercent(t *testing.T) {
+	tests := []struct {
+		name        string
+		money       Money
+		basisPoints int64
+		amount      int64
+	}{
+		{"exact", Money{Amount: 1000, Currency: "USD"}, 1050, 105},
+		{"half to even down", Money{Amount: 250, Currency: "USD"}, 100, 2},
+		{"half to even up", Money{Amount: 350, Currency: "USD"}, 100, 4},
+		{"above half", Money{Amount: 251, Currency: "USD"}, 100, 3},
+		{"below half", Money{Amount: 249, Currency: "USD"}, 100, 2},
+		{"negative half to even", Money{Amount: -250, Currency: "USD"}, 100, -2},
+		{"negative half away", Money{Amount: -350, Currency: "USD"}, 100, -4},
+		{"negative above half", Money{Amount: -251, Currency: "USD"}, 100, -3},
+		{"zero exponent half to even", Money{Amount: 250, Currency: "JPY"}, 100, 2},
+		{"zero exponent half up to even", Money{Amount: 150, Currency: "JPY"}, 100, 2},
+		{"zero", Money{Amount: 0, Currency: "JPY"}, 2500, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := test.money.Percent(test.basisPoints)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.Amount != test.amount || m.Currency != test.money.Currency {
+				t.Fatalf("%v, expected %v %v", m, test.amount, test.money.Currency)
+			}
+		})
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	usd := func(amount int64) Money { return Money{Amount: amount, Currency: "USD"} }
+	max := usd(1<<63 - 1)
+	min := usd(-1 << 63)
+
+	if sum, err := usd(-150).Add(usd(100)); err != nil || sum != usd(-50) {
+		t.Errorf("-150 + 100: %v %v", sum, err)
+	}
+	if difference, err := usd(100).Sub(usd(250)); err != nil || difference != usd(-150) {
+		t.Errorf("100 - 250: %v %v", difference, err)
+	}
+	if product, err := usd(-250).Multiply(3); err != nil || product != usd(-750) {
+		t.Errorf("-250 * 3: %v %v", product, err)
+	}
+	if _, err := usd(100).Add(Money{Amount: 100, Currency: "JPY"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("USD + JPY: %v", err)
+	}
+	if _, err := usd(100).Cmp(Money{Amount: 100, Currency: "JPY"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("USD cmp JPY: %v", err)
+	}
+	for name, operation := range map[string]func() (Money, error){
+		"max + 1":  func() (Money, error) { return max.Add(usd(1)) },
+		"min - 1":  func() (Money, error) { return min.Sub(usd(1)) },
+		"0 - min":  func() (Money, error) { return usd(0).Sub(min) },
+		"max * 2":  func() (Money, error) { return max.Multiply(2) },
+		"min * -1": func() (Money, error) { return min.Multiply(-1) },
+	} {
+		if _, err := operation(); !errors.Is(err, ErrMoneyOverflow) {
+			t.Errorf("%v: %v, expected an overflow", name, err)
+		}
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	tests := []struct {
+		money  Money
+		ratios []int64
+		shares []int64
+	}{
+		{Money{Amount: 100, Currency: "USD"}, []int64{1, 1, 1}, []int64{34, 33, 33}},
+		{Money{Amount: -100, Currency: "USD"}, []int64{1, 1, 1}, []int64{-34, -33, -33}},
+		{Money{Amount: 5, Currency: "JPY"}, []int64{0, 1, 1}, []int64{0, 3, 2}},
+		{Money{Amount: 1000, Currency: "USD"}, []int64{70, 30}, []int64{700, 300}},
+	}
+	for _, test := range tests {
+		shares, err := test.money.Allocate(test.ratios...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		amounts := make([]int64, len(shares))
+		for i, share := range shares {
+			amounts[i] = share.Amount
+		}
+		if !reflect.DeepEqual(amounts, test.shares) {
+			t.Errorf("%v by %v: %v, expected %v", test.money, test.ratios, amounts, test.shares)
+		}
+	}
+	if _, err := (Money{Amount: 100, Currency: "USD"}).Allocate(0, 0); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("ratios summing to 0: %v", err)
+	}
+	if _, err := (Money{Amount: 100, Currency: "USD"}).Allocate(2, -1); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("negative ratio: %v", err)
+	}
+}
+
+func TestMoneySerialization(t *testing.T) {
+	m := Money{Amount: -1500, Currency: "JPY"}
+	content, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != `{"amount":-1500,"currency":"JPY","decimal":"-1500"}` {
+		t.Fatalf("json %s", content)
+	}
+	var decoded Money
+	if err = json.Unmarshal(content, &decoded); err != nil || decoded != m {
+		t.Fatalf("json round trip: %v %v", decoded, err)
+	}
+	if err = json.Unmarshal([]byte(`{"amount":1,"currency":"XYZ"}`), &decoded); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("unknown currency: %v", err)
+	}
+
+	for _, m := range []Money{{Amount: -1234, Currency: "USD"}, {Amount: 1500, Currency: "JPY"}, {Amount: 1, Currency: "KWD"}} {
+		value, _ := m.Value()
+		var scanned Money
+		if err = scanned.Scan([]byte(value.(string))); err != nil || scanned != m {
+			t.Errorf("%v scanned as %v %v", value, scanned, err)
+		}
+	}
+	var scanned Money
+	for _, value := range []interface{}{"12.34", "12.345 USD", 1234} {
+		if err = scanned.Scan(value); err == nil {
+			t.Errorf("%v scanned", value)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package helpers
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const Redacted = "[redacted]"
+
+// keys whose values are personal data or secrets
+var PIIKeys = []string{"email", "name", "password", "password_confirmation", "token", "access_token", "refresh_token", "phone", "image", "ip", "address", "code", "secret", "signature"}
+
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+func IsPIIKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, pii := range PIIKeys {
+		if key == pii || strings.HasSuffix(key, "_"+pii) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactPII replaces the values of personal keys (at any depth) and email addresses found in strings
+func RedactPII(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if IsPIIKey(key) {
+				redacted[key] = Redacted
+				continue
+			}
+			redacted[key] = RedactPII(item)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, item := range v {
+			redacted[i] = RedactPII(item)
+		}
+		return redacted
+	case string:
+		return emailPattern.ReplaceAllString(v, Redacted)
+	default:
+		return v
+	}
+}
+
+// RedactQuery replaces the values of the personal keys of a query string or of a form body, the single use tokens of
+// the links included
+func RedactQuery(rawQuery string) string {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return ""
+	}
+	for key := range values {
+		if IsPIIKey(key) {
+			values[key] = []string{Redacted}
+		}
+	}
+	return values.Encode()
+}
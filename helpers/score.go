@@ -0,0 +1,17 @@
+package helpers
+
+import (
+	"math"
+)
+
+// ScoreCalculate ranks likes and dislikes with the lower bound of the wilson score interval at 95% confidence,
+// a few votes weigh less than many votes with the same ratio. The score is between 0 and 1
+func ScoreCalculate(likes int64, dislikes int64) float64 {
+	n := float64(likes + dislikes)
+	if n <= 0 {
+		return 0
+	}
+	const z = 1.96
+	p := float64(likes) / n
+	return (p + z*z/(2*n) - z*math.Sqrt((p*(1-p)+z*z/(4*n))/n)) / (1 + z*z/n)
+}
@@ -3,8 +3,11 @@ package helpers
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
+var nonSlugCharacters = regexp.MustCompile("[^a-z0-9]+")
+
 func ClearNonAlphanumericalCharacters(val string) (string, error) {
 	reg, err := regexp.Compile("[^a-zA-Z0-9]+")
 	if err != nil {
@@ -25,3 +28,17 @@ func ByteCountDecimal(b int64) string {
 	}
 	return fmt.Sprintf("%.1f %cB", float64(b)/float64(div), "kMGTPE"[exp])
 }
+
+// Slugify lowercases the value and joins its words with -, "Go Lang!" is "go-lang"
+func Slugify(val string) string {
+	return strings.Trim(nonSlugCharacters.ReplaceAllString(strings.ToLower(val), "-"), "-")
+}
+
+// Truncate shortens the value to at most max runes, ending a shortened value with …
+func Truncate(val string, max int) string {
+	runes := []rune(val)
+	if len(runes) <= max {
+		return val
+	}
+	return string(runes[:max-1]) + "…"
+}
@@ -0,0 +1,105 @@
+package infrastructures
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gotham/config"
+)
+
+/**
+ * AnalyticsEvent
+ *
+ */
+type AnalyticsEvent struct {
+	Name       string                 `json:"name"`
+	Tenant     string                 `json:"tenant,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+	Time       time.Time              `json:"time"`
+}
+
+/**
+ * IAnalytics
+ *
+ */
+type IAnalytics interface {
+	Track(event AnalyticsEvent)
+	Close() error
+}
+
+/**
+ * FileAnalytics
+ * events are queued and appended as json lines by a single writer, a full queue drops events instead of slowing requests
+ */
+type FileAnalytics struct {
+	Config *config.Analytics
+	events chan AnalyticsEvent
+	done   chan struct{}
+	once   sync.Once
+}
+
+/**
+ * NewFileAnalytics
+ *
+ */
+func NewFileAnalytics(analyticsConfig *config.Analytics) IAnalytics {
+	a := &FileAnalytics{
+		Config: analyticsConfig,
+		events: make(chan AnalyticsEvent, 1024),
+		done:   make(chan struct{}),
+	}
+	go a.write()
+	return a
+}
+
+/**
+ * Track
+ *
+ */
+func (a *FileAnalytics) Track(event AnalyticsEvent) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	select {
+	case a.events <- event:
+	default:
+	}
+}
+
+/**
+ * Close
+ * flush the queued events
+ */
+func (a *FileAnalytics) Close() error {
+	a.once.Do(func() {
+		close(a.events)
+	})
+	<-a.done
+	return nil
+}
+
+func (a *FileAnalytics) write() {
+	defer close(a.done)
+	if err := os.MkdirAll(filepath.Dir(a.Config.Path), 0755); err != nil {
+		log.Printf("analytics: %v", err)
+	}
+	file, err := os.OpenFile(a.Config.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Printf("analytics: %v", err)
+		for range a.events {
+		}
+		return
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	for event := range a.events {
+		if err = encoder.Encode(event); err != nil {
+			log.Printf("analytics: %v", err)
+		}
+	}
+}
@@ -0,0 +1,431 @@
+package infrastructures
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gotham/config"
+)
+
+// Payment Gateway
+
+var ErrWebhookSignature = errors.New("billing: invalid webhook signature")
+
+/**
+ * IPaymentGateway
+ *
+ * interface
+ */
+type IPaymentGateway interface {
+	// CreateCheckout returns the url of a hosted page where the user pays for the subscription
+	CreateCheckout(ctx context.Context, checkout Checkout) (string, error)
+	// CancelSubscription cancels the subscription at the end of its current period
+	CancelSubscription(ctx context.Context, subscriptionID string) error
+	// CreateCoupon creates the coupon applying the discount at checkout and returns its id
+	CreateCoupon(ctx context.Context, coupon PaymentCoupon) (string, error)
+	// ParseWebhook verifies the signature of a webhook and reads its event
+	ParseWebhook(payload []byte, signature string) (PaymentEvent, error)
+}
+
+type Checkout struct {
+	UserID     uint
+	Email      string
+	CustomerID string
+	PriceID    string
+	SuccessURL string
+	CancelURL  string
+	// the coupon of the provider and the code the user entered, both empty without a coupon
+	CouponID   string
+	CouponCode string
+}
+
+// PaymentCoupon is a discount of the first payment, either PercentOff or AmountOff in Currency
+type PaymentCoupon struct {
+	Code       string
+	PercentOff int
+	AmountOff  int64
+	Currency   string
+}
+
+type PaymentEvent struct {
+	ID      string
+	Type    string
+	Created time.Time
+	// set for the customer.subscription.* events
+	Subscription *PaymentSubscription
+	// set for the invoice.paid event
+	Invoice *PaymentInvoice
+}
+
+type PaymentSubscription struct {
+	ID         string
+	CustomerID string
+	UserID     uint
+	PriceID    string
+	// CouponCode is the code of the coupon applied at checkout
+	CouponCode        string
+	Status            string
+	CurrentPeriodEnd  time.Time
+	CancelAtPeriodEnd bool
+}
+
+type PaymentInvoice struct {
+	ID         string
+	Number     string
+	CustomerID string
+	// UserID is 0 when the invoice is not about a subscription created by a checkout
+	UserID      uint
+	Currency    string
+	AmountPaid  int64
+	Lines       []PaymentInvoiceLine
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	PaidAt      time.Time
+}
+
+type PaymentInvoiceLine struct {
+	Description string
+	Amount      int64
+}
+
+/**
+ * NewPaymentGateway
+ *
+ */
+func NewPaymentGateway(billingConfig *config.Billing, clients IHttpClientFactory) (IPaymentGateway, error) {
+	switch billingConfig.Driver {
+	case "log":
+		return NewLogPaymentGateway(billingConfig), nil
+	case "stripe":
+		return &StripePaymentGateway{Config: billingConfig, Client: clients.Make("stripe")}, nil
+	}
+	return nil, fmt.Errorf("unsupported billing driver %q", billingConfig.Driver)
+}
+
+/**
+ * StripePaymentGateway
+ * checkout sessions and subscriptions of the stripe rest api
+ */
+type StripePaymentGateway struct {
+	Config *config.Billing
+	Client IHttpClient
+}
+
+/**
+ * CreateCheckout
+ *
+ */
+func (g *StripePaymentGateway) CreateCheckout(ctx context.Context, checkout Checkout) (string, error) {
+	userID := strconv.FormatUint(uint64(checkout.UserID), 10)
+	form := url.Values{
+		"mode":                                 {"subscription"},
+		"line_items[0][price]":                 {checkout.PriceID},
+		"line_items[0][quantity]":              {"1"},
+		"success_url":                          {checkout.SuccessURL},
+		"cancel_url":                           {checkout.CancelURL},
+		"client_reference_id":                  {userID},
+		"subscription_data[metadata][user_id]": {userID},
+	}
+	if checkout.CustomerID != "" {
+		form.Set("customer", checkout.CustomerID)
+	} else {
+		form.Set("customer_email", checkout.Email)
+	}
+	if checkout.CouponID != "" {
+		form.Set("discounts[0][coupon]", checkout.CouponID)
+		form.Set("subscription_data[metadata][coupon_code]", checkout.CouponCode)
+	}
+
+	var session struct {
+		Url string `json:"url"`
+	}
+	if err := g.post(ctx, "/v1/checkout/sessions", form, &session); err != nil {
+		return "", err
+	}
+	return session.Url, nil
+}
+
+/**
+ * CancelSubscription
+ *
+ */
+func (g *StripePaymentGateway) CancelSubscription(ctx context.Context, subscriptionID string) error {
+	return g.post(ctx, "/v1/subscriptions/"+url.PathEscape(subscriptionID), url.Values{"cancel_at_period_end": {"true"}}, nil)
+}
+
+/**
+ * CreateCoupon
+ *
+ */
+func (g *StripePaymentGateway) CreateCoupon(ctx context.Context, coupon PaymentCoupon) (string, error) {
+	form := url.Values{"name": {coupon.Code}, "duration": {"once"}}
+	if coupon.PercentOff > 0 {
+		form.Set("percent_off", strconv.Itoa(coupon.PercentOff))
+	} else {
+		form.Set("amount_off", strconv.FormatInt(coupon.AmountOff, 10))
+		form.Set("currency", strings.ToLower(coupon.Currency))
+	}
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := g.post(ctx, "/v1/coupons", form, &created); err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+/**
+ * ParseWebhook
+ *
+ */
+func (g *StripePaymentGateway) ParseWebhook(payload []byte, signature string) (PaymentEvent, error) {
+	return parseStripeWebhook(payload, signature, g.Config.StripeWebhookSecret, g.Config.WebhookTolerance, time.Now())
+}
+
+func (g *StripePaymentGateway) post(ctx context.Context, path string, form url.Values, result interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.stripe.com"+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+g.Config.StripeSecretKey)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := g.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("stripe: %s: %s", response.Status, body)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(response.Body).Decode(result)
+}
+
+// parseStripeWebhook checks the Stripe-Signature header (t=<unix time>,v1=<hex hmac sha256 of "<t>.<payload>">)
+// before reading the event
+func parseStripeWebhook(payload []byte, header string, secret string, tolerance time.Duration, now time.Time) (event PaymentEvent, err error) {
+	if secret == "" {
+		return event, ErrWebhookSignature
+	}
+	var timestamp int64
+	var signatures []string
+	for _, item := range strings.Split(header, ",") {
+		parts := strings.SplitN(strings.TrimSpace(item), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch parts[0] {
+		case "t":
+			timestamp, _ = strconv.ParseInt(parts[1], 10, 64)
+		case "v1":
+			signatures = append(signatures, parts[1])
+		}
+	}
+	if timestamp == 0 || len(signatures) == 0 {
+		return event, ErrWebhookSignature
+	}
+	if age := now.Sub(time.Unix(timestamp, 0)); age > tolerance || age < -tolerance {
+		return event, ErrWebhookSignature
+	}
+
+	expected := SignStripeWebhook(payload, secret, timestamp)
+	valid := false
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			valid = true
+		}
+	}
+	if !valid {
+		return event, ErrWebhookSignature
+	}
+
+	var raw struct {
+		ID      string `json:"id"`
+		Type    string `json:"type"`
+		Created int64  `json:"created"`
+		Data    struct {
+			Object json.RawMessage `json:"object"`
+		} `json:"data"`
+	}
+	if err = json.Unmarshal(payload, &raw); err != nil {
+		return event, err
+	}
+	event = PaymentEvent{ID: raw.ID, Type: raw.Type, Created: time.Unix(raw.Created, 0)}
+	if raw.Type == "invoice.paid" {
+		event.Invoice, err = parseStripeInvoice(raw.Data.Object)
+		return event, err
+	}
+	if !strings.HasPrefix(raw.Type, "customer.subscription.") {
+		return event, nil
+	}
+
+	var object struct {
+		ID                string            `json:"id"`
+		Customer          string            `json:"customer"`
+		Status            string            `json:"status"`
+		CurrentPeriodEnd  int64             `json:"current_period_end"`
+		CancelAtPeriodEnd bool              `json:"cancel_at_period_end"`
+		Metadata          map[string]string `json:"metadata"`
+		Items             struct {
+			Data []struct {
+				Price struct {
+					ID string `json:"id"`
+				} `json:"price"`
+			} `json:"data"`
+		} `json:"items"`
+	}
+	if err = json.Unmarshal(raw.Data.Object, &object); err != nil {
+		return event, err
+	}
+	userID, _ := strconv.ParseUint(object.Metadata["user_id"], 10, 64)
+	event.Subscription = &PaymentSubscription{
+		ID:                object.ID,
+		CustomerID:        object.Customer,
+		UserID:            uint(userID),
+		CouponCode:        object.Metadata["coupon_code"],
+		Status:            object.Status,
+		CurrentPeriodEnd:  time.Unix(object.CurrentPeriodEnd, 0),
+		CancelAtPeriodEnd: object.CancelAtPeriodEnd,
+	}
+	if len(object.Items.Data) > 0 {
+		event.Subscription.PriceID = object.Items.Data[0].Price.ID
+	}
+	return event, nil
+}
+
+func parseStripeInvoice(data json.RawMessage) (*PaymentInvoice, error) {
+	var object struct {
+		ID                  string `json:"id"`
+		Number              string `json:"number"`
+		Customer            string `json:"customer"`
+		Currency            string `json:"currency"`
+		AmountPaid          int64  `json:"amount_paid"`
+		PeriodStart         int64  `json:"period_start"`
+		PeriodEnd           int64  `json:"period_end"`
+		SubscriptionDetails struct {
+			Metadata map[string]string `json:"metadata"`
+		} `json:"subscription_details"`
+		StatusTransitions struct {
+			PaidAt int64 `json:"paid_at"`
+		} `json:"status_transitions"`
+		Lines struct {
+			Data []struct {
+				Description string `json:"description"`
+				Amount      int64  `json:"amount"`
+			} `json:"data"`
+		} `json:"lines"`
+	}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	userID, _ := strconv.ParseUint(object.SubscriptionDetails.Metadata["user_id"], 10, 64)
+	invoice := &PaymentInvoice{
+		ID:          object.ID,
+		Number:      object.Number,
+		CustomerID:  object.Customer,
+		UserID:      uint(userID),
+		Currency:    strings.ToUpper(object.Currency),
+		AmountPaid:  object.AmountPaid,
+		PeriodStart: time.Unix(object.PeriodStart, 0),
+		PeriodEnd:   time.Unix(object.PeriodEnd, 0),
+		PaidAt:      time.Unix(object.StatusTransitions.PaidAt, 0),
+	}
+	for _, line := range object.Lines.Data {
+		invoice.Lines = append(invoice.Lines, PaymentInvoiceLine{Description: line.Description, Amount: line.Amount})
+	}
+	return invoice, nil
+}
+
+// SignStripeWebhook returns the v1 signature stripe sends for the payload at timestamp
+func SignStripeWebhook(payload []byte, secret string, timestamp int64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+/**
+ * LogPaymentGateway
+ * mock gateway of development and test environments, checkouts and cancellations are logged and kept,
+ * webhooks are verified like stripe does with STRIPE_WEBHOOK_SECRET so they can be sent by hand
+ */
+type LogPaymentGateway struct {
+	Config    *config.Billing
+	checkouts []Checkout
+	canceled  []string
+	mu        sync.Mutex
+}
+
+func NewLogPaymentGateway(billingConfig *config.Billing) *LogPaymentGateway {
+	return &LogPaymentGateway{Config: billingConfig}
+}
+
+/**
+ * CreateCheckout
+ *
+ */
+func (g *LogPaymentGateway) CreateCheckout(ctx context.Context, checkout Checkout) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.checkouts = append(g.checkouts, checkout)
+	log.Printf("billing: checkout of %v for user %v", checkout.PriceID, checkout.UserID)
+	return checkout.SuccessURL, nil
+}
+
+/**
+ * CancelSubscription
+ *
+ */
+func (g *LogPaymentGateway) CancelSubscription(ctx context.Context, subscriptionID string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.canceled = append(g.canceled, subscriptionID)
+	log.Printf("billing: cancel of %v", subscriptionID)
+	return nil
+}
+
+/**
+ * CreateCoupon
+ *
+ */
+func (g *LogPaymentGateway) CreateCoupon(ctx context.Context, coupon PaymentCoupon) (string, error) {
+	log.Printf("billing: coupon %v", coupon.Code)
+	return "log_" + coupon.Code, nil
+}
+
+/**
+ * ParseWebhook
+ *
+ */
+func (g *LogPaymentGateway) ParseWebhook(payload []byte, signature string) (PaymentEvent, error) {
+	return parseStripeWebhook(payload, signature, g.Config.StripeWebhookSecret, g.Config.WebhookTolerance, time.Now())
+}
+
+/**
+ * Checkouts
+ * the checkouts created so far
+ */
+func (g *LogPaymentGateway) Checkouts() []Checkout {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]Checkout(nil), g.checkouts...)
+}
@@ -0,0 +1,115 @@
+package infrastructures
+
+import (
+	"sync"
+	"time"
+)
+
+/**
+ * Breadcrumb
+ * a log entry leading to an error, sent with its report
+ */
+type Breadcrumb struct {
+	Time    time.Time
+	Level   string
+	Message string
+	Fields  Fields
+}
+
+/**
+ * Breadcrumbs
+ * the last entries of the logger, of every request of the instance, in a ring
+ */
+type Breadcrumbs struct {
+	entries []Breadcrumb
+	next    int
+	full    bool
+	mu      sync.Mutex
+}
+
+func NewBreadcrumbs(size int) *Breadcrumbs {
+	return &Breadcrumbs{entries: make([]Breadcrumb, size)}
+}
+
+// Add keeps the breadcrumb, dropping the oldest one once the ring is full
+func (b *Breadcrumbs) Add(breadcrumb Breadcrumb) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.entries) == 0 {
+		return
+	}
+	b.entries[b.next] = breadcrumb
+	b.next = (b.next + 1) % len(b.entries)
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+// Last the breadcrumbs kept, the oldest first
+func (b *Breadcrumbs) Last() []Breadcrumb {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.full {
+		return append([]Breadcrumb(nil), b.entries[:b.next]...)
+	}
+	return append(append([]Breadcrumb(nil), b.entries[b.next:]...), b.entries[:b.next]...)
+}
+
+/**
+ * BreadcrumbLogger
+ * keeps every entry of the logger as a breadcrumb, the debug ones included whatever the level written
+ */
+type BreadcrumbLogger struct {
+	Logger      ILogger
+	Breadcrumbs *Breadcrumbs
+	fields      Fields
+}
+
+func NewBreadcrumbLogger(logger ILogger, breadcrumbs *Breadcrumbs) ILogger {
+	return &BreadcrumbLogger{Logger: logger, Breadcrumbs: breadcrumbs}
+}
+
+func (l *BreadcrumbLogger) Debug(message string, fields Fields) {
+	l.add("debug", message, fields)
+	l.Logger.Debug(message, fields)
+}
+
+func (l *BreadcrumbLogger) Info(message string, fields Fields) {
+	l.add("info", message, fields)
+	l.Logger.Info(message, fields)
+}
+
+func (l *BreadcrumbLogger) Warn(message string, fields Fields) {
+	l.add("warn", message, fields)
+	l.Logger.Warn(message, fields)
+}
+
+func (l *BreadcrumbLogger) Error(message string, fields Fields) {
+	l.add("error", message, fields)
+	l.Logger.Error(message, fields)
+}
+
+func (l *BreadcrumbLogger) With(fields Fields) ILogger {
+	merged := Fields{}
+	for key, value := range l.fields {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+	return &BreadcrumbLogger{Logger: l.Logger.With(fields), Breadcrumbs: l.Breadcrumbs, fields: merged}
+}
+
+func (l *BreadcrumbLogger) add(level string, message string, fields Fields) {
+	entry := Fields{}
+	for key, value := range l.fields {
+		entry[key] = value
+	}
+	for key, value := range fields {
+		if err, ok := value.(error); ok {
+			value = err.Error()
+		}
+		entry[key] = value
+	}
+	l.Breadcrumbs.Add(Breadcrumb{Time: time.Now(), Level: level, Message: message, Fields: entry})
+}
@@ -0,0 +1,148 @@
+package infrastructures
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gotham/config"
+)
+
+/**
+ * ICache
+ * byte values with a ttl, keys can be tagged so everything derived from some data is dropped at once
+ */
+type ICache interface {
+	Get(ctx context.Context, key string) (value []byte, found bool, err error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Add sets the key only when it is not set, added tells if it was, so one caller of many wins
+	Add(ctx context.Context, key string, value []byte, ttl time.Duration) (added bool, err error)
+	Delete(ctx context.Context, keys ...string) error
+	// Tag associates keys with a tag, InvalidateTags deletes every key of the tags
+	Tag(ctx context.Context, tag string, keys ...string) error
+	InvalidateTags(ctx context.Context, tags ...string) error
+}
+
+/**
+ * NewCache
+ *
+ */
+func NewCache(cacheConfig *config.Cache) (ICache, error) {
+	switch cacheConfig.Driver {
+	case "memory":
+		return NewMemoryCache(), nil
+	case "redis":
+		return NewRedisCache(cacheConfig), nil
+	}
+	return nil, fmt.Errorf("unsupported cache driver %q", cacheConfig.Driver)
+}
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+/**
+ * MemoryCache
+ * a cache local to the instance, for development and single instance deployments
+ */
+type MemoryCache struct {
+	entries map[string]memoryEntry
+	tags    map[string]map[string]bool
+	sets    int
+	mu      sync.Mutex
+}
+
+/**
+ * NewMemoryCache
+ *
+ */
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: map[string]memoryEntry{},
+		tags:    map[string]map[string]bool{},
+	}
+}
+
+func (m *MemoryCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		delete(m.entries, key)
+		return nil, false, nil
+	}
+	return entry.value, true, nil
+}
+
+func (m *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry := memoryEntry{value: value}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+	m.entries[key] = entry
+
+	// expired entries nobody reads again are swept from time to time
+	if m.sets++; m.sets%1000 == 0 {
+		now := time.Now()
+		for key, entry := range m.entries {
+			if !entry.expiresAt.IsZero() && now.After(entry.expiresAt) {
+				delete(m.entries, key)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *MemoryCache) Add(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if entry, ok := m.entries[key]; ok && (entry.expiresAt.IsZero() || time.Now().Before(entry.expiresAt)) {
+		return false, nil
+	}
+	entry := memoryEntry{value: value}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+	m.entries[key] = entry
+	return true, nil
+}
+
+func (m *MemoryCache) Delete(ctx context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		delete(m.entries, key)
+	}
+	return nil
+}
+
+func (m *MemoryCache) Tag(ctx context.Context, tag string, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.tags[tag] == nil {
+		m.tags[tag] = map[string]bool{}
+	}
+	for _, key := range keys {
+		m.tags[tag][key] = true
+	}
+	return nil
+}
+
+func (m *MemoryCache) InvalidateTags(ctx context.Context, tags ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, tag := range tags {
+		for key := range m.tags[tag] {
+			delete(m.entries, key)
+		}
+		delete(m.tags, tag)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package infrastructures
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gotham/config"
+)
+
+// the tag sets are kept as long as the longest entry they may point to
+const redisTagTTL = 24 * time.Hour
+
+/**
+ * RedisCache
+ * only the few commands the cache needs, see redisClient
+ */
+type RedisCache struct {
+	Config *config.Cache
+	client *redisClient
+}
+
+/**
+ * NewRedisCache
+ *
+ */
+func NewRedisCache(cacheConfig *config.Cache) *RedisCache {
+	return &RedisCache{
+		Config: cacheConfig,
+		client: newRedisClient(cacheConfig),
+	}
+}
+
+func (r *RedisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := r.client.do(ctx, "GET", key)
+	if err != nil || reply == nil {
+		return nil, false, err
+	}
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("redis: unexpected reply %T to GET", reply)
+	}
+	return value, true, nil
+}
+
+func (r *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	args := []interface{}{"SET", key, value}
+	if ttl > 0 {
+		args = append(args, "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	}
+	_, err := r.client.do(ctx, args...)
+	return err
+}
+
+func (r *RedisCache) Add(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	args := []interface{}{"SET", key, value, "NX"}
+	if ttl > 0 {
+		args = append(args, "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	}
+	reply, err := r.client.do(ctx, args...)
+	return reply != nil, err
+}
+
+func (r *RedisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	args := []interface{}{"DEL"}
+	for _, key := range keys {
+		args = append(args, key)
+	}
+	_, err := r.client.do(ctx, args...)
+	return err
+}
+
+func (r *RedisCache) Tag(ctx context.Context, tag string, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	args := []interface{}{"SADD", "tag:" + tag}
+	for _, key := range keys {
+		args = append(args, key)
+	}
+	if _, err := r.client.do(ctx, args...); err != nil {
+		return err
+	}
+	_, err := r.client.do(ctx, "PEXPIRE", "tag:"+tag, strconv.FormatInt(redisTagTTL.Milliseconds(), 10))
+	return err
+}
+
+func (r *RedisCache) InvalidateTags(ctx context.Context, tags ...string) error {
+	for _, tag := range tags {
+		reply, err := r.client.do(ctx, "SMEMBERS", "tag:"+tag)
+		if err != nil {
+			return err
+		}
+		keys := []string{"tag:" + tag}
+		members, _ := reply.([]interface{})
+		for _, member := range members {
+			if key, ok := member.([]byte); ok {
+				keys = append(keys, string(key))
+			}
+		}
+		if err = r.Delete(ctx, keys...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package infrastructures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"gotham/config"
+)
+
+// Captcha Service
+
+/**
+ * ICaptchaService
+ *
+ * interface
+ */
+type ICaptchaService interface {
+	// Verify checks the token the widget gave the client, remoteIP is optional
+	Verify(ctx context.Context, token string, remoteIP string) (bool, error)
+}
+
+var captchaVerifyUrls = map[string]string{
+	"hcaptcha":  "https://hcaptcha.com/siteverify",
+	"recaptcha": "https://www.google.com/recaptcha/api/siteverify",
+}
+
+/**
+ * NewCaptchaService
+ *
+ */
+func NewCaptchaService(captchaConfig *config.Captcha, clients IHttpClientFactory) (ICaptchaService, error) {
+	if captchaConfig.Driver == "none" {
+		return NoCaptchaService{}, nil
+	}
+	verifyUrl, ok := captchaVerifyUrls[captchaConfig.Driver]
+	if !ok {
+		return nil, fmt.Errorf("unsupported captcha driver %q", captchaConfig.Driver)
+	}
+	return &SiteVerifyCaptchaService{Secret: captchaConfig.Secret, VerifyUrl: verifyUrl, Client: clients.Make("captcha")}, nil
+}
+
+/**
+ * SiteVerifyCaptchaService
+ * hcaptcha and recaptcha share the same siteverify protocol
+ */
+type SiteVerifyCaptchaService struct {
+	Secret    string
+	VerifyUrl string
+	Client    IHttpClient
+}
+
+/**
+ * Verify
+ *
+ */
+func (s *SiteVerifyCaptchaService) Verify(ctx context.Context, token string, remoteIP string) (bool, error) {
+	form := url.Values{"secret": {s.Secret}, "response": {token}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.VerifyUrl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := s.Client.Do(request)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("captcha: %s", response.Status)
+	}
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err = json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return false, err
+	}
+	return result.Success, nil
+}
+
+/**
+ * NoCaptchaService
+ * without a provider every token passes, the challenge can not be enforced
+ */
+type NoCaptchaService struct{}
+
+func (NoCaptchaService) Verify(ctx context.Context, token string, remoteIP string) (bool, error) {
+	return true, nil
+}
+
+/**
+ * FakeCaptchaService
+ * mock of the test environment, only the Pass token is valid
+ */
+type FakeCaptchaService struct {
+	Pass string
+}
+
+func (f FakeCaptchaService) Verify(ctx context.Context, token string, remoteIP string) (bool, error) {
+	return token != "" && token == f.Pass, nil
+}
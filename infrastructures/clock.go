@@ -0,0 +1,67 @@
+package infrastructures
+
+import (
+	"sync"
+	"time"
+)
+
+/**
+ * IClock
+ * the time of the services, expiries and schedules are computed from it so a test sets the time
+ */
+type IClock interface {
+	Now() time.Time
+}
+
+/**
+ * SystemClock
+ *
+ */
+type SystemClock struct{}
+
+/**
+ * NewSystemClock
+ *
+ */
+func NewSystemClock() IClock {
+	return SystemClock{}
+}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+/**
+ * FakeClock
+ * a clock standing still until it is set or advanced
+ */
+type FakeClock struct {
+	now time.Time
+	mu  sync.Mutex
+}
+
+/**
+ * NewFakeClock
+ *
+ */
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (f *FakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *FakeClock) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = now
+}
+
+func (f *FakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
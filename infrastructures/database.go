@@ -1,9 +1,14 @@
 package infrastructures
 
 import (
+	"database/sql"
+	"fmt"
+	"sync"
+
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
 
 	"gotham/config"
 )
@@ -22,7 +27,11 @@ type IGormDatabase interface {
  */
 type GormDatabase struct {
 	Pool     IGormDatabasePool
+	Logger   gormLogger.Interface
+	Plugins  []gorm.Plugin
 	Database *gorm.DB
+
+	mu sync.RWMutex
 }
 
 /**
@@ -30,17 +39,64 @@ type GormDatabase struct {
  * get DB
  */
 func (g *GormDatabase) DB() *gorm.DB {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
 	return g.Database
 }
 
+/**
+ * IReconnectable
+ *
+ */
+type IReconnectable interface {
+	Reconnect() error
+}
+
+/**
+ * Reconnect
+ * open a new connection from the pool and swap it in, the holders of the database keep working with the new one
+ */
+func (g *GormDatabase) Reconnect() error {
+	fresh, err := NewGormDatabase(g.Pool, g.Logger, g.Plugins...)
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	old := g.Database
+	g.Database = fresh.Database
+	g.mu.Unlock()
+
+	if old != nil {
+		if sqlDB, err := old.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}
+	return nil
+}
+
 /**
  * NewGormDatabase
  *
  */
-func NewGormDatabase(pool IGormDatabasePool) (*GormDatabase, error) {
-	connection, err := gorm.Open(pool.GetDialector(), &gorm.Config{})
+func NewGormDatabase(pool IGormDatabasePool, logger gormLogger.Interface, plugins ...gorm.Plugin) (*GormDatabase, error) {
+	connection, err := gorm.Open(pool.GetDialector(), &gorm.Config{Logger: logger})
+	for _, plugin := range plugins {
+		if err != nil {
+			break
+		}
+		err = connection.Use(plugin)
+	}
+	if err == nil {
+		var sqlDB *sql.DB
+		if sqlDB, err = connection.DB(); err == nil {
+			pool.Configure(sqlDB)
+		}
+	}
 	return &GormDatabase{
 		Pool:     pool,
+		Logger:   logger,
+		Plugins:  plugins,
 		Database: connection,
 	}, err
 }
@@ -51,6 +107,7 @@ func NewGormDatabase(pool IGormDatabasePool) (*GormDatabase, error) {
  */
 type IGormDatabasePool interface {
 	GetDialector() gorm.Dialector
+	Configure(db *sql.DB)
 }
 
 /**
@@ -59,6 +116,7 @@ type IGormDatabasePool interface {
  */
 type GormDatabasePool struct {
 	Dialector gorm.Dialector
+	Config    config.Database
 }
 
 /**
@@ -69,19 +127,45 @@ func (m *GormDatabasePool) GetDialector() gorm.Dialector {
 	return m.Dialector
 }
 
+/**
+ * Configure
+ * apply the connection pool settings, zero values keep the database/sql defaults
+ */
+func (m *GormDatabasePool) Configure(db *sql.DB) {
+	if m.Config.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(m.Config.MaxOpenConns)
+	}
+	if m.Config.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(m.Config.MaxIdleConns)
+	}
+	if m.Config.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(m.Config.ConnMaxLifetime)
+	}
+	if m.Config.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(m.Config.ConnMaxIdleTime)
+	}
+}
+
+// pools by DB_CONNECTION, drivers built behind a build tag register themselves in their init
+var pools = map[string]func(dbConfig config.Database) IGormDatabasePool{
+	"mysql":    NewMysqlPool,
+	"postgres": NewPostgresPool,
+}
+
 /**
  * NewGormDatabasePool
  *
  */
-func NewGormDatabasePool(dbConfig config.Database) IGormDatabasePool {
-	switch dbConfig.DbConnection {
-	case "postgres":
-		return NewPostgresPool(dbConfig)
-	case "mysql":
-		return NewMysqlPool(dbConfig)
-	default:
-		return NewMysqlPool(dbConfig)
+func NewGormDatabasePool(dbConfig config.Database) (IGormDatabasePool, error) {
+	connection := dbConfig.DbConnection
+	if connection == "" {
+		connection = "mysql"
+	}
+	newPool, ok := pools[connection]
+	if !ok {
+		return nil, fmt.Errorf("unsupported DB_CONNECTION %v (sqlite needs the sqlite build tag)", connection)
 	}
+	return newPool(dbConfig), nil
 }
 
 /**
@@ -97,14 +181,26 @@ type MysqlPool struct {
  *
  */
 func NewMysqlPool(DbConfig config.Database) IGormDatabasePool {
-	dsn := DbConfig.DbUserName + ":" + DbConfig.DbPassword + "@(" + DbConfig.DbHost + ")/" + DbConfig.DbDatabase + "?charset=utf8&parseTime=True&loc=Local"
 	return &MysqlPool{
 		GormDatabasePool{
-			Dialector: mysql.Open(dsn),
+			Dialector: mysql.Open(MysqlDSN(DbConfig)),
+			Config:    DbConfig,
 		},
 	}
 }
 
+/**
+ * MysqlDSN
+ *
+ */
+func MysqlDSN(DbConfig config.Database) string {
+	address := DbConfig.DbHost
+	if DbConfig.DbPort != "" {
+		address += ":" + DbConfig.DbPort
+	}
+	return DbConfig.DbUserName + ":" + DbConfig.DbPassword + "@tcp(" + address + ")/" + DbConfig.DbDatabase + "?charset=utf8&parseTime=True&loc=Local"
+}
+
 /**
  * PostgresPool
  *
@@ -121,9 +217,22 @@ func NewPostgresPool(DbConfig config.Database) IGormDatabasePool {
 	return &PostgresPool{
 		GormDatabasePool{
 			Dialector: postgres.New(postgres.Config{
-				DSN:                  "user=" + DbConfig.DbUserName + " host=" + DbConfig.DbHost + " password=" + DbConfig.DbPassword + " dbname=" + DbConfig.DbDatabase + " port=" + DbConfig.DbPort + " sslmode=disable",
+				DSN:                  PostgresDSN(DbConfig),
 				PreferSimpleProtocol: true,
 			}),
+			Config: DbConfig,
 		},
 	}
 }
+
+/**
+ * PostgresDSN
+ *
+ */
+func PostgresDSN(DbConfig config.Database) string {
+	sslMode := DbConfig.DbSslMode
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+	return "user=" + DbConfig.DbUserName + " host=" + DbConfig.DbHost + " password=" + DbConfig.DbPassword + " dbname=" + DbConfig.DbDatabase + " port=" + DbConfig.DbPort + " sslmode=" + sslMode
+}
@@ -0,0 +1,99 @@
+package infrastructures
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"gotham/config"
+)
+
+/**
+ * IDatabaseSupervisor
+ *
+ */
+type IDatabaseSupervisor interface {
+	// Check pings the database and reports the pool stats, the connection is rebuilt once it failed for too long
+	Check() error
+}
+
+/**
+ * DatabaseSupervisor
+ *
+ */
+type DatabaseSupervisor struct {
+	Database IGormDatabase
+	Metrics  IMetrics
+	Config   *config.DbSupervisor
+
+	failingSince time.Time
+	mu           sync.Mutex
+}
+
+/**
+ * NewDatabaseSupervisor
+ *
+ */
+func NewDatabaseSupervisor(database IGormDatabase, metrics IMetrics, supervisorConfig *config.DbSupervisor) IDatabaseSupervisor {
+	return &DatabaseSupervisor{
+		Database: database,
+		Metrics:  metrics,
+		Config:   supervisorConfig,
+	}
+}
+
+/**
+ * Check
+ *
+ */
+func (s *DatabaseSupervisor) Check() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.ping()
+	if err == nil {
+		s.failingSince = time.Time{}
+		s.Metrics.Set("db_up", nil, 1)
+		return nil
+	}
+
+	s.Metrics.Set("db_up", nil, 0)
+	if s.failingSince.IsZero() {
+		s.failingSince = time.Now()
+	}
+	if time.Since(s.failingSince) < s.Config.ReconnectAfter {
+		return err
+	}
+
+	reconnectable, ok := s.Database.(IReconnectable)
+	if !ok {
+		return err
+	}
+	s.Metrics.Inc("db_reconnects_total", nil, 1)
+	if reconnectErr := reconnectable.Reconnect(); reconnectErr != nil {
+		return fmt.Errorf("%v, reconnect failed: %v", err, reconnectErr)
+	}
+	log.Printf("database: connection rebuilt after failing for %v", time.Since(s.failingSince).Round(time.Second))
+	s.failingSince = time.Time{}
+	return nil
+}
+
+func (s *DatabaseSupervisor) ping() error {
+	sqlDB, err := s.Database.DB().DB()
+	if err != nil {
+		return err
+	}
+
+	stats := sqlDB.Stats()
+	s.Metrics.Set("db_open_connections", nil, float64(stats.OpenConnections))
+	s.Metrics.Set("db_in_use_connections", nil, float64(stats.InUse))
+	s.Metrics.Set("db_idle_connections", nil, float64(stats.Idle))
+	s.Metrics.Set("db_wait_count", nil, float64(stats.WaitCount))
+	s.Metrics.Set("db_wait_duration_seconds", nil, stats.WaitDuration.Seconds())
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.Config.PingTimeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
@@ -0,0 +1,52 @@
+//go:build sqlite
+// +build sqlite
+
+package infrastructures
+
+import (
+	"strings"
+
+	"gorm.io/driver/sqlite"
+
+	"gotham/config"
+)
+
+// sqlite is behind its build tag, the driver needs cgo, build with `-tags sqlite` to use DB_CONNECTION=sqlite
+func init() {
+	pools["sqlite"] = NewSqlitePool
+}
+
+/**
+ * SqlitePool
+ *
+ */
+type SqlitePool struct {
+	GormDatabasePool
+}
+
+/**
+ * NewSqlitePool
+ *
+ */
+func NewSqlitePool(DbConfig config.Database) IGormDatabasePool {
+	return &SqlitePool{
+		GormDatabasePool{
+			Dialector: sqlite.Open(SqliteDSN(DbConfig)),
+			Config:    DbConfig,
+		},
+	}
+}
+
+/**
+ * SqliteDSN
+ * DB_DATABASE is the path of the database file, ":memory:" for an in memory database, or a file uri with its options
+ */
+func SqliteDSN(DbConfig config.Database) string {
+	if DbConfig.DbDatabase == "" || DbConfig.DbDatabase == ":memory:" {
+		return "file::memory:?cache=shared"
+	}
+	if strings.Contains(DbConfig.DbDatabase, "?") {
+		return DbConfig.DbDatabase + "&_foreign_keys=on"
+	}
+	return DbConfig.DbDatabase + "?_foreign_keys=on"
+}
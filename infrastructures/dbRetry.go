@@ -0,0 +1,123 @@
+package infrastructures
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"math/rand"
+	"syscall"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	"gotham/config"
+)
+
+// the reasons a database error is transient, the label of the retry metrics
+const (
+	DbErrorDeadlock      = "deadlock"
+	DbErrorSerialization = "serialization"
+	DbErrorLockTimeout   = "lock_timeout"
+	DbErrorConnection    = "connection"
+)
+
+/**
+ * TransientDbError
+ * the reason the error of the database is worth retrying, empty when it is not: a deadlock, a serialization failure or
+ * a lock wait timeout, which a new attempt usually gets past, or a connection reset, which the pool replaces
+ */
+func TransientDbError(err error) string {
+	if err == nil {
+		return ""
+	}
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1213:
+			return DbErrorDeadlock
+		case 1205:
+			return DbErrorLockTimeout
+		}
+		return ""
+	}
+	// the postgres errors of pgconn
+	var pgErr interface{ SQLState() string }
+	if errors.As(err, &pgErr) {
+		switch pgErr.SQLState() {
+		case "40P01":
+			return DbErrorDeadlock
+		case "40001":
+			return DbErrorSerialization
+		case "55P03":
+			return DbErrorLockTimeout
+		}
+		return ""
+	}
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, mysql.ErrInvalidConn) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return DbErrorConnection
+	}
+	return ""
+}
+
+/**
+ * IDbRetrier
+ *
+ */
+type IDbRetrier interface {
+	// Do runs the operation again after a backoff while it fails on a transient error of the database, DB_RETRIES
+	// times at most. The operation must be whole to be run again: a transaction, not a statement inside one
+	Do(ctx context.Context, operation string, fn func() error) error
+}
+
+/**
+ * DbRetrier
+ *
+ */
+type DbRetrier struct {
+	Metrics IMetrics
+	Config  *config.DbRetry
+}
+
+/**
+ * NewDbRetrier
+ *
+ */
+func NewDbRetrier(metrics IMetrics, retryConfig *config.DbRetry) IDbRetrier {
+	return &DbRetrier{
+		Metrics: metrics,
+		Config:  retryConfig,
+	}
+}
+
+func (r *DbRetrier) Do(ctx context.Context, operation string, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		reason := TransientDbError(err)
+		if reason == "" {
+			return err
+		}
+		labels := map[string]string{"operation": operation, "reason": reason}
+		if attempt >= r.Config.Retries {
+			r.Metrics.Inc("db_retries_exhausted_total", labels, 1)
+			return err
+		}
+		r.Metrics.Inc("db_retries_total", labels, 1)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(r.backoff(attempt)):
+		}
+	}
+}
+
+// backoff is a random wait up to the exponential backoff of the attempt, the transactions that deadlocked together do
+// not run again together
+func (r *DbRetrier) backoff(attempt int) time.Duration {
+	backoff := r.Config.Backoff << attempt
+	if backoff <= 0 || backoff > r.Config.MaxBackoff {
+		backoff = r.Config.MaxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
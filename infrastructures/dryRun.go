@@ -0,0 +1,24 @@
+package infrastructures
+
+import (
+	"context"
+)
+
+type dryRunKey struct{}
+
+/**
+ * WithDryRun
+ * marks the context of a dry run, the transactions of the unit of work run with it are rolled back
+ */
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+/**
+ * IsDryRun
+ * tells if the context is the one of a dry run, the services skip what follows a commit (events, mails, caches)
+ */
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
@@ -2,7 +2,9 @@ package infrastructures
 
 import (
 	"fmt"
+	"log"
 	"net/smtp"
+	"sync"
 
 	"github.com/jordan-wright/email"
 
@@ -41,3 +43,38 @@ func NewEmailService(emailConfig *config.Email) IEmailService {
 func (e EmailService) Send(Context email.Email) error {
 	return Context.Send(fmt.Sprintf("%v:%v", e.Config.Host, e.Config.Port), smtp.PlainAuth("", e.Config.From, e.Config.Password, e.Config.Host))
 }
+
+/**
+ * LogEmailService
+ * mock mailer of the test environment, mails are logged and kept instead of being sent
+ */
+type LogEmailService struct {
+	sent []email.Email
+	mu   sync.Mutex
+}
+
+func NewLogEmailService() *LogEmailService {
+	return &LogEmailService{}
+}
+
+/**
+ * Send
+ *
+ */
+func (e *LogEmailService) Send(Context email.Email) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.sent = append(e.sent, Context)
+	log.Printf("email: %q to %v", Context.Subject, Context.To)
+	return nil
+}
+
+/**
+ * Sent
+ * the mails sent so far
+ */
+func (e *LogEmailService) Sent() []email.Email {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]email.Email(nil), e.sent...)
+}
@@ -0,0 +1,641 @@
+package infrastructures
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	mathRand "math/rand"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"gotham/config"
+)
+
+// Error Reporter
+
+// the reports sent at once by a remote reporter, the next ones are dropped until one is done
+const reporterConcurrency = 16
+
+/**
+ * StackFrame
+ * a call of a stack trace, the function qualified by its package like gotham/services.(*UserService).GetUserByID
+ */
+type StackFrame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+func (f StackFrame) String() string {
+	return fmt.Sprintf("%v %v:%d", f.Function, f.File, f.Line)
+}
+
+/**
+ * CaptureStack
+ * the stack of the caller, the innermost call first. skip drops the calls of the caller, 0 keeps it
+ */
+func CaptureStack(skip int) []StackFrame {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip+2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	var stack []StackFrame
+	for {
+		frame, more := frames.Next()
+		stack = append(stack, StackFrame{Function: frame.Function, File: frame.File, Line: frame.Line})
+		if !more {
+			return stack
+		}
+	}
+}
+
+/**
+ * ReportScope
+ * what is known of the request an error breaks, the middlewares fill the scope of the request context as they learn
+ * it (the recovery middleware the request, the auth middleware the user)
+ */
+type ReportScope struct {
+	Method    string
+	URL       string
+	Route     string
+	IP        string
+	RequestID string
+	// 0 for a guest
+	UserID uint
+	Tags   map[string]string
+}
+
+type reportScopeKey struct{}
+
+// WithReportScope the context whose reports are enriched with the scope
+func WithReportScope(ctx context.Context, scope *ReportScope) context.Context {
+	return context.WithValue(ctx, reportScopeKey{}, scope)
+}
+
+// ReportScopeFrom the scope of the context, nil outside a request
+func ReportScopeFrom(ctx context.Context) *ReportScope {
+	scope, _ := ctx.Value(reportScopeKey{}).(*ReportScope)
+	return scope
+}
+
+/**
+ * ErrorReport
+ * an error nobody handled, with the request it broke
+ */
+type ErrorReport struct {
+	Error error
+	// the error is a recovered panic
+	Panic bool
+	Stack []StackFrame
+	Time  time.Time
+	ReportScope
+
+	// set by the reporter
+	Environment string
+	Release     string
+	Breadcrumbs []Breadcrumb
+}
+
+// enrich fills what the report does not say from the scope of the context and the config
+func (report ErrorReport) enrich(ctx context.Context, errorsConfig *config.ErrorReporting) ErrorReport {
+	if scope := ReportScopeFrom(ctx); scope != nil {
+		tags := map[string]string{}
+		for key, value := range scope.Tags {
+			tags[key] = value
+		}
+		for key, value := range report.Tags {
+			tags[key] = value
+		}
+		if report.Method == "" {
+			report.Method, report.URL, report.Route, report.IP = scope.Method, scope.URL, scope.Route, scope.IP
+		}
+		if report.RequestID == "" {
+			report.RequestID = scope.RequestID
+		}
+		if report.UserID == 0 {
+			report.UserID = scope.UserID
+		}
+		report.Tags = tags
+	}
+	if report.Time.IsZero() {
+		report.Time = time.Now()
+	}
+	report.Environment = errorsConfig.Environment
+	report.Release = errorsConfig.Release
+	return report
+}
+
+/**
+ * IErrorReporter
+ *
+ * interface
+ */
+type IErrorReporter interface {
+	// Report does not block the request, a report failing to be sent is logged. The report is enriched with the scope of ctx
+	Report(ctx context.Context, report ErrorReport)
+	// Close waits for the reports being sent
+	Close() error
+}
+
+/**
+ * NewErrorReporter
+ * sentry and rollbar without their dsn or token report nothing, so a deployment can leave them unset
+ */
+func NewErrorReporter(errorsConfig *config.ErrorReporting, clients IHttpClientFactory, logger ILogger, breadcrumbs *Breadcrumbs) (IErrorReporter, error) {
+	switch errorsConfig.Driver {
+	case "log":
+		return NewLogErrorReporter(errorsConfig, logger), nil
+	case "none":
+		return NoopErrorReporter{}, nil
+	case "sentry":
+		if errorsConfig.SentryDSN == "" {
+			log.Printf("errors: SENTRY_DSN is not set, the errors are not reported")
+			return NoopErrorReporter{}, nil
+		}
+		reporter, err := NewSentryErrorReporter(errorsConfig, clients.Make("sentry"), breadcrumbs)
+		if err != nil {
+			return nil, err
+		}
+		return NewSampledErrorReporter(reporter, errorsConfig.SampleRate), nil
+	case "rollbar":
+		if errorsConfig.RollbarToken == "" {
+			log.Printf("errors: ROLLBAR_ACCESS_TOKEN is not set, the errors are not reported")
+			return NoopErrorReporter{}, nil
+		}
+		reporter := NewRollbarErrorReporter(errorsConfig, clients.Make("rollbar"), breadcrumbs)
+		return NewSampledErrorReporter(reporter, errorsConfig.SampleRate), nil
+	}
+	return nil, fmt.Errorf("unsupported error reporter driver %q", errorsConfig.Driver)
+}
+
+/**
+ * NoopErrorReporter
+ * drops the reports
+ */
+type NoopErrorReporter struct{}
+
+func (NoopErrorReporter) Report(ctx context.Context, report ErrorReport) {}
+
+func (NoopErrorReporter) Close() error {
+	return nil
+}
+
+/**
+ * SampledErrorReporter
+ * sends the share Rate of the reports, so a burst of errors does not exhaust the quota of the project
+ */
+type SampledErrorReporter struct {
+	Reporter IErrorReporter
+	Rate     float64
+
+	random *mathRand.Rand
+	mu     sync.Mutex
+}
+
+func NewSampledErrorReporter(reporter IErrorReporter, rate float64) IErrorReporter {
+	if rate >= 1 {
+		return reporter
+	}
+	return &SampledErrorReporter{Reporter: reporter, Rate: rate, random: mathRand.New(mathRand.NewSource(time.Now().UnixNano()))}
+}
+
+/**
+ * Report
+ *
+ */
+func (r *SampledErrorReporter) Report(ctx context.Context, report ErrorReport) {
+	r.mu.Lock()
+	sampled := r.random.Float64() < r.Rate
+	r.mu.Unlock()
+	if sampled {
+		r.Reporter.Report(ctx, report)
+	}
+}
+
+/**
+ * Close
+ *
+ */
+func (r *SampledErrorReporter) Close() error {
+	return r.Reporter.Close()
+}
+
+// background sends the reports of a remote reporter, at most reporterConcurrency at once
+type background struct {
+	name    string
+	sending chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newBackground(name string) *background {
+	return &background{name: name, sending: make(chan struct{}, reporterConcurrency)}
+}
+
+func (b *background) run(send func() error) {
+	select {
+	case b.sending <- struct{}{}:
+	default:
+		log.Printf("%v: %v reports are being sent, dropping one", b.name, reporterConcurrency)
+		return
+	}
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		defer func() { <-b.sending }()
+		if err := send(); err != nil {
+			log.Printf("%v: %v", b.name, err)
+		}
+	}()
+}
+
+/**
+ * SentryErrorReporter
+ * sends the reports as events of an envelope to the project of the dsn
+ */
+type SentryErrorReporter struct {
+	Config      *config.ErrorReporting
+	Client      IHttpClient
+	Breadcrumbs *Breadcrumbs
+
+	endpoint   string
+	key        string
+	background *background
+}
+
+func NewSentryErrorReporter(errorsConfig *config.ErrorReporting, client IHttpClient, breadcrumbs *Breadcrumbs) (*SentryErrorReporter, error) {
+	dsn, err := url.Parse(errorsConfig.SentryDSN)
+	if err != nil {
+		return nil, fmt.Errorf("sentry: invalid dsn: %v", err)
+	}
+	i := strings.LastIndex(dsn.Path, "/")
+	if dsn.User == nil || dsn.User.Username() == "" || i < 0 || dsn.Path[i+1:] == "" {
+		return nil, fmt.Errorf("sentry: the dsn is not https://<public key>@<host>/<project id>")
+	}
+	endpoint := url.URL{Scheme: dsn.Scheme, Host: dsn.Host, Path: dsn.Path[:i] + "/api/" + dsn.Path[i+1:] + "/envelope/"}
+	return &SentryErrorReporter{
+		Config:      errorsConfig,
+		Client:      client,
+		Breadcrumbs: breadcrumbs,
+		endpoint:    endpoint.String(),
+		key:         dsn.User.Username(),
+		background:  newBackground("sentry"),
+	}, nil
+}
+
+/**
+ * Report
+ *
+ */
+func (r *SentryErrorReporter) Report(ctx context.Context, report ErrorReport) {
+	report = report.enrich(ctx, r.Config)
+	if r.Breadcrumbs != nil {
+		report.Breadcrumbs = r.Breadcrumbs.Last()
+	}
+	r.background.run(func() error {
+		return r.send(report)
+	})
+}
+
+/**
+ * Close
+ *
+ */
+func (r *SentryErrorReporter) Close() error {
+	r.background.wg.Wait()
+	return nil
+}
+
+func (r *SentryErrorReporter) send(report ErrorReport) error {
+	id, err := eventID()
+	if err != nil {
+		return err
+	}
+	event, err := json.Marshal(r.event(id, report))
+	if err != nil {
+		return err
+	}
+	// an envelope is its header, then the header and the payload of each item, one json per line
+	var body bytes.Buffer
+	header, _ := json.Marshal(map[string]interface{}{"event_id": id, "sent_at": time.Now().UTC().Format(time.RFC3339Nano)})
+	item, _ := json.Marshal(map[string]interface{}{"type": "event", "length": len(event), "content_type": "application/json"})
+	body.Write(header)
+	body.WriteByte('\n')
+	body.Write(item)
+	body.WriteByte('\n')
+	body.Write(event)
+	body.WriteByte('\n')
+
+	request, err := http.NewRequest(http.MethodPost, r.endpoint, &body)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/x-sentry-envelope")
+	request.Header.Set("X-Sentry-Auth", "Sentry sentry_version=7, sentry_client=gotham/1.0, sentry_key="+r.key)
+	return post(r.Client, request)
+}
+
+func (r *SentryErrorReporter) event(id string, report ErrorReport) map[string]interface{} {
+	// sentry lists the frames the outermost call first
+	frames := make([]map[string]interface{}, 0, len(report.Stack))
+	for i := len(report.Stack) - 1; i >= 0; i-- {
+		frame := report.Stack[i]
+		module, function := splitFunction(frame.Function)
+		frames = append(frames, map[string]interface{}{
+			"function": function,
+			"module":   module,
+			"abs_path": frame.File,
+			"filename": filepath.Base(frame.File),
+			"lineno":   frame.Line,
+			"in_app":   strings.HasPrefix(module, "gotham"),
+		})
+	}
+	breadcrumbs := make([]map[string]interface{}, 0, len(report.Breadcrumbs))
+	for _, breadcrumb := range report.Breadcrumbs {
+		level := breadcrumb.Level
+		if level == "warn" {
+			level = "warning"
+		}
+		category := "log"
+		if component, ok := breadcrumb.Fields["component"].(string); ok {
+			category = component
+		}
+		breadcrumbs = append(breadcrumbs, map[string]interface{}{
+			"timestamp": breadcrumb.Time.UTC().Format(time.RFC3339Nano),
+			"category":  category,
+			"level":     level,
+			"message":   breadcrumb.Message,
+			"data":      breadcrumb.Fields,
+		})
+	}
+	level, mechanism := "error", "generic"
+	if report.Panic {
+		level, mechanism = "fatal", "recover"
+	}
+	tags := map[string]string{"route": report.Route, "request_id": report.RequestID}
+	for key, value := range report.Tags {
+		tags[key] = value
+	}
+	event := map[string]interface{}{
+		"event_id":    id,
+		"timestamp":   report.Time.UTC().Format(time.RFC3339Nano),
+		"level":       level,
+		"platform":    "go",
+		"environment": report.Environment,
+		"exception": map[string]interface{}{
+			"values": []map[string]interface{}{{
+				"type":       fmt.Sprintf("%T", report.Error),
+				"value":      report.Error.Error(),
+				"stacktrace": map[string]interface{}{"frames": frames},
+				"mechanism":  map[string]interface{}{"type": mechanism, "handled": false},
+			}},
+		},
+		"breadcrumbs": map[string]interface{}{"values": breadcrumbs},
+		"request":     map[string]interface{}{"method": report.Method, "url": report.URL},
+		"tags":        tags,
+	}
+	if report.Release != "" {
+		event["release"] = report.Release
+	}
+	user := map[string]interface{}{"ip_address": report.IP}
+	if report.UserID != 0 {
+		user["id"] = fmt.Sprint(report.UserID)
+	}
+	event["user"] = user
+	return event
+}
+
+/**
+ * RollbarErrorReporter
+ * sends the reports as items of the project of the token
+ */
+type RollbarErrorReporter struct {
+	Config      *config.ErrorReporting
+	Client      IHttpClient
+	Breadcrumbs *Breadcrumbs
+
+	background *background
+}
+
+func NewRollbarErrorReporter(errorsConfig *config.ErrorReporting, client IHttpClient, breadcrumbs *Breadcrumbs) *RollbarErrorReporter {
+	return &RollbarErrorReporter{Config: errorsConfig, Client: client, Breadcrumbs: breadcrumbs, background: newBackground("rollbar")}
+}
+
+/**
+ * Report
+ *
+ */
+func (r *RollbarErrorReporter) Report(ctx context.Context, report ErrorReport) {
+	report = report.enrich(ctx, r.Config)
+	if r.Breadcrumbs != nil {
+		report.Breadcrumbs = r.Breadcrumbs.Last()
+	}
+	r.background.run(func() error {
+		return r.send(report)
+	})
+}
+
+/**
+ * Close
+ *
+ */
+func (r *RollbarErrorReporter) Close() error {
+	r.background.wg.Wait()
+	return nil
+}
+
+func (r *RollbarErrorReporter) send(report ErrorReport) error {
+	id, err := eventID()
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]interface{}{"data": r.item(id, report)})
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, "https://api.rollbar.com/api/1/item/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Rollbar-Access-Token", r.Config.RollbarToken)
+	return post(r.Client, request)
+}
+
+func (r *RollbarErrorReporter) item(id string, report ErrorReport) map[string]interface{} {
+	// rollbar lists the frames the most recent call last
+	frames := make([]map[string]interface{}, 0, len(report.Stack))
+	for i := len(report.Stack) - 1; i >= 0; i-- {
+		frame := report.Stack[i]
+		frames = append(frames, map[string]interface{}{
+			"filename": frame.File,
+			"lineno":   frame.Line,
+			"method":   frame.Function,
+		})
+	}
+	telemetry := make([]map[string]interface{}, 0, len(report.Breadcrumbs))
+	for _, breadcrumb := range report.Breadcrumbs {
+		level := breadcrumb.Level
+		if level == "warn" {
+			level = "warning"
+		}
+		entry := map[string]interface{}{"message": breadcrumb.Message}
+		for key, value := range breadcrumb.Fields {
+			entry[key] = value
+		}
+		telemetry = append(telemetry, map[string]interface{}{
+			"level":        level,
+			"type":         "log",
+			"source":       "server",
+			"timestamp_ms": breadcrumb.Time.UnixNano() / int64(time.Millisecond),
+			"body":         entry,
+		})
+	}
+	level := "error"
+	if report.Panic {
+		level = "critical"
+	}
+	custom := map[string]string{"route": report.Route, "request_id": report.RequestID}
+	for key, value := range report.Tags {
+		custom[key] = value
+	}
+	item := map[string]interface{}{
+		"uuid":        id,
+		"environment": report.Environment,
+		"level":       level,
+		"timestamp":   report.Time.Unix(),
+		"platform":    "go",
+		"language":    "go",
+		"framework":   "echo",
+		"body": map[string]interface{}{
+			"trace": map[string]interface{}{
+				"frames":    frames,
+				"exception": map[string]interface{}{"class": fmt.Sprintf("%T", report.Error), "message": report.Error.Error()},
+			},
+			"telemetry": telemetry,
+		},
+		"request": map[string]interface{}{"method": report.Method, "url": report.URL, "user_ip": report.IP},
+		"custom":  custom,
+	}
+	if report.Release != "" {
+		item["code_version"] = report.Release
+	}
+	if report.UserID != 0 {
+		item["person"] = map[string]interface{}{"id": fmt.Sprint(report.UserID)}
+	}
+	return item
+}
+
+// post sends the request of a report, a response that is not a success is an error
+func post(client IHttpClient, request *http.Request) error {
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= 300 {
+		content, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("%s: %s", response.Status, content)
+	}
+	return nil
+}
+
+// splitFunction splits gotham/services.(*UserService).Get at the dot ending its package
+func splitFunction(function string) (module string, name string) {
+	slash := strings.LastIndex(function, "/")
+	dot := strings.Index(function[slash+1:], ".")
+	if dot < 0 {
+		return "", function
+	}
+	return function[:slash+1+dot], function[slash+2+dot:]
+}
+
+// eventID a random uuid written as 32 hex digits
+func eventID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+/**
+ * LogErrorReporter
+ * reporter of development and test environments, the reports are logged and kept instead of being sent
+ */
+type LogErrorReporter struct {
+	Config *config.ErrorReporting
+	Logger ILogger
+
+	reported []ErrorReport
+	mu       sync.Mutex
+}
+
+func NewLogErrorReporter(errorsConfig *config.ErrorReporting, logger ILogger) *LogErrorReporter {
+	return &LogErrorReporter{Config: errorsConfig, Logger: logger}
+}
+
+/**
+ * Report
+ *
+ */
+func (r *LogErrorReporter) Report(ctx context.Context, report ErrorReport) {
+	report = report.enrich(ctx, r.Config)
+	r.mu.Lock()
+	r.reported = append(r.reported, report)
+	r.mu.Unlock()
+
+	stack := make([]string, 0, len(report.Stack))
+	for _, frame := range report.Stack {
+		stack = append(stack, frame.String())
+	}
+	fields := Fields{
+		"error":  report.Error.Error(),
+		"panic":  report.Panic,
+		"stack":  stack,
+		"method": report.Method,
+		"uri":    report.URL,
+		"route":  report.Route,
+		"ip":     report.IP,
+	}
+	if report.RequestID != "" {
+		fields["request_id"] = report.RequestID
+	}
+	if report.UserID != 0 {
+		fields["user_id"] = report.UserID
+	}
+	if report.Release != "" {
+		fields["release"] = report.Release
+	}
+	for key, value := range report.Tags {
+		fields["tag_"+key] = value
+	}
+	r.Logger.Error("unhandled error", fields)
+}
+
+/**
+ * Reported
+ * the reports so far
+ */
+func (r *LogErrorReporter) Reported() []ErrorReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]ErrorReport(nil), r.reported...)
+}
+
+/**
+ * Close
+ *
+ */
+func (r *LogErrorReporter) Close() error {
+	return nil
+}
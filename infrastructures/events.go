@@ -0,0 +1,94 @@
+package infrastructures
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+/**
+ * Event
+ *
+ */
+type Event struct {
+	Name    string
+	Payload interface{}
+	Time    time.Time
+}
+
+type EventHandler func(event Event) error
+
+/**
+ * IEventBus
+ *
+ */
+type IEventBus interface {
+	Publish(name string, payload interface{})
+	Subscribe(name string, handler EventHandler)
+	Close() error
+}
+
+/**
+ * MemoryEventBus
+ * delivers the events to the handlers subscribed in the process, each handler runs in its own goroutine so a slow
+ * or failing handler does not hold the publisher, events published while nothing listens are dropped
+ */
+type MemoryEventBus struct {
+	handlers map[string][]EventHandler
+	mu       sync.RWMutex
+	wg       sync.WaitGroup
+}
+
+/**
+ * NewMemoryEventBus
+ *
+ */
+func NewMemoryEventBus() IEventBus {
+	return &MemoryEventBus{
+		handlers: map[string][]EventHandler{},
+	}
+}
+
+/**
+ * Publish
+ *
+ */
+func (b *MemoryEventBus) Publish(name string, payload interface{}) {
+	event := Event{Name: name, Payload: payload, Time: time.Now()}
+	b.mu.RLock()
+	handlers := b.handlers[name]
+	b.mu.RUnlock()
+	for _, handler := range handlers {
+		b.wg.Add(1)
+		go func(handler EventHandler) {
+			defer b.wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					log.Printf("events: handler of %v panicked: %v", name, r)
+				}
+			}()
+			if err := handler(event); err != nil {
+				log.Printf("events: handler of %v failed: %v", name, err)
+			}
+		}(handler)
+	}
+}
+
+/**
+ * Subscribe
+ *
+ */
+func (b *MemoryEventBus) Subscribe(name string, handler EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+/**
+ * Close
+ * wait for the running handlers to finish
+ */
+func (b *MemoryEventBus) Close() error {
+	b.wg.Wait()
+	return nil
+}
@@ -0,0 +1,113 @@
+package infrastructures
+
+import (
+	"gotham/config"
+)
+
+/**
+ * IFeatureFlags
+ *
+ */
+type IFeatureFlags interface {
+	Enabled(name string) bool
+	Exists(name string) bool
+	All() map[string]bool
+}
+
+/**
+ * FeatureFlags
+ * global flags read from the configuration
+ */
+type FeatureFlags struct {
+	Config *config.Features
+}
+
+/**
+ * NewFeatureFlags
+ *
+ */
+func NewFeatureFlags(featuresConfig *config.Features) IFeatureFlags {
+	return &FeatureFlags{
+		Config: featuresConfig,
+	}
+}
+
+/**
+ * Enabled
+ *
+ */
+func (f *FeatureFlags) Enabled(name string) bool {
+	return f.Config.Flags[name]
+}
+
+/**
+ * Exists
+ *
+ */
+func (f *FeatureFlags) Exists(name string) bool {
+	_, ok := f.Config.Flags[name]
+	return ok
+}
+
+/**
+ * All
+ *
+ */
+func (f *FeatureFlags) All() map[string]bool {
+	all := make(map[string]bool, len(f.Config.Flags))
+	for name, enabled := range f.Config.Flags {
+		all[name] = enabled
+	}
+	return all
+}
+
+/**
+ * OverriddenFeatureFlags
+ * flags of a single request, overrides win over the global state
+ */
+type OverriddenFeatureFlags struct {
+	Base      IFeatureFlags
+	Overrides map[string]bool
+}
+
+/**
+ * Enabled
+ *
+ */
+func (f *OverriddenFeatureFlags) Enabled(name string) bool {
+	if enabled, ok := f.Overrides[name]; ok {
+		return enabled
+	}
+	return f.Base.Enabled(name)
+}
+
+/**
+ * Exists
+ *
+ */
+func (f *OverriddenFeatureFlags) Exists(name string) bool {
+	return f.Base.Exists(name)
+}
+
+/**
+ * All
+ *
+ */
+func (f *OverriddenFeatureFlags) All() map[string]bool {
+	all := f.Base.All()
+	for name, enabled := range f.Overrides {
+		all[name] = enabled
+	}
+	return all
+}
+
+/**
+ * ConvertFeatureFlags
+ * get the flags stored on the request by the features middleware, fallback when there is none
+ */
+func ConvertFeatureFlags(flags interface{}, fallback IFeatureFlags) IFeatureFlags {
+	if f, ok := flags.(IFeatureFlags); ok {
+		return f
+	}
+	return fallback
+}
@@ -0,0 +1,172 @@
+package infrastructures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+
+	"gotham/config"
+)
+
+type queryStatsKey struct{}
+
+/**
+ * QueryStats
+ * queries run with a request context, attached to the access log entry of the request
+ */
+type QueryStats struct {
+	count    int64
+	duration time.Duration
+	shapes   map[string]int
+	mu       sync.Mutex
+}
+
+/**
+ * WithQueryStats
+ * a context counting the queries run with it, pass it with DB().WithContext
+ */
+func WithQueryStats(ctx context.Context) (context.Context, *QueryStats) {
+	stats := &QueryStats{}
+	return context.WithValue(ctx, queryStatsKey{}, stats), stats
+}
+
+/**
+ * QueryStatsFrom
+ *
+ */
+func QueryStatsFrom(ctx context.Context) *QueryStats {
+	stats, _ := ctx.Value(queryStatsKey{}).(*QueryStats)
+	return stats
+}
+
+func (s *QueryStats) add(duration time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.count++
+	s.duration += duration
+}
+
+// repeat counts a statement, the sql with its placeholders identifies it whatever the parameters
+func (s *QueryStats) repeat(sql string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.shapes == nil {
+		s.shapes = map[string]int{}
+	}
+	s.shapes[sql]++
+	return s.shapes[sql]
+}
+
+/**
+ * Get
+ * the number of queries and their total duration
+ */
+func (s *QueryStats) Get() (count int64, duration time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.count, s.duration
+}
+
+var (
+	sqlStrings = regexp.MustCompile(`'(?:[^']|'')*'`)
+	sqlNumbers = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+)
+
+/**
+ * GormLogger
+ * gorm logger adapter writing through the structured logger, queries slower than the threshold are warned about
+ */
+type GormLogger struct {
+	Logger ILogger
+	Config *config.Logger
+	level  gormLogger.LogLevel
+}
+
+/**
+ * NewGormLogger
+ *
+ */
+func NewGormLogger(logger ILogger, loggerConfig *config.Logger) gormLogger.Interface {
+	return &GormLogger{
+		Logger: logger.With(Fields{"component": "gorm"}),
+		Config: loggerConfig,
+		level:  gormLogger.Warn,
+	}
+}
+
+func (l *GormLogger) LogMode(level gormLogger.LogLevel) gormLogger.Interface {
+	copied := *l
+	copied.level = level
+	return &copied
+}
+
+func (l *GormLogger) Info(ctx context.Context, message string, data ...interface{}) {
+	if l.level >= gormLogger.Info {
+		l.Logger.Info(fmt.Sprintf(message, data...), nil)
+	}
+}
+
+func (l *GormLogger) Warn(ctx context.Context, message string, data ...interface{}) {
+	if l.level >= gormLogger.Warn {
+		l.Logger.Warn(fmt.Sprintf(message, data...), nil)
+	}
+}
+
+func (l *GormLogger) Error(ctx context.Context, message string, data ...interface{}) {
+	if l.level >= gormLogger.Error {
+		l.Logger.Error(fmt.Sprintf(message, data...), nil)
+	}
+}
+
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	elapsed := time.Since(begin)
+	if stats := QueryStatsFrom(ctx); stats != nil {
+		stats.add(elapsed)
+	}
+	if trace := TraceFrom(ctx); trace != nil {
+		sql, _ := fc()
+		span := Span{Kind: SpanDB, Name: RedactSQL(sql), Start: begin, Duration: elapsed}
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			span.Error = err.Error()
+		}
+		trace.Add(span)
+	}
+	if l.level <= gormLogger.Silent {
+		return
+	}
+
+	fields := func() Fields {
+		sql, rows := fc()
+		if l.Config.RedactQueries {
+			sql = RedactSQL(sql)
+		}
+		return Fields{"sql": sql, "rows": rows, "duration_ms": float64(elapsed.Microseconds()) / 1000}
+	}
+
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && l.level >= gormLogger.Error:
+		entry := fields()
+		entry["error"] = err
+		l.Logger.Error("query failed", entry)
+	case l.Config.SlowQueryThreshold > 0 && elapsed > l.Config.SlowQueryThreshold && l.level >= gormLogger.Warn:
+		entry := fields()
+		entry["threshold_ms"] = l.Config.SlowQueryThreshold.Milliseconds()
+		l.Logger.Warn("slow query", entry)
+	case l.Config.LogQueries:
+		l.Logger.Debug("query", fields())
+	}
+}
+
+/**
+ * RedactSQL
+ * replace the literal values of a query, the parameters gorm interpolates for logging included
+ */
+func RedactSQL(sql string) string {
+	return sqlNumbers.ReplaceAllString(sqlStrings.ReplaceAllString(sql, "'?'"), "?")
+}
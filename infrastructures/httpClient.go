@@ -0,0 +1,268 @@
+package infrastructures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"gotham/config"
+)
+
+var ErrHttpCircuitOpen = errors.New("http client: circuit open")
+
+/**
+ * IHttpClient
+ * sends the requests of a consumer to a third party
+ */
+type IHttpClient interface {
+	Do(request *http.Request) (*http.Response, error)
+}
+
+/**
+ * IHttpClientFactory
+ *
+ */
+type IHttpClientFactory interface {
+	Make(name string) IHttpClient
+}
+
+/**
+ * HttpClientFactory
+ * every named client is created once and shared, so connections are pooled per consumer. The circuits are per host and
+ * shared by the clients, a host that is down is down for all of them.
+ */
+type HttpClientFactory struct {
+	Config   *config.HttpClient
+	Metrics  IMetrics
+	clients  map[string]IHttpClient
+	breakers map[string]*httpBreaker
+	mu       sync.Mutex
+}
+
+/**
+ * NewHttpClientFactory
+ *
+ */
+func NewHttpClientFactory(httpConfig *config.HttpClient, metrics IMetrics) IHttpClientFactory {
+	return &HttpClientFactory{
+		Config:   httpConfig,
+		Metrics:  metrics,
+		clients:  map[string]IHttpClient{},
+		breakers: map[string]*httpBreaker{},
+	}
+}
+
+/**
+ * Make
+ * get (or create) the client registered under name
+ */
+func (f *HttpClientFactory) Make(name string) IHttpClient {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if client, ok := f.clients[name]; ok {
+		return client
+	}
+	client := &HttpClient{
+		Name:    name,
+		Config:  f.Config,
+		Metrics: f.Metrics,
+		Client:  &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()},
+		breaker: f.breaker,
+	}
+	f.clients[name] = client
+	return client
+}
+
+func (f *HttpClientFactory) breaker(host string) *httpBreaker {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	breaker, ok := f.breakers[host]
+	if !ok {
+		breaker = &httpBreaker{failures: f.Config.BreakerFailures, cooldown: f.Config.BreakerCooldown}
+		f.breakers[host] = breaker
+	}
+	return breaker
+}
+
+/**
+ * HttpClient
+ * times every attempt out after the timeout of its host, retries the idempotent requests with a jittered backoff and
+ * fails at once while the circuit of the host is open. The attempts are counted in http_client_requests_total and
+ * timed in http_client_request_seconds by client, host and status.
+ */
+type HttpClient struct {
+	Name    string
+	Config  *config.HttpClient
+	Metrics IMetrics
+	Client  *http.Client
+	breaker func(host string) *httpBreaker
+}
+
+func (c *HttpClient) Do(request *http.Request) (response *http.Response, err error) {
+	host := request.URL.Host
+	breaker := c.breaker(host)
+	retries := 0
+	if retryable(request) {
+		retries = c.Config.Retries
+	}
+	for attempt := 0; ; attempt++ {
+		if !breaker.allow(time.Now()) {
+			c.Metrics.Inc("http_client_requests_total", map[string]string{"client": c.Name, "host": host, "status": "circuit_open"}, 1)
+			return nil, fmt.Errorf("%w for %v", ErrHttpCircuitOpen, host)
+		}
+		if attempt > 0 && request.GetBody != nil {
+			if request.Body, err = request.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+
+		response, err = c.attempt(request, host)
+		failed := err != nil || response.StatusCode >= http.StatusInternalServerError
+		breaker.record(failed, time.Now())
+		// the failure opening the circuit is returned rather than the open circuit
+		if attempt >= retries || !retry(response, err) || request.Context().Err() != nil || breaker.open() {
+			return response, err
+		}
+		if response != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 4096))
+			_ = response.Body.Close()
+		}
+
+		select {
+		case <-time.After(c.backoff(attempt)):
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		}
+	}
+}
+
+// attempt sends the request once within the timeout of its host, the timeout runs until the body is closed
+func (c *HttpClient) attempt(request *http.Request, host string) (*http.Response, error) {
+	timeout, ok := c.Config.HostTimeouts[request.URL.Hostname()]
+	if !ok {
+		timeout = c.Config.Timeout
+	}
+	ctx, cancel := context.WithTimeout(request.Context(), timeout)
+	end := StartSpan(request.Context(), SpanHttp, c.Name+" "+request.Method+" "+host+request.URL.Path)
+	start := time.Now()
+	response, err := c.Client.Do(request.WithContext(ctx))
+	end(err)
+
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(response.StatusCode)
+	}
+	labels := map[string]string{"client": c.Name, "host": host, "status": status}
+	c.Metrics.Inc("http_client_requests_total", labels, 1)
+	c.Metrics.Observe("http_client_request_seconds", map[string]string{"client": c.Name, "host": host}, time.Since(start).Seconds())
+
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	response.Body = &cancelOnClose{ReadCloser: response.Body, cancel: cancel}
+	return response, nil
+}
+
+// backoff is a random wait up to the exponential backoff of the attempt, so the clients retrying do not retry together
+func (c *HttpClient) backoff(attempt int) time.Duration {
+	backoff := c.Config.RetryBackoff << attempt
+	if backoff <= 0 || backoff > c.Config.RetryMaxBackoff {
+		backoff = c.Config.RetryMaxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// retryable tells if sending the request twice does what sending it once does, a POST is with an Idempotency-Key
+func retryable(request *http.Request) bool {
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+		return false
+	}
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return request.Header.Get("Idempotency-Key") != ""
+}
+
+// retry tells if the failure of an attempt may pass, the network failing or the host being overloaded or restarted
+func retry(response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch response.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// httpBreaker opens after its consecutive failures, once its cooldown passed a single request probes the host and
+// closes it again by succeeding
+type httpBreaker struct {
+	failures int
+	cooldown time.Duration
+
+	mu       sync.Mutex
+	failed   int
+	openedAt time.Time
+	probing  bool
+}
+
+func (b *httpBreaker) allow(now time.Time) bool {
+	if b.failures <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failed < b.failures {
+		return true
+	}
+	if b.probing || now.Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *httpBreaker) open() bool {
+	if b.failures <= 0 {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failed >= b.failures
+}
+
+func (b *httpBreaker) record(failed bool, now time.Time) {
+	if b.failures <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if !failed {
+		b.failed = 0
+		return
+	}
+	b.failed++
+	if b.failed >= b.failures {
+		b.openedAt = now
+	}
+}
@@ -0,0 +1,171 @@
+package infrastructures
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// messages queued per socket, a socket too slow to drain them misses the next ones
+	hubSendBuffer   = 32
+	hubWriteTimeout = 10 * time.Second
+	// clients only listen, what they write is read to notice the socket closing and dropped
+	hubMaxPayload = 4096
+)
+
+/**
+ * HubMessage
+ * written as json to the sockets, Type tells the clients how to read Data
+ */
+type HubMessage struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+/**
+ * IHub
+ *
+ */
+type IHub interface {
+	// Serve registers the socket of the user and blocks until it is closed
+	Serve(userID uint, conn *websocket.Conn)
+	// Send writes the message to every socket of the user, a user without socket misses it
+	Send(userID uint, message HubMessage)
+	Online(userID uint) bool
+	Close() error
+}
+
+type hubClient struct {
+	conn *websocket.Conn
+	send chan HubMessage
+}
+
+/**
+ * WebSocketHub
+ * keeps the sockets of the users connected to this process, a user may have one per device
+ */
+type WebSocketHub struct {
+	clients map[uint]map[*hubClient]struct{}
+	closed  bool
+	mu      sync.RWMutex
+}
+
+/**
+ * NewWebSocketHub
+ *
+ */
+func NewWebSocketHub() IHub {
+	return &WebSocketHub{
+		clients: map[uint]map[*hubClient]struct{}{},
+	}
+}
+
+/**
+ * Serve
+ *
+ */
+func (h *WebSocketHub) Serve(userID uint, conn *websocket.Conn) {
+	defer conn.Close()
+	conn.MaxPayloadBytes = hubMaxPayload
+	client := &hubClient{conn: conn, send: make(chan HubMessage, hubSendBuffer)}
+	if !h.register(userID, client) {
+		return
+	}
+	defer h.unregister(userID, client)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var discard string
+		for websocket.Message.Receive(conn, &discard) == nil {
+		}
+	}()
+
+	for {
+		select {
+		case message, ok := <-client.send:
+			if !ok {
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(hubWriteTimeout))
+			if err := websocket.JSON.Send(conn, message); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+/**
+ * Send
+ *
+ */
+func (h *WebSocketHub) Send(userID uint, message HubMessage) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for client := range h.clients[userID] {
+		select {
+		case client.send <- message:
+		default:
+			log.Printf("hub: dropped %v for user %v, the socket is too slow", message.Type, userID)
+		}
+	}
+}
+
+/**
+ * Online
+ *
+ */
+func (h *WebSocketHub) Online(userID uint) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.clients[userID]) > 0
+}
+
+/**
+ * Close
+ * stop serving every socket
+ */
+func (h *WebSocketHub) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for userID, clients := range h.clients {
+		for client := range clients {
+			close(client.send)
+		}
+		delete(h.clients, userID)
+	}
+	return nil
+}
+
+func (h *WebSocketHub) register(userID uint, client *hubClient) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return false
+	}
+	if h.clients[userID] == nil {
+		h.clients[userID] = map[*hubClient]struct{}{}
+	}
+	h.clients[userID][client] = struct{}{}
+	return true
+}
+
+// unregister closes the queue of the client unless Close already did
+func (h *WebSocketHub) unregister(userID uint, client *hubClient) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.clients[userID][client]; !ok {
+		return
+	}
+	close(client.send)
+	delete(h.clients[userID], client)
+	if len(h.clients[userID]) == 0 {
+		delete(h.clients, userID)
+	}
+}
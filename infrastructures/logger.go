@@ -0,0 +1,195 @@
+package infrastructures
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"gotham/config"
+)
+
+// Fields are the structured attributes of a log entry
+type Fields map[string]interface{}
+
+/**
+ * ILogger
+ *
+ */
+type ILogger interface {
+	Debug(message string, fields Fields)
+	Info(message string, fields Fields)
+	Warn(message string, fields Fields)
+	Error(message string, fields Fields)
+	// With returns a logger adding fields to every entry
+	With(fields Fields) ILogger
+}
+
+var logLevels = map[string]int{"debug": 0, "info": 1, "warn": 2, "error": 3}
+
+// LogLevelNames the levels of the loggers, the least severe first
+var LogLevelNames = []string{"debug", "info", "warn", "error"}
+
+/**
+ * LogLevels
+ * the level written by the loggers and the levels of their components (the "component" field, gorm, http, audit...),
+ * shared by every logger of the container and changed at runtime
+ */
+type LogLevels struct {
+	level   string
+	modules map[string]string
+	mu      sync.RWMutex
+}
+
+/**
+ * NewLogLevels
+ * the levels of the config, the unknown ones are ignored
+ */
+func NewLogLevels(loggerConfig *config.Logger) *LogLevels {
+	levels := &LogLevels{level: "info", modules: map[string]string{}}
+	if _, ok := logLevels[loggerConfig.Level]; ok {
+		levels.level = loggerConfig.Level
+	}
+	for module, level := range loggerConfig.Modules {
+		if _, ok := logLevels[level]; ok {
+			levels.modules[module] = level
+		}
+	}
+	return levels
+}
+
+// Level the level of the components without a level of their own
+func (l *LogLevels) Level() string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.level
+}
+
+// Modules the levels of the components
+func (l *LogLevels) Modules() map[string]string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	modules := make(map[string]string, len(l.modules))
+	for module, level := range l.modules {
+		modules[module] = level
+	}
+	return modules
+}
+
+/**
+ * Set
+ * replaces the levels, the components missing from modules get the level
+ */
+func (l *LogLevels) Set(level string, modules map[string]string) error {
+	if _, ok := logLevels[level]; !ok {
+		return fmt.Errorf("unknown log level %q", level)
+	}
+	replaced := make(map[string]string, len(modules))
+	for module, moduleLevel := range modules {
+		if _, ok := logLevels[moduleLevel]; !ok {
+			return fmt.Errorf("unknown log level %q of %v", moduleLevel, module)
+		}
+		replaced[module] = moduleLevel
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.level = level
+	l.modules = replaced
+	return nil
+}
+
+// Enabled an entry of the level is written for the component, "" for none
+func (l *LogLevels) Enabled(level string, module string) bool {
+	l.mu.RLock()
+	threshold := l.level
+	if moduleLevel, ok := l.modules[module]; ok && module != "" {
+		threshold = moduleLevel
+	}
+	l.mu.RUnlock()
+	return logLevels[level] >= logLevels[threshold]
+}
+
+/**
+ * JsonLogger
+ * writes one json object per entry
+ */
+type JsonLogger struct {
+	out    io.Writer
+	levels *LogLevels
+	fields Fields
+	mu     *sync.Mutex
+}
+
+/**
+ * NewJsonLogger
+ *
+ */
+func NewJsonLogger(levels *LogLevels) ILogger {
+	return &JsonLogger{
+		out:    os.Stdout,
+		levels: levels,
+		mu:     &sync.Mutex{},
+	}
+}
+
+func (l *JsonLogger) Debug(message string, fields Fields) {
+	l.write("debug", message, fields)
+}
+
+func (l *JsonLogger) Info(message string, fields Fields) {
+	l.write("info", message, fields)
+}
+
+func (l *JsonLogger) Warn(message string, fields Fields) {
+	l.write("warn", message, fields)
+}
+
+func (l *JsonLogger) Error(message string, fields Fields) {
+	l.write("error", message, fields)
+}
+
+func (l *JsonLogger) With(fields Fields) ILogger {
+	merged := Fields{}
+	for key, value := range l.fields {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+	return &JsonLogger{out: l.out, levels: l.levels, fields: merged, mu: l.mu}
+}
+
+func (l *JsonLogger) write(level string, message string, fields Fields) {
+	module, _ := l.fields["component"].(string)
+	if component, ok := fields["component"].(string); ok {
+		module = component
+	}
+	if !l.levels.Enabled(level, module) {
+		return
+	}
+
+	entry := Fields{}
+	for key, value := range l.fields {
+		entry[key] = value
+	}
+	for key, value := range fields {
+		if err, ok := value.(error); ok {
+			value = err.Error()
+		}
+		entry[key] = value
+	}
+	entry["time"] = time.Now().Format(time.RFC3339Nano)
+	entry["level"] = level
+	entry["message"] = message
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		line, _ = json.Marshal(Fields{"time": entry["time"], "level": "error", "message": "log entry could not be encoded: " + err.Error()})
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = l.out.Write(append(line, '\n'))
+}
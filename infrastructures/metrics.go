@@ -0,0 +1,155 @@
+package infrastructures
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/**
+ * IMetrics
+ *
+ */
+type IMetrics interface {
+	// Inc adds value to a counter
+	Inc(name string, labels map[string]string, value float64)
+	// Set replaces the value of a gauge
+	Set(name string, labels map[string]string, value float64)
+	// Observe records a sample of a summary (count, sum, max)
+	Observe(name string, labels map[string]string, value float64)
+	// Render returns every metric in the prometheus text format
+	Render() string
+}
+
+type metricSeries struct {
+	kind   string
+	labels string
+	value  float64
+	count  float64
+	sum    float64
+	max    float64
+}
+
+/**
+ * Metrics
+ * in memory registry, scraped through the metrics endpoint
+ */
+type Metrics struct {
+	series map[string]map[string]*metricSeries
+	kinds  map[string]string
+	mu     sync.Mutex
+}
+
+/**
+ * NewMetrics
+ *
+ */
+func NewMetrics() IMetrics {
+	return &Metrics{
+		series: map[string]map[string]*metricSeries{},
+		kinds:  map[string]string{},
+	}
+}
+
+/**
+ * Inc
+ *
+ */
+func (m *Metrics) Inc(name string, labels map[string]string, value float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.get("counter", name, labels).value += value
+}
+
+/**
+ * Set
+ *
+ */
+func (m *Metrics) Set(name string, labels map[string]string, value float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.get("gauge", name, labels).value = value
+}
+
+/**
+ * Observe
+ *
+ */
+func (m *Metrics) Observe(name string, labels map[string]string, value float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := m.get("summary", name, labels)
+	s.count++
+	s.sum += value
+	s.max = math.Max(s.max, value)
+}
+
+/**
+ * Render
+ *
+ */
+func (m *Metrics) Render() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.series))
+	for name := range m.series {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		kind := m.kinds[name]
+		b.WriteString(fmt.Sprintf("# TYPE %v %v\n", name, kind))
+
+		keys := make([]string, 0, len(m.series[name]))
+		for key := range m.series[name] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			s := m.series[name][key]
+			if kind == "summary" {
+				b.WriteString(fmt.Sprintf("%v_count%v %v\n", name, s.labels, s.count))
+				b.WriteString(fmt.Sprintf("%v_sum%v %v\n", name, s.labels, s.sum))
+				b.WriteString(fmt.Sprintf("%v_max%v %v\n", name, s.labels, s.max))
+				continue
+			}
+			b.WriteString(fmt.Sprintf("%v%v %v\n", name, s.labels, s.value))
+		}
+	}
+	return b.String()
+}
+
+func (m *Metrics) get(kind string, name string, labels map[string]string) *metricSeries {
+	key := formatLabels(labels)
+	if _, ok := m.series[name]; !ok {
+		m.series[name] = map[string]*metricSeries{}
+		m.kinds[name] = kind
+	}
+	s, ok := m.series[name][key]
+	if !ok {
+		s = &metricSeries{kind: kind, labels: key}
+		m.series[name][key] = s
+	}
+	return s
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%v=%q", key, labels[key]))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
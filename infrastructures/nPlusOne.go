@@ -0,0 +1,89 @@
+package infrastructures
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+)
+
+// ErrNPlusOne is added to the query repeating a statement past the threshold in strict mode
+var ErrNPlusOne = errors.New("n+1 query detected")
+
+/**
+ * NPlusOneDetector
+ * gorm plugin warning about a statement repeated within one request, the classic n+1 of a relation loaded in a loop
+ */
+type NPlusOneDetector struct {
+	Logger ILogger
+	Config *config.Logger
+}
+
+/**
+ * NewNPlusOneDetector
+ *
+ */
+func NewNPlusOneDetector(logger ILogger, loggerConfig *config.Logger) gorm.Plugin {
+	return &NPlusOneDetector{
+		Logger: logger.With(Fields{"component": "n+1"}),
+		Config: loggerConfig,
+	}
+}
+
+func (d *NPlusOneDetector) Name() string {
+	return "gotham:n_plus_one"
+}
+
+func (d *NPlusOneDetector) Initialize(db *gorm.DB) error {
+	if !d.Config.DetectNPlusOne {
+		return nil
+	}
+	return db.Callback().Query().After("gorm:query").Register("gotham:n_plus_one", d.check)
+}
+
+// check counts the statement in the request the query runs for, queries without a request context are not tracked
+func (d *NPlusOneDetector) check(db *gorm.DB) {
+	if db.Statement == nil || db.Statement.Context == nil {
+		return
+	}
+	stats := QueryStatsFrom(db.Statement.Context)
+	if stats == nil {
+		return
+	}
+
+	sql := db.Statement.SQL.String()
+	count := stats.repeat(sql)
+	if count != d.Config.NPlusOneThreshold {
+		return
+	}
+
+	d.Logger.Warn("n+1 query detected", Fields{
+		"sql":       sql,
+		"count":     count,
+		"call_site": callSite(),
+	})
+	if d.Config.NPlusOneStrict {
+		_ = db.AddError(fmt.Errorf("%w: %v", ErrNPlusOne, sql))
+	}
+}
+
+// callSite is the first frame outside gorm and the data layer, where the loop running the queries is
+func callSite() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "gorm.io/") &&
+			!strings.HasPrefix(frame.Function, "gotham/infrastructures.") &&
+			!strings.HasPrefix(frame.Function, "gotham/repositories") {
+			return fmt.Sprintf("%v:%d %v", frame.File, frame.Line, frame.Function)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
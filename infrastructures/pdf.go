@@ -0,0 +1,151 @@
+package infrastructures
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/alecthomas/template"
+)
+
+// Pdf Service
+
+/**
+ * IPdfService
+ *
+ * interface
+ */
+type IPdfService interface {
+	// Render executes the text template of the path with data and lays its lines out on A4 pages,
+	// lines starting with "# " are written as headings
+	Render(path string, data interface{}) ([]byte, error)
+}
+
+const (
+	pdfPageWidth  = 595
+	pdfPageHeight = 842
+	pdfMargin     = 50
+	pdfFontSize   = 10
+	pdfLeading    = 14
+	// characters of helvetica 10 fitting between the margins
+	pdfLineWidth = 95
+)
+
+/**
+ * TextPdfService
+ * writes plain text documents with the standard helvetica fonts, no font is embedded
+ */
+type TextPdfService struct{}
+
+func NewTextPdfService() IPdfService {
+	return TextPdfService{}
+}
+
+/**
+ * Render
+ *
+ */
+func (s TextPdfService) Render(path string, data interface{}) ([]byte, error) {
+	t, err := template.ParseFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	var text bytes.Buffer
+	if err = t.Execute(&text, data); err != nil {
+		return nil, err
+	}
+	return s.write(s.paginate(strings.Split(strings.TrimRight(text.String(), "\n"), "\n"))), nil
+}
+
+// paginate wraps the long lines and splits them into pages
+func (s TextPdfService) paginate(lines []string) (pages [][]string) {
+	perPage := (pdfPageHeight - 2*pdfMargin) / pdfLeading
+	var page []string
+	for _, line := range lines {
+		for _, wrapped := range wrapPdfLine(line) {
+			if len(page) == perPage {
+				pages, page = append(pages, page), nil
+			}
+			page = append(page, wrapped)
+		}
+	}
+	return append(pages, page)
+}
+
+func (s TextPdfService) write(pages [][]string) []byte {
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n")
+	// 1 catalog, 2 page tree, 3 and 4 fonts, then a page and its content for every page
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, lines := range pages {
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT\n%d TL\n%d %d Td\n", pdfLeading, pdfMargin, pdfPageHeight-pdfMargin)
+		for _, line := range lines {
+			if strings.HasPrefix(line, "# ") {
+				fmt.Fprintf(&content, "/F2 %d Tf\n(%s) Tj T*\n", pdfFontSize+2, escapePdfText(line[2:]))
+				continue
+			}
+			fmt.Fprintf(&content, "/F1 %d Tf\n(%s) Tj T*\n", pdfFontSize, escapePdfText(line))
+		}
+		content.WriteString("ET")
+
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", pdfPageWidth, pdfPageHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return out.Bytes()
+}
+
+func wrapPdfLine(line string) (lines []string) {
+	runes := []rune(line)
+	for len(runes) > pdfLineWidth {
+		cut := pdfLineWidth
+		for i := pdfLineWidth; i > pdfLineWidth/2; i-- {
+			if runes[i] == ' ' {
+				cut = i
+				break
+			}
+		}
+		lines = append(lines, string(runes[:cut]))
+		runes = []rune(strings.TrimLeft(string(runes[cut:]), " "))
+	}
+	return append(lines, string(runes))
+}
+
+// escapePdfText writes the line in the latin-1 range of WinAnsiEncoding, other characters become "?"
+func escapePdfText(line string) string {
+	var b strings.Builder
+	for _, r := range line {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,160 @@
+package infrastructures
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"gotham/config"
+)
+
+// ErrPushTokenInvalid is returned for a token the provider no longer delivers to, it should be forgotten
+var ErrPushTokenInvalid = errors.New("push: the device token is not registered")
+
+// Push Service
+
+/**
+ * PushNotification
+ *
+ */
+type PushNotification struct {
+	Title string
+	Body  string
+	// Data is handed to the app with the notification, like the conversation to open
+	Data map[string]string
+}
+
+/**
+ * IPushService
+ *
+ * interface
+ */
+type IPushService interface {
+	Send(token string, notification PushNotification) error
+}
+
+/**
+ * NewPushService
+ *
+ */
+func NewPushService(pushConfig *config.Push, clients IHttpClientFactory) (IPushService, error) {
+	switch pushConfig.Driver {
+	case "log":
+		return NewLogPushService(), nil
+	case "fcm":
+		return &FcmPushService{Config: pushConfig, Client: clients.Make("fcm")}, nil
+	}
+	return nil, fmt.Errorf("unsupported push driver %q", pushConfig.Driver)
+}
+
+/**
+ * FcmPushService
+ * sends through the legacy http api of firebase cloud messaging
+ */
+type FcmPushService struct {
+	Config *config.Push
+	Client IHttpClient
+}
+
+type fcmResponse struct {
+	Results []struct {
+		Error string `json:"error"`
+	} `json:"results"`
+}
+
+/**
+ * Send
+ *
+ */
+func (s *FcmPushService) Send(token string, notification PushNotification) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"to":           token,
+		"notification": map[string]string{"title": notification.Title, "body": notification.Body},
+		"data":         notification.Data,
+	})
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, "https://fcm.googleapis.com/fcm/send", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "key="+s.Config.FcmServerKey)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := s.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, _ := ioutil.ReadAll(io.LimitReader(response.Body, 4096))
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("fcm: %s: %s", response.Status, body)
+	}
+
+	var result fcmResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("fcm: %w", err)
+	}
+	for _, r := range result.Results {
+		switch r.Error {
+		case "":
+		case "NotRegistered", "InvalidRegistration", "MismatchSenderId":
+			return ErrPushTokenInvalid
+		default:
+			return fmt.Errorf("fcm: %s", r.Error)
+		}
+	}
+	return nil
+}
+
+/**
+ * LogPushService
+ * mock sender of development and test environments, notifications are logged and kept instead of being sent,
+ * tokens starting with "invalid-" are refused like a provider refuses a stale token
+ */
+type LogPushService struct {
+	sent []SentPush
+	mu   sync.Mutex
+}
+
+type SentPush struct {
+	Token        string
+	Notification PushNotification
+}
+
+func NewLogPushService() *LogPushService {
+	return &LogPushService{}
+}
+
+/**
+ * Send
+ *
+ */
+func (s *LogPushService) Send(token string, notification PushNotification) error {
+	if strings.HasPrefix(token, "invalid-") {
+		return ErrPushTokenInvalid
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, SentPush{Token: token, Notification: notification})
+	log.Printf("push: %q to %v", notification.Title, token)
+	return nil
+}
+
+/**
+ * Sent
+ * the notifications sent so far
+ */
+func (s *LogPushService) Sent() []SentPush {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SentPush(nil), s.sent...)
+}
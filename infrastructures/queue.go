@@ -0,0 +1,276 @@
+package infrastructures
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gotham/config"
+)
+
+var (
+	ErrQueueFull   = errors.New("queue: the queue is full")
+	ErrQueueClosed = errors.New("queue: the queue is closed")
+)
+
+/**
+ * Job
+ *
+ */
+type Job struct {
+	Queue    string `json:"queue"`
+	Payload  []byte `json:"payload"`
+	Attempts int    `json:"attempts"`
+}
+
+type JobHandler func(job Job) error
+
+/**
+ * IQueue
+ * jobs run in the background by the workers of their queue, a failed job is pushed again until its max attempts
+ */
+type IQueue interface {
+	Push(ctx context.Context, queue string, payload []byte) error
+	// Work runs the handler on the jobs of the queue with the concurrency workers
+	Work(queue string, concurrency int, handler JobHandler)
+	// Close stops the workers once they finish their job
+	Close() error
+}
+
+/**
+ * NewQueue
+ *
+ */
+func NewQueue(queueConfig *config.Queue, cacheConfig *config.Cache) (IQueue, error) {
+	switch queueConfig.Driver {
+	case "memory":
+		return NewMemoryQueue(queueConfig), nil
+	case "redis":
+		return NewRedisQueue(queueConfig, cacheConfig), nil
+	}
+	return nil, fmt.Errorf("unsupported queue driver %q", queueConfig.Driver)
+}
+
+// run runs the handler on the job, the job to push again is returned when it failed with attempts left
+func run(handler JobHandler, job Job, maxAttempts int) (retry *Job) {
+	job.Attempts++
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panicked: %v", r)
+			}
+		}()
+		return handler(job)
+	}()
+	if err == nil {
+		return nil
+	}
+	if job.Attempts >= maxAttempts {
+		log.Printf("queue: job of %v dropped after %d attempts: %v", job.Queue, job.Attempts, err)
+		return nil
+	}
+	log.Printf("queue: job of %v failed, attempt %d: %v", job.Queue, job.Attempts, err)
+	return &job
+}
+
+/**
+ * MemoryQueue
+ * a queue on channels local to the process, for development and single instance deployments. The jobs still queued
+ * when the process stops are lost
+ */
+type MemoryQueue struct {
+	Config   *config.Queue
+	channels map[string]chan Job
+	closed   bool
+	mu       sync.RWMutex
+	wg       sync.WaitGroup
+}
+
+/**
+ * NewMemoryQueue
+ *
+ */
+func NewMemoryQueue(queueConfig *config.Queue) *MemoryQueue {
+	return &MemoryQueue{
+		Config:   queueConfig,
+		channels: map[string]chan Job{},
+	}
+}
+
+func (q *MemoryQueue) Push(ctx context.Context, queue string, payload []byte) error {
+	return q.push(Job{Queue: queue, Payload: payload})
+}
+
+func (q *MemoryQueue) push(job Job) error {
+	channel := q.channel(job.Queue)
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return ErrQueueClosed
+	}
+	select {
+	case channel <- job:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+func (q *MemoryQueue) Work(queue string, concurrency int, handler JobHandler) {
+	channel := q.channel(queue)
+	for i := 0; i < concurrency; i++ {
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			for job := range channel {
+				if retry := run(handler, job, q.Config.MaxAttempts); retry != nil {
+					if err := q.push(*retry); err != nil {
+						log.Printf("queue: job of %v dropped: %v", job.Queue, err)
+					}
+				}
+			}
+		}()
+	}
+}
+
+/**
+ * Close
+ * the workers run the jobs left in the channels before stopping
+ */
+func (q *MemoryQueue) Close() error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		for _, channel := range q.channels {
+			close(channel)
+		}
+	}
+	q.mu.Unlock()
+	q.wg.Wait()
+	return nil
+}
+
+func (q *MemoryQueue) channel(queue string) chan Job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	channel, ok := q.channels[queue]
+	if !ok {
+		channel = make(chan Job, q.Config.Buffer)
+		q.channels[queue] = channel
+		if q.closed {
+			close(channel)
+		}
+	}
+	return channel
+}
+
+// redisQueuePoll is how long a worker blocks on an empty queue before checking if the queue is closed
+const redisQueuePoll = time.Second
+
+/**
+ * RedisQueue
+ * a queue on the lists of redis, shared by the instances and kept across restarts
+ */
+type RedisQueue struct {
+	Config *config.Queue
+	client *redisClient
+	closed int32
+	wg     sync.WaitGroup
+}
+
+/**
+ * NewRedisQueue
+ *
+ */
+func NewRedisQueue(queueConfig *config.Queue, cacheConfig *config.Cache) *RedisQueue {
+	return &RedisQueue{
+		Config: queueConfig,
+		client: newRedisClient(cacheConfig),
+	}
+}
+
+func (q *RedisQueue) Push(ctx context.Context, queue string, payload []byte) error {
+	if atomic.LoadInt32(&q.closed) == 1 {
+		return ErrQueueClosed
+	}
+	return q.push(ctx, Job{Queue: queue, Payload: payload})
+}
+
+// push the retries of the workers are pushed even while closing, redis keeps them for the next process
+func (q *RedisQueue) push(ctx context.Context, job Job) error {
+	encoded, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	_, err = q.client.do(ctx, "LPUSH", "queue:"+job.Queue, encoded)
+	return err
+}
+
+func (q *RedisQueue) Work(queue string, concurrency int, handler JobHandler) {
+	for i := 0; i < concurrency; i++ {
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			q.work(queue, handler)
+		}()
+	}
+}
+
+// work pops the jobs on a connection of its own, a failed connection is dialed again after a pause
+func (q *RedisQueue) work(queue string, handler JobHandler) {
+	var conn *redisConn
+	defer func() {
+		if conn != nil {
+			_ = conn.Close()
+		}
+	}()
+	for atomic.LoadInt32(&q.closed) == 0 {
+		if conn == nil {
+			var err error
+			if conn, err = q.client.dial(context.Background()); err != nil {
+				log.Printf("queue: %v", err)
+				time.Sleep(redisQueuePoll)
+				continue
+			}
+		}
+		_ = conn.SetDeadline(time.Now().Add(redisQueuePoll + 5*time.Second))
+		reply, err := conn.command("BRPOP", "queue:"+queue, fmt.Sprint(int(redisQueuePoll.Seconds())))
+		if err != nil {
+			log.Printf("queue: %v", err)
+			_ = conn.Close()
+			conn = nil
+			continue
+		}
+		// a nil reply is the end of the poll, the pair is the list and the job
+		pair, _ := reply.([]interface{})
+		if len(pair) != 2 {
+			continue
+		}
+		encoded, _ := pair[1].([]byte)
+		var job Job
+		if err = json.Unmarshal(encoded, &job); err != nil {
+			log.Printf("queue: malformed job of %v dropped: %v", queue, err)
+			continue
+		}
+		if retry := run(handler, job, q.Config.MaxAttempts); retry != nil {
+			if err = q.push(context.Background(), *retry); err != nil {
+				log.Printf("queue: job of %v dropped: %v", job.Queue, err)
+			}
+		}
+	}
+}
+
+/**
+ * Close
+ * the workers stop after their job or their poll, the jobs left stay in redis
+ */
+func (q *RedisQueue) Close() error {
+	atomic.StoreInt32(&q.closed, 1)
+	q.wg.Wait()
+	return nil
+}
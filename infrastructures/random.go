@@ -0,0 +1,91 @@
+package infrastructures
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"sync"
+
+	"gotham/helpers"
+)
+
+/**
+ * IRandom
+ * the random tokens and codes of the services, a test sees the codes it is sent
+ */
+type IRandom interface {
+	// Token is a hex string of n random bytes, for the tokens of links and sessions
+	Token(n int) (string, error)
+	// Digits is a code of n decimal digits, for the codes typed by people
+	Digits(n int) (string, error)
+	// String is n random letters and digits
+	String(n int) (string, error)
+}
+
+/**
+ * SecureRandom
+ * reads crypto/rand, the codes can not be guessed
+ */
+type SecureRandom struct{}
+
+/**
+ * NewSecureRandom
+ *
+ */
+func NewSecureRandom() IRandom {
+	return SecureRandom{}
+}
+
+func (SecureRandom) Token(n int) (string, error) {
+	return helpers.SecureToken(n)
+}
+
+func (SecureRandom) Digits(n int) (string, error) {
+	return helpers.SecureDigits(n)
+}
+
+func (SecureRandom) String(n int) (string, error) {
+	return helpers.RandomString(n)
+}
+
+/**
+ * SeededRandom
+ * the same codes for the same seed, for the tests only, they are predictable
+ */
+type SeededRandom struct {
+	rand *rand.Rand
+	mu   sync.Mutex
+}
+
+/**
+ * NewSeededRandom
+ *
+ */
+func NewSeededRandom(seed int64) *SeededRandom {
+	return &SeededRandom{rand: rand.New(rand.NewSource(seed))}
+}
+
+func (s *SeededRandom) Token(n int) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := make([]byte, n)
+	s.rand.Read(b)
+	return hex.EncodeToString(b), nil
+}
+
+func (s *SeededRandom) Digits(n int) (string, error) {
+	return s.pick("0123456789", n), nil
+}
+
+func (s *SeededRandom) String(n int) (string, error) {
+	return s.pick(helpers.RandomLetters, n), nil
+}
+
+func (s *SeededRandom) pick(letters string, n int) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = letters[s.rand.Intn(len(letters))]
+	}
+	return string(b)
+}
@@ -0,0 +1,177 @@
+package infrastructures
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"gotham/config"
+)
+
+/**
+ * redisClient
+ * speaks the redis protocol over a small connection pool, shared by the drivers on redis
+ */
+type redisClient struct {
+	Addr     string
+	Password string
+	DB       int
+	pool     chan *redisConn
+}
+
+type redisConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+func newRedisClient(cacheConfig *config.Cache) *redisClient {
+	return &redisClient{
+		Addr:     cacheConfig.RedisAddr,
+		Password: cacheConfig.RedisPassword,
+		DB:       cacheConfig.RedisDB,
+		pool:     make(chan *redisConn, 10),
+	}
+}
+
+// do runs a command on a pooled connection, a connection that failed is dropped
+func (r *redisClient) do(ctx context.Context, args ...interface{}) (interface{}, error) {
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+	}
+
+	reply, err := conn.command(args...)
+	var replyErr redisError
+	if err != nil && !errors.As(err, &replyErr) {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	select {
+	case r.pool <- conn:
+	default:
+		_ = conn.Close()
+	}
+	return reply, err
+}
+
+func (r *redisClient) conn(ctx context.Context) (*redisConn, error) {
+	select {
+	case conn := <-r.pool:
+		return conn, nil
+	default:
+	}
+	return r.dial(ctx)
+}
+
+// dial opens a connection outside of the pool, for the blocking commands
+func (r *redisClient) dial(ctx context.Context) (*redisConn, error) {
+	dialer := net.Dialer{Timeout: 5 * time.Second}
+	netConn, err := dialer.DialContext(ctx, "tcp", r.Addr)
+	if err != nil {
+		return nil, err
+	}
+	conn := &redisConn{Conn: netConn, reader: bufio.NewReader(netConn)}
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+	if r.Password != "" {
+		if _, err = conn.command("AUTH", r.Password); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	if r.DB != 0 {
+		if _, err = conn.command("SELECT", strconv.Itoa(r.DB)); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func (c *redisConn) command(args ...interface{}) (interface{}, error) {
+	buffer := []byte("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		var value []byte
+		switch v := arg.(type) {
+		case string:
+			value = []byte(v)
+		case []byte:
+			value = v
+		default:
+			value = []byte(fmt.Sprint(v))
+		}
+		buffer = append(buffer, "$"+strconv.Itoa(len(value))+"\r\n"...)
+		buffer = append(buffer, value...)
+		buffer = append(buffer, "\r\n"...)
+	}
+	if _, err := c.Write(buffer); err != nil {
+		return nil, err
+	}
+	return c.reply()
+}
+
+// reply reads one reply, bulk strings are []byte, a nil bulk string or array is nil
+func (c *redisConn) reply() (interface{}, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 {
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+	kind, payload := line[0], line[1:len(line)-2]
+
+	switch kind {
+	case '+':
+		return payload, nil
+	case '-':
+		return nil, redisError(payload)
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(payload)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		value := make([]byte, size+2)
+		if _, err = io.ReadFull(c.reader, value); err != nil {
+			return nil, err
+		}
+		return value[:size], nil
+	case '*':
+		size, err := strconv.Atoi(payload)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		// every element is read, even after an error reply, so the connection stays usable
+		values := make([]interface{}, size)
+		var replyErr error
+		for i := range values {
+			if values[i], err = c.reply(); err != nil {
+				var redisErr redisError
+				if !errors.As(err, &redisErr) {
+					return nil, err
+				}
+				replyErr = err
+			}
+		}
+		return values, replyErr
+	}
+	return nil, fmt.Errorf("redis: unknown reply type %q", kind)
+}
@@ -0,0 +1,110 @@
+package infrastructures
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+/**
+ * IScheduler
+ *
+ */
+type IScheduler interface {
+	Every(name string, interval time.Duration, job func() error)
+	Start()
+	Stop()
+}
+
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	job      func() error
+}
+
+/**
+ * Scheduler
+ * runs every registered job on its own ticker until Stop is called
+ */
+type Scheduler struct {
+	jobs    []scheduledJob
+	quit    chan struct{}
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	started bool
+}
+
+/**
+ * NewScheduler
+ *
+ */
+func NewScheduler() IScheduler {
+	return &Scheduler{
+		quit: make(chan struct{}),
+	}
+}
+
+/**
+ * Every
+ * register a job, jobs registered after Start are started right away
+ */
+func (s *Scheduler) Every(name string, interval time.Duration, job func() error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j := scheduledJob{name: name, interval: interval, job: job}
+	s.jobs = append(s.jobs, j)
+	if s.started {
+		s.run(j)
+	}
+}
+
+/**
+ * Start
+ *
+ */
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return
+	}
+	s.started = true
+	for _, j := range s.jobs {
+		s.run(j)
+	}
+}
+
+/**
+ * Stop
+ * wait for running jobs to finish
+ */
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	if !s.started {
+		s.mu.Unlock()
+		return
+	}
+	s.started = false
+	close(s.quit)
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(j scheduledJob) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := j.job(); err != nil {
+					log.Printf("scheduler: job %v failed: %v", j.name, err)
+				}
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+}
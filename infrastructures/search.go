@@ -0,0 +1,172 @@
+package infrastructures
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"gotham/config"
+)
+
+// Search Engine
+
+// SearchDocument is a record as the search engine indexes it, its "id" identifies it in its index
+type SearchDocument map[string]interface{}
+
+/**
+ * ISearchEngine
+ *
+ * interface
+ */
+type ISearchEngine interface {
+	// Index adds the documents to the index or replaces the ones with the same id
+	Index(index string, documents []SearchDocument) error
+	Delete(index string, IDs []uint) error
+}
+
+/**
+ * NewSearchEngine
+ *
+ */
+func NewSearchEngine(searchConfig *config.Search, clients IHttpClientFactory) (ISearchEngine, error) {
+	switch searchConfig.Driver {
+	case "log":
+		return NewLogSearchEngine(), nil
+	case "meilisearch":
+		return &MeilisearchEngine{Config: searchConfig, Client: clients.Make("meilisearch")}, nil
+	}
+	return nil, fmt.Errorf("unsupported search driver %q", searchConfig.Driver)
+}
+
+/**
+ * MeilisearchEngine
+ * the documents api of meilisearch, the engine applies the changes asynchronously
+ */
+type MeilisearchEngine struct {
+	Config *config.Search
+	Client IHttpClient
+}
+
+/**
+ * Index
+ *
+ */
+func (e *MeilisearchEngine) Index(index string, documents []SearchDocument) error {
+	if len(documents) == 0 {
+		return nil
+	}
+	return e.post(index, "/documents", documents)
+}
+
+/**
+ * Delete
+ *
+ */
+func (e *MeilisearchEngine) Delete(index string, IDs []uint) error {
+	if len(IDs) == 0 {
+		return nil
+	}
+	return e.post(index, "/documents/delete-batch", IDs)
+}
+
+func (e *MeilisearchEngine) post(index string, path string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	endpoint := strings.TrimRight(e.Config.Url, "/") + "/indexes/" + url.PathEscape(index) + path
+	request, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if e.Config.ApiKey != "" {
+		request.Header.Set("Authorization", "Bearer "+e.Config.ApiKey)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := e.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= 300 {
+		message, _ := ioutil.ReadAll(io.LimitReader(response.Body, 4096))
+		return fmt.Errorf("meilisearch: %s: %s", response.Status, message)
+	}
+	return nil
+}
+
+/**
+ * LogSearchEngine
+ * mock engine of development and test environments, the documents are logged and kept in memory
+ */
+type LogSearchEngine struct {
+	indexes map[string]map[uint]SearchDocument
+	mu      sync.Mutex
+}
+
+func NewLogSearchEngine() *LogSearchEngine {
+	return &LogSearchEngine{indexes: map[string]map[uint]SearchDocument{}}
+}
+
+/**
+ * Index
+ *
+ */
+func (e *LogSearchEngine) Index(index string, documents []SearchDocument) error {
+	if len(documents) == 0 {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.indexes[index] == nil {
+		e.indexes[index] = map[uint]SearchDocument{}
+	}
+	for _, document := range documents {
+		ID, ok := document["id"].(uint)
+		if !ok {
+			return fmt.Errorf("search: document of %v without an uint id", index)
+		}
+		e.indexes[index][ID] = document
+	}
+	log.Printf("search: %v documents indexed in %v", len(documents), index)
+	return nil
+}
+
+/**
+ * Delete
+ *
+ */
+func (e *LogSearchEngine) Delete(index string, IDs []uint) error {
+	if len(IDs) == 0 {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, ID := range IDs {
+		delete(e.indexes[index], ID)
+	}
+	log.Printf("search: %v documents deleted from %v", len(IDs), index)
+	return nil
+}
+
+/**
+ * Documents
+ * the documents of the index so far
+ */
+func (e *LogSearchEngine) Documents(index string) []SearchDocument {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	documents := make([]SearchDocument, 0, len(e.indexes[index]))
+	for _, document := range e.indexes[index] {
+		documents = append(documents, document)
+	}
+	return documents
+}
@@ -0,0 +1,143 @@
+package infrastructures
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+
+	"gotham/config"
+)
+
+// ShardKey is what a record is placed on a shard by, the tenant or the user owning it
+type ShardKey struct {
+	Tenant string
+	UserID uint
+}
+
+// ShardResolver is the index of the shard of the key among count shards
+type ShardResolver func(key ShardKey, count int) int
+
+// ShardByUser spreads the records by a hash of the id of their user, the records without a user are on the main database
+func ShardByUser(key ShardKey, count int) int {
+	if key.UserID == 0 {
+		return 0
+	}
+	return shardOf(strconv.FormatUint(uint64(key.UserID), 10), count)
+}
+
+// ShardByTenant spreads the records by a hash of their tenant, the records without a tenant are on the main database
+func ShardByTenant(key ShardKey, count int) int {
+	if key.Tenant == "" {
+		return 0
+	}
+	return shardOf(key.Tenant, count)
+}
+
+func shardOf(key string, count int) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(key))
+	return int(hash.Sum32() % uint32(count))
+}
+
+/**
+ * IShards
+ * the gorm connections of the shards, the first one is the main database. Without shards every key resolves to it
+ */
+type IShards interface {
+	// For is the database of the shard of the key
+	For(key ShardKey) IGormDatabase
+	// All are the databases of every shard, in the order of their indexes
+	All() []IGormDatabase
+	// Migrate creates the tables of the models on every shard
+	Migrate(models ...interface{}) error
+	Close() error
+}
+
+type Shards struct {
+	Databases []IGormDatabase
+	Resolver  ShardResolver
+}
+
+/**
+ * NewShards
+ * opens the databases of DB_SHARDS on the server of the main database with its credentials and pool settings, the
+ * main database is the first shard
+ */
+func NewShards(main IGormDatabase, dbConfig config.Database, shardsConfig *config.Shards, logger gormLogger.Interface, plugins ...gorm.Plugin) (*Shards, error) {
+	shards := &Shards{Databases: []IGormDatabase{main}, Resolver: ShardByUser}
+	if shardsConfig.By == config.ShardByTenant {
+		shards.Resolver = ShardByTenant
+	}
+	for _, address := range shardsConfig.Databases {
+		shardConfig, err := shardDbConfig(dbConfig, address)
+		if err != nil {
+			_ = shards.Close()
+			return nil, err
+		}
+		pool, err := NewGormDatabasePool(shardConfig)
+		if err != nil {
+			_ = shards.Close()
+			return nil, err
+		}
+		database, err := NewGormDatabase(pool, logger, plugins...)
+		if err != nil {
+			_ = shards.Close()
+			return nil, fmt.Errorf("shard %v: %w", address, err)
+		}
+		shards.Databases = append(shards.Databases, database)
+	}
+	return shards, nil
+}
+
+// shardDbConfig is the config of the main database pointing at the host:port/database of a shard
+func shardDbConfig(dbConfig config.Database, address string) (config.Database, error) {
+	hostPort, database, ok := strings.Cut(address, "/")
+	if !ok || hostPort == "" || database == "" {
+		return dbConfig, errors.New("shards: a shard is host:port/database, not " + address)
+	}
+	dbConfig.DbHost, dbConfig.DbPort = hostPort, ""
+	if host, port, err := net.SplitHostPort(hostPort); err == nil {
+		dbConfig.DbHost, dbConfig.DbPort = host, port
+	}
+	dbConfig.DbDatabase = database
+	return dbConfig, nil
+}
+
+func (s *Shards) For(key ShardKey) IGormDatabase {
+	if len(s.Databases) == 1 {
+		return s.Databases[0]
+	}
+	return s.Databases[s.Resolver(key, len(s.Databases))]
+}
+
+func (s *Shards) All() []IGormDatabase {
+	return s.Databases
+}
+
+func (s *Shards) Migrate(models ...interface{}) error {
+	for i, database := range s.Databases {
+		if err := database.DB().AutoMigrate(models...); err != nil {
+			return fmt.Errorf("shard %v: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Close closes the connections of the shards, the main database is closed by its own definition
+func (s *Shards) Close() error {
+	var err error
+	for _, database := range s.Databases[1:] {
+		if sqlDB, e := database.DB().DB(); e == nil {
+			if e = sqlDB.Close(); e != nil && err == nil {
+				err = e
+			}
+		}
+	}
+	return err
+}
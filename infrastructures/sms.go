@@ -0,0 +1,114 @@
+package infrastructures
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"gotham/config"
+)
+
+// Sms Service
+
+/**
+ * ISmsService
+ *
+ * interface
+ */
+type ISmsService interface {
+	Send(to string, message string) error
+}
+
+/**
+ * NewSmsService
+ *
+ */
+func NewSmsService(smsConfig *config.Sms, clients IHttpClientFactory) (ISmsService, error) {
+	switch smsConfig.Driver {
+	case "log":
+		return NewLogSmsService(), nil
+	case "twilio":
+		return &TwilioSmsService{Config: smsConfig, Client: clients.Make("twilio")}, nil
+	}
+	return nil, fmt.Errorf("unsupported sms driver %q", smsConfig.Driver)
+}
+
+/**
+ * TwilioSmsService
+ * sends through the messages resource of the twilio rest api
+ */
+type TwilioSmsService struct {
+	Config *config.Sms
+	Client IHttpClient
+}
+
+/**
+ * Send
+ *
+ */
+func (s *TwilioSmsService) Send(to string, message string) error {
+	endpoint := fmt.Sprintf("https://api.twilio.com/2010-04-01/Accounts/%s/Messages.json", url.PathEscape(s.Config.TwilioAccountSid))
+	form := url.Values{"To": {to}, "From": {s.Config.TwilioFrom}, "Body": {message}}
+	request, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	request.SetBasicAuth(s.Config.TwilioAccountSid, s.Config.TwilioAuthToken)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := s.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("twilio: %s: %s", response.Status, body)
+	}
+	return nil
+}
+
+/**
+ * LogSmsService
+ * mock sender of development and test environments, messages are logged and kept instead of being sent
+ */
+type LogSmsService struct {
+	sent []SentSms
+	mu   sync.Mutex
+}
+
+type SentSms struct {
+	To      string
+	Message string
+}
+
+func NewLogSmsService() *LogSmsService {
+	return &LogSmsService{}
+}
+
+/**
+ * Send
+ *
+ */
+func (s *LogSmsService) Send(to string, message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, SentSms{To: to, Message: message})
+	log.Printf("sms: %q to %v", message, to)
+	return nil
+}
+
+/**
+ * Sent
+ * the messages sent so far
+ */
+func (s *LogSmsService) Sent() []SentSms {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SentSms(nil), s.sent...)
+}
@@ -0,0 +1,140 @@
+package infrastructures
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gotham/config"
+)
+
+var ErrInvalidStoragePath = errors.New("invalid storage path")
+
+// StorageFile is an opened file of the storage, read from any offset so it is served in ranges
+type StorageFile interface {
+	io.ReadSeekCloser
+	Stat() (fs.FileInfo, error)
+}
+
+/**
+ * IStorageService
+ *
+ */
+type IStorageService interface {
+	Put(path string, content []byte) error
+	Get(path string) ([]byte, error)
+	// Open opens the file to be streamed, the caller closes it
+	Open(path string) (StorageFile, error)
+	Delete(path string) error
+	Exists(path string) bool
+	FullPath(path string) (string, error)
+}
+
+/**
+ * LocalStorageService
+ * stores files under the configured root directory
+ */
+type LocalStorageService struct {
+	Config *config.Storage
+}
+
+/**
+ * NewLocalStorageService
+ *
+ */
+func NewLocalStorageService(storageConfig *config.Storage) IStorageService {
+	return &LocalStorageService{
+		Config: storageConfig,
+	}
+}
+
+/**
+ * FullPath
+ * resolve path inside the root, the root itself and paths escaping it are rejected
+ */
+func (s *LocalStorageService) FullPath(path string) (string, error) {
+	root, err := filepath.Abs(s.Config.Root)
+	if err != nil {
+		return "", err
+	}
+	full := filepath.Join(root, filepath.FromSlash(path))
+	if !strings.HasPrefix(full, root+string(filepath.Separator)) {
+		return "", ErrInvalidStoragePath
+	}
+	return full, nil
+}
+
+/**
+ * Put
+ *
+ */
+func (s *LocalStorageService) Put(path string, content []byte) error {
+	full, err := s.FullPath(path)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(full, content, 0644)
+}
+
+/**
+ * Get
+ *
+ */
+func (s *LocalStorageService) Get(path string) ([]byte, error) {
+	full, err := s.FullPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(full)
+}
+
+/**
+ * Open
+ *
+ */
+func (s *LocalStorageService) Open(path string) (StorageFile, error) {
+	full, err := s.FullPath(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(full)
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+/**
+ * Delete
+ *
+ */
+func (s *LocalStorageService) Delete(path string) error {
+	full, err := s.FullPath(path)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(full); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+/**
+ * Exists
+ *
+ */
+func (s *LocalStorageService) Exists(path string) bool {
+	full, err := s.FullPath(path)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(full)
+	return err == nil
+}
@@ -0,0 +1,189 @@
+package infrastructures
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// the kinds of the spans recorded by the infrastructures
+const (
+	SpanDB    = "db"
+	SpanCache = "cache"
+	SpanHttp  = "http"
+)
+
+type traceKey struct{}
+
+/**
+ * Span
+ * a call made while serving a request, a query, a cache operation or a request to another service
+ */
+type Span struct {
+	Kind     string
+	Name     string
+	Start    time.Time
+	Duration time.Duration
+	Error    string
+}
+
+// SpanTotal the calls of a kind and their total duration
+type SpanTotal struct {
+	Count    int
+	Duration time.Duration
+}
+
+/**
+ * Trace
+ * the spans of a request, the calls made with its context. The totals count every span, the spans themselves are
+ * kept up to the limit
+ */
+type Trace struct {
+	Start time.Time
+
+	spans   []Span
+	totals  map[string]SpanTotal
+	limit   int
+	dropped int
+	mu      sync.Mutex
+}
+
+/**
+ * WithTrace
+ * a context recording the spans of the calls made with it
+ */
+func WithTrace(ctx context.Context, limit int) (context.Context, *Trace) {
+	trace := &Trace{Start: time.Now(), totals: map[string]SpanTotal{}, limit: limit}
+	return context.WithValue(ctx, traceKey{}, trace), trace
+}
+
+/**
+ * TraceFrom
+ * the trace of the context, nil when nothing is traced
+ */
+func TraceFrom(ctx context.Context) *Trace {
+	if ctx == nil {
+		return nil
+	}
+	trace, _ := ctx.Value(traceKey{}).(*Trace)
+	return trace
+}
+
+/**
+ * StartSpan
+ * starts a span of the trace of the context, the returned function ends it with the error of the call
+ */
+func StartSpan(ctx context.Context, kind string, name string) func(err error) {
+	trace := TraceFrom(ctx)
+	if trace == nil {
+		return func(error) {}
+	}
+	start := time.Now()
+	return func(err error) {
+		span := Span{Kind: kind, Name: name, Start: start, Duration: time.Since(start)}
+		if err != nil {
+			span.Error = err.Error()
+		}
+		trace.Add(span)
+	}
+}
+
+// Add records a span that ended
+func (t *Trace) Add(span Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	total := t.totals[span.Kind]
+	total.Count++
+	total.Duration += span.Duration
+	t.totals[span.Kind] = total
+	if len(t.spans) >= t.limit {
+		t.dropped++
+		return
+	}
+	t.spans = append(t.spans, span)
+}
+
+// Totals the count and duration of the spans by kind
+func (t *Trace) Totals() map[string]SpanTotal {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	totals := make(map[string]SpanTotal, len(t.totals))
+	for kind, total := range t.totals {
+		totals[kind] = total
+	}
+	return totals
+}
+
+// Slowest the n slowest spans kept, the slowest first
+func (t *Trace) Slowest(n int) []Span {
+	t.mu.Lock()
+	spans := append([]Span(nil), t.spans...)
+	t.mu.Unlock()
+	sort.SliceStable(spans, func(i, j int) bool { return spans[i].Duration > spans[j].Duration })
+	if len(spans) > n {
+		spans = spans[:n]
+	}
+	return spans
+}
+
+// Dropped the spans beyond the limit, counted in the totals only
+func (t *Trace) Dropped() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.dropped
+}
+
+/**
+ * TracedCache
+ * records the operations of the cache as spans of the trace of their context
+ */
+type TracedCache struct {
+	Cache ICache
+}
+
+func NewTracedCache(cache ICache) ICache {
+	return TracedCache{Cache: cache}
+}
+
+func (c TracedCache) Get(ctx context.Context, key string) (value []byte, found bool, err error) {
+	end := StartSpan(ctx, SpanCache, "get "+key)
+	value, found, err = c.Cache.Get(ctx, key)
+	end(err)
+	return
+}
+
+func (c TracedCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) (err error) {
+	end := StartSpan(ctx, SpanCache, "set "+key)
+	err = c.Cache.Set(ctx, key, value, ttl)
+	end(err)
+	return
+}
+
+func (c TracedCache) Add(ctx context.Context, key string, value []byte, ttl time.Duration) (added bool, err error) {
+	end := StartSpan(ctx, SpanCache, "add "+key)
+	added, err = c.Cache.Add(ctx, key, value, ttl)
+	end(err)
+	return
+}
+
+func (c TracedCache) Delete(ctx context.Context, keys ...string) (err error) {
+	end := StartSpan(ctx, SpanCache, "delete")
+	err = c.Cache.Delete(ctx, keys...)
+	end(err)
+	return
+}
+
+func (c TracedCache) Tag(ctx context.Context, tag string, keys ...string) (err error) {
+	end := StartSpan(ctx, SpanCache, "tag "+tag)
+	err = c.Cache.Tag(ctx, tag, keys...)
+	end(err)
+	return
+}
+
+func (c TracedCache) InvalidateTags(ctx context.Context, tags ...string) (err error) {
+	end := StartSpan(ctx, SpanCache, "invalidate tags")
+	err = c.Cache.InvalidateTags(ctx, tags...)
+	end(err)
+	return
+}
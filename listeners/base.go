@@ -0,0 +1,29 @@
+package listeners
+
+import (
+	"gotham/app"
+	"gotham/models"
+	"gotham/services"
+)
+
+func Initialize() {
+	events := app.Application.Container.GetEvents()
+
+	// push notifications
+	events.Subscribe(services.EventMessageCreated, app.Application.Container.GetNotificationService().MessageCreated)
+	events.Subscribe(services.EventFollowCreated, app.Application.Container.GetNotificationService().FollowCreated)
+
+	// announcements
+	events.Subscribe(services.EventPublished(models.Announcement{}.TableName()), app.Application.Container.GetAnnouncementService().Published)
+
+	// search
+	for _, name := range []string{services.EventCommentCreated, services.EventCommentUpdated, services.EventCommentDeleted, services.EventPublished(models.Announcement{}.TableName()), services.EventAnnouncementDeleted} {
+		events.Subscribe(name, app.Application.Container.GetSearchService().Sync)
+	}
+	app.Application.Container.GetSearchService().Start()
+
+	// read models
+	for _, name := range []string{services.EventUserCreated, services.EventUserUpdated, services.EventUserDeleted, services.EventTagsChanged, services.EventFollowCreated, services.EventFollowDeleted} {
+		events.Subscribe(name, app.Application.Container.GetUserProjectionService().Project)
+	}
+}
@@ -0,0 +1,57 @@
+package mails
+
+import (
+	"bytes"
+
+	"github.com/alecthomas/template"
+	"github.com/jordan-wright/email"
+)
+
+/**
+ * EmailChangeConfirmation
+ * sent to the new address, holds the confirmation link
+ */
+type EmailChangeConfirmation struct {
+	Type    string
+	Context email.Email
+}
+
+/**
+ * NewEmailChangeConfirmation
+ *
+ * @return EmailChangeConfirmation
+ */
+func NewEmailChangeConfirmation(context email.Email) EmailChangeConfirmation {
+	return EmailChangeConfirmation{
+		Type:    "-",
+		Context: context,
+	}
+}
+
+/**
+ * Render
+ *
+ * @return email.Email
+ */
+func (m EmailChangeConfirmation) Render(data map[string]interface{}, to []string) (context email.Email, err error) {
+	var t *template.Template
+	t, err = template.ParseFiles("views/emailChangeConfirmation.html")
+	if err != nil {
+		return email.Email{}, err
+	}
+	var body bytes.Buffer
+	err = t.Execute(&body, struct {
+		Url       interface{}
+		Email     interface{}
+		ExpiresAt interface{}
+	}{
+		Url:       data["url"],
+		Email:     data["email"],
+		ExpiresAt: data["expires_at"],
+	})
+	m.Context.From = "Gotham <example@go-gotham.com>"
+	m.Context.To = to
+	m.Context.Subject = "Confirm your new email address"
+	m.Context.HTML = body.Bytes()
+	return m.Context, err
+}
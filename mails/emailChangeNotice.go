@@ -0,0 +1,53 @@
+package mails
+
+import (
+	"bytes"
+
+	"github.com/alecthomas/template"
+	"github.com/jordan-wright/email"
+)
+
+/**
+ * EmailChangeNotice
+ * sent to the current address when a change is requested
+ */
+type EmailChangeNotice struct {
+	Type    string
+	Context email.Email
+}
+
+/**
+ * NewEmailChangeNotice
+ *
+ * @return EmailChangeNotice
+ */
+func NewEmailChangeNotice(context email.Email) EmailChangeNotice {
+	return EmailChangeNotice{
+		Type:    "-",
+		Context: context,
+	}
+}
+
+/**
+ * Render
+ *
+ * @return email.Email
+ */
+func (m EmailChangeNotice) Render(data map[string]interface{}, to []string) (context email.Email, err error) {
+	var t *template.Template
+	t, err = template.ParseFiles("views/emailChangeNotice.html")
+	if err != nil {
+		return email.Email{}, err
+	}
+	var body bytes.Buffer
+	err = t.Execute(&body, struct {
+		Email interface{}
+	}{
+		Email: data["email"],
+	})
+	m.Context.From = "Gotham <example@go-gotham.com>"
+	m.Context.To = to
+	m.Context.Subject = "Your account email is being changed"
+	m.Context.HTML = body.Bytes()
+	return m.Context, err
+}
@@ -0,0 +1,55 @@
+package mails
+
+import (
+	"bytes"
+
+	"github.com/alecthomas/template"
+	"github.com/jordan-wright/email"
+)
+
+/**
+ * MagicLink
+ * a single use sign in link
+ */
+type MagicLink struct {
+	Type    string
+	Context email.Email
+}
+
+/**
+ * NewMagicLink
+ *
+ * @return MagicLink
+ */
+func NewMagicLink(context email.Email) MagicLink {
+	return MagicLink{
+		Type:    "-",
+		Context: context,
+	}
+}
+
+/**
+ * Render
+ *
+ * @return email.Email
+ */
+func (m MagicLink) Render(data map[string]interface{}, to []string) (context email.Email, err error) {
+	var t *template.Template
+	t, err = template.ParseFiles("views/magicLink.html")
+	if err != nil {
+		return email.Email{}, err
+	}
+	var body bytes.Buffer
+	err = t.Execute(&body, struct {
+		Url       interface{}
+		ExpiresAt interface{}
+	}{
+		Url:       data["url"],
+		ExpiresAt: data["expires_at"],
+	})
+	m.Context.From = "Gotham <example@go-gotham.com>"
+	m.Context.To = to
+	m.Context.Subject = "Your sign in link"
+	m.Context.HTML = body.Bytes()
+	return m.Context, err
+}
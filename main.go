@@ -1,20 +1,59 @@
 package main
 
 import (
+	"flag"
+	"log"
+
 	"github.com/labstack/echo/v4"
 
 	"gotham/app"
+	"gotham/app/flags"
+	"gotham/commands"
 	"gotham/config"
 	"gotham/database/migrations"
 	"gotham/database/seeds"
+	"gotham/listeners"
+	"gotham/retention"
 	"gotham/routers"
+	"gotham/sagas"
+	"gotham/schedules"
+	"gotham/workers"
 )
 
 func main() {
 	config.Configurations()
 	app.New()
 	defer app.Application.Container.Delete()
+	if *flags.Graph != "" {
+		if err := app.Application.PrintGraph(*flags.Graph); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+	if *flags.Routes {
+		if err := routers.PrintRoutes(echo.New()); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+	if *flags.Mock {
+		routers.Mock(echo.New())
+		return
+	}
+	if name := flag.Arg(0); name != "" {
+		if err := commands.Run(name, flag.Args()[1:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
 	migrations.Initialize()
 	seeds.Initialize()
+	listeners.Initialize()
+	retention.Initialize()
+	sagas.Initialize()
+	schedules.Initialize()
+	workers.Initialize()
+	// the configuration of the instance, compared with the one of the other deployment by GET /admin/config
+	app.Application.Container.GetConfigService().Record()
 	routers.Route(echo.New())
 }
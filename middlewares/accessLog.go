@@ -0,0 +1,60 @@
+package GMiddleware
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/infrastructures"
+)
+
+type AccessLog struct {
+	Logger infrastructures.ILogger
+}
+
+// AccessLogMiddleware writes one structured entry per request, with the queries run with the request context
+func (a AccessLog) AccessLogMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		ctx, stats := infrastructures.WithQueryStats(c.Request().Context())
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		start := time.Now()
+		if err = next(c); err != nil {
+			c.Error(err)
+		}
+		latency := time.Since(start)
+
+		queries, queryDuration := stats.Get()
+		fields := infrastructures.Fields{
+			"method":     c.Request().Method,
+			"route":      c.Path(),
+			"uri":        c.Request().RequestURI,
+			"status":     c.Response().Status,
+			"latency_ms": float64(latency.Microseconds()) / 1000,
+			"bytes_in":   c.Request().ContentLength,
+			"bytes_out":  c.Response().Size,
+			"ip":         c.RealIP(),
+			"user_agent": c.Request().UserAgent(),
+		}
+		if id := c.Response().Header().Get(echo.HeaderXRequestID); id != "" {
+			fields["request_id"] = id
+		}
+		if queries > 0 {
+			fields["queries"] = queries
+			fields["query_duration_ms"] = float64(queryDuration.Microseconds()) / 1000
+		}
+		if err != nil {
+			fields["error"] = err
+		}
+
+		switch status := c.Response().Status; {
+		case status >= 500:
+			a.Logger.Error("request", fields)
+		case status >= 400:
+			a.Logger.Warn("request", fields)
+		default:
+			a.Logger.Info("request", fields)
+		}
+		return
+	}
+}
@@ -0,0 +1,80 @@
+package GMiddleware
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"gotham/policies"
+	"gotham/problems"
+	"gotham/services"
+)
+
+type AccessRules struct {
+	SettingService services.ISettingService
+}
+
+// Resource loads the resource of the request a rule is evaluated on
+type Resource func(c echo.Context) (interface{}, error)
+
+// Require allows the request when the access rule stored in the setting holds for the auth user, the action and the
+// resource, a setting without a rule allows every request. A rule failing to evaluate denies it.
+// it must run after the auth middleware
+func (a AccessRules) Require(setting string, action policies.Action, resource Resource) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			source := a.SettingService.String(setting, "")
+			if strings.TrimSpace(source) == "" {
+				return next(c)
+			}
+
+			var target interface{}
+			if resource != nil {
+				var err error
+				if target, err = resource(c); err != nil {
+					return err
+				}
+			}
+			allowed, err := evaluate(source, c.Get("auth"), action, target)
+			if err != nil {
+				c.Logger().Errorf("access rule %v: %v", setting, err)
+			}
+			if !allowed {
+				return problems.New(problems.AccessRuleDenied)
+			}
+			return next(c)
+		}
+	}
+}
+
+func evaluate(source string, auth interface{}, action policies.Action, resource interface{}) (bool, error) {
+	expression, err := policies.ParseExpression(source)
+	if err != nil {
+		return false, err
+	}
+	env, err := policies.Env(auth, action, resource)
+	if err != nil {
+		return false, err
+	}
+	return expression.Allows(env)
+}
+
+// UserResource is the user of the :user param of the route
+func UserResource(service services.IUserService) Resource {
+	return func(c echo.Context) (interface{}, error) {
+		id, err := strconv.ParseUint(c.Param("user"), 10, 64)
+		if err != nil {
+			return nil, problems.New(problems.UserNotFound)
+		}
+		user, err := service.GetUserByID(uint(id))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, problems.New(problems.UserNotFound)
+		} else if err != nil {
+			return nil, echo.ErrInternalServerError
+		}
+		return user, nil
+	}
+}
@@ -0,0 +1,86 @@
+package GMiddleware
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type Analytics struct {
+	Analytics infrastructures.IAnalytics
+	Config    *config.Analytics
+}
+
+// AnalyticsMiddleware records a sanitized summary of a sample of requests
+func (a Analytics) AnalyticsMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		if !a.Config.Enabled {
+			return next(c)
+		}
+
+		tenant := c.Request().Header.Get(a.Config.TenantHeader)
+		if tenant != "" && helpers.InArray(tenant, a.Config.OptOut) {
+			return next(c)
+		}
+		if rand.Float64() >= a.rate(c, tenant) {
+			return next(c)
+		}
+
+		start := time.Now()
+		if err = next(c); err != nil {
+			c.Error(err)
+		}
+
+		a.Analytics.Track(infrastructures.AnalyticsEvent{
+			Name:       "api.request",
+			Tenant:     tenant,
+			Properties: a.summary(c, time.Since(start)),
+		})
+		return
+	}
+}
+
+// rate picks the most specific sampling rate, tenant first then route
+func (a Analytics) rate(c echo.Context, tenant string) float64 {
+	if rate, ok := a.Config.TenantRates[tenant]; ok && tenant != "" {
+		return rate
+	}
+	if rate, ok := a.Config.RouteRates[c.Request().Method+" "+c.Path()]; ok {
+		return rate
+	}
+	return a.Config.SampleRate
+}
+
+func (a Analytics) summary(c echo.Context, duration time.Duration) map[string]interface{} {
+	query := map[string]interface{}{}
+	for key, values := range c.QueryParams() {
+		if len(values) == 1 {
+			query[key] = values[0]
+		} else {
+			query[key] = values
+		}
+	}
+
+	summary := map[string]interface{}{
+		"method":      c.Request().Method,
+		"route":       c.Path(),
+		"status":      c.Response().Status,
+		"duration_ms": duration.Milliseconds(),
+		"bytes_in":    c.Request().ContentLength,
+		"bytes_out":   c.Response().Size,
+		"query":       helpers.RedactPII(query),
+	}
+
+	// users are pseudonymized, the same user always gets the same id
+	if auth, ok := c.Get("auth").(models.User); ok {
+		summary["user"] = helpers.ComputeHmacSha1(fmt.Sprintf("%d", auth.ID), config.Conf.SecretKey)
+	}
+	return summary
+}
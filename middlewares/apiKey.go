@@ -0,0 +1,46 @@
+package GMiddleware
+
+import (
+	"errors"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/services"
+)
+
+// HeaderApiKey carries the api key of a machine client instead of a bearer token
+const HeaderApiKey = "X-API-Key"
+
+type ApiKey struct {
+	ApiKeyService services.IApiKeyService
+}
+
+// HasApiKey skips the jwt middleware for the requests authenticated by an api key
+func HasApiKey(c echo.Context) bool {
+	return c.Request().Header.Get(HeaderApiKey) != ""
+}
+
+// ApiKeyMiddleware authenticates the api key as the claims of a token of its user limited to its scopes, the
+// middlewares reading the token see it as any other. It must run before the auth middleware
+func (a ApiKey) ApiKeyMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get(HeaderApiKey)
+		if key == "" {
+			return next(c)
+		}
+		apiKey, err := a.ApiKeyService.Authenticate(key)
+		if errors.Is(err, services.ErrApiKeyInvalid) {
+			return err
+		} else if err != nil {
+			return echo.ErrInternalServerError
+		}
+		c.Set("api_key", apiKey)
+		c.Set("user", &jwt.Token{
+			Claims: &config.JwtCustomClaims{AuthID: apiKey.UserID, Scopes: apiKey.ScopeList()},
+			Valid:  true,
+		})
+		return next(c)
+	}
+}
@@ -2,31 +2,70 @@ package GMiddleware
 
 import (
 	"errors"
+	"strconv"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/labstack/echo/v4"
 	"gorm.io/gorm"
 
 	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/problems"
 	"gotham/services"
 )
 
+// HeaderImpersonatedBy carries the id of the admin behind an impersonation token
+const HeaderImpersonatedBy = "X-Impersonated-By"
+
 type Auth struct {
 	UserService services.IUserService
+	Logger      infrastructures.ILogger
 }
 
 func (s Auth) AuthMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		token := c.Get("user").(*jwt.Token)
 		claims := token.Claims.(*config.JwtCustomClaims)
+		// the tokens of the clients are for the internal routes only
+		if claims.ClientID != 0 {
+			return problems.New(problems.Unauthenticated)
+		}
 		auth, err := s.UserService.GetUserByID(claims.AuthID)
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return echo.NewHTTPError(401, "auth user could not be found")
+				return problems.New(problems.Unauthenticated)
 			}
 			return echo.ErrInternalServerError
 		}
 		c.Set("auth", auth)
+		if scope := infrastructures.ReportScopeFrom(c.Request().Context()); scope != nil {
+			scope.UserID = auth.ID
+		}
+
+		if claims.ImpersonatorID != 0 {
+			// the token stops working as soon as its admin is no longer an admin
+			impersonator, err := s.UserService.GetUserByID(claims.ImpersonatorID)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return problems.New(problems.Unauthenticated)
+				}
+				return echo.ErrInternalServerError
+			}
+			if !impersonator.IsAdmin() {
+				return problems.New(problems.Unauthenticated)
+			}
+			c.Set("impersonator", impersonator)
+			c.Response().Header().Set(HeaderImpersonatedBy, strconv.FormatUint(uint64(impersonator.ID), 10))
+
+			s.Logger.Info("impersonated request", infrastructures.Fields{
+				"audit":           true,
+				"impersonator_id": impersonator.ID,
+				"user_id":         auth.ID,
+				"method":          c.Request().Method,
+				"route":           c.Path(),
+				"ip":              c.RealIP(),
+			})
+		}
 		return next(c)
 	}
 }
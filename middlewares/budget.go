@@ -0,0 +1,123 @@
+package GMiddleware
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/problems"
+)
+
+type Budget struct {
+	Metrics infrastructures.IMetrics
+	Config  *config.Budget
+}
+
+// BudgetMiddleware measures the payload and header size of every response, reports them as metrics and logs the
+// responses over budget. In strict mode an oversized response is replaced by an error so tests catch it. The websockets
+// take the connection over, they are not measured
+func (b Budget) BudgetMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		if c.Request().Header.Get(echo.HeaderUpgrade) != "" {
+			return next(c)
+		}
+		writer := &budgetWriter{ResponseWriter: c.Response().Writer, strict: b.Config.Strict, status: http.StatusOK}
+		c.Response().Writer = writer
+		defer func() {
+			c.Response().Writer = writer.ResponseWriter
+		}()
+
+		if err = next(c); err != nil {
+			c.Error(err)
+		}
+
+		labels := map[string]string{"method": c.Request().Method, "route": c.Path()}
+		b.Metrics.Observe("http_response_size_bytes", labels, float64(writer.size))
+		b.Metrics.Observe("http_response_header_bytes", labels, float64(writer.headerSize))
+
+		exceeded := false
+		if budget := b.payloadBudget(c); budget > 0 && writer.size > budget {
+			exceeded = true
+			b.violation(c, "payload", writer.size, budget)
+		}
+		if b.Config.Header > 0 && writer.headerSize > b.Config.Header {
+			exceeded = true
+			b.violation(c, "header", writer.headerSize, b.Config.Header)
+		}
+
+		if !b.Config.Strict {
+			return
+		}
+		if exceeded {
+			return writer.reject(c.Request().URL.Path)
+		}
+		return writer.flush()
+	}
+}
+
+func (b Budget) payloadBudget(c echo.Context) int64 {
+	if budget, ok := b.Config.Routes[c.Request().Method+" "+c.Path()]; ok {
+		return budget
+	}
+	return b.Config.DefaultPayload
+}
+
+func (b Budget) violation(c echo.Context, kind string, size int64, budget int64) {
+	b.Metrics.Inc("http_response_budget_violations_total", map[string]string{"method": c.Request().Method, "route": c.Path(), "kind": kind}, 1)
+	c.Logger().Warnf("response %v budget exceeded on %v %v: %v bytes (budget %v bytes)", kind, c.Request().Method, c.Path(), size, budget)
+}
+
+type budgetWriter struct {
+	http.ResponseWriter
+	strict     bool
+	buffer     bytes.Buffer
+	status     int
+	size       int64
+	headerSize int64
+}
+
+func (w *budgetWriter) WriteHeader(status int) {
+	w.status = status
+	for key, values := range w.Header() {
+		for _, value := range values {
+			w.headerSize += int64(len(key) + len(value) + 4)
+		}
+	}
+	if !w.strict {
+		w.ResponseWriter.WriteHeader(status)
+	}
+}
+
+func (w *budgetWriter) Write(b []byte) (int, error) {
+	w.size += int64(len(b))
+	if w.strict {
+		return w.buffer.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *budgetWriter) flush() error {
+	w.ResponseWriter.WriteHeader(w.status)
+	_, err := w.ResponseWriter.Write(w.buffer.Bytes())
+	return err
+}
+
+func (w *budgetWriter) reject(instance string) error {
+	p := problems.New(problems.ResponseBudgetExceeded, fmt.Sprintf("the response is %v bytes with %v bytes of headers", w.size, w.headerSize))
+	p.Instance = instance
+	body, err := p.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	header := w.ResponseWriter.Header()
+	header.Set(echo.HeaderContentType, problems.ContentType)
+	header.Set(echo.HeaderContentLength, strconv.Itoa(len(body)))
+	w.ResponseWriter.WriteHeader(p.Status)
+	_, err = w.ResponseWriter.Write(body)
+	return err
+}
@@ -0,0 +1,56 @@
+package GMiddleware
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/problems"
+	"gotham/services"
+)
+
+type Client struct {
+	ClientService services.IClientService
+}
+
+// ClientMiddleware authenticates the client of a client credentials token and counts the request in the rate limit of
+// the client, reported in the X-RateLimit headers. The tokens of the users are refused, it replaces the auth middleware
+// on the internal routes
+func (s Client) ClientMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		token := c.Get("user").(*jwt.Token)
+		claims := token.Claims.(*config.JwtCustomClaims)
+		if claims.ClientID == 0 {
+			return problems.New(problems.Unauthenticated)
+		}
+		client, err := s.ClientService.GetActiveClient(claims.ClientID)
+		if errors.Is(err, services.ErrClientInvalid) {
+			return err
+		} else if err != nil {
+			return echo.ErrInternalServerError
+		}
+		c.Set("client", client)
+
+		status, err := s.ClientService.Throttle(c.Request().Context(), client)
+		if err != nil && !errors.Is(err, services.ErrClientRateLimitReached) {
+			return echo.ErrInternalServerError
+		}
+		if status.Limit == 0 {
+			return next(c)
+		}
+		header := c.Response().Header()
+		header.Set("X-RateLimit-Limit", strconv.Itoa(status.Limit))
+		header.Set("X-RateLimit-Remaining", strconv.Itoa(status.Remaining))
+		header.Set("X-RateLimit-Reset", strconv.FormatInt(status.Reset.Unix(), 10))
+		header.Set("X-RateLimit-Window", status.Window)
+		if err != nil {
+			header.Set("Retry-After", strconv.FormatInt(int64(time.Until(status.Reset).Seconds())+1, 10))
+			return err
+		}
+		return next(c)
+	}
+}
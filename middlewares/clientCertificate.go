@@ -0,0 +1,56 @@
+package GMiddleware
+
+import (
+	"crypto/x509"
+	"errors"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/services"
+)
+
+type ClientCertificate struct {
+	ClientService services.IClientService
+}
+
+// HasClientCertificate skips the jwt middleware for the requests of a connection with a verified client certificate
+func HasClientCertificate(c echo.Context) bool {
+	state := c.Request().TLS
+	return state != nil && len(state.VerifiedChains) > 0
+}
+
+// ClientCertificateMiddleware authenticates the verified client certificate of the connection as the claims of a token
+// of its client with all its scopes, the client middleware sees it as any client token. A certificate of no client is
+// refused. It must run before the client middleware
+func (s ClientCertificate) ClientCertificateMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !HasClientCertificate(c) {
+			return next(c)
+		}
+		client, err := s.ClientService.AuthenticateCertificate(certificateIdentities(c.Request().TLS.VerifiedChains[0][0]))
+		if errors.Is(err, services.ErrClientInvalid) {
+			return err
+		} else if err != nil {
+			return echo.ErrInternalServerError
+		}
+		c.Set("user", &jwt.Token{
+			Claims: &config.JwtCustomClaims{ClientID: client.ID, Scopes: client.ScopeList()},
+			Valid:  true,
+		})
+		return next(c)
+	}
+}
+
+// certificateIdentities are the names of the certificate, its URIs like a SPIFFE ID first and its common name last
+func certificateIdentities(certificate *x509.Certificate) (identities []string) {
+	for _, uri := range certificate.URIs {
+		identities = append(identities, uri.String())
+	}
+	identities = append(identities, certificate.DNSNames...)
+	if certificate.Subject.CommonName != "" {
+		identities = append(identities, certificate.Subject.CommonName)
+	}
+	return identities
+}
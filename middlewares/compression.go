@@ -0,0 +1,214 @@
+package GMiddleware
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+)
+
+// compressor is a pooled writer of a content coding
+type compressor interface {
+	io.WriteCloser
+	Reset(w io.Writer)
+}
+
+// compressors are the writers of the content codings, by their name in Accept-Encoding
+var compressors = map[string]func(level int) (compressor, error){
+	"gzip": func(level int) (compressor, error) {
+		return gzip.NewWriterLevel(io.Discard, level)
+	},
+	"deflate": func(level int) (compressor, error) {
+		return flate.NewWriter(io.Discard, level)
+	},
+}
+
+// Compression compresses the responses of the configured content types once they reach the minimum size, in the
+// encoding of the Accept-Encoding header. Smaller responses are written as they are, without a compressor.
+type Compression struct {
+	Config *config.Compression
+
+	pools sync.Map
+}
+
+func (m *Compression) CompressionMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if len(m.Config.Encodings) == 0 {
+			return next(c)
+		}
+		c.Response().Header().Add(echo.HeaderVary, echo.HeaderAcceptEncoding)
+		// websockets hijack the connection
+		if c.Request().Method == http.MethodHead || c.Request().Header.Get("Upgrade") != "" {
+			return next(c)
+		}
+		encoding := m.negotiate(c.Request().Header.Get(echo.HeaderAcceptEncoding))
+		if encoding == "" {
+			return next(c)
+		}
+
+		writer := &compressionWriter{ResponseWriter: c.Response().Writer, middleware: m, encoding: encoding}
+		c.Response().Writer = writer
+		defer func() {
+			c.Response().Writer = writer.ResponseWriter
+		}()
+
+		err := next(c)
+		if closeErr := writer.close(); err == nil {
+			err = closeErr
+		}
+		return err
+	}
+}
+
+// negotiate is the configured encoding of the Accept-Encoding header with the highest quality, empty for none
+func (m *Compression) negotiate(acceptEncoding string) string {
+	best, quality := "", 0.0
+	for _, encoding := range m.Config.Encodings {
+		if _, supported := compressors[encoding]; !supported {
+			continue
+		}
+		if q := encodingQuality(acceptEncoding, encoding); q > quality {
+			best, quality = encoding, q
+		}
+	}
+	return best
+}
+
+// encodingQuality is the quality of the encoding in the Accept-Encoding header, or of *, parsed without allocating as
+// it runs on every request
+func encodingQuality(acceptEncoding string, encoding string) float64 {
+	quality, found := 0.0, false
+	for rest := acceptEncoding; rest != ""; {
+		var part string
+		part, rest, _ = strings.Cut(rest, ",")
+		name, params, _ := strings.Cut(part, ";")
+		name = strings.TrimSpace(name)
+		if !strings.EqualFold(name, encoding) && (found || name != "*") {
+			continue
+		}
+		q := 1.0
+		if _, value, ok := strings.Cut(params, "q="); ok {
+			if parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+				q = parsed
+			}
+		}
+		if name != "*" {
+			return q
+		}
+		quality, found = q, true
+	}
+	return quality
+}
+
+func (m *Compression) get(encoding string, w io.Writer) (compressor, error) {
+	pool, _ := m.pools.LoadOrStore(encoding, &sync.Pool{})
+	if writer, ok := pool.(*sync.Pool).Get().(compressor); ok {
+		writer.Reset(w)
+		return writer, nil
+	}
+	writer, err := compressors[encoding](m.Config.Level)
+	if err != nil {
+		return nil, err
+	}
+	writer.Reset(w)
+	return writer, nil
+}
+
+func (m *Compression) put(encoding string, writer compressor) {
+	writer.Reset(io.Discard)
+	pool, _ := m.pools.LoadOrStore(encoding, &sync.Pool{})
+	pool.(*sync.Pool).Put(writer)
+}
+
+// compressable tells if a response of the status and the headers is compressed
+func (m *Compression) compressable(status int, header http.Header) bool {
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusPartialContent || status == http.StatusNotModified {
+		return false
+	}
+	if header.Get(echo.HeaderContentEncoding) != "" {
+		return false
+	}
+	contentType := strings.ToLower(header.Get(echo.HeaderContentType))
+	for _, prefix := range m.Config.Types {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// compressionWriter holds a compressable response back until it reaches the minimum size, then compresses it
+type compressionWriter struct {
+	http.ResponseWriter
+	middleware *Compression
+	encoding   string
+	status     int
+	buffer     bytes.Buffer
+	// holding while the response is compressable but smaller than the minimum size
+	holding    bool
+	compressor compressor
+}
+
+func (w *compressionWriter) WriteHeader(status int) {
+	if w.middleware.compressable(status, w.Header()) {
+		w.status, w.holding = status, true
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *compressionWriter) Write(b []byte) (int, error) {
+	if w.compressor != nil {
+		return w.compressor.Write(b)
+	}
+	if !w.holding {
+		return w.ResponseWriter.Write(b)
+	}
+	w.buffer.Write(b)
+	if w.buffer.Len() < w.middleware.Config.MinSize {
+		return len(b), nil
+	}
+	if err := w.compress(); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// compress writes the headers of the encoding and the held back body through the compressor
+func (w *compressionWriter) compress() (err error) {
+	if w.compressor, err = w.middleware.get(w.encoding, w.ResponseWriter); err != nil {
+		return err
+	}
+	w.holding = false
+	w.Header().Set(echo.HeaderContentEncoding, w.encoding)
+	w.Header().Del(echo.HeaderContentLength)
+	w.ResponseWriter.WriteHeader(w.status)
+	_, err = w.compressor.Write(w.buffer.Bytes())
+	w.buffer.Reset()
+	return err
+}
+
+// close writes a response below the minimum size as it is, or ends the compressed one
+func (w *compressionWriter) close() error {
+	if w.holding {
+		w.holding = false
+		w.ResponseWriter.WriteHeader(w.status)
+		_, err := w.ResponseWriter.Write(w.buffer.Bytes())
+		return err
+	}
+	if w.compressor == nil {
+		return nil
+	}
+	err := w.compressor.Close()
+	w.middleware.put(w.encoding, w.compressor)
+	w.compressor = nil
+	return err
+}
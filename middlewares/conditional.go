@@ -1,15 +1,11 @@
 package GMiddleware
 
 import (
-	"fmt"
-
 	"github.com/labstack/echo/v4"
-
-	"gotham/viewModels"
 )
 
 type IConditionalMiddleware interface {
-	control(c echo.Context) *echo.HTTPError
+	control(c echo.Context) error
 }
 
 // Conditional Middlewares
@@ -17,7 +13,7 @@ type IConditionalMiddleware interface {
 func Or(middleware ...IConditionalMiddleware) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			var err *echo.HTTPError
+			var err error
 			for _, m := range middleware {
 				err = m.control(c)
 				if err == nil {
@@ -26,7 +22,7 @@ func Or(middleware ...IConditionalMiddleware) echo.MiddlewareFunc {
 			}
 
 			if err != nil {
-				return c.JSON(err.Code, viewModels.MResponse(fmt.Sprintf("%v", err.Message)))
+				return err
 			}
 
 			return next(c)
@@ -40,7 +36,7 @@ func And(middleware ...IConditionalMiddleware) echo.MiddlewareFunc {
 			for _, m := range middleware {
 				err := m.control(c)
 				if err != nil {
-					return c.JSON(err.Code, viewModels.MResponse(fmt.Sprintf("%v", err.Message)))
+					return err
 				}
 			}
 			return next(c)
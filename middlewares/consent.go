@@ -0,0 +1,35 @@
+package GMiddleware
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"gotham/helpers"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/services"
+)
+
+type Consent struct {
+	ConsentService services.IConsentService
+	// route paths reachable without accepting the current policies
+	Except []string
+}
+
+// ConsentMiddleware rejects users who have not accepted the current version of every policy, it must run after the auth middleware
+func (s Consent) ConsentMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if helpers.InArray(c.Path(), s.Except) {
+			return next(c)
+		}
+
+		auth := models.ConvertUser(c.Get("auth"))
+		pending, err := s.ConsentService.GetPendingPolicies(auth)
+		if err != nil {
+			return echo.ErrInternalServerError
+		}
+		if len(pending) > 0 {
+			return problems.New(problems.PolicyAcceptanceRequired).With("policies", pending)
+		}
+		return next(c)
+	}
+}
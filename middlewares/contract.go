@@ -0,0 +1,164 @@
+package GMiddleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/openapi"
+	"gotham/problems"
+)
+
+// the violations reported in a problem or a log line, the first ones are enough to find the drift
+const contractMaxErrors = 20
+
+// contractUnchecked are the prefixes of the routes outside the api, the static files, the ui and the debug handlers
+var contractUnchecked = []string{"/admin/ui", "/debug", "/doc"}
+
+type Contract struct {
+	Spec *openapi.Spec
+	// Operations are the operations of the spec by route, "GET /v1/restricted/users/:user"
+	Operations map[string]*openapi.Operation
+	Metrics    infrastructures.IMetrics
+	Config     *config.Contract
+}
+
+// ContractMiddleware validates the query and the json body of the requests, the status and the json body of the
+// responses against the swagger spec. The violations are logged and counted, in strict mode the request is refused
+// with a 400 and the response is replaced by a 500 so the drift between the code and its documentation fails loudly.
+// A route missing from the spec is a violation too, refused with a 500 in strict mode. The websockets and the routes
+// outside the api are not checked, a HEAD request is checked against the GET of its route
+func (m Contract) ContractMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		if !m.Config.Enabled || c.Request().Header.Get(echo.HeaderUpgrade) != "" || contractSkipped(c.Path()) {
+			return next(c)
+		}
+		method := c.Request().Method
+		if method == http.MethodHead {
+			method = http.MethodGet
+		}
+		operation, ok := m.Operations[method+" "+c.Path()]
+		if !ok {
+			errs := m.violation(c, "route", []string{"route: is not documented"})
+			if m.Config.Strict {
+				return problems.New(problems.ContractUndocumented).With("errors", errs)
+			}
+			return next(c)
+		}
+
+		body, err := ioutil.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.ErrBadRequest
+		}
+		c.Request().Body = ioutil.NopCloser(bytes.NewReader(body))
+		if errs := m.Spec.ValidateRequest(operation, c.QueryParams(), body, isJSON(c.Request().Header.Get(echo.HeaderContentType))); len(errs) > 0 {
+			errs = m.violation(c, "request", errs)
+			if m.Config.Strict {
+				return problems.New(problems.ContractRequest).With("errors", errs)
+			}
+		}
+
+		writer := &contractWriter{ResponseWriter: c.Response().Writer, status: http.StatusOK}
+		c.Response().Writer = writer
+		defer func() {
+			c.Response().Writer = writer.ResponseWriter
+		}()
+		if err = next(c); err != nil {
+			c.Error(err)
+		}
+
+		if errs := m.validateResponse(operation, writer); len(errs) > 0 {
+			errs = m.violation(c, "response", errs)
+			if m.Config.Strict {
+				return writer.reject(c.Request().URL.Path, errs)
+			}
+		}
+		return writer.flush()
+	}
+}
+
+func (m Contract) validateResponse(operation *openapi.Operation, writer *contractWriter) []string {
+	response, ok := operation.Responses[strconv.Itoa(writer.status)]
+	if !ok {
+		return []string{"status: " + strconv.Itoa(writer.status) + " is not documented"}
+	}
+	if response.Schema == nil || writer.buffer.Len() == 0 || !isJSON(writer.Header().Get(echo.HeaderContentType)) {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(writer.buffer.Bytes(), &value); err != nil {
+		return []string{"body: invalid json"}
+	}
+	return m.Spec.Validate(response.Schema, value, "body")
+}
+
+func (m Contract) violation(c echo.Context, kind string, errs []string) []string {
+	if len(errs) > contractMaxErrors {
+		errs = errs[:contractMaxErrors]
+	}
+	m.Metrics.Inc("http_contract_violations_total", map[string]string{"method": c.Request().Method, "route": c.Path(), "kind": kind}, 1)
+	c.Logger().Warnf("contract %v violated on %v %v: %v", kind, c.Request().Method, c.Path(), strings.Join(errs, "; "))
+	return errs
+}
+
+// contractSkipped is true for the routes outside the api and the wildcards, the not found handlers of echo
+func contractSkipped(path string) bool {
+	if path == "" || strings.HasSuffix(path, "*") {
+		return true
+	}
+	for _, prefix := range contractUnchecked {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isJSON is true for application/json and the +json types, the problems
+func isJSON(contentType string) bool {
+	mediaType := strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0])
+	return mediaType == echo.MIMEApplicationJSON || strings.HasSuffix(mediaType, "+json")
+}
+
+// contractWriter holds the response until it is validated
+type contractWriter struct {
+	http.ResponseWriter
+	buffer bytes.Buffer
+	status int
+}
+
+func (w *contractWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *contractWriter) Write(b []byte) (int, error) {
+	return w.buffer.Write(b)
+}
+
+func (w *contractWriter) flush() error {
+	w.ResponseWriter.WriteHeader(w.status)
+	_, err := w.ResponseWriter.Write(w.buffer.Bytes())
+	return err
+}
+
+func (w *contractWriter) reject(instance string, errs []string) error {
+	p := problems.New(problems.ContractResponse).With("errors", errs)
+	p.Instance = instance
+	body, err := p.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	header := w.ResponseWriter.Header()
+	header.Set(echo.HeaderContentType, problems.ContentType)
+	header.Set(echo.HeaderContentLength, strconv.Itoa(len(body)))
+	w.ResponseWriter.WriteHeader(p.Status)
+	_, err = w.ResponseWriter.Write(body)
+	return err
+}
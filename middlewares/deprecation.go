@@ -0,0 +1,71 @@
+package GMiddleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/services"
+)
+
+type Deprecation struct {
+	DeprecationService services.IDeprecationService
+}
+
+// Deprecate marks the route deprecated since the day, removed on the sunset day ("" when not planned yet), with a link
+// to the documentation of the deprecation or to the route replacing it. The days are 2006-01-02, a mistyped one stops
+// the start
+func (d Deprecation) Deprecate(route *echo.Route, since string, sunset string, link string) *echo.Route {
+	deprecation := services.Deprecation{Method: route.Method, Path: route.Path, Since: mustParseDay(since), Link: link}
+	if sunset != "" {
+		day := mustParseDay(sunset)
+		deprecation.Sunset = &day
+	}
+	d.DeprecationService.Register(deprecation)
+	return route
+}
+
+// DeprecationMiddleware runs after the routing, the route of the request is known. The calls to a deprecated route get
+// the Deprecation, Sunset and Link headers, and are counted by caller once the group middlewares set it
+func (d Deprecation) DeprecationMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		deprecation, ok := d.DeprecationService.Get(c.Request().Method, c.Path())
+		if !ok {
+			return next(c)
+		}
+		header := c.Response().Header()
+		header.Set("Deprecation", "@"+strconv.FormatInt(deprecation.Since.Unix(), 10))
+		if deprecation.Sunset != nil {
+			header.Set("Sunset", deprecation.Sunset.UTC().Format(http.TimeFormat))
+		}
+		if deprecation.Link != "" {
+			header.Add("Link", "<"+deprecation.Link+`>; rel="deprecation"`)
+		}
+
+		err := next(c)
+		d.DeprecationService.Record(deprecation, deprecatedCaller(c))
+		return err
+	}
+}
+
+// deprecatedCaller is the machine or the user calling, "anonymous" on the public routes
+func deprecatedCaller(c echo.Context) string {
+	if caller := machineCaller(c); caller != "" {
+		return caller
+	}
+	if auth, ok := c.Get("auth").(models.User); ok {
+		return "user:" + strconv.FormatUint(uint64(auth.ID), 10)
+	}
+	return "anonymous"
+}
+
+func mustParseDay(day string) time.Time {
+	t, err := time.Parse("2006-01-02", day)
+	if err != nil {
+		panic("deprecation: invalid day " + day)
+	}
+	return t
+}
@@ -0,0 +1,56 @@
+package GMiddleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/infrastructures"
+	"gotham/problems"
+)
+
+// HeaderDryRun asks for a dry run of a mutating request, it is echoed on the response of a dry run
+const HeaderDryRun = "X-Dry-Run"
+
+/**
+ * DryRun
+ * the transaction middleware: a mutating request with X-Dry-Run: true runs its validations and its writes in the
+ * transactions of the unit of work, which are rolled back, and answers what would have happened. Only the routes
+ * allowed run dry, their writes all go through the unit of work and they skip their events and mails, the others
+ * refuse the header so a preview never changes anything
+ */
+type DryRun struct {
+	routes map[string]bool
+}
+
+func NewDryRun() *DryRun {
+	return &DryRun{routes: map[string]bool{}}
+}
+
+// Allow lets the route run dry, it is called with the routes being registered
+func (d *DryRun) Allow(route *echo.Route) *echo.Route {
+	d.routes[route.Method+" "+route.Path] = true
+	return route
+}
+
+// DryRunMiddleware runs after the routing, the route of the request is known
+func (d *DryRun) DryRunMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		request := c.Request()
+		if dryRun, _ := strconv.ParseBool(request.Header.Get(HeaderDryRun)); !dryRun || isSafeMethod(request.Method) {
+			return next(c)
+		}
+		if !d.routes[request.Method+" "+c.Path()] {
+			return problems.New(problems.DryRunUnsupported)
+		}
+		c.SetRequest(request.WithContext(infrastructures.WithDryRun(request.Context())))
+		c.Response().Header().Set(HeaderDryRun, "true")
+		return next(c)
+	}
+}
+
+// isSafeMethod reads without changing anything, there is nothing to roll back
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
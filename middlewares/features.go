@@ -0,0 +1,105 @@
+package GMiddleware
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+)
+
+const FeatureOverridesHeader = "X-Feature-Overrides"
+
+// featuresKey is where the flags of the request are stored, read them with FeatureFlagsFrom
+const featuresKey = "features"
+
+// noFeatures are the flags of a request the features middleware did not run for, all off
+var noFeatures = infrastructures.NewFeatureFlags(&config.Features{})
+
+type Features struct {
+	FeatureFlags infrastructures.IFeatureFlags
+	Config       *config.Features
+}
+
+// FeaturesMiddleware stores the flags of the request, read with FeatureFlagsFrom, admins may override them with
+// X-Feature-Overrides: new-search=on,legacy-index=off
+// it must run after the auth middleware
+func (f Features) FeaturesMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		header := c.Request().Header.Get(FeatureOverridesHeader)
+		if header == "" {
+			c.Set(featuresKey, f.FeatureFlags)
+			return next(c)
+		}
+
+		auth := models.ConvertUser(c.Get("auth"))
+		if !f.Config.OverridesEnabled || !auth.IsAdmin() {
+			return problems.New(problems.FeatureOverrideForbidden)
+		}
+
+		overrides, errs := f.parse(header)
+		if len(errs) > 0 {
+			return problems.New(problems.FeatureOverrideInvalid).With("errors", map[string]interface{}{
+				"features": errs,
+			})
+		}
+
+		c.Logger().Infof("feature overrides %v by user %v on %v %v", overrides, auth.ID, c.Request().Method, c.Request().URL.Path)
+		c.Set(featuresKey, &infrastructures.OverriddenFeatureFlags{
+			Base:      f.FeatureFlags,
+			Overrides: overrides,
+		})
+		return next(c)
+	}
+}
+
+// FeatureFlagsFrom returns the flags of the request, with the overrides of the admin
+func FeatureFlagsFrom(c echo.Context) infrastructures.IFeatureFlags {
+	return infrastructures.ConvertFeatureFlags(c.Get(featuresKey), noFeatures)
+}
+
+// RequireFeature answers a 404 while the feature is off for the request, the route does not exist until it is released.
+// It runs after the features middleware
+func RequireFeature(name string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !FeatureFlagsFrom(c).Enabled(name) {
+				return echo.ErrNotFound
+			}
+			return next(c)
+		}
+	}
+}
+
+func (f Features) parse(header string) (overrides map[string]bool, errs []string) {
+	overrides = map[string]bool{}
+	for _, item := range strings.Split(header, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.SplitN(item, "=", 2)
+		name := strings.TrimSpace(parts[0])
+		if !f.FeatureFlags.Exists(name) {
+			errs = append(errs, fmt.Sprintf("unknown feature %v", name))
+			continue
+		}
+		state := "on"
+		if len(parts) == 2 {
+			state = strings.ToLower(strings.TrimSpace(parts[1]))
+		}
+		switch state {
+		case "on", "true", "1":
+			overrides[name] = true
+		case "off", "false", "0":
+			overrides[name] = false
+		default:
+			errs = append(errs, fmt.Sprintf("invalid state %v for feature %v", state, name))
+		}
+	}
+	return overrides, errs
+}
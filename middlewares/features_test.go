@@ -0,0 +1,69 @@
+package GMiddleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+)
+
+// features serves /beta behind the beta flag, as the user
+func features(flags map[string]bool, user models.User) *echo.Echo {
+	featuresConfig := &config.Features{Flags: flags, OverridesEnabled: true}
+	middleware := Features{FeatureFlags: infrastructures.NewFeatureFlags(featuresConfig), Config: featuresConfig}
+	auth := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("auth", user)
+			return next(c)
+		}
+	}
+
+	e := echo.New()
+	e.HTTPErrorHandler = problems.HTTPErrorHandler
+	e.GET("/beta", func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	}, auth, middleware.FeaturesMiddleware, RequireFeature("beta"))
+	return e
+}
+
+func TestRequireFeature(t *testing.T) {
+	admin := models.User{Admin: true}
+	tests := []struct {
+		name      string
+		enabled   bool
+		user      models.User
+		overrides string
+		status    int
+	}{
+		{"off", false, admin, "", http.StatusNotFound},
+		{"on", true, admin, "", http.StatusNoContent},
+		{"turned on by the admin", false, admin, "beta=on", http.StatusNoContent},
+		{"turned off by the admin", true, admin, "beta=off", http.StatusNotFound},
+		{"turned on by a user", false, models.User{}, "beta=on", problems.FeatureOverrideForbidden.Status},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/beta", nil)
+			if test.overrides != "" {
+				request.Header.Set(FeatureOverridesHeader, test.overrides)
+			}
+			recorder := httptest.NewRecorder()
+			features(map[string]bool{"beta": test.enabled}, test.user).ServeHTTP(recorder, request)
+			if recorder.Code != test.status {
+				t.Fatalf("status %v, expected %v", recorder.Code, test.status)
+			}
+		})
+	}
+
+	// without the features middleware every feature is off
+	e := echo.New()
+	if FeatureFlagsFrom(e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())).Enabled("beta") {
+		t.Fatal("a feature is on without the features middleware")
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/labstack/echo/v4"
 	"gorm.io/gorm"
 	"gotham/config"
+	"gotham/policies"
+	"gotham/problems"
 	"gotham/services"
 )
 
@@ -14,14 +16,18 @@ type IsAdmin struct {
 	UserService services.IUserService
 }
 
-func (i IsAdmin) control(c echo.Context) *echo.HTTPError {
+func (i IsAdmin) control(c echo.Context) error {
+	// an admin acts as one with a token of the admin scope only
+	if err := requireScope(c, policies.ScopeAdmin); err != nil {
+		return err
+	}
 	u := c.Get("user").(*jwt.Token)
 	claims := u.Claims.(*config.JwtCustomClaims)
 
 	user, err := i.UserService.GetUserByID(claims.AuthID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return echo.NewHTTPError(404, "user could not be found")
+			return problems.New(problems.UserNotFound)
 		}
 		return echo.ErrInternalServerError
 	}
@@ -30,5 +36,5 @@ func (i IsAdmin) control(c echo.Context) *echo.HTTPError {
 		return nil
 	}
 
-	return echo.NewHTTPError(403, "you are not admin")
+	return problems.New(problems.NotAdmin)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"gorm.io/gorm"
 	"gotham/config"
+	"gotham/problems"
 	"gotham/services"
 )
 
@@ -14,14 +15,14 @@ type IsVerified struct {
 	UserService services.IUserService
 }
 
-func (i IsVerified) control(c echo.Context) *echo.HTTPError {
+func (i IsVerified) control(c echo.Context) error {
 	u := c.Get("user").(*jwt.Token)
 	claims := u.Claims.(*config.JwtCustomClaims)
 
 	user, err := i.UserService.GetUserByID(claims.AuthID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return echo.NewHTTPError(404, "user could not be found")
+			return problems.New(problems.UserNotFound)
 		}
 		return echo.ErrInternalServerError
 	}
@@ -30,5 +31,5 @@ func (i IsVerified) control(c echo.Context) *echo.HTTPError {
 		return nil
 	}
 
-	return echo.NewHTTPError(403, "your email not verified")
+	return problems.New(problems.NotVerified)
 }
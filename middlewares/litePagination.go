@@ -0,0 +1,17 @@
+package GMiddleware
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"gotham/utils"
+)
+
+// LitePagination makes the pages of a route over a large table lite by default, its records are not counted unless lite=false
+type LitePagination struct{}
+
+func (l LitePagination) LitePaginationMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Set(utils.LitePaginationKey, true)
+		return next(c)
+	}
+}
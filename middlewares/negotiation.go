@@ -0,0 +1,86 @@
+package GMiddleware
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/viewModels"
+)
+
+// Negotiation writes the json responses in the format of the Accept header of the request, see viewModels.Serializers.
+// It runs before the other middlewares rewriting json bodies so they still get json, problems stay application/problem+json.
+type Negotiation struct{}
+
+func (n Negotiation) NegotiationMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+		serializer := viewModels.Negotiate(c.Request().Header.Get(echo.HeaderAccept))
+		// a websocket takes the connection over, the writer could not hijack it
+		if serializer.Serialize == nil || c.Request().Header.Get(echo.HeaderUpgrade) != "" {
+			return next(c)
+		}
+
+		writer := &negotiationWriter{ResponseWriter: c.Response().Writer, serializer: serializer}
+		c.Response().Writer = writer
+		defer func() {
+			c.Response().Writer = writer.ResponseWriter
+		}()
+
+		// a response written before an error is still serialized
+		err := next(c)
+		if flushErr := writer.flush(); err == nil {
+			err = flushErr
+		}
+		return err
+	}
+}
+
+// negotiationWriter holds a json response back until it is serialized, other responses are written through
+type negotiationWriter struct {
+	http.ResponseWriter
+	serializer viewModels.Serializer
+	body       bytes.Buffer
+	status     int
+	holding    bool
+}
+
+func (w *negotiationWriter) WriteHeader(status int) {
+	if strings.HasPrefix(w.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		w.status, w.holding = status, true
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *negotiationWriter) Write(b []byte) (int, error) {
+	if w.holding {
+		return w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *negotiationWriter) flush() error {
+	if !w.holding {
+		return nil
+	}
+	w.holding = false
+	body := w.body.Bytes()
+	if w.body.Len() > 0 {
+		serialized, err := w.serializer.Serialize(body)
+		if err != nil {
+			// a body that is not a json document goes out as it is
+			w.ResponseWriter.WriteHeader(w.status)
+			_, err = w.ResponseWriter.Write(body)
+			return err
+		}
+		body = serialized
+	}
+	w.Header().Set(echo.HeaderContentType, w.serializer.ContentType)
+	w.Header().Del(echo.HeaderContentLength)
+	w.ResponseWriter.WriteHeader(w.status)
+	_, err := w.ResponseWriter.Write(body)
+	return err
+}
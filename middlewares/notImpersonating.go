@@ -0,0 +1,17 @@
+package GMiddleware
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"gotham/problems"
+)
+
+// NotImpersonating keeps the actions only the account owner may take out of reach of impersonation tokens
+type NotImpersonating struct{}
+
+func (n NotImpersonating) control(c echo.Context) error {
+	if c.Get("impersonator") != nil {
+		return problems.New(problems.Impersonating)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package GMiddleware
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"gotham/services"
+	"gotham/utils"
+)
+
+type PageSizes struct {
+	SettingService services.ISettingService
+}
+
+// PageSizesMiddleware gives the page sizes of the settings to requests.BindQuery, they are read when a page is parsed
+func (p PageSizes) PageSizesMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Set(utils.PageSizesKey, func() utils.PageSizes {
+			return utils.PageSizes{
+				Default: p.SettingService.Int("default_page_size", utils.DefaultPageSizes.Default),
+				Max:     p.SettingService.Int("max_page_size", utils.DefaultPageSizes.Max),
+			}
+		})
+		return next(c)
+	}
+}
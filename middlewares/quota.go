@@ -0,0 +1,55 @@
+package GMiddleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/services"
+)
+
+type Quota struct {
+	QuotaService services.IQuotaService
+}
+
+// QuotaMiddleware enforces the daily and monthly request quotas of the auth user, the tightest window is reported in
+// the X-RateLimit headers. Requests of an admin impersonating the user are not counted.
+func (q Quota) QuotaMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if c.Get("impersonator") != nil {
+			return next(c)
+		}
+
+		auth := models.ConvertUser(c.Get("auth"))
+		now := time.Now()
+		status, err := q.QuotaService.Check(auth, now)
+		if err != nil {
+			return echo.ErrInternalServerError
+		}
+		if status.Limit == 0 {
+			return q.consume(c, next, auth, now)
+		}
+
+		header := c.Response().Header()
+		header.Set("X-RateLimit-Limit", strconv.Itoa(status.Limit))
+		header.Set("X-RateLimit-Reset", strconv.FormatInt(status.Reset.Unix(), 10))
+		header.Set("X-RateLimit-Window", status.Window)
+		if status.Exceeded() {
+			header.Set("X-RateLimit-Remaining", "0")
+			header.Set("Retry-After", strconv.FormatInt(int64(time.Until(status.Reset).Seconds())+1, 10))
+			return problems.New(problems.QuotaExceeded).With("window", status.Window).With("reset", status.Reset)
+		}
+		header.Set("X-RateLimit-Remaining", strconv.Itoa(status.Remaining-1))
+		return q.consume(c, next, auth, now)
+	}
+}
+
+func (q Quota) consume(c echo.Context, next echo.HandlerFunc, auth models.User, now time.Time) error {
+	if err := q.QuotaService.Consume(auth, now); err != nil {
+		return echo.ErrInternalServerError
+	}
+	return next(c)
+}
@@ -0,0 +1,150 @@
+package GMiddleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/testkit"
+)
+
+// headers never written to the corpus
+var recorderScrubbed = []string{"Authorization", "Cookie", "X-Api-Key"}
+
+// the routes of the passwords, the tokens, the codes and the signed links, never written to the corpus whatever their body
+var recorderSkipped = []string{
+	"/v1/login",
+	"/v1/register",
+	"/v1/auth/",
+	"/v1/oauth/",
+	"/v1/email-changes/",
+	"/v1/billing/webhook",
+	"/v1/billing/invoices/",
+	"/v1/restricted/auth/",
+	"/v1/restricted/users/me/password",
+	"/v1/restricted/users/me/email",
+	"/v1/restricted/users/me/phone",
+	"/v1/restricted/users/me/api-keys",
+	"/v1/restricted/admin/clients",
+}
+
+// Recorder appends every request it sees to a testkit corpus file that can later be replayed by cmd/canary. The
+// personal and secret values of the bodies and the queries are redacted, the file is only readable by its owner
+type Recorder struct {
+	Config *config.Recorder
+	file   *os.File
+	mu     sync.Mutex
+}
+
+func NewRecorder(recorderConfig *config.Recorder) *Recorder {
+	return &Recorder{Config: recorderConfig}
+}
+
+func (r *Recorder) RecorderMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !r.Config.Enabled || recorderSkips(c.Path()) {
+			return next(c)
+		}
+
+		request := c.Request()
+		var body []byte
+		if request.Body != nil {
+			// one byte more than the limit tells a larger body, the handler still reads it whole
+			body, _ = ioutil.ReadAll(io.LimitReader(request.Body, r.Config.MaxBodySize+1))
+			request.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body), request.Body))
+			if int64(len(body)) > r.Config.MaxBodySize {
+				return next(c)
+			}
+		}
+		recorded, ok := recorderBody(request.Header.Get(echo.HeaderContentType), body)
+		if !ok {
+			return next(c)
+		}
+
+		header := request.Header.Clone()
+		for _, key := range recorderScrubbed {
+			header.Del(key)
+		}
+		uri := request.URL.Path
+		if request.URL.RawQuery != "" {
+			uri += "?" + helpers.RedactQuery(request.URL.RawQuery)
+		}
+
+		r.write(testkit.Entry{
+			Method: request.Method,
+			Uri:    uri,
+			Header: header,
+			Body:   recorded,
+		})
+		return next(c)
+	}
+}
+
+// Close closes the corpus file
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+func (r *Recorder) write(entry testkit.Entry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		if err = os.MkdirAll(filepath.Dir(r.Config.Path), 0700); err != nil {
+			return
+		}
+		if r.file, err = os.OpenFile(r.Config.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600); err != nil {
+			r.file = nil
+			return
+		}
+	}
+	_, _ = r.file.Write(append(line, '\n'))
+}
+
+func recorderSkips(path string) bool {
+	for _, skipped := range recorderSkipped {
+		if path == skipped || strings.HasSuffix(skipped, "/") && strings.HasPrefix(path, skipped) {
+			return true
+		}
+	}
+	return false
+}
+
+// recorderBody redacts the json and the form bodies, false for the other ones which can not be
+func recorderBody(contentType string, body []byte) (string, bool) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return "", true
+	}
+	switch {
+	case strings.HasPrefix(contentType, echo.MIMEApplicationJSON):
+		var value interface{}
+		if err := json.Unmarshal(body, &value); err != nil {
+			return "", false
+		}
+		redacted, err := json.Marshal(helpers.RedactPII(value))
+		return string(redacted), err == nil
+	case strings.HasPrefix(contentType, echo.MIMEApplicationForm):
+		return helpers.RedactQuery(string(body)), true
+	}
+	return "", false
+}
@@ -0,0 +1,80 @@
+package GMiddleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/problems"
+)
+
+type Recovery struct {
+	Reporter infrastructures.IErrorReporter
+	Config   *config.ErrorReporting
+}
+
+/**
+ * RecoveryMiddleware
+ * a panic of the next handlers is answered with the 500 problem+json and reported with its stack, the server keeps
+ * serving. An http.ErrAbortHandler panic still aborts the response. The report scope of the request is opened here,
+ * the errors reported with the request context are enriched with it
+ */
+func (r Recovery) RecoveryMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		scope := &infrastructures.ReportScope{
+			Method:    c.Request().Method,
+			URL:       c.Request().RequestURI,
+			Route:     c.Path(),
+			IP:        c.RealIP(),
+			RequestID: c.Response().Header().Get(echo.HeaderXRequestID),
+		}
+		c.SetRequest(c.Request().WithContext(infrastructures.WithReportScope(c.Request().Context(), scope)))
+
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+			panicErr, ok := recovered.(error)
+			if !ok {
+				panicErr = fmt.Errorf("%v", recovered)
+			}
+			stack := panicStack(infrastructures.CaptureStack(0))
+
+			r.Reporter.Report(c.Request().Context(), infrastructures.ErrorReport{
+				Error: panicErr,
+				Panic: true,
+				Stack: stack,
+				Time:  time.Now(),
+			})
+
+			p := problems.New(problems.Internal)
+			if r.Config.ExposeStack {
+				lines := make([]string, 0, len(stack))
+				for _, frame := range stack {
+					lines = append(lines, frame.String())
+				}
+				p.With("panic", panicErr.Error()).With("stack", lines)
+			}
+			err = p
+		}()
+		return next(c)
+	}
+}
+
+// panicStack drops the calls of the recovery up to the panic, the stack starts at the function that panicked
+func panicStack(stack []infrastructures.StackFrame) []infrastructures.StackFrame {
+	for i, frame := range stack {
+		if frame.Function == "runtime.gopanic" {
+			return stack[i+1:]
+		}
+	}
+	return stack
+}
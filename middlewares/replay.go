@@ -0,0 +1,74 @@
+package GMiddleware
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/signing"
+)
+
+// the headers of a request protected from replays
+const (
+	HeaderRequestNonce     = "X-Request-Nonce"
+	HeaderRequestTimestamp = "X-Request-Timestamp"
+)
+
+// the length of a nonce, a short one could be guessed by another caller
+const (
+	nonceMinLength = 16
+	nonceMaxLength = 128
+)
+
+type Replay struct {
+	Cache  infrastructures.ICache
+	Clock  infrastructures.IClock
+	Config *config.Replay
+}
+
+// ReplayMiddleware accepts a request of a machine, a client token or an api key, once: it carries a unique nonce and
+// its unix timestamp within REPLAY_TOLERANCE of now, the nonces are kept in the cache per caller past the tolerance.
+// The requests of the users go through, it runs after the middleware setting the client or the api key
+func (r Replay) ReplayMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		caller := machineCaller(c)
+		if !r.Config.Enabled || caller == "" {
+			return next(c)
+		}
+		nonce := c.Request().Header.Get(HeaderRequestNonce)
+		timestamp := c.Request().Header.Get(HeaderRequestTimestamp)
+		if len(nonce) < nonceMinLength || len(nonce) > nonceMaxLength || timestamp == "" {
+			return problems.New(problems.NonceRequired, fmt.Sprintf("the request needs a nonce of %d to %d characters and a timestamp", nonceMinLength, nonceMaxLength))
+		}
+		if err := signing.CheckTimestamp(timestamp, r.Config.Tolerance, r.Clock.Now()); errors.Is(err, signing.ErrExpired) {
+			return problems.New(problems.NonceExpired)
+		}
+
+		// past the tolerance on both sides of now the timestamp is refused anyway
+		fresh, err := r.Cache.Add(c.Request().Context(), "replay:nonce:"+caller+":"+nonce, []byte{1}, 2*r.Config.Tolerance)
+		if err != nil {
+			return echo.ErrInternalServerError
+		}
+		if !fresh {
+			return problems.New(problems.NonceReplayed)
+		}
+		return next(c)
+	}
+}
+
+// machineCaller is the machine sending the request, a client or an api key, "" for the users
+func machineCaller(c echo.Context) string {
+	if client, ok := c.Get("client").(models.Client); ok {
+		return "client:" + strconv.FormatUint(uint64(client.ID), 10)
+	}
+	if apiKey, ok := c.Get("api_key").(models.ApiKey); ok {
+		return "key:" + strconv.FormatUint(uint64(apiKey.ID), 10)
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+package GMiddleware
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/services"
+)
+
+type Billing struct {
+	BillingService services.IBillingService
+}
+
+// RequirePlan keeps a route to the users whose subscription includes the plan, like
+// GMiddleware.And(billing.RequirePlan("pro")), it must run after the auth middleware
+func (b Billing) RequirePlan(plan string) RequirePlan {
+	return RequirePlan{BillingService: b.BillingService, Plan: plan}
+}
+
+type RequirePlan struct {
+	BillingService services.IBillingService
+	Plan           string
+}
+
+func (r RequirePlan) control(c echo.Context) error {
+	auth := models.ConvertUser(c.Get("auth"))
+	ok, err := r.BillingService.HasPlan(auth.ID, r.Plan)
+	if err != nil {
+		return echo.ErrInternalServerError
+	}
+	if !ok {
+		return problems.New(problems.PlanRequired).With("plan", r.Plan)
+	}
+	return nil
+}
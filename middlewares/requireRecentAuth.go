@@ -0,0 +1,30 @@
+package GMiddleware
+
+import (
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/problems"
+)
+
+// RequireRecentAuth keeps sensitive operations to tokens whose password was confirmed within MaxAge,
+// POST /v1/restricted/auth/sudo confirms it again
+type RequireRecentAuth struct {
+	MaxAge time.Duration
+	// Clock is the one the tokens are stamped with
+	Clock infrastructures.IClock
+}
+
+func (r RequireRecentAuth) control(c echo.Context) error {
+	u := c.Get("user").(*jwt.Token)
+	claims := u.Claims.(*config.JwtCustomClaims)
+
+	if claims.ImpersonatorID == 0 && claims.AuthAt != 0 && r.Clock.Now().Sub(time.Unix(claims.AuthAt, 0)) <= r.MaxAge {
+		return nil
+	}
+	return problems.New(problems.SudoRequired).With("max_age", int(r.MaxAge.Seconds()))
+}
@@ -0,0 +1,79 @@
+package GMiddleware
+
+import (
+	"fmt"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/policies"
+	"gotham/problems"
+)
+
+// RequireScope keeps the route to the tokens having the scope, like users:write, see policies.Scopes
+type RequireScope struct {
+	Scope string
+}
+
+func (r RequireScope) control(c echo.Context) error {
+	err := requireScope(c, r.Scope)
+	if err != nil {
+		challengeScope(c, r.Scope)
+	}
+	return err
+}
+
+/**
+ * Scopes
+ * the scopes of the routes of a group: every route declares the scope a token needs with Require, the scoped tokens
+ * (api keys, clients, sign ins) are refused the routes declaring none. A route added without a scope is closed to
+ * the api keys until its scope is thought of, instead of being open to every one of them
+ */
+type Scopes struct {
+	prefix string
+	routes map[string]string
+}
+
+// NewScopes are the scopes of the routes of the group of the prefix
+func NewScopes(prefix string) *Scopes {
+	return &Scopes{prefix: prefix, routes: map[string]string{}}
+}
+
+// Require keeps the route to the tokens having the scope, it is called with the routes being registered
+func (s *Scopes) Require(scope string, route *echo.Route) *echo.Route {
+	s.routes[route.Method+" "+route.Path] = scope
+	return route
+}
+
+// ScopesMiddleware runs after the authentication, the route of the request and its token are known
+func (s *Scopes) ScopesMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		// the routes echo adds to the group for its unknown paths answer a 404 to everyone
+		if c.Path() == s.prefix || c.Path() == s.prefix+"/*" {
+			return next(c)
+		}
+		scope := s.routes[c.Request().Method+" "+c.Path()]
+		if err := requireScope(c, scope); err != nil {
+			challengeScope(c, scope)
+			return err
+		}
+		return next(c)
+	}
+}
+
+// requireScope refuses the token without the scope, a route without a scope is refused to every scoped token
+func requireScope(c echo.Context, scope string) error {
+	u := c.Get("user").(*jwt.Token)
+	claims := u.Claims.(*config.JwtCustomClaims)
+
+	if claims.Scopes == nil || scope != "" && policies.Scoped(claims.Scopes, scope) {
+		return nil
+	}
+	return problems.New(problems.InsufficientScope).With("scope", scope)
+}
+
+// challengeScope tells the client the scope the route needs
+func challengeScope(c echo.Context, scope string) {
+	c.Response().Header().Set(echo.HeaderWWWAuthenticate, fmt.Sprintf(`Bearer error="insufficient_scope", scope=%q`, scope))
+}
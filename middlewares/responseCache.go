@@ -0,0 +1,106 @@
+package GMiddleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type ResponseCache struct {
+	Cache  infrastructures.ICache
+	Config *config.Cache
+}
+
+type cachedResponse struct {
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// CacheMiddleware serves successful GET responses from the cache, keyed by route, user and query. The entries are tagged so
+// the services changing the underlying data can invalidate them. A request with Cache-Control: no-cache skips it.
+func (r ResponseCache) CacheMiddleware(tags ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) (err error) {
+			route := c.Request().Method + " " + c.Path()
+			ttl, ok := r.Config.ResponseRoutes[route]
+			if !ok {
+				ttl = r.Config.ResponseTTL
+			}
+			if c.Request().Method != http.MethodGet || ttl == 0 || strings.Contains(c.Request().Header.Get("Cache-Control"), "no-cache") {
+				return next(c)
+			}
+
+			ctx := c.Request().Context()
+			key := r.key(c, route)
+			if data, found, err := r.Cache.Get(ctx, key); err == nil && found {
+				var cached cachedResponse
+				if json.Unmarshal(data, &cached) == nil {
+					c.Response().Header().Set("X-Cache", "HIT")
+					return c.Blob(cached.Status, cached.ContentType, cached.Body)
+				}
+			}
+
+			writer := &cacheWriter{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = writer
+			c.Response().Header().Set("X-Cache", "MISS")
+			defer func() {
+				c.Response().Writer = writer.ResponseWriter
+			}()
+
+			if err = next(c); err != nil {
+				return err
+			}
+			if c.Response().Status != http.StatusOK {
+				return nil
+			}
+
+			data, err := json.Marshal(cachedResponse{
+				Status:      c.Response().Status,
+				ContentType: c.Response().Header().Get(echo.HeaderContentType),
+				Body:        writer.body.Bytes(),
+			})
+			if err == nil {
+				err = r.Cache.Set(ctx, key, data, ttl)
+			}
+			for _, tag := range tags {
+				if err == nil {
+					err = r.Cache.Tag(ctx, tag, key)
+				}
+			}
+			if err != nil {
+				c.Logger().Warnf("response cache: storing %v failed: %v", route, err)
+			}
+			return nil
+		}
+	}
+}
+
+func (r ResponseCache) key(c echo.Context, route string) string {
+	user := "guest"
+	if auth, ok := c.Get("auth").(models.User); ok {
+		user = fmt.Sprint(auth.ID)
+	}
+	// url.Values.Encode sorts the parameters, the same query in another order hits the same entry
+	return "response:" + helpers.MD5Hash(route+"|"+user+"|"+c.QueryParams().Encode())
+}
+
+// cacheWriter keeps a copy of the body written through it
+type cacheWriter struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *cacheWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,104 @@
+package GMiddleware
+
+import (
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+)
+
+// maximum number of mirrored requests in flight, extra samples are dropped
+const shadowConcurrency = 32
+
+// the routes of the single use and the signed links, their token would be spent or leaked by the mirror
+var shadowSkipped = []string{"/v1/auth/magic-link/callback", "/v1/email-changes/confirm", "/v1/billing/invoices/:invoice/download"}
+
+type Shadow struct {
+	HttpClientFactory infrastructures.IHttpClientFactory
+	Config            *config.Shadow
+	slots             chan struct{}
+}
+
+func NewShadow(factory infrastructures.IHttpClientFactory, shadowConfig *config.Shadow) Shadow {
+	return Shadow{
+		HttpClientFactory: factory,
+		Config:            shadowConfig,
+		slots:             make(chan struct{}, shadowConcurrency),
+	}
+}
+
+// ShadowMiddleware mirrors a sample of read requests to the staging url without waiting for it
+func (s Shadow) ShadowMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if s.sampled(c.Request(), c.Path()) {
+			select {
+			case s.slots <- struct{}{}:
+				go func(r *http.Request) {
+					defer func() { <-s.slots }()
+					s.mirror(r)
+				}(s.clone(c.Request()))
+			default:
+			}
+		}
+		return next(c)
+	}
+}
+
+func (s Shadow) sampled(r *http.Request, path string) bool {
+	if !s.Config.Enabled || s.Config.Url == "" || helpers.InArray(path, shadowSkipped) {
+		return false
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	return rand.Float64() < s.Config.SampleRate
+}
+
+// clone copies what the mirror needs before the original request is recycled by echo, the tokens and the personal
+// values of the query are redacted like the scrubbed headers
+func (s Shadow) clone(r *http.Request) *http.Request {
+	header := r.Header.Clone()
+	for key := range header {
+		if helpers.InArray(http.CanonicalHeaderKey(key), s.scrubbed()) {
+			header.Del(key)
+		}
+	}
+	header.Set("X-Shadow-Request", "1")
+	uri := r.URL.EscapedPath()
+	if r.URL.RawQuery != "" {
+		uri += "?" + helpers.RedactQuery(r.URL.RawQuery)
+	}
+	return &http.Request{
+		Method:     r.Method,
+		RequestURI: uri,
+		Header:     header,
+	}
+}
+
+func (s Shadow) scrubbed() []string {
+	headers := make([]string, 0, len(s.Config.ScrubHeaders))
+	for _, header := range s.Config.ScrubHeaders {
+		headers = append(headers, http.CanonicalHeaderKey(header))
+	}
+	return headers
+}
+
+func (s Shadow) mirror(r *http.Request) {
+	request, err := http.NewRequest(r.Method, s.Config.Url+r.RequestURI, nil)
+	if err != nil {
+		return
+	}
+	request.Header = r.Header
+	response, err := s.HttpClientFactory.Make("shadow").Do(request)
+	if err != nil {
+		return
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+}
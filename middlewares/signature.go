@@ -0,0 +1,42 @@
+package GMiddleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/problems"
+	"gotham/signing"
+)
+
+type Signature struct {
+	Verifier    signing.Verifier
+	MaxBodySize int64
+}
+
+// SignatureMiddleware accepts the requests of the partners signed with their secret, see signing.Verifier. The key of
+// the partner is set as "partner" for the handlers.
+func (s Signature) SignatureMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		body, err := ioutil.ReadAll(io.LimitReader(c.Request().Body, s.MaxBodySize))
+		if err != nil {
+			return echo.ErrBadRequest
+		}
+		c.Request().Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		partner, err := s.Verifier.Verify(c.Request(), body)
+		switch {
+		case errors.Is(err, signing.ErrReplayed):
+			return problems.New(problems.SignatureReplayed)
+		case errors.Is(err, signing.ErrUnsigned), errors.Is(err, signing.ErrUnknownKey), errors.Is(err, signing.ErrExpired), errors.Is(err, signing.ErrInvalid):
+			return problems.New(problems.SignatureInvalid, err.Error())
+		case err != nil:
+			return echo.ErrInternalServerError
+		}
+		c.Set("partner", partner)
+		return next(c)
+	}
+}
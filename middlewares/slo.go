@@ -0,0 +1,30 @@
+package GMiddleware
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/services"
+)
+
+type Slo struct {
+	SloService services.ISloService
+}
+
+// SloMiddleware times the requests of the routes with an objective and counts them in their compliance, good when
+// answered within the latency of the objective without a server error. It runs after the routing, the route is known
+func (s Slo) SloMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		route := c.Request().Method + " " + c.Path()
+		if _, ok := s.SloService.Objective(route); !ok {
+			return next(c)
+		}
+		start := time.Now()
+		if err = next(c); err != nil {
+			c.Error(err)
+		}
+		s.SloService.Track(route, time.Since(start), c.Response().Status)
+		return
+	}
+}
@@ -0,0 +1,92 @@
+package GMiddleware
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	"gotham/infrastructures"
+)
+
+// the spans of a request kept for its breakdown, the next ones are only counted
+const slowRequestMaxSpans = 500
+
+type SlowRequest struct {
+	Logger infrastructures.ILogger
+	Config *config.SlowRequest
+}
+
+/**
+ * SlowRequestMiddleware
+ * traces the request, its queries, cache operations and requests to other services made with the request context. A
+ * request slower than the threshold is logged with the time spent in each kind of call, the time spent elsewhere and
+ * its slowest calls
+ */
+func (s SlowRequest) SlowRequestMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		if s.Config.Threshold <= 0 {
+			return next(c)
+		}
+		ctx, trace := infrastructures.WithTrace(c.Request().Context(), slowRequestMaxSpans)
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		err = next(c)
+		latency := time.Since(trace.Start)
+		if latency < s.Config.Threshold {
+			return err
+		}
+
+		fields := infrastructures.Fields{
+			"method":       c.Request().Method,
+			"route":        c.Path(),
+			"uri":          c.Request().RequestURI,
+			"latency_ms":   milliseconds(latency),
+			"threshold_ms": milliseconds(s.Config.Threshold),
+		}
+		if id := c.Response().Header().Get(echo.HeaderXRequestID); id != "" {
+			fields["request_id"] = id
+		}
+		if err != nil {
+			fields["error"] = err
+		}
+		// the calls made concurrently overlap, the time spent elsewhere is then 0
+		var traced time.Duration
+		for kind, total := range trace.Totals() {
+			fields[kind+"_count"] = total.Count
+			fields[kind+"_ms"] = milliseconds(total.Duration)
+			traced += total.Duration
+		}
+		if other := latency - traced; other > 0 {
+			fields["other_ms"] = milliseconds(other)
+		} else {
+			fields["other_ms"] = 0
+		}
+
+		slowest := trace.Slowest(s.Config.Spans)
+		spans := make([]infrastructures.Fields, 0, len(slowest))
+		for _, span := range slowest {
+			entry := infrastructures.Fields{
+				"kind":        span.Kind,
+				"name":        span.Name,
+				"at_ms":       milliseconds(span.Start.Sub(trace.Start)),
+				"duration_ms": milliseconds(span.Duration),
+			}
+			if span.Error != "" {
+				entry["error"] = span.Error
+			}
+			spans = append(spans, entry)
+		}
+		fields["spans"] = spans
+		if dropped := trace.Dropped(); dropped > 0 {
+			fields["spans_dropped"] = dropped
+		}
+
+		s.Logger.Warn("slow request", fields)
+		return err
+	}
+}
+
+func milliseconds(duration time.Duration) float64 {
+	return float64(duration.Microseconds()) / 1000
+}
@@ -0,0 +1,29 @@
+package GMiddleware
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/models"
+	"gotham/problems"
+)
+
+type Suspension struct{}
+
+// SuspensionMiddleware rejects suspended accounts, an expired suspension no longer counts even before the scheduler lifts it.
+// Admins impersonating a suspended user are let through.
+func (s Suspension) SuspensionMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		auth := models.ConvertUser(c.Get("auth"))
+		if !auth.IsSuspended(time.Now()) || c.Get("impersonator") != nil {
+			return next(c)
+		}
+
+		problem := problems.New(problems.UserSuspended).With("suspended_until", auth.SuspendedUntil)
+		if auth.SuspensionReason != nil {
+			problem = problem.With("reason", *auth.SuspensionReason)
+		}
+		return problem
+	}
+}
@@ -0,0 +1,82 @@
+package GMiddleware
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/helpers"
+	"gotham/models"
+)
+
+// Timezone writes the times of json responses in the timezone of the auth user, the stored times stay in UTC
+type Timezone struct{}
+
+func (t Timezone) TimezoneMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		auth, ok := c.Get("auth").(models.User)
+		// a websocket takes the connection over, the writer could not hijack it
+		if !ok || c.Request().Header.Get(echo.HeaderUpgrade) != "" {
+			return next(c)
+		}
+		loc, err := helpers.LoadLocation(auth.Timezone)
+		if err != nil || loc == time.UTC {
+			return next(c)
+		}
+
+		writer := &timezoneWriter{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = writer
+		defer func() {
+			c.Response().Writer = writer.ResponseWriter
+		}()
+
+		if err = next(c); err != nil {
+			return err
+		}
+		return writer.flush(loc)
+	}
+}
+
+// timezoneWriter holds a json response back until its times are rewritten, files are streamed through
+type timezoneWriter struct {
+	http.ResponseWriter
+	body    bytes.Buffer
+	status  int
+	through bool
+}
+
+func (w *timezoneWriter) WriteHeader(status int) {
+	if !strings.HasPrefix(w.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		w.through = true
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	w.status = status
+}
+
+func (w *timezoneWriter) Write(b []byte) (int, error) {
+	if w.through {
+		return w.ResponseWriter.Write(b)
+	}
+	return w.body.Write(b)
+}
+
+func (w *timezoneWriter) flush(loc *time.Location) error {
+	if w.through {
+		return nil
+	}
+	if w.status == 0 {
+		if w.body.Len() == 0 {
+			return nil
+		}
+		w.status = http.StatusOK
+	}
+	body := helpers.LocalizeJSON(w.body.Bytes(), loc)
+	w.Header().Del(echo.HeaderContentLength)
+	w.ResponseWriter.WriteHeader(w.status)
+	_, err := w.ResponseWriter.Write(body)
+	return err
+}
@@ -0,0 +1,728 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/jordan-wright/email"
+	"golang.org/x/net/websocket"
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+)
+
+// Analytics is a mock of infrastructures.IAnalytics
+type Analytics struct {
+	TrackFunc func(event infrastructures.AnalyticsEvent)
+	CloseFunc func() error
+}
+
+var _ infrastructures.IAnalytics = (*Analytics)(nil)
+
+func (mock *Analytics) Track(event infrastructures.AnalyticsEvent) {
+	if mock.TrackFunc == nil {
+		panic("mocks: Analytics.Track is not mocked")
+	}
+	mock.TrackFunc(event)
+}
+
+func (mock *Analytics) Close() error {
+	if mock.CloseFunc == nil {
+		panic("mocks: Analytics.Close is not mocked")
+	}
+	return mock.CloseFunc()
+}
+
+// PaymentGateway is a mock of infrastructures.IPaymentGateway
+type PaymentGateway struct {
+	CreateCheckoutFunc     func(ctx context.Context, checkout infrastructures.Checkout) (string, error)
+	CancelSubscriptionFunc func(ctx context.Context, subscriptionID string) error
+	CreateCouponFunc       func(ctx context.Context, coupon infrastructures.PaymentCoupon) (string, error)
+	ParseWebhookFunc       func(payload []byte, signature string) (infrastructures.PaymentEvent, error)
+}
+
+var _ infrastructures.IPaymentGateway = (*PaymentGateway)(nil)
+
+func (mock *PaymentGateway) CreateCheckout(ctx context.Context, checkout infrastructures.Checkout) (string, error) {
+	if mock.CreateCheckoutFunc == nil {
+		panic("mocks: PaymentGateway.CreateCheckout is not mocked")
+	}
+	return mock.CreateCheckoutFunc(ctx, checkout)
+}
+
+func (mock *PaymentGateway) CancelSubscription(ctx context.Context, subscriptionID string) error {
+	if mock.CancelSubscriptionFunc == nil {
+		panic("mocks: PaymentGateway.CancelSubscription is not mocked")
+	}
+	return mock.CancelSubscriptionFunc(ctx, subscriptionID)
+}
+
+func (mock *PaymentGateway) CreateCoupon(ctx context.Context, coupon infrastructures.PaymentCoupon) (string, error) {
+	if mock.CreateCouponFunc == nil {
+		panic("mocks: PaymentGateway.CreateCoupon is not mocked")
+	}
+	return mock.CreateCouponFunc(ctx, coupon)
+}
+
+func (mock *PaymentGateway) ParseWebhook(payload []byte, signature string) (infrastructures.PaymentEvent, error) {
+	if mock.ParseWebhookFunc == nil {
+		panic("mocks: PaymentGateway.ParseWebhook is not mocked")
+	}
+	return mock.ParseWebhookFunc(payload, signature)
+}
+
+// Cache is a mock of infrastructures.ICache
+type Cache struct {
+	GetFunc            func(ctx context.Context, key string) (value []byte, found bool, err error)
+	SetFunc            func(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	AddFunc            func(ctx context.Context, key string, value []byte, ttl time.Duration) (added bool, err error)
+	DeleteFunc         func(ctx context.Context, keys ...string) error
+	TagFunc            func(ctx context.Context, tag string, keys ...string) error
+	InvalidateTagsFunc func(ctx context.Context, tags ...string) error
+}
+
+var _ infrastructures.ICache = (*Cache)(nil)
+
+func (mock *Cache) Get(ctx context.Context, key string) (value []byte, found bool, err error) {
+	if mock.GetFunc == nil {
+		panic("mocks: Cache.Get is not mocked")
+	}
+	return mock.GetFunc(ctx, key)
+}
+
+func (mock *Cache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if mock.SetFunc == nil {
+		panic("mocks: Cache.Set is not mocked")
+	}
+	return mock.SetFunc(ctx, key, value, ttl)
+}
+
+func (mock *Cache) Add(ctx context.Context, key string, value []byte, ttl time.Duration) (added bool, err error) {
+	if mock.AddFunc == nil {
+		panic("mocks: Cache.Add is not mocked")
+	}
+	return mock.AddFunc(ctx, key, value, ttl)
+}
+
+func (mock *Cache) Delete(ctx context.Context, keys ...string) error {
+	if mock.DeleteFunc == nil {
+		panic("mocks: Cache.Delete is not mocked")
+	}
+	return mock.DeleteFunc(ctx, keys...)
+}
+
+func (mock *Cache) Tag(ctx context.Context, tag string, keys ...string) error {
+	if mock.TagFunc == nil {
+		panic("mocks: Cache.Tag is not mocked")
+	}
+	return mock.TagFunc(ctx, tag, keys...)
+}
+
+func (mock *Cache) InvalidateTags(ctx context.Context, tags ...string) error {
+	if mock.InvalidateTagsFunc == nil {
+		panic("mocks: Cache.InvalidateTags is not mocked")
+	}
+	return mock.InvalidateTagsFunc(ctx, tags...)
+}
+
+// CaptchaService is a mock of infrastructures.ICaptchaService
+type CaptchaService struct {
+	VerifyFunc func(ctx context.Context, token string, remoteIP string) (bool, error)
+}
+
+var _ infrastructures.ICaptchaService = (*CaptchaService)(nil)
+
+func (mock *CaptchaService) Verify(ctx context.Context, token string, remoteIP string) (bool, error) {
+	if mock.VerifyFunc == nil {
+		panic("mocks: CaptchaService.Verify is not mocked")
+	}
+	return mock.VerifyFunc(ctx, token, remoteIP)
+}
+
+// Clock is a mock of infrastructures.IClock
+type Clock struct {
+	NowFunc func() time.Time
+}
+
+var _ infrastructures.IClock = (*Clock)(nil)
+
+func (mock *Clock) Now() time.Time {
+	if mock.NowFunc == nil {
+		panic("mocks: Clock.Now is not mocked")
+	}
+	return mock.NowFunc()
+}
+
+// GormDatabase is a mock of infrastructures.IGormDatabase
+type GormDatabase struct {
+	DBFunc func() *gorm.DB
+}
+
+var _ infrastructures.IGormDatabase = (*GormDatabase)(nil)
+
+func (mock *GormDatabase) DB() *gorm.DB {
+	if mock.DBFunc == nil {
+		panic("mocks: GormDatabase.DB is not mocked")
+	}
+	return mock.DBFunc()
+}
+
+// Reconnectable is a mock of infrastructures.IReconnectable
+type Reconnectable struct {
+	ReconnectFunc func() error
+}
+
+var _ infrastructures.IReconnectable = (*Reconnectable)(nil)
+
+func (mock *Reconnectable) Reconnect() error {
+	if mock.ReconnectFunc == nil {
+		panic("mocks: Reconnectable.Reconnect is not mocked")
+	}
+	return mock.ReconnectFunc()
+}
+
+// GormDatabasePool is a mock of infrastructures.IGormDatabasePool
+type GormDatabasePool struct {
+	GetDialectorFunc func() gorm.Dialector
+	ConfigureFunc    func(db *sql.DB)
+}
+
+var _ infrastructures.IGormDatabasePool = (*GormDatabasePool)(nil)
+
+func (mock *GormDatabasePool) GetDialector() gorm.Dialector {
+	if mock.GetDialectorFunc == nil {
+		panic("mocks: GormDatabasePool.GetDialector is not mocked")
+	}
+	return mock.GetDialectorFunc()
+}
+
+func (mock *GormDatabasePool) Configure(db *sql.DB) {
+	if mock.ConfigureFunc == nil {
+		panic("mocks: GormDatabasePool.Configure is not mocked")
+	}
+	mock.ConfigureFunc(db)
+}
+
+// DatabaseSupervisor is a mock of infrastructures.IDatabaseSupervisor
+type DatabaseSupervisor struct {
+	CheckFunc func() error
+}
+
+var _ infrastructures.IDatabaseSupervisor = (*DatabaseSupervisor)(nil)
+
+func (mock *DatabaseSupervisor) Check() error {
+	if mock.CheckFunc == nil {
+		panic("mocks: DatabaseSupervisor.Check is not mocked")
+	}
+	return mock.CheckFunc()
+}
+
+// DbRetrier is a mock of infrastructures.IDbRetrier
+type DbRetrier struct {
+	DoFunc func(ctx context.Context, operation string, fn func() error) error
+}
+
+var _ infrastructures.IDbRetrier = (*DbRetrier)(nil)
+
+func (mock *DbRetrier) Do(ctx context.Context, operation string, fn func() error) error {
+	if mock.DoFunc == nil {
+		panic("mocks: DbRetrier.Do is not mocked")
+	}
+	return mock.DoFunc(ctx, operation, fn)
+}
+
+// EmailService is a mock of infrastructures.IEmailService
+type EmailService struct {
+	SendFunc func(Context email.Email) error
+}
+
+var _ infrastructures.IEmailService = (*EmailService)(nil)
+
+func (mock *EmailService) Send(Context email.Email) error {
+	if mock.SendFunc == nil {
+		panic("mocks: EmailService.Send is not mocked")
+	}
+	return mock.SendFunc(Context)
+}
+
+// ErrorReporter is a mock of infrastructures.IErrorReporter
+type ErrorReporter struct {
+	ReportFunc func(ctx context.Context, report infrastructures.ErrorReport)
+	CloseFunc  func() error
+}
+
+var _ infrastructures.IErrorReporter = (*ErrorReporter)(nil)
+
+func (mock *ErrorReporter) Report(ctx context.Context, report infrastructures.ErrorReport) {
+	if mock.ReportFunc == nil {
+		panic("mocks: ErrorReporter.Report is not mocked")
+	}
+	mock.ReportFunc(ctx, report)
+}
+
+func (mock *ErrorReporter) Close() error {
+	if mock.CloseFunc == nil {
+		panic("mocks: ErrorReporter.Close is not mocked")
+	}
+	return mock.CloseFunc()
+}
+
+// EventBus is a mock of infrastructures.IEventBus
+type EventBus struct {
+	PublishFunc   func(name string, payload interface{})
+	SubscribeFunc func(name string, handler infrastructures.EventHandler)
+	CloseFunc     func() error
+}
+
+var _ infrastructures.IEventBus = (*EventBus)(nil)
+
+func (mock *EventBus) Publish(name string, payload interface{}) {
+	if mock.PublishFunc == nil {
+		panic("mocks: EventBus.Publish is not mocked")
+	}
+	mock.PublishFunc(name, payload)
+}
+
+func (mock *EventBus) Subscribe(name string, handler infrastructures.EventHandler) {
+	if mock.SubscribeFunc == nil {
+		panic("mocks: EventBus.Subscribe is not mocked")
+	}
+	mock.SubscribeFunc(name, handler)
+}
+
+func (mock *EventBus) Close() error {
+	if mock.CloseFunc == nil {
+		panic("mocks: EventBus.Close is not mocked")
+	}
+	return mock.CloseFunc()
+}
+
+// FeatureFlags is a mock of infrastructures.IFeatureFlags
+type FeatureFlags struct {
+	EnabledFunc func(name string) bool
+	ExistsFunc  func(name string) bool
+	AllFunc     func() map[string]bool
+}
+
+var _ infrastructures.IFeatureFlags = (*FeatureFlags)(nil)
+
+func (mock *FeatureFlags) Enabled(name string) bool {
+	if mock.EnabledFunc == nil {
+		panic("mocks: FeatureFlags.Enabled is not mocked")
+	}
+	return mock.EnabledFunc(name)
+}
+
+func (mock *FeatureFlags) Exists(name string) bool {
+	if mock.ExistsFunc == nil {
+		panic("mocks: FeatureFlags.Exists is not mocked")
+	}
+	return mock.ExistsFunc(name)
+}
+
+func (mock *FeatureFlags) All() map[string]bool {
+	if mock.AllFunc == nil {
+		panic("mocks: FeatureFlags.All is not mocked")
+	}
+	return mock.AllFunc()
+}
+
+// HttpClient is a mock of infrastructures.IHttpClient
+type HttpClient struct {
+	DoFunc func(request *http.Request) (*http.Response, error)
+}
+
+var _ infrastructures.IHttpClient = (*HttpClient)(nil)
+
+func (mock *HttpClient) Do(request *http.Request) (*http.Response, error) {
+	if mock.DoFunc == nil {
+		panic("mocks: HttpClient.Do is not mocked")
+	}
+	return mock.DoFunc(request)
+}
+
+// HttpClientFactory is a mock of infrastructures.IHttpClientFactory
+type HttpClientFactory struct {
+	MakeFunc func(name string) infrastructures.IHttpClient
+}
+
+var _ infrastructures.IHttpClientFactory = (*HttpClientFactory)(nil)
+
+func (mock *HttpClientFactory) Make(name string) infrastructures.IHttpClient {
+	if mock.MakeFunc == nil {
+		panic("mocks: HttpClientFactory.Make is not mocked")
+	}
+	return mock.MakeFunc(name)
+}
+
+// Hub is a mock of infrastructures.IHub
+type Hub struct {
+	ServeFunc  func(userID uint, conn *websocket.Conn)
+	SendFunc   func(userID uint, message infrastructures.HubMessage)
+	OnlineFunc func(userID uint) bool
+	CloseFunc  func() error
+}
+
+var _ infrastructures.IHub = (*Hub)(nil)
+
+func (mock *Hub) Serve(userID uint, conn *websocket.Conn) {
+	if mock.ServeFunc == nil {
+		panic("mocks: Hub.Serve is not mocked")
+	}
+	mock.ServeFunc(userID, conn)
+}
+
+func (mock *Hub) Send(userID uint, message infrastructures.HubMessage) {
+	if mock.SendFunc == nil {
+		panic("mocks: Hub.Send is not mocked")
+	}
+	mock.SendFunc(userID, message)
+}
+
+func (mock *Hub) Online(userID uint) bool {
+	if mock.OnlineFunc == nil {
+		panic("mocks: Hub.Online is not mocked")
+	}
+	return mock.OnlineFunc(userID)
+}
+
+func (mock *Hub) Close() error {
+	if mock.CloseFunc == nil {
+		panic("mocks: Hub.Close is not mocked")
+	}
+	return mock.CloseFunc()
+}
+
+// Logger is a mock of infrastructures.ILogger
+type Logger struct {
+	DebugFunc func(message string, fields infrastructures.Fields)
+	InfoFunc  func(message string, fields infrastructures.Fields)
+	WarnFunc  func(message string, fields infrastructures.Fields)
+	ErrorFunc func(message string, fields infrastructures.Fields)
+	WithFunc  func(fields infrastructures.Fields) infrastructures.ILogger
+}
+
+var _ infrastructures.ILogger = (*Logger)(nil)
+
+func (mock *Logger) Debug(message string, fields infrastructures.Fields) {
+	if mock.DebugFunc == nil {
+		panic("mocks: Logger.Debug is not mocked")
+	}
+	mock.DebugFunc(message, fields)
+}
+
+func (mock *Logger) Info(message string, fields infrastructures.Fields) {
+	if mock.InfoFunc == nil {
+		panic("mocks: Logger.Info is not mocked")
+	}
+	mock.InfoFunc(message, fields)
+}
+
+func (mock *Logger) Warn(message string, fields infrastructures.Fields) {
+	if mock.WarnFunc == nil {
+		panic("mocks: Logger.Warn is not mocked")
+	}
+	mock.WarnFunc(message, fields)
+}
+
+func (mock *Logger) Error(message string, fields infrastructures.Fields) {
+	if mock.ErrorFunc == nil {
+		panic("mocks: Logger.Error is not mocked")
+	}
+	mock.ErrorFunc(message, fields)
+}
+
+func (mock *Logger) With(fields infrastructures.Fields) infrastructures.ILogger {
+	if mock.WithFunc == nil {
+		panic("mocks: Logger.With is not mocked")
+	}
+	return mock.WithFunc(fields)
+}
+
+// Metrics is a mock of infrastructures.IMetrics
+type Metrics struct {
+	IncFunc     func(name string, labels map[string]string, value float64)
+	SetFunc     func(name string, labels map[string]string, value float64)
+	ObserveFunc func(name string, labels map[string]string, value float64)
+	RenderFunc  func() string
+}
+
+var _ infrastructures.IMetrics = (*Metrics)(nil)
+
+func (mock *Metrics) Inc(name string, labels map[string]string, value float64) {
+	if mock.IncFunc == nil {
+		panic("mocks: Metrics.Inc is not mocked")
+	}
+	mock.IncFunc(name, labels, value)
+}
+
+func (mock *Metrics) Set(name string, labels map[string]string, value float64) {
+	if mock.SetFunc == nil {
+		panic("mocks: Metrics.Set is not mocked")
+	}
+	mock.SetFunc(name, labels, value)
+}
+
+func (mock *Metrics) Observe(name string, labels map[string]string, value float64) {
+	if mock.ObserveFunc == nil {
+		panic("mocks: Metrics.Observe is not mocked")
+	}
+	mock.ObserveFunc(name, labels, value)
+}
+
+func (mock *Metrics) Render() string {
+	if mock.RenderFunc == nil {
+		panic("mocks: Metrics.Render is not mocked")
+	}
+	return mock.RenderFunc()
+}
+
+// PdfService is a mock of infrastructures.IPdfService
+type PdfService struct {
+	RenderFunc func(path string, data interface{}) ([]byte, error)
+}
+
+var _ infrastructures.IPdfService = (*PdfService)(nil)
+
+func (mock *PdfService) Render(path string, data interface{}) ([]byte, error) {
+	if mock.RenderFunc == nil {
+		panic("mocks: PdfService.Render is not mocked")
+	}
+	return mock.RenderFunc(path, data)
+}
+
+// PushService is a mock of infrastructures.IPushService
+type PushService struct {
+	SendFunc func(token string, notification infrastructures.PushNotification) error
+}
+
+var _ infrastructures.IPushService = (*PushService)(nil)
+
+func (mock *PushService) Send(token string, notification infrastructures.PushNotification) error {
+	if mock.SendFunc == nil {
+		panic("mocks: PushService.Send is not mocked")
+	}
+	return mock.SendFunc(token, notification)
+}
+
+// Queue is a mock of infrastructures.IQueue
+type Queue struct {
+	PushFunc  func(ctx context.Context, queue string, payload []byte) error
+	WorkFunc  func(queue string, concurrency int, handler infrastructures.JobHandler)
+	CloseFunc func() error
+}
+
+var _ infrastructures.IQueue = (*Queue)(nil)
+
+func (mock *Queue) Push(ctx context.Context, queue string, payload []byte) error {
+	if mock.PushFunc == nil {
+		panic("mocks: Queue.Push is not mocked")
+	}
+	return mock.PushFunc(ctx, queue, payload)
+}
+
+func (mock *Queue) Work(queue string, concurrency int, handler infrastructures.JobHandler) {
+	if mock.WorkFunc == nil {
+		panic("mocks: Queue.Work is not mocked")
+	}
+	mock.WorkFunc(queue, concurrency, handler)
+}
+
+func (mock *Queue) Close() error {
+	if mock.CloseFunc == nil {
+		panic("mocks: Queue.Close is not mocked")
+	}
+	return mock.CloseFunc()
+}
+
+// Random is a mock of infrastructures.IRandom
+type Random struct {
+	TokenFunc  func(n int) (string, error)
+	DigitsFunc func(n int) (string, error)
+	StringFunc func(n int) (string, error)
+}
+
+var _ infrastructures.IRandom = (*Random)(nil)
+
+func (mock *Random) Token(n int) (string, error) {
+	if mock.TokenFunc == nil {
+		panic("mocks: Random.Token is not mocked")
+	}
+	return mock.TokenFunc(n)
+}
+
+func (mock *Random) Digits(n int) (string, error) {
+	if mock.DigitsFunc == nil {
+		panic("mocks: Random.Digits is not mocked")
+	}
+	return mock.DigitsFunc(n)
+}
+
+func (mock *Random) String(n int) (string, error) {
+	if mock.StringFunc == nil {
+		panic("mocks: Random.String is not mocked")
+	}
+	return mock.StringFunc(n)
+}
+
+// Scheduler is a mock of infrastructures.IScheduler
+type Scheduler struct {
+	EveryFunc func(name string, interval time.Duration, job func() error)
+	StartFunc func()
+	StopFunc  func()
+}
+
+var _ infrastructures.IScheduler = (*Scheduler)(nil)
+
+func (mock *Scheduler) Every(name string, interval time.Duration, job func() error) {
+	if mock.EveryFunc == nil {
+		panic("mocks: Scheduler.Every is not mocked")
+	}
+	mock.EveryFunc(name, interval, job)
+}
+
+func (mock *Scheduler) Start() {
+	if mock.StartFunc == nil {
+		panic("mocks: Scheduler.Start is not mocked")
+	}
+	mock.StartFunc()
+}
+
+func (mock *Scheduler) Stop() {
+	if mock.StopFunc == nil {
+		panic("mocks: Scheduler.Stop is not mocked")
+	}
+	mock.StopFunc()
+}
+
+// SearchEngine is a mock of infrastructures.ISearchEngine
+type SearchEngine struct {
+	IndexFunc  func(index string, documents []infrastructures.SearchDocument) error
+	DeleteFunc func(index string, IDs []uint) error
+}
+
+var _ infrastructures.ISearchEngine = (*SearchEngine)(nil)
+
+func (mock *SearchEngine) Index(index string, documents []infrastructures.SearchDocument) error {
+	if mock.IndexFunc == nil {
+		panic("mocks: SearchEngine.Index is not mocked")
+	}
+	return mock.IndexFunc(index, documents)
+}
+
+func (mock *SearchEngine) Delete(index string, IDs []uint) error {
+	if mock.DeleteFunc == nil {
+		panic("mocks: SearchEngine.Delete is not mocked")
+	}
+	return mock.DeleteFunc(index, IDs)
+}
+
+// Shards is a mock of infrastructures.IShards
+type Shards struct {
+	ForFunc     func(key infrastructures.ShardKey) infrastructures.IGormDatabase
+	AllFunc     func() []infrastructures.IGormDatabase
+	MigrateFunc func(models ...interface{}) error
+	CloseFunc   func() error
+}
+
+var _ infrastructures.IShards = (*Shards)(nil)
+
+func (mock *Shards) For(key infrastructures.ShardKey) infrastructures.IGormDatabase {
+	if mock.ForFunc == nil {
+		panic("mocks: Shards.For is not mocked")
+	}
+	return mock.ForFunc(key)
+}
+
+func (mock *Shards) All() []infrastructures.IGormDatabase {
+	if mock.AllFunc == nil {
+		panic("mocks: Shards.All is not mocked")
+	}
+	return mock.AllFunc()
+}
+
+func (mock *Shards) Migrate(models ...interface{}) error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: Shards.Migrate is not mocked")
+	}
+	return mock.MigrateFunc(models...)
+}
+
+func (mock *Shards) Close() error {
+	if mock.CloseFunc == nil {
+		panic("mocks: Shards.Close is not mocked")
+	}
+	return mock.CloseFunc()
+}
+
+// SmsService is a mock of infrastructures.ISmsService
+type SmsService struct {
+	SendFunc func(to string, message string) error
+}
+
+var _ infrastructures.ISmsService = (*SmsService)(nil)
+
+func (mock *SmsService) Send(to string, message string) error {
+	if mock.SendFunc == nil {
+		panic("mocks: SmsService.Send is not mocked")
+	}
+	return mock.SendFunc(to, message)
+}
+
+// StorageService is a mock of infrastructures.IStorageService
+type StorageService struct {
+	PutFunc      func(path string, content []byte) error
+	GetFunc      func(path string) ([]byte, error)
+	OpenFunc     func(path string) (infrastructures.StorageFile, error)
+	DeleteFunc   func(path string) error
+	ExistsFunc   func(path string) bool
+	FullPathFunc func(path string) (string, error)
+}
+
+var _ infrastructures.IStorageService = (*StorageService)(nil)
+
+func (mock *StorageService) Put(path string, content []byte) error {
+	if mock.PutFunc == nil {
+		panic("mocks: StorageService.Put is not mocked")
+	}
+	return mock.PutFunc(path, content)
+}
+
+func (mock *StorageService) Get(path string) ([]byte, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: StorageService.Get is not mocked")
+	}
+	return mock.GetFunc(path)
+}
+
+func (mock *StorageService) Open(path string) (infrastructures.StorageFile, error) {
+	if mock.OpenFunc == nil {
+		panic("mocks: StorageService.Open is not mocked")
+	}
+	return mock.OpenFunc(path)
+}
+
+func (mock *StorageService) Delete(path string) error {
+	if mock.DeleteFunc == nil {
+		panic("mocks: StorageService.Delete is not mocked")
+	}
+	return mock.DeleteFunc(path)
+}
+
+func (mock *StorageService) Exists(path string) bool {
+	if mock.ExistsFunc == nil {
+		panic("mocks: StorageService.Exists is not mocked")
+	}
+	return mock.ExistsFunc(path)
+}
+
+func (mock *StorageService) FullPath(path string) (string, error) {
+	if mock.FullPathFunc == nil {
+		panic("mocks: StorageService.FullPath is not mocked")
+	}
+	return mock.FullPathFunc(path)
+}
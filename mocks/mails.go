@@ -0,0 +1,23 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"github.com/jordan-wright/email"
+
+	"gotham/mails"
+)
+
+// MailRenderer is a mock of mails.IMailRenderer
+type MailRenderer struct {
+	RenderFunc func(data map[string]interface{}, to []string) (context email.Email, err error)
+}
+
+var _ mails.IMailRenderer = (*MailRenderer)(nil)
+
+func (mock *MailRenderer) Render(data map[string]interface{}, to []string) (context email.Email, err error) {
+	if mock.RenderFunc == nil {
+		panic("mocks: MailRenderer.Render is not mocked")
+	}
+	return mock.RenderFunc(data, to)
+}
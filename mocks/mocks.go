@@ -0,0 +1,9 @@
+// Package mocks holds a mock of every interface of the services, generated by cmd/mockgen. A mock has a func field
+// per method, set the ones the test expects, a method without its func panics.
+//
+//	users := &mocks.UserRepository{
+//		GetUserByIDFunc: func(ID uint) (models.User, error) { return models.User{ID: ID}, nil },
+//	}
+package mocks
+
+//go:generate go run ../cmd/mockgen -root .. -out .
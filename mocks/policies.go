@@ -0,0 +1,78 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"gotham/models"
+	"gotham/policies"
+)
+
+// UserPolicy is a mock of policies.IUserPolicy
+type UserPolicy struct {
+	IndexFunc        func(auth models.User) bool
+	ShowFunc         func(auth models.User, user models.User) bool
+	UpdateFunc       func(auth models.User, user models.User) bool
+	DeleteFunc       func(auth models.User, user models.User) bool
+	CanFunc          func(auth models.User, action policies.Action, user models.User) bool
+	HiddenFieldsFunc func(auth models.User, user models.User) []string
+	PatchFunc        func(auth models.User, user models.User) bool
+	PatchFieldsFunc  func(auth models.User, user models.User, fields []string) (forbidden []string)
+}
+
+var _ policies.IUserPolicy = (*UserPolicy)(nil)
+
+func (mock *UserPolicy) Index(auth models.User) bool {
+	if mock.IndexFunc == nil {
+		panic("mocks: UserPolicy.Index is not mocked")
+	}
+	return mock.IndexFunc(auth)
+}
+
+func (mock *UserPolicy) Show(auth models.User, user models.User) bool {
+	if mock.ShowFunc == nil {
+		panic("mocks: UserPolicy.Show is not mocked")
+	}
+	return mock.ShowFunc(auth, user)
+}
+
+func (mock *UserPolicy) Update(auth models.User, user models.User) bool {
+	if mock.UpdateFunc == nil {
+		panic("mocks: UserPolicy.Update is not mocked")
+	}
+	return mock.UpdateFunc(auth, user)
+}
+
+func (mock *UserPolicy) Delete(auth models.User, user models.User) bool {
+	if mock.DeleteFunc == nil {
+		panic("mocks: UserPolicy.Delete is not mocked")
+	}
+	return mock.DeleteFunc(auth, user)
+}
+
+func (mock *UserPolicy) Can(auth models.User, action policies.Action, user models.User) bool {
+	if mock.CanFunc == nil {
+		panic("mocks: UserPolicy.Can is not mocked")
+	}
+	return mock.CanFunc(auth, action, user)
+}
+
+func (mock *UserPolicy) HiddenFields(auth models.User, user models.User) []string {
+	if mock.HiddenFieldsFunc == nil {
+		panic("mocks: UserPolicy.HiddenFields is not mocked")
+	}
+	return mock.HiddenFieldsFunc(auth, user)
+}
+
+func (mock *UserPolicy) Patch(auth models.User, user models.User) bool {
+	if mock.PatchFunc == nil {
+		panic("mocks: UserPolicy.Patch is not mocked")
+	}
+	return mock.PatchFunc(auth, user)
+}
+
+func (mock *UserPolicy) PatchFields(auth models.User, user models.User, fields []string) (forbidden []string) {
+	if mock.PatchFieldsFunc == nil {
+		panic("mocks: UserPolicy.PatchFields is not mocked")
+	}
+	return mock.PatchFieldsFunc(auth, user, fields)
+}
@@ -0,0 +1,2710 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+	"gotham/pagination"
+	"gotham/repositories"
+)
+
+// AnnouncementRepository is a mock of repositories.IAnnouncementRepository
+type AnnouncementRepository struct {
+	MigrateFunc              func() error
+	ExportUserDataFunc       func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc        func(userID uint) error
+	FindByIDFunc             func(ID uint) (models.Announcement, error)
+	FindByIDsFunc            func(IDs []uint) (records []models.Announcement, err error)
+	ListFunc                 func(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Announcement, totalCount int64, err error)
+	ChunkFunc                func(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Announcement, err error)
+	FindEachFunc             func(ctx context.Context, batchSize int, handle func(records []models.Announcement) error, filters ...func(db *gorm.DB) *gorm.DB) (err error)
+	StreamFunc               func(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.Announcement, errs <-chan error)
+	CreateFunc               func(record *models.Announcement) (err error)
+	UpdateFunc               func(record *models.Announcement, updates map[string]interface{}) (err error)
+	UpdateFieldsFunc         func(record *models.Announcement, fields ...string) (err error)
+	DeleteFunc               func(record *models.Announcement) (err error)
+	OnShardFunc              func(key infrastructures.ShardKey) repositories.IBaseRepository[models.Announcement]
+	ExistsFunc               func(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error)
+	CountFunc                func(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error)
+	PublishDueFunc           func(now time.Time, limit int) (records []models.Announcement, err error)
+	PublishFunc              func(record *models.Announcement, now time.Time) (published bool, err error)
+	GetAnnouncementByIDFunc  func(ID uint) (models.Announcement, error)
+	GetAnnouncementsFunc     func(pagination scopes.GormPager) (announcements []models.Announcement, totalCount int64, err error)
+	GetUserAnnouncementsFunc func(verified bool, planSlugs []string, pagination scopes.GormPager) (announcements []models.Announcement, totalCount int64, err error)
+	GetAudienceFunc          func(audience string, planRank int, afterID uint, limit int) (userIDs []uint, err error)
+	GetReadIDsFunc           func(userID uint, announcementIDs []uint) (IDs []uint, err error)
+	CountReadsFunc           func(announcementIDs []uint) (counts []models.AnnouncementReadCount, err error)
+	MarkReadFunc             func(announcementID uint, userID uint) error
+}
+
+var _ repositories.IAnnouncementRepository = (*AnnouncementRepository)(nil)
+
+func (mock *AnnouncementRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: AnnouncementRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *AnnouncementRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: AnnouncementRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *AnnouncementRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: AnnouncementRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *AnnouncementRepository) FindByID(ID uint) (models.Announcement, error) {
+	if mock.FindByIDFunc == nil {
+		panic("mocks: AnnouncementRepository.FindByID is not mocked")
+	}
+	return mock.FindByIDFunc(ID)
+}
+
+func (mock *AnnouncementRepository) FindByIDs(IDs []uint) (records []models.Announcement, err error) {
+	if mock.FindByIDsFunc == nil {
+		panic("mocks: AnnouncementRepository.FindByIDs is not mocked")
+	}
+	return mock.FindByIDsFunc(IDs)
+}
+
+func (mock *AnnouncementRepository) List(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Announcement, totalCount int64, err error) {
+	if mock.ListFunc == nil {
+		panic("mocks: AnnouncementRepository.List is not mocked")
+	}
+	return mock.ListFunc(pagination, filters...)
+}
+
+func (mock *AnnouncementRepository) Chunk(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Announcement, err error) {
+	if mock.ChunkFunc == nil {
+		panic("mocks: AnnouncementRepository.Chunk is not mocked")
+	}
+	return mock.ChunkFunc(afterID, limit, filters...)
+}
+
+func (mock *AnnouncementRepository) FindEach(ctx context.Context, batchSize int, handle func(records []models.Announcement) error, filters ...func(db *gorm.DB) *gorm.DB) (err error) {
+	if mock.FindEachFunc == nil {
+		panic("mocks: AnnouncementRepository.FindEach is not mocked")
+	}
+	return mock.FindEachFunc(ctx, batchSize, handle, filters...)
+}
+
+func (mock *AnnouncementRepository) Stream(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.Announcement, errs <-chan error) {
+	if mock.StreamFunc == nil {
+		panic("mocks: AnnouncementRepository.Stream is not mocked")
+	}
+	return mock.StreamFunc(ctx, batchSize, filters...)
+}
+
+func (mock *AnnouncementRepository) Create(record *models.Announcement) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: AnnouncementRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(record)
+}
+
+func (mock *AnnouncementRepository) Update(record *models.Announcement, updates map[string]interface{}) (err error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: AnnouncementRepository.Update is not mocked")
+	}
+	return mock.UpdateFunc(record, updates)
+}
+
+func (mock *AnnouncementRepository) UpdateFields(record *models.Announcement, fields ...string) (err error) {
+	if mock.UpdateFieldsFunc == nil {
+		panic("mocks: AnnouncementRepository.UpdateFields is not mocked")
+	}
+	return mock.UpdateFieldsFunc(record, fields...)
+}
+
+func (mock *AnnouncementRepository) Delete(record *models.Announcement) (err error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: AnnouncementRepository.Delete is not mocked")
+	}
+	return mock.DeleteFunc(record)
+}
+
+func (mock *AnnouncementRepository) OnShard(key infrastructures.ShardKey) repositories.IBaseRepository[models.Announcement] {
+	if mock.OnShardFunc == nil {
+		panic("mocks: AnnouncementRepository.OnShard is not mocked")
+	}
+	return mock.OnShardFunc(key)
+}
+
+func (mock *AnnouncementRepository) Exists(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error) {
+	if mock.ExistsFunc == nil {
+		panic("mocks: AnnouncementRepository.Exists is not mocked")
+	}
+	return mock.ExistsFunc(filters...)
+}
+
+func (mock *AnnouncementRepository) Count(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error) {
+	if mock.CountFunc == nil {
+		panic("mocks: AnnouncementRepository.Count is not mocked")
+	}
+	return mock.CountFunc(filters...)
+}
+
+func (mock *AnnouncementRepository) PublishDue(now time.Time, limit int) (records []models.Announcement, err error) {
+	if mock.PublishDueFunc == nil {
+		panic("mocks: AnnouncementRepository.PublishDue is not mocked")
+	}
+	return mock.PublishDueFunc(now, limit)
+}
+
+func (mock *AnnouncementRepository) Publish(record *models.Announcement, now time.Time) (published bool, err error) {
+	if mock.PublishFunc == nil {
+		panic("mocks: AnnouncementRepository.Publish is not mocked")
+	}
+	return mock.PublishFunc(record, now)
+}
+
+func (mock *AnnouncementRepository) GetAnnouncementByID(ID uint) (models.Announcement, error) {
+	if mock.GetAnnouncementByIDFunc == nil {
+		panic("mocks: AnnouncementRepository.GetAnnouncementByID is not mocked")
+	}
+	return mock.GetAnnouncementByIDFunc(ID)
+}
+
+func (mock *AnnouncementRepository) GetAnnouncements(pagination scopes.GormPager) (announcements []models.Announcement, totalCount int64, err error) {
+	if mock.GetAnnouncementsFunc == nil {
+		panic("mocks: AnnouncementRepository.GetAnnouncements is not mocked")
+	}
+	return mock.GetAnnouncementsFunc(pagination)
+}
+
+func (mock *AnnouncementRepository) GetUserAnnouncements(verified bool, planSlugs []string, pagination scopes.GormPager) (announcements []models.Announcement, totalCount int64, err error) {
+	if mock.GetUserAnnouncementsFunc == nil {
+		panic("mocks: AnnouncementRepository.GetUserAnnouncements is not mocked")
+	}
+	return mock.GetUserAnnouncementsFunc(verified, planSlugs, pagination)
+}
+
+func (mock *AnnouncementRepository) GetAudience(audience string, planRank int, afterID uint, limit int) (userIDs []uint, err error) {
+	if mock.GetAudienceFunc == nil {
+		panic("mocks: AnnouncementRepository.GetAudience is not mocked")
+	}
+	return mock.GetAudienceFunc(audience, planRank, afterID, limit)
+}
+
+func (mock *AnnouncementRepository) GetReadIDs(userID uint, announcementIDs []uint) (IDs []uint, err error) {
+	if mock.GetReadIDsFunc == nil {
+		panic("mocks: AnnouncementRepository.GetReadIDs is not mocked")
+	}
+	return mock.GetReadIDsFunc(userID, announcementIDs)
+}
+
+func (mock *AnnouncementRepository) CountReads(announcementIDs []uint) (counts []models.AnnouncementReadCount, err error) {
+	if mock.CountReadsFunc == nil {
+		panic("mocks: AnnouncementRepository.CountReads is not mocked")
+	}
+	return mock.CountReadsFunc(announcementIDs)
+}
+
+func (mock *AnnouncementRepository) MarkRead(announcementID uint, userID uint) error {
+	if mock.MarkReadFunc == nil {
+		panic("mocks: AnnouncementRepository.MarkRead is not mocked")
+	}
+	return mock.MarkReadFunc(announcementID, userID)
+}
+
+// ApiKeyRepository is a mock of repositories.IApiKeyRepository
+type ApiKeyRepository struct {
+	MigrateFunc          func() error
+	GetApiKeysOfUserFunc func(userID uint) (keys []models.ApiKey, err error)
+	GetApiKeyOfUserFunc  func(userID uint, ID uint) (models.ApiKey, error)
+	GetApiKeyByHashFunc  func(hash string) (models.ApiKey, error)
+	CreateFunc           func(key *models.ApiKey) (err error)
+	RevokeFunc           func(key *models.ApiKey, now time.Time) (err error)
+	TouchFunc            func(key *models.ApiKey, now time.Time) (err error)
+}
+
+var _ repositories.IApiKeyRepository = (*ApiKeyRepository)(nil)
+
+func (mock *ApiKeyRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: ApiKeyRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *ApiKeyRepository) GetApiKeysOfUser(userID uint) (keys []models.ApiKey, err error) {
+	if mock.GetApiKeysOfUserFunc == nil {
+		panic("mocks: ApiKeyRepository.GetApiKeysOfUser is not mocked")
+	}
+	return mock.GetApiKeysOfUserFunc(userID)
+}
+
+func (mock *ApiKeyRepository) GetApiKeyOfUser(userID uint, ID uint) (models.ApiKey, error) {
+	if mock.GetApiKeyOfUserFunc == nil {
+		panic("mocks: ApiKeyRepository.GetApiKeyOfUser is not mocked")
+	}
+	return mock.GetApiKeyOfUserFunc(userID, ID)
+}
+
+func (mock *ApiKeyRepository) GetApiKeyByHash(hash string) (models.ApiKey, error) {
+	if mock.GetApiKeyByHashFunc == nil {
+		panic("mocks: ApiKeyRepository.GetApiKeyByHash is not mocked")
+	}
+	return mock.GetApiKeyByHashFunc(hash)
+}
+
+func (mock *ApiKeyRepository) Create(key *models.ApiKey) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: ApiKeyRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(key)
+}
+
+func (mock *ApiKeyRepository) Revoke(key *models.ApiKey, now time.Time) (err error) {
+	if mock.RevokeFunc == nil {
+		panic("mocks: ApiKeyRepository.Revoke is not mocked")
+	}
+	return mock.RevokeFunc(key, now)
+}
+
+func (mock *ApiKeyRepository) Touch(key *models.ApiKey, now time.Time) (err error) {
+	if mock.TouchFunc == nil {
+		panic("mocks: ApiKeyRepository.Touch is not mocked")
+	}
+	return mock.TouchFunc(key, now)
+}
+
+// BillingRepository is a mock of repositories.IBillingRepository
+type BillingRepository struct {
+	MigrateFunc                     func() error
+	SeedFunc                        func() error
+	ExportUserDataFunc              func(userID uint) (data interface{}, err error)
+	GetActivePlansFunc              func() (plans []models.Plan, err error)
+	GetPlanBySlugFunc               func(slug string) (models.Plan, error)
+	GetPlanByPriceIDFunc            func(priceID string) (models.Plan, error)
+	GetSubscriptionByUserIDFunc     func(userID uint) (models.Subscription, error)
+	GetSubscriptionByCustomerIDFunc func(customerID string) (models.Subscription, error)
+	IsPlanInUseFunc                 func(planID uint) (bool, error)
+	GetInvoiceByIDFunc              func(ID uint) (models.Invoice, error)
+	GetInvoiceByExternalIDFunc      func(externalID string) (models.Invoice, error)
+	GetInvoicesByUserIDFunc         func(userID uint) (invoices []models.Invoice, err error)
+	GetUnrenderedInvoicesFunc       func(before time.Time, maxAttempts int) (invoices []models.Invoice, err error)
+	CreateSubscriptionFunc          func(subscription *models.Subscription) (err error)
+	CreateInvoiceFunc               func(invoice *models.Invoice) (err error)
+	UpdateSubscriptionFunc          func(subscription *models.Subscription, updates map[string]interface{}) (err error)
+	UpdateInvoiceFunc               func(invoice *models.Invoice, updates map[string]interface{}) (err error)
+}
+
+var _ repositories.IBillingRepository = (*BillingRepository)(nil)
+
+func (mock *BillingRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: BillingRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *BillingRepository) Seed() error {
+	if mock.SeedFunc == nil {
+		panic("mocks: BillingRepository.Seed is not mocked")
+	}
+	return mock.SeedFunc()
+}
+
+func (mock *BillingRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: BillingRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *BillingRepository) GetActivePlans() (plans []models.Plan, err error) {
+	if mock.GetActivePlansFunc == nil {
+		panic("mocks: BillingRepository.GetActivePlans is not mocked")
+	}
+	return mock.GetActivePlansFunc()
+}
+
+func (mock *BillingRepository) GetPlanBySlug(slug string) (models.Plan, error) {
+	if mock.GetPlanBySlugFunc == nil {
+		panic("mocks: BillingRepository.GetPlanBySlug is not mocked")
+	}
+	return mock.GetPlanBySlugFunc(slug)
+}
+
+func (mock *BillingRepository) GetPlanByPriceID(priceID string) (models.Plan, error) {
+	if mock.GetPlanByPriceIDFunc == nil {
+		panic("mocks: BillingRepository.GetPlanByPriceID is not mocked")
+	}
+	return mock.GetPlanByPriceIDFunc(priceID)
+}
+
+func (mock *BillingRepository) GetSubscriptionByUserID(userID uint) (models.Subscription, error) {
+	if mock.GetSubscriptionByUserIDFunc == nil {
+		panic("mocks: BillingRepository.GetSubscriptionByUserID is not mocked")
+	}
+	return mock.GetSubscriptionByUserIDFunc(userID)
+}
+
+func (mock *BillingRepository) GetSubscriptionByCustomerID(customerID string) (models.Subscription, error) {
+	if mock.GetSubscriptionByCustomerIDFunc == nil {
+		panic("mocks: BillingRepository.GetSubscriptionByCustomerID is not mocked")
+	}
+	return mock.GetSubscriptionByCustomerIDFunc(customerID)
+}
+
+func (mock *BillingRepository) IsPlanInUse(planID uint) (bool, error) {
+	if mock.IsPlanInUseFunc == nil {
+		panic("mocks: BillingRepository.IsPlanInUse is not mocked")
+	}
+	return mock.IsPlanInUseFunc(planID)
+}
+
+func (mock *BillingRepository) GetInvoiceByID(ID uint) (models.Invoice, error) {
+	if mock.GetInvoiceByIDFunc == nil {
+		panic("mocks: BillingRepository.GetInvoiceByID is not mocked")
+	}
+	return mock.GetInvoiceByIDFunc(ID)
+}
+
+func (mock *BillingRepository) GetInvoiceByExternalID(externalID string) (models.Invoice, error) {
+	if mock.GetInvoiceByExternalIDFunc == nil {
+		panic("mocks: BillingRepository.GetInvoiceByExternalID is not mocked")
+	}
+	return mock.GetInvoiceByExternalIDFunc(externalID)
+}
+
+func (mock *BillingRepository) GetInvoicesByUserID(userID uint) (invoices []models.Invoice, err error) {
+	if mock.GetInvoicesByUserIDFunc == nil {
+		panic("mocks: BillingRepository.GetInvoicesByUserID is not mocked")
+	}
+	return mock.GetInvoicesByUserIDFunc(userID)
+}
+
+func (mock *BillingRepository) GetUnrenderedInvoices(before time.Time, maxAttempts int) (invoices []models.Invoice, err error) {
+	if mock.GetUnrenderedInvoicesFunc == nil {
+		panic("mocks: BillingRepository.GetUnrenderedInvoices is not mocked")
+	}
+	return mock.GetUnrenderedInvoicesFunc(before, maxAttempts)
+}
+
+func (mock *BillingRepository) CreateSubscription(subscription *models.Subscription) (err error) {
+	if mock.CreateSubscriptionFunc == nil {
+		panic("mocks: BillingRepository.CreateSubscription is not mocked")
+	}
+	return mock.CreateSubscriptionFunc(subscription)
+}
+
+func (mock *BillingRepository) CreateInvoice(invoice *models.Invoice) (err error) {
+	if mock.CreateInvoiceFunc == nil {
+		panic("mocks: BillingRepository.CreateInvoice is not mocked")
+	}
+	return mock.CreateInvoiceFunc(invoice)
+}
+
+func (mock *BillingRepository) UpdateSubscription(subscription *models.Subscription, updates map[string]interface{}) (err error) {
+	if mock.UpdateSubscriptionFunc == nil {
+		panic("mocks: BillingRepository.UpdateSubscription is not mocked")
+	}
+	return mock.UpdateSubscriptionFunc(subscription, updates)
+}
+
+func (mock *BillingRepository) UpdateInvoice(invoice *models.Invoice, updates map[string]interface{}) (err error) {
+	if mock.UpdateInvoiceFunc == nil {
+		panic("mocks: BillingRepository.UpdateInvoice is not mocked")
+	}
+	return mock.UpdateInvoiceFunc(invoice, updates)
+}
+
+// ClientRepository is a mock of repositories.IClientRepository
+type ClientRepository struct {
+	MigrateFunc                        func() error
+	GetClientsFunc                     func() (clients []models.Client, err error)
+	GetClientByIDFunc                  func(ID uint) (models.Client, error)
+	GetClientByClientIDFunc            func(clientID string) (models.Client, error)
+	GetClientByCertificateIdentityFunc func(identity string) (models.Client, error)
+	CreateFunc                         func(client *models.Client) (err error)
+	RevokeFunc                         func(client *models.Client, now time.Time) (err error)
+	TouchFunc                          func(client *models.Client, now time.Time) (err error)
+}
+
+var _ repositories.IClientRepository = (*ClientRepository)(nil)
+
+func (mock *ClientRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: ClientRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *ClientRepository) GetClients() (clients []models.Client, err error) {
+	if mock.GetClientsFunc == nil {
+		panic("mocks: ClientRepository.GetClients is not mocked")
+	}
+	return mock.GetClientsFunc()
+}
+
+func (mock *ClientRepository) GetClientByID(ID uint) (models.Client, error) {
+	if mock.GetClientByIDFunc == nil {
+		panic("mocks: ClientRepository.GetClientByID is not mocked")
+	}
+	return mock.GetClientByIDFunc(ID)
+}
+
+func (mock *ClientRepository) GetClientByClientID(clientID string) (models.Client, error) {
+	if mock.GetClientByClientIDFunc == nil {
+		panic("mocks: ClientRepository.GetClientByClientID is not mocked")
+	}
+	return mock.GetClientByClientIDFunc(clientID)
+}
+
+func (mock *ClientRepository) GetClientByCertificateIdentity(identity string) (models.Client, error) {
+	if mock.GetClientByCertificateIdentityFunc == nil {
+		panic("mocks: ClientRepository.GetClientByCertificateIdentity is not mocked")
+	}
+	return mock.GetClientByCertificateIdentityFunc(identity)
+}
+
+func (mock *ClientRepository) Create(client *models.Client) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: ClientRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(client)
+}
+
+func (mock *ClientRepository) Revoke(client *models.Client, now time.Time) (err error) {
+	if mock.RevokeFunc == nil {
+		panic("mocks: ClientRepository.Revoke is not mocked")
+	}
+	return mock.RevokeFunc(client, now)
+}
+
+func (mock *ClientRepository) Touch(client *models.Client, now time.Time) (err error) {
+	if mock.TouchFunc == nil {
+		panic("mocks: ClientRepository.Touch is not mocked")
+	}
+	return mock.TouchFunc(client, now)
+}
+
+// CommentRepository is a mock of repositories.ICommentRepository
+type CommentRepository struct {
+	MigrateFunc            func() error
+	ExportUserDataFunc     func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc      func(userID uint) error
+	FindByIDFunc           func(ID uint) (models.Comment, error)
+	FindByIDsFunc          func(IDs []uint) (records []models.Comment, err error)
+	ListFunc               func(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Comment, totalCount int64, err error)
+	ChunkFunc              func(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Comment, err error)
+	FindEachFunc           func(ctx context.Context, batchSize int, handle func(records []models.Comment) error, filters ...func(db *gorm.DB) *gorm.DB) (err error)
+	StreamFunc             func(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.Comment, errs <-chan error)
+	CreateFunc             func(record *models.Comment) (err error)
+	UpdateFunc             func(record *models.Comment, updates map[string]interface{}) (err error)
+	UpdateFieldsFunc       func(record *models.Comment, fields ...string) (err error)
+	DeleteFunc             func(record *models.Comment) (err error)
+	OnShardFunc            func(key infrastructures.ShardKey) repositories.IBaseRepository[models.Comment]
+	ExistsFunc             func(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error)
+	CountFunc              func(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error)
+	GetCommentByIDFunc     func(ID uint) (models.Comment, error)
+	GetThreadsFunc         func(commentableType string, commentableID uint, pagination scopes.GormPager, order scopes.GormOrderer) (comments []models.Comment, totalCount int64, err error)
+	GetModerationQueueFunc func(pagination scopes.GormPager) (comments []models.Comment, totalCount int64, err error)
+	HasFlaggedFunc         func(commentID uint, userID uint) (bool, error)
+	UpdatesFunc            func(comment *models.Comment, updates map[string]interface{}) (err error)
+	FlagFunc               func(comment *models.Comment, flag *models.CommentFlag, hideAt int) (err error)
+}
+
+var _ repositories.ICommentRepository = (*CommentRepository)(nil)
+
+func (mock *CommentRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: CommentRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *CommentRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: CommentRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *CommentRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: CommentRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *CommentRepository) FindByID(ID uint) (models.Comment, error) {
+	if mock.FindByIDFunc == nil {
+		panic("mocks: CommentRepository.FindByID is not mocked")
+	}
+	return mock.FindByIDFunc(ID)
+}
+
+func (mock *CommentRepository) FindByIDs(IDs []uint) (records []models.Comment, err error) {
+	if mock.FindByIDsFunc == nil {
+		panic("mocks: CommentRepository.FindByIDs is not mocked")
+	}
+	return mock.FindByIDsFunc(IDs)
+}
+
+func (mock *CommentRepository) List(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Comment, totalCount int64, err error) {
+	if mock.ListFunc == nil {
+		panic("mocks: CommentRepository.List is not mocked")
+	}
+	return mock.ListFunc(pagination, filters...)
+}
+
+func (mock *CommentRepository) Chunk(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Comment, err error) {
+	if mock.ChunkFunc == nil {
+		panic("mocks: CommentRepository.Chunk is not mocked")
+	}
+	return mock.ChunkFunc(afterID, limit, filters...)
+}
+
+func (mock *CommentRepository) FindEach(ctx context.Context, batchSize int, handle func(records []models.Comment) error, filters ...func(db *gorm.DB) *gorm.DB) (err error) {
+	if mock.FindEachFunc == nil {
+		panic("mocks: CommentRepository.FindEach is not mocked")
+	}
+	return mock.FindEachFunc(ctx, batchSize, handle, filters...)
+}
+
+func (mock *CommentRepository) Stream(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.Comment, errs <-chan error) {
+	if mock.StreamFunc == nil {
+		panic("mocks: CommentRepository.Stream is not mocked")
+	}
+	return mock.StreamFunc(ctx, batchSize, filters...)
+}
+
+func (mock *CommentRepository) Create(record *models.Comment) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: CommentRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(record)
+}
+
+func (mock *CommentRepository) Update(record *models.Comment, updates map[string]interface{}) (err error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: CommentRepository.Update is not mocked")
+	}
+	return mock.UpdateFunc(record, updates)
+}
+
+func (mock *CommentRepository) UpdateFields(record *models.Comment, fields ...string) (err error) {
+	if mock.UpdateFieldsFunc == nil {
+		panic("mocks: CommentRepository.UpdateFields is not mocked")
+	}
+	return mock.UpdateFieldsFunc(record, fields...)
+}
+
+func (mock *CommentRepository) Delete(record *models.Comment) (err error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: CommentRepository.Delete is not mocked")
+	}
+	return mock.DeleteFunc(record)
+}
+
+func (mock *CommentRepository) OnShard(key infrastructures.ShardKey) repositories.IBaseRepository[models.Comment] {
+	if mock.OnShardFunc == nil {
+		panic("mocks: CommentRepository.OnShard is not mocked")
+	}
+	return mock.OnShardFunc(key)
+}
+
+func (mock *CommentRepository) Exists(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error) {
+	if mock.ExistsFunc == nil {
+		panic("mocks: CommentRepository.Exists is not mocked")
+	}
+	return mock.ExistsFunc(filters...)
+}
+
+func (mock *CommentRepository) Count(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error) {
+	if mock.CountFunc == nil {
+		panic("mocks: CommentRepository.Count is not mocked")
+	}
+	return mock.CountFunc(filters...)
+}
+
+func (mock *CommentRepository) GetCommentByID(ID uint) (models.Comment, error) {
+	if mock.GetCommentByIDFunc == nil {
+		panic("mocks: CommentRepository.GetCommentByID is not mocked")
+	}
+	return mock.GetCommentByIDFunc(ID)
+}
+
+func (mock *CommentRepository) GetThreads(commentableType string, commentableID uint, pagination scopes.GormPager, order scopes.GormOrderer) (comments []models.Comment, totalCount int64, err error) {
+	if mock.GetThreadsFunc == nil {
+		panic("mocks: CommentRepository.GetThreads is not mocked")
+	}
+	return mock.GetThreadsFunc(commentableType, commentableID, pagination, order)
+}
+
+func (mock *CommentRepository) GetModerationQueue(pagination scopes.GormPager) (comments []models.Comment, totalCount int64, err error) {
+	if mock.GetModerationQueueFunc == nil {
+		panic("mocks: CommentRepository.GetModerationQueue is not mocked")
+	}
+	return mock.GetModerationQueueFunc(pagination)
+}
+
+func (mock *CommentRepository) HasFlagged(commentID uint, userID uint) (bool, error) {
+	if mock.HasFlaggedFunc == nil {
+		panic("mocks: CommentRepository.HasFlagged is not mocked")
+	}
+	return mock.HasFlaggedFunc(commentID, userID)
+}
+
+func (mock *CommentRepository) Updates(comment *models.Comment, updates map[string]interface{}) (err error) {
+	if mock.UpdatesFunc == nil {
+		panic("mocks: CommentRepository.Updates is not mocked")
+	}
+	return mock.UpdatesFunc(comment, updates)
+}
+
+func (mock *CommentRepository) Flag(comment *models.Comment, flag *models.CommentFlag, hideAt int) (err error) {
+	if mock.FlagFunc == nil {
+		panic("mocks: CommentRepository.Flag is not mocked")
+	}
+	return mock.FlagFunc(comment, flag, hideAt)
+}
+
+// ConfigSnapshotRepository is a mock of repositories.IConfigSnapshotRepository
+type ConfigSnapshotRepository struct {
+	MigrateFunc     func() error
+	RecordFunc      func(snapshot *models.ConfigSnapshot) (err error)
+	GetPreviousFunc func(checksum string) (snapshot models.ConfigSnapshot, err error)
+}
+
+var _ repositories.IConfigSnapshotRepository = (*ConfigSnapshotRepository)(nil)
+
+func (mock *ConfigSnapshotRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: ConfigSnapshotRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *ConfigSnapshotRepository) Record(snapshot *models.ConfigSnapshot) (err error) {
+	if mock.RecordFunc == nil {
+		panic("mocks: ConfigSnapshotRepository.Record is not mocked")
+	}
+	return mock.RecordFunc(snapshot)
+}
+
+func (mock *ConfigSnapshotRepository) GetPrevious(checksum string) (snapshot models.ConfigSnapshot, err error) {
+	if mock.GetPreviousFunc == nil {
+		panic("mocks: ConfigSnapshotRepository.GetPrevious is not mocked")
+	}
+	return mock.GetPreviousFunc(checksum)
+}
+
+// ConversationRepository is a mock of repositories.IConversationRepository
+type ConversationRepository struct {
+	MigrateFunc              func() error
+	ExportUserDataFunc       func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc        func(userID uint) error
+	GetParticipantFunc       func(conversationID uint, userID uint) (participant models.ConversationParticipant, err error)
+	GetParticipantsFunc      func(conversationIDs ...uint) (participants []models.ConversationParticipant, err error)
+	GetUserConversationsFunc func(userID uint, pagination scopes.GormPager) (conversations []models.Conversation, totalCount int64, err error)
+	GetMessagesFunc          func(conversationID uint, beforeID uint, limit int) (messages []models.Message, err error)
+	GetLatestMessageIDFunc   func(conversationID uint) (messageID uint, err error)
+	GetUnreadCountsFunc      func(userID uint, conversationIDs ...uint) (unread []models.ConversationUnread, err error)
+	CreateConversationFunc   func(conversation *models.Conversation, userIDs []uint) error
+	CreateMessageFunc        func(message *models.Message) error
+	MarkReadFunc             func(conversationID uint, userID uint, messageID uint) error
+}
+
+var _ repositories.IConversationRepository = (*ConversationRepository)(nil)
+
+func (mock *ConversationRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: ConversationRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *ConversationRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: ConversationRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *ConversationRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: ConversationRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *ConversationRepository) GetParticipant(conversationID uint, userID uint) (participant models.ConversationParticipant, err error) {
+	if mock.GetParticipantFunc == nil {
+		panic("mocks: ConversationRepository.GetParticipant is not mocked")
+	}
+	return mock.GetParticipantFunc(conversationID, userID)
+}
+
+func (mock *ConversationRepository) GetParticipants(conversationIDs ...uint) (participants []models.ConversationParticipant, err error) {
+	if mock.GetParticipantsFunc == nil {
+		panic("mocks: ConversationRepository.GetParticipants is not mocked")
+	}
+	return mock.GetParticipantsFunc(conversationIDs...)
+}
+
+func (mock *ConversationRepository) GetUserConversations(userID uint, pagination scopes.GormPager) (conversations []models.Conversation, totalCount int64, err error) {
+	if mock.GetUserConversationsFunc == nil {
+		panic("mocks: ConversationRepository.GetUserConversations is not mocked")
+	}
+	return mock.GetUserConversationsFunc(userID, pagination)
+}
+
+func (mock *ConversationRepository) GetMessages(conversationID uint, beforeID uint, limit int) (messages []models.Message, err error) {
+	if mock.GetMessagesFunc == nil {
+		panic("mocks: ConversationRepository.GetMessages is not mocked")
+	}
+	return mock.GetMessagesFunc(conversationID, beforeID, limit)
+}
+
+func (mock *ConversationRepository) GetLatestMessageID(conversationID uint) (messageID uint, err error) {
+	if mock.GetLatestMessageIDFunc == nil {
+		panic("mocks: ConversationRepository.GetLatestMessageID is not mocked")
+	}
+	return mock.GetLatestMessageIDFunc(conversationID)
+}
+
+func (mock *ConversationRepository) GetUnreadCounts(userID uint, conversationIDs ...uint) (unread []models.ConversationUnread, err error) {
+	if mock.GetUnreadCountsFunc == nil {
+		panic("mocks: ConversationRepository.GetUnreadCounts is not mocked")
+	}
+	return mock.GetUnreadCountsFunc(userID, conversationIDs...)
+}
+
+func (mock *ConversationRepository) CreateConversation(conversation *models.Conversation, userIDs []uint) error {
+	if mock.CreateConversationFunc == nil {
+		panic("mocks: ConversationRepository.CreateConversation is not mocked")
+	}
+	return mock.CreateConversationFunc(conversation, userIDs)
+}
+
+func (mock *ConversationRepository) CreateMessage(message *models.Message) error {
+	if mock.CreateMessageFunc == nil {
+		panic("mocks: ConversationRepository.CreateMessage is not mocked")
+	}
+	return mock.CreateMessageFunc(message)
+}
+
+func (mock *ConversationRepository) MarkRead(conversationID uint, userID uint, messageID uint) error {
+	if mock.MarkReadFunc == nil {
+		panic("mocks: ConversationRepository.MarkRead is not mocked")
+	}
+	return mock.MarkReadFunc(conversationID, userID, messageID)
+}
+
+// CouponRepository is a mock of repositories.ICouponRepository
+type CouponRepository struct {
+	MigrateFunc          func() error
+	ExportUserDataFunc   func(userID uint) (data interface{}, err error)
+	GetCouponsFunc       func() (coupons []models.Coupon, err error)
+	GetCouponByIDFunc    func(ID uint) (models.Coupon, error)
+	GetCouponByCodeFunc  func(code string) (models.Coupon, error)
+	CountRedemptionsFunc func(couponID uint, userID uint) (count int64, err error)
+	CreateFunc           func(coupon *models.Coupon) (err error)
+	UpdatesFunc          func(coupon *models.Coupon, updates map[string]interface{}) (err error)
+	RedeemFunc           func(coupon *models.Coupon, redemption *models.CouponRedemption) (err error)
+}
+
+var _ repositories.ICouponRepository = (*CouponRepository)(nil)
+
+func (mock *CouponRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: CouponRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *CouponRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: CouponRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *CouponRepository) GetCoupons() (coupons []models.Coupon, err error) {
+	if mock.GetCouponsFunc == nil {
+		panic("mocks: CouponRepository.GetCoupons is not mocked")
+	}
+	return mock.GetCouponsFunc()
+}
+
+func (mock *CouponRepository) GetCouponByID(ID uint) (models.Coupon, error) {
+	if mock.GetCouponByIDFunc == nil {
+		panic("mocks: CouponRepository.GetCouponByID is not mocked")
+	}
+	return mock.GetCouponByIDFunc(ID)
+}
+
+func (mock *CouponRepository) GetCouponByCode(code string) (models.Coupon, error) {
+	if mock.GetCouponByCodeFunc == nil {
+		panic("mocks: CouponRepository.GetCouponByCode is not mocked")
+	}
+	return mock.GetCouponByCodeFunc(code)
+}
+
+func (mock *CouponRepository) CountRedemptions(couponID uint, userID uint) (count int64, err error) {
+	if mock.CountRedemptionsFunc == nil {
+		panic("mocks: CouponRepository.CountRedemptions is not mocked")
+	}
+	return mock.CountRedemptionsFunc(couponID, userID)
+}
+
+func (mock *CouponRepository) Create(coupon *models.Coupon) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: CouponRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(coupon)
+}
+
+func (mock *CouponRepository) Updates(coupon *models.Coupon, updates map[string]interface{}) (err error) {
+	if mock.UpdatesFunc == nil {
+		panic("mocks: CouponRepository.Updates is not mocked")
+	}
+	return mock.UpdatesFunc(coupon, updates)
+}
+
+func (mock *CouponRepository) Redeem(coupon *models.Coupon, redemption *models.CouponRedemption) (err error) {
+	if mock.RedeemFunc == nil {
+		panic("mocks: CouponRepository.Redeem is not mocked")
+	}
+	return mock.RedeemFunc(coupon, redemption)
+}
+
+// DataExportRepository is a mock of repositories.IDataExportRepository
+type DataExportRepository struct {
+	MigrateFunc                func() error
+	ExportUserDataFunc         func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc          func(userID uint) error
+	GetDataExportByIDFunc      func(ID uint) (models.DataExport, error)
+	GetDataExportsByUserIDFunc func(userID uint) (dataExports []models.DataExport, err error)
+	GetExpiredDataExportsFunc  func(now time.Time) (dataExports []models.DataExport, err error)
+	CreateFunc                 func(dataExport *models.DataExport) (err error)
+	SaveFunc                   func(dataExport *models.DataExport) (err error)
+	DeleteFunc                 func(dataExport *models.DataExport) (err error)
+}
+
+var _ repositories.IDataExportRepository = (*DataExportRepository)(nil)
+
+func (mock *DataExportRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: DataExportRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *DataExportRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: DataExportRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *DataExportRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: DataExportRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *DataExportRepository) GetDataExportByID(ID uint) (models.DataExport, error) {
+	if mock.GetDataExportByIDFunc == nil {
+		panic("mocks: DataExportRepository.GetDataExportByID is not mocked")
+	}
+	return mock.GetDataExportByIDFunc(ID)
+}
+
+func (mock *DataExportRepository) GetDataExportsByUserID(userID uint) (dataExports []models.DataExport, err error) {
+	if mock.GetDataExportsByUserIDFunc == nil {
+		panic("mocks: DataExportRepository.GetDataExportsByUserID is not mocked")
+	}
+	return mock.GetDataExportsByUserIDFunc(userID)
+}
+
+func (mock *DataExportRepository) GetExpiredDataExports(now time.Time) (dataExports []models.DataExport, err error) {
+	if mock.GetExpiredDataExportsFunc == nil {
+		panic("mocks: DataExportRepository.GetExpiredDataExports is not mocked")
+	}
+	return mock.GetExpiredDataExportsFunc(now)
+}
+
+func (mock *DataExportRepository) Create(dataExport *models.DataExport) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: DataExportRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(dataExport)
+}
+
+func (mock *DataExportRepository) Save(dataExport *models.DataExport) (err error) {
+	if mock.SaveFunc == nil {
+		panic("mocks: DataExportRepository.Save is not mocked")
+	}
+	return mock.SaveFunc(dataExport)
+}
+
+func (mock *DataExportRepository) Delete(dataExport *models.DataExport) (err error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: DataExportRepository.Delete is not mocked")
+	}
+	return mock.DeleteFunc(dataExport)
+}
+
+// DeadLetterRepository is a mock of repositories.IDeadLetterRepository
+type DeadLetterRepository struct {
+	MigrateFunc              func() error
+	RecordFunc               func(letter *models.DeadLetter) (err error)
+	GetDeadLettersFunc       func(filter repositories.DeadLetterFilter, pagination scopes.GormPager) (letters []models.DeadLetter, totalCount int64, err error)
+	GetOldestDeadLettersFunc func(filter repositories.DeadLetterFilter, limit int) (letters []models.DeadLetter, err error)
+	GetDeadLetterByIDFunc    func(ID uint) (models.DeadLetter, error)
+	SaveFunc                 func(letter *models.DeadLetter) (err error)
+	DeleteFunc               func(letter *models.DeadLetter) (err error)
+	DeleteByEventIDFunc      func(kind string, eventID string) (err error)
+	PurgeFunc                func(filter repositories.DeadLetterFilter) (deleted int64, err error)
+	CountBySourceFunc        func() (counts []repositories.DeadLetterCount, err error)
+}
+
+var _ repositories.IDeadLetterRepository = (*DeadLetterRepository)(nil)
+
+func (mock *DeadLetterRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: DeadLetterRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *DeadLetterRepository) Record(letter *models.DeadLetter) (err error) {
+	if mock.RecordFunc == nil {
+		panic("mocks: DeadLetterRepository.Record is not mocked")
+	}
+	return mock.RecordFunc(letter)
+}
+
+func (mock *DeadLetterRepository) GetDeadLetters(filter repositories.DeadLetterFilter, pagination scopes.GormPager) (letters []models.DeadLetter, totalCount int64, err error) {
+	if mock.GetDeadLettersFunc == nil {
+		panic("mocks: DeadLetterRepository.GetDeadLetters is not mocked")
+	}
+	return mock.GetDeadLettersFunc(filter, pagination)
+}
+
+func (mock *DeadLetterRepository) GetOldestDeadLetters(filter repositories.DeadLetterFilter, limit int) (letters []models.DeadLetter, err error) {
+	if mock.GetOldestDeadLettersFunc == nil {
+		panic("mocks: DeadLetterRepository.GetOldestDeadLetters is not mocked")
+	}
+	return mock.GetOldestDeadLettersFunc(filter, limit)
+}
+
+func (mock *DeadLetterRepository) GetDeadLetterByID(ID uint) (models.DeadLetter, error) {
+	if mock.GetDeadLetterByIDFunc == nil {
+		panic("mocks: DeadLetterRepository.GetDeadLetterByID is not mocked")
+	}
+	return mock.GetDeadLetterByIDFunc(ID)
+}
+
+func (mock *DeadLetterRepository) Save(letter *models.DeadLetter) (err error) {
+	if mock.SaveFunc == nil {
+		panic("mocks: DeadLetterRepository.Save is not mocked")
+	}
+	return mock.SaveFunc(letter)
+}
+
+func (mock *DeadLetterRepository) Delete(letter *models.DeadLetter) (err error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: DeadLetterRepository.Delete is not mocked")
+	}
+	return mock.DeleteFunc(letter)
+}
+
+func (mock *DeadLetterRepository) DeleteByEventID(kind string, eventID string) (err error) {
+	if mock.DeleteByEventIDFunc == nil {
+		panic("mocks: DeadLetterRepository.DeleteByEventID is not mocked")
+	}
+	return mock.DeleteByEventIDFunc(kind, eventID)
+}
+
+func (mock *DeadLetterRepository) Purge(filter repositories.DeadLetterFilter) (deleted int64, err error) {
+	if mock.PurgeFunc == nil {
+		panic("mocks: DeadLetterRepository.Purge is not mocked")
+	}
+	return mock.PurgeFunc(filter)
+}
+
+func (mock *DeadLetterRepository) CountBySource() (counts []repositories.DeadLetterCount, err error) {
+	if mock.CountBySourceFunc == nil {
+		panic("mocks: DeadLetterRepository.CountBySource is not mocked")
+	}
+	return mock.CountBySourceFunc()
+}
+
+// DeprecationRepository is a mock of repositories.IDeprecationRepository
+type DeprecationRepository struct {
+	MigrateFunc   func() error
+	IncrementFunc func(route string, caller string, now time.Time) (err error)
+	GetCallsFunc  func(routes []string, since time.Time) (calls []models.DeprecatedCall, err error)
+}
+
+var _ repositories.IDeprecationRepository = (*DeprecationRepository)(nil)
+
+func (mock *DeprecationRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: DeprecationRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *DeprecationRepository) Increment(route string, caller string, now time.Time) (err error) {
+	if mock.IncrementFunc == nil {
+		panic("mocks: DeprecationRepository.Increment is not mocked")
+	}
+	return mock.IncrementFunc(route, caller, now)
+}
+
+func (mock *DeprecationRepository) GetCalls(routes []string, since time.Time) (calls []models.DeprecatedCall, err error) {
+	if mock.GetCallsFunc == nil {
+		panic("mocks: DeprecationRepository.GetCalls is not mocked")
+	}
+	return mock.GetCallsFunc(routes, since)
+}
+
+// DeviceRepository is a mock of repositories.IDeviceRepository
+type DeviceRepository struct {
+	MigrateFunc        func() error
+	ExportUserDataFunc func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc  func(userID uint) error
+	GetDeviceByIDFunc  func(ID uint) (device models.Device, err error)
+	GetUserDevicesFunc func(userID uint) (devices []models.Device, err error)
+	GetOptOutsFunc     func(userID uint) (categories []string, err error)
+	RegisterFunc       func(device *models.Device) error
+	DeleteFunc         func(device *models.Device) error
+	DeleteByTokenFunc  func(token string) error
+	TouchFunc          func(deviceID uint, at time.Time) error
+	SetOptOutsFunc     func(userID uint, categories []string) error
+}
+
+var _ repositories.IDeviceRepository = (*DeviceRepository)(nil)
+
+func (mock *DeviceRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: DeviceRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *DeviceRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: DeviceRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *DeviceRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: DeviceRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *DeviceRepository) GetDeviceByID(ID uint) (device models.Device, err error) {
+	if mock.GetDeviceByIDFunc == nil {
+		panic("mocks: DeviceRepository.GetDeviceByID is not mocked")
+	}
+	return mock.GetDeviceByIDFunc(ID)
+}
+
+func (mock *DeviceRepository) GetUserDevices(userID uint) (devices []models.Device, err error) {
+	if mock.GetUserDevicesFunc == nil {
+		panic("mocks: DeviceRepository.GetUserDevices is not mocked")
+	}
+	return mock.GetUserDevicesFunc(userID)
+}
+
+func (mock *DeviceRepository) GetOptOuts(userID uint) (categories []string, err error) {
+	if mock.GetOptOutsFunc == nil {
+		panic("mocks: DeviceRepository.GetOptOuts is not mocked")
+	}
+	return mock.GetOptOutsFunc(userID)
+}
+
+func (mock *DeviceRepository) Register(device *models.Device) error {
+	if mock.RegisterFunc == nil {
+		panic("mocks: DeviceRepository.Register is not mocked")
+	}
+	return mock.RegisterFunc(device)
+}
+
+func (mock *DeviceRepository) Delete(device *models.Device) error {
+	if mock.DeleteFunc == nil {
+		panic("mocks: DeviceRepository.Delete is not mocked")
+	}
+	return mock.DeleteFunc(device)
+}
+
+func (mock *DeviceRepository) DeleteByToken(token string) error {
+	if mock.DeleteByTokenFunc == nil {
+		panic("mocks: DeviceRepository.DeleteByToken is not mocked")
+	}
+	return mock.DeleteByTokenFunc(token)
+}
+
+func (mock *DeviceRepository) Touch(deviceID uint, at time.Time) error {
+	if mock.TouchFunc == nil {
+		panic("mocks: DeviceRepository.Touch is not mocked")
+	}
+	return mock.TouchFunc(deviceID, at)
+}
+
+func (mock *DeviceRepository) SetOptOuts(userID uint, categories []string) error {
+	if mock.SetOptOutsFunc == nil {
+		panic("mocks: DeviceRepository.SetOptOuts is not mocked")
+	}
+	return mock.SetOptOutsFunc(userID, categories)
+}
+
+// EmailChangeRepository is a mock of repositories.IEmailChangeRepository
+type EmailChangeRepository struct {
+	MigrateFunc                   func() error
+	ExportUserDataFunc            func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc             func(userID uint) error
+	GetEmailChangeByTokenHashFunc func(tokenHash string) (models.EmailChange, error)
+	CreateFunc                    func(emailChange *models.EmailChange) (err error)
+	ConfirmFunc                   func(emailChange *models.EmailChange, now time.Time) (err error)
+	DeletePendingFunc             func(userID uint) (err error)
+}
+
+var _ repositories.IEmailChangeRepository = (*EmailChangeRepository)(nil)
+
+func (mock *EmailChangeRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: EmailChangeRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *EmailChangeRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: EmailChangeRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *EmailChangeRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: EmailChangeRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *EmailChangeRepository) GetEmailChangeByTokenHash(tokenHash string) (models.EmailChange, error) {
+	if mock.GetEmailChangeByTokenHashFunc == nil {
+		panic("mocks: EmailChangeRepository.GetEmailChangeByTokenHash is not mocked")
+	}
+	return mock.GetEmailChangeByTokenHashFunc(tokenHash)
+}
+
+func (mock *EmailChangeRepository) Create(emailChange *models.EmailChange) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: EmailChangeRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(emailChange)
+}
+
+func (mock *EmailChangeRepository) Confirm(emailChange *models.EmailChange, now time.Time) (err error) {
+	if mock.ConfirmFunc == nil {
+		panic("mocks: EmailChangeRepository.Confirm is not mocked")
+	}
+	return mock.ConfirmFunc(emailChange, now)
+}
+
+func (mock *EmailChangeRepository) DeletePending(userID uint) (err error) {
+	if mock.DeletePendingFunc == nil {
+		panic("mocks: EmailChangeRepository.DeletePending is not mocked")
+	}
+	return mock.DeletePendingFunc(userID)
+}
+
+// FollowRepository is a mock of repositories.IFollowRepository
+type FollowRepository struct {
+	MigrateFunc        func() error
+	ExportUserDataFunc func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc  func(userID uint) error
+	IsFollowingFunc    func(followerID uint, followedID uint) (bool, error)
+	CountFollowersFunc func(userID uint) (count int64, err error)
+	CountFollowingFunc func(userID uint) (count int64, err error)
+	GetFollowersFunc   func(userID uint, pagination scopes.GormPager) (users []models.User, totalCount int64, err error)
+	GetFollowingFunc   func(userID uint, pagination scopes.GormPager) (users []models.User, totalCount int64, err error)
+	FollowFunc         func(followerID uint, followedID uint) (created bool, err error)
+	UnfollowFunc       func(followerID uint, followedID uint) (deleted bool, err error)
+}
+
+var _ repositories.IFollowRepository = (*FollowRepository)(nil)
+
+func (mock *FollowRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: FollowRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *FollowRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: FollowRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *FollowRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: FollowRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *FollowRepository) IsFollowing(followerID uint, followedID uint) (bool, error) {
+	if mock.IsFollowingFunc == nil {
+		panic("mocks: FollowRepository.IsFollowing is not mocked")
+	}
+	return mock.IsFollowingFunc(followerID, followedID)
+}
+
+func (mock *FollowRepository) CountFollowers(userID uint) (count int64, err error) {
+	if mock.CountFollowersFunc == nil {
+		panic("mocks: FollowRepository.CountFollowers is not mocked")
+	}
+	return mock.CountFollowersFunc(userID)
+}
+
+func (mock *FollowRepository) CountFollowing(userID uint) (count int64, err error) {
+	if mock.CountFollowingFunc == nil {
+		panic("mocks: FollowRepository.CountFollowing is not mocked")
+	}
+	return mock.CountFollowingFunc(userID)
+}
+
+func (mock *FollowRepository) GetFollowers(userID uint, pagination scopes.GormPager) (users []models.User, totalCount int64, err error) {
+	if mock.GetFollowersFunc == nil {
+		panic("mocks: FollowRepository.GetFollowers is not mocked")
+	}
+	return mock.GetFollowersFunc(userID, pagination)
+}
+
+func (mock *FollowRepository) GetFollowing(userID uint, pagination scopes.GormPager) (users []models.User, totalCount int64, err error) {
+	if mock.GetFollowingFunc == nil {
+		panic("mocks: FollowRepository.GetFollowing is not mocked")
+	}
+	return mock.GetFollowingFunc(userID, pagination)
+}
+
+func (mock *FollowRepository) Follow(followerID uint, followedID uint) (created bool, err error) {
+	if mock.FollowFunc == nil {
+		panic("mocks: FollowRepository.Follow is not mocked")
+	}
+	return mock.FollowFunc(followerID, followedID)
+}
+
+func (mock *FollowRepository) Unfollow(followerID uint, followedID uint) (deleted bool, err error) {
+	if mock.UnfollowFunc == nil {
+		panic("mocks: FollowRepository.Unfollow is not mocked")
+	}
+	return mock.UnfollowFunc(followerID, followedID)
+}
+
+// InvitationRepository is a mock of repositories.IInvitationRepository
+type InvitationRepository struct {
+	MigrateFunc             func() error
+	GetInvitationsFunc      func() (invitations []models.Invitation, err error)
+	GetInvitationByIDFunc   func(ID uint) (models.Invitation, error)
+	GetInvitationByCodeFunc func(code string) (models.Invitation, error)
+	CreateFunc              func(invitation *models.Invitation) (err error)
+	RevokeFunc              func(invitation *models.Invitation, now time.Time) (err error)
+	RedeemFunc              func(invitation *models.Invitation, now time.Time) (err error)
+	ReleaseFunc             func(invitationID uint) (err error)
+}
+
+var _ repositories.IInvitationRepository = (*InvitationRepository)(nil)
+
+func (mock *InvitationRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: InvitationRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *InvitationRepository) GetInvitations() (invitations []models.Invitation, err error) {
+	if mock.GetInvitationsFunc == nil {
+		panic("mocks: InvitationRepository.GetInvitations is not mocked")
+	}
+	return mock.GetInvitationsFunc()
+}
+
+func (mock *InvitationRepository) GetInvitationByID(ID uint) (models.Invitation, error) {
+	if mock.GetInvitationByIDFunc == nil {
+		panic("mocks: InvitationRepository.GetInvitationByID is not mocked")
+	}
+	return mock.GetInvitationByIDFunc(ID)
+}
+
+func (mock *InvitationRepository) GetInvitationByCode(code string) (models.Invitation, error) {
+	if mock.GetInvitationByCodeFunc == nil {
+		panic("mocks: InvitationRepository.GetInvitationByCode is not mocked")
+	}
+	return mock.GetInvitationByCodeFunc(code)
+}
+
+func (mock *InvitationRepository) Create(invitation *models.Invitation) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: InvitationRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(invitation)
+}
+
+func (mock *InvitationRepository) Revoke(invitation *models.Invitation, now time.Time) (err error) {
+	if mock.RevokeFunc == nil {
+		panic("mocks: InvitationRepository.Revoke is not mocked")
+	}
+	return mock.RevokeFunc(invitation, now)
+}
+
+func (mock *InvitationRepository) Redeem(invitation *models.Invitation, now time.Time) (err error) {
+	if mock.RedeemFunc == nil {
+		panic("mocks: InvitationRepository.Redeem is not mocked")
+	}
+	return mock.RedeemFunc(invitation, now)
+}
+
+func (mock *InvitationRepository) Release(invitationID uint) (err error) {
+	if mock.ReleaseFunc == nil {
+		panic("mocks: InvitationRepository.Release is not mocked")
+	}
+	return mock.ReleaseFunc(invitationID)
+}
+
+// MagicLinkRepository is a mock of repositories.IMagicLinkRepository
+type MagicLinkRepository struct {
+	MigrateFunc                 func() error
+	EraseUserDataFunc           func(userID uint) error
+	GetMagicLinkByTokenHashFunc func(tokenHash string) (models.MagicLink, error)
+	CreateFunc                  func(magicLink *models.MagicLink) (err error)
+	UseFunc                     func(magicLink *models.MagicLink, now time.Time) (err error)
+	InvalidateFunc              func(userID uint, now time.Time) (err error)
+}
+
+var _ repositories.IMagicLinkRepository = (*MagicLinkRepository)(nil)
+
+func (mock *MagicLinkRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: MagicLinkRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *MagicLinkRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: MagicLinkRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *MagicLinkRepository) GetMagicLinkByTokenHash(tokenHash string) (models.MagicLink, error) {
+	if mock.GetMagicLinkByTokenHashFunc == nil {
+		panic("mocks: MagicLinkRepository.GetMagicLinkByTokenHash is not mocked")
+	}
+	return mock.GetMagicLinkByTokenHashFunc(tokenHash)
+}
+
+func (mock *MagicLinkRepository) Create(magicLink *models.MagicLink) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: MagicLinkRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(magicLink)
+}
+
+func (mock *MagicLinkRepository) Use(magicLink *models.MagicLink, now time.Time) (err error) {
+	if mock.UseFunc == nil {
+		panic("mocks: MagicLinkRepository.Use is not mocked")
+	}
+	return mock.UseFunc(magicLink, now)
+}
+
+func (mock *MagicLinkRepository) Invalidate(userID uint, now time.Time) (err error) {
+	if mock.InvalidateFunc == nil {
+		panic("mocks: MagicLinkRepository.Invalidate is not mocked")
+	}
+	return mock.InvalidateFunc(userID, now)
+}
+
+// MediaRepository is a mock of repositories.IMediaRepository
+type MediaRepository struct {
+	MigrateFunc                    func() error
+	ExportUserDataFunc             func(userID uint) (data interface{}, err error)
+	StreamUserDataFunc             func(ctx context.Context, userID uint, write func(record interface{}) error) (err error)
+	EraseUserDataFunc              func(userID uint) error
+	FindByIDFunc                   func(ID uint) (models.Media, error)
+	FindByIDsFunc                  func(IDs []uint) (records []models.Media, err error)
+	ListFunc                       func(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Media, totalCount int64, err error)
+	ChunkFunc                      func(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Media, err error)
+	FindEachFunc                   func(ctx context.Context, batchSize int, handle func(records []models.Media) error, filters ...func(db *gorm.DB) *gorm.DB) (err error)
+	StreamFunc                     func(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.Media, errs <-chan error)
+	CreateFunc                     func(record *models.Media) (err error)
+	UpdateFunc                     func(record *models.Media, updates map[string]interface{}) (err error)
+	UpdateFieldsFunc               func(record *models.Media, fields ...string) (err error)
+	DeleteFunc                     func(record *models.Media) (err error)
+	OnShardFunc                    func(key infrastructures.ShardKey) repositories.IBaseRepository[models.Media]
+	ExistsFunc                     func(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error)
+	CountFunc                      func(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error)
+	GetMediaByIDFunc               func(ID uint) (models.Media, error)
+	GetMediaByUserIDFunc           func(userID uint) (media []models.Media, err error)
+	GetUserMediaWithPaginationFunc func(userID uint, pagination scopes.GormPager) (media []models.Media, totalCount int64, err error)
+	GetOrphansFunc                 func(before time.Time, limit int) (media []models.Media, err error)
+	GetUsageFunc                   func(filters ...func(db *gorm.DB) *gorm.DB) (usage models.MediaUsage, err error)
+	GetUsageByUserFunc             func(limit int) (usages []models.MediaUserUsage, err error)
+	GetUsageByMimeFunc             func() (usages []models.MediaMimeUsage, err error)
+	UpdatesFunc                    func(media *models.Media, updates map[string]interface{}) (err error)
+}
+
+var _ repositories.IMediaRepository = (*MediaRepository)(nil)
+
+func (mock *MediaRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: MediaRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *MediaRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: MediaRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *MediaRepository) StreamUserData(ctx context.Context, userID uint, write func(record interface{}) error) (err error) {
+	if mock.StreamUserDataFunc == nil {
+		panic("mocks: MediaRepository.StreamUserData is not mocked")
+	}
+	return mock.StreamUserDataFunc(ctx, userID, write)
+}
+
+func (mock *MediaRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: MediaRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *MediaRepository) FindByID(ID uint) (models.Media, error) {
+	if mock.FindByIDFunc == nil {
+		panic("mocks: MediaRepository.FindByID is not mocked")
+	}
+	return mock.FindByIDFunc(ID)
+}
+
+func (mock *MediaRepository) FindByIDs(IDs []uint) (records []models.Media, err error) {
+	if mock.FindByIDsFunc == nil {
+		panic("mocks: MediaRepository.FindByIDs is not mocked")
+	}
+	return mock.FindByIDsFunc(IDs)
+}
+
+func (mock *MediaRepository) List(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Media, totalCount int64, err error) {
+	if mock.ListFunc == nil {
+		panic("mocks: MediaRepository.List is not mocked")
+	}
+	return mock.ListFunc(pagination, filters...)
+}
+
+func (mock *MediaRepository) Chunk(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Media, err error) {
+	if mock.ChunkFunc == nil {
+		panic("mocks: MediaRepository.Chunk is not mocked")
+	}
+	return mock.ChunkFunc(afterID, limit, filters...)
+}
+
+func (mock *MediaRepository) FindEach(ctx context.Context, batchSize int, handle func(records []models.Media) error, filters ...func(db *gorm.DB) *gorm.DB) (err error) {
+	if mock.FindEachFunc == nil {
+		panic("mocks: MediaRepository.FindEach is not mocked")
+	}
+	return mock.FindEachFunc(ctx, batchSize, handle, filters...)
+}
+
+func (mock *MediaRepository) Stream(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.Media, errs <-chan error) {
+	if mock.StreamFunc == nil {
+		panic("mocks: MediaRepository.Stream is not mocked")
+	}
+	return mock.StreamFunc(ctx, batchSize, filters...)
+}
+
+func (mock *MediaRepository) Create(record *models.Media) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: MediaRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(record)
+}
+
+func (mock *MediaRepository) Update(record *models.Media, updates map[string]interface{}) (err error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: MediaRepository.Update is not mocked")
+	}
+	return mock.UpdateFunc(record, updates)
+}
+
+func (mock *MediaRepository) UpdateFields(record *models.Media, fields ...string) (err error) {
+	if mock.UpdateFieldsFunc == nil {
+		panic("mocks: MediaRepository.UpdateFields is not mocked")
+	}
+	return mock.UpdateFieldsFunc(record, fields...)
+}
+
+func (mock *MediaRepository) Delete(record *models.Media) (err error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: MediaRepository.Delete is not mocked")
+	}
+	return mock.DeleteFunc(record)
+}
+
+func (mock *MediaRepository) OnShard(key infrastructures.ShardKey) repositories.IBaseRepository[models.Media] {
+	if mock.OnShardFunc == nil {
+		panic("mocks: MediaRepository.OnShard is not mocked")
+	}
+	return mock.OnShardFunc(key)
+}
+
+func (mock *MediaRepository) Exists(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error) {
+	if mock.ExistsFunc == nil {
+		panic("mocks: MediaRepository.Exists is not mocked")
+	}
+	return mock.ExistsFunc(filters...)
+}
+
+func (mock *MediaRepository) Count(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error) {
+	if mock.CountFunc == nil {
+		panic("mocks: MediaRepository.Count is not mocked")
+	}
+	return mock.CountFunc(filters...)
+}
+
+func (mock *MediaRepository) GetMediaByID(ID uint) (models.Media, error) {
+	if mock.GetMediaByIDFunc == nil {
+		panic("mocks: MediaRepository.GetMediaByID is not mocked")
+	}
+	return mock.GetMediaByIDFunc(ID)
+}
+
+func (mock *MediaRepository) GetMediaByUserID(userID uint) (media []models.Media, err error) {
+	if mock.GetMediaByUserIDFunc == nil {
+		panic("mocks: MediaRepository.GetMediaByUserID is not mocked")
+	}
+	return mock.GetMediaByUserIDFunc(userID)
+}
+
+func (mock *MediaRepository) GetUserMediaWithPagination(userID uint, pagination scopes.GormPager) (media []models.Media, totalCount int64, err error) {
+	if mock.GetUserMediaWithPaginationFunc == nil {
+		panic("mocks: MediaRepository.GetUserMediaWithPagination is not mocked")
+	}
+	return mock.GetUserMediaWithPaginationFunc(userID, pagination)
+}
+
+func (mock *MediaRepository) GetOrphans(before time.Time, limit int) (media []models.Media, err error) {
+	if mock.GetOrphansFunc == nil {
+		panic("mocks: MediaRepository.GetOrphans is not mocked")
+	}
+	return mock.GetOrphansFunc(before, limit)
+}
+
+func (mock *MediaRepository) GetUsage(filters ...func(db *gorm.DB) *gorm.DB) (usage models.MediaUsage, err error) {
+	if mock.GetUsageFunc == nil {
+		panic("mocks: MediaRepository.GetUsage is not mocked")
+	}
+	return mock.GetUsageFunc(filters...)
+}
+
+func (mock *MediaRepository) GetUsageByUser(limit int) (usages []models.MediaUserUsage, err error) {
+	if mock.GetUsageByUserFunc == nil {
+		panic("mocks: MediaRepository.GetUsageByUser is not mocked")
+	}
+	return mock.GetUsageByUserFunc(limit)
+}
+
+func (mock *MediaRepository) GetUsageByMime() (usages []models.MediaMimeUsage, err error) {
+	if mock.GetUsageByMimeFunc == nil {
+		panic("mocks: MediaRepository.GetUsageByMime is not mocked")
+	}
+	return mock.GetUsageByMimeFunc()
+}
+
+func (mock *MediaRepository) Updates(media *models.Media, updates map[string]interface{}) (err error) {
+	if mock.UpdatesFunc == nil {
+		panic("mocks: MediaRepository.Updates is not mocked")
+	}
+	return mock.UpdatesFunc(media, updates)
+}
+
+// PolicyRepository is a mock of repositories.IPolicyRepository
+type PolicyRepository struct {
+	MigrateFunc              func() error
+	SeedFunc                 func() error
+	ExportUserDataFunc       func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc        func(userID uint) error
+	GetPolicyByIDFunc        func(ID uint) (models.Policy, error)
+	GetCurrentPoliciesFunc   func(now time.Time) (policies []models.Policy, err error)
+	GetAcceptedPolicyIDsFunc func(userID uint, policyIDs []uint) (acceptedIDs []uint, err error)
+	CreateFunc               func(policy *models.Policy) (err error)
+	CreateConsentFunc        func(consent *models.Consent) (err error)
+}
+
+var _ repositories.IPolicyRepository = (*PolicyRepository)(nil)
+
+func (mock *PolicyRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: PolicyRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *PolicyRepository) Seed() error {
+	if mock.SeedFunc == nil {
+		panic("mocks: PolicyRepository.Seed is not mocked")
+	}
+	return mock.SeedFunc()
+}
+
+func (mock *PolicyRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: PolicyRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *PolicyRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: PolicyRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *PolicyRepository) GetPolicyByID(ID uint) (models.Policy, error) {
+	if mock.GetPolicyByIDFunc == nil {
+		panic("mocks: PolicyRepository.GetPolicyByID is not mocked")
+	}
+	return mock.GetPolicyByIDFunc(ID)
+}
+
+func (mock *PolicyRepository) GetCurrentPolicies(now time.Time) (policies []models.Policy, err error) {
+	if mock.GetCurrentPoliciesFunc == nil {
+		panic("mocks: PolicyRepository.GetCurrentPolicies is not mocked")
+	}
+	return mock.GetCurrentPoliciesFunc(now)
+}
+
+func (mock *PolicyRepository) GetAcceptedPolicyIDs(userID uint, policyIDs []uint) (acceptedIDs []uint, err error) {
+	if mock.GetAcceptedPolicyIDsFunc == nil {
+		panic("mocks: PolicyRepository.GetAcceptedPolicyIDs is not mocked")
+	}
+	return mock.GetAcceptedPolicyIDsFunc(userID, policyIDs)
+}
+
+func (mock *PolicyRepository) Create(policy *models.Policy) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: PolicyRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(policy)
+}
+
+func (mock *PolicyRepository) CreateConsent(consent *models.Consent) (err error) {
+	if mock.CreateConsentFunc == nil {
+		panic("mocks: PolicyRepository.CreateConsent is not mocked")
+	}
+	return mock.CreateConsentFunc(consent)
+}
+
+// ReportRepository is a mock of repositories.IReportRepository
+type ReportRepository struct {
+	MigrateFunc        func() error
+	ExportUserDataFunc func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc  func(userID uint) error
+	FindByIDFunc       func(ID uint) (models.Report, error)
+	FindByIDsFunc      func(IDs []uint) (records []models.Report, err error)
+	ListFunc           func(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Report, totalCount int64, err error)
+	ChunkFunc          func(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Report, err error)
+	FindEachFunc       func(ctx context.Context, batchSize int, handle func(records []models.Report) error, filters ...func(db *gorm.DB) *gorm.DB) (err error)
+	StreamFunc         func(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.Report, errs <-chan error)
+	CreateFunc         func(record *models.Report) (err error)
+	UpdateFunc         func(record *models.Report, updates map[string]interface{}) (err error)
+	UpdateFieldsFunc   func(record *models.Report, fields ...string) (err error)
+	DeleteFunc         func(record *models.Report) (err error)
+	OnShardFunc        func(key infrastructures.ShardKey) repositories.IBaseRepository[models.Report]
+	ExistsFunc         func(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error)
+	CountFunc          func(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error)
+	GetReportByIDFunc  func(ID uint) (models.Report, error)
+	GetQueueFunc       func(status string, pagination scopes.GormPager) (reports []models.Report, totalCount int64, err error)
+	HasOpenReportFunc  func(reporterID uint, reportableType string, reportableID uint) (bool, error)
+	CountUpheldFunc    func(offenderID uint, since time.Time) (count int64, err error)
+	CloseFunc          func(report *models.Report, updates map[string]interface{}) (closed bool, err error)
+}
+
+var _ repositories.IReportRepository = (*ReportRepository)(nil)
+
+func (mock *ReportRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: ReportRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *ReportRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: ReportRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *ReportRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: ReportRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *ReportRepository) FindByID(ID uint) (models.Report, error) {
+	if mock.FindByIDFunc == nil {
+		panic("mocks: ReportRepository.FindByID is not mocked")
+	}
+	return mock.FindByIDFunc(ID)
+}
+
+func (mock *ReportRepository) FindByIDs(IDs []uint) (records []models.Report, err error) {
+	if mock.FindByIDsFunc == nil {
+		panic("mocks: ReportRepository.FindByIDs is not mocked")
+	}
+	return mock.FindByIDsFunc(IDs)
+}
+
+func (mock *ReportRepository) List(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Report, totalCount int64, err error) {
+	if mock.ListFunc == nil {
+		panic("mocks: ReportRepository.List is not mocked")
+	}
+	return mock.ListFunc(pagination, filters...)
+}
+
+func (mock *ReportRepository) Chunk(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Report, err error) {
+	if mock.ChunkFunc == nil {
+		panic("mocks: ReportRepository.Chunk is not mocked")
+	}
+	return mock.ChunkFunc(afterID, limit, filters...)
+}
+
+func (mock *ReportRepository) FindEach(ctx context.Context, batchSize int, handle func(records []models.Report) error, filters ...func(db *gorm.DB) *gorm.DB) (err error) {
+	if mock.FindEachFunc == nil {
+		panic("mocks: ReportRepository.FindEach is not mocked")
+	}
+	return mock.FindEachFunc(ctx, batchSize, handle, filters...)
+}
+
+func (mock *ReportRepository) Stream(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.Report, errs <-chan error) {
+	if mock.StreamFunc == nil {
+		panic("mocks: ReportRepository.Stream is not mocked")
+	}
+	return mock.StreamFunc(ctx, batchSize, filters...)
+}
+
+func (mock *ReportRepository) Create(record *models.Report) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: ReportRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(record)
+}
+
+func (mock *ReportRepository) Update(record *models.Report, updates map[string]interface{}) (err error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: ReportRepository.Update is not mocked")
+	}
+	return mock.UpdateFunc(record, updates)
+}
+
+func (mock *ReportRepository) UpdateFields(record *models.Report, fields ...string) (err error) {
+	if mock.UpdateFieldsFunc == nil {
+		panic("mocks: ReportRepository.UpdateFields is not mocked")
+	}
+	return mock.UpdateFieldsFunc(record, fields...)
+}
+
+func (mock *ReportRepository) Delete(record *models.Report) (err error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: ReportRepository.Delete is not mocked")
+	}
+	return mock.DeleteFunc(record)
+}
+
+func (mock *ReportRepository) OnShard(key infrastructures.ShardKey) repositories.IBaseRepository[models.Report] {
+	if mock.OnShardFunc == nil {
+		panic("mocks: ReportRepository.OnShard is not mocked")
+	}
+	return mock.OnShardFunc(key)
+}
+
+func (mock *ReportRepository) Exists(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error) {
+	if mock.ExistsFunc == nil {
+		panic("mocks: ReportRepository.Exists is not mocked")
+	}
+	return mock.ExistsFunc(filters...)
+}
+
+func (mock *ReportRepository) Count(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error) {
+	if mock.CountFunc == nil {
+		panic("mocks: ReportRepository.Count is not mocked")
+	}
+	return mock.CountFunc(filters...)
+}
+
+func (mock *ReportRepository) GetReportByID(ID uint) (models.Report, error) {
+	if mock.GetReportByIDFunc == nil {
+		panic("mocks: ReportRepository.GetReportByID is not mocked")
+	}
+	return mock.GetReportByIDFunc(ID)
+}
+
+func (mock *ReportRepository) GetQueue(status string, pagination scopes.GormPager) (reports []models.Report, totalCount int64, err error) {
+	if mock.GetQueueFunc == nil {
+		panic("mocks: ReportRepository.GetQueue is not mocked")
+	}
+	return mock.GetQueueFunc(status, pagination)
+}
+
+func (mock *ReportRepository) HasOpenReport(reporterID uint, reportableType string, reportableID uint) (bool, error) {
+	if mock.HasOpenReportFunc == nil {
+		panic("mocks: ReportRepository.HasOpenReport is not mocked")
+	}
+	return mock.HasOpenReportFunc(reporterID, reportableType, reportableID)
+}
+
+func (mock *ReportRepository) CountUpheld(offenderID uint, since time.Time) (count int64, err error) {
+	if mock.CountUpheldFunc == nil {
+		panic("mocks: ReportRepository.CountUpheld is not mocked")
+	}
+	return mock.CountUpheldFunc(offenderID, since)
+}
+
+func (mock *ReportRepository) Close(report *models.Report, updates map[string]interface{}) (closed bool, err error) {
+	if mock.CloseFunc == nil {
+		panic("mocks: ReportRepository.Close is not mocked")
+	}
+	return mock.CloseFunc(report, updates)
+}
+
+// RetentionRepository is a mock of repositories.IRetentionRepository
+type RetentionRepository struct {
+	ExpiredFunc     func(model interface{}, column string, before time.Time, limit int) (rows []map[string]interface{}, err error)
+	DeleteByIDsFunc func(model interface{}, IDs []interface{}) (deleted int64, err error)
+}
+
+var _ repositories.IRetentionRepository = (*RetentionRepository)(nil)
+
+func (mock *RetentionRepository) Expired(model interface{}, column string, before time.Time, limit int) (rows []map[string]interface{}, err error) {
+	if mock.ExpiredFunc == nil {
+		panic("mocks: RetentionRepository.Expired is not mocked")
+	}
+	return mock.ExpiredFunc(model, column, before, limit)
+}
+
+func (mock *RetentionRepository) DeleteByIDs(model interface{}, IDs []interface{}) (deleted int64, err error) {
+	if mock.DeleteByIDsFunc == nil {
+		panic("mocks: RetentionRepository.DeleteByIDs is not mocked")
+	}
+	return mock.DeleteByIDsFunc(model, IDs)
+}
+
+// SagaRepository is a mock of repositories.ISagaRepository
+type SagaRepository struct {
+	MigrateFunc         func() error
+	CreateFunc          func(saga *models.Saga) (err error)
+	SaveFunc            func(saga *models.Saga) (err error)
+	GetStalledSagasFunc func(before time.Time, limit int) (sagas []models.Saga, err error)
+	ClaimFunc           func(saga *models.Saga) (claimed bool, err error)
+}
+
+var _ repositories.ISagaRepository = (*SagaRepository)(nil)
+
+func (mock *SagaRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: SagaRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *SagaRepository) Create(saga *models.Saga) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: SagaRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(saga)
+}
+
+func (mock *SagaRepository) Save(saga *models.Saga) (err error) {
+	if mock.SaveFunc == nil {
+		panic("mocks: SagaRepository.Save is not mocked")
+	}
+	return mock.SaveFunc(saga)
+}
+
+func (mock *SagaRepository) GetStalledSagas(before time.Time, limit int) (sagas []models.Saga, err error) {
+	if mock.GetStalledSagasFunc == nil {
+		panic("mocks: SagaRepository.GetStalledSagas is not mocked")
+	}
+	return mock.GetStalledSagasFunc(before, limit)
+}
+
+func (mock *SagaRepository) Claim(saga *models.Saga) (claimed bool, err error) {
+	if mock.ClaimFunc == nil {
+		panic("mocks: SagaRepository.Claim is not mocked")
+	}
+	return mock.ClaimFunc(saga)
+}
+
+// SessionRepository is a mock of repositories.ISessionRepository
+type SessionRepository struct {
+	MigrateFunc        func() error
+	ExportUserDataFunc func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc  func(userID uint) error
+	GetSessionByIDFunc func(ID uint) (models.Session, error)
+	CreateFunc         func(session *models.Session) (err error)
+	RotateFunc         func(session *models.Session, tokenHash string, expiresAt time.Time, now time.Time) (err error)
+	RevokeFunc         func(session *models.Session, now time.Time) (err error)
+	RevokeAllFunc      func(userID uint, now time.Time) (err error)
+}
+
+var _ repositories.ISessionRepository = (*SessionRepository)(nil)
+
+func (mock *SessionRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: SessionRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *SessionRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: SessionRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *SessionRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: SessionRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *SessionRepository) GetSessionByID(ID uint) (models.Session, error) {
+	if mock.GetSessionByIDFunc == nil {
+		panic("mocks: SessionRepository.GetSessionByID is not mocked")
+	}
+	return mock.GetSessionByIDFunc(ID)
+}
+
+func (mock *SessionRepository) Create(session *models.Session) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: SessionRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(session)
+}
+
+func (mock *SessionRepository) Rotate(session *models.Session, tokenHash string, expiresAt time.Time, now time.Time) (err error) {
+	if mock.RotateFunc == nil {
+		panic("mocks: SessionRepository.Rotate is not mocked")
+	}
+	return mock.RotateFunc(session, tokenHash, expiresAt, now)
+}
+
+func (mock *SessionRepository) Revoke(session *models.Session, now time.Time) (err error) {
+	if mock.RevokeFunc == nil {
+		panic("mocks: SessionRepository.Revoke is not mocked")
+	}
+	return mock.RevokeFunc(session, now)
+}
+
+func (mock *SessionRepository) RevokeAll(userID uint, now time.Time) (err error) {
+	if mock.RevokeAllFunc == nil {
+		panic("mocks: SessionRepository.RevokeAll is not mocked")
+	}
+	return mock.RevokeAllFunc(userID, now)
+}
+
+// SettingRepository is a mock of repositories.ISettingRepository
+type SettingRepository struct {
+	MigrateFunc         func() error
+	SeedFunc            func() error
+	GetSettingsFunc     func() (settings []models.Setting, err error)
+	GetSettingByKeyFunc func(key string) (models.Setting, error)
+	SaveFunc            func(setting *models.Setting) (err error)
+}
+
+var _ repositories.ISettingRepository = (*SettingRepository)(nil)
+
+func (mock *SettingRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: SettingRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *SettingRepository) Seed() error {
+	if mock.SeedFunc == nil {
+		panic("mocks: SettingRepository.Seed is not mocked")
+	}
+	return mock.SeedFunc()
+}
+
+func (mock *SettingRepository) GetSettings() (settings []models.Setting, err error) {
+	if mock.GetSettingsFunc == nil {
+		panic("mocks: SettingRepository.GetSettings is not mocked")
+	}
+	return mock.GetSettingsFunc()
+}
+
+func (mock *SettingRepository) GetSettingByKey(key string) (models.Setting, error) {
+	if mock.GetSettingByKeyFunc == nil {
+		panic("mocks: SettingRepository.GetSettingByKey is not mocked")
+	}
+	return mock.GetSettingByKeyFunc(key)
+}
+
+func (mock *SettingRepository) Save(setting *models.Setting) (err error) {
+	if mock.SaveFunc == nil {
+		panic("mocks: SettingRepository.Save is not mocked")
+	}
+	return mock.SaveFunc(setting)
+}
+
+// TagRepository is a mock of repositories.ITagRepository
+type TagRepository struct {
+	MigrateFunc          func() error
+	EraseUserDataFunc    func(userID uint) error
+	FindByIDFunc         func(ID uint) (models.Tag, error)
+	FindByIDsFunc        func(IDs []uint) (records []models.Tag, err error)
+	ListFunc             func(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Tag, totalCount int64, err error)
+	ChunkFunc            func(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Tag, err error)
+	FindEachFunc         func(ctx context.Context, batchSize int, handle func(records []models.Tag) error, filters ...func(db *gorm.DB) *gorm.DB) (err error)
+	StreamFunc           func(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.Tag, errs <-chan error)
+	CreateFunc           func(record *models.Tag) (err error)
+	UpdateFunc           func(record *models.Tag, updates map[string]interface{}) (err error)
+	UpdateFieldsFunc     func(record *models.Tag, fields ...string) (err error)
+	DeleteFunc           func(record *models.Tag) (err error)
+	OnShardFunc          func(key infrastructures.ShardKey) repositories.IBaseRepository[models.Tag]
+	ExistsFunc           func(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error)
+	CountFunc            func(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error)
+	FindOrCreateTagsFunc func(tags []models.Tag) (found []models.Tag, err error)
+	GetTagsBySlugFunc    func(slugs []string) (tags []models.Tag, err error)
+	GetTagsFunc          func(taggableType string, taggableID uint) (tags []models.Tag, err error)
+	GetPopularTagsFunc   func(taggableType string, limit int) (tags []models.PopularTag, err error)
+	AttachFunc           func(taggableType string, taggableID uint, tagIDs []uint) (err error)
+	DetachFunc           func(taggableType string, taggableID uint, tagIDs []uint) (err error)
+	SyncFunc             func(taggableType string, taggableID uint, tagIDs []uint) (err error)
+}
+
+var _ repositories.ITagRepository = (*TagRepository)(nil)
+
+func (mock *TagRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: TagRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *TagRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: TagRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *TagRepository) FindByID(ID uint) (models.Tag, error) {
+	if mock.FindByIDFunc == nil {
+		panic("mocks: TagRepository.FindByID is not mocked")
+	}
+	return mock.FindByIDFunc(ID)
+}
+
+func (mock *TagRepository) FindByIDs(IDs []uint) (records []models.Tag, err error) {
+	if mock.FindByIDsFunc == nil {
+		panic("mocks: TagRepository.FindByIDs is not mocked")
+	}
+	return mock.FindByIDsFunc(IDs)
+}
+
+func (mock *TagRepository) List(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Tag, totalCount int64, err error) {
+	if mock.ListFunc == nil {
+		panic("mocks: TagRepository.List is not mocked")
+	}
+	return mock.ListFunc(pagination, filters...)
+}
+
+func (mock *TagRepository) Chunk(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.Tag, err error) {
+	if mock.ChunkFunc == nil {
+		panic("mocks: TagRepository.Chunk is not mocked")
+	}
+	return mock.ChunkFunc(afterID, limit, filters...)
+}
+
+func (mock *TagRepository) FindEach(ctx context.Context, batchSize int, handle func(records []models.Tag) error, filters ...func(db *gorm.DB) *gorm.DB) (err error) {
+	if mock.FindEachFunc == nil {
+		panic("mocks: TagRepository.FindEach is not mocked")
+	}
+	return mock.FindEachFunc(ctx, batchSize, handle, filters...)
+}
+
+func (mock *TagRepository) Stream(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.Tag, errs <-chan error) {
+	if mock.StreamFunc == nil {
+		panic("mocks: TagRepository.Stream is not mocked")
+	}
+	return mock.StreamFunc(ctx, batchSize, filters...)
+}
+
+func (mock *TagRepository) Create(record *models.Tag) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: TagRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(record)
+}
+
+func (mock *TagRepository) Update(record *models.Tag, updates map[string]interface{}) (err error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: TagRepository.Update is not mocked")
+	}
+	return mock.UpdateFunc(record, updates)
+}
+
+func (mock *TagRepository) UpdateFields(record *models.Tag, fields ...string) (err error) {
+	if mock.UpdateFieldsFunc == nil {
+		panic("mocks: TagRepository.UpdateFields is not mocked")
+	}
+	return mock.UpdateFieldsFunc(record, fields...)
+}
+
+func (mock *TagRepository) Delete(record *models.Tag) (err error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: TagRepository.Delete is not mocked")
+	}
+	return mock.DeleteFunc(record)
+}
+
+func (mock *TagRepository) OnShard(key infrastructures.ShardKey) repositories.IBaseRepository[models.Tag] {
+	if mock.OnShardFunc == nil {
+		panic("mocks: TagRepository.OnShard is not mocked")
+	}
+	return mock.OnShardFunc(key)
+}
+
+func (mock *TagRepository) Exists(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error) {
+	if mock.ExistsFunc == nil {
+		panic("mocks: TagRepository.Exists is not mocked")
+	}
+	return mock.ExistsFunc(filters...)
+}
+
+func (mock *TagRepository) Count(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error) {
+	if mock.CountFunc == nil {
+		panic("mocks: TagRepository.Count is not mocked")
+	}
+	return mock.CountFunc(filters...)
+}
+
+func (mock *TagRepository) FindOrCreateTags(tags []models.Tag) (found []models.Tag, err error) {
+	if mock.FindOrCreateTagsFunc == nil {
+		panic("mocks: TagRepository.FindOrCreateTags is not mocked")
+	}
+	return mock.FindOrCreateTagsFunc(tags)
+}
+
+func (mock *TagRepository) GetTagsBySlug(slugs []string) (tags []models.Tag, err error) {
+	if mock.GetTagsBySlugFunc == nil {
+		panic("mocks: TagRepository.GetTagsBySlug is not mocked")
+	}
+	return mock.GetTagsBySlugFunc(slugs)
+}
+
+func (mock *TagRepository) GetTags(taggableType string, taggableID uint) (tags []models.Tag, err error) {
+	if mock.GetTagsFunc == nil {
+		panic("mocks: TagRepository.GetTags is not mocked")
+	}
+	return mock.GetTagsFunc(taggableType, taggableID)
+}
+
+func (mock *TagRepository) GetPopularTags(taggableType string, limit int) (tags []models.PopularTag, err error) {
+	if mock.GetPopularTagsFunc == nil {
+		panic("mocks: TagRepository.GetPopularTags is not mocked")
+	}
+	return mock.GetPopularTagsFunc(taggableType, limit)
+}
+
+func (mock *TagRepository) Attach(taggableType string, taggableID uint, tagIDs []uint) (err error) {
+	if mock.AttachFunc == nil {
+		panic("mocks: TagRepository.Attach is not mocked")
+	}
+	return mock.AttachFunc(taggableType, taggableID, tagIDs)
+}
+
+func (mock *TagRepository) Detach(taggableType string, taggableID uint, tagIDs []uint) (err error) {
+	if mock.DetachFunc == nil {
+		panic("mocks: TagRepository.Detach is not mocked")
+	}
+	return mock.DetachFunc(taggableType, taggableID, tagIDs)
+}
+
+func (mock *TagRepository) Sync(taggableType string, taggableID uint, tagIDs []uint) (err error) {
+	if mock.SyncFunc == nil {
+		panic("mocks: TagRepository.Sync is not mocked")
+	}
+	return mock.SyncFunc(taggableType, taggableID, tagIDs)
+}
+
+// UsageRepository is a mock of repositories.IUsageRepository
+type UsageRepository struct {
+	MigrateFunc        func() error
+	ExportUserDataFunc func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc  func(userID uint) error
+	GetUsagesFunc      func(userID uint, from time.Time, to time.Time) (usages []models.ApiUsage, err error)
+	IncrementFunc      func(userID uint, now time.Time) (err error)
+}
+
+var _ repositories.IUsageRepository = (*UsageRepository)(nil)
+
+func (mock *UsageRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: UsageRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *UsageRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: UsageRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *UsageRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: UsageRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *UsageRepository) GetUsages(userID uint, from time.Time, to time.Time) (usages []models.ApiUsage, err error) {
+	if mock.GetUsagesFunc == nil {
+		panic("mocks: UsageRepository.GetUsages is not mocked")
+	}
+	return mock.GetUsagesFunc(userID, from, to)
+}
+
+func (mock *UsageRepository) Increment(userID uint, now time.Time) (err error) {
+	if mock.IncrementFunc == nil {
+		panic("mocks: UsageRepository.Increment is not mocked")
+	}
+	return mock.IncrementFunc(userID, now)
+}
+
+// UserRepository is a mock of repositories.IUserRepository
+type UserRepository struct {
+	MigrateFunc                        func() error
+	SeedFunc                           func() error
+	ExportUserDataFunc                 func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc                  func(userID uint) error
+	FindByIDFunc                       func(ID uint) (models.User, error)
+	FindByIDsFunc                      func(IDs []uint) (records []models.User, err error)
+	ListFunc                           func(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.User, totalCount int64, err error)
+	ChunkFunc                          func(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.User, err error)
+	FindEachFunc                       func(ctx context.Context, batchSize int, handle func(records []models.User) error, filters ...func(db *gorm.DB) *gorm.DB) (err error)
+	StreamFunc                         func(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.User, errs <-chan error)
+	CreateFunc                         func(record *models.User) (err error)
+	UpdateFunc                         func(record *models.User, updates map[string]interface{}) (err error)
+	UpdateFieldsFunc                   func(record *models.User, fields ...string) (err error)
+	DeleteFunc                         func(record *models.User) (err error)
+	OnShardFunc                        func(key infrastructures.ShardKey) repositories.IBaseRepository[models.User]
+	ExistsFunc                         func(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error)
+	CountFunc                          func(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error)
+	GetUserByIDFunc                    func(ID uint) (models.User, error)
+	GetUserByEmailFunc                 func(email string) (models.User, error)
+	GetUserByPhoneFunc                 func(phone string) (models.User, error)
+	GetUserByVerificationTokenFunc     func(tokenHash string) (models.User, error)
+	GetUsersWithPaginationAndOrderFunc func(pagination scopes.GormPager, order scopes.GormOrderer, filters ...func(db *gorm.DB) *gorm.DB) (users []models.User, totalCount int64, err error)
+	PaginateFunc                       func(keyset pagination.Keyset, filters ...func(db *gorm.DB) *gorm.DB) (users []models.User, next *pagination.Cursor, err error)
+	SaveFunc                           func(user *models.User) (err error)
+	UpdatesFunc                        func(user *models.User, updates map[string]interface{}) (err error)
+	GetUserIDsFunc                     func() (userIDs []uint, err error)
+	GetUsersDueForDeletionFunc         func(before time.Time) (users []models.User, err error)
+	GetUsersWithExpiredSuspensionFunc  func(now time.Time) (users []models.User, err error)
+}
+
+var _ repositories.IUserRepository = (*UserRepository)(nil)
+
+func (mock *UserRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: UserRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *UserRepository) Seed() error {
+	if mock.SeedFunc == nil {
+		panic("mocks: UserRepository.Seed is not mocked")
+	}
+	return mock.SeedFunc()
+}
+
+func (mock *UserRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: UserRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *UserRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: UserRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *UserRepository) FindByID(ID uint) (models.User, error) {
+	if mock.FindByIDFunc == nil {
+		panic("mocks: UserRepository.FindByID is not mocked")
+	}
+	return mock.FindByIDFunc(ID)
+}
+
+func (mock *UserRepository) FindByIDs(IDs []uint) (records []models.User, err error) {
+	if mock.FindByIDsFunc == nil {
+		panic("mocks: UserRepository.FindByIDs is not mocked")
+	}
+	return mock.FindByIDsFunc(IDs)
+}
+
+func (mock *UserRepository) List(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []models.User, totalCount int64, err error) {
+	if mock.ListFunc == nil {
+		panic("mocks: UserRepository.List is not mocked")
+	}
+	return mock.ListFunc(pagination, filters...)
+}
+
+func (mock *UserRepository) Chunk(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []models.User, err error) {
+	if mock.ChunkFunc == nil {
+		panic("mocks: UserRepository.Chunk is not mocked")
+	}
+	return mock.ChunkFunc(afterID, limit, filters...)
+}
+
+func (mock *UserRepository) FindEach(ctx context.Context, batchSize int, handle func(records []models.User) error, filters ...func(db *gorm.DB) *gorm.DB) (err error) {
+	if mock.FindEachFunc == nil {
+		panic("mocks: UserRepository.FindEach is not mocked")
+	}
+	return mock.FindEachFunc(ctx, batchSize, handle, filters...)
+}
+
+func (mock *UserRepository) Stream(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan models.User, errs <-chan error) {
+	if mock.StreamFunc == nil {
+		panic("mocks: UserRepository.Stream is not mocked")
+	}
+	return mock.StreamFunc(ctx, batchSize, filters...)
+}
+
+func (mock *UserRepository) Create(record *models.User) (err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: UserRepository.Create is not mocked")
+	}
+	return mock.CreateFunc(record)
+}
+
+func (mock *UserRepository) Update(record *models.User, updates map[string]interface{}) (err error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: UserRepository.Update is not mocked")
+	}
+	return mock.UpdateFunc(record, updates)
+}
+
+func (mock *UserRepository) UpdateFields(record *models.User, fields ...string) (err error) {
+	if mock.UpdateFieldsFunc == nil {
+		panic("mocks: UserRepository.UpdateFields is not mocked")
+	}
+	return mock.UpdateFieldsFunc(record, fields...)
+}
+
+func (mock *UserRepository) Delete(record *models.User) (err error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: UserRepository.Delete is not mocked")
+	}
+	return mock.DeleteFunc(record)
+}
+
+func (mock *UserRepository) OnShard(key infrastructures.ShardKey) repositories.IBaseRepository[models.User] {
+	if mock.OnShardFunc == nil {
+		panic("mocks: UserRepository.OnShard is not mocked")
+	}
+	return mock.OnShardFunc(key)
+}
+
+func (mock *UserRepository) Exists(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error) {
+	if mock.ExistsFunc == nil {
+		panic("mocks: UserRepository.Exists is not mocked")
+	}
+	return mock.ExistsFunc(filters...)
+}
+
+func (mock *UserRepository) Count(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error) {
+	if mock.CountFunc == nil {
+		panic("mocks: UserRepository.Count is not mocked")
+	}
+	return mock.CountFunc(filters...)
+}
+
+func (mock *UserRepository) GetUserByID(ID uint) (models.User, error) {
+	if mock.GetUserByIDFunc == nil {
+		panic("mocks: UserRepository.GetUserByID is not mocked")
+	}
+	return mock.GetUserByIDFunc(ID)
+}
+
+func (mock *UserRepository) GetUserByEmail(email string) (models.User, error) {
+	if mock.GetUserByEmailFunc == nil {
+		panic("mocks: UserRepository.GetUserByEmail is not mocked")
+	}
+	return mock.GetUserByEmailFunc(email)
+}
+
+func (mock *UserRepository) GetUserByPhone(phone string) (models.User, error) {
+	if mock.GetUserByPhoneFunc == nil {
+		panic("mocks: UserRepository.GetUserByPhone is not mocked")
+	}
+	return mock.GetUserByPhoneFunc(phone)
+}
+
+func (mock *UserRepository) GetUserByVerificationToken(tokenHash string) (models.User, error) {
+	if mock.GetUserByVerificationTokenFunc == nil {
+		panic("mocks: UserRepository.GetUserByVerificationToken is not mocked")
+	}
+	return mock.GetUserByVerificationTokenFunc(tokenHash)
+}
+
+func (mock *UserRepository) GetUsersWithPaginationAndOrder(pagination scopes.GormPager, order scopes.GormOrderer, filters ...func(db *gorm.DB) *gorm.DB) (users []models.User, totalCount int64, err error) {
+	if mock.GetUsersWithPaginationAndOrderFunc == nil {
+		panic("mocks: UserRepository.GetUsersWithPaginationAndOrder is not mocked")
+	}
+	return mock.GetUsersWithPaginationAndOrderFunc(pagination, order, filters...)
+}
+
+func (mock *UserRepository) Paginate(keyset pagination.Keyset, filters ...func(db *gorm.DB) *gorm.DB) (users []models.User, next *pagination.Cursor, err error) {
+	if mock.PaginateFunc == nil {
+		panic("mocks: UserRepository.Paginate is not mocked")
+	}
+	return mock.PaginateFunc(keyset, filters...)
+}
+
+func (mock *UserRepository) Save(user *models.User) (err error) {
+	if mock.SaveFunc == nil {
+		panic("mocks: UserRepository.Save is not mocked")
+	}
+	return mock.SaveFunc(user)
+}
+
+func (mock *UserRepository) Updates(user *models.User, updates map[string]interface{}) (err error) {
+	if mock.UpdatesFunc == nil {
+		panic("mocks: UserRepository.Updates is not mocked")
+	}
+	return mock.UpdatesFunc(user, updates)
+}
+
+func (mock *UserRepository) GetUserIDs() (userIDs []uint, err error) {
+	if mock.GetUserIDsFunc == nil {
+		panic("mocks: UserRepository.GetUserIDs is not mocked")
+	}
+	return mock.GetUserIDsFunc()
+}
+
+func (mock *UserRepository) GetUsersDueForDeletion(before time.Time) (users []models.User, err error) {
+	if mock.GetUsersDueForDeletionFunc == nil {
+		panic("mocks: UserRepository.GetUsersDueForDeletion is not mocked")
+	}
+	return mock.GetUsersDueForDeletionFunc(before)
+}
+
+func (mock *UserRepository) GetUsersWithExpiredSuspension(now time.Time) (users []models.User, err error) {
+	if mock.GetUsersWithExpiredSuspensionFunc == nil {
+		panic("mocks: UserRepository.GetUsersWithExpiredSuspension is not mocked")
+	}
+	return mock.GetUsersWithExpiredSuspensionFunc(now)
+}
+
+// UserSearchViewRepository is a mock of repositories.IUserSearchViewRepository
+type UserSearchViewRepository struct {
+	MigrateFunc                        func() error
+	SearchUserViewsFunc                func(filter repositories.UserViewFilter, pagination scopes.GormPager) (views []models.UserSearchView, totalCount int64, err error)
+	SaveUserViewFunc                   func(view *models.UserSearchView) (err error)
+	DeleteUserViewFunc                 func(userID uint) (err error)
+	DeleteUserViewsProjectedBeforeFunc func(before time.Time) (deleted int64, err error)
+}
+
+var _ repositories.IUserSearchViewRepository = (*UserSearchViewRepository)(nil)
+
+func (mock *UserSearchViewRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: UserSearchViewRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *UserSearchViewRepository) SearchUserViews(filter repositories.UserViewFilter, pagination scopes.GormPager) (views []models.UserSearchView, totalCount int64, err error) {
+	if mock.SearchUserViewsFunc == nil {
+		panic("mocks: UserSearchViewRepository.SearchUserViews is not mocked")
+	}
+	return mock.SearchUserViewsFunc(filter, pagination)
+}
+
+func (mock *UserSearchViewRepository) SaveUserView(view *models.UserSearchView) (err error) {
+	if mock.SaveUserViewFunc == nil {
+		panic("mocks: UserSearchViewRepository.SaveUserView is not mocked")
+	}
+	return mock.SaveUserViewFunc(view)
+}
+
+func (mock *UserSearchViewRepository) DeleteUserView(userID uint) (err error) {
+	if mock.DeleteUserViewFunc == nil {
+		panic("mocks: UserSearchViewRepository.DeleteUserView is not mocked")
+	}
+	return mock.DeleteUserViewFunc(userID)
+}
+
+func (mock *UserSearchViewRepository) DeleteUserViewsProjectedBefore(before time.Time) (deleted int64, err error) {
+	if mock.DeleteUserViewsProjectedBeforeFunc == nil {
+		panic("mocks: UserSearchViewRepository.DeleteUserViewsProjectedBefore is not mocked")
+	}
+	return mock.DeleteUserViewsProjectedBeforeFunc(before)
+}
+
+// UserVersionRepository is a mock of repositories.IUserVersionRepository
+type UserVersionRepository struct {
+	MigrateFunc         func() error
+	ExportUserDataFunc  func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc   func(userID uint) error
+	GetUserVersionsFunc func(userID uint, pagination scopes.GormPager) (versions []models.UserVersion, totalCount int64, err error)
+	GetUserVersionFunc  func(userID uint, ID uint) (models.UserVersion, error)
+}
+
+var _ repositories.IUserVersionRepository = (*UserVersionRepository)(nil)
+
+func (mock *UserVersionRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: UserVersionRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *UserVersionRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: UserVersionRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *UserVersionRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: UserVersionRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *UserVersionRepository) GetUserVersions(userID uint, pagination scopes.GormPager) (versions []models.UserVersion, totalCount int64, err error) {
+	if mock.GetUserVersionsFunc == nil {
+		panic("mocks: UserVersionRepository.GetUserVersions is not mocked")
+	}
+	return mock.GetUserVersionsFunc(userID, pagination)
+}
+
+func (mock *UserVersionRepository) GetUserVersion(userID uint, ID uint) (models.UserVersion, error) {
+	if mock.GetUserVersionFunc == nil {
+		panic("mocks: UserVersionRepository.GetUserVersion is not mocked")
+	}
+	return mock.GetUserVersionFunc(userID, ID)
+}
+
+// VoteRepository is a mock of repositories.IVoteRepository
+type VoteRepository struct {
+	MigrateFunc            func() error
+	ExportUserDataFunc     func(userID uint) (data interface{}, err error)
+	EraseUserDataFunc      func(userID uint) error
+	GetVoteFunc            func(userID uint, votableType string, votableID uint) (models.Vote, error)
+	GetTallyFunc           func(votableType string, votableID uint) (models.VoteTally, error)
+	GetUnscoredTalliesFunc func(limit int) (tallies []models.VoteTally, err error)
+	CastFunc               func(userID uint, votableType string, votableID uint, value int) (err error)
+	UpdateScoreFunc        func(tally *models.VoteTally, score float64, scoredAt time.Time) (err error)
+}
+
+var _ repositories.IVoteRepository = (*VoteRepository)(nil)
+
+func (mock *VoteRepository) Migrate() error {
+	if mock.MigrateFunc == nil {
+		panic("mocks: VoteRepository.Migrate is not mocked")
+	}
+	return mock.MigrateFunc()
+}
+
+func (mock *VoteRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("mocks: VoteRepository.ExportUserData is not mocked")
+	}
+	return mock.ExportUserDataFunc(userID)
+}
+
+func (mock *VoteRepository) EraseUserData(userID uint) error {
+	if mock.EraseUserDataFunc == nil {
+		panic("mocks: VoteRepository.EraseUserData is not mocked")
+	}
+	return mock.EraseUserDataFunc(userID)
+}
+
+func (mock *VoteRepository) GetVote(userID uint, votableType string, votableID uint) (models.Vote, error) {
+	if mock.GetVoteFunc == nil {
+		panic("mocks: VoteRepository.GetVote is not mocked")
+	}
+	return mock.GetVoteFunc(userID, votableType, votableID)
+}
+
+func (mock *VoteRepository) GetTally(votableType string, votableID uint) (models.VoteTally, error) {
+	if mock.GetTallyFunc == nil {
+		panic("mocks: VoteRepository.GetTally is not mocked")
+	}
+	return mock.GetTallyFunc(votableType, votableID)
+}
+
+func (mock *VoteRepository) GetUnscoredTallies(limit int) (tallies []models.VoteTally, err error) {
+	if mock.GetUnscoredTalliesFunc == nil {
+		panic("mocks: VoteRepository.GetUnscoredTallies is not mocked")
+	}
+	return mock.GetUnscoredTalliesFunc(limit)
+}
+
+func (mock *VoteRepository) Cast(userID uint, votableType string, votableID uint, value int) (err error) {
+	if mock.CastFunc == nil {
+		panic("mocks: VoteRepository.Cast is not mocked")
+	}
+	return mock.CastFunc(userID, votableType, votableID, value)
+}
+
+func (mock *VoteRepository) UpdateScore(tally *models.VoteTally, score float64, scoredAt time.Time) (err error) {
+	if mock.UpdateScoreFunc == nil {
+		panic("mocks: VoteRepository.UpdateScore is not mocked")
+	}
+	return mock.UpdateScoreFunc(tally, score, scoredAt)
+}
@@ -0,0 +1,23 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"gotham/repositories/transactions"
+)
+
+// UnitOfWork is a mock of transactions.IUnitOfWork
+type UnitOfWork struct {
+	WithinTransactionFunc func(ctx context.Context, fn func(repos transactions.RepoSet) error) error
+}
+
+var _ transactions.IUnitOfWork = (*UnitOfWork)(nil)
+
+func (mock *UnitOfWork) WithinTransaction(ctx context.Context, fn func(repos transactions.RepoSet) error) error {
+	if mock.WithinTransactionFunc == nil {
+		panic("mocks: UnitOfWork.WithinTransaction is not mocked")
+	}
+	return mock.WithinTransactionFunc(ctx, fn)
+}
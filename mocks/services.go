@@ -0,0 +1,1825 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/pagination"
+	"gotham/repositories"
+	"gotham/services"
+	"gotham/utils"
+)
+
+// AdminService is a mock of services.IAdminService
+type AdminService struct {
+	ResourceFunc func() string
+	FiltersFunc  func() []string
+	ListFunc     func(filters map[string]string, pagination utils.IPagination) (records interface{}, totalCount int64, err error)
+	ShowFunc     func(ID uint) (interface{}, error)
+	CreateFunc   func(admin models.User, fields map[string]interface{}) (interface{}, error)
+	UpdateFunc   func(admin models.User, ID uint, fields map[string]interface{}) (interface{}, error)
+	DeleteFunc   func(admin models.User, ID uint) error
+}
+
+var _ services.IAdminService = (*AdminService)(nil)
+
+func (mock *AdminService) Resource() string {
+	if mock.ResourceFunc == nil {
+		panic("mocks: AdminService.Resource is not mocked")
+	}
+	return mock.ResourceFunc()
+}
+
+func (mock *AdminService) Filters() []string {
+	if mock.FiltersFunc == nil {
+		panic("mocks: AdminService.Filters is not mocked")
+	}
+	return mock.FiltersFunc()
+}
+
+func (mock *AdminService) List(filters map[string]string, pagination utils.IPagination) (records interface{}, totalCount int64, err error) {
+	if mock.ListFunc == nil {
+		panic("mocks: AdminService.List is not mocked")
+	}
+	return mock.ListFunc(filters, pagination)
+}
+
+func (mock *AdminService) Show(ID uint) (interface{}, error) {
+	if mock.ShowFunc == nil {
+		panic("mocks: AdminService.Show is not mocked")
+	}
+	return mock.ShowFunc(ID)
+}
+
+func (mock *AdminService) Create(admin models.User, fields map[string]interface{}) (interface{}, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: AdminService.Create is not mocked")
+	}
+	return mock.CreateFunc(admin, fields)
+}
+
+func (mock *AdminService) Update(admin models.User, ID uint, fields map[string]interface{}) (interface{}, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: AdminService.Update is not mocked")
+	}
+	return mock.UpdateFunc(admin, ID, fields)
+}
+
+func (mock *AdminService) Delete(admin models.User, ID uint) error {
+	if mock.DeleteFunc == nil {
+		panic("mocks: AdminService.Delete is not mocked")
+	}
+	return mock.DeleteFunc(admin, ID)
+}
+
+// AnnouncementService is a mock of services.IAnnouncementService
+type AnnouncementService struct {
+	CreateFunc            func(admin models.User, announcement models.Announcement) (models.Announcement, error)
+	PublishFunc           func(admin models.User, announcementID uint, publishAt *time.Time) (models.Announcement, error)
+	AnnouncementsFunc     func(pagination utils.IPagination) (announcements []models.Announcement, totalCount int64, err error)
+	DeleteFunc            func(admin models.User, announcementID uint) error
+	UserAnnouncementsFunc func(user models.User, pagination utils.IPagination) (announcements []models.Announcement, totalCount int64, err error)
+	ReadFunc              func(user models.User, announcementID uint) (models.Announcement, error)
+	PublishedFunc         func(event infrastructures.Event) error
+}
+
+var _ services.IAnnouncementService = (*AnnouncementService)(nil)
+
+func (mock *AnnouncementService) Create(admin models.User, announcement models.Announcement) (models.Announcement, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: AnnouncementService.Create is not mocked")
+	}
+	return mock.CreateFunc(admin, announcement)
+}
+
+func (mock *AnnouncementService) Publish(admin models.User, announcementID uint, publishAt *time.Time) (models.Announcement, error) {
+	if mock.PublishFunc == nil {
+		panic("mocks: AnnouncementService.Publish is not mocked")
+	}
+	return mock.PublishFunc(admin, announcementID, publishAt)
+}
+
+func (mock *AnnouncementService) Announcements(pagination utils.IPagination) (announcements []models.Announcement, totalCount int64, err error) {
+	if mock.AnnouncementsFunc == nil {
+		panic("mocks: AnnouncementService.Announcements is not mocked")
+	}
+	return mock.AnnouncementsFunc(pagination)
+}
+
+func (mock *AnnouncementService) Delete(admin models.User, announcementID uint) error {
+	if mock.DeleteFunc == nil {
+		panic("mocks: AnnouncementService.Delete is not mocked")
+	}
+	return mock.DeleteFunc(admin, announcementID)
+}
+
+func (mock *AnnouncementService) UserAnnouncements(user models.User, pagination utils.IPagination) (announcements []models.Announcement, totalCount int64, err error) {
+	if mock.UserAnnouncementsFunc == nil {
+		panic("mocks: AnnouncementService.UserAnnouncements is not mocked")
+	}
+	return mock.UserAnnouncementsFunc(user, pagination)
+}
+
+func (mock *AnnouncementService) Read(user models.User, announcementID uint) (models.Announcement, error) {
+	if mock.ReadFunc == nil {
+		panic("mocks: AnnouncementService.Read is not mocked")
+	}
+	return mock.ReadFunc(user, announcementID)
+}
+
+func (mock *AnnouncementService) Published(event infrastructures.Event) error {
+	if mock.PublishedFunc == nil {
+		panic("mocks: AnnouncementService.Published is not mocked")
+	}
+	return mock.PublishedFunc(event)
+}
+
+// ApiKeyService is a mock of services.IApiKeyService
+type ApiKeyService struct {
+	GetApiKeysFunc   func(user models.User) ([]models.ApiKey, error)
+	CreateFunc       func(user models.User, name string, scopes []string, ttl time.Duration) (apiKey models.ApiKey, key string, err error)
+	RevokeFunc       func(user models.User, ID uint) (models.ApiKey, error)
+	AuthenticateFunc func(key string) (models.ApiKey, error)
+}
+
+var _ services.IApiKeyService = (*ApiKeyService)(nil)
+
+func (mock *ApiKeyService) GetApiKeys(user models.User) ([]models.ApiKey, error) {
+	if mock.GetApiKeysFunc == nil {
+		panic("mocks: ApiKeyService.GetApiKeys is not mocked")
+	}
+	return mock.GetApiKeysFunc(user)
+}
+
+func (mock *ApiKeyService) Create(user models.User, name string, scopes []string, ttl time.Duration) (apiKey models.ApiKey, key string, err error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: ApiKeyService.Create is not mocked")
+	}
+	return mock.CreateFunc(user, name, scopes, ttl)
+}
+
+func (mock *ApiKeyService) Revoke(user models.User, ID uint) (models.ApiKey, error) {
+	if mock.RevokeFunc == nil {
+		panic("mocks: ApiKeyService.Revoke is not mocked")
+	}
+	return mock.RevokeFunc(user, ID)
+}
+
+func (mock *ApiKeyService) Authenticate(key string) (models.ApiKey, error) {
+	if mock.AuthenticateFunc == nil {
+		panic("mocks: ApiKeyService.Authenticate is not mocked")
+	}
+	return mock.AuthenticateFunc(key)
+}
+
+// AuthService is a mock of services.IAuthService
+type AuthService struct {
+	GetUserByEmailFunc   func(email string) (user models.User, err error)
+	CheckFunc            func(email string, password string) (bool, error)
+	IssueTokenFunc       func(userID uint, impersonatorID uint, ttl time.Duration) (token string, expiresAt int64, err error)
+	IssueSudoTokenFunc   func(userID uint, ttl time.Duration, authAt time.Time) (token string, expiresAt int64, err error)
+	IssueClientTokenFunc func(clientID uint, scopes []string, ttl time.Duration) (token string, expiresAt int64, err error)
+	ConfirmPasswordFunc  func(ctx context.Context, user models.User, password string) (bool, error)
+	ChangePasswordFunc   func(user models.User, password string) (models.User, error)
+}
+
+var _ services.IAuthService = (*AuthService)(nil)
+
+func (mock *AuthService) GetUserByEmail(email string) (user models.User, err error) {
+	if mock.GetUserByEmailFunc == nil {
+		panic("mocks: AuthService.GetUserByEmail is not mocked")
+	}
+	return mock.GetUserByEmailFunc(email)
+}
+
+func (mock *AuthService) Check(email string, password string) (bool, error) {
+	if mock.CheckFunc == nil {
+		panic("mocks: AuthService.Check is not mocked")
+	}
+	return mock.CheckFunc(email, password)
+}
+
+func (mock *AuthService) IssueToken(userID uint, impersonatorID uint, ttl time.Duration) (token string, expiresAt int64, err error) {
+	if mock.IssueTokenFunc == nil {
+		panic("mocks: AuthService.IssueToken is not mocked")
+	}
+	return mock.IssueTokenFunc(userID, impersonatorID, ttl)
+}
+
+func (mock *AuthService) IssueSudoToken(userID uint, ttl time.Duration, authAt time.Time) (token string, expiresAt int64, err error) {
+	if mock.IssueSudoTokenFunc == nil {
+		panic("mocks: AuthService.IssueSudoToken is not mocked")
+	}
+	return mock.IssueSudoTokenFunc(userID, ttl, authAt)
+}
+
+func (mock *AuthService) IssueClientToken(clientID uint, scopes []string, ttl time.Duration) (token string, expiresAt int64, err error) {
+	if mock.IssueClientTokenFunc == nil {
+		panic("mocks: AuthService.IssueClientToken is not mocked")
+	}
+	return mock.IssueClientTokenFunc(clientID, scopes, ttl)
+}
+
+func (mock *AuthService) ConfirmPassword(ctx context.Context, user models.User, password string) (bool, error) {
+	if mock.ConfirmPasswordFunc == nil {
+		panic("mocks: AuthService.ConfirmPassword is not mocked")
+	}
+	return mock.ConfirmPasswordFunc(ctx, user, password)
+}
+
+func (mock *AuthService) ChangePassword(user models.User, password string) (models.User, error) {
+	if mock.ChangePasswordFunc == nil {
+		panic("mocks: AuthService.ChangePassword is not mocked")
+	}
+	return mock.ChangePasswordFunc(user, password)
+}
+
+// BillingService is a mock of services.IBillingService
+type BillingService struct {
+	PlansFunc         func() ([]models.Plan, error)
+	SubscriptionFunc  func(user models.User) (models.Subscription, error)
+	CheckoutFunc      func(ctx context.Context, user models.User, planSlug string, couponCode string) (string, error)
+	QuoteCouponFunc   func(user models.User, couponCode string, planSlug string) (services.CouponQuote, error)
+	CancelFunc        func(ctx context.Context, user models.User) (models.Subscription, error)
+	HandleWebhookFunc func(payload []byte, signature string) error
+	ReplayWebhookFunc func(ctx context.Context, payload []byte) error
+	HasPlanFunc       func(userID uint, planSlug string) (bool, error)
+}
+
+var _ services.IBillingService = (*BillingService)(nil)
+
+func (mock *BillingService) Plans() ([]models.Plan, error) {
+	if mock.PlansFunc == nil {
+		panic("mocks: BillingService.Plans is not mocked")
+	}
+	return mock.PlansFunc()
+}
+
+func (mock *BillingService) Subscription(user models.User) (models.Subscription, error) {
+	if mock.SubscriptionFunc == nil {
+		panic("mocks: BillingService.Subscription is not mocked")
+	}
+	return mock.SubscriptionFunc(user)
+}
+
+func (mock *BillingService) Checkout(ctx context.Context, user models.User, planSlug string, couponCode string) (string, error) {
+	if mock.CheckoutFunc == nil {
+		panic("mocks: BillingService.Checkout is not mocked")
+	}
+	return mock.CheckoutFunc(ctx, user, planSlug, couponCode)
+}
+
+func (mock *BillingService) QuoteCoupon(user models.User, couponCode string, planSlug string) (services.CouponQuote, error) {
+	if mock.QuoteCouponFunc == nil {
+		panic("mocks: BillingService.QuoteCoupon is not mocked")
+	}
+	return mock.QuoteCouponFunc(user, couponCode, planSlug)
+}
+
+func (mock *BillingService) Cancel(ctx context.Context, user models.User) (models.Subscription, error) {
+	if mock.CancelFunc == nil {
+		panic("mocks: BillingService.Cancel is not mocked")
+	}
+	return mock.CancelFunc(ctx, user)
+}
+
+func (mock *BillingService) HandleWebhook(payload []byte, signature string) error {
+	if mock.HandleWebhookFunc == nil {
+		panic("mocks: BillingService.HandleWebhook is not mocked")
+	}
+	return mock.HandleWebhookFunc(payload, signature)
+}
+
+func (mock *BillingService) ReplayWebhook(ctx context.Context, payload []byte) error {
+	if mock.ReplayWebhookFunc == nil {
+		panic("mocks: BillingService.ReplayWebhook is not mocked")
+	}
+	return mock.ReplayWebhookFunc(ctx, payload)
+}
+
+func (mock *BillingService) HasPlan(userID uint, planSlug string) (bool, error) {
+	if mock.HasPlanFunc == nil {
+		panic("mocks: BillingService.HasPlan is not mocked")
+	}
+	return mock.HasPlanFunc(userID, planSlug)
+}
+
+// ChallengeService is a mock of services.IChallengeService
+type ChallengeService struct {
+	RequiredFunc func(ctx context.Context, action string, subject services.ChallengeSubject) (bool, error)
+	CheckFunc    func(ctx context.Context, action string, subject services.ChallengeSubject, token string) error
+	RecordFunc   func(ctx context.Context, action string, subject services.ChallengeSubject) error
+	ForgetFunc   func(ctx context.Context, action string, subject services.ChallengeSubject) error
+}
+
+var _ services.IChallengeService = (*ChallengeService)(nil)
+
+func (mock *ChallengeService) Required(ctx context.Context, action string, subject services.ChallengeSubject) (bool, error) {
+	if mock.RequiredFunc == nil {
+		panic("mocks: ChallengeService.Required is not mocked")
+	}
+	return mock.RequiredFunc(ctx, action, subject)
+}
+
+func (mock *ChallengeService) Check(ctx context.Context, action string, subject services.ChallengeSubject, token string) error {
+	if mock.CheckFunc == nil {
+		panic("mocks: ChallengeService.Check is not mocked")
+	}
+	return mock.CheckFunc(ctx, action, subject, token)
+}
+
+func (mock *ChallengeService) Record(ctx context.Context, action string, subject services.ChallengeSubject) error {
+	if mock.RecordFunc == nil {
+		panic("mocks: ChallengeService.Record is not mocked")
+	}
+	return mock.RecordFunc(ctx, action, subject)
+}
+
+func (mock *ChallengeService) Forget(ctx context.Context, action string, subject services.ChallengeSubject) error {
+	if mock.ForgetFunc == nil {
+		panic("mocks: ChallengeService.Forget is not mocked")
+	}
+	return mock.ForgetFunc(ctx, action, subject)
+}
+
+// ClientService is a mock of services.IClientService
+type ClientService struct {
+	GetClientsFunc              func() ([]models.Client, error)
+	RegisterFunc                func(creator models.User, name string, scopes []string, rateLimit *int, certificateIdentity *string) (client models.Client, secret string, err error)
+	RevokeFunc                  func(ID uint) (models.Client, error)
+	GrantFunc                   func(clientID string, secret string, scopes []string) (services.ClientToken, error)
+	GetActiveClientFunc         func(ID uint) (models.Client, error)
+	AuthenticateCertificateFunc func(identities []string) (models.Client, error)
+	ThrottleFunc                func(ctx context.Context, client models.Client) (services.QuotaStatus, error)
+}
+
+var _ services.IClientService = (*ClientService)(nil)
+
+func (mock *ClientService) GetClients() ([]models.Client, error) {
+	if mock.GetClientsFunc == nil {
+		panic("mocks: ClientService.GetClients is not mocked")
+	}
+	return mock.GetClientsFunc()
+}
+
+func (mock *ClientService) Register(creator models.User, name string, scopes []string, rateLimit *int, certificateIdentity *string) (client models.Client, secret string, err error) {
+	if mock.RegisterFunc == nil {
+		panic("mocks: ClientService.Register is not mocked")
+	}
+	return mock.RegisterFunc(creator, name, scopes, rateLimit, certificateIdentity)
+}
+
+func (mock *ClientService) Revoke(ID uint) (models.Client, error) {
+	if mock.RevokeFunc == nil {
+		panic("mocks: ClientService.Revoke is not mocked")
+	}
+	return mock.RevokeFunc(ID)
+}
+
+func (mock *ClientService) Grant(clientID string, secret string, scopes []string) (services.ClientToken, error) {
+	if mock.GrantFunc == nil {
+		panic("mocks: ClientService.Grant is not mocked")
+	}
+	return mock.GrantFunc(clientID, secret, scopes)
+}
+
+func (mock *ClientService) GetActiveClient(ID uint) (models.Client, error) {
+	if mock.GetActiveClientFunc == nil {
+		panic("mocks: ClientService.GetActiveClient is not mocked")
+	}
+	return mock.GetActiveClientFunc(ID)
+}
+
+func (mock *ClientService) AuthenticateCertificate(identities []string) (models.Client, error) {
+	if mock.AuthenticateCertificateFunc == nil {
+		panic("mocks: ClientService.AuthenticateCertificate is not mocked")
+	}
+	return mock.AuthenticateCertificateFunc(identities)
+}
+
+func (mock *ClientService) Throttle(ctx context.Context, client models.Client) (services.QuotaStatus, error) {
+	if mock.ThrottleFunc == nil {
+		panic("mocks: ClientService.Throttle is not mocked")
+	}
+	return mock.ThrottleFunc(ctx, client)
+}
+
+// CommentService is a mock of services.ICommentService
+type CommentService struct {
+	ThreadsFunc         func(viewer models.User, commentableType string, commentableID uint, pagination utils.IPagination, order utils.IOrder) (comments []models.Comment, totalCount int64, err error)
+	CreateFunc          func(user models.User, commentableType string, commentableID uint, parentID *uint, body string) (models.Comment, error)
+	UpdateFunc          func(user models.User, commentID uint, body string) (models.Comment, error)
+	DeleteFunc          func(user models.User, commentID uint) error
+	FlagFunc            func(user models.User, commentID uint, reason string) error
+	ModerationQueueFunc func(pagination utils.IPagination) (comments []models.Comment, totalCount int64, err error)
+	ApproveFunc         func(admin models.User, commentID uint) (models.Comment, error)
+	RemoveFunc          func(admin models.User, commentID uint) (models.Comment, error)
+}
+
+var _ services.ICommentService = (*CommentService)(nil)
+
+func (mock *CommentService) Threads(viewer models.User, commentableType string, commentableID uint, pagination utils.IPagination, order utils.IOrder) (comments []models.Comment, totalCount int64, err error) {
+	if mock.ThreadsFunc == nil {
+		panic("mocks: CommentService.Threads is not mocked")
+	}
+	return mock.ThreadsFunc(viewer, commentableType, commentableID, pagination, order)
+}
+
+func (mock *CommentService) Create(user models.User, commentableType string, commentableID uint, parentID *uint, body string) (models.Comment, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: CommentService.Create is not mocked")
+	}
+	return mock.CreateFunc(user, commentableType, commentableID, parentID, body)
+}
+
+func (mock *CommentService) Update(user models.User, commentID uint, body string) (models.Comment, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: CommentService.Update is not mocked")
+	}
+	return mock.UpdateFunc(user, commentID, body)
+}
+
+func (mock *CommentService) Delete(user models.User, commentID uint) error {
+	if mock.DeleteFunc == nil {
+		panic("mocks: CommentService.Delete is not mocked")
+	}
+	return mock.DeleteFunc(user, commentID)
+}
+
+func (mock *CommentService) Flag(user models.User, commentID uint, reason string) error {
+	if mock.FlagFunc == nil {
+		panic("mocks: CommentService.Flag is not mocked")
+	}
+	return mock.FlagFunc(user, commentID, reason)
+}
+
+func (mock *CommentService) ModerationQueue(pagination utils.IPagination) (comments []models.Comment, totalCount int64, err error) {
+	if mock.ModerationQueueFunc == nil {
+		panic("mocks: CommentService.ModerationQueue is not mocked")
+	}
+	return mock.ModerationQueueFunc(pagination)
+}
+
+func (mock *CommentService) Approve(admin models.User, commentID uint) (models.Comment, error) {
+	if mock.ApproveFunc == nil {
+		panic("mocks: CommentService.Approve is not mocked")
+	}
+	return mock.ApproveFunc(admin, commentID)
+}
+
+func (mock *CommentService) Remove(admin models.User, commentID uint) (models.Comment, error) {
+	if mock.RemoveFunc == nil {
+		panic("mocks: CommentService.Remove is not mocked")
+	}
+	return mock.RemoveFunc(admin, commentID)
+}
+
+// ConfigService is a mock of services.IConfigService
+type ConfigService struct {
+	RecordFunc func()
+	ReportFunc func() (services.ConfigReport, error)
+}
+
+var _ services.IConfigService = (*ConfigService)(nil)
+
+func (mock *ConfigService) Record() {
+	if mock.RecordFunc == nil {
+		panic("mocks: ConfigService.Record is not mocked")
+	}
+	mock.RecordFunc()
+}
+
+func (mock *ConfigService) Report() (services.ConfigReport, error) {
+	if mock.ReportFunc == nil {
+		panic("mocks: ConfigService.Report is not mocked")
+	}
+	return mock.ReportFunc()
+}
+
+// ConsentService is a mock of services.IConsentService
+type ConsentService struct {
+	GetCurrentPoliciesFunc func() ([]models.Policy, error)
+	GetPendingPoliciesFunc func(user models.User) ([]models.Policy, error)
+	AcceptFunc             func(user models.User, policyID uint, ip string) (models.Consent, error)
+}
+
+var _ services.IConsentService = (*ConsentService)(nil)
+
+func (mock *ConsentService) GetCurrentPolicies() ([]models.Policy, error) {
+	if mock.GetCurrentPoliciesFunc == nil {
+		panic("mocks: ConsentService.GetCurrentPolicies is not mocked")
+	}
+	return mock.GetCurrentPoliciesFunc()
+}
+
+func (mock *ConsentService) GetPendingPolicies(user models.User) ([]models.Policy, error) {
+	if mock.GetPendingPoliciesFunc == nil {
+		panic("mocks: ConsentService.GetPendingPolicies is not mocked")
+	}
+	return mock.GetPendingPoliciesFunc(user)
+}
+
+func (mock *ConsentService) Accept(user models.User, policyID uint, ip string) (models.Consent, error) {
+	if mock.AcceptFunc == nil {
+		panic("mocks: ConsentService.Accept is not mocked")
+	}
+	return mock.AcceptFunc(user, policyID, ip)
+}
+
+// ConversationService is a mock of services.IConversationService
+type ConversationService struct {
+	StartFunc         func(user models.User, recipientIDs []uint, body string) (models.Conversation, error)
+	ConversationsFunc func(user models.User, pagination utils.IPagination) (conversations []models.Conversation, totalCount int64, err error)
+	MessagesFunc      func(user models.User, conversationID uint, beforeID uint, limit int) ([]models.Message, error)
+	SendFunc          func(user models.User, conversationID uint, body string) (models.Message, error)
+	ReadFunc          func(user models.User, conversationID uint, messageID uint) (services.UnreadCounters, error)
+	UnreadFunc        func(user models.User) (services.UnreadCounters, error)
+}
+
+var _ services.IConversationService = (*ConversationService)(nil)
+
+func (mock *ConversationService) Start(user models.User, recipientIDs []uint, body string) (models.Conversation, error) {
+	if mock.StartFunc == nil {
+		panic("mocks: ConversationService.Start is not mocked")
+	}
+	return mock.StartFunc(user, recipientIDs, body)
+}
+
+func (mock *ConversationService) Conversations(user models.User, pagination utils.IPagination) (conversations []models.Conversation, totalCount int64, err error) {
+	if mock.ConversationsFunc == nil {
+		panic("mocks: ConversationService.Conversations is not mocked")
+	}
+	return mock.ConversationsFunc(user, pagination)
+}
+
+func (mock *ConversationService) Messages(user models.User, conversationID uint, beforeID uint, limit int) ([]models.Message, error) {
+	if mock.MessagesFunc == nil {
+		panic("mocks: ConversationService.Messages is not mocked")
+	}
+	return mock.MessagesFunc(user, conversationID, beforeID, limit)
+}
+
+func (mock *ConversationService) Send(user models.User, conversationID uint, body string) (models.Message, error) {
+	if mock.SendFunc == nil {
+		panic("mocks: ConversationService.Send is not mocked")
+	}
+	return mock.SendFunc(user, conversationID, body)
+}
+
+func (mock *ConversationService) Read(user models.User, conversationID uint, messageID uint) (services.UnreadCounters, error) {
+	if mock.ReadFunc == nil {
+		panic("mocks: ConversationService.Read is not mocked")
+	}
+	return mock.ReadFunc(user, conversationID, messageID)
+}
+
+func (mock *ConversationService) Unread(user models.User) (services.UnreadCounters, error) {
+	if mock.UnreadFunc == nil {
+		panic("mocks: ConversationService.Unread is not mocked")
+	}
+	return mock.UnreadFunc(user)
+}
+
+// CouponService is a mock of services.ICouponService
+type CouponService struct {
+	GetCouponsFunc func() ([]models.Coupon, error)
+	CreateFunc     func(ctx context.Context, admin models.User, input services.CouponInput) (models.Coupon, error)
+	UpdateFunc     func(ID uint, updates map[string]interface{}) (models.Coupon, error)
+	DeactivateFunc func(ID uint) (models.Coupon, error)
+	QuoteFunc      func(user models.User, code string, plan models.Plan) (services.CouponQuote, error)
+	RedeemFunc     func(code string, userID uint, subscriptionID string) error
+}
+
+var _ services.ICouponService = (*CouponService)(nil)
+
+func (mock *CouponService) GetCoupons() ([]models.Coupon, error) {
+	if mock.GetCouponsFunc == nil {
+		panic("mocks: CouponService.GetCoupons is not mocked")
+	}
+	return mock.GetCouponsFunc()
+}
+
+func (mock *CouponService) Create(ctx context.Context, admin models.User, input services.CouponInput) (models.Coupon, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: CouponService.Create is not mocked")
+	}
+	return mock.CreateFunc(ctx, admin, input)
+}
+
+func (mock *CouponService) Update(ID uint, updates map[string]interface{}) (models.Coupon, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: CouponService.Update is not mocked")
+	}
+	return mock.UpdateFunc(ID, updates)
+}
+
+func (mock *CouponService) Deactivate(ID uint) (models.Coupon, error) {
+	if mock.DeactivateFunc == nil {
+		panic("mocks: CouponService.Deactivate is not mocked")
+	}
+	return mock.DeactivateFunc(ID)
+}
+
+func (mock *CouponService) Quote(user models.User, code string, plan models.Plan) (services.CouponQuote, error) {
+	if mock.QuoteFunc == nil {
+		panic("mocks: CouponService.Quote is not mocked")
+	}
+	return mock.QuoteFunc(user, code, plan)
+}
+
+func (mock *CouponService) Redeem(code string, userID uint, subscriptionID string) error {
+	if mock.RedeemFunc == nil {
+		panic("mocks: CouponService.Redeem is not mocked")
+	}
+	return mock.RedeemFunc(code, userID, subscriptionID)
+}
+
+// DeadLetterService is a mock of services.IDeadLetterService
+type DeadLetterService struct {
+	RegisterReplayerFunc func(source string, replayer services.WebhookReplayer)
+	GuardFunc            func(handler infrastructures.JobHandler) infrastructures.JobHandler
+	RecordWebhookFunc    func(source string, eventID string, payload []byte, failure error)
+	ResolveWebhookFunc   func(eventID string)
+	ListFunc             func(filter repositories.DeadLetterFilter, pagination utils.IPagination) (letters []models.DeadLetter, totalCount int64, err error)
+	GetFunc              func(ID uint) (models.DeadLetter, error)
+	ReplayFunc           func(ctx context.Context, ID uint) (models.DeadLetter, error)
+	ReplayManyFunc       func(ctx context.Context, ids []uint, filter repositories.DeadLetterFilter, limit int) ([]services.DeadLetterReplay, error)
+	DeleteFunc           func(ID uint) error
+	PurgeFunc            func(filter repositories.DeadLetterFilter) (int64, error)
+	CountFunc            func() error
+}
+
+var _ services.IDeadLetterService = (*DeadLetterService)(nil)
+
+func (mock *DeadLetterService) RegisterReplayer(source string, replayer services.WebhookReplayer) {
+	if mock.RegisterReplayerFunc == nil {
+		panic("mocks: DeadLetterService.RegisterReplayer is not mocked")
+	}
+	mock.RegisterReplayerFunc(source, replayer)
+}
+
+func (mock *DeadLetterService) Guard(handler infrastructures.JobHandler) infrastructures.JobHandler {
+	if mock.GuardFunc == nil {
+		panic("mocks: DeadLetterService.Guard is not mocked")
+	}
+	return mock.GuardFunc(handler)
+}
+
+func (mock *DeadLetterService) RecordWebhook(source string, eventID string, payload []byte, failure error) {
+	if mock.RecordWebhookFunc == nil {
+		panic("mocks: DeadLetterService.RecordWebhook is not mocked")
+	}
+	mock.RecordWebhookFunc(source, eventID, payload, failure)
+}
+
+func (mock *DeadLetterService) ResolveWebhook(eventID string) {
+	if mock.ResolveWebhookFunc == nil {
+		panic("mocks: DeadLetterService.ResolveWebhook is not mocked")
+	}
+	mock.ResolveWebhookFunc(eventID)
+}
+
+func (mock *DeadLetterService) List(filter repositories.DeadLetterFilter, pagination utils.IPagination) (letters []models.DeadLetter, totalCount int64, err error) {
+	if mock.ListFunc == nil {
+		panic("mocks: DeadLetterService.List is not mocked")
+	}
+	return mock.ListFunc(filter, pagination)
+}
+
+func (mock *DeadLetterService) Get(ID uint) (models.DeadLetter, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: DeadLetterService.Get is not mocked")
+	}
+	return mock.GetFunc(ID)
+}
+
+func (mock *DeadLetterService) Replay(ctx context.Context, ID uint) (models.DeadLetter, error) {
+	if mock.ReplayFunc == nil {
+		panic("mocks: DeadLetterService.Replay is not mocked")
+	}
+	return mock.ReplayFunc(ctx, ID)
+}
+
+func (mock *DeadLetterService) ReplayMany(ctx context.Context, ids []uint, filter repositories.DeadLetterFilter, limit int) ([]services.DeadLetterReplay, error) {
+	if mock.ReplayManyFunc == nil {
+		panic("mocks: DeadLetterService.ReplayMany is not mocked")
+	}
+	return mock.ReplayManyFunc(ctx, ids, filter, limit)
+}
+
+func (mock *DeadLetterService) Delete(ID uint) error {
+	if mock.DeleteFunc == nil {
+		panic("mocks: DeadLetterService.Delete is not mocked")
+	}
+	return mock.DeleteFunc(ID)
+}
+
+func (mock *DeadLetterService) Purge(filter repositories.DeadLetterFilter) (int64, error) {
+	if mock.PurgeFunc == nil {
+		panic("mocks: DeadLetterService.Purge is not mocked")
+	}
+	return mock.PurgeFunc(filter)
+}
+
+func (mock *DeadLetterService) Count() error {
+	if mock.CountFunc == nil {
+		panic("mocks: DeadLetterService.Count is not mocked")
+	}
+	return mock.CountFunc()
+}
+
+// DeprecationService is a mock of services.IDeprecationService
+type DeprecationService struct {
+	RegisterFunc func(deprecation services.Deprecation)
+	GetFunc      func(method string, path string) (services.Deprecation, bool)
+	RecordFunc   func(deprecation services.Deprecation, caller string)
+	ReportFunc   func(since time.Time) ([]services.DeprecationReport, error)
+}
+
+var _ services.IDeprecationService = (*DeprecationService)(nil)
+
+func (mock *DeprecationService) Register(deprecation services.Deprecation) {
+	if mock.RegisterFunc == nil {
+		panic("mocks: DeprecationService.Register is not mocked")
+	}
+	mock.RegisterFunc(deprecation)
+}
+
+func (mock *DeprecationService) Get(method string, path string) (services.Deprecation, bool) {
+	if mock.GetFunc == nil {
+		panic("mocks: DeprecationService.Get is not mocked")
+	}
+	return mock.GetFunc(method, path)
+}
+
+func (mock *DeprecationService) Record(deprecation services.Deprecation, caller string) {
+	if mock.RecordFunc == nil {
+		panic("mocks: DeprecationService.Record is not mocked")
+	}
+	mock.RecordFunc(deprecation, caller)
+}
+
+func (mock *DeprecationService) Report(since time.Time) ([]services.DeprecationReport, error) {
+	if mock.ReportFunc == nil {
+		panic("mocks: DeprecationService.Report is not mocked")
+	}
+	return mock.ReportFunc(since)
+}
+
+// EmailChangeService is a mock of services.IEmailChangeService
+type EmailChangeService struct {
+	RequestFunc func(user models.User, currentPassword string, newEmail string) (models.EmailChange, error)
+	ConfirmFunc func(ctx context.Context, token string) (models.User, error)
+}
+
+var _ services.IEmailChangeService = (*EmailChangeService)(nil)
+
+func (mock *EmailChangeService) Request(user models.User, currentPassword string, newEmail string) (models.EmailChange, error) {
+	if mock.RequestFunc == nil {
+		panic("mocks: EmailChangeService.Request is not mocked")
+	}
+	return mock.RequestFunc(user, currentPassword, newEmail)
+}
+
+func (mock *EmailChangeService) Confirm(ctx context.Context, token string) (models.User, error) {
+	if mock.ConfirmFunc == nil {
+		panic("mocks: EmailChangeService.Confirm is not mocked")
+	}
+	return mock.ConfirmFunc(ctx, token)
+}
+
+// FollowService is a mock of services.IFollowService
+type FollowService struct {
+	FollowFunc       func(user models.User, userID uint) (services.Relationship, error)
+	UnfollowFunc     func(user models.User, userID uint) (services.Relationship, error)
+	RelationshipFunc func(user models.User, userID uint) (services.Relationship, error)
+	FollowersFunc    func(userID uint, pagination utils.IPagination) (users []models.User, totalCount int64, err error)
+	FollowingFunc    func(userID uint, pagination utils.IPagination) (users []models.User, totalCount int64, err error)
+}
+
+var _ services.IFollowService = (*FollowService)(nil)
+
+func (mock *FollowService) Follow(user models.User, userID uint) (services.Relationship, error) {
+	if mock.FollowFunc == nil {
+		panic("mocks: FollowService.Follow is not mocked")
+	}
+	return mock.FollowFunc(user, userID)
+}
+
+func (mock *FollowService) Unfollow(user models.User, userID uint) (services.Relationship, error) {
+	if mock.UnfollowFunc == nil {
+		panic("mocks: FollowService.Unfollow is not mocked")
+	}
+	return mock.UnfollowFunc(user, userID)
+}
+
+func (mock *FollowService) Relationship(user models.User, userID uint) (services.Relationship, error) {
+	if mock.RelationshipFunc == nil {
+		panic("mocks: FollowService.Relationship is not mocked")
+	}
+	return mock.RelationshipFunc(user, userID)
+}
+
+func (mock *FollowService) Followers(userID uint, pagination utils.IPagination) (users []models.User, totalCount int64, err error) {
+	if mock.FollowersFunc == nil {
+		panic("mocks: FollowService.Followers is not mocked")
+	}
+	return mock.FollowersFunc(userID, pagination)
+}
+
+func (mock *FollowService) Following(userID uint, pagination utils.IPagination) (users []models.User, totalCount int64, err error) {
+	if mock.FollowingFunc == nil {
+		panic("mocks: FollowService.Following is not mocked")
+	}
+	return mock.FollowingFunc(userID, pagination)
+}
+
+// ImpersonationService is a mock of services.IImpersonationService
+type ImpersonationService struct {
+	ImpersonateFunc func(admin models.User, userID uint, ip string) (services.Impersonation, error)
+}
+
+var _ services.IImpersonationService = (*ImpersonationService)(nil)
+
+func (mock *ImpersonationService) Impersonate(admin models.User, userID uint, ip string) (services.Impersonation, error) {
+	if mock.ImpersonateFunc == nil {
+		panic("mocks: ImpersonationService.Impersonate is not mocked")
+	}
+	return mock.ImpersonateFunc(admin, userID, ip)
+}
+
+// InvitationService is a mock of services.IInvitationService
+type InvitationService struct {
+	GetInvitationsFunc func() ([]models.Invitation, error)
+	CreateFunc         func(admin models.User, maxUses int, ttl time.Duration, email string) (models.Invitation, error)
+	RevokeFunc         func(ID uint) (models.Invitation, error)
+}
+
+var _ services.IInvitationService = (*InvitationService)(nil)
+
+func (mock *InvitationService) GetInvitations() ([]models.Invitation, error) {
+	if mock.GetInvitationsFunc == nil {
+		panic("mocks: InvitationService.GetInvitations is not mocked")
+	}
+	return mock.GetInvitationsFunc()
+}
+
+func (mock *InvitationService) Create(admin models.User, maxUses int, ttl time.Duration, email string) (models.Invitation, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: InvitationService.Create is not mocked")
+	}
+	return mock.CreateFunc(admin, maxUses, ttl, email)
+}
+
+func (mock *InvitationService) Revoke(ID uint) (models.Invitation, error) {
+	if mock.RevokeFunc == nil {
+		panic("mocks: InvitationService.Revoke is not mocked")
+	}
+	return mock.RevokeFunc(ID)
+}
+
+// InvoiceService is a mock of services.IInvoiceService
+type InvoiceService struct {
+	RecordFunc        func(incoming infrastructures.PaymentInvoice) error
+	InvoicesFunc      func(user models.User) ([]models.Invoice, error)
+	DownloadFunc      func(id uint, expires int64, signature string) (models.Invoice, infrastructures.StorageFile, error)
+	RenderPendingFunc func() error
+}
+
+var _ services.IInvoiceService = (*InvoiceService)(nil)
+
+func (mock *InvoiceService) Record(incoming infrastructures.PaymentInvoice) error {
+	if mock.RecordFunc == nil {
+		panic("mocks: InvoiceService.Record is not mocked")
+	}
+	return mock.RecordFunc(incoming)
+}
+
+func (mock *InvoiceService) Invoices(user models.User) ([]models.Invoice, error) {
+	if mock.InvoicesFunc == nil {
+		panic("mocks: InvoiceService.Invoices is not mocked")
+	}
+	return mock.InvoicesFunc(user)
+}
+
+func (mock *InvoiceService) Download(id uint, expires int64, signature string) (models.Invoice, infrastructures.StorageFile, error) {
+	if mock.DownloadFunc == nil {
+		panic("mocks: InvoiceService.Download is not mocked")
+	}
+	return mock.DownloadFunc(id, expires, signature)
+}
+
+func (mock *InvoiceService) RenderPending() error {
+	if mock.RenderPendingFunc == nil {
+		panic("mocks: InvoiceService.RenderPending is not mocked")
+	}
+	return mock.RenderPendingFunc()
+}
+
+// MagicLinkService is a mock of services.IMagicLinkService
+type MagicLinkService struct {
+	SendFunc     func(ctx context.Context, email string) error
+	ExchangeFunc func(ctx context.Context, token string) (models.User, error)
+}
+
+var _ services.IMagicLinkService = (*MagicLinkService)(nil)
+
+func (mock *MagicLinkService) Send(ctx context.Context, email string) error {
+	if mock.SendFunc == nil {
+		panic("mocks: MagicLinkService.Send is not mocked")
+	}
+	return mock.SendFunc(ctx, email)
+}
+
+func (mock *MagicLinkService) Exchange(ctx context.Context, token string) (models.User, error) {
+	if mock.ExchangeFunc == nil {
+		panic("mocks: MagicLinkService.Exchange is not mocked")
+	}
+	return mock.ExchangeFunc(ctx, token)
+}
+
+// MediaService is a mock of services.IMediaService
+type MediaService struct {
+	UploadFunc         func(user models.User, name string, content io.Reader, visibility string) (models.Media, error)
+	MediaOfUserFunc    func(user models.User, pagination utils.IPagination) (media []models.Media, totalCount int64, err error)
+	GetFunc            func(user models.User, mediaID uint) (models.Media, error)
+	ContentFunc        func(user models.User, mediaID uint) (models.Media, infrastructures.StorageFile, error)
+	AttachFunc         func(user models.User, mediaID uint, attachableType string, attachableID uint) (models.Media, error)
+	DetachFunc         func(user models.User, mediaID uint) (models.Media, error)
+	DeleteFunc         func(user models.User, mediaID uint) error
+	CleanupOrphansFunc func() error
+	UsageFunc          func(limit int) (services.MediaUsageReport, error)
+}
+
+var _ services.IMediaService = (*MediaService)(nil)
+
+func (mock *MediaService) Upload(user models.User, name string, content io.Reader, visibility string) (models.Media, error) {
+	if mock.UploadFunc == nil {
+		panic("mocks: MediaService.Upload is not mocked")
+	}
+	return mock.UploadFunc(user, name, content, visibility)
+}
+
+func (mock *MediaService) MediaOfUser(user models.User, pagination utils.IPagination) (media []models.Media, totalCount int64, err error) {
+	if mock.MediaOfUserFunc == nil {
+		panic("mocks: MediaService.MediaOfUser is not mocked")
+	}
+	return mock.MediaOfUserFunc(user, pagination)
+}
+
+func (mock *MediaService) Get(user models.User, mediaID uint) (models.Media, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: MediaService.Get is not mocked")
+	}
+	return mock.GetFunc(user, mediaID)
+}
+
+func (mock *MediaService) Content(user models.User, mediaID uint) (models.Media, infrastructures.StorageFile, error) {
+	if mock.ContentFunc == nil {
+		panic("mocks: MediaService.Content is not mocked")
+	}
+	return mock.ContentFunc(user, mediaID)
+}
+
+func (mock *MediaService) Attach(user models.User, mediaID uint, attachableType string, attachableID uint) (models.Media, error) {
+	if mock.AttachFunc == nil {
+		panic("mocks: MediaService.Attach is not mocked")
+	}
+	return mock.AttachFunc(user, mediaID, attachableType, attachableID)
+}
+
+func (mock *MediaService) Detach(user models.User, mediaID uint) (models.Media, error) {
+	if mock.DetachFunc == nil {
+		panic("mocks: MediaService.Detach is not mocked")
+	}
+	return mock.DetachFunc(user, mediaID)
+}
+
+func (mock *MediaService) Delete(user models.User, mediaID uint) error {
+	if mock.DeleteFunc == nil {
+		panic("mocks: MediaService.Delete is not mocked")
+	}
+	return mock.DeleteFunc(user, mediaID)
+}
+
+func (mock *MediaService) CleanupOrphans() error {
+	if mock.CleanupOrphansFunc == nil {
+		panic("mocks: MediaService.CleanupOrphans is not mocked")
+	}
+	return mock.CleanupOrphansFunc()
+}
+
+func (mock *MediaService) Usage(limit int) (services.MediaUsageReport, error) {
+	if mock.UsageFunc == nil {
+		panic("mocks: MediaService.Usage is not mocked")
+	}
+	return mock.UsageFunc(limit)
+}
+
+// NotificationService is a mock of services.INotificationService
+type NotificationService struct {
+	RegisterDeviceFunc        func(user models.User, token string, platform string) (models.Device, error)
+	DevicesFunc               func(user models.User) ([]models.Device, error)
+	UnregisterDeviceFunc      func(user models.User, deviceID uint) error
+	PushPreferencesFunc       func(user models.User) (services.PushPreferences, error)
+	UpdatePushPreferencesFunc func(user models.User, preferences services.PushPreferences) (services.PushPreferences, error)
+	PushFunc                  func(userID uint, category string, notification infrastructures.PushNotification) error
+	MessageCreatedFunc        func(event infrastructures.Event) error
+	FollowCreatedFunc         func(event infrastructures.Event) error
+}
+
+var _ services.INotificationService = (*NotificationService)(nil)
+
+func (mock *NotificationService) RegisterDevice(user models.User, token string, platform string) (models.Device, error) {
+	if mock.RegisterDeviceFunc == nil {
+		panic("mocks: NotificationService.RegisterDevice is not mocked")
+	}
+	return mock.RegisterDeviceFunc(user, token, platform)
+}
+
+func (mock *NotificationService) Devices(user models.User) ([]models.Device, error) {
+	if mock.DevicesFunc == nil {
+		panic("mocks: NotificationService.Devices is not mocked")
+	}
+	return mock.DevicesFunc(user)
+}
+
+func (mock *NotificationService) UnregisterDevice(user models.User, deviceID uint) error {
+	if mock.UnregisterDeviceFunc == nil {
+		panic("mocks: NotificationService.UnregisterDevice is not mocked")
+	}
+	return mock.UnregisterDeviceFunc(user, deviceID)
+}
+
+func (mock *NotificationService) PushPreferences(user models.User) (services.PushPreferences, error) {
+	if mock.PushPreferencesFunc == nil {
+		panic("mocks: NotificationService.PushPreferences is not mocked")
+	}
+	return mock.PushPreferencesFunc(user)
+}
+
+func (mock *NotificationService) UpdatePushPreferences(user models.User, preferences services.PushPreferences) (services.PushPreferences, error) {
+	if mock.UpdatePushPreferencesFunc == nil {
+		panic("mocks: NotificationService.UpdatePushPreferences is not mocked")
+	}
+	return mock.UpdatePushPreferencesFunc(user, preferences)
+}
+
+func (mock *NotificationService) Push(userID uint, category string, notification infrastructures.PushNotification) error {
+	if mock.PushFunc == nil {
+		panic("mocks: NotificationService.Push is not mocked")
+	}
+	return mock.PushFunc(userID, category, notification)
+}
+
+func (mock *NotificationService) MessageCreated(event infrastructures.Event) error {
+	if mock.MessageCreatedFunc == nil {
+		panic("mocks: NotificationService.MessageCreated is not mocked")
+	}
+	return mock.MessageCreatedFunc(event)
+}
+
+func (mock *NotificationService) FollowCreated(event infrastructures.Event) error {
+	if mock.FollowCreatedFunc == nil {
+		panic("mocks: NotificationService.FollowCreated is not mocked")
+	}
+	return mock.FollowCreatedFunc(event)
+}
+
+// PhoneService is a mock of services.IPhoneService
+type PhoneService struct {
+	UpdateFunc   func(user models.User, phone string) (models.User, error)
+	SendCodeFunc func(user models.User) (models.User, error)
+	VerifyFunc   func(user models.User, code string) (models.User, error)
+	RemoveFunc   func(user models.User) (models.User, error)
+}
+
+var _ services.IPhoneService = (*PhoneService)(nil)
+
+func (mock *PhoneService) Update(user models.User, phone string) (models.User, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: PhoneService.Update is not mocked")
+	}
+	return mock.UpdateFunc(user, phone)
+}
+
+func (mock *PhoneService) SendCode(user models.User) (models.User, error) {
+	if mock.SendCodeFunc == nil {
+		panic("mocks: PhoneService.SendCode is not mocked")
+	}
+	return mock.SendCodeFunc(user)
+}
+
+func (mock *PhoneService) Verify(user models.User, code string) (models.User, error) {
+	if mock.VerifyFunc == nil {
+		panic("mocks: PhoneService.Verify is not mocked")
+	}
+	return mock.VerifyFunc(user, code)
+}
+
+func (mock *PhoneService) Remove(user models.User) (models.User, error) {
+	if mock.RemoveFunc == nil {
+		panic("mocks: PhoneService.Remove is not mocked")
+	}
+	return mock.RemoveFunc(user)
+}
+
+// PrivacyService is a mock of services.IPrivacyService
+type PrivacyService struct {
+	RequestDataExportFunc       func(user models.User) (models.DataExport, error)
+	BuildDataExportFunc         func(job infrastructures.Job) error
+	GetDataExportByIDFunc       func(id uint) (models.DataExport, error)
+	GetDataExportFileFunc       func(dataExport models.DataExport) (infrastructures.StorageFile, error)
+	ScheduleDeletionFunc        func(user models.User) (models.User, error)
+	CancelDeletionFunc          func(user models.User) (models.User, error)
+	PurgeDueDeletionsFunc       func() error
+	PurgeExpiredDataExportsFunc func() error
+}
+
+var _ services.IPrivacyService = (*PrivacyService)(nil)
+
+func (mock *PrivacyService) RequestDataExport(user models.User) (models.DataExport, error) {
+	if mock.RequestDataExportFunc == nil {
+		panic("mocks: PrivacyService.RequestDataExport is not mocked")
+	}
+	return mock.RequestDataExportFunc(user)
+}
+
+func (mock *PrivacyService) BuildDataExport(job infrastructures.Job) error {
+	if mock.BuildDataExportFunc == nil {
+		panic("mocks: PrivacyService.BuildDataExport is not mocked")
+	}
+	return mock.BuildDataExportFunc(job)
+}
+
+func (mock *PrivacyService) GetDataExportByID(id uint) (models.DataExport, error) {
+	if mock.GetDataExportByIDFunc == nil {
+		panic("mocks: PrivacyService.GetDataExportByID is not mocked")
+	}
+	return mock.GetDataExportByIDFunc(id)
+}
+
+func (mock *PrivacyService) GetDataExportFile(dataExport models.DataExport) (infrastructures.StorageFile, error) {
+	if mock.GetDataExportFileFunc == nil {
+		panic("mocks: PrivacyService.GetDataExportFile is not mocked")
+	}
+	return mock.GetDataExportFileFunc(dataExport)
+}
+
+func (mock *PrivacyService) ScheduleDeletion(user models.User) (models.User, error) {
+	if mock.ScheduleDeletionFunc == nil {
+		panic("mocks: PrivacyService.ScheduleDeletion is not mocked")
+	}
+	return mock.ScheduleDeletionFunc(user)
+}
+
+func (mock *PrivacyService) CancelDeletion(user models.User) (models.User, error) {
+	if mock.CancelDeletionFunc == nil {
+		panic("mocks: PrivacyService.CancelDeletion is not mocked")
+	}
+	return mock.CancelDeletionFunc(user)
+}
+
+func (mock *PrivacyService) PurgeDueDeletions() error {
+	if mock.PurgeDueDeletionsFunc == nil {
+		panic("mocks: PrivacyService.PurgeDueDeletions is not mocked")
+	}
+	return mock.PurgeDueDeletionsFunc()
+}
+
+func (mock *PrivacyService) PurgeExpiredDataExports() error {
+	if mock.PurgeExpiredDataExportsFunc == nil {
+		panic("mocks: PrivacyService.PurgeExpiredDataExports is not mocked")
+	}
+	return mock.PurgeExpiredDataExportsFunc()
+}
+
+// PublishingService is a mock of services.IPublishingService
+type PublishingService struct {
+	PublishDueFunc func() error
+}
+
+var _ services.IPublishingService = (*PublishingService)(nil)
+
+func (mock *PublishingService) PublishDue() error {
+	if mock.PublishDueFunc == nil {
+		panic("mocks: PublishingService.PublishDue is not mocked")
+	}
+	return mock.PublishDueFunc()
+}
+
+// QuotaService is a mock of services.IQuotaService
+type QuotaService struct {
+	LimitsFunc   func(user models.User) (daily int, monthly int)
+	CheckFunc    func(user models.User, now time.Time) (services.QuotaStatus, error)
+	ConsumeFunc  func(user models.User, now time.Time) error
+	GetUsageFunc func(user models.User, year int) (services.Usage, error)
+}
+
+var _ services.IQuotaService = (*QuotaService)(nil)
+
+func (mock *QuotaService) Limits(user models.User) (daily int, monthly int) {
+	if mock.LimitsFunc == nil {
+		panic("mocks: QuotaService.Limits is not mocked")
+	}
+	return mock.LimitsFunc(user)
+}
+
+func (mock *QuotaService) Check(user models.User, now time.Time) (services.QuotaStatus, error) {
+	if mock.CheckFunc == nil {
+		panic("mocks: QuotaService.Check is not mocked")
+	}
+	return mock.CheckFunc(user, now)
+}
+
+func (mock *QuotaService) Consume(user models.User, now time.Time) error {
+	if mock.ConsumeFunc == nil {
+		panic("mocks: QuotaService.Consume is not mocked")
+	}
+	return mock.ConsumeFunc(user, now)
+}
+
+func (mock *QuotaService) GetUsage(user models.User, year int) (services.Usage, error) {
+	if mock.GetUsageFunc == nil {
+		panic("mocks: QuotaService.GetUsage is not mocked")
+	}
+	return mock.GetUsageFunc(user, year)
+}
+
+// RegistrationService is a mock of services.IRegistrationService
+type RegistrationService struct {
+	ModeFunc     func() string
+	RegisterFunc func(ctx context.Context, name string, email string, password string, invitationCode string) (models.User, error)
+	VerifyFunc   func(ctx context.Context, token string) (models.User, error)
+	SagaFunc     func() services.SagaDefinition
+}
+
+var _ services.IRegistrationService = (*RegistrationService)(nil)
+
+func (mock *RegistrationService) Mode() string {
+	if mock.ModeFunc == nil {
+		panic("mocks: RegistrationService.Mode is not mocked")
+	}
+	return mock.ModeFunc()
+}
+
+func (mock *RegistrationService) Register(ctx context.Context, name string, email string, password string, invitationCode string) (models.User, error) {
+	if mock.RegisterFunc == nil {
+		panic("mocks: RegistrationService.Register is not mocked")
+	}
+	return mock.RegisterFunc(ctx, name, email, password, invitationCode)
+}
+
+func (mock *RegistrationService) Verify(ctx context.Context, token string) (models.User, error) {
+	if mock.VerifyFunc == nil {
+		panic("mocks: RegistrationService.Verify is not mocked")
+	}
+	return mock.VerifyFunc(ctx, token)
+}
+
+func (mock *RegistrationService) Saga() services.SagaDefinition {
+	if mock.SagaFunc == nil {
+		panic("mocks: RegistrationService.Saga is not mocked")
+	}
+	return mock.SagaFunc()
+}
+
+// ReportService is a mock of services.IReportService
+type ReportService struct {
+	ReportFunc  func(user models.User, reportableType string, reportableID uint, reason string, details string) (models.Report, error)
+	QueueFunc   func(status string, pagination utils.IPagination) (reports []models.Report, totalCount int64, err error)
+	ResolveFunc func(admin models.User, reportID uint, note string) (models.Report, error)
+	DismissFunc func(admin models.User, reportID uint, note string) (models.Report, error)
+}
+
+var _ services.IReportService = (*ReportService)(nil)
+
+func (mock *ReportService) Report(user models.User, reportableType string, reportableID uint, reason string, details string) (models.Report, error) {
+	if mock.ReportFunc == nil {
+		panic("mocks: ReportService.Report is not mocked")
+	}
+	return mock.ReportFunc(user, reportableType, reportableID, reason, details)
+}
+
+func (mock *ReportService) Queue(status string, pagination utils.IPagination) (reports []models.Report, totalCount int64, err error) {
+	if mock.QueueFunc == nil {
+		panic("mocks: ReportService.Queue is not mocked")
+	}
+	return mock.QueueFunc(status, pagination)
+}
+
+func (mock *ReportService) Resolve(admin models.User, reportID uint, note string) (models.Report, error) {
+	if mock.ResolveFunc == nil {
+		panic("mocks: ReportService.Resolve is not mocked")
+	}
+	return mock.ResolveFunc(admin, reportID, note)
+}
+
+func (mock *ReportService) Dismiss(admin models.User, reportID uint, note string) (models.Report, error) {
+	if mock.DismissFunc == nil {
+		panic("mocks: ReportService.Dismiss is not mocked")
+	}
+	return mock.DismissFunc(admin, reportID, note)
+}
+
+// RetentionService is a mock of services.IRetentionService
+type RetentionService struct {
+	RegisterFunc func(policy services.RetentionPolicy)
+	PoliciesFunc func() []services.RetentionPolicy
+	EnforceFunc  func() error
+}
+
+var _ services.IRetentionService = (*RetentionService)(nil)
+
+func (mock *RetentionService) Register(policy services.RetentionPolicy) {
+	if mock.RegisterFunc == nil {
+		panic("mocks: RetentionService.Register is not mocked")
+	}
+	mock.RegisterFunc(policy)
+}
+
+func (mock *RetentionService) Policies() []services.RetentionPolicy {
+	if mock.PoliciesFunc == nil {
+		panic("mocks: RetentionService.Policies is not mocked")
+	}
+	return mock.PoliciesFunc()
+}
+
+func (mock *RetentionService) Enforce() error {
+	if mock.EnforceFunc == nil {
+		panic("mocks: RetentionService.Enforce is not mocked")
+	}
+	return mock.EnforceFunc()
+}
+
+// SagaService is a mock of services.ISagaService
+type SagaService struct {
+	RegisterFunc func(definition services.SagaDefinition)
+	StartFunc    func(ctx context.Context, name string, state services.SagaState) (services.SagaState, error)
+	ResumeFunc   func() error
+}
+
+var _ services.ISagaService = (*SagaService)(nil)
+
+func (mock *SagaService) Register(definition services.SagaDefinition) {
+	if mock.RegisterFunc == nil {
+		panic("mocks: SagaService.Register is not mocked")
+	}
+	mock.RegisterFunc(definition)
+}
+
+func (mock *SagaService) Start(ctx context.Context, name string, state services.SagaState) (services.SagaState, error) {
+	if mock.StartFunc == nil {
+		panic("mocks: SagaService.Start is not mocked")
+	}
+	return mock.StartFunc(ctx, name, state)
+}
+
+func (mock *SagaService) Resume() error {
+	if mock.ResumeFunc == nil {
+		panic("mocks: SagaService.Resume is not mocked")
+	}
+	return mock.ResumeFunc()
+}
+
+// SearchService is a mock of services.ISearchService
+type SearchService struct {
+	IndexesFunc func() []string
+	ReindexFunc func(ctx context.Context, index string, progress func(services.SearchProgress)) error
+	StartFunc   func()
+	CloseFunc   func() error
+	SyncFunc    func(event infrastructures.Event) error
+}
+
+var _ services.ISearchService = (*SearchService)(nil)
+
+func (mock *SearchService) Indexes() []string {
+	if mock.IndexesFunc == nil {
+		panic("mocks: SearchService.Indexes is not mocked")
+	}
+	return mock.IndexesFunc()
+}
+
+func (mock *SearchService) Reindex(ctx context.Context, index string, progress func(services.SearchProgress)) error {
+	if mock.ReindexFunc == nil {
+		panic("mocks: SearchService.Reindex is not mocked")
+	}
+	return mock.ReindexFunc(ctx, index, progress)
+}
+
+func (mock *SearchService) Start() {
+	if mock.StartFunc == nil {
+		panic("mocks: SearchService.Start is not mocked")
+	}
+	mock.StartFunc()
+}
+
+func (mock *SearchService) Close() error {
+	if mock.CloseFunc == nil {
+		panic("mocks: SearchService.Close is not mocked")
+	}
+	return mock.CloseFunc()
+}
+
+func (mock *SearchService) Sync(event infrastructures.Event) error {
+	if mock.SyncFunc == nil {
+		panic("mocks: SearchService.Sync is not mocked")
+	}
+	return mock.SyncFunc(event)
+}
+
+// SessionService is a mock of services.ISessionService
+type SessionService struct {
+	StartFunc   func(user models.User, deviceID string, userAgent string) (session models.Session, refreshToken string, err error)
+	RefreshFunc func(refreshToken string, deviceID string) (user models.User, session models.Session, newRefreshToken string, err error)
+	RevokeFunc  func(refreshToken string) error
+}
+
+var _ services.ISessionService = (*SessionService)(nil)
+
+func (mock *SessionService) Start(user models.User, deviceID string, userAgent string) (session models.Session, refreshToken string, err error) {
+	if mock.StartFunc == nil {
+		panic("mocks: SessionService.Start is not mocked")
+	}
+	return mock.StartFunc(user, deviceID, userAgent)
+}
+
+func (mock *SessionService) Refresh(refreshToken string, deviceID string) (user models.User, session models.Session, newRefreshToken string, err error) {
+	if mock.RefreshFunc == nil {
+		panic("mocks: SessionService.Refresh is not mocked")
+	}
+	return mock.RefreshFunc(refreshToken, deviceID)
+}
+
+func (mock *SessionService) Revoke(refreshToken string) error {
+	if mock.RevokeFunc == nil {
+		panic("mocks: SessionService.Revoke is not mocked")
+	}
+	return mock.RevokeFunc(refreshToken)
+}
+
+// SettingService is a mock of services.ISettingService
+type SettingService struct {
+	GetSettingsFunc func() ([]models.Setting, error)
+	GetSettingFunc  func(key string) (models.Setting, error)
+	UpdateFunc      func(key string, value string) (models.Setting, error)
+	InvalidateFunc  func()
+	StringFunc      func(key string, fallback string) string
+	IntFunc         func(key string, fallback int) int
+	BoolFunc        func(key string, fallback bool) bool
+	DurationFunc    func(key string, fallback time.Duration) time.Duration
+}
+
+var _ services.ISettingService = (*SettingService)(nil)
+
+func (mock *SettingService) GetSettings() ([]models.Setting, error) {
+	if mock.GetSettingsFunc == nil {
+		panic("mocks: SettingService.GetSettings is not mocked")
+	}
+	return mock.GetSettingsFunc()
+}
+
+func (mock *SettingService) GetSetting(key string) (models.Setting, error) {
+	if mock.GetSettingFunc == nil {
+		panic("mocks: SettingService.GetSetting is not mocked")
+	}
+	return mock.GetSettingFunc(key)
+}
+
+func (mock *SettingService) Update(key string, value string) (models.Setting, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: SettingService.Update is not mocked")
+	}
+	return mock.UpdateFunc(key, value)
+}
+
+func (mock *SettingService) Invalidate() {
+	if mock.InvalidateFunc == nil {
+		panic("mocks: SettingService.Invalidate is not mocked")
+	}
+	mock.InvalidateFunc()
+}
+
+func (mock *SettingService) String(key string, fallback string) string {
+	if mock.StringFunc == nil {
+		panic("mocks: SettingService.String is not mocked")
+	}
+	return mock.StringFunc(key, fallback)
+}
+
+func (mock *SettingService) Int(key string, fallback int) int {
+	if mock.IntFunc == nil {
+		panic("mocks: SettingService.Int is not mocked")
+	}
+	return mock.IntFunc(key, fallback)
+}
+
+func (mock *SettingService) Bool(key string, fallback bool) bool {
+	if mock.BoolFunc == nil {
+		panic("mocks: SettingService.Bool is not mocked")
+	}
+	return mock.BoolFunc(key, fallback)
+}
+
+func (mock *SettingService) Duration(key string, fallback time.Duration) time.Duration {
+	if mock.DurationFunc == nil {
+		panic("mocks: SettingService.Duration is not mocked")
+	}
+	return mock.DurationFunc(key, fallback)
+}
+
+// SloService is a mock of services.ISloService
+type SloService struct {
+	ObjectiveFunc func(route string) (config.SloObjective, bool)
+	TrackFunc     func(route string, latency time.Duration, status int)
+	ReportFunc    func() []services.SloReport
+	EvaluateFunc  func() error
+}
+
+var _ services.ISloService = (*SloService)(nil)
+
+func (mock *SloService) Objective(route string) (config.SloObjective, bool) {
+	if mock.ObjectiveFunc == nil {
+		panic("mocks: SloService.Objective is not mocked")
+	}
+	return mock.ObjectiveFunc(route)
+}
+
+func (mock *SloService) Track(route string, latency time.Duration, status int) {
+	if mock.TrackFunc == nil {
+		panic("mocks: SloService.Track is not mocked")
+	}
+	mock.TrackFunc(route, latency, status)
+}
+
+func (mock *SloService) Report() []services.SloReport {
+	if mock.ReportFunc == nil {
+		panic("mocks: SloService.Report is not mocked")
+	}
+	return mock.ReportFunc()
+}
+
+func (mock *SloService) Evaluate() error {
+	if mock.EvaluateFunc == nil {
+		panic("mocks: SloService.Evaluate is not mocked")
+	}
+	return mock.EvaluateFunc()
+}
+
+// SuspensionService is a mock of services.ISuspensionService
+type SuspensionService struct {
+	SuspendFunc                func(admin models.User, userID uint, until *time.Time, reason string) (models.User, error)
+	UnsuspendFunc              func(admin models.User, userID uint) (models.User, error)
+	LiftExpiredSuspensionsFunc func() error
+}
+
+var _ services.ISuspensionService = (*SuspensionService)(nil)
+
+func (mock *SuspensionService) Suspend(admin models.User, userID uint, until *time.Time, reason string) (models.User, error) {
+	if mock.SuspendFunc == nil {
+		panic("mocks: SuspensionService.Suspend is not mocked")
+	}
+	return mock.SuspendFunc(admin, userID, until, reason)
+}
+
+func (mock *SuspensionService) Unsuspend(admin models.User, userID uint) (models.User, error) {
+	if mock.UnsuspendFunc == nil {
+		panic("mocks: SuspensionService.Unsuspend is not mocked")
+	}
+	return mock.UnsuspendFunc(admin, userID)
+}
+
+func (mock *SuspensionService) LiftExpiredSuspensions() error {
+	if mock.LiftExpiredSuspensionsFunc == nil {
+		panic("mocks: SuspensionService.LiftExpiredSuspensions is not mocked")
+	}
+	return mock.LiftExpiredSuspensionsFunc()
+}
+
+// TagService is a mock of services.ITagService
+type TagService struct {
+	TagsFunc    func(taggableType string, taggableID uint) ([]models.Tag, error)
+	AttachFunc  func(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error)
+	DetachFunc  func(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error)
+	SyncFunc    func(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error)
+	PopularFunc func(taggableType string, limit int) ([]models.PopularTag, error)
+}
+
+var _ services.ITagService = (*TagService)(nil)
+
+func (mock *TagService) Tags(taggableType string, taggableID uint) ([]models.Tag, error) {
+	if mock.TagsFunc == nil {
+		panic("mocks: TagService.Tags is not mocked")
+	}
+	return mock.TagsFunc(taggableType, taggableID)
+}
+
+func (mock *TagService) Attach(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error) {
+	if mock.AttachFunc == nil {
+		panic("mocks: TagService.Attach is not mocked")
+	}
+	return mock.AttachFunc(user, taggableType, taggableID, names)
+}
+
+func (mock *TagService) Detach(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error) {
+	if mock.DetachFunc == nil {
+		panic("mocks: TagService.Detach is not mocked")
+	}
+	return mock.DetachFunc(user, taggableType, taggableID, names)
+}
+
+func (mock *TagService) Sync(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error) {
+	if mock.SyncFunc == nil {
+		panic("mocks: TagService.Sync is not mocked")
+	}
+	return mock.SyncFunc(user, taggableType, taggableID, names)
+}
+
+func (mock *TagService) Popular(taggableType string, limit int) ([]models.PopularTag, error) {
+	if mock.PopularFunc == nil {
+		panic("mocks: TagService.Popular is not mocked")
+	}
+	return mock.PopularFunc(taggableType, limit)
+}
+
+// UserHistoryService is a mock of services.IUserHistoryService
+type UserHistoryService struct {
+	VersionsFunc func(userID uint, pagination utils.IPagination) (versions []models.UserVersion, totalCount int64, err error)
+	DiffFunc     func(userID uint, versionID uint, otherID *uint) (changes []services.UserChange, err error)
+	RestoreFunc  func(admin models.User, userID uint, versionID uint) (models.User, error)
+}
+
+var _ services.IUserHistoryService = (*UserHistoryService)(nil)
+
+func (mock *UserHistoryService) Versions(userID uint, pagination utils.IPagination) (versions []models.UserVersion, totalCount int64, err error) {
+	if mock.VersionsFunc == nil {
+		panic("mocks: UserHistoryService.Versions is not mocked")
+	}
+	return mock.VersionsFunc(userID, pagination)
+}
+
+func (mock *UserHistoryService) Diff(userID uint, versionID uint, otherID *uint) (changes []services.UserChange, err error) {
+	if mock.DiffFunc == nil {
+		panic("mocks: UserHistoryService.Diff is not mocked")
+	}
+	return mock.DiffFunc(userID, versionID, otherID)
+}
+
+func (mock *UserHistoryService) Restore(admin models.User, userID uint, versionID uint) (models.User, error) {
+	if mock.RestoreFunc == nil {
+		panic("mocks: UserHistoryService.Restore is not mocked")
+	}
+	return mock.RestoreFunc(admin, userID, versionID)
+}
+
+// UserProjectionService is a mock of services.IUserProjectionService
+type UserProjectionService struct {
+	SearchFunc      func(filter repositories.UserViewFilter, pagination utils.IPagination) (views []models.UserSearchView, totalCount int64, err error)
+	RebuildFunc     func(ctx context.Context, progress func(services.ProjectionProgress)) error
+	ProjectFunc     func(event infrastructures.Event) error
+	ProjectUserFunc func(job infrastructures.Job) error
+}
+
+var _ services.IUserProjectionService = (*UserProjectionService)(nil)
+
+func (mock *UserProjectionService) Search(filter repositories.UserViewFilter, pagination utils.IPagination) (views []models.UserSearchView, totalCount int64, err error) {
+	if mock.SearchFunc == nil {
+		panic("mocks: UserProjectionService.Search is not mocked")
+	}
+	return mock.SearchFunc(filter, pagination)
+}
+
+func (mock *UserProjectionService) Rebuild(ctx context.Context, progress func(services.ProjectionProgress)) error {
+	if mock.RebuildFunc == nil {
+		panic("mocks: UserProjectionService.Rebuild is not mocked")
+	}
+	return mock.RebuildFunc(ctx, progress)
+}
+
+func (mock *UserProjectionService) Project(event infrastructures.Event) error {
+	if mock.ProjectFunc == nil {
+		panic("mocks: UserProjectionService.Project is not mocked")
+	}
+	return mock.ProjectFunc(event)
+}
+
+func (mock *UserProjectionService) ProjectUser(job infrastructures.Job) error {
+	if mock.ProjectUserFunc == nil {
+		panic("mocks: UserProjectionService.ProjectUser is not mocked")
+	}
+	return mock.ProjectUserFunc(job)
+}
+
+// UserService is a mock of services.IUserService
+type UserService struct {
+	GetUsersWithPaginationAndOrderFunc func(pagination utils.IPagination, order utils.IOrder, tags []string) (users []models.User, totalCount int64, err error)
+	PaginateUsersFunc                  func(keyset pagination.Keyset, tags []string) (users []models.User, next *pagination.Cursor, err error)
+	GetUserByIDFunc                    func(id uint) (models.User, error)
+	GetUsersByIDsFunc                  func(ids []uint) (users []models.User, missing []uint, err error)
+	GetUserByEmailFunc                 func(email string) (models.User, error)
+	UpdatePreferencesFunc              func(ctx context.Context, user models.User, timezone string) (models.User, error)
+	PatchUserFunc                      func(ctx context.Context, auth models.User, user models.User, changes map[string]interface{}) (models.User, error)
+	BulkUpdateFunc                     func(ctx context.Context, admin models.User, updates []services.UserUpdate) ([]services.BulkResult, error)
+	BulkDeleteFunc                     func(ctx context.Context, admin models.User, ids []uint) ([]services.BulkResult, error)
+}
+
+var _ services.IUserService = (*UserService)(nil)
+
+func (mock *UserService) GetUsersWithPaginationAndOrder(pagination utils.IPagination, order utils.IOrder, tags []string) (users []models.User, totalCount int64, err error) {
+	if mock.GetUsersWithPaginationAndOrderFunc == nil {
+		panic("mocks: UserService.GetUsersWithPaginationAndOrder is not mocked")
+	}
+	return mock.GetUsersWithPaginationAndOrderFunc(pagination, order, tags)
+}
+
+func (mock *UserService) PaginateUsers(keyset pagination.Keyset, tags []string) (users []models.User, next *pagination.Cursor, err error) {
+	if mock.PaginateUsersFunc == nil {
+		panic("mocks: UserService.PaginateUsers is not mocked")
+	}
+	return mock.PaginateUsersFunc(keyset, tags)
+}
+
+func (mock *UserService) GetUserByID(id uint) (models.User, error) {
+	if mock.GetUserByIDFunc == nil {
+		panic("mocks: UserService.GetUserByID is not mocked")
+	}
+	return mock.GetUserByIDFunc(id)
+}
+
+func (mock *UserService) GetUsersByIDs(ids []uint) (users []models.User, missing []uint, err error) {
+	if mock.GetUsersByIDsFunc == nil {
+		panic("mocks: UserService.GetUsersByIDs is not mocked")
+	}
+	return mock.GetUsersByIDsFunc(ids)
+}
+
+func (mock *UserService) GetUserByEmail(email string) (models.User, error) {
+	if mock.GetUserByEmailFunc == nil {
+		panic("mocks: UserService.GetUserByEmail is not mocked")
+	}
+	return mock.GetUserByEmailFunc(email)
+}
+
+func (mock *UserService) UpdatePreferences(ctx context.Context, user models.User, timezone string) (models.User, error) {
+	if mock.UpdatePreferencesFunc == nil {
+		panic("mocks: UserService.UpdatePreferences is not mocked")
+	}
+	return mock.UpdatePreferencesFunc(ctx, user, timezone)
+}
+
+func (mock *UserService) PatchUser(ctx context.Context, auth models.User, user models.User, changes map[string]interface{}) (models.User, error) {
+	if mock.PatchUserFunc == nil {
+		panic("mocks: UserService.PatchUser is not mocked")
+	}
+	return mock.PatchUserFunc(ctx, auth, user, changes)
+}
+
+func (mock *UserService) BulkUpdate(ctx context.Context, admin models.User, updates []services.UserUpdate) ([]services.BulkResult, error) {
+	if mock.BulkUpdateFunc == nil {
+		panic("mocks: UserService.BulkUpdate is not mocked")
+	}
+	return mock.BulkUpdateFunc(ctx, admin, updates)
+}
+
+func (mock *UserService) BulkDelete(ctx context.Context, admin models.User, ids []uint) ([]services.BulkResult, error) {
+	if mock.BulkDeleteFunc == nil {
+		panic("mocks: UserService.BulkDelete is not mocked")
+	}
+	return mock.BulkDeleteFunc(ctx, admin, ids)
+}
+
+// VoteService is a mock of services.IVoteService
+type VoteService struct {
+	VoteFunc            func(user models.User, votableType string, votableID uint, value int) (services.VoteSummary, error)
+	SummaryFunc         func(user models.User, votableType string, votableID uint) (services.VoteSummary, error)
+	RecomputeScoresFunc func() error
+}
+
+var _ services.IVoteService = (*VoteService)(nil)
+
+func (mock *VoteService) Vote(user models.User, votableType string, votableID uint, value int) (services.VoteSummary, error) {
+	if mock.VoteFunc == nil {
+		panic("mocks: VoteService.Vote is not mocked")
+	}
+	return mock.VoteFunc(user, votableType, votableID, value)
+}
+
+func (mock *VoteService) Summary(user models.User, votableType string, votableID uint) (services.VoteSummary, error) {
+	if mock.SummaryFunc == nil {
+		panic("mocks: VoteService.Summary is not mocked")
+	}
+	return mock.SummaryFunc(user, votableType, votableID)
+}
+
+func (mock *VoteService) RecomputeScores() error {
+	if mock.RecomputeScoresFunc == nil {
+		panic("mocks: VoteService.RecomputeScores is not mocked")
+	}
+	return mock.RecomputeScoresFunc()
+}
@@ -0,0 +1,67 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"gotham/utils"
+)
+
+// Order is a mock of utils.IOrder
+type Order struct {
+	GetFunc        func() *utils.Order
+	GetOrderByFunc func() string
+	GetSortByFunc  func() string
+}
+
+var _ utils.IOrder = (*Order)(nil)
+
+func (mock *Order) Get() *utils.Order {
+	if mock.GetFunc == nil {
+		panic("mocks: Order.Get is not mocked")
+	}
+	return mock.GetFunc()
+}
+
+func (mock *Order) GetOrderBy() string {
+	if mock.GetOrderByFunc == nil {
+		panic("mocks: Order.GetOrderBy is not mocked")
+	}
+	return mock.GetOrderByFunc()
+}
+
+func (mock *Order) GetSortBy() string {
+	if mock.GetSortByFunc == nil {
+		panic("mocks: Order.GetSortBy is not mocked")
+	}
+	return mock.GetSortByFunc()
+}
+
+// Pagination is a mock of utils.IPagination
+type Pagination struct {
+	GetFunc      func() *utils.Pagination
+	GetPageFunc  func() int
+	GetLimitFunc func() int
+}
+
+var _ utils.IPagination = (*Pagination)(nil)
+
+func (mock *Pagination) Get() *utils.Pagination {
+	if mock.GetFunc == nil {
+		panic("mocks: Pagination.Get is not mocked")
+	}
+	return mock.GetFunc()
+}
+
+func (mock *Pagination) GetPage() int {
+	if mock.GetPageFunc == nil {
+		panic("mocks: Pagination.GetPage is not mocked")
+	}
+	return mock.GetPageFunc()
+}
+
+func (mock *Pagination) GetLimit() int {
+	if mock.GetLimitFunc == nil {
+		panic("mocks: Pagination.GetLimit is not mocked")
+	}
+	return mock.GetLimitFunc()
+}
@@ -0,0 +1,166 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"gotham/openapi"
+)
+
+// the nesting past which a schema is an empty value, a definition referencing itself ends there
+const maxDepth = 8
+
+// the elements of an example array, a paginator counts them as its total
+const arrayLength = 2
+
+// Factory makes the example of a definition from the factory of its model, numbered with the sequence
+type Factory func(sequence int) (interface{}, error)
+
+/**
+ * Generator
+ * makes the example of a schema: its example or its first enum value when documented, the record of the factory of
+ * a definition registered in Factories, and a value fitting the name and the format of the other fields
+ */
+type Generator struct {
+	Spec *openapi.Spec
+	// Factories are by definition name, "models.User"
+	Factories map[string]Factory
+	Now       func() time.Time
+
+	sequence int
+	mu       sync.Mutex
+}
+
+func (g *Generator) Example(schema *openapi.Schema) interface{} {
+	return g.value(schema, "", 0)
+}
+
+func (g *Generator) value(schema *openapi.Schema, name string, depth int) interface{} {
+	if schema == nil || depth > maxDepth {
+		return nil
+	}
+	if schema.Example != nil {
+		return schema.Example
+	}
+	if schema.Ref != "" && len(schema.AllOf) == 0 && len(schema.Properties) == 0 {
+		return g.definition(schema.Ref, name, depth)
+	}
+	if len(schema.AllOf) > 0 || len(schema.Properties) > 0 {
+		object := map[string]interface{}{}
+		// the properties of the schema override the ones of its parts, the data of a response envelope
+		for _, part := range schema.AllOf {
+			if fields, ok := g.value(part, name, depth+1).(map[string]interface{}); ok {
+				for key, value := range fields {
+					object[key] = value
+				}
+			}
+		}
+		if schema.Ref != "" {
+			if fields, ok := g.definition(schema.Ref, name, depth).(map[string]interface{}); ok {
+				for key, value := range fields {
+					object[key] = value
+				}
+			}
+		}
+		for key, property := range schema.Properties {
+			object[key] = g.value(property, key, depth+1)
+		}
+		return object
+	}
+	if len(schema.Enum) > 0 {
+		return schema.Enum[0]
+	}
+
+	switch schema.Type {
+	case "array":
+		items := make([]interface{}, 0, arrayLength)
+		for i := 0; i < arrayLength; i++ {
+			items = append(items, g.value(schema.Items, strings.TrimSuffix(name, "s"), depth+1))
+		}
+		return items
+	case "object":
+		return map[string]interface{}{}
+	case "integer":
+		return g.integer(name)
+	case "number":
+		return 1.5
+	case "boolean":
+		return true
+	case "string":
+		return g.string(name, schema.Format)
+	}
+	return nil
+}
+
+// definition is the record of the factory of the definition, or the example of its schema
+func (g *Generator) definition(ref string, name string, depth int) interface{} {
+	if factory, ok := g.Factories[strings.TrimPrefix(ref, "#/definitions/")]; ok {
+		if example, err := g.fromFactory(factory); err == nil {
+			return example
+		}
+	}
+	definition := g.Spec.Definition(ref)
+	if definition == nil {
+		return map[string]interface{}{}
+	}
+	return g.value(definition, name, depth+1)
+}
+
+// fromFactory is the json of the record, the hidden fields of the model stay hidden
+func (g *Generator) fromFactory(factory Factory) (interface{}, error) {
+	record, err := factory(g.next())
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	var example interface{}
+	return example, json.Unmarshal(data, &example)
+}
+
+func (g *Generator) integer(name string) interface{} {
+	switch {
+	case name == "id" || strings.HasSuffix(name, "_id"):
+		return g.next()
+	case name == "page":
+		return 1
+	case name == "limit":
+		return 10
+	case name == "total_record":
+		return arrayLength
+	}
+	return 1
+}
+
+func (g *Generator) string(name string, format string) interface{} {
+	switch {
+	case format == "date-time" || strings.HasSuffix(name, "_at"):
+		return g.Now().UTC().Format(time.RFC3339)
+	case format == "date":
+		return g.Now().UTC().Format("2006-01-02")
+	case format == "uuid":
+		return "8b5f3a4e-6f0c-4d5b-9a51-1c2e3d4f5a6b"
+	case strings.Contains(name, "email"):
+		return "bruce.wayne@gotham.test"
+	case strings.HasSuffix(name, "url") || strings.HasSuffix(name, "image") || strings.HasSuffix(name, "link"):
+		return "https://example.com/" + name
+	case strings.HasSuffix(name, "token"):
+		return "mock-" + name
+	case name == "name":
+		return "Bruce Wayne"
+	case name != "":
+		return name
+	}
+	return "string"
+}
+
+func (g *Generator) next() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.sequence++
+	return g.sequence
+}
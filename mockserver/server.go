@@ -0,0 +1,83 @@
+package mockserver
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/openapi"
+)
+
+/**
+ * Register
+ * adds a route for every operation of the spec answering the example of its lowest documented 2xx response. A request
+ * with the Prefer: code=404 header gets the example of another documented response, so a frontend tries its errors
+ */
+func Register(e *echo.Echo, spec *openapi.Spec, generator *Generator) {
+	paths := make([]string, 0, len(spec.Paths))
+	for path := range spec.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		for method, operation := range spec.Paths[path] {
+			e.Add(strings.ToUpper(method), openapi.EchoPath(path), handler(operation, generator))
+		}
+	}
+}
+
+func handler(operation *openapi.Operation, generator *Generator) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		preferred := preferredCode(c.Request().Header.Get("Prefer"))
+		status, response, ok := documented(operation.Responses, preferred)
+		if !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, "the operation documents no response with the code of Prefer")
+		}
+		if preferred != 0 {
+			c.Response().Header().Set("Preference-Applied", "code="+strconv.Itoa(preferred))
+		}
+		if status == http.StatusNoContent || response.Schema == nil {
+			return c.NoContent(status)
+		}
+		return c.JSON(status, generator.Example(response.Schema))
+	}
+}
+
+// documented is the response of the preferred code, false when it is not documented, or without a preference the
+// lowest documented 2xx one. 200 without a body when there is none
+func documented(responses map[string]openapi.Response, preferred int) (int, openapi.Response, bool) {
+	if preferred != 0 {
+		response, ok := responses[strconv.Itoa(preferred)]
+		return preferred, response, ok
+	}
+	best := 0
+	var response openapi.Response
+	for code, r := range responses {
+		status, err := strconv.Atoi(code)
+		if err != nil || status < 200 || status > 299 {
+			continue
+		}
+		if best == 0 || status < best {
+			best, response = status, r
+		}
+	}
+	if best == 0 {
+		return http.StatusOK, openapi.Response{}, true
+	}
+	return best, response, true
+}
+
+// preferredCode reads the code preference of a Prefer header, "code=404", 0 without one
+func preferredCode(prefer string) int {
+	for _, preference := range strings.FieldsFunc(prefer, func(r rune) bool { return r == ',' || r == ';' }) {
+		parts := strings.SplitN(strings.TrimSpace(preference), "=", 2)
+		if len(parts) == 2 && strings.TrimSpace(parts[0]) == "code" {
+			code, _ := strconv.Atoi(strings.Trim(strings.TrimSpace(parts[1]), `"`))
+			return code
+		}
+	}
+	return 0
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"time"
+)
+
+// announcement audiences
+const (
+	AudienceAll      = "all"
+	AudienceVerified = "verified"
+	// AudiencePlan are the users whose plan includes the plan of the announcement
+	AudiencePlan = "plan"
+)
+
+var Audiences = []interface{}{AudienceAll, AudienceVerified, AudiencePlan}
+
+// Announcement is a message of the admins to an audience, it is delivered when it is published
+type Announcement struct {
+	ID        uint    `gorm:"primaryKey;auto_increment" json:"id"`
+	CreatedBy uint    `gorm:"not null" json:"created_by"`
+	Title     string  `gorm:"size:150;not null" json:"title"`
+	Body      string  `gorm:"type:text;not null" json:"body"`
+	Audience  string  `gorm:"size:20;not null" json:"audience"`
+	PlanSlug  *string `gorm:"size:50" json:"plan_slug"`
+	Publishable
+
+	// Read tells if the user read the announcement, ReadCount how many users did
+	Read      bool  `gorm:"-" json:"read"`
+	ReadCount int64 `gorm:"-" json:"read_count,omitempty"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Announcement) TableName() string {
+	return "announcements"
+}
+
+/**
+ * SearchID
+ *
+ * @return uint
+ */
+func (a Announcement) SearchID() uint {
+	return a.ID
+}
+
+/**
+ * SearchDocument
+ * only the published announcements are found, the audience is indexed for the search to filter on it
+ *
+ * @return map[string]interface{}
+ */
+func (a Announcement) SearchDocument() map[string]interface{} {
+	if !a.IsPublished() || a.PublishedAt == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"id":           a.ID,
+		"title":        a.Title,
+		"body":         a.Body,
+		"audience":     a.Audience,
+		"plan_slug":    a.PlanSlug,
+		"published_at": a.PublishedAt.Unix(),
+	}
+}
+
+// AnnouncementRead is the first time a user read an announcement
+type AnnouncementRead struct {
+	ID             uint `gorm:"primaryKey;auto_increment" json:"-"`
+	AnnouncementID uint `gorm:"not null;uniqueIndex:idx_announcement_read" json:"announcement_id"`
+	UserID         uint `gorm:"not null;uniqueIndex:idx_announcement_read;index" json:"user_id"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (AnnouncementRead) TableName() string {
+	return "announcement_reads"
+}
+
+// AnnouncementReadCount is the number of users who read an announcement
+type AnnouncementReadCount struct {
+	AnnouncementID uint
+	Count          int64
+}
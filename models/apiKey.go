@@ -0,0 +1,53 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// ApiKey authenticates a machine client as its user, limited to its scopes. Only the hash of the key is kept, the
+// prefix tells the keys apart
+type ApiKey struct {
+	ID         uint       `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID     uint       `gorm:"index;not null" json:"user_id"`
+	Name       string     `gorm:"size:100;not null" json:"name"`
+	Prefix     string     `gorm:"size:12;not null" json:"prefix"`
+	KeyHash    string     `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	Scopes     string     `gorm:"size:500;not null" json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (ApiKey) TableName() string {
+	return "api_keys"
+}
+
+/**
+ * IsActive
+ * not revoked and not expired
+ *
+ * @return bool
+ */
+func (k *ApiKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+/**
+ * ScopeList
+ * the scopes are stored space separated, like the scope of OAuth2
+ *
+ * @return []string
+ */
+func (k *ApiKey) ScopeList() []string {
+	return strings.Fields(k.Scopes)
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+)
+
+// ApiUsage counts the requests of a user on one day, the monthly usage is the sum of its days
+type ApiUsage struct {
+	ID       uint   `gorm:"primaryKey;auto_increment" json:"-"`
+	UserID   uint   `gorm:"not null;uniqueIndex:idx_api_usage_user_day" json:"-"`
+	Day      string `gorm:"size:10;not null;uniqueIndex:idx_api_usage_user_day" json:"day"`
+	Requests int64  `gorm:"not null;default:0" json:"requests"`
+
+	// Time
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (ApiUsage) TableName() string {
+	return "api_usages"
+}
+
+// UsageDay is the day key of a time, in UTC so every instance agrees on when a day starts
+func UsageDay(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Client is an internal service authenticating with the client credentials grant. Only the hash of its secret is
+// kept, the ClientID is public
+type Client struct {
+	ID         uint   `gorm:"primaryKey;auto_increment" json:"id"`
+	Name       string `gorm:"size:100;not null" json:"name"`
+	ClientID   string `gorm:"size:40;not null;uniqueIndex" json:"client_id"`
+	SecretHash string `gorm:"size:64;not null" json:"-"`
+	Scopes     string `gorm:"size:500;not null" json:"scopes"`
+	// RateLimit is the requests of the client within a window, nil uses the default of the config and 0 is unlimited
+	RateLimit *int `json:"rate_limit"`
+	// CertificateIdentity authenticates the client by a verified certificate instead of a token, it is matched against
+	// the URI and DNS names of the certificate, like a SPIFFE ID, and then its common name
+	CertificateIdentity *string    `gorm:"size:255;uniqueIndex" json:"certificate_identity"`
+	CreatedBy           uint       `gorm:"not null" json:"created_by"`
+	LastUsedAt          *time.Time `json:"last_used_at"`
+	RevokedAt           *time.Time `json:"revoked_at"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Client) TableName() string {
+	return "clients"
+}
+
+/**
+ * IsActive
+ * not revoked
+ *
+ * @return bool
+ */
+func (c *Client) IsActive() bool {
+	return c.RevokedAt == nil
+}
+
+/**
+ * ScopeList
+ * the scopes are stored space separated, like the scope of OAuth2
+ *
+ * @return []string
+ */
+func (c *Client) ScopeList() []string {
+	return strings.Fields(c.Scopes)
+}
@@ -0,0 +1,121 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	CommentVisible = "visible"
+	// CommentHidden comments were flagged too often and wait for a moderator
+	CommentHidden = "hidden"
+	// CommentRemoved comments were taken down by a moderator
+	CommentRemoved = "removed"
+)
+
+// Comment is a comment of a user on anything commentable, the target is its type and id. Replies keep the root of
+// their thread so a page of threads is loaded with two queries
+type Comment struct {
+	ID              uint       `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID          uint       `gorm:"not null;index" json:"user_id"`
+	CommentableType string     `gorm:"size:50;not null;index:idx_comment_commentable" json:"commentable_type"`
+	CommentableID   uint       `gorm:"not null;index:idx_comment_commentable" json:"commentable_id"`
+	ParentID        *uint      `gorm:"index" json:"parent_id"`
+	RootID          *uint      `gorm:"index" json:"root_id"`
+	Depth           int        `gorm:"not null;default:0" json:"depth"`
+	Body            string     `gorm:"type:text;not null" json:"body"`
+	Status          string     `gorm:"size:20;not null;default:visible;index" json:"status"`
+	Flags           int        `gorm:"not null;default:0" json:"-"`
+	EditedAt        *time.Time `json:"edited_at"`
+	ReviewedAt      *time.Time `json:"-"`
+
+	Replies []Comment `gorm:"-" json:"replies,omitempty"`
+
+	// Time
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string" format:"date-time"`
+}
+
+// CommentFlag is the report of a comment by a user, a user flags a comment once
+type CommentFlag struct {
+	ID        uint      `gorm:"primaryKey;auto_increment" json:"id"`
+	CommentID uint      `gorm:"not null;uniqueIndex:idx_flag_comment_user" json:"comment_id"`
+	UserID    uint      `gorm:"not null;uniqueIndex:idx_flag_comment_user" json:"user_id"`
+	Reason    string    `gorm:"size:255" json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Comment) TableName() string {
+	return "comments"
+}
+
+/**
+ * SearchID
+ *
+ * @return uint
+ */
+func (c Comment) SearchID() uint {
+	return c.ID
+}
+
+/**
+ * SearchDocument
+ * only the visible comments are found
+ *
+ * @return map[string]interface{}
+ */
+func (c Comment) SearchDocument() map[string]interface{} {
+	if c.Status != CommentVisible || c.DeletedAt.Valid {
+		return nil
+	}
+	return map[string]interface{}{
+		"id":               c.ID,
+		"user_id":          c.UserID,
+		"commentable_type": c.CommentableType,
+		"commentable_id":   c.CommentableID,
+		"body":             c.Body,
+		"created_at":       c.CreatedAt.Unix(),
+	}
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (CommentFlag) TableName() string {
+	return "comment_flags"
+}
+
+/**
+ * IsDeleted
+ *
+ * @return bool
+ */
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt.Valid
+}
+
+/**
+ * Redact
+ * clears what the viewer may not read, deleted and removed comments stay in their thread without a body or author,
+ * hidden ones are only read by their author
+ */
+func (c *Comment) Redact(viewer User) {
+	switch {
+	case c.IsDeleted() || c.Status == CommentRemoved:
+		c.Body, c.UserID = "", 0
+	case c.Status == CommentHidden && c.UserID != viewer.ID:
+		c.Body = ""
+	}
+	for i := range c.Replies {
+		c.Replies[i].Redact(viewer)
+	}
+}
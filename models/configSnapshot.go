@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+)
+
+// ConfigSnapshot is a configuration the instances loaded, the instances loading the same one share it. The blue and the
+// green deployments each have theirs, the diff of two snapshots is the drift of their environments
+type ConfigSnapshot struct {
+	ID uint `gorm:"primaryKey;auto_increment" json:"id"`
+	// Checksum is the sha256 of the variables, the key of the snapshot
+	Checksum string `gorm:"size:64;not null;uniqueIndex" json:"checksum"`
+	// Variables is the json of the config.Variable read, the secrets as their fingerprint
+	Variables JSON `gorm:"type:text;not null" json:"-"`
+	// Version and Host are the ones of the last instance loading it
+	Version string `gorm:"size:100" json:"version"`
+	Host    string `gorm:"size:255" json:"host"`
+	// Loads counts the instances started with it
+	Loads    int64     `gorm:"not null;default:0" json:"loads"`
+	LoadedAt time.Time `gorm:"index;not null" json:"loaded_at"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (ConfigSnapshot) TableName() string {
+	return "config_snapshots"
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+)
+
+// Conversation is a private thread of messages between its participants
+type Conversation struct {
+	ID uint `gorm:"primaryKey;auto_increment" json:"id"`
+	// DirectKey pairs the two users of a direct conversation so starting it again returns the same one
+	DirectKey     *string    `gorm:"size:64;uniqueIndex" json:"-"`
+	Direct        bool       `gorm:"not null;default:false" json:"direct"`
+	CreatedBy     uint       `gorm:"not null" json:"created_by"`
+	LastMessageAt *time.Time `gorm:"index" json:"last_message_at"`
+
+	// Relations
+	Participants []ConversationParticipant `gorm:"-" json:"participants,omitempty"`
+	UnreadCount  int64                     `gorm:"-" json:"unread_count"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Conversation) TableName() string {
+	return "conversations"
+}
+
+// ConversationParticipant is a user of a conversation, the messages after LastReadMessageID are unread
+type ConversationParticipant struct {
+	ID                uint `gorm:"primaryKey;auto_increment" json:"-"`
+	ConversationID    uint `gorm:"not null;uniqueIndex:idx_conversation_participant" json:"conversation_id"`
+	UserID            uint `gorm:"not null;uniqueIndex:idx_conversation_participant;index" json:"user_id"`
+	LastReadMessageID uint `gorm:"not null;default:0" json:"last_read_message_id"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (ConversationParticipant) TableName() string {
+	return "conversation_participants"
+}
+
+// Message is sent by a participant to the others of the conversation
+type Message struct {
+	ID             uint   `gorm:"primaryKey;auto_increment" json:"id"`
+	ConversationID uint   `gorm:"not null;index" json:"conversation_id"`
+	UserID         uint   `gorm:"not null;index" json:"user_id"`
+	Body           string `gorm:"type:text;not null" json:"body"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Message) TableName() string {
+	return "messages"
+}
+
+// ConversationUnread is the number of messages of a conversation the user has not read
+type ConversationUnread struct {
+	ConversationID uint  `json:"conversation_id"`
+	Count          int64 `json:"count"`
+}
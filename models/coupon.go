@@ -0,0 +1,97 @@
+package models
+
+import (
+	"time"
+
+	"gotham/helpers"
+)
+
+type CouponType string
+
+const (
+	CouponPercentage CouponType = "percentage"
+	CouponFixed      CouponType = "fixed"
+)
+
+// Coupon is a discount code applied to the first payment of a subscription, its discount is mirrored by a coupon
+// of the payment provider so it can not be changed once created
+type Coupon struct {
+	ID             uint           `gorm:"primaryKey;auto_increment" json:"id"`
+	Code           string         `gorm:"size:50;not null;uniqueIndex" json:"code"`
+	Type           CouponType     `gorm:"size:20;not null" json:"type"`
+	PercentOff     int            `gorm:"not null;default:0" json:"percent_off,omitempty"`
+	AmountOff      *helpers.Money `gorm:"size:50" json:"amount_off,omitempty"`
+	PlanID         *uint          `gorm:"index" json:"plan_id"`
+	MaxRedemptions int            `gorm:"not null;default:0" json:"max_redemptions"`
+	MaxPerUser     int            `gorm:"not null;default:1" json:"max_per_user"`
+	Redemptions    int            `gorm:"not null;default:0" json:"redemptions"`
+	ExpiresAt      *time.Time     `gorm:"index" json:"expires_at"`
+	Active         bool           `gorm:"type:boolean;not null;default:1" json:"active"`
+	ExternalID     string         `gorm:"size:100;not null" json:"-"`
+	CreatedBy      uint           `gorm:"index" json:"created_by"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CouponRedemption struct {
+	ID             uint      `gorm:"primaryKey;auto_increment" json:"id"`
+	CouponID       uint      `gorm:"index;not null" json:"coupon_id"`
+	Coupon         Coupon    `gorm:"constraint:OnDelete:CASCADE" json:"coupon"`
+	UserID         uint      `gorm:"index;not null" json:"user_id"`
+	SubscriptionID string    `gorm:"size:100" json:"-"`
+	RedeemedAt     time.Time `gorm:"not null" json:"redeemed_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Coupon) TableName() string {
+	return "coupons"
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (CouponRedemption) TableName() string {
+	return "coupon_redemptions"
+}
+
+/**
+ * IsUsable
+ * active, not expired and redemptions left, a max of 0 is unlimited
+ *
+ * @return bool
+ */
+func (c *Coupon) IsUsable(now time.Time) bool {
+	if !c.Active {
+		return false
+	}
+	if c.ExpiresAt != nil && !now.Before(*c.ExpiresAt) {
+		return false
+	}
+	return c.MaxRedemptions == 0 || c.Redemptions < c.MaxRedemptions
+}
+
+/**
+ * Discount
+ * the amount taken off the price, never more than the price
+ *
+ * @return helpers.Money
+ */
+func (c *Coupon) Discount(price helpers.Money) (helpers.Money, error) {
+	if c.Type == CouponPercentage {
+		return price.Percent(int64(c.PercentOff) * 100)
+	}
+	if cmp, err := c.AmountOff.Cmp(price); err != nil {
+		return helpers.Money{}, err
+	} else if cmp > 0 {
+		return price, nil
+	}
+	return *c.AmountOff, nil
+}
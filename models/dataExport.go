@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+)
+
+type DataExportStatus string
+
+const (
+	DataExportPending DataExportStatus = "pending"
+	DataExportReady   DataExportStatus = "ready"
+	DataExportFailed  DataExportStatus = "failed"
+)
+
+type DataExport struct {
+	ID        uint             `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID    uint             `gorm:"index;not null" json:"user_id"`
+	Status    DataExportStatus `gorm:"size:20;not null" json:"status"`
+	Path      string           `gorm:"size:255" json:"-"`
+	ExpiresAt *time.Time       `json:"expires_at"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (DataExport) TableName() string {
+	return "data_exports"
+}
+
+/**
+ * IsReady
+ *
+ * @return bool
+ */
+func (d *DataExport) IsReady() bool {
+	return d.Status == DataExportReady && (d.ExpiresAt == nil || d.ExpiresAt.After(time.Now()))
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	DeadLetterJob     = "job"
+	DeadLetterWebhook = "webhook"
+)
+
+// DeadLetter is a job dropped after its last attempt or a webhook that could not be applied, kept to be replayed
+type DeadLetter struct {
+	ID   uint   `gorm:"primaryKey;auto_increment" json:"id"`
+	Kind string `gorm:"size:20;not null;index:idx_dead_letters_source,priority:1;uniqueIndex:idx_dead_letters_event,priority:1" json:"kind"`
+	// Source is the queue of a job, the provider of a webhook
+	Source string `gorm:"size:50;not null;index:idx_dead_letters_source,priority:2" json:"source"`
+	// EventID is the id of the event of a webhook, a delivery failing again updates its dead letter. Nil for the jobs
+	EventID *string `gorm:"size:255;uniqueIndex:idx_dead_letters_event,priority:2" json:"event_id"`
+	Payload string  `gorm:"type:text;not null" json:"payload"`
+	Error   string  `gorm:"size:1000;not null" json:"error"`
+	// Attempts are the runs of the job before it was dropped, the failed deliveries of a webhook
+	Attempts int `gorm:"not null;default:0" json:"attempts"`
+	// Replays counts the replays that failed again
+	Replays int `gorm:"not null;default:0" json:"replays"`
+
+	// Time
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (DeadLetter) TableName() string {
+	return "dead_letters"
+}
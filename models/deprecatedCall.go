@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+)
+
+// DeprecatedCall counts the calls of a caller to a deprecated route, so the ones still calling it are known before its
+// sunset
+type DeprecatedCall struct {
+	ID uint `gorm:"primaryKey;auto_increment" json:"-"`
+	// Route is the method and the path of the route, "GET /v1/restricted/users/:user"
+	Route string `gorm:"size:255;not null;uniqueIndex:idx_deprecated_calls_caller,priority:1" json:"route"`
+	// Caller is "client:<id>", "key:<id>" or "user:<id>", "anonymous" for the public routes
+	Caller       string    `gorm:"size:64;not null;uniqueIndex:idx_deprecated_calls_caller,priority:2" json:"caller"`
+	Calls        int64     `gorm:"not null;default:0" json:"calls"`
+	LastCalledAt time.Time `gorm:"index;not null" json:"last_called_at"`
+
+	// Time
+	CreatedAt time.Time `json:"first_called_at"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (DeprecatedCall) TableName() string {
+	return "deprecated_calls"
+}
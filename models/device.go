@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+)
+
+// device platforms
+const (
+	DeviceAndroid = "android"
+	DeviceIos     = "ios"
+	DeviceWeb     = "web"
+)
+
+var DevicePlatforms = []interface{}{DeviceAndroid, DeviceIos, DeviceWeb}
+
+// push notification categories a user can opt out of
+const (
+	PushMessages      = "messages"
+	PushFollows       = "follows"
+	PushAnnouncements = "announcements"
+)
+
+var PushCategories = []interface{}{PushMessages, PushFollows, PushAnnouncements}
+
+// Device is a push token of a user, a token belongs to the last user registering it
+type Device struct {
+	ID         uint       `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID     uint       `gorm:"not null;index" json:"user_id"`
+	Token      string     `gorm:"size:255;not null;uniqueIndex" json:"token"`
+	Platform   string     `gorm:"size:16;not null" json:"platform"`
+	LastPushAt *time.Time `json:"last_push_at"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Device) TableName() string {
+	return "devices"
+}
+
+// PushOptOut is a category of push notifications the user does not want
+type PushOptOut struct {
+	ID       uint   `gorm:"primaryKey;auto_increment" json:"-"`
+	UserID   uint   `gorm:"not null;uniqueIndex:idx_push_opt_out" json:"user_id"`
+	Category string `gorm:"size:50;not null;uniqueIndex:idx_push_opt_out" json:"category"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (PushOptOut) TableName() string {
+	return "push_opt_outs"
+}
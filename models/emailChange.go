@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+)
+
+// EmailChange is a pending change of the email of a user, applied once it is confirmed from the new address
+type EmailChange struct {
+	ID          uint       `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID      uint       `gorm:"index;not null" json:"user_id"`
+	NewEmail    string     `gorm:"size:100;not null" json:"new_email"`
+	TokenHash   string     `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	ExpiresAt   time.Time  `gorm:"not null" json:"expires_at"`
+	ConfirmedAt *time.Time `json:"confirmed_at"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (EmailChange) TableName() string {
+	return "email_changes"
+}
+
+/**
+ * IsPending
+ * not confirmed yet and not expired
+ *
+ * @return bool
+ */
+func (e *EmailChange) IsPending(now time.Time) bool {
+	return e.ConfirmedAt == nil && now.Before(e.ExpiresAt)
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// Follow is a user following another user, two users following each other are mutual
+type Follow struct {
+	ID         uint `gorm:"primaryKey;auto_increment" json:"id"`
+	FollowerID uint `gorm:"not null;uniqueIndex:idx_follow_pair" json:"follower_id"`
+	FollowedID uint `gorm:"not null;uniqueIndex:idx_follow_pair;index" json:"followed_id"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Follow) TableName() string {
+	return "follows"
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+)
+
+// Invitation lets people register while registration is invite only
+type Invitation struct {
+	ID        uint       `gorm:"primaryKey;auto_increment" json:"id"`
+	Code      string     `gorm:"size:64;not null;uniqueIndex" json:"code"`
+	Email     *string    `gorm:"size:100" json:"email"`
+	MaxUses   int        `gorm:"not null;default:1" json:"max_uses"`
+	Uses      int        `gorm:"not null;default:0" json:"uses"`
+	ExpiresAt *time.Time `gorm:"index" json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+	CreatedBy uint       `gorm:"index" json:"created_by"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Invitation) TableName() string {
+	return "invitations"
+}
+
+/**
+ * IsUsable
+ * not revoked, not expired and uses left, a max of 0 is unlimited
+ *
+ * @return bool
+ */
+func (i *Invitation) IsUsable(now time.Time) bool {
+	if i.RevokedAt != nil {
+		return false
+	}
+	if i.ExpiresAt != nil && !now.Before(*i.ExpiresAt) {
+		return false
+	}
+	return i.MaxUses == 0 || i.Uses < i.MaxUses
+}
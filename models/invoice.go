@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+
+	"gotham/helpers"
+)
+
+type InvoiceStatus string
+
+const (
+	InvoicePending InvoiceStatus = "pending"
+	InvoiceReady   InvoiceStatus = "ready"
+	InvoiceFailed  InvoiceStatus = "failed"
+)
+
+// Invoice is a paid invoice of the payment provider, its pdf is rendered in the background
+type Invoice struct {
+	ID          uint          `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID      uint          `gorm:"index;not null" json:"user_id"`
+	ExternalID  string        `gorm:"size:100;uniqueIndex;not null" json:"-"`
+	Number      string        `gorm:"size:100" json:"number"`
+	Total       helpers.Money `gorm:"size:50;not null" json:"total"`
+	Lines       []InvoiceLine `gorm:"constraint:OnDelete:CASCADE" json:"lines"`
+	PeriodStart time.Time     `json:"period_start"`
+	PeriodEnd   time.Time     `json:"period_end"`
+	PaidAt      time.Time     `json:"paid_at"`
+	Status      InvoiceStatus `gorm:"size:20;not null" json:"status"`
+	Path        string        `gorm:"size:255" json:"-"`
+	Attempts    int           `gorm:"not null;default:0" json:"-"`
+	// DownloadUrl is a signed link to the pdf, set once it is ready
+	DownloadUrl string `gorm:"-" json:"download_url,omitempty"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type InvoiceLine struct {
+	ID          uint          `gorm:"primaryKey;auto_increment" json:"-"`
+	InvoiceID   uint          `gorm:"index;not null" json:"-"`
+	Description string        `gorm:"size:255" json:"description"`
+	Amount      helpers.Money `gorm:"size:50;not null" json:"amount"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Invoice) TableName() string {
+	return "invoices"
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (InvoiceLine) TableName() string {
+	return "invoice_lines"
+}
+
+/**
+ * IsReady
+ *
+ * @return bool
+ */
+func (i *Invoice) IsReady() bool {
+	return i.Status == InvoiceReady
+}
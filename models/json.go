@@ -0,0 +1,49 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// JSON is a json document stored as text. gorm binds a json.RawMessage as a list of bytes, not as one value
+type JSON json.RawMessage
+
+/**
+ * Value
+ *
+ * @return driver.Value, error
+ */
+func (j JSON) Value() (driver.Value, error) {
+	if j == nil {
+		return nil, nil
+	}
+	return string(j), nil
+}
+
+/**
+ * Scan
+ *
+ * @return error
+ */
+func (j *JSON) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*j = nil
+	case []byte:
+		*j = append(JSON(nil), v...)
+	case string:
+		*j = JSON(v)
+	default:
+		return fmt.Errorf("models: can not scan %T as json", value)
+	}
+	return nil
+}
+
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return json.RawMessage(j).MarshalJSON()
+}
+
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	return (*json.RawMessage)(j).UnmarshalJSON(data)
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+)
+
+// MagicLink is a single use login link mailed to a user, only the hash of its token is kept
+type MagicLink struct {
+	ID        uint       `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID    uint       `gorm:"index;not null" json:"user_id"`
+	TokenHash string     `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (MagicLink) TableName() string {
+	return "magic_links"
+}
+
+/**
+ * IsUsable
+ * not used and not expired
+ *
+ * @return bool
+ */
+func (m *MagicLink) IsUsable(now time.Time) bool {
+	return m.UsedAt == nil && now.Before(m.ExpiresAt)
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	MediaPublic  = "public"
+	MediaPrivate = "private"
+)
+
+// MediaDiskLocal is the storage driver of the files kept by infrastructures.LocalStorageService
+const MediaDiskLocal = "local"
+
+// Media is an uploaded file, attached to anything attachable once it is used. Unattached media are orphans and are
+// cleaned up after a while
+type Media struct {
+	ID             uint    `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID         uint    `gorm:"not null;index" json:"user_id"`
+	Name           string  `gorm:"size:255;not null" json:"name"`
+	Path           string  `gorm:"size:255;not null" json:"-"`
+	Disk           string  `gorm:"size:20;not null" json:"disk"`
+	Mime           string  `gorm:"size:100;not null;index" json:"mime"`
+	Size           int64   `gorm:"not null" json:"size"`
+	Checksum       string  `gorm:"size:64;not null;index" json:"checksum"`
+	Visibility     string  `gorm:"size:20;not null;default:private" json:"visibility"`
+	AttachableType *string `gorm:"size:50;index:idx_media_attachable" json:"attachable_type"`
+	AttachableID   *uint   `gorm:"index:idx_media_attachable" json:"attachable_id"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Media) TableName() string {
+	return "media"
+}
+
+/**
+ * IsPublic
+ *
+ * @return bool
+ */
+func (m *Media) IsPublic() bool {
+	return m.Visibility == MediaPublic
+}
+
+/**
+ * IsAttached
+ *
+ * @return bool
+ */
+func (m *Media) IsAttached() bool {
+	return m.AttachableType != nil
+}
+
+// MediaUsage totals the files and bytes of a group of media
+type MediaUsage struct {
+	Files int64 `json:"files"`
+	Bytes int64 `json:"bytes"`
+}
+
+// MediaUserUsage is the usage of the media uploaded by a user
+type MediaUserUsage struct {
+	UserID uint `json:"user_id"`
+	MediaUsage
+}
+
+// MediaMimeUsage is the usage of the media of a mime type
+type MediaMimeUsage struct {
+	Mime string `json:"mime"`
+	MediaUsage
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"gotham/helpers"
+)
+
+// Plan is an offer users subscribe to, a plan includes the plans of a lower rank
+type Plan struct {
+	ID            uint          `gorm:"primaryKey;auto_increment" json:"id"`
+	Slug          string        `gorm:"size:50;uniqueIndex;not null" json:"slug"`
+	Name          string        `gorm:"size:100;not null" json:"name"`
+	Rank          int           `gorm:"not null;default:0" json:"rank"`
+	Price         helpers.Money `gorm:"size:50;not null" json:"price"`
+	Interval      string        `gorm:"size:10;not null;default:month" json:"interval"`
+	StripePriceID *string       `gorm:"size:100;uniqueIndex" json:"-"`
+	Active        bool          `gorm:"type:boolean;not null;default:1" json:"active"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Plan) TableName() string {
+	return "plans"
+}
+
+/**
+ * IsFree
+ * the plan of users without a subscription
+ *
+ * @return bool
+ */
+func (p *Plan) IsFree() bool {
+	return p.Price.IsZero()
+}
+
+/**
+ * Includes
+ * a plan includes itself and the plans of a lower rank
+ *
+ * @return bool
+ */
+func (p *Plan) Includes(other Plan) bool {
+	return p.Rank >= other.Rank
+}
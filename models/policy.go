@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+)
+
+// Policy is a published version of a legal document (terms of service, privacy policy ...) users have to accept
+type Policy struct {
+	ID          uint      `gorm:"primaryKey;auto_increment" json:"id"`
+	Type        string    `gorm:"size:50;not null;uniqueIndex:idx_policy_type_version" json:"type"`
+	Version     string    `gorm:"size:50;not null;uniqueIndex:idx_policy_type_version" json:"version"`
+	Url         string    `gorm:"size:500" json:"url"`
+	PublishedAt time.Time `gorm:"index;not null" json:"published_at"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Policy) TableName() string {
+	return "policies"
+}
+
+type Consent struct {
+	ID         uint      `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID     uint      `gorm:"not null;uniqueIndex:idx_consent_user_policy" json:"user_id"`
+	PolicyID   uint      `gorm:"not null;uniqueIndex:idx_consent_user_policy" json:"policy_id"`
+	Policy     Policy    `gorm:"constraint:OnDelete:CASCADE" json:"policy"`
+	Ip         string    `gorm:"size:45" json:"ip"`
+	AcceptedAt time.Time `gorm:"not null" json:"accepted_at"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Consent) TableName() string {
+	return "consents"
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+)
+
+// publish statuses
+const (
+	PublishDraft     = "draft"
+	PublishScheduled = "scheduled"
+	PublishPublished = "published"
+)
+
+var PublishStatuses = []interface{}{PublishDraft, PublishScheduled, PublishPublished}
+
+// Publishable is embedded by the models going live at a time, a scheduled record is published once its PublishAt has
+// come and a draft is only published on demand
+type Publishable struct {
+	PublishStatus string     `gorm:"size:20;not null;default:draft;index" json:"publish_status"`
+	PublishAt     *time.Time `gorm:"index" json:"publish_at"`
+	PublishedAt   *time.Time `json:"published_at"`
+}
+
+/**
+ * IsPublished
+ *
+ * @return bool
+ */
+func (p *Publishable) IsPublished() bool {
+	return p.PublishStatus == PublishPublished
+}
+
+/**
+ * IsDue
+ * a scheduled record whose publish time has come
+ *
+ * @return bool
+ */
+func (p *Publishable) IsDue(now time.Time) bool {
+	return p.PublishStatus == PublishScheduled && p.PublishAt != nil && !p.PublishAt.After(now)
+}
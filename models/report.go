@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	ReportOpen      = "open"
+	ReportResolved  = "resolved"
+	ReportDismissed = "dismissed"
+)
+
+// report reasons
+const (
+	ReportSpam          = "spam"
+	ReportHarassment    = "harassment"
+	ReportHate          = "hate"
+	ReportInappropriate = "inappropriate"
+	ReportOther         = "other"
+)
+
+var ReportReasons = []interface{}{ReportSpam, ReportHarassment, ReportHate, ReportInappropriate, ReportOther}
+
+// Report is the report of a user on a user or a content, the offender is the user responsible for the reportable.
+// A resolved report is upheld against the offender, a dismissed one is not
+type Report struct {
+	ID             uint       `gorm:"primaryKey;auto_increment" json:"id"`
+	ReporterID     uint       `gorm:"not null;index" json:"reporter_id"`
+	ReportableType string     `gorm:"size:50;not null;index:idx_report_reportable" json:"reportable_type"`
+	ReportableID   uint       `gorm:"not null;index:idx_report_reportable" json:"reportable_id"`
+	OffenderID     uint       `gorm:"not null;index" json:"offender_id"`
+	Reason         string     `gorm:"size:30;not null" json:"reason"`
+	Details        string     `gorm:"size:1000" json:"details"`
+	Status         string     `gorm:"size:20;not null;default:open;index" json:"status"`
+	ResolvedByID   *uint      `json:"resolved_by_id"`
+	ResolvedAt     *time.Time `gorm:"index" json:"resolved_at"`
+	Note           *string    `gorm:"size:1000" json:"note"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Report) TableName() string {
+	return "reports"
+}
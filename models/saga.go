@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	SagaRunning      = "running"
+	SagaCompleted    = "completed"
+	SagaCompensating = "compensating"
+	SagaCompensated  = "compensated"
+	SagaFailed       = "failed"
+)
+
+// Saga is the persisted progress of a workflow spanning several services, a saga stopped by a crash is resumed from it
+type Saga struct {
+	ID   uint   `gorm:"primaryKey;auto_increment" json:"id"`
+	Name string `gorm:"size:50;not null;index" json:"name"`
+	// Status is running until the last step, compensating from a failed step until every step done is undone
+	Status string `gorm:"size:20;not null;index:idx_sagas_stalled,priority:1" json:"status"`
+	// Step is the number of steps done and not compensated, the next one to run while running
+	Step int `gorm:"not null;default:0" json:"step"`
+	// State is the json of the values the steps share
+	State JSON `gorm:"type:text;not null" json:"state" swaggertype:"object"`
+	// Error is the one of the failed step, or of the failed compensation
+	Error *string `gorm:"size:1000" json:"error"`
+	// Attempts counts the resumes, a resume claims the saga by incrementing it
+	Attempts int `gorm:"not null;default:0" json:"attempts"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `gorm:"index:idx_sagas_stalled,priority:2" json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Saga) TableName() string {
+	return "sagas"
+}
+
+/**
+ * IsDone
+ * completed, compensated or failed, nothing runs on the saga anymore
+ *
+ * @return bool
+ */
+func (s *Saga) IsDone() bool {
+	return s.Status != SagaRunning && s.Status != SagaCompensating
+}
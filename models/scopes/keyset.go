@@ -0,0 +1,54 @@
+package scopes
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"gotham/pagination"
+)
+
+/**
+ * KeysetOrder
+ * orders the records by (created_at, id), the ordering of the keyset pages
+ */
+func KeysetOrder(tableName string, descending bool) func(db *gorm.DB) *gorm.DB {
+	direction := "asc"
+	if descending {
+		direction = "desc"
+	}
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Order(fmt.Sprintf("%v.created_at %v", tableName, direction)).Order(fmt.Sprintf("%v.id %v", tableName, direction))
+	}
+}
+
+// KeysetOrderer is the GormOrderer of the keyset ordering, whatever the default and the options
+type KeysetOrderer struct {
+	Descending bool
+}
+
+func (o KeysetOrderer) ToOrder(tableName string, defaultOrder string, orderByOptions ...string) func(db *gorm.DB) *gorm.DB {
+	return KeysetOrder(tableName, o.Descending)
+}
+
+/**
+ * Keyset
+ * seeks the records after the cursor of the keyset instead of skipping the previous pages with an offset, the cost
+ * of a page does not grow with its depth on an index of (created_at, id). One more record than the limit is read,
+ * its presence tells there is a next page
+ */
+func Keyset(tableName string, keyset pagination.Keyset) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if after := keyset.After; after != nil {
+			operator := ">"
+			if keyset.Descending {
+				operator = "<"
+			}
+			db = db.Where(
+				fmt.Sprintf("(%[1]v.created_at %[2]v ? OR (%[1]v.created_at = ? AND %[1]v.id %[2]v ?))", tableName, operator),
+				after.CreatedAt, after.CreatedAt, after.ID,
+			)
+		}
+		return KeysetOrder(tableName, keyset.Descending)(db).Limit(keyset.Limit + 1)
+	}
+}
@@ -10,12 +10,36 @@ type GormPager interface {
 	ToPaginate() func(db *gorm.DB) *gorm.DB
 }
 
+/**
+ * LitePager
+ * a pager whose page can be read without counting the records, it reads one more record than the limit instead and
+ * is told whether it was found
+ */
+type LitePager interface {
+	GormPager
+	GetLimit() int
+	IsLite() bool
+	SetHasNext(hasNext bool)
+}
+
 type GormPagination struct {
 	*utils.Pagination
 }
 
 func (r *GormPagination) ToPaginate() func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
-		return db.Offset(helpers.OffsetCal(r.Pagination.GetPage(), r.Pagination.GetLimit())).Limit(r.Pagination.GetLimit())
+		limit := r.Pagination.GetLimit()
+		if r.Pagination.Lite {
+			limit++
+		}
+		return db.Offset(helpers.OffsetCal(r.Pagination.GetPage(), r.Pagination.GetLimit())).Limit(limit)
 	}
 }
+
+func (r *GormPagination) IsLite() bool {
+	return r.Pagination.Lite
+}
+
+func (r *GormPagination) SetHasNext(hasNext bool) {
+	r.Pagination.HasNext = hasNext
+}
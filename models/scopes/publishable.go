@@ -0,0 +1,31 @@
+package scopes
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/models"
+)
+
+// Published keeps the published records of the table
+func Published(tableName string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(fmt.Sprintf("%v.publish_status = ?", tableName), models.PublishPublished)
+	}
+}
+
+// Drafts keeps the drafts of the table
+func Drafts(tableName string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(fmt.Sprintf("%v.publish_status = ?", tableName), models.PublishDraft)
+	}
+}
+
+// DueForPublishing keeps the scheduled records of the table whose publish time has come
+func DueForPublishing(tableName string, now time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(fmt.Sprintf("%[1]v.publish_status = ? AND %[1]v.publish_at <= ?", tableName), models.PublishScheduled, now)
+	}
+}
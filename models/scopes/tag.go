@@ -0,0 +1,21 @@
+package scopes
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// TaggedWith keeps the records of the table tagged with every slug, no slug keeps them all
+func TaggedWith(tableName string, taggableType string, slugs []string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(slugs) == 0 {
+			return db
+		}
+		return db.Where(fmt.Sprintf("%v.id IN (?)", tableName), gorm.Expr(
+			"SELECT taggables.taggable_id FROM taggables JOIN tags ON tags.id = taggables.tag_id "+
+				"WHERE taggables.taggable_type = ? AND tags.slug IN ? GROUP BY taggables.taggable_id HAVING COUNT(DISTINCT tags.id) = ?",
+			taggableType, slugs, len(slugs),
+		))
+	}
+}
@@ -0,0 +1,9 @@
+package models
+
+// Searchable is a model the search engine indexes, in the index named after its table
+type Searchable interface {
+	TableName() string
+	SearchID() uint
+	// SearchDocument is the record as it is indexed, nil when it must not be found
+	SearchDocument() map[string]interface{}
+}
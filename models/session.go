@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+)
+
+// Session is a remember me session of a device, its refresh token rotates on every use and only hashes are kept
+type Session struct {
+	ID                uint       `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID            uint       `gorm:"index;not null" json:"user_id"`
+	DeviceID          string     `gorm:"size:100;not null" json:"device_id"`
+	UserAgent         string     `gorm:"size:255" json:"user_agent"`
+	TokenHash         string     `gorm:"size:64;not null" json:"-"`
+	PreviousTokenHash *string    `gorm:"size:64" json:"-"`
+	ExpiresAt         time.Time  `gorm:"index;not null" json:"expires_at"`
+	LastUsedAt        time.Time  `json:"last_used_at"`
+	RevokedAt         *time.Time `json:"revoked_at"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Session) TableName() string {
+	return "sessions"
+}
+
+/**
+ * IsActive
+ * not revoked and not expired
+ *
+ * @return bool
+ */
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
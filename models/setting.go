@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+)
+
+// setting types, the value of a setting is stored as a string and parsed by its type
+const (
+	SettingString   = "string"
+	SettingInt      = "int"
+	SettingBool     = "bool"
+	SettingDuration = "duration"
+	// SettingExpression is an access rule of the policies, empty when there is none
+	SettingExpression = "expression"
+)
+
+// registration modes
+const (
+	RegistrationOpen       = "open"
+	RegistrationInviteOnly = "invite_only"
+	RegistrationClosed     = "closed"
+)
+
+// Setting is a runtime tunable of the application, changed by admins without a deploy.
+// Options restricts the value to a comma separated list.
+type Setting struct {
+	Key         string `gorm:"primaryKey;size:100" json:"key"`
+	Type        string `gorm:"size:20;not null" json:"type"`
+	Value       string `gorm:"type:text" json:"value"`
+	Options     string `gorm:"size:255" json:"options,omitempty"`
+	Description string `gorm:"size:255" json:"description"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Setting) TableName() string {
+	return "settings"
+}
+
+// DefaultSettings are seeded once, the stored value wins afterwards
+var DefaultSettings = []Setting{
+	{Key: "signup_enabled", Type: SettingBool, Value: "true", Description: "new users can register"},
+	{Key: "registration_mode", Type: SettingString, Value: RegistrationOpen, Options: RegistrationOpen + "," + RegistrationInviteOnly + "," + RegistrationClosed, Description: "who can register when signup is enabled"},
+	{Key: "default_user_tags", Type: SettingString, Value: "", Description: "comma separated tags given to the new users"},
+	{Key: "default_page_size", Type: SettingInt, Value: "20", Description: "the page size of a paginated endpoint when the request gives none"},
+	{Key: "max_page_size", Type: SettingInt, Value: "100", Description: "the largest page a request to a paginated endpoint can ask for, a larger one is rejected"},
+	{Key: "captcha_enabled", Type: SettingBool, Value: "false", Description: "login and registration ask for a captcha after suspicious activity"},
+	{Key: "captcha_login_threshold", Type: SettingInt, Value: "3", Description: "failed logins of an ip or email before a captcha is required"},
+	{Key: "captcha_registration_threshold", Type: SettingInt, Value: "5", Description: "registrations of an ip before a captcha is required"},
+	{Key: "comment_flag_threshold", Type: SettingInt, Value: "3", Description: "flags hiding a comment until a moderator reviews it, 0 never hides"},
+	{Key: "report_suspension_threshold", Type: SettingInt, Value: "3", Description: "upheld reports within the offense window suspending the offender, 0 never suspends"},
+	{Key: "report_offense_window", Type: SettingDuration, Value: "720h", Description: "how long an upheld report counts against the offender"},
+	{Key: "report_suspension_duration", Type: SettingDuration, Value: "168h", Description: "how long a repeat offender is suspended, 0 suspends indefinitely"},
+	{Key: "access_rule_users_show", Type: SettingExpression, Value: "", Description: "the rule a user is viewed under besides its policy, like user.department == resource.department"},
+	{Key: "access_rule_users_patch", Type: SettingExpression, Value: "", Description: "the rule a user is patched under besides its policy"},
+}
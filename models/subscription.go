@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	SubscriptionTrialing   = "trialing"
+	SubscriptionActive     = "active"
+	SubscriptionPastDue    = "past_due"
+	SubscriptionIncomplete = "incomplete"
+	SubscriptionCanceled   = "canceled"
+	SubscriptionUnpaid     = "unpaid"
+)
+
+// Subscription mirrors the subscription of a user at the payment provider, it is only written by the webhooks
+type Subscription struct {
+	ID                uint      `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID            uint      `gorm:"uniqueIndex;not null" json:"user_id"`
+	PlanID            uint      `gorm:"not null" json:"plan_id"`
+	Plan              Plan      `gorm:"constraint:OnDelete:RESTRICT" json:"plan"`
+	Status            string    `gorm:"size:20;not null" json:"status"`
+	CustomerID        string    `gorm:"size:100;index;not null" json:"-"`
+	ExternalID        string    `gorm:"size:100;uniqueIndex;not null" json:"-"`
+	CurrentPeriodEnd  time.Time `json:"current_period_end"`
+	CancelAtPeriodEnd bool      `gorm:"type:boolean;not null;default:0" json:"cancel_at_period_end"`
+	// the time of the last webhook applied, events delivered out of order are not applied over newer ones
+	LastEventAt time.Time `json:"-"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Subscription) TableName() string {
+	return "subscriptions"
+}
+
+// EntitledStatuses are the statuses of the subscriptions granting their plan until the end of the period
+var EntitledStatuses = []string{SubscriptionActive, SubscriptionTrialing, SubscriptionPastDue}
+
+/**
+ * IsEntitled
+ * the plan of the subscription is granted while it is paid, past due subscriptions keep it while stripe retries
+ *
+ * @return bool
+ */
+func (s *Subscription) IsEntitled(now time.Time) bool {
+	switch s.Status {
+	case SubscriptionActive, SubscriptionTrialing, SubscriptionPastDue:
+		return now.Before(s.CurrentPeriodEnd)
+	}
+	return false
+}
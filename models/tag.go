@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+)
+
+// Tag is a label attached to anything taggable, tags are matched by their slug
+type Tag struct {
+	ID   uint   `gorm:"primaryKey;auto_increment" json:"id"`
+	Name string `gorm:"size:50;not null" json:"name"`
+	Slug string `gorm:"size:50;not null;uniqueIndex" json:"slug"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Taggable attaches a tag to a taggable, the target is its type and id
+type Taggable struct {
+	ID           uint   `gorm:"primaryKey;auto_increment" json:"-"`
+	TagID        uint   `gorm:"not null;uniqueIndex:idx_taggable_tag" json:"tag_id"`
+	TaggableType string `gorm:"size:50;not null;uniqueIndex:idx_taggable_tag;index:idx_taggable_taggable" json:"taggable_type"`
+	TaggableID   uint   `gorm:"not null;uniqueIndex:idx_taggable_tag;index:idx_taggable_taggable" json:"taggable_id"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PopularTag is a tag with the number of taggables it is attached to
+type PopularTag struct {
+	Tag
+	Count int64 `json:"count"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Tag) TableName() string {
+	return "tags"
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Taggable) TableName() string {
+	return "taggables"
+}
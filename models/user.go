@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
@@ -8,7 +9,7 @@ import (
 )
 
 type User struct {
-	ID                uint    `gorm:"primaryKey;auto_increment" json:"id"`
+	ID                uint    `gorm:"primaryKey;auto_increment;index:idx_users_keyset,priority:2" json:"id"`
 	Name              string  `gorm:"size:255;not null" json:"name"`
 	Email             string  `gorm:"size:100;not null;unique;unique_index" json:"email"`
 	Password          string  `gorm:"size:100" json:"-"`
@@ -16,9 +17,30 @@ type User struct {
 	VerificationToken *string `gorm:"size:50;" json:"-"`
 	Image             *string `gorm:"size:500;" json:"image"`
 	Admin             bool    `gorm:"type:boolean;not null;default:0" json:"admin"`
+	// Timezone is an IANA name, the times of the responses to the user are written in it
+	Timezone string `gorm:"size:64;not null;default:UTC" json:"timezone"`
 
-	// Time
-	CreatedAt time.Time      `json:"created_at"`
+	// Phone in E.164, verified with a code sent by sms, only the hash of the pending code is kept
+	Phone              *string    `gorm:"size:16;uniqueIndex" json:"phone"`
+	PhoneVerifiedAt    *time.Time `json:"phone_verified_at"`
+	PhoneCodeHash      *string    `gorm:"size:64" json:"-"`
+	PhoneCodeExpiresAt *time.Time `json:"-"`
+	PhoneCodeAttempts  int        `gorm:"not null;default:0" json:"-"`
+
+	// Request quotas, the configured defaults apply when nil and 0 is unlimited
+	DailyQuota   *int `json:"daily_quota"`
+	MonthlyQuota *int `json:"monthly_quota"`
+
+	// Suspension set by an admin, indefinite without an end
+	SuspendedAt      *time.Time `json:"suspended_at"`
+	SuspendedUntil   *time.Time `gorm:"index" json:"suspended_until"`
+	SuspensionReason *string    `gorm:"size:500" json:"suspension_reason"`
+
+	// Deletion requested by the user, the account is purged once the grace period is over
+	DeletionScheduledAt *time.Time `gorm:"index" json:"deletion_scheduled_at"`
+
+	// Time, (created_at, id) is indexed for the keyset pages of the users
+	CreatedAt time.Time      `gorm:"index:idx_users_keyset,priority:1" json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 }
@@ -61,6 +83,66 @@ func (u *User) IsAdmin() bool {
 	return u.Admin
 }
 
+/**
+ * IsDeletionScheduled
+ *
+ * @return bool
+ */
+func (u *User) IsDeletionScheduled() bool {
+	return u.DeletionScheduledAt != nil
+}
+
+/**
+ * IsPhoneVerified
+ *
+ * @return bool
+ */
+func (u *User) IsPhoneVerified() bool {
+	return u.Phone != nil && u.PhoneVerifiedAt != nil
+}
+
+/**
+ * IsSuspended
+ *
+ * @return bool
+ */
+func (u *User) IsSuspended(now time.Time) bool {
+	return u.SuspendedAt != nil && (u.SuspendedUntil == nil || now.Before(*u.SuspendedUntil))
+}
+
+/**
+ * AfterCreate
+ * the first version of the user
+ */
+func (u *User) AfterCreate(tx *gorm.DB) error {
+	return u.writeVersion(tx)
+}
+
+/**
+ * AfterUpdate
+ * a version of the user after each change of a loaded user, the updates of the rows matching a condition have none
+ */
+func (u *User) AfterUpdate(tx *gorm.DB) error {
+	return u.writeVersion(tx)
+}
+
+// writeVersion reads the user again, an update of some columns does not set the other fields
+func (u *User) writeVersion(tx *gorm.DB) error {
+	if u.ID == 0 {
+		return nil
+	}
+	db := tx.Session(&gorm.Session{NewDB: true})
+	var current User
+	if err := db.Unscoped().First(&current, u.ID).Error; err != nil {
+		return err
+	}
+	data, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+	return db.Create(&UserVersion{UserID: u.ID, Data: data}).Error
+}
+
 // ConvertUser /**
 func ConvertUser(claims interface{}) User {
 	return claims.(User)
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+)
+
+// UserSearchView is the denormalized row of a user read by the admin search, written by the projection of the user
+// events and never by the requests
+type UserSearchView struct {
+	UserID    uint   `gorm:"primaryKey;autoIncrement:false" json:"user_id"`
+	Name      string `gorm:"size:255;not null" json:"name"`
+	Email     string `gorm:"size:100;not null;index" json:"email"`
+	Verified  bool   `gorm:"type:boolean;not null;default:0" json:"verified"`
+	Admin     bool   `gorm:"type:boolean;not null;default:0" json:"admin"`
+	Suspended bool   `gorm:"type:boolean;not null;default:0" json:"suspended"`
+	// TagNames are the names of the tags of the user, TagSlugs their slugs between commas (,a,b,) to filter by one
+	TagNames       string `gorm:"size:1000;not null;default:''" json:"tag_names"`
+	TagSlugs       string `gorm:"size:1000;not null;default:''" json:"-"`
+	FollowersCount int64  `gorm:"not null;default:0" json:"followers_count"`
+	FollowingCount int64  `gorm:"not null;default:0" json:"following_count"`
+
+	// Time, CreatedAt is the one of the user, ProjectedAt the last time the row was projected
+	CreatedAt   time.Time `gorm:"index" json:"created_at"`
+	ProjectedAt time.Time `json:"projected_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (UserSearchView) TableName() string {
+	return "user_search_views"
+}
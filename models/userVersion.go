@@ -0,0 +1,37 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// UserVersion is the state of a user after one of its changes, written by the hooks of the user in the same transaction
+type UserVersion struct {
+	ID     uint `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID uint `gorm:"index;not null" json:"user_id"`
+	// Data is the json of the user, without the fields its json hides
+	Data JSON `gorm:"type:text;not null" json:"user" swaggertype:"object"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (UserVersion) TableName() string {
+	return "user_versions"
+}
+
+/**
+ * Fields
+ * the fields of the user in this version by their json names
+ *
+ * @return map[string]interface{}, error
+ */
+func (v *UserVersion) Fields() (fields map[string]interface{}, err error) {
+	err = json.Unmarshal(v.Data, &fields)
+	return
+}
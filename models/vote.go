@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	VoteLike    = 1
+	VoteDislike = -1
+)
+
+// Vote is the like or dislike of a user on anything votable, the target is its type and id
+type Vote struct {
+	ID          uint   `gorm:"primaryKey;auto_increment" json:"id"`
+	UserID      uint   `gorm:"not null;uniqueIndex:idx_vote_user_votable" json:"user_id"`
+	VotableType string `gorm:"size:50;not null;uniqueIndex:idx_vote_user_votable" json:"votable_type"`
+	VotableID   uint   `gorm:"not null;uniqueIndex:idx_vote_user_votable" json:"votable_id"`
+	Value       int    `gorm:"not null" json:"value"`
+
+	// Time
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// VoteTally holds the counters of a votable, they are updated with the votes and the score is computed by a job
+type VoteTally struct {
+	ID          uint       `gorm:"primaryKey;auto_increment" json:"-"`
+	VotableType string     `gorm:"size:50;not null;uniqueIndex:idx_tally_votable" json:"votable_type"`
+	VotableID   uint       `gorm:"not null;uniqueIndex:idx_tally_votable" json:"votable_id"`
+	Likes       int64      `gorm:"not null;default:0" json:"likes"`
+	Dislikes    int64      `gorm:"not null;default:0" json:"dislikes"`
+	Score       float64    `gorm:"not null;default:0;index" json:"score"`
+	ScoredAt    *time.Time `json:"-"`
+
+	// Time
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (Vote) TableName() string {
+	return "votes"
+}
+
+/**
+ * TableName
+ *
+ * @return string
+ */
+func (VoteTally) TableName() string {
+	return "vote_tallies"
+}
@@ -0,0 +1,92 @@
+package openapi
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/swaggo/swag"
+)
+
+var pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+// Schema is the subset of a swagger 2.0 schema swag emits
+type Schema struct {
+	Ref        string             `json:"$ref"`
+	Type       string             `json:"type"`
+	Format     string             `json:"format"`
+	Example    interface{}        `json:"example"`
+	Enum       []interface{}      `json:"enum"`
+	Items      *Schema            `json:"items"`
+	Properties map[string]*Schema `json:"properties"`
+	Required   []string           `json:"required"`
+	AllOf      []*Schema          `json:"allOf"`
+}
+
+type Parameter struct {
+	Name     string        `json:"name"`
+	In       string        `json:"in"`
+	Type     string        `json:"type"`
+	Required bool          `json:"required"`
+	Enum     []interface{} `json:"enum"`
+	Items    *Schema       `json:"items"`
+	Schema   *Schema       `json:"schema"`
+}
+
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema"`
+}
+
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Parameters  []Parameter         `json:"parameters"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+type Spec struct {
+	Paths       map[string]map[string]*Operation `json:"paths"`
+	Definitions map[string]*Schema               `json:"definitions"`
+}
+
+// ReadSpec decodes the swagger spec generated by swag
+func ReadSpec(doc []byte) (*Spec, error) {
+	spec := new(Spec)
+	if err := json.Unmarshal(doc, spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// ReadRegisteredSpec decodes the swagger spec the docs package registers with swag, it has to be imported
+func ReadRegisteredSpec() (*Spec, error) {
+	doc, err := swag.ReadDoc()
+	if err != nil {
+		return nil, err
+	}
+	return ReadSpec([]byte(doc))
+}
+
+/**
+ * Operations
+ * the operations of the spec by "METHOD /path/:param", the route keys of echo
+ */
+func (s *Spec) Operations() map[string]*Operation {
+	operations := map[string]*Operation{}
+	for path, methods := range s.Paths {
+		for method, operation := range methods {
+			operations[strings.ToUpper(method)+" "+EchoPath(path)] = operation
+		}
+	}
+	return operations
+}
+
+// Definition is the schema of the reference, nil when the spec does not define it
+func (s *Spec) Definition(ref string) *Schema {
+	return s.Definitions[strings.TrimPrefix(ref, "#/definitions/")]
+}
+
+// EchoPath turns the {param} of a swagger path into the :param of echo
+func EchoPath(path string) string {
+	return pathParam.ReplaceAllString(path, ":$1")
+}
@@ -0,0 +1,233 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the nesting past which a value is not checked, a definition referencing itself ends there
+const maxDepth = 16
+
+/**
+ * Validate
+ * the errors of the json value against the schema, "body.data.records[0].email: a string is expected". The allOf
+ * parts are merged, the last one wins as swag overrides the data of the response envelope with them. A null is
+ * accepted for every type, swag does not document the nullable fields, and an object with documented properties has
+ * no others
+ */
+func (s *Spec) Validate(schema *Schema, value interface{}, path string) []string {
+	var errs []string
+	s.validate(schema, value, path, 0, &errs)
+	sort.Strings(errs)
+	return errs
+}
+
+func (s *Spec) validate(schema *Schema, value interface{}, path string, depth int, errs *[]string) {
+	if schema == nil || value == nil || depth > maxDepth {
+		return
+	}
+	schema = s.resolve(schema, 0)
+	if len(schema.Enum) > 0 && !inEnum(schema.Enum, value) {
+		*errs = append(*errs, fmt.Sprintf("%v: %v is not one of %v", path, value, schema.Enum))
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			*errs = append(*errs, path+": an object is expected")
+			return
+		}
+		for _, name := range schema.Required {
+			if _, ok := object[name]; !ok {
+				*errs = append(*errs, path+"."+name+": is required")
+			}
+		}
+		if len(schema.Properties) == 0 {
+			return
+		}
+		for name, field := range object {
+			property, ok := schema.Properties[name]
+			if !ok {
+				*errs = append(*errs, path+"."+name+": is not documented")
+				continue
+			}
+			s.validate(property, field, path+"."+name, depth+1, errs)
+		}
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			*errs = append(*errs, path+": an array is expected")
+			return
+		}
+		for i, item := range array {
+			s.validate(schema.Items, item, fmt.Sprintf("%v[%d]", path, i), depth+1, errs)
+		}
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			*errs = append(*errs, path+": a string is expected")
+		} else if _, err := time.Parse(time.RFC3339, text); schema.Format == "date-time" && err != nil {
+			*errs = append(*errs, path+": a RFC 3339 date-time is expected")
+		}
+	case "integer":
+		if number, ok := value.(float64); !ok || number != math.Trunc(number) {
+			*errs = append(*errs, path+": an integer is expected")
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			*errs = append(*errs, path+": a number is expected")
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			*errs = append(*errs, path+": a boolean is expected")
+		}
+	}
+}
+
+// resolve flattens the reference and the allOf parts of the schema into one schema
+func (s *Spec) resolve(schema *Schema, depth int) *Schema {
+	if schema.Ref == "" && len(schema.AllOf) == 0 {
+		if schema.Type == "" && len(schema.Properties) > 0 {
+			resolved := *schema
+			resolved.Type = "object"
+			return &resolved
+		}
+		return schema
+	}
+
+	var parts []*Schema
+	if definition := s.Definition(schema.Ref); schema.Ref != "" && definition != nil {
+		parts = append(parts, definition)
+	}
+	parts = append(parts, schema.AllOf...)
+	resolved := &Schema{Type: schema.Type, Format: schema.Format, Enum: schema.Enum, Items: schema.Items, Properties: map[string]*Schema{}, Required: schema.Required}
+	for _, part := range parts {
+		if depth < maxDepth {
+			part = s.resolve(part, depth+1)
+		}
+		if resolved.Type == "" {
+			resolved.Type = part.Type
+		}
+		if resolved.Items == nil {
+			resolved.Items = part.Items
+		}
+		for name, property := range part.Properties {
+			resolved.Properties[name] = property
+		}
+		resolved.Required = append(resolved.Required, part.Required...)
+	}
+	for name, property := range schema.Properties {
+		resolved.Properties[name] = property
+	}
+	if resolved.Type == "" && len(resolved.Properties) > 0 {
+		resolved.Type = "object"
+	}
+	return resolved
+}
+
+/**
+ * ValidateRequest
+ * the errors of the query and of the json body of a request against the parameters of the operation. A single body
+ * parameter with an object schema is the whole body, several are the fields of the body
+ */
+func (s *Spec) ValidateRequest(operation *Operation, query url.Values, body []byte, isJSON bool) []string {
+	var errs []string
+	var fields []Parameter
+	for _, parameter := range operation.Parameters {
+		switch parameter.In {
+		case "query":
+			errs = append(errs, validateQuery(parameter, query[parameter.Name])...)
+		case "body":
+			fields = append(fields, parameter)
+		}
+	}
+	if len(fields) == 0 || !isJSON {
+		return errs
+	}
+
+	var value interface{}
+	if len(strings.TrimSpace(string(body))) > 0 {
+		if err := json.Unmarshal(body, &value); err != nil {
+			return append(errs, "body: invalid json")
+		}
+	}
+	if schema := fields[0].Schema; len(fields) == 1 && schema != nil && (schema.Ref != "" || len(schema.Properties) > 0) {
+		if value == nil && fields[0].Required {
+			return append(errs, "body: is required")
+		}
+		return append(errs, s.Validate(schema, value, "body")...)
+	}
+
+	object, _ := value.(map[string]interface{})
+	if value != nil && object == nil {
+		return append(errs, "body: an object is expected")
+	}
+	for _, field := range fields {
+		fieldValue, ok := object[field.Name]
+		if !ok {
+			if field.Required {
+				errs = append(errs, "body."+field.Name+": is required")
+			}
+			continue
+		}
+		schema := field.Schema
+		if schema == nil {
+			schema = &Schema{Type: field.Type, Enum: field.Enum, Items: field.Items}
+		}
+		errs = append(errs, s.Validate(schema, fieldValue, "body."+field.Name)...)
+	}
+	return errs
+}
+
+func validateQuery(parameter Parameter, values []string) (errs []string) {
+	path := "query." + parameter.Name
+	if len(values) == 0 {
+		if parameter.Required {
+			errs = append(errs, path+": is required")
+		}
+		return errs
+	}
+	kind, enum := parameter.Type, parameter.Enum
+	if kind == "array" && parameter.Items != nil {
+		kind, enum = parameter.Items.Type, parameter.Items.Enum
+		var split []string
+		for _, value := range values {
+			split = append(split, strings.Split(value, ",")...)
+		}
+		values = split
+	}
+	for _, value := range values {
+		var err error
+		switch kind {
+		case "integer":
+			_, err = strconv.ParseInt(value, 10, 64)
+		case "number":
+			_, err = strconv.ParseFloat(value, 64)
+		case "boolean":
+			_, err = strconv.ParseBool(value)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%v: %q is not a valid %v", path, value, kind))
+		} else if len(enum) > 0 && !inEnum(enum, value) {
+			errs = append(errs, fmt.Sprintf("%v: %v is not one of %v", path, value, enum))
+		}
+	}
+	return errs
+}
+
+// inEnum compares the values as text, a query value is a string whatever the type of the enum
+func inEnum(enum []interface{}, value interface{}) bool {
+	for _, allowed := range enum {
+		if fmt.Sprint(allowed) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
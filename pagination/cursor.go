@@ -0,0 +1,125 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"gotham/utils"
+)
+
+var ErrInvalidCursor = errors.New("pagination: the cursor is invalid")
+
+// the version of the encoding, a cursor of another version is invalid
+const version = "2"
+
+/**
+ * Cursor
+ * the position of a keyset page, the (created_at, id) of the last record of the previous page and the direction of
+ * the ordering. The records of the next page are after it in that ordering, whatever was created or deleted since
+ */
+type Cursor struct {
+	CreatedAt  time.Time
+	ID         uint
+	Descending bool
+}
+
+/**
+ * Keyset
+ * a page of records ordered by (created_at, id), the first one when After is nil
+ */
+type Keyset struct {
+	After      *Cursor
+	Limit      int
+	Descending bool
+}
+
+/**
+ * After
+ * the keyset of the page after the cursor, in the direction of the cursor
+ */
+func After(cursor Cursor, limit int) Keyset {
+	return Keyset{After: &cursor, Limit: limit, Descending: cursor.Descending}
+}
+
+// Next the cursor of the page after the record of the keyset
+func (k Keyset) Next(createdAt time.Time, id uint) Cursor {
+	return Cursor{CreatedAt: createdAt, ID: id, Descending: k.Descending}
+}
+
+/**
+ * More
+ * whether there is a page after the records read for the keyset, one more than the limit is read when there is
+ */
+func (k Keyset) More(read int) bool {
+	return read > k.Limit
+}
+
+/**
+ * Encode
+ * the opaque string of the cursor, url safe base64 so it can be passed back in the query as it is. It is signed with
+ * the key for the listing of the scope, its filters, so a cursor changed by the client or of another listing is invalid
+ */
+func (c Cursor) Encode(key []byte, scope string) string {
+	direction := "a"
+	if c.Descending {
+		direction = "d"
+	}
+	raw := strings.Join([]string{version, direction, strconv.FormatInt(c.CreatedAt.UnixNano(), 36), strconv.FormatUint(uint64(c.ID), 36)}, ".")
+	return base64.RawURLEncoding.EncodeToString([]byte(raw + "." + sign(key, scope, raw)))
+}
+
+/**
+ * Decode
+ * the cursor of an opaque string written by Encode with the key for the scope, ErrInvalidCursor when it was not
+ */
+func Decode(encoded string, key []byte, scope string) (cursor Cursor, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor, ErrInvalidCursor
+	}
+	parts := strings.Split(string(raw), ".")
+	if len(parts) != 5 || !hmac.Equal([]byte(parts[4]), []byte(sign(key, scope, strings.Join(parts[:4], ".")))) {
+		return cursor, ErrInvalidCursor
+	}
+	if parts[0] != version || (parts[1] != "a" && parts[1] != "d") {
+		return cursor, ErrInvalidCursor
+	}
+	nanoseconds, err := strconv.ParseInt(parts[2], 36, 64)
+	if err != nil {
+		return cursor, ErrInvalidCursor
+	}
+	id, err := strconv.ParseUint(parts[3], 36, 64)
+	if err != nil || id == 0 {
+		return cursor, ErrInvalidCursor
+	}
+	return Cursor{CreatedAt: time.Unix(0, nanoseconds), ID: uint(id), Descending: parts[1] == "d"}, nil
+}
+
+// sign is the hmac of the raw cursor for the scope
+func sign(key []byte, scope string, raw string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(scope))
+	mac.Write([]byte{0})
+	mac.Write([]byte(raw))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:16])
+}
+
+/**
+ * Ordering
+ * the direction of the keyset pages continuing the listing of the order, ok when it is the (created_at, id) ordering
+ * of the keyset pages: the default one or created_at
+ */
+func Ordering(order utils.IOrder) (descending bool, ok bool) {
+	switch order.GetOrderBy() {
+	case "":
+		return false, true
+	case "created_at":
+		return order.GetSortBy() == "desc", true
+	}
+	return false, false
+}
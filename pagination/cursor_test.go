@@ -0,0 +1,153 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"gotham/utils"
+)
+
+var (
+	key   = []byte("secret")
+	scope = "users?tags="
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2021, 3, 4, 5, 6, 7, 123456789, time.UTC)
+	for _, cursor := range []Cursor{
+		{CreatedAt: createdAt, ID: 42},
+		{CreatedAt: createdAt, ID: 42, Descending: true},
+		{CreatedAt: time.Unix(0, 0), ID: 1},
+		{CreatedAt: time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC), ID: 1<<32 - 1},
+	} {
+		decoded, err := Decode(cursor.Encode(key, scope), key, scope)
+		if err != nil {
+			t.Fatalf("%+v: %v", cursor, err)
+		}
+		if !decoded.CreatedAt.Equal(cursor.CreatedAt) || decoded.ID != cursor.ID || decoded.Descending != cursor.Descending {
+			t.Fatalf("%+v decoded as %+v", cursor, decoded)
+		}
+	}
+}
+
+func TestCursorTies(t *testing.T) {
+	// the records created in the same instant are told apart by their id, to the nanosecond
+	createdAt := time.Date(2021, 3, 4, 5, 6, 7, 1, time.UTC)
+	first := Cursor{CreatedAt: createdAt, ID: 7}
+	second := Cursor{CreatedAt: createdAt, ID: 8}
+	if first.Encode(key, scope) == second.Encode(key, scope) {
+		t.Fatal("the cursors of a tie are the same")
+	}
+	later := Cursor{CreatedAt: createdAt.Add(time.Nanosecond), ID: 7}
+	if first.Encode(key, scope) == later.Encode(key, scope) {
+		t.Fatal("the cursors a nanosecond apart are the same")
+	}
+	for _, cursor := range []Cursor{first, second, later} {
+		decoded, err := Decode(cursor.Encode(key, scope), key, scope)
+		if err != nil || !decoded.CreatedAt.Equal(cursor.CreatedAt) || decoded.ID != cursor.ID {
+			t.Fatalf("%+v decoded as %+v %v", cursor, decoded, err)
+		}
+	}
+}
+
+func TestCursorTampered(t *testing.T) {
+	cursor := Cursor{CreatedAt: time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC), ID: 42}
+	encoded := cursor.Encode(key, scope)
+	raw, _ := base64.RawURLEncoding.DecodeString(encoded)
+	parts := strings.Split(string(raw), ".")
+	reencode := func(parts ...string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(parts, ".")))
+	}
+
+	tampered := map[string]string{
+		"empty":                "",
+		"not base64":           "not a cursor!",
+		"truncated":            encoded[:len(encoded)-2],
+		"other id":             reencode(parts[0], parts[1], parts[2], "1", parts[4]),
+		"other time":           reencode(parts[0], parts[1], "0", parts[3], parts[4]),
+		"other direction":      reencode(parts[0], "d", parts[2], parts[3], parts[4]),
+		"without signature":    reencode(parts[:4]...),
+		"empty signature":      reencode(parts[0], parts[1], parts[2], parts[3], ""),
+		"signature of another": reencode(parts[0], parts[1], parts[2], parts[3], strings.Split(mustDecode(t, Cursor{ID: 1}.Encode(key, scope)), ".")[4]),
+		"extra part":           reencode(append(parts, "x")...),
+		"unsigned version 1":   reencode("1", "a", parts[2], parts[3]),
+	}
+	for name, encoded := range tampered {
+		if cursor, err := Decode(encoded, key, scope); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%v: decoded as %+v %v", name, cursor, err)
+		}
+	}
+}
+
+func TestCursorForeign(t *testing.T) {
+	encoded := Cursor{CreatedAt: time.Now(), ID: 42}.Encode(key, scope)
+	if _, err := Decode(encoded, []byte("other secret"), scope); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("a cursor of another key: %v", err)
+	}
+	if _, err := Decode(encoded, key, "users?tags=admin"); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("a cursor of another listing: %v", err)
+	}
+	if _, err := Decode(encoded, nil, scope); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("a cursor without a key: %v", err)
+	}
+}
+
+func TestKeysetPages(t *testing.T) {
+	keyset := Keyset{Limit: 2}
+	tests := []struct {
+		read int
+		more bool
+	}{
+		{0, false},
+		{1, false},
+		// the last page is full, no more record was read past it
+		{2, false},
+		{3, true},
+	}
+	for _, test := range tests {
+		if more := keyset.More(test.read); more != test.more {
+			t.Errorf("%d read: %v, expected %v", test.read, more, test.more)
+		}
+	}
+
+	// the next pages keep the direction of the first one
+	createdAt := time.Now()
+	next := After(Cursor{CreatedAt: createdAt, ID: 3, Descending: true}, 10).Next(createdAt, 2)
+	if !next.Descending || next.ID != 2 {
+		t.Errorf("next cursor %+v", next)
+	}
+	if page := After(next, 10); page.After == nil || page.After.ID != 2 || !page.Descending || page.Limit != 10 {
+		t.Errorf("page %+v", page)
+	}
+}
+
+func TestOrdering(t *testing.T) {
+	tests := []struct {
+		order      utils.Order
+		descending bool
+		ok         bool
+	}{
+		{utils.Order{}, false, true},
+		{utils.Order{OrderBy: "created_at"}, false, true},
+		{utils.Order{OrderBy: "created_at", SortBy: "desc"}, true, true},
+		{utils.Order{OrderBy: "name"}, false, false},
+	}
+	for _, test := range tests {
+		descending, ok := Ordering(&test.order)
+		if descending != test.descending || ok != test.ok {
+			t.Errorf("%+v: %v %v", test.order, descending, ok)
+		}
+	}
+}
+
+func mustDecode(t *testing.T, encoded string) string {
+	t.Helper()
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(raw)
+}
@@ -0,0 +1,295 @@
+package patch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// the media types of the patch documents
+const (
+	MergePatchType = "application/merge-patch+json"
+	JSONPatchType  = "application/json-patch+json"
+)
+
+var (
+	ErrUnsupported = errors.New("patch: the media type is not a patch document")
+	ErrInvalid     = errors.New("patch: the patch document is invalid")
+	ErrConflict    = errors.New("patch: the patch can not be applied to the document")
+)
+
+/**
+ * Patch
+ * applies the patch document of the media type to the json document, a merge patch (RFC 7386) or a json patch
+ * (RFC 6902). A plain json body is taken as a merge patch
+ */
+func Patch(mediaType string, document []byte, patch []byte) ([]byte, error) {
+	switch mediaType {
+	case MergePatchType, "application/json":
+		return Merge(document, patch)
+	case JSONPatchType:
+		return Apply(document, patch)
+	}
+	return nil, ErrUnsupported
+}
+
+/**
+ * Merge
+ * applies the merge patch to the document (RFC 7386): the members of the patch replace the ones of the document, the
+ * null ones are removed and the objects are merged recursively
+ */
+func Merge(document []byte, patch []byte) ([]byte, error) {
+	var target, changes interface{}
+	if err := json.Unmarshal(document, &target); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, &changes); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	return json.Marshal(merge(target, changes))
+}
+
+func merge(target interface{}, changes interface{}) interface{} {
+	members, ok := changes.(map[string]interface{})
+	if !ok {
+		return changes
+	}
+	object, ok := target.(map[string]interface{})
+	if !ok {
+		object = map[string]interface{}{}
+	}
+	for key, value := range members {
+		if value == nil {
+			delete(object, key)
+		} else {
+			object[key] = merge(object[key], value)
+		}
+	}
+	return object
+}
+
+// Operation is an operation of a json patch, Value is nil when the operation has none
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+/**
+ * Apply
+ * applies the operations of the json patch to the document in their order (RFC 6902), the document is left as it
+ * was when one of them fails, like a test whose value differs
+ */
+func Apply(document []byte, patch []byte) ([]byte, error) {
+	var target interface{}
+	if err := json.Unmarshal(document, &target); err != nil {
+		return nil, err
+	}
+	var operations []Operation
+	if err := json.Unmarshal(patch, &operations); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	for i, operation := range operations {
+		var err error
+		if target, err = apply(target, operation); err != nil {
+			return nil, fmt.Errorf("%w, operation %d (%v %v)", err, i, operation.Op, operation.Path)
+		}
+	}
+	return json.Marshal(target)
+}
+
+func apply(target interface{}, operation Operation) (interface{}, error) {
+	path, err := parsePointer(operation.Path)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	switch operation.Op {
+	case "add", "replace", "test":
+		if operation.Value == nil {
+			return nil, fmt.Errorf("%w: the value is missing", ErrInvalid)
+		}
+		if err = json.Unmarshal(operation.Value, &value); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+		}
+	case "move", "copy":
+		from, err := parsePointer(operation.From)
+		if err != nil {
+			return nil, err
+		}
+		if value, err = get(target, from); err != nil {
+			return nil, err
+		}
+		if operation.Op == "move" {
+			if len(path) > len(from) && reflect.DeepEqual(path[:len(from)], from) {
+				return nil, fmt.Errorf("%w: a value can not be moved into itself", ErrInvalid)
+			}
+			if target, err = remove(target, from); err != nil {
+				return nil, err
+			}
+		} else {
+			value = clone(value)
+		}
+	}
+
+	switch operation.Op {
+	case "add", "move", "copy":
+		return add(target, path, value)
+	case "remove":
+		return remove(target, path)
+	case "replace":
+		if _, err = get(target, path); err != nil {
+			return nil, err
+		}
+		if len(path) == 0 {
+			return value, nil
+		}
+		if target, err = remove(target, path); err != nil {
+			return nil, err
+		}
+		return add(target, path, value)
+	case "test":
+		current, err := get(target, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(current, value) {
+			return nil, fmt.Errorf("%w: the value of the test differs", ErrConflict)
+		}
+		return target, nil
+	}
+	return nil, fmt.Errorf("%w: unknown operation %q", ErrInvalid, operation.Op)
+}
+
+// pointer is a parsed json pointer (RFC 6901), the root is empty
+type pointer []string
+
+func parsePointer(value string) (pointer, error) {
+	if value == "" {
+		return pointer{}, nil
+	}
+	if !strings.HasPrefix(value, "/") {
+		return nil, fmt.Errorf("%w: %q is not a json pointer", ErrInvalid, value)
+	}
+	tokens := strings.Split(value[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+	return tokens, nil
+}
+
+func get(target interface{}, path pointer) (interface{}, error) {
+	for _, token := range path {
+		switch node := target.(type) {
+		case map[string]interface{}:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("%w: %q is missing", ErrConflict, token)
+			}
+			target = value
+		case []interface{}:
+			index, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			target = node[index]
+		default:
+			return nil, fmt.Errorf("%w: %q is missing", ErrConflict, token)
+		}
+	}
+	return target, nil
+}
+
+func add(target interface{}, path pointer, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return change(target, path, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			node[token] = value
+			return node, nil
+		case []interface{}:
+			index := len(node)
+			if token != "-" {
+				var err error
+				if index, err = arrayIndex(token, len(node)); err != nil {
+					return nil, err
+				}
+			}
+			node = append(node, nil)
+			copy(node[index+1:], node[index:])
+			node[index] = value
+			return node, nil
+		}
+		return nil, fmt.Errorf("%w: %q can not be added to a value", ErrConflict, token)
+	})
+}
+
+func remove(target interface{}, path pointer) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("%w: the document can not be removed", ErrInvalid)
+	}
+	return change(target, path, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := node[token]; !ok {
+				return nil, fmt.Errorf("%w: %q is missing", ErrConflict, token)
+			}
+			delete(node, token)
+			return node, nil
+		case []interface{}:
+			index, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			return append(node[:index], node[index+1:]...), nil
+		}
+		return nil, fmt.Errorf("%w: %q is missing", ErrConflict, token)
+	})
+}
+
+// change replaces the parent of the last token of the path with the one returned by fn, up to the root
+func change(target interface{}, path pointer, fn func(parent interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return fn(target, path[0])
+	}
+	child, err := get(target, path[:1])
+	if err != nil {
+		return nil, err
+	}
+	if child, err = change(child, path[1:], fn); err != nil {
+		return nil, err
+	}
+	switch node := target.(type) {
+	case map[string]interface{}:
+		node[path[0]] = child
+	case []interface{}:
+		index, _ := arrayIndex(path[0], len(node)-1)
+		node[index] = child
+	}
+	return target, nil
+}
+
+// arrayIndex is the index of the token, up to max
+func arrayIndex(token string, max int) (int, error) {
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("%w: %q is not an array index", ErrInvalid, token)
+	}
+	if index > max {
+		return 0, fmt.Errorf("%w: the index %d is out of the array", ErrConflict, index)
+	}
+	return index, nil
+}
+
+func clone(value interface{}) interface{} {
+	encoded, _ := json.Marshal(value)
+	var copied interface{}
+	_ = json.Unmarshal(encoded, &copied)
+	return copied
+}
@@ -0,0 +1,181 @@
+package patch
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// equalJSON tells whether two json documents hold the same values, whatever the order of their keys
+func equalJSON(t *testing.T, a []byte, b string) bool {
+	t.Helper()
+	var x, y interface{}
+	if err := json.Unmarshal(a, &x); err != nil {
+		t.Fatalf("%s: %v", a, err)
+	}
+	if err := json.Unmarshal([]byte(b), &y); err != nil {
+		t.Fatalf("%s: %v", b, err)
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		patch    string
+		expected string
+		err      error
+	}{
+		{"add member", `{"a": 1}`, `[{"op": "add", "path": "/b", "value": 2}]`, `{"a": 1, "b": 2}`, nil},
+		{"add replaces member", `{"a": 1}`, `[{"op": "add", "path": "/a", "value": [1]}]`, `{"a": [1]}`, nil},
+		{"add inserts in array", `{"a": [1, 3]}`, `[{"op": "add", "path": "/a/1", "value": 2}]`, `{"a": [1, 2, 3]}`, nil},
+		{"add at array length", `{"a": [1]}`, `[{"op": "add", "path": "/a/1", "value": 2}]`, `{"a": [1, 2]}`, nil},
+		{"add past array length", `{"a": [1]}`, `[{"op": "add", "path": "/a/2", "value": 2}]`, "", ErrConflict},
+		{"add appends with -", `{"a": [1, 2]}`, `[{"op": "add", "path": "/a/-", "value": 3}]`, `{"a": [1, 2, 3]}`, nil},
+		{"add appends with - to empty array", `{"a": []}`, `[{"op": "add", "path": "/a/-", "value": {"b": 1}}]`, `{"a": [{"b": 1}]}`, nil},
+		{"- is not an element", `{"a": [1]}`, `[{"op": "replace", "path": "/a/-", "value": 2}]`, "", ErrInvalid},
+		{"- can not be removed", `{"a": [1]}`, `[{"op": "remove", "path": "/a/-"}]`, "", ErrInvalid},
+		{"leading zero index", `{"a": [1, 2]}`, `[{"op": "remove", "path": "/a/01"}]`, "", ErrInvalid},
+		{"negative index", `{"a": [1, 2]}`, `[{"op": "remove", "path": "/a/-1"}]`, "", ErrInvalid},
+		{"add to missing parent", `{}`, `[{"op": "add", "path": "/a/b", "value": 1}]`, "", ErrConflict},
+		{"add without value", `{}`, `[{"op": "add", "path": "/a"}]`, "", ErrInvalid},
+		{"add null value", `{}`, `[{"op": "add", "path": "/a", "value": null}]`, `{"a": null}`, nil},
+		{"replace root", `{"a": 1}`, `[{"op": "replace", "path": "", "value": [1]}]`, `[1]`, nil},
+		{"replace missing", `{"a": 1}`, `[{"op": "replace", "path": "/b", "value": 2}]`, "", ErrConflict},
+		{"remove member", `{"a": 1, "b": 2}`, `[{"op": "remove", "path": "/a"}]`, `{"b": 2}`, nil},
+		{"remove array element", `{"a": [1, 2, 3]}`, `[{"op": "remove", "path": "/a/1"}]`, `{"a": [1, 3]}`, nil},
+		{"remove missing", `{"a": 1}`, `[{"op": "remove", "path": "/b"}]`, "", ErrConflict},
+		{"remove root", `{"a": 1}`, `[{"op": "remove", "path": ""}]`, "", ErrInvalid},
+		{"escaped ~1 is a slash", `{"a/b": 1}`, `[{"op": "replace", "path": "/a~1b", "value": 2}]`, `{"a/b": 2}`, nil},
+		{"escaped ~0 is a tilde", `{"a~b": 1}`, `[{"op": "remove", "path": "/a~0b"}]`, `{}`, nil},
+		{"~01 is ~1 not a slash", `{"a~1b": 1, "a/b": 2}`, `[{"op": "remove", "path": "/a~01b"}]`, `{"a/b": 2}`, nil},
+		{"~10 is /0", `{"a/0": 1}`, `[{"op": "test", "path": "/a~10", "value": 1}]`, `{"a/0": 1}`, nil},
+		{"not a pointer", `{"a": 1}`, `[{"op": "remove", "path": "a"}]`, "", ErrInvalid},
+		{"test equal", `{"a": {"b": [1, "x", null]}}`, `[{"op": "test", "path": "/a", "value": {"b": [1, "x", null]}}]`, `{"a": {"b": [1, "x", null]}}`, nil},
+		{"test number", `{"a": 1}`, `[{"op": "test", "path": "/a", "value": 1.0}]`, `{"a": 1}`, nil},
+		{"test differs", `{"a": 1}`, `[{"op": "test", "path": "/a", "value": "1"}]`, "", ErrConflict},
+		{"test array order", `{"a": [1, 2]}`, `[{"op": "test", "path": "/a", "value": [2, 1]}]`, "", ErrConflict},
+		{"test missing", `{"a": 1}`, `[{"op": "test", "path": "/b", "value": null}]`, "", ErrConflict},
+		{"test without value", `{"a": 1}`, `[{"op": "test", "path": "/a"}]`, "", ErrInvalid},
+		{"move member", `{"a": {"b": 1}, "c": {}}`, `[{"op": "move", "from": "/a/b", "path": "/c/d"}]`, `{"a": {}, "c": {"d": 1}}`, nil},
+		{"move array element", `{"a": [1, 2, 3]}`, `[{"op": "move", "from": "/a/0", "path": "/a/-"}]`, `{"a": [2, 3, 1]}`, nil},
+		{"move to itself", `{"a": {"b": 1}}`, `[{"op": "move", "from": "/a", "path": "/a"}]`, `{"a": {"b": 1}}`, nil},
+		{"move into own child", `{"a": {"b": 1}}`, `[{"op": "move", "from": "/a", "path": "/a/b/c"}]`, "", ErrInvalid},
+		{"move into own array child", `{"a": [1]}`, `[{"op": "move", "from": "/a", "path": "/a/-"}]`, "", ErrInvalid},
+		{"move to sibling with the same prefix", `{"a": 1}`, `[{"op": "move", "from": "/a", "path": "/ab"}]`, `{"ab": 1}`, nil},
+		{"move missing", `{"a": 1}`, `[{"op": "move", "from": "/b", "path": "/c"}]`, "", ErrConflict},
+		{"copy is a deep copy", `{"a": {"b": 1}}`, `[{"op": "copy", "from": "/a", "path": "/c"}, {"op": "replace", "path": "/c/b", "value": 2}]`, `{"a": {"b": 1}, "c": {"b": 2}}`, nil},
+		{"unknown operation", `{"a": 1}`, `[{"op": "increment", "path": "/a"}]`, "", ErrInvalid},
+		{"not a list of operations", `{"a": 1}`, `{"op": "remove", "path": "/a"}`, "", ErrInvalid},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			patched, err := Apply([]byte(test.document), []byte(test.patch))
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("error %v, expected %v", err, test.err)
+				}
+				if patched != nil {
+					t.Fatalf("a failed patch returned %s", patched)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalJSON(t, patched, test.expected) {
+				t.Fatalf("%s, expected %s", patched, test.expected)
+			}
+		})
+	}
+}
+
+func TestApplyIsAtomic(t *testing.T) {
+	document := []byte(`{"name": "Bruce", "roles": ["user"], "profile": {"city": "Gotham"}}`)
+	original := string(document)
+	patch := []byte(`[
+		{"op": "replace", "path": "/name", "value": "Batman"},
+		{"op": "add", "path": "/roles/-", "value": "admin"},
+		{"op": "remove", "path": "/profile/city"},
+		{"op": "test", "path": "/name", "value": "Bruce"}
+	]`)
+
+	patched, err := Apply(document, patch)
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("error %v, expected a conflict", err)
+	}
+	if patched != nil {
+		t.Fatalf("the operations before the failed one were returned: %s", patched)
+	}
+	if string(document) != original {
+		t.Fatalf("the document was changed: %s", document)
+	}
+
+	// the same operations with a test holding are applied together
+	patched, err = Apply(document, []byte(`[
+		{"op": "replace", "path": "/name", "value": "Batman"},
+		{"op": "add", "path": "/roles/-", "value": "admin"},
+		{"op": "remove", "path": "/profile/city"},
+		{"op": "test", "path": "/name", "value": "Batman"}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalJSON(t, patched, `{"name": "Batman", "roles": ["user", "admin"], "profile": {}}`) {
+		t.Fatalf("%s", patched)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	// the examples of RFC 7386
+	tests := []struct {
+		document string
+		patch    string
+		expected string
+	}{
+		{`{"a": "b"}`, `{"a": "c"}`, `{"a": "c"}`},
+		{`{"a": "b"}`, `{"b": "c"}`, `{"a": "b", "b": "c"}`},
+		{`{"a": "b"}`, `{"a": null}`, `{}`},
+		{`{"a": "b", "b": "c"}`, `{"a": null}`, `{"b": "c"}`},
+		{`{"a": ["b"]}`, `{"a": "c"}`, `{"a": "c"}`},
+		{`{"a": "c"}`, `{"a": ["b"]}`, `{"a": ["b"]}`},
+		{`{"a": {"b": "c"}}`, `{"a": {"b": "d", "c": null}}`, `{"a": {"b": "d"}}`},
+		{`{"a": [{"b": "c"}]}`, `{"a": [1]}`, `{"a": [1]}`},
+		{`["a", "b"]`, `["c", "d"]`, `["c", "d"]`},
+		{`{"a": "b"}`, `["c"]`, `["c"]`},
+		{`{"a": "foo"}`, `null`, `null`},
+		{`{"a": "foo"}`, `"bar"`, `"bar"`},
+		{`{"e": null}`, `{"a": 1}`, `{"e": null, "a": 1}`},
+		{`[1, 2]`, `{"a": "b", "c": null}`, `{"a": "b"}`},
+		{`{}`, `{"a": {"bb": {"ccc": null}}}`, `{"a": {"bb": {}}}`},
+	}
+	for _, test := range tests {
+		merged, err := Merge([]byte(test.document), []byte(test.patch))
+		if err != nil {
+			t.Fatalf("%v merged with %v: %v", test.document, test.patch, err)
+		}
+		if !equalJSON(t, merged, test.expected) {
+			t.Errorf("%v merged with %v: %s, expected %v", test.document, test.patch, merged, test.expected)
+		}
+	}
+	if _, err := Merge([]byte(`{}`), []byte(`{`)); !errors.Is(err, ErrInvalid) {
+		t.Errorf("invalid merge patch: %v", err)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	document := []byte(`{"a": 1}`)
+	for _, mediaType := range []string{MergePatchType, "application/json"} {
+		if patched, err := Patch(mediaType, document, []byte(`{"a": 2}`)); err != nil || !equalJSON(t, patched, `{"a": 2}`) {
+			t.Errorf("%v: %s %v", mediaType, patched, err)
+		}
+	}
+	if patched, err := Patch(JSONPatchType, document, []byte(`[{"op": "remove", "path": "/a"}]`)); err != nil || !equalJSON(t, patched, `{}`) {
+		t.Errorf("%v: %s %v", JSONPatchType, patched, err)
+	}
+	if _, err := Patch("text/plain", document, []byte(`{}`)); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("text/plain: %v", err)
+	}
+}
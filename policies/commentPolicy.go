@@ -0,0 +1,20 @@
+package policies
+
+import (
+	"gotham/models"
+)
+
+type CommentPolicy struct{}
+
+// Can a comment is viewed by everyone, edited by its author while it is not removed and deleted by its author or an admin
+func (CommentPolicy) Can(auth models.User, action Action, comment models.Comment) bool {
+	switch action {
+	case View:
+		return true
+	case Update:
+		return auth.ID == comment.UserID && comment.Status != models.CommentRemoved
+	case Delete:
+		return Owns(auth, comment.UserID)
+	}
+	return false
+}
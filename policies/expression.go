@@ -0,0 +1,441 @@
+package policies
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gotham/helpers"
+)
+
+var ErrExpression = errors.New("policies: invalid expression")
+
+// ExpressionNames are the names a rule can read, the members of the env built by Env
+var ExpressionNames = []string{"user", "resource", "action"}
+
+// maxExpressionDepth bounds the nesting of the parentheses, the lists and the negations of a rule
+const maxExpressionDepth = 32
+
+/**
+ * Expression
+ * an access rule over the user, the resource and the action, like
+ * user.department == resource.department && action == 'view'
+ * with the literals null, true, false, numbers, quoted strings and [lists], the members of the objects by their json
+ * names, ==, !=, <, <=, >, >=, in, !, && and || and parentheses. A missing member is null, a name other than the
+ * ExpressionNames is refused
+ */
+type Expression struct {
+	Source string
+	eval   evaluator
+}
+
+type evaluator func(env map[string]interface{}) (interface{}, error)
+
+func ParseExpression(source string) (*Expression, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	eval, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenEnd {
+		return nil, p.unexpected()
+	}
+	return &Expression{Source: source, eval: eval}, nil
+}
+
+// Allows evaluates the expression in the env, it must be a boolean
+func (e *Expression) Allows(env map[string]interface{}) (bool, error) {
+	value, err := e.eval(env)
+	if err != nil {
+		return false, err
+	}
+	allowed, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: %q is not a boolean", ErrExpression, e.Source)
+	}
+	return allowed, nil
+}
+
+// Env is the env of a rule, the user and the resource are seen as their json
+func Env(auth interface{}, action Action, resource interface{}) (map[string]interface{}, error) {
+	env := map[string]interface{}{"action": string(action)}
+	for name, value := range map[string]interface{}{"user": auth, "resource": resource} {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		var decoded interface{}
+		if err = json.Unmarshal(encoded, &decoded); err != nil {
+			return nil, err
+		}
+		env[name] = decoded
+	}
+	return env, nil
+}
+
+/**
+ * Lexer
+ *
+ */
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+)
+
+type token struct {
+	kind     tokenKind
+	text     string
+	position int
+}
+
+// operators are matched longest first
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", "[", "]", ",", "."}
+
+func lex(source string) (tokens []token, err error) {
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"':
+			end := i + 1
+			for end < len(source) && source[end] != c {
+				if source[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(source) {
+				return nil, fmt.Errorf("%w: the string at %d is not closed", ErrExpression, i)
+			}
+			text := strings.NewReplacer(`\\`, `\`, `\'`, `'`, `\"`, `"`).Replace(source[i+1 : end])
+			tokens = append(tokens, token{kind: tokenString, text: text, position: i})
+			i = end + 1
+		case c >= '0' && c <= '9' || c == '-' && i+1 < len(source) && source[i+1] >= '0' && source[i+1] <= '9':
+			end := i + 1
+			for end < len(source) && (source[end] >= '0' && source[end] <= '9' || source[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: source[i:end], position: i})
+			i = end
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			end := i + 1
+			for end < len(source) && (source[end] == '_' || source[end] >= 'a' && source[end] <= 'z' || source[end] >= 'A' && source[end] <= 'Z' || source[end] >= '0' && source[end] <= '9') {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: source[i:end], position: i})
+			i = end
+		default:
+			matched := false
+			for _, operator := range operators {
+				if strings.HasPrefix(source[i:], operator) {
+					tokens = append(tokens, token{kind: tokenOperator, text: operator, position: i})
+					i += len(operator)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("%w: unexpected %q at %d", ErrExpression, c, i)
+			}
+		}
+	}
+	return append(tokens, token{kind: tokenEnd, position: len(source)}), nil
+}
+
+/**
+ * Parser
+ * or > and > comparison > unary > member > primary
+ */
+
+type parser struct {
+	tokens []token
+	next   int
+	depth  int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) accept(operator string) bool {
+	if t := p.peek(); (t.kind == tokenOperator || t.kind == tokenIdent) && t.text == operator {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *parser) unexpected() error {
+	t := p.peek()
+	if t.kind == tokenEnd {
+		return fmt.Errorf("%w: unexpected end", ErrExpression)
+	}
+	return fmt.Errorf("%w: unexpected %q at %d", ErrExpression, t.text, t.position)
+}
+
+func (p *parser) or() (evaluator, error) {
+	left, err := p.and()
+	for err == nil && p.accept("||") {
+		var right evaluator
+		if right, err = p.and(); err == nil {
+			left = logical(left, right, true)
+		}
+	}
+	return left, err
+}
+
+func (p *parser) and() (evaluator, error) {
+	left, err := p.comparison()
+	for err == nil && p.accept("&&") {
+		var right evaluator
+		if right, err = p.comparison(); err == nil {
+			left = logical(left, right, false)
+		}
+	}
+	return left, err
+}
+
+// logical short circuits on the value deciding the result, true for || and false for &&
+func logical(left evaluator, right evaluator, decides bool) evaluator {
+	return func(env map[string]interface{}) (interface{}, error) {
+		for _, operand := range []evaluator{left, right} {
+			value, err := operand(env)
+			if err != nil {
+				return nil, err
+			}
+			b, ok := value.(bool)
+			if !ok {
+				return nil, fmt.Errorf("%w: %v is not a boolean", ErrExpression, value)
+			}
+			if b == decides {
+				return decides, nil
+			}
+		}
+		return !decides, nil
+	}
+}
+
+func (p *parser) comparison() (evaluator, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for _, operator := range []string{"==", "!=", "<=", ">=", "<", ">", "in"} {
+		if !p.accept(operator) {
+			continue
+		}
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(env map[string]interface{}) (interface{}, error) {
+			a, err := left(env)
+			if err != nil {
+				return nil, err
+			}
+			b, err := right(env)
+			if err != nil {
+				return nil, err
+			}
+			return compare(operator, a, b)
+		}, nil
+	}
+	return left, nil
+}
+
+func (p *parser) unary() (evaluator, error) {
+	if p.depth++; p.depth > maxExpressionDepth {
+		return nil, fmt.Errorf("%w: nested deeper than %d", ErrExpression, maxExpressionDepth)
+	}
+	defer func() { p.depth-- }()
+
+	if !p.accept("!") {
+		return p.member()
+	}
+	operand, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return func(env map[string]interface{}) (interface{}, error) {
+		value, err := operand(env)
+		if err != nil {
+			return nil, err
+		}
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%w: %v is not a boolean", ErrExpression, value)
+		}
+		return !b, nil
+	}, nil
+}
+
+func (p *parser) member() (evaluator, error) {
+	object, err := p.primary()
+	for err == nil && p.accept(".") {
+		name := p.peek()
+		if name.kind != tokenIdent {
+			return nil, p.unexpected()
+		}
+		p.next++
+		object = field(object, name.text)
+	}
+	return object, err
+}
+
+func field(object evaluator, name string) evaluator {
+	return func(env map[string]interface{}) (interface{}, error) {
+		value, err := object(env)
+		if err != nil {
+			return nil, err
+		}
+		members, _ := value.(map[string]interface{})
+		return members[name], nil
+	}
+}
+
+func (p *parser) primary() (evaluator, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokenString:
+		p.next++
+		return constant(t.text), nil
+	case tokenNumber:
+		p.next++
+		number, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q is not a number", ErrExpression, t.text)
+		}
+		return constant(number), nil
+	case tokenIdent:
+		p.next++
+		switch t.text {
+		case "null":
+			return constant(nil), nil
+		case "true", "false":
+			return constant(t.text == "true"), nil
+		}
+		if !helpers.InArray(t.text, ExpressionNames) {
+			return nil, fmt.Errorf("%w: unknown name %q at %d", ErrExpression, t.text, t.position)
+		}
+		return func(env map[string]interface{}) (interface{}, error) {
+			return env[t.text], nil
+		}, nil
+	}
+
+	if p.accept("(") {
+		inner, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, p.unexpected()
+		}
+		return inner, nil
+	}
+	if p.accept("[") {
+		var items []evaluator
+		for !p.accept("]") {
+			if len(items) > 0 && !p.accept(",") {
+				return nil, p.unexpected()
+			}
+			item, err := p.or()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return func(env map[string]interface{}) (interface{}, error) {
+			list := make([]interface{}, len(items))
+			for i, item := range items {
+				var err error
+				if list[i], err = item(env); err != nil {
+					return nil, err
+				}
+			}
+			return list, nil
+		}, nil
+	}
+	return nil, p.unexpected()
+}
+
+func constant(value interface{}) evaluator {
+	return func(map[string]interface{}) (interface{}, error) {
+		return value, nil
+	}
+}
+
+// compare applies the comparison operator, values of different types are not equal and are not ordered
+func compare(operator string, a interface{}, b interface{}) (interface{}, error) {
+	switch operator {
+	case "==":
+		return reflect.DeepEqual(a, b), nil
+	case "!=":
+		return !reflect.DeepEqual(a, b), nil
+	case "in":
+		switch container := b.(type) {
+		case []interface{}:
+			for _, item := range container {
+				if reflect.DeepEqual(a, item) {
+					return true, nil
+				}
+			}
+			return false, nil
+		case map[string]interface{}:
+			key, ok := a.(string)
+			_, found := container[key]
+			return ok && found, nil
+		case string:
+			s, ok := a.(string)
+			return ok && strings.Contains(container, s), nil
+		case nil:
+			return false, nil
+		}
+		return nil, fmt.Errorf("%w: %v is not a list, an object or a string", ErrExpression, b)
+	}
+
+	var order int
+	switch x := a.(type) {
+	case float64:
+		y, ok := b.(float64)
+		if !ok {
+			return false, nil
+		}
+		switch {
+		case x < y:
+			order = -1
+		case x > y:
+			order = 1
+		}
+	case string:
+		y, ok := b.(string)
+		if !ok {
+			return false, nil
+		}
+		order = strings.Compare(x, y)
+	default:
+		return false, nil
+	}
+	switch operator {
+	case "<":
+		return order < 0, nil
+	case "<=":
+		return order <= 0, nil
+	case ">":
+		return order > 0, nil
+	}
+	return order >= 0, nil
+}
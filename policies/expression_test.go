@@ -0,0 +1,154 @@
+package policies
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func expressionEnv() map[string]interface{} {
+	return map[string]interface{}{
+		"action": "view",
+		"user":   map[string]interface{}{"id": 1.0, "department": "legal", "admin": false, "tags": []interface{}{"a", "b"}},
+		"resource": map[string]interface{}{
+			"id":         2.0,
+			"department": "legal",
+			"manager":    map[string]interface{}{"id": 1.0},
+		},
+	}
+}
+
+func TestExpressionAllows(t *testing.T) {
+	tests := []struct {
+		source  string
+		allowed bool
+	}{
+		{"user.department == resource.department && action == 'view'", true},
+		{"user.department == resource.department && action == 'delete'", false},
+		{"resource.manager.id == user.id", true},
+		{"resource.missing.id == null", true},
+		{"action in ['view', 'update']", true},
+		{"'b' in user.tags", true},
+		{"'department' in resource", true},
+		{"'eg' in user.department", true},
+		{"action in null", false},
+		{"user.id < resource.id && resource.id <= 2 && 2 >= 2 && 'b' > 'a'", true},
+		{"user.id < 'a'", false},
+		{"user.id != 1.0", false},
+		{`"it's" == 'it\'s'`, true},
+		{"-1 < 0", true},
+
+		// && binds tighter than ||
+		{"true || false && false", true},
+		{"false && false || true", true},
+		{"(true || false) && false", false},
+		{"action == 'update' || action == 'view' && user.department == 'legal'", true},
+		{"action == 'view' || action == 'update' && user.admin", true},
+		// ! binds tighter than && and the comparisons
+		{"!false && false", false},
+		{"!(false && false)", true},
+		{"!user.admin == true", true},
+		{"!!true", true},
+		// the comparisons bind tighter than && and ||
+		{"1 == 1 && 2 == 2", true},
+		{"1 == 2 || 2 == 2", true},
+		// || and && short circuit, the right operand is not evaluated
+		{"true || user", true},
+		{"false && user", false},
+	}
+	for _, test := range tests {
+		expression, err := ParseExpression(test.source)
+		if err != nil {
+			t.Errorf("%v: %v", test.source, err)
+			continue
+		}
+		allowed, err := expression.Allows(expressionEnv())
+		if err != nil {
+			t.Errorf("%v: %v", test.source, err)
+			continue
+		}
+		if allowed != test.allowed {
+			t.Errorf("%v: %v, expected %v", test.source, allowed, test.allowed)
+		}
+	}
+}
+
+func TestParseExpressionMalformed(t *testing.T) {
+	sources := []string{
+		"",
+		"   ",
+		"(",
+		")",
+		"(true",
+		"true)",
+		"user.",
+		"user..id",
+		"user.1",
+		"user ==",
+		"== user",
+		"&& true",
+		"true &&",
+		"true true",
+		"1 < 2 < 3",
+		"1 == 1 == true",
+		"'open",
+		`'escaped\'`,
+		`'\`,
+		"[1, 2",
+		"[1 2]",
+		"[,]",
+		"[1,]",
+		"1.2.3 == 1",
+		"-",
+		"user # 1",
+		"user = 1",
+		"user & resource",
+		"ü == 1",
+		strings.Repeat("(", 10000) + "true" + strings.Repeat(")", 10000),
+		strings.Repeat("[", 10000) + strings.Repeat("]", 10000),
+		strings.Repeat("!", 10000) + "true",
+		strings.Repeat("(", 10000),
+	}
+	for _, source := range sources {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("%.40q panicked: %v", source, r)
+				}
+			}()
+			if expression, err := ParseExpression(source); !errors.Is(err, ErrExpression) {
+				t.Errorf("%.40q: %v %v, expected an invalid expression", source, expression, err)
+			}
+		}()
+	}
+}
+
+func TestParseExpressionNames(t *testing.T) {
+	for _, source := range []string{"password == 'x'", "env.user.id == 1", "users.admin", "user.id == id", "[user, secret]", "!admin"} {
+		if _, err := ParseExpression(source); !errors.Is(err, ErrExpression) || !strings.Contains(err.Error(), "unknown name") {
+			t.Errorf("%v: %v, expected an unknown name", source, err)
+		}
+	}
+	for _, name := range ExpressionNames {
+		if _, err := ParseExpression(name + " == null"); err != nil {
+			t.Errorf("%v: %v", name, err)
+		}
+	}
+	// the names are only looked up at the root, the members are the json of the user and the resource
+	if _, err := ParseExpression("user.password == null && resource.user.admin"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestExpressionEvaluationErrors(t *testing.T) {
+	for _, source := range []string{"1", "user", "'true'", "null", "user && true", "!action", "action in 1", "true && 1"} {
+		expression, err := ParseExpression(source)
+		if err != nil {
+			t.Errorf("%v: %v", source, err)
+			continue
+		}
+		if allowed, err := expression.Allows(expressionEnv()); allowed || !errors.Is(err, ErrExpression) {
+			t.Errorf("%v: %v %v, expected an invalid expression", source, allowed, err)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package policies
+
+import (
+	"gotham/models"
+)
+
+type MediaPolicy struct{}
+
+// Can a public media is viewed by everyone, a private one and the changes are for its owner or an admin
+func (MediaPolicy) Can(auth models.User, action Action, media models.Media) bool {
+	switch action {
+	case View:
+		return media.IsPublic() || Owns(auth, media.UserID)
+	case Update, Delete:
+		return Owns(auth, media.UserID)
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package policies
+
+import (
+	"gotham/models"
+)
+
+// Action is what a user does to a resource, checked by the Can of the policy of its model
+type Action string
+
+const (
+	View   Action = "view"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// Owns is whether the auth user can change a resource of the owner, its owner or an admin
+func Owns(auth models.User, ownerID uint) bool {
+	return auth.ID == ownerID || auth.IsAdmin()
+}
@@ -0,0 +1,47 @@
+package policies
+
+import (
+	"gotham/helpers"
+	"gotham/models"
+)
+
+// Scopes of the tokens, a token is limited to the routes of its scopes on top of the policies of its user
+const (
+	ScopeUsersRead          = "users:read"
+	ScopeUsersWrite         = "users:write"
+	ScopeCommentsRead       = "comments:read"
+	ScopeCommentsWrite      = "comments:write"
+	ScopeMediaRead          = "media:read"
+	ScopeMediaWrite         = "media:write"
+	ScopeAccountRead        = "account:read"
+	ScopeAccountWrite       = "account:write"
+	ScopeSocialRead         = "social:read"
+	ScopeSocialWrite        = "social:write"
+	ScopeConversationsRead  = "conversations:read"
+	ScopeConversationsWrite = "conversations:write"
+	ScopeBillingRead        = "billing:read"
+	ScopeBillingWrite       = "billing:write"
+	ScopeAdmin              = "admin"
+)
+
+// Scopes are every scope, the ones of the tokens of a sign in
+var Scopes = []string{
+	ScopeUsersRead, ScopeUsersWrite, ScopeCommentsRead, ScopeCommentsWrite, ScopeMediaRead, ScopeMediaWrite,
+	ScopeAccountRead, ScopeAccountWrite, ScopeSocialRead, ScopeSocialWrite, ScopeConversationsRead,
+	ScopeConversationsWrite, ScopeBillingRead, ScopeBillingWrite, ScopeAdmin,
+}
+
+// Scoped is whether the granted scopes include the scope, a token issued before the scopes has none and every scope
+func Scoped(granted []string, scope string) bool {
+	return granted == nil || helpers.InArray(scope, granted)
+}
+
+// Grantable is whether the user can grant the scopes to a token of its own, the admin scope is for the admins
+func Grantable(user models.User, scopes []string) bool {
+	for _, scope := range scopes {
+		if !helpers.InArray(scope, Scopes) || (scope == ScopeAdmin && !user.IsAdmin()) {
+			return false
+		}
+	}
+	return true
+}
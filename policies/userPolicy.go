@@ -1,6 +1,7 @@
 package policies
 
 import (
+	"gotham/helpers"
 	"gotham/models"
 )
 
@@ -9,6 +10,22 @@ type IUserPolicy interface {
 	Show(auth models.User, user models.User) bool
 	Update(auth models.User, user models.User) bool
 	Delete(auth models.User, user models.User) bool
+	// Can is whether the auth user can do the action to the user
+	Can(auth models.User, action Action, user models.User) bool
+	// HiddenFields are the json fields of the user the auth user can not view
+	HiddenFields(auth models.User, user models.User) []string
+	// Patch is whether the auth user can patch the user, PatchFields are the fields it can not change
+	Patch(auth models.User, user models.User) bool
+	PatchFields(auth models.User, user models.User, fields []string) (forbidden []string)
+}
+
+// userSelfPatchFields are the fields a user can patch on itself, the others are patched by the admins
+var userSelfPatchFields = []string{"name", "image", "timezone"}
+
+// userPrivateFields are the fields of a user viewed by the user itself and the admins only
+var userPrivateFields = []string{
+	"email", "phone", "phone_verified_at", "daily_quota", "monthly_quota",
+	"suspended_at", "suspended_until", "suspension_reason", "deletion_scheduled_at",
 }
 
 type UserPolicy struct{}
@@ -17,14 +34,49 @@ func (UserPolicy) Index(auth models.User) bool {
 	return auth.Admin
 }
 
-func (UserPolicy) Show(auth models.User, user models.User) bool {
-	return auth.ID == 1 && user.Verified
+func (p UserPolicy) Show(auth models.User, user models.User) bool {
+	return p.Can(auth, View, user)
+}
+
+func (p UserPolicy) Update(auth models.User, user models.User) bool {
+	return p.Can(auth, Update, user)
+}
+
+func (p UserPolicy) Delete(auth models.User, user models.User) bool {
+	return p.Can(auth, Delete, user)
+}
+
+func (UserPolicy) Can(auth models.User, action Action, user models.User) bool {
+	switch action {
+	case View:
+		return Owns(auth, user.ID) || user.Verified
+	case Update:
+		return Owns(auth, user.ID)
+	case Delete:
+		return auth.ID == user.ID && user.Verified
+	}
+	return false
+}
+
+func (UserPolicy) HiddenFields(auth models.User, user models.User) []string {
+	if Owns(auth, user.ID) {
+		return nil
+	}
+	return userPrivateFields
 }
 
-func (UserPolicy) Update(auth models.User, user models.User) bool {
-	return auth.ID == user.ID && user.Verified
+func (p UserPolicy) Patch(auth models.User, user models.User) bool {
+	return p.Can(auth, Update, user)
 }
 
-func (UserPolicy) Delete(auth models.User, user models.User) bool {
-	return auth.ID == user.ID && user.Verified
+func (UserPolicy) PatchFields(auth models.User, user models.User, fields []string) (forbidden []string) {
+	if auth.Admin {
+		return nil
+	}
+	for _, field := range fields {
+		if !helpers.InArray(field, userSelfPatchFields) {
+			forbidden = append(forbidden, field)
+		}
+	}
+	return forbidden
 }
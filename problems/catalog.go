@@ -0,0 +1,91 @@
+package problems
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Code is a stable, machine readable error code, clients branch on Code instead of the message
+type Code struct {
+	Code        string `json:"code"`
+	Status      int    `json:"status"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// codes look like AUTH_001_INVALID_CREDENTIALS: domain, number, short name
+var codePattern = regexp.MustCompile(`^[A-Z]+_[0-9]{3}_[A-Z0-9_]+$`)
+
+var (
+	catalog = map[string]Code{}
+	mu      sync.RWMutex
+)
+
+// Register adds a code to the catalog, it panics when the code is malformed, has no status or is already registered
+// so a domain error without a proper code can not reach production
+func Register(code Code) Code {
+	if !codePattern.MatchString(code.Code) {
+		panic(fmt.Sprintf("problems: malformed error code %q", code.Code))
+	}
+	if code.Status < 400 || code.Status > 599 {
+		panic(fmt.Sprintf("problems: error code %v must declare a 4xx or 5xx status", code.Code))
+	}
+	if code.Title == "" {
+		code.Title = http.StatusText(code.Status)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := catalog[code.Code]; ok {
+		panic(fmt.Sprintf("problems: error code %v is registered twice", code.Code))
+	}
+	catalog[code.Code] = code
+	return code
+}
+
+// Catalog lists every registered code sorted by code
+func Catalog() []Code {
+	mu.RLock()
+	defer mu.RUnlock()
+	codes := make([]Code, 0, len(catalog))
+	for _, code := range catalog {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		return codes[i].Code < codes[j].Code
+	})
+	return codes
+}
+
+// Lookup finds a registered code
+func Lookup(code string) (Code, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	c, ok := catalog[code]
+	return c, ok
+}
+
+// ForStatus returns the generic HTTP_<status>_<TEXT> code of an http status, registering it on first use
+func ForStatus(status int) Code {
+	if status < 400 || status > 599 {
+		status = http.StatusInternalServerError
+	}
+	text := strings.ToUpper(regexp.MustCompile(`[^A-Za-z0-9]+`).ReplaceAllString(http.StatusText(status), "_"))
+	name := strings.Trim(fmt.Sprintf("HTTP_%03d_%v", status, text), "_")
+	if code, ok := Lookup(name); ok {
+		return code
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if code, ok := catalog[name]; ok {
+		return code
+	}
+	code := Code{Code: name, Status: status, Title: http.StatusText(status)}
+	catalog[name] = code
+	return code
+}
@@ -0,0 +1,233 @@
+package problems
+
+import (
+	"net/http"
+)
+
+// Auth
+var (
+	InvalidCredentials  = Register(Code{Code: "AUTH_001_INVALID_CREDENTIALS", Status: http.StatusUnprocessableEntity, Description: "email or password is incorrect"})
+	Unauthenticated     = Register(Code{Code: "AUTH_002_UNAUTHENTICATED", Status: http.StatusUnauthorized, Description: "auth user could not be found"})
+	Forbidden           = Register(Code{Code: "AUTH_003_FORBIDDEN", Status: http.StatusForbidden, Description: "unauthorized transaction detected"})
+	NotAdmin            = Register(Code{Code: "AUTH_004_NOT_ADMIN", Status: http.StatusForbidden, Description: "you are not admin"})
+	NotVerified         = Register(Code{Code: "AUTH_005_NOT_VERIFIED", Status: http.StatusForbidden, Description: "your email not verified"})
+	NotImpersonable     = Register(Code{Code: "AUTH_006_NOT_IMPERSONABLE", Status: http.StatusForbidden, Description: "the user can not be impersonated"})
+	Impersonating       = Register(Code{Code: "AUTH_007_IMPERSONATING", Status: http.StatusForbidden, Description: "this action is not allowed while impersonating"})
+	MagicLinkThrottled  = Register(Code{Code: "AUTH_008_MAGIC_LINK_THROTTLED", Status: http.StatusTooManyRequests, Description: "too many sign in links were asked for this email"})
+	MagicLinkInvalid    = Register(Code{Code: "AUTH_009_MAGIC_LINK_INVALID", Status: http.StatusUnprocessableEntity, Description: "the sign in link is invalid, expired or already used"})
+	SudoRequired        = Register(Code{Code: "AUTH_010_SUDO_REQUIRED", Status: http.StatusForbidden, Description: "confirm your password to perform this operation"})
+	RefreshTokenInvalid = Register(Code{Code: "AUTH_011_REFRESH_TOKEN_INVALID", Status: http.StatusUnauthorized, Description: "the refresh token is invalid or expired"})
+	InsufficientScope   = Register(Code{Code: "AUTH_012_INSUFFICIENT_SCOPE", Status: http.StatusForbidden, Description: "the token does not have the scope of this operation"})
+	SudoLocked          = Register(Code{Code: "AUTH_013_SUDO_LOCKED", Status: http.StatusTooManyRequests, Description: "too many wrong passwords were confirmed, try again later"})
+)
+
+// Api keys
+var (
+	ApiKeyNotFound    = Register(Code{Code: "APIKEY_001_NOT_FOUND", Status: http.StatusNotFound, Description: "api key could not be found"})
+	ScopeNotGrantable = Register(Code{Code: "APIKEY_002_SCOPE_NOT_GRANTABLE", Status: http.StatusUnprocessableEntity, Description: "the scopes can not be granted to the api key"})
+)
+
+// Clients
+var (
+	ClientNotFound         = Register(Code{Code: "CLIENT_001_NOT_FOUND", Status: http.StatusNotFound, Description: "client could not be found"})
+	ClientInvalid          = Register(Code{Code: "CLIENT_002_INVALID", Status: http.StatusUnauthorized, Description: "the client is unknown, revoked or its secret is wrong"})
+	ClientScopeInvalid     = Register(Code{Code: "CLIENT_003_SCOPE_INVALID", Status: http.StatusBadRequest, Description: "the scope is not assigned to the client"})
+	GrantTypeUnsupported   = Register(Code{Code: "CLIENT_004_GRANT_TYPE_UNSUPPORTED", Status: http.StatusBadRequest, Description: "only the client_credentials grant is supported"})
+	ClientRateLimitReached = Register(Code{Code: "CLIENT_005_RATE_LIMITED", Status: http.StatusTooManyRequests, Description: "the client made too many requests"})
+	IdentityTaken          = Register(Code{Code: "CLIENT_006_CERTIFICATE_IDENTITY_TAKEN", Status: http.StatusUnprocessableEntity, Description: "the certificate identity belongs to another client"})
+)
+
+// Captcha
+var (
+	CaptchaRequired = Register(Code{Code: "CAPTCHA_001_REQUIRED", Status: http.StatusForbidden, Description: "solve the captcha to continue"})
+	CaptchaInvalid  = Register(Code{Code: "CAPTCHA_002_INVALID", Status: http.StatusUnprocessableEntity, Description: "the captcha is invalid"})
+)
+
+// Billing
+var (
+	PlanRequired         = Register(Code{Code: "BILLING_001_PLAN_REQUIRED", Status: http.StatusPaymentRequired, Description: "your plan does not include this feature"})
+	PlanNotFound         = Register(Code{Code: "BILLING_002_PLAN_NOT_FOUND", Status: http.StatusNotFound, Description: "plan could not be found"})
+	PlanNotPurchasable   = Register(Code{Code: "BILLING_003_PLAN_NOT_PURCHASABLE", Status: http.StatusUnprocessableEntity, Description: "the plan can not be subscribed to"})
+	AlreadySubscribed    = Register(Code{Code: "BILLING_004_ALREADY_SUBSCRIBED", Status: http.StatusConflict, Description: "you already have a subscription"})
+	SubscriptionNotFound = Register(Code{Code: "BILLING_005_SUBSCRIPTION_NOT_FOUND", Status: http.StatusNotFound, Description: "subscription could not be found"})
+	WebhookInvalid       = Register(Code{Code: "BILLING_006_WEBHOOK_INVALID", Status: http.StatusBadRequest, Description: "the webhook signature is invalid"})
+	InvoiceNotFound      = Register(Code{Code: "BILLING_007_INVOICE_NOT_FOUND", Status: http.StatusNotFound, Description: "invoice could not be found"})
+	InvoiceNotReady      = Register(Code{Code: "BILLING_008_INVOICE_NOT_READY", Status: http.StatusConflict, Description: "the invoice is not rendered yet"})
+	InvoiceLinkInvalid   = Register(Code{Code: "BILLING_009_INVOICE_LINK_INVALID", Status: http.StatusForbidden, Description: "the download link is invalid or expired"})
+	CouponNotFound       = Register(Code{Code: "BILLING_010_COUPON_NOT_FOUND", Status: http.StatusNotFound, Description: "coupon could not be found"})
+	CouponInvalid        = Register(Code{Code: "BILLING_011_COUPON_INVALID", Status: http.StatusUnprocessableEntity, Description: "the coupon is invalid, expired or used up"})
+	CouponNotApplicable  = Register(Code{Code: "BILLING_012_COUPON_NOT_APPLICABLE", Status: http.StatusUnprocessableEntity, Description: "the coupon does not apply to this plan"})
+	CouponCodeTaken      = Register(Code{Code: "BILLING_013_COUPON_CODE_TAKEN", Status: http.StatusUnprocessableEntity, Description: "the coupon code is already used"})
+)
+
+// Votes
+var (
+	VotableNotFound = Register(Code{Code: "VOTE_001_VOTABLE_NOT_FOUND", Status: http.StatusNotFound, Description: "the votable could not be found"})
+)
+
+// Comments
+var (
+	CommentNotFound     = Register(Code{Code: "COMMENT_001_NOT_FOUND", Status: http.StatusNotFound, Description: "comment could not be found"})
+	CommentableNotFound = Register(Code{Code: "COMMENT_002_COMMENTABLE_NOT_FOUND", Status: http.StatusNotFound, Description: "the commentable could not be found"})
+	CommentTooDeep      = Register(Code{Code: "COMMENT_003_TOO_DEEP", Status: http.StatusUnprocessableEntity, Description: "the comment can not be replied to"})
+	CommentNotEditable  = Register(Code{Code: "COMMENT_004_NOT_EDITABLE", Status: http.StatusForbidden, Description: "the comment can not be changed"})
+	CommentFlagged      = Register(Code{Code: "COMMENT_005_FLAGGED", Status: http.StatusConflict, Description: "you already flagged this comment"})
+	CommentNotFlaggable = Register(Code{Code: "COMMENT_006_NOT_FLAGGABLE", Status: http.StatusUnprocessableEntity, Description: "you can not flag your own comment"})
+)
+
+// Reports
+var (
+	ReportNotFound     = Register(Code{Code: "REPORT_001_NOT_FOUND", Status: http.StatusNotFound, Description: "report could not be found"})
+	ReportableNotFound = Register(Code{Code: "REPORT_002_REPORTABLE_NOT_FOUND", Status: http.StatusNotFound, Description: "the reportable could not be found"})
+	AlreadyReported    = Register(Code{Code: "REPORT_003_ALREADY_REPORTED", Status: http.StatusConflict, Description: "you already reported this"})
+	NotReportable      = Register(Code{Code: "REPORT_004_NOT_REPORTABLE", Status: http.StatusUnprocessableEntity, Description: "you can not report yourself"})
+	ReportClosed       = Register(Code{Code: "REPORT_005_CLOSED", Status: http.StatusConflict, Description: "the report is already closed"})
+)
+
+// Tags
+var (
+	TaggableNotFound = Register(Code{Code: "TAG_001_TAGGABLE_NOT_FOUND", Status: http.StatusNotFound, Description: "the taggable could not be found"})
+	NotTaggable      = Register(Code{Code: "TAG_002_NOT_TAGGABLE", Status: http.StatusForbidden, Description: "you can not tag this"})
+)
+
+// Media
+var (
+	MediaNotFound           = Register(Code{Code: "MEDIA_001_NOT_FOUND", Status: http.StatusNotFound, Description: "media could not be found"})
+	MediaTooLarge           = Register(Code{Code: "MEDIA_002_TOO_LARGE", Status: http.StatusRequestEntityTooLarge, Description: "the file is too large"})
+	MediaTypeNotAllowed     = Register(Code{Code: "MEDIA_003_TYPE_NOT_ALLOWED", Status: http.StatusUnsupportedMediaType, Description: "the file type is not allowed"})
+	MediaAttachableNotFound = Register(Code{Code: "MEDIA_004_ATTACHABLE_NOT_FOUND", Status: http.StatusNotFound, Description: "the attachable could not be found"})
+	MediaForbidden          = Register(Code{Code: "MEDIA_005_FORBIDDEN", Status: http.StatusForbidden, Description: "you can not change this media"})
+)
+
+// Follows
+var (
+	NotFollowable = Register(Code{Code: "FOLLOW_001_NOT_FOLLOWABLE", Status: http.StatusUnprocessableEntity, Description: "you can not follow yourself"})
+)
+
+// Conversations
+var (
+	ConversationNotFound          = Register(Code{Code: "CONVERSATION_001_NOT_FOUND", Status: http.StatusNotFound, Description: "conversation could not be found"})
+	ConversationInvalidRecipients = Register(Code{Code: "CONVERSATION_002_INVALID_RECIPIENTS", Status: http.StatusUnprocessableEntity, Description: "the recipients could not be found"})
+)
+
+// Notifications
+var (
+	DeviceNotFound = Register(Code{Code: "NOTIFICATION_001_DEVICE_NOT_FOUND", Status: http.StatusNotFound, Description: "device could not be found"})
+)
+
+// Announcements
+var (
+	AnnouncementNotFound     = Register(Code{Code: "ANNOUNCEMENT_001_NOT_FOUND", Status: http.StatusNotFound, Description: "announcement could not be found"})
+	AnnouncementPlanNotFound = Register(Code{Code: "ANNOUNCEMENT_002_PLAN_NOT_FOUND", Status: http.StatusUnprocessableEntity, Description: "the plan of the audience could not be found"})
+	AnnouncementPublished    = Register(Code{Code: "ANNOUNCEMENT_003_PUBLISHED", Status: http.StatusConflict, Description: "announcement is already published"})
+)
+
+// Admin resources
+var (
+	AdminRecordNotFound   = Register(Code{Code: "ADMIN_001_NOT_FOUND", Status: http.StatusNotFound, Description: "record could not be found"})
+	AdminRecordInUse      = Register(Code{Code: "ADMIN_002_IN_USE", Status: http.StatusConflict, Description: "the record is in use and can not be deleted"})
+	AdminResourceNotFound = Register(Code{Code: "ADMIN_003_RESOURCE_NOT_FOUND", Status: http.StatusNotFound, Description: "resource could not be found"})
+)
+
+// Signing
+var (
+	SignatureInvalid  = Register(Code{Code: "SIGNATURE_001_INVALID", Status: http.StatusUnauthorized, Description: "the request signature is missing or invalid"})
+	SignatureReplayed = Register(Code{Code: "SIGNATURE_002_REPLAYED", Status: http.StatusUnauthorized, Description: "the signed request was received already"})
+)
+
+// Replay protection
+var (
+	NonceRequired = Register(Code{Code: "REPLAY_001_NONCE_REQUIRED", Status: http.StatusBadRequest, Description: "the request needs a nonce and a timestamp"})
+	NonceExpired  = Register(Code{Code: "REPLAY_002_EXPIRED", Status: http.StatusBadRequest, Description: "the timestamp of the request is out of the tolerance"})
+	NonceReplayed = Register(Code{Code: "REPLAY_003_REPLAYED", Status: http.StatusConflict, Description: "the nonce was used already, the request is not run again"})
+)
+
+// Validation
+var (
+	InvalidInput = Register(Code{Code: "VALIDATION_001_INVALID_INPUT", Status: http.StatusUnprocessableEntity, Description: "the request contains invalid fields"})
+)
+
+// Patches
+var (
+	PatchUnsupported = Register(Code{Code: "PATCH_001_UNSUPPORTED", Status: http.StatusUnsupportedMediaType, Description: "the body must be a merge patch or a json patch"})
+	PatchInvalid     = Register(Code{Code: "PATCH_002_INVALID", Status: http.StatusUnprocessableEntity, Description: "the patch document is invalid"})
+	PatchConflict    = Register(Code{Code: "PATCH_003_CONFLICT", Status: http.StatusConflict, Description: "the patch can not be applied to the resource"})
+	PatchForbidden   = Register(Code{Code: "PATCH_004_FORBIDDEN_FIELDS", Status: http.StatusForbidden, Description: "you can not change some of the patched fields"})
+	PatchTooLarge    = Register(Code{Code: "PATCH_005_TOO_LARGE", Status: http.StatusRequestEntityTooLarge, Description: "the patch document is too large"})
+)
+
+// User
+var (
+	UserNotFound        = Register(Code{Code: "USER_001_NOT_FOUND", Status: http.StatusNotFound, Description: "user could not be found"})
+	UserSuspended       = Register(Code{Code: "USER_002_SUSPENDED", Status: http.StatusForbidden, Description: "your account is suspended"})
+	NotSuspendable      = Register(Code{Code: "USER_003_NOT_SUSPENDABLE", Status: http.StatusForbidden, Description: "the user can not be suspended"})
+	EmailUnchanged      = Register(Code{Code: "USER_004_EMAIL_UNCHANGED", Status: http.StatusUnprocessableEntity, Description: "the new email is the current email"})
+	EmailChangeInvalid  = Register(Code{Code: "USER_005_EMAIL_CHANGE_INVALID", Status: http.StatusUnprocessableEntity, Description: "the confirmation link is invalid or expired"})
+	UserNotDeletable    = Register(Code{Code: "USER_006_NOT_DELETABLE", Status: http.StatusForbidden, Description: "the user can not be deleted"})
+	UserVersionNotFound = Register(Code{Code: "USER_007_VERSION_NOT_FOUND", Status: http.StatusNotFound, Description: "the version of the user could not be found"})
+)
+
+// Phone
+var (
+	PhoneTaken       = Register(Code{Code: "PHONE_001_TAKEN", Status: http.StatusUnprocessableEntity, Description: "the phone number is already used by another account"})
+	PhoneCodeInvalid = Register(Code{Code: "PHONE_002_CODE_INVALID", Status: http.StatusUnprocessableEntity, Description: "the verification code is invalid or expired"})
+	PhoneNotPending  = Register(Code{Code: "PHONE_003_NOT_PENDING", Status: http.StatusConflict, Description: "there is no phone number waiting for verification"})
+	PhoneCodeTooSoon = Register(Code{Code: "PHONE_004_CODE_TOO_SOON", Status: http.StatusTooManyRequests, Description: "a verification code was sent recently"})
+)
+
+// Feature flags
+var (
+	FeatureOverrideForbidden = Register(Code{Code: "FEATURE_001_OVERRIDE_FORBIDDEN", Status: http.StatusForbidden, Description: "you are not allowed to override feature flags"})
+	FeatureOverrideInvalid   = Register(Code{Code: "FEATURE_002_INVALID_OVERRIDE", Status: http.StatusUnprocessableEntity, Description: "the feature overrides header is invalid"})
+)
+
+// Policies
+var (
+	PolicyAcceptanceRequired = Register(Code{Code: "POLICY_001_ACCEPTANCE_REQUIRED", Status: http.StatusConflict, Description: "you have to accept the current policies"})
+	PolicyNotCurrent         = Register(Code{Code: "POLICY_002_NOT_CURRENT", Status: http.StatusUnprocessableEntity, Description: "only the current version of a policy can be accepted"})
+	AccessRuleDenied         = Register(Code{Code: "POLICY_003_ACCESS_RULE_DENIED", Status: http.StatusForbidden, Description: "an access rule does not allow this action"})
+)
+
+// Data exports
+var (
+	DataExportNotFound = Register(Code{Code: "EXPORT_001_NOT_FOUND", Status: http.StatusNotFound, Description: "data export could not be found"})
+	DataExportNotReady = Register(Code{Code: "EXPORT_002_NOT_READY", Status: http.StatusConflict, Description: "data export is not ready"})
+)
+
+// Registration
+var (
+	RegistrationClosed  = Register(Code{Code: "REGISTRATION_001_CLOSED", Status: http.StatusForbidden, Description: "registration is closed"})
+	InvitationRequired  = Register(Code{Code: "REGISTRATION_002_INVITATION_REQUIRED", Status: http.StatusForbidden, Description: "registration requires an invitation"})
+	InvitationInvalid   = Register(Code{Code: "REGISTRATION_003_INVITATION_INVALID", Status: http.StatusUnprocessableEntity, Description: "the invitation is invalid, expired or used up"})
+	EmailTaken          = Register(Code{Code: "REGISTRATION_004_EMAIL_TAKEN", Status: http.StatusUnprocessableEntity, Description: "the email is already registered"})
+	VerificationInvalid = Register(Code{Code: "REGISTRATION_005_VERIFICATION_INVALID", Status: http.StatusUnprocessableEntity, Description: "the verification link is invalid or already used"})
+	InvitationNotFound  = Register(Code{Code: "INVITATION_001_NOT_FOUND", Status: http.StatusNotFound, Description: "invitation could not be found"})
+)
+
+// Quotas
+var (
+	QuotaExceeded = Register(Code{Code: "QUOTA_001_EXCEEDED", Status: http.StatusTooManyRequests, Description: "the request quota is exhausted"})
+)
+
+// Settings
+var (
+	SettingNotFound = Register(Code{Code: "SETTING_001_NOT_FOUND", Status: http.StatusNotFound, Description: "setting could not be found"})
+	SettingInvalid  = Register(Code{Code: "SETTING_002_INVALID_VALUE", Status: http.StatusUnprocessableEntity, Description: "the value does not match the type of the setting"})
+)
+
+// Dead letters
+var (
+	DeadLetterNotFound      = Register(Code{Code: "DEADLETTER_001_NOT_FOUND", Status: http.StatusNotFound, Description: "dead letter could not be found"})
+	DeadLetterReplayFailed  = Register(Code{Code: "DEADLETTER_002_REPLAY_FAILED", Status: http.StatusConflict, Description: "the replay failed again, the error of the dead letter is updated"})
+	DeadLetterNotReplayable = Register(Code{Code: "DEADLETTER_003_NOT_REPLAYABLE", Status: http.StatusUnprocessableEntity, Description: "nothing replays the dead letters of this source"})
+)
+
+// Server
+var (
+	Internal               = Register(Code{Code: "SERVER_001_INTERNAL", Status: http.StatusInternalServerError, Description: "internal server error"})
+	ResponseBudgetExceeded = Register(Code{Code: "SERVER_002_RESPONSE_BUDGET_EXCEEDED", Status: http.StatusInternalServerError, Description: "the response exceeds the size budget of the endpoint"})
+	DryRunUnsupported      = Register(Code{Code: "SERVER_003_DRY_RUN_UNSUPPORTED", Status: http.StatusBadRequest, Description: "the endpoint can not be run dry, nothing was done"})
+	ContractRequest        = Register(Code{Code: "SERVER_004_CONTRACT_REQUEST", Status: http.StatusBadRequest, Description: "the request does not match the api documentation"})
+	ContractResponse       = Register(Code{Code: "SERVER_005_CONTRACT_RESPONSE", Status: http.StatusInternalServerError, Description: "the response does not match the api documentation"})
+	ContractUndocumented   = Register(Code{Code: "SERVER_006_CONTRACT_UNDOCUMENTED", Status: http.StatusInternalServerError, Description: "the route is missing from the api documentation"})
+)
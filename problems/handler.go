@@ -0,0 +1,68 @@
+package problems
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HTTPErrorHandler renders every error returned by handlers and middlewares as problem+json
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	p := FromError(err)
+	p.Instance = c.Request().URL.Path
+	if p.Status >= http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(p.Status)
+	} else {
+		err = Render(c, p)
+	}
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
+
+// Render writes a problem as the response
+func Render(c echo.Context, p *Problem) error {
+	body, err := p.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return c.Blob(p.Status, ContentType, body)
+}
+
+// FromError converts any error to a problem, errors without a code become a generic one
+func FromError(err error) *Problem {
+	var problem *Problem
+	if errors.As(err, &problem) {
+		return problem
+	}
+
+	var domainError *DomainError
+	if errors.As(err, &domainError) {
+		return domainError.Problem()
+	}
+
+	var httpError *echo.HTTPError
+	if errors.As(err, &httpError) {
+		if httpError.Internal != nil {
+			if inner := FromError(httpError.Internal); inner.Code != Internal.Code {
+				return inner
+			}
+		}
+		if httpError.Code >= http.StatusInternalServerError {
+			return New(Internal)
+		}
+		return New(ForStatus(httpError.Code), fmt.Sprintf("%v", httpError.Message))
+	}
+
+	return New(Internal)
+}
@@ -0,0 +1,95 @@
+package problems
+
+import (
+	"encoding/json"
+)
+
+const ContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem detail carrying a catalog code
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+
+	// extension members, serialized next to the standard ones
+	Extensions map[string]interface{} `json:"-"`
+}
+
+// New creates the problem of a catalog code, detail defaults to the code description
+func New(code Code, detail ...string) *Problem {
+	p := &Problem{
+		Type:   "/errors#" + code.Code,
+		Title:  code.Title,
+		Status: code.Status,
+		Detail: code.Description,
+		Code:   code.Code,
+	}
+	if len(detail) > 0 {
+		p.Detail = detail[0]
+	}
+	return p
+}
+
+// With adds an extension member
+func (p *Problem) With(key string, value interface{}) *Problem {
+	if p.Extensions == nil {
+		p.Extensions = map[string]interface{}{}
+	}
+	p.Extensions[key] = value
+	return p
+}
+
+func (p *Problem) Error() string {
+	if p.Detail != "" {
+		return p.Code + ": " + p.Detail
+	}
+	return p.Code + ": " + p.Title
+}
+
+func (p *Problem) MarshalJSON() ([]byte, error) {
+	type problem Problem
+	out := map[string]interface{}{}
+	for key, value := range p.Extensions {
+		out[key] = value
+	}
+	standard, err := json.Marshal((*problem)(p))
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(standard, &out); err != nil {
+		return nil, err
+	}
+	return json.Marshal(out)
+}
+
+// DomainError is an error raised by services, it has to be declared with its catalog code
+type DomainError struct {
+	Code    Code
+	Message string
+}
+
+// Define declares a domain error, the code must come from Register
+func Define(code Code, message string) *DomainError {
+	if _, ok := Lookup(code.Code); !ok {
+		panic("problems: domain error \"" + message + "\" declares an unregistered code")
+	}
+	return &DomainError{Code: code, Message: message}
+}
+
+func (e *DomainError) Error() string {
+	return e.Message
+}
+
+// Problem converts the domain error to its response
+func (e *DomainError) Problem() *Problem {
+	return New(e.Code, e.Message)
+}
+
+// Validation is the problem of a request with invalid fields, errors is keyed by field
+func Validation(errs interface{}) *Problem {
+	return New(InvalidInput).With("errors", errs)
+}
@@ -0,0 +1,130 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/models"
+	"gotham/models/scopes"
+)
+
+type IAnnouncementRepository interface {
+	Migratable
+	Exportable
+	Erasable
+	IBaseRepository[models.Announcement]
+	IPublishableRepository[models.Announcement]
+
+	GetAnnouncementByID(ID uint) (models.Announcement, error)
+	// GetAnnouncements returns a page of every announcement whatever its publish status, the latest created first
+	GetAnnouncements(pagination scopes.GormPager) (announcements []models.Announcement, totalCount int64, err error)
+	// GetUserAnnouncements returns a page of the published announcements to everyone, to the verified users when the
+	// user is verified and to the plans of the slugs, the latest first
+	GetUserAnnouncements(verified bool, planSlugs []string, pagination scopes.GormPager) (announcements []models.Announcement, totalCount int64, err error)
+	// GetAudience returns up to limit ids of the users of the audience after the id, planRank is the rank of the plan
+	// of a plan audience
+	GetAudience(audience string, planRank int, afterID uint, limit int) (userIDs []uint, err error)
+	GetReadIDs(userID uint, announcementIDs []uint) (IDs []uint, err error)
+	CountReads(announcementIDs []uint) (counts []models.AnnouncementReadCount, err error)
+
+	// Updates
+	MarkRead(announcementID uint, userID uint) error
+}
+
+type AnnouncementRepository struct {
+	BaseRepository[models.Announcement]
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *AnnouncementRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Announcement{}, models.AnnouncementRead{})
+}
+
+func (repository *AnnouncementRepository) GetAnnouncementByID(ID uint) (announcement models.Announcement, err error) {
+	err = repository.DB().First(&announcement, ID).Error
+	return
+}
+
+func (repository *AnnouncementRepository) GetAnnouncements(pagination scopes.GormPager) (announcements []models.Announcement, totalCount int64, err error) {
+	return repository.List(pagination, func(db *gorm.DB) *gorm.DB {
+		return db.Order("id desc")
+	})
+}
+
+func (repository *AnnouncementRepository) GetUserAnnouncements(verified bool, planSlugs []string, pagination scopes.GormPager) (announcements []models.Announcement, totalCount int64, err error) {
+	return repository.List(pagination, func(db *gorm.DB) *gorm.DB {
+		audience := repository.DB().Where("audience = ?", models.AudienceAll)
+		if verified {
+			audience = audience.Or("audience = ?", models.AudienceVerified)
+		}
+		if len(planSlugs) > 0 {
+			audience = audience.Or("audience = ? AND plan_slug IN ?", models.AudiencePlan, planSlugs)
+		}
+		return db.Scopes(scopes.Published(models.Announcement{}.TableName())).Where(audience).Order("published_at desc").Order("id desc")
+	})
+}
+
+func (repository *AnnouncementRepository) GetAudience(audience string, planRank int, afterID uint, limit int) (userIDs []uint, err error) {
+	query := repository.DB().Model(&models.User{}).Where("users.id > ?", afterID)
+	switch audience {
+	case models.AudienceVerified:
+		query = query.Where("users.verified = ?", true)
+	case models.AudiencePlan:
+		query = query.Joins("JOIN subscriptions ON subscriptions.user_id = users.id").
+			Joins("JOIN plans ON plans.id = subscriptions.plan_id").
+			Where("subscriptions.status IN ? AND subscriptions.current_period_end > ?", models.EntitledStatuses, time.Now()).
+			Where("plans.rank >= ?", planRank)
+	}
+	err = query.Order("users.id asc").Limit(limit).Pluck("users.id", &userIDs).Error
+	return
+}
+
+func (repository *AnnouncementRepository) GetReadIDs(userID uint, announcementIDs []uint) (IDs []uint, err error) {
+	if len(announcementIDs) == 0 {
+		return
+	}
+	err = repository.DB().Model(&models.AnnouncementRead{}).
+		Where("user_id = ? AND announcement_id IN ?", userID, announcementIDs).Pluck("announcement_id", &IDs).Error
+	return
+}
+
+func (repository *AnnouncementRepository) CountReads(announcementIDs []uint) (counts []models.AnnouncementReadCount, err error) {
+	if len(announcementIDs) == 0 {
+		return
+	}
+	err = repository.DB().Model(&models.AnnouncementRead{}).Select("announcement_id, COUNT(*) AS count").
+		Where("announcement_id IN ?", announcementIDs).Group("announcement_id").Scan(&counts).Error
+	return
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *AnnouncementRepository) MarkRead(announcementID uint, userID uint) error {
+	return repository.DB().Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.AnnouncementRead{AnnouncementID: announcementID, UserID: userID}).Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *AnnouncementRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var reads []models.AnnouncementRead
+	err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&reads).Error
+	return reads, err
+}
+
+// EraseUserData deletes the reads of the user, the announcements an admin wrote stay
+func (repository *AnnouncementRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Where("user_id = ?", userID).Delete(&models.AnnouncementRead{}).Error
+}
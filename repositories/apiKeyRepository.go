@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"time"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IApiKeyRepository interface {
+	Migratable
+
+	GetApiKeysOfUser(userID uint) (keys []models.ApiKey, err error)
+	GetApiKeyOfUser(userID uint, ID uint) (models.ApiKey, error)
+	GetApiKeyByHash(hash string) (models.ApiKey, error)
+
+	// Create
+	Create(key *models.ApiKey) (err error)
+
+	// Updates
+	Revoke(key *models.ApiKey, now time.Time) (err error)
+	Touch(key *models.ApiKey, now time.Time) (err error)
+}
+
+type ApiKeyRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *ApiKeyRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.ApiKey{})
+}
+
+func (repository *ApiKeyRepository) GetApiKeysOfUser(userID uint) (keys []models.ApiKey, err error) {
+	err = repository.DB().Where("user_id = ?", userID).Order("id desc").Find(&keys).Error
+	return
+}
+
+func (repository *ApiKeyRepository) GetApiKeyOfUser(userID uint, ID uint) (key models.ApiKey, err error) {
+	err = repository.DB().Where("user_id = ?", userID).First(&key, ID).Error
+	return
+}
+
+func (repository *ApiKeyRepository) GetApiKeyByHash(hash string) (key models.ApiKey, err error) {
+	err = repository.DB().Where(models.ApiKey{KeyHash: hash}).First(&key).Error
+	return
+}
+
+/**
+ * Create
+ *
+ */
+
+func (repository *ApiKeyRepository) Create(key *models.ApiKey) (err error) {
+	return repository.DB().Create(key).Error
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *ApiKeyRepository) Revoke(key *models.ApiKey, now time.Time) (err error) {
+	key.RevokedAt = &now
+	return repository.DB().Model(key).Update("revoked_at", now).Error
+}
+
+func (repository *ApiKeyRepository) Touch(key *models.ApiKey, now time.Time) (err error) {
+	key.LastUsedAt = &now
+	return repository.DB().Model(key).UpdateColumn("last_used_at", now).Error
+}
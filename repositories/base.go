@@ -1,5 +1,10 @@
 package repositories
 
+import "context"
+
+// exportBatchSize is the number of rows a StreamExportable repository reads at once
+const exportBatchSize = 500
+
 type Seedable interface {
 	Seed() error
 }
@@ -7,3 +12,19 @@ type Seedable interface {
 type Migratable interface {
 	Migrate() error
 }
+
+// Exportable repositories contribute the data they hold about a user to its data export
+type Exportable interface {
+	ExportUserData(userID uint) (data interface{}, err error)
+}
+
+// Erasable repositories remove (or anonymize) the data they hold about a user when its account is purged
+type Erasable interface {
+	EraseUserData(userID uint) error
+}
+
+// StreamExportable repositories write the data they hold about a user record by record, for the sections too large to
+// be read at once. The records of the section are exported as an array
+type StreamExportable interface {
+	StreamUserData(ctx context.Context, userID uint, write func(record interface{}) error) (err error)
+}
@@ -0,0 +1,257 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+)
+
+type IBaseRepository[T any] interface {
+	FindByID(ID uint) (T, error)
+	FindByIDs(IDs []uint) (records []T, err error)
+	List(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []T, totalCount int64, err error)
+
+	// Chunk returns up to limit records after the id in the order of their ids, to walk large tables without offsets
+	Chunk(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []T, err error)
+	// FindEach hands the records matching the filters to handle in batches of batchSize in the order of their ids, until
+	// they run out, handle fails or the context is done. The slice is reused by the next batch
+	FindEach(ctx context.Context, batchSize int, handle func(records []T) error, filters ...func(db *gorm.DB) *gorm.DB) (err error)
+	// Stream sends the records matching the filters one by one, read batchSize at a time. The records channel is closed
+	// after the last one, then the errs one after the error that stopped the stream, if any. A reader leaving early
+	// cancels the context
+	Stream(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (records <-chan T, errs <-chan error)
+
+	// Create & Update & Delete
+	Create(record *T) (err error)
+	Update(record *T, updates map[string]interface{}) (err error)
+	// UpdateFields saves the fields of the record, by their columns, zero values included
+	UpdateFields(record *T, fields ...string) (err error)
+	Delete(record *T) (err error)
+
+	// OnShard is the repository on the shard of the key, itself without shards
+	OnShard(key infrastructures.ShardKey) IBaseRepository[T]
+
+	// Aggregates
+	Exists(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error)
+	Count(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error)
+}
+
+// IPublishableRepository is the publishing of the models embedding models.Publishable, BaseRepository implements it
+type IPublishableRepository[T any] interface {
+	// PublishDue publishes up to limit scheduled records whose publish time has come and returns them
+	PublishDue(now time.Time, limit int) (records []T, err error)
+	// Publish publishes the record now, false when it was already published
+	Publish(record *T, now time.Time) (published bool, err error)
+}
+
+/**
+ * BaseRepository
+ * queries every repository of a model repeats, embed it and add the model specific ones
+ */
+type BaseRepository[T any] struct {
+	infrastructures.IGormDatabase
+	// Shards are optional, the records of a repository with shards are on the shard of their key
+	Shards infrastructures.IShards
+}
+
+// table is the table of the model, for the scopes qualifying their columns
+func (repository *BaseRepository[T]) table() string {
+	statement := &gorm.Statement{DB: repository.DB()}
+	if err := statement.Parse(new(T)); err != nil {
+		return ""
+	}
+	return statement.Schema.Table
+}
+
+func (repository *BaseRepository[T]) query(filters ...func(db *gorm.DB) *gorm.DB) *gorm.DB {
+	return repository.DB().Model(new(T)).Scopes(filters...)
+}
+
+func (repository *BaseRepository[T]) FindByID(ID uint) (record T, err error) {
+	err = repository.DB().First(&record, ID).Error
+	return
+}
+
+func (repository *BaseRepository[T]) FindByIDs(IDs []uint) (records []T, err error) {
+	if len(IDs) == 0 {
+		return
+	}
+	err = repository.DB().Find(&records, IDs).Error
+	return
+}
+
+/**
+ * List
+ * records matching the filters (filter and order scopes) with the count before pagination, -1 on a lite page
+ */
+func (repository *BaseRepository[T]) List(pagination scopes.GormPager, filters ...func(db *gorm.DB) *gorm.DB) (records []T, totalCount int64, err error) {
+	if totalCount, err = countPage(pagination, repository.query(filters...)); err != nil {
+		return
+	}
+	query := repository.query(filters...)
+	if pagination != nil {
+		query = query.Scopes(pagination.ToPaginate())
+	}
+	if err = query.Find(&records).Error; err != nil {
+		return
+	}
+	return trimPage(pagination, records), totalCount, nil
+}
+
+// countPage counts the records of the query before pagination, skipped on a lite page whose count is -1
+func countPage(pagination scopes.GormPager, query *gorm.DB) (totalCount int64, err error) {
+	if lite, ok := pagination.(scopes.LitePager); ok && lite.IsLite() {
+		return -1, nil
+	}
+	err = query.Count(&totalCount).Error
+	return
+}
+
+// trimPage drops the record after the limit read by a lite page, telling the pager whether a next page exists
+func trimPage[T any](pagination scopes.GormPager, records []T) []T {
+	lite, ok := pagination.(scopes.LitePager)
+	if !ok || !lite.IsLite() {
+		return records
+	}
+	hasNext := len(records) > lite.GetLimit()
+	lite.SetHasNext(hasNext)
+	if hasNext {
+		return records[:lite.GetLimit()]
+	}
+	return records
+}
+
+func (repository *BaseRepository[T]) Chunk(afterID uint, limit int, filters ...func(db *gorm.DB) *gorm.DB) (records []T, err error) {
+	err = repository.query(filters...).Where("id > ?", afterID).Order("id asc").Limit(limit).Find(&records).Error
+	return
+}
+
+/**
+ * FindEach
+ * the records of the whole table without loading it, a batch at a time. gorm reads each batch after the primary key
+ * of the last one, the context cancels the query in flight
+ */
+func (repository *BaseRepository[T]) FindEach(ctx context.Context, batchSize int, handle func(records []T) error, filters ...func(db *gorm.DB) *gorm.DB) (err error) {
+	var records []T
+	return repository.query(filters...).WithContext(ctx).FindInBatches(&records, batchSize, func(tx *gorm.DB, batch int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return handle(records)
+	}).Error
+}
+
+func (repository *BaseRepository[T]) Stream(ctx context.Context, batchSize int, filters ...func(db *gorm.DB) *gorm.DB) (<-chan T, <-chan error) {
+	records := make(chan T, batchSize)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		err := repository.FindEach(ctx, batchSize, func(batch []T) error {
+			for _, record := range batch {
+				select {
+				case records <- record:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			return nil
+		}, filters...)
+		close(records)
+		if err != nil {
+			errs <- err
+		}
+	}()
+	return records, errs
+}
+
+/**
+ * Create & Update & Delete
+ *
+ */
+
+func (repository *BaseRepository[T]) Create(record *T) (err error) {
+	return repository.DB().Create(record).Error
+}
+
+func (repository *BaseRepository[T]) Update(record *T, updates map[string]interface{}) (err error) {
+	return repository.DB().Model(record).Updates(updates).Error
+}
+
+func (repository *BaseRepository[T]) UpdateFields(record *T, fields ...string) (err error) {
+	if len(fields) == 0 {
+		return nil
+	}
+	return repository.DB().Model(record).Select(fields).Updates(record).Error
+}
+
+func (repository *BaseRepository[T]) Delete(record *T) (err error) {
+	return repository.DB().Delete(record).Error
+}
+
+/**
+ * Shards
+ *
+ */
+
+func (repository *BaseRepository[T]) OnShard(key infrastructures.ShardKey) IBaseRepository[T] {
+	if repository.Shards == nil {
+		return repository
+	}
+	return &BaseRepository[T]{IGormDatabase: repository.Shards.For(key), Shards: repository.Shards}
+}
+
+// ShardDB is the database of the shard of the key, for the queries of the repositories embedding the base
+func (repository *BaseRepository[T]) ShardDB(key infrastructures.ShardKey) *gorm.DB {
+	if repository.Shards == nil {
+		return repository.DB()
+	}
+	return repository.Shards.For(key).DB()
+}
+
+/**
+ * Aggregates
+ *
+ */
+
+func (repository *BaseRepository[T]) Exists(filters ...func(db *gorm.DB) *gorm.DB) (exists bool, err error) {
+	var count int64
+	err = repository.query(filters...).Count(&count).Error
+	return count > 0, err
+}
+
+func (repository *BaseRepository[T]) Count(filters ...func(db *gorm.DB) *gorm.DB) (count int64, err error) {
+	err = repository.query(filters...).Count(&count).Error
+	return
+}
+
+/**
+ * Publishing
+ * only for the models embedding models.Publishable
+ */
+
+func (repository *BaseRepository[T]) PublishDue(now time.Time, limit int) (records []T, err error) {
+	err = repository.DB().Transaction(func(tx *gorm.DB) error {
+		// the rows stay locked until they are published so two instances do not publish them twice
+		if err := tx.Model(new(T)).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scopes(scopes.DueForPublishing(repository.table(), now)).Order("publish_at asc").Limit(limit).Find(&records).Error; err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		return tx.Model(&records).Updates(map[string]interface{}{"publish_status": models.PublishPublished, "published_at": now}).Error
+	})
+	return records, err
+}
+
+func (repository *BaseRepository[T]) Publish(record *T, now time.Time) (published bool, err error) {
+	result := repository.DB().Model(record).Where("publish_status <> ?", models.PublishPublished).
+		Updates(map[string]interface{}{"publish_status": models.PublishPublished, "published_at": now})
+	return result.RowsAffected > 0, result.Error
+}
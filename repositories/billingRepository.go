@@ -0,0 +1,173 @@
+package repositories
+
+import (
+	"time"
+
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IBillingRepository interface {
+	Migratable
+	Seedable
+	Exportable
+
+	GetActivePlans() (plans []models.Plan, err error)
+	GetPlanBySlug(slug string) (models.Plan, error)
+	GetPlanByPriceID(priceID string) (models.Plan, error)
+	GetSubscriptionByUserID(userID uint) (models.Subscription, error)
+	GetSubscriptionByCustomerID(customerID string) (models.Subscription, error)
+	// IsPlanInUse tells if a subscription or a coupon refers to the plan
+	IsPlanInUse(planID uint) (bool, error)
+	GetInvoiceByID(ID uint) (models.Invoice, error)
+	GetInvoiceByExternalID(externalID string) (models.Invoice, error)
+	GetInvoicesByUserID(userID uint) (invoices []models.Invoice, err error)
+	// GetUnrenderedInvoices returns the pending and failed invoices created before the time with less than maxAttempts renders
+	GetUnrenderedInvoices(before time.Time, maxAttempts int) (invoices []models.Invoice, err error)
+
+	// Create
+	CreateSubscription(subscription *models.Subscription) (err error)
+	CreateInvoice(invoice *models.Invoice) (err error)
+
+	// Updates
+	UpdateSubscription(subscription *models.Subscription, updates map[string]interface{}) (err error)
+	UpdateInvoice(invoice *models.Invoice, updates map[string]interface{}) (err error)
+}
+
+// BillingRepository holds the plans, the subscriptions and the invoices of the users, subscriptions and invoices are
+// not erased with the account, they are kept for accounting
+type BillingRepository struct {
+	infrastructures.IGormDatabase
+	// the stripe price the seeded pro plan is sold at
+	ProPriceID string
+}
+
+/**
+ * Seed
+ *
+ * @return error
+ */
+func (repository *BillingRepository) Seed() (err error) {
+	free, _ := helpers.NewMoney(0, "USD")
+	pro, _ := helpers.NewMoney(900, "USD")
+	plans := []models.Plan{
+		{Slug: "free", Name: "Free", Rank: 0, Price: free, Interval: "month", Active: true},
+		{Slug: "pro", Name: "Pro", Rank: 1, Price: pro, Interval: "month", Active: true},
+	}
+	if repository.ProPriceID != "" {
+		plans[1].StripePriceID = &repository.ProPriceID
+	}
+	for _, plan := range plans {
+		if err = repository.DB().Where(models.Plan{Slug: plan.Slug}).FirstOrCreate(&plan).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *BillingRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Plan{}, models.Subscription{}, models.Invoice{}, models.InvoiceLine{})
+}
+
+func (repository *BillingRepository) GetActivePlans() (plans []models.Plan, err error) {
+	err = repository.DB().Where("active = ?", true).Order("rank asc, id asc").Find(&plans).Error
+	return
+}
+
+func (repository *BillingRepository) GetPlanBySlug(slug string) (plan models.Plan, err error) {
+	err = repository.DB().Where("slug = ?", slug).First(&plan).Error
+	return
+}
+
+func (repository *BillingRepository) GetPlanByPriceID(priceID string) (plan models.Plan, err error) {
+	err = repository.DB().Where("stripe_price_id = ?", priceID).First(&plan).Error
+	return
+}
+
+func (repository *BillingRepository) GetSubscriptionByUserID(userID uint) (subscription models.Subscription, err error) {
+	err = repository.DB().Preload("Plan").Where("user_id = ?", userID).First(&subscription).Error
+	return
+}
+
+func (repository *BillingRepository) GetSubscriptionByCustomerID(customerID string) (subscription models.Subscription, err error) {
+	err = repository.DB().Preload("Plan").Where("customer_id = ?", customerID).First(&subscription).Error
+	return
+}
+
+func (repository *BillingRepository) IsPlanInUse(planID uint) (bool, error) {
+	var count int64
+	if err := repository.DB().Model(&models.Subscription{}).Where("plan_id = ?", planID).Count(&count).Error; err != nil || count > 0 {
+		return count > 0, err
+	}
+	err := repository.DB().Model(&models.Coupon{}).Where("plan_id = ?", planID).Count(&count).Error
+	return count > 0, err
+}
+
+func (repository *BillingRepository) GetInvoiceByID(ID uint) (invoice models.Invoice, err error) {
+	err = repository.DB().Preload("Lines").First(&invoice, ID).Error
+	return
+}
+
+func (repository *BillingRepository) GetInvoiceByExternalID(externalID string) (invoice models.Invoice, err error) {
+	err = repository.DB().Where("external_id = ?", externalID).First(&invoice).Error
+	return
+}
+
+func (repository *BillingRepository) GetInvoicesByUserID(userID uint) (invoices []models.Invoice, err error) {
+	err = repository.DB().Preload("Lines").Where("user_id = ?", userID).Order("paid_at desc, id desc").Find(&invoices).Error
+	return
+}
+
+func (repository *BillingRepository) GetUnrenderedInvoices(before time.Time, maxAttempts int) (invoices []models.Invoice, err error) {
+	err = repository.DB().Preload("Lines").
+		Where("status IN ? AND created_at < ? AND attempts < ?", []models.InvoiceStatus{models.InvoicePending, models.InvoiceFailed}, before, maxAttempts).
+		Order("id asc").Find(&invoices).Error
+	return
+}
+
+/**
+ * Create
+ *
+ */
+
+func (repository *BillingRepository) CreateSubscription(subscription *models.Subscription) (err error) {
+	return repository.DB().Create(subscription).Error
+}
+
+func (repository *BillingRepository) CreateInvoice(invoice *models.Invoice) (err error) {
+	return repository.DB().Create(invoice).Error
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *BillingRepository) UpdateSubscription(subscription *models.Subscription, updates map[string]interface{}) (err error) {
+	return repository.DB().Model(subscription).Updates(updates).Error
+}
+
+func (repository *BillingRepository) UpdateInvoice(invoice *models.Invoice, updates map[string]interface{}) (err error) {
+	return repository.DB().Model(invoice).Updates(updates).Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *BillingRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var subscriptions []models.Subscription
+	if err = repository.DB().Preload("Plan").Where("user_id = ?", userID).Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	var invoices []models.Invoice
+	err = repository.DB().Preload("Lines").Where("user_id = ?", userID).Order("id asc").Find(&invoices).Error
+	return map[string]interface{}{"subscriptions": subscriptions, "invoices": invoices}, err
+}
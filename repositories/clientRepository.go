@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"time"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IClientRepository interface {
+	Migratable
+
+	GetClients() (clients []models.Client, err error)
+	GetClientByID(ID uint) (models.Client, error)
+	GetClientByClientID(clientID string) (models.Client, error)
+	GetClientByCertificateIdentity(identity string) (models.Client, error)
+
+	// Create
+	Create(client *models.Client) (err error)
+
+	// Updates
+	Revoke(client *models.Client, now time.Time) (err error)
+	Touch(client *models.Client, now time.Time) (err error)
+}
+
+type ClientRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *ClientRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Client{})
+}
+
+func (repository *ClientRepository) GetClients() (clients []models.Client, err error) {
+	err = repository.DB().Order("id desc").Find(&clients).Error
+	return
+}
+
+func (repository *ClientRepository) GetClientByID(ID uint) (client models.Client, err error) {
+	err = repository.DB().First(&client, ID).Error
+	return
+}
+
+func (repository *ClientRepository) GetClientByClientID(clientID string) (client models.Client, err error) {
+	err = repository.DB().Where(models.Client{ClientID: clientID}).First(&client).Error
+	return
+}
+
+func (repository *ClientRepository) GetClientByCertificateIdentity(identity string) (client models.Client, err error) {
+	err = repository.DB().Where("certificate_identity = ?", identity).First(&client).Error
+	return
+}
+
+/**
+ * Create
+ *
+ */
+
+func (repository *ClientRepository) Create(client *models.Client) (err error) {
+	return repository.DB().Create(client).Error
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *ClientRepository) Revoke(client *models.Client, now time.Time) (err error) {
+	client.RevokedAt = &now
+	return repository.DB().Model(client).Update("revoked_at", now).Error
+}
+
+func (repository *ClientRepository) Touch(client *models.Client, now time.Time) (err error) {
+	client.LastUsedAt = &now
+	return repository.DB().Model(client).UpdateColumn("last_used_at", now).Error
+}
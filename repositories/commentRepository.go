@@ -0,0 +1,153 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/models"
+	"gotham/models/scopes"
+)
+
+type ICommentRepository interface {
+	Migratable
+	Exportable
+	Erasable
+	IBaseRepository[models.Comment]
+
+	GetCommentByID(ID uint) (models.Comment, error)
+	// GetThreads returns a page of the top level comments of the commentable with their replies, deleted comments
+	// included to keep the threads whole
+	GetThreads(commentableType string, commentableID uint, pagination scopes.GormPager, order scopes.GormOrderer) (comments []models.Comment, totalCount int64, err error)
+	// GetModerationQueue returns the flagged comments no moderator has reviewed since, the most flagged first
+	GetModerationQueue(pagination scopes.GormPager) (comments []models.Comment, totalCount int64, err error)
+	HasFlagged(commentID uint, userID uint) (bool, error)
+
+	// Updates
+	Updates(comment *models.Comment, updates map[string]interface{}) (err error)
+	// Flag records the flag and counts it on the comment, a visible comment reaching hideAt flags is hidden
+	Flag(comment *models.Comment, flag *models.CommentFlag, hideAt int) (err error)
+}
+
+type CommentRepository struct {
+	BaseRepository[models.Comment]
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *CommentRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Comment{}, models.CommentFlag{})
+}
+
+func (repository *CommentRepository) GetCommentByID(ID uint) (comment models.Comment, err error) {
+	err = repository.DB().First(&comment, ID).Error
+	return
+}
+
+func (repository *CommentRepository) GetThreads(commentableType string, commentableID uint, pagination scopes.GormPager, order scopes.GormOrderer) (comments []models.Comment, totalCount int64, err error) {
+	comments, totalCount, err = repository.List(pagination,
+		func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped().Where("commentable_type = ? AND commentable_id = ? AND parent_id IS NULL", commentableType, commentableID)
+		},
+		order.ToOrder(models.Comment{}.TableName(), "id", "id", "created_at"),
+	)
+	if err != nil || len(comments) == 0 {
+		return
+	}
+
+	rootIDs := make([]uint, len(comments))
+	for i := range comments {
+		rootIDs[i] = comments[i].ID
+	}
+	var replies []models.Comment
+	if err = repository.DB().Unscoped().Where("root_id IN ?", rootIDs).Order("id asc").Find(&replies).Error; err != nil {
+		return
+	}
+	for i := range comments {
+		comments[i].Replies = nest(comments[i].ID, replies)
+	}
+	return
+}
+
+// nest builds the replies of a comment from the replies of its thread, ordered by id a reply comes after its parent
+func nest(parentID uint, replies []models.Comment) (children []models.Comment) {
+	for _, reply := range replies {
+		if reply.ParentID != nil && *reply.ParentID == parentID {
+			reply.Replies = nest(reply.ID, replies)
+			children = append(children, reply)
+		}
+	}
+	return
+}
+
+func (repository *CommentRepository) GetModerationQueue(pagination scopes.GormPager) (comments []models.Comment, totalCount int64, err error) {
+	return repository.List(pagination, func(db *gorm.DB) *gorm.DB {
+		return db.Where("status = ? OR (status = ? AND flags > 0 AND reviewed_at IS NULL)", models.CommentHidden, models.CommentVisible).
+			Order("flags desc").Order("id asc")
+	})
+}
+
+func (repository *CommentRepository) HasFlagged(commentID uint, userID uint) (bool, error) {
+	var count int64
+	err := repository.DB().Model(&models.CommentFlag{}).Where("comment_id = ? AND user_id = ?", commentID, userID).Count(&count).Error
+	return count > 0, err
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *CommentRepository) Updates(comment *models.Comment, updates map[string]interface{}) (err error) {
+	return repository.DB().Model(comment).Updates(updates).Error
+}
+
+func (repository *CommentRepository) Flag(comment *models.Comment, flag *models.CommentFlag, hideAt int) (err error) {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(comment, comment.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(flag).Error; err != nil {
+			return err
+		}
+		updates := map[string]interface{}{"flags": comment.Flags + 1}
+		if comment.Status == models.CommentVisible && hideAt > 0 && comment.Flags+1 >= hideAt {
+			updates["status"] = models.CommentHidden
+		}
+		return tx.Model(comment).Updates(updates).Error
+	})
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *CommentRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var comments []models.Comment
+	if err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	var flags []models.CommentFlag
+	if err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&flags).Error; err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"comments": comments, "flags": flags}, nil
+}
+
+// EraseUserData blanks and deletes the comments of the user, the rows stay so the replies of others keep their thread
+func (repository *CommentRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.CommentFlag{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Model(&models.Comment{}).Where("user_id = ?", userID).Updates(map[string]interface{}{
+			"body":       "",
+			"deleted_at": gorm.Expr("COALESCE(deleted_at, ?)", time.Now()),
+		}).Error
+	})
+}
@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IConfigSnapshotRepository interface {
+	Migratable
+
+	// Record stores the snapshot, or counts a load of the one with its checksum. The snapshot gets the id stored
+	Record(snapshot *models.ConfigSnapshot) (err error)
+	// GetPrevious returns the snapshot loaded last other than the one of the checksum, gorm.ErrRecordNotFound without
+	GetPrevious(checksum string) (snapshot models.ConfigSnapshot, err error)
+}
+
+type ConfigSnapshotRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *ConfigSnapshotRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.ConfigSnapshot{})
+}
+
+func (repository *ConfigSnapshotRepository) Record(snapshot *models.ConfigSnapshot) (err error) {
+	snapshot.Loads = 1
+	err = repository.DB().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "checksum"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"loads": gorm.Expr("loads + 1"), "version": snapshot.Version, "host": snapshot.Host, "loaded_at": snapshot.LoadedAt, "updated_at": snapshot.LoadedAt,
+		}),
+	}).Create(snapshot).Error
+	if err != nil {
+		return err
+	}
+	// the id of a conflicting insert is not returned by every driver
+	return repository.DB().Where("checksum = ?", snapshot.Checksum).First(snapshot).Error
+}
+
+func (repository *ConfigSnapshotRepository) GetPrevious(checksum string) (snapshot models.ConfigSnapshot, err error) {
+	err = repository.DB().Where("checksum <> ?", checksum).Order("loaded_at desc").First(&snapshot).Error
+	return
+}
@@ -0,0 +1,175 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+)
+
+type IConversationRepository interface {
+	Migratable
+	Exportable
+	Erasable
+
+	GetParticipant(conversationID uint, userID uint) (participant models.ConversationParticipant, err error)
+	GetParticipants(conversationIDs ...uint) (participants []models.ConversationParticipant, err error)
+	// GetUserConversations returns a page of the conversations of the user, the latest messaged first
+	GetUserConversations(userID uint, pagination scopes.GormPager) (conversations []models.Conversation, totalCount int64, err error)
+	// GetMessages returns up to limit messages sent before the message, the latest first, from the latest when beforeID is 0
+	GetMessages(conversationID uint, beforeID uint, limit int) (messages []models.Message, err error)
+	GetLatestMessageID(conversationID uint) (messageID uint, err error)
+	// GetUnreadCounts returns the conversations of the user with unread messages, only the given ones when there are any
+	GetUnreadCounts(userID uint, conversationIDs ...uint) (unread []models.ConversationUnread, err error)
+
+	// Updates
+	// CreateConversation creates the conversation with its participants, a direct conversation that already exists is loaded instead
+	CreateConversation(conversation *models.Conversation, userIDs []uint) error
+	// CreateMessage stores the message, moves the conversation up and marks it read for its sender
+	CreateMessage(message *models.Message) error
+	// MarkRead moves the read marker of the user forward to the message, it never goes back
+	MarkRead(conversationID uint, userID uint, messageID uint) error
+}
+
+type ConversationRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *ConversationRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Conversation{}, models.ConversationParticipant{}, models.Message{})
+}
+
+func (repository *ConversationRepository) GetParticipant(conversationID uint, userID uint) (participant models.ConversationParticipant, err error) {
+	err = repository.DB().Where("conversation_id = ? AND user_id = ?", conversationID, userID).First(&participant).Error
+	return
+}
+
+func (repository *ConversationRepository) GetParticipants(conversationIDs ...uint) (participants []models.ConversationParticipant, err error) {
+	if len(conversationIDs) == 0 {
+		return
+	}
+	err = repository.DB().Where("conversation_id IN ?", conversationIDs).Order("id asc").Find(&participants).Error
+	return
+}
+
+func (repository *ConversationRepository) GetUserConversations(userID uint, pagination scopes.GormPager) (conversations []models.Conversation, totalCount int64, err error) {
+	query := func() *gorm.DB {
+		return repository.DB().Model(&models.Conversation{}).
+			Joins("JOIN conversation_participants ON conversation_participants.conversation_id = conversations.id").
+			Where("conversation_participants.user_id = ?", userID)
+	}
+	if totalCount, err = countPage(pagination, query()); err != nil {
+		return
+	}
+	err = query().Scopes(pagination.ToPaginate()).
+		Order("COALESCE(conversations.last_message_at, conversations.created_at) desc").Order("conversations.id desc").
+		Find(&conversations).Error
+	if err != nil {
+		return
+	}
+	return trimPage(pagination, conversations), totalCount, nil
+}
+
+func (repository *ConversationRepository) GetMessages(conversationID uint, beforeID uint, limit int) (messages []models.Message, err error) {
+	query := repository.DB().Where("conversation_id = ?", conversationID)
+	if beforeID > 0 {
+		query = query.Where("id < ?", beforeID)
+	}
+	err = query.Order("id desc").Limit(limit).Find(&messages).Error
+	return
+}
+
+func (repository *ConversationRepository) GetLatestMessageID(conversationID uint) (messageID uint, err error) {
+	err = repository.DB().Model(&models.Message{}).Where("conversation_id = ?", conversationID).
+		Select("COALESCE(MAX(id), 0)").Scan(&messageID).Error
+	return
+}
+
+func (repository *ConversationRepository) GetUnreadCounts(userID uint, conversationIDs ...uint) (unread []models.ConversationUnread, err error) {
+	query := repository.DB().Model(&models.Message{}).
+		Select("messages.conversation_id, COUNT(*) AS count").
+		Joins("JOIN conversation_participants ON conversation_participants.conversation_id = messages.conversation_id AND conversation_participants.user_id = ?", userID).
+		Where("messages.id > conversation_participants.last_read_message_id AND messages.user_id <> ?", userID)
+	if len(conversationIDs) > 0 {
+		query = query.Where("messages.conversation_id IN ?", conversationIDs)
+	}
+	err = query.Group("messages.conversation_id").Scan(&unread).Error
+	return
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *ConversationRepository) CreateConversation(conversation *models.Conversation, userIDs []uint) error {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(conversation)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return tx.Where("direct_key = ?", conversation.DirectKey).First(conversation).Error
+		}
+		participants := make([]models.ConversationParticipant, 0, len(userIDs))
+		for _, userID := range userIDs {
+			participants = append(participants, models.ConversationParticipant{ConversationID: conversation.ID, UserID: userID})
+		}
+		return tx.Create(&participants).Error
+	})
+}
+
+func (repository *ConversationRepository) CreateMessage(message *models.Message) error {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(message).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Conversation{}).Where("id = ?", message.ConversationID).
+			Updates(map[string]interface{}{"last_message_at": message.CreatedAt}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.ConversationParticipant{}).
+			Where("conversation_id = ? AND user_id = ?", message.ConversationID, message.UserID).
+			Update("last_read_message_id", message.ID).Error
+	})
+}
+
+func (repository *ConversationRepository) MarkRead(conversationID uint, userID uint, messageID uint) error {
+	return repository.DB().Model(&models.ConversationParticipant{}).
+		Where("conversation_id = ? AND user_id = ? AND last_read_message_id < ?", conversationID, userID, messageID).
+		Update("last_read_message_id", messageID).Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *ConversationRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var participants []models.ConversationParticipant
+	if err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&participants).Error; err != nil {
+		return nil, err
+	}
+	var messages []models.Message
+	if err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&messages).Error; err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"conversations": participants, "messages": messages}, nil
+}
+
+// EraseUserData deletes the messages of the user and takes them out of their conversations, the others keep theirs
+func (repository *ConversationRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.Message{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&models.ConversationParticipant{}).Error
+	})
+}
@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type ICouponRepository interface {
+	Migratable
+	Exportable
+
+	GetCoupons() (coupons []models.Coupon, err error)
+	GetCouponByID(ID uint) (models.Coupon, error)
+	GetCouponByCode(code string) (models.Coupon, error)
+	CountRedemptions(couponID uint, userID uint) (count int64, err error)
+
+	// Create
+	Create(coupon *models.Coupon) (err error)
+
+	// Updates
+	Updates(coupon *models.Coupon, updates map[string]interface{}) (err error)
+	Redeem(coupon *models.Coupon, redemption *models.CouponRedemption) (err error)
+}
+
+// CouponRepository holds the coupons and their redemptions, redemptions are billing records and are not erased
+// with the account
+type CouponRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *CouponRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Coupon{}, models.CouponRedemption{})
+}
+
+func (repository *CouponRepository) GetCoupons() (coupons []models.Coupon, err error) {
+	err = repository.DB().Order("id desc").Find(&coupons).Error
+	return
+}
+
+func (repository *CouponRepository) GetCouponByID(ID uint) (coupon models.Coupon, err error) {
+	err = repository.DB().First(&coupon, ID).Error
+	return
+}
+
+func (repository *CouponRepository) GetCouponByCode(code string) (coupon models.Coupon, err error) {
+	err = repository.DB().Where("code = ?", strings.ToUpper(strings.TrimSpace(code))).First(&coupon).Error
+	return
+}
+
+func (repository *CouponRepository) CountRedemptions(couponID uint, userID uint) (count int64, err error) {
+	err = repository.DB().Model(&models.CouponRedemption{}).Where("coupon_id = ? AND user_id = ?", couponID, userID).Count(&count).Error
+	return
+}
+
+/**
+ * Create
+ *
+ */
+
+func (repository *CouponRepository) Create(coupon *models.Coupon) (err error) {
+	return repository.DB().Create(coupon).Error
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *CouponRepository) Updates(coupon *models.Coupon, updates map[string]interface{}) (err error) {
+	return repository.DB().Model(coupon).Updates(updates).Error
+}
+
+// Redeem counts a redemption and records it, the limit is checked by the update itself so concurrent redemptions
+// can not exceed it
+func (repository *CouponRepository) Redeem(coupon *models.Coupon, redemption *models.CouponRedemption) (err error) {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Coupon{}).
+			Where("id = ?", coupon.ID).
+			Where("max_redemptions = 0 OR redemptions < max_redemptions").
+			Update("redemptions", gorm.Expr("redemptions + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		redemption.CouponID = coupon.ID
+		if err := tx.Create(redemption).Error; err != nil {
+			return err
+		}
+		coupon.Redemptions++
+		return nil
+	})
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *CouponRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var redemptions []models.CouponRedemption
+	err = repository.DB().Preload("Coupon").Where("user_id = ?", userID).Order("id asc").Find(&redemptions).Error
+	return redemptions, err
+}
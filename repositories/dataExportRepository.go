@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"time"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IDataExportRepository interface {
+	Migratable
+	Exportable
+	Erasable
+
+	GetDataExportByID(ID uint) (models.DataExport, error)
+	GetDataExportsByUserID(userID uint) (dataExports []models.DataExport, err error)
+	GetExpiredDataExports(now time.Time) (dataExports []models.DataExport, err error)
+
+	// Create & Save & Delete
+	Create(dataExport *models.DataExport) (err error)
+	Save(dataExport *models.DataExport) (err error)
+	Delete(dataExport *models.DataExport) (err error)
+}
+
+type DataExportRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *DataExportRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.DataExport{})
+}
+
+func (repository *DataExportRepository) GetDataExportByID(ID uint) (dataExport models.DataExport, err error) {
+	err = repository.DB().First(&dataExport, ID).Error
+	return
+}
+
+func (repository *DataExportRepository) GetDataExportsByUserID(userID uint) (dataExports []models.DataExport, err error) {
+	err = repository.DB().Where("user_id = ?", userID).Order("id desc").Find(&dataExports).Error
+	return
+}
+
+func (repository *DataExportRepository) GetExpiredDataExports(now time.Time) (dataExports []models.DataExport, err error) {
+	err = repository.DB().Where("expires_at IS NOT NULL AND expires_at <= ?", now).Find(&dataExports).Error
+	return
+}
+
+/**
+ * Create & Save & Delete
+ *
+ */
+
+func (repository *DataExportRepository) Create(dataExport *models.DataExport) (err error) {
+	return repository.DB().Create(dataExport).Error
+}
+
+func (repository *DataExportRepository) Save(dataExport *models.DataExport) (err error) {
+	return repository.DB().Save(dataExport).Error
+}
+
+func (repository *DataExportRepository) Delete(dataExport *models.DataExport) (err error) {
+	return repository.DB().Delete(dataExport).Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *DataExportRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	return repository.GetDataExportsByUserID(userID)
+}
+
+func (repository *DataExportRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Where("user_id = ?", userID).Delete(&models.DataExport{}).Error
+}
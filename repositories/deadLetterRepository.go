@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+)
+
+// DeadLetterFilter narrows the dead letters, the empty fields do not filter
+type DeadLetterFilter struct {
+	Kind   string
+	Source string
+	// Before keeps the dead letters created before it
+	Before *time.Time
+}
+
+// DeadLetterCount is the number of dead letters of a source
+type DeadLetterCount struct {
+	Kind   string
+	Source string
+	Count  int64
+}
+
+type IDeadLetterRepository interface {
+	Migratable
+
+	// Record stores the dead letter, one with the event of a stored one updates it and increments its attempts
+	Record(letter *models.DeadLetter) (err error)
+	// GetDeadLetters returns a page of the dead letters matching the filter, the latest first
+	GetDeadLetters(filter DeadLetterFilter, pagination scopes.GormPager) (letters []models.DeadLetter, totalCount int64, err error)
+	// GetOldestDeadLetters returns up to limit dead letters matching the filter, the oldest first
+	GetOldestDeadLetters(filter DeadLetterFilter, limit int) (letters []models.DeadLetter, err error)
+	GetDeadLetterByID(ID uint) (models.DeadLetter, error)
+	Save(letter *models.DeadLetter) (err error)
+	Delete(letter *models.DeadLetter) (err error)
+	// DeleteByEventID deletes the dead letter of the event, the webhook delivered again by its provider
+	DeleteByEventID(kind string, eventID string) (err error)
+	// Purge deletes the dead letters matching the filter
+	Purge(filter DeadLetterFilter) (deleted int64, err error)
+	// CountBySource counts the dead letters of each kind and source
+	CountBySource() (counts []DeadLetterCount, err error)
+}
+
+type DeadLetterRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *DeadLetterRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.DeadLetter{})
+}
+
+func (repository *DeadLetterRepository) Record(letter *models.DeadLetter) (err error) {
+	if letter.EventID == nil {
+		return repository.DB().Create(letter).Error
+	}
+	return repository.DB().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "kind"}, {Name: "event_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"payload":    letter.Payload,
+			"error":      letter.Error,
+			"attempts":   gorm.Expr("attempts + 1"),
+			"updated_at": time.Now(),
+		}),
+	}).Create(letter).Error
+}
+
+func (repository *DeadLetterRepository) GetDeadLetters(filter DeadLetterFilter, pagination scopes.GormPager) (letters []models.DeadLetter, totalCount int64, err error) {
+	query := func() *gorm.DB {
+		return repository.filter(filter)
+	}
+	if totalCount, err = countPage(pagination, query()); err != nil {
+		return
+	}
+	if err = query().Scopes(pagination.ToPaginate()).Order("id desc").Find(&letters).Error; err != nil {
+		return
+	}
+	return trimPage(pagination, letters), totalCount, nil
+}
+
+func (repository *DeadLetterRepository) GetOldestDeadLetters(filter DeadLetterFilter, limit int) (letters []models.DeadLetter, err error) {
+	err = repository.filter(filter).Order("id asc").Limit(limit).Find(&letters).Error
+	return
+}
+
+func (repository *DeadLetterRepository) GetDeadLetterByID(ID uint) (letter models.DeadLetter, err error) {
+	err = repository.DB().First(&letter, ID).Error
+	return
+}
+
+func (repository *DeadLetterRepository) Save(letter *models.DeadLetter) (err error) {
+	return repository.DB().Save(letter).Error
+}
+
+func (repository *DeadLetterRepository) Delete(letter *models.DeadLetter) (err error) {
+	return repository.DB().Delete(letter).Error
+}
+
+func (repository *DeadLetterRepository) DeleteByEventID(kind string, eventID string) (err error) {
+	return repository.DB().Where("kind = ? AND event_id = ?", kind, eventID).Delete(&models.DeadLetter{}).Error
+}
+
+func (repository *DeadLetterRepository) Purge(filter DeadLetterFilter) (deleted int64, err error) {
+	// a delete without conditions is refused by gorm
+	result := repository.filter(filter).Where("1 = 1").Delete(&models.DeadLetter{})
+	return result.RowsAffected, result.Error
+}
+
+func (repository *DeadLetterRepository) CountBySource() (counts []DeadLetterCount, err error) {
+	err = repository.DB().Model(&models.DeadLetter{}).
+		Select("kind, source, COUNT(*) AS count").
+		Group("kind, source").Scan(&counts).Error
+	return
+}
+
+func (repository *DeadLetterRepository) filter(filter DeadLetterFilter) *gorm.DB {
+	db := repository.DB().Model(&models.DeadLetter{})
+	if filter.Kind != "" {
+		db = db.Where("kind = ?", filter.Kind)
+	}
+	if filter.Source != "" {
+		db = db.Where("source = ?", filter.Source)
+	}
+	if filter.Before != nil {
+		db = db.Where("created_at < ?", *filter.Before)
+	}
+	return db
+}
@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IDeprecationRepository interface {
+	Migratable
+
+	// Increment counts a call of the caller to the route at now
+	Increment(route string, caller string, now time.Time) (err error)
+	// GetCalls returns the callers of the routes called since, the latest first
+	GetCalls(routes []string, since time.Time) (calls []models.DeprecatedCall, err error)
+}
+
+type DeprecationRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *DeprecationRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.DeprecatedCall{})
+}
+
+func (repository *DeprecationRepository) Increment(route string, caller string, now time.Time) (err error) {
+	return repository.DB().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "route"}, {Name: "caller"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"calls": gorm.Expr("calls + 1"), "last_called_at": now, "updated_at": now}),
+	}).Create(&models.DeprecatedCall{Route: route, Caller: caller, Calls: 1, LastCalledAt: now}).Error
+}
+
+func (repository *DeprecationRepository) GetCalls(routes []string, since time.Time) (calls []models.DeprecatedCall, err error) {
+	err = repository.DB().Where("route IN ? AND last_called_at >= ?", routes, since).Order("last_called_at desc").Find(&calls).Error
+	return
+}
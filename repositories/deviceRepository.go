@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IDeviceRepository interface {
+	Migratable
+	Exportable
+	Erasable
+
+	GetDeviceByID(ID uint) (device models.Device, err error)
+	GetUserDevices(userID uint) (devices []models.Device, err error)
+	GetOptOuts(userID uint) (categories []string, err error)
+
+	// Updates
+	// Register stores the token for the user, a token already registered moves to the user
+	Register(device *models.Device) error
+	Delete(device *models.Device) error
+	DeleteByToken(token string) error
+	Touch(deviceID uint, at time.Time) error
+	// SetOptOuts replaces the categories the user opted out of
+	SetOptOuts(userID uint, categories []string) error
+}
+
+type DeviceRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *DeviceRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Device{}, models.PushOptOut{})
+}
+
+func (repository *DeviceRepository) GetDeviceByID(ID uint) (device models.Device, err error) {
+	err = repository.DB().First(&device, ID).Error
+	return
+}
+
+func (repository *DeviceRepository) GetUserDevices(userID uint) (devices []models.Device, err error) {
+	err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&devices).Error
+	return
+}
+
+func (repository *DeviceRepository) GetOptOuts(userID uint) (categories []string, err error) {
+	err = repository.DB().Model(&models.PushOptOut{}).Where("user_id = ?", userID).Order("category asc").Pluck("category", &categories).Error
+	return
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *DeviceRepository) Register(device *models.Device) error {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		var existing models.Device
+		err := tx.Where("token = ?", device.Token).First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return tx.Create(device).Error
+		}
+		if err != nil {
+			return err
+		}
+		if err = tx.Model(&existing).Updates(map[string]interface{}{"user_id": device.UserID, "platform": device.Platform}).Error; err != nil {
+			return err
+		}
+		*device = existing
+		return nil
+	})
+}
+
+func (repository *DeviceRepository) Delete(device *models.Device) error {
+	return repository.DB().Delete(device).Error
+}
+
+func (repository *DeviceRepository) DeleteByToken(token string) error {
+	return repository.DB().Where("token = ?", token).Delete(&models.Device{}).Error
+}
+
+func (repository *DeviceRepository) Touch(deviceID uint, at time.Time) error {
+	return repository.DB().Model(&models.Device{}).Where("id = ?", deviceID).Update("last_push_at", at).Error
+}
+
+func (repository *DeviceRepository) SetOptOuts(userID uint, categories []string) error {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.PushOptOut{}).Error; err != nil {
+			return err
+		}
+		if len(categories) == 0 {
+			return nil
+		}
+		optOuts := make([]models.PushOptOut, 0, len(categories))
+		for _, category := range categories {
+			optOuts = append(optOuts, models.PushOptOut{UserID: userID, Category: category})
+		}
+		return tx.Create(&optOuts).Error
+	})
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *DeviceRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var devices []models.Device
+	if err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&devices).Error; err != nil {
+		return nil, err
+	}
+	var optOuts []models.PushOptOut
+	if err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&optOuts).Error; err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"devices": devices, "push_opt_outs": optOuts}, nil
+}
+
+func (repository *DeviceRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.PushOptOut{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&models.Device{}).Error
+	})
+}
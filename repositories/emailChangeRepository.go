@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IEmailChangeRepository interface {
+	Migratable
+	Exportable
+	Erasable
+
+	GetEmailChangeByTokenHash(tokenHash string) (models.EmailChange, error)
+
+	// Create
+	Create(emailChange *models.EmailChange) (err error)
+
+	// Updates
+	Confirm(emailChange *models.EmailChange, now time.Time) (err error)
+
+	// Deletes
+	DeletePending(userID uint) (err error)
+}
+
+type EmailChangeRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *EmailChangeRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.EmailChange{})
+}
+
+func (repository *EmailChangeRepository) GetEmailChangeByTokenHash(tokenHash string) (emailChange models.EmailChange, err error) {
+	err = repository.DB().Where(models.EmailChange{TokenHash: tokenHash}).First(&emailChange).Error
+	return
+}
+
+/**
+ * Create
+ *
+ */
+
+func (repository *EmailChangeRepository) Create(emailChange *models.EmailChange) (err error) {
+	return repository.DB().Create(emailChange).Error
+}
+
+/**
+ * Updates
+ *
+ */
+
+// Confirm marks the change as confirmed, the update only matches a pending change so a token can not be used twice
+func (repository *EmailChangeRepository) Confirm(emailChange *models.EmailChange, now time.Time) (err error) {
+	result := repository.DB().Model(&models.EmailChange{}).
+		Where("id = ? AND confirmed_at IS NULL AND expires_at > ?", emailChange.ID, now).
+		Update("confirmed_at", now)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	emailChange.ConfirmedAt = &now
+	return nil
+}
+
+/**
+ * Deletes
+ *
+ */
+
+// DeletePending drops the unconfirmed changes of the user, a new request replaces the previous one
+func (repository *EmailChangeRepository) DeletePending(userID uint) (err error) {
+	return repository.DB().Where("user_id = ? AND confirmed_at IS NULL", userID).Delete(&models.EmailChange{}).Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *EmailChangeRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var emailChanges []models.EmailChange
+	err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&emailChanges).Error
+	return emailChanges, err
+}
+
+func (repository *EmailChangeRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Where("user_id = ?", userID).Delete(&models.EmailChange{}).Error
+}
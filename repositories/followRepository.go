@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+)
+
+type IFollowRepository interface {
+	Migratable
+	Exportable
+	Erasable
+
+	IsFollowing(followerID uint, followedID uint) (bool, error)
+	CountFollowers(userID uint) (count int64, err error)
+	CountFollowing(userID uint) (count int64, err error)
+	// GetFollowers returns a page of the users following the user, the latest followers first
+	GetFollowers(userID uint, pagination scopes.GormPager) (users []models.User, totalCount int64, err error)
+	// GetFollowing returns a page of the users the user follows, the latest followed first
+	GetFollowing(userID uint, pagination scopes.GormPager) (users []models.User, totalCount int64, err error)
+
+	// Updates
+	// Follow returns false when the user already followed
+	Follow(followerID uint, followedID uint) (created bool, err error)
+	// Unfollow returns false when the user did not follow
+	Unfollow(followerID uint, followedID uint) (deleted bool, err error)
+}
+
+type FollowRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *FollowRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Follow{})
+}
+
+func (repository *FollowRepository) IsFollowing(followerID uint, followedID uint) (bool, error) {
+	var count int64
+	err := repository.DB().Model(&models.Follow{}).Where("follower_id = ? AND followed_id = ?", followerID, followedID).Count(&count).Error
+	return count > 0, err
+}
+
+func (repository *FollowRepository) CountFollowers(userID uint) (count int64, err error) {
+	err = repository.DB().Model(&models.Follow{}).Where("followed_id = ?", userID).Count(&count).Error
+	return
+}
+
+func (repository *FollowRepository) CountFollowing(userID uint) (count int64, err error) {
+	err = repository.DB().Model(&models.Follow{}).Where("follower_id = ?", userID).Count(&count).Error
+	return
+}
+
+func (repository *FollowRepository) GetFollowers(userID uint, pagination scopes.GormPager) (users []models.User, totalCount int64, err error) {
+	return repository.users("follows.follower_id", "follows.followed_id", userID, pagination)
+}
+
+func (repository *FollowRepository) GetFollowing(userID uint, pagination scopes.GormPager) (users []models.User, totalCount int64, err error) {
+	return repository.users("follows.followed_id", "follows.follower_id", userID, pagination)
+}
+
+// users lists the users on one side of the follows of the user on the other side
+func (repository *FollowRepository) users(userColumn string, ofColumn string, userID uint, pagination scopes.GormPager) (users []models.User, totalCount int64, err error) {
+	query := func() *gorm.DB {
+		return repository.DB().Model(&models.User{}).Joins("JOIN follows ON "+userColumn+" = users.id").Where(ofColumn+" = ?", userID)
+	}
+	if totalCount, err = countPage(pagination, query()); err != nil {
+		return
+	}
+	if err = query().Scopes(pagination.ToPaginate()).Order("follows.id desc").Find(&users).Error; err != nil {
+		return
+	}
+	return trimPage(pagination, users), totalCount, nil
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *FollowRepository) Follow(followerID uint, followedID uint) (created bool, err error) {
+	result := repository.DB().Clauses(clause.OnConflict{DoNothing: true}).Create(&models.Follow{FollowerID: followerID, FollowedID: followedID})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (repository *FollowRepository) Unfollow(followerID uint, followedID uint) (deleted bool, err error) {
+	result := repository.DB().Where("follower_id = ? AND followed_id = ?", followerID, followedID).Delete(&models.Follow{})
+	return result.RowsAffected > 0, result.Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *FollowRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var follows []models.Follow
+	err = repository.DB().Where("follower_id = ? OR followed_id = ?", userID, userID).Order("id asc").Find(&follows).Error
+	return follows, err
+}
+
+func (repository *FollowRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Where("follower_id = ? OR followed_id = ?", userID, userID).Delete(&models.Follow{}).Error
+}
@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IInvitationRepository interface {
+	Migratable
+
+	GetInvitations() (invitations []models.Invitation, err error)
+	GetInvitationByID(ID uint) (models.Invitation, error)
+	GetInvitationByCode(code string) (models.Invitation, error)
+
+	// Create
+	Create(invitation *models.Invitation) (err error)
+
+	// Updates
+	Revoke(invitation *models.Invitation, now time.Time) (err error)
+	Redeem(invitation *models.Invitation, now time.Time) (err error)
+	// Release gives back a use of the invitation, the one of a registration undone
+	Release(invitationID uint) (err error)
+}
+
+type InvitationRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *InvitationRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Invitation{})
+}
+
+func (repository *InvitationRepository) GetInvitations() (invitations []models.Invitation, err error) {
+	err = repository.DB().Order("id desc").Find(&invitations).Error
+	return
+}
+
+func (repository *InvitationRepository) GetInvitationByID(ID uint) (invitation models.Invitation, err error) {
+	err = repository.DB().First(&invitation, ID).Error
+	return
+}
+
+func (repository *InvitationRepository) GetInvitationByCode(code string) (invitation models.Invitation, err error) {
+	err = repository.DB().Where(models.Invitation{Code: strings.TrimSpace(code)}).First(&invitation).Error
+	return
+}
+
+/**
+ * Create
+ *
+ */
+
+func (repository *InvitationRepository) Create(invitation *models.Invitation) (err error) {
+	return repository.DB().Create(invitation).Error
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *InvitationRepository) Revoke(invitation *models.Invitation, now time.Time) (err error) {
+	invitation.RevokedAt = &now
+	return repository.DB().Model(invitation).Update("revoked_at", now).Error
+}
+
+// Redeem counts a use, the conditions are checked by the update itself so concurrent registrations can not exceed the limit
+func (repository *InvitationRepository) Redeem(invitation *models.Invitation, now time.Time) (err error) {
+	result := repository.DB().Model(&models.Invitation{}).
+		Where("id = ? AND revoked_at IS NULL", invitation.ID).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Where("max_uses = 0 OR uses < max_uses").
+		Update("uses", gorm.Expr("uses + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	invitation.Uses++
+	return nil
+}
+
+func (repository *InvitationRepository) Release(invitationID uint) (err error) {
+	return repository.DB().Model(&models.Invitation{}).
+		Where("id = ? AND uses > 0", invitationID).
+		Update("uses", gorm.Expr("uses - 1")).Error
+}
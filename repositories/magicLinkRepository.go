@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IMagicLinkRepository interface {
+	Migratable
+	Erasable
+
+	GetMagicLinkByTokenHash(tokenHash string) (models.MagicLink, error)
+
+	// Create
+	Create(magicLink *models.MagicLink) (err error)
+
+	// Updates
+	Use(magicLink *models.MagicLink, now time.Time) (err error)
+	// Invalidate marks every unused link of the user as used
+	Invalidate(userID uint, now time.Time) (err error)
+}
+
+type MagicLinkRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *MagicLinkRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.MagicLink{})
+}
+
+func (repository *MagicLinkRepository) GetMagicLinkByTokenHash(tokenHash string) (magicLink models.MagicLink, err error) {
+	err = repository.DB().Where(models.MagicLink{TokenHash: tokenHash}).First(&magicLink).Error
+	return
+}
+
+/**
+ * Create
+ *
+ */
+
+func (repository *MagicLinkRepository) Create(magicLink *models.MagicLink) (err error) {
+	return repository.DB().Create(magicLink).Error
+}
+
+/**
+ * Updates
+ *
+ */
+
+// Use marks the link as used, the update only matches a usable link so it can not be exchanged twice
+func (repository *MagicLinkRepository) Use(magicLink *models.MagicLink, now time.Time) (err error) {
+	result := repository.DB().Model(&models.MagicLink{}).
+		Where("id = ? AND used_at IS NULL AND expires_at > ?", magicLink.ID, now).
+		Update("used_at", now)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	magicLink.UsedAt = &now
+	return nil
+}
+
+func (repository *MagicLinkRepository) Invalidate(userID uint, now time.Time) (err error) {
+	return repository.DB().Model(&models.MagicLink{}).Where("user_id = ? AND used_at IS NULL", userID).Update("used_at", now).Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *MagicLinkRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Where("user_id = ?", userID).Delete(&models.MagicLink{}).Error
+}
@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/models"
+	"gotham/models/scopes"
+)
+
+type IMediaRepository interface {
+	Migratable
+	Exportable
+	StreamExportable
+	Erasable
+	IBaseRepository[models.Media]
+
+	GetMediaByID(ID uint) (models.Media, error)
+	GetMediaByUserID(userID uint) (media []models.Media, err error)
+	GetUserMediaWithPagination(userID uint, pagination scopes.GormPager) (media []models.Media, totalCount int64, err error)
+	// GetOrphans returns the media never attached, or detached, before the time
+	GetOrphans(before time.Time, limit int) (media []models.Media, err error)
+
+	// Usage
+	GetUsage(filters ...func(db *gorm.DB) *gorm.DB) (usage models.MediaUsage, err error)
+	GetUsageByUser(limit int) (usages []models.MediaUserUsage, err error)
+	GetUsageByMime() (usages []models.MediaMimeUsage, err error)
+
+	// Updates
+	Updates(media *models.Media, updates map[string]interface{}) (err error)
+}
+
+type MediaRepository struct {
+	BaseRepository[models.Media]
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *MediaRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Media{})
+}
+
+func (repository *MediaRepository) GetMediaByID(ID uint) (media models.Media, err error) {
+	err = repository.DB().First(&media, ID).Error
+	return
+}
+
+func (repository *MediaRepository) GetMediaByUserID(userID uint) (media []models.Media, err error) {
+	err = repository.DB().Where("user_id = ?", userID).Find(&media).Error
+	return
+}
+
+func (repository *MediaRepository) GetUserMediaWithPagination(userID uint, pagination scopes.GormPager) (media []models.Media, totalCount int64, err error) {
+	return repository.List(pagination, func(db *gorm.DB) *gorm.DB {
+		return db.Where("user_id = ?", userID).Order("id desc")
+	})
+}
+
+func (repository *MediaRepository) GetOrphans(before time.Time, limit int) (media []models.Media, err error) {
+	err = repository.DB().Scopes(Orphaned).Where("updated_at < ?", before).Order("id asc").Limit(limit).Find(&media).Error
+	return
+}
+
+// Orphaned keeps the media not attached to anything
+func Orphaned(db *gorm.DB) *gorm.DB {
+	return db.Where("attachable_type IS NULL")
+}
+
+/**
+ * Usage
+ *
+ */
+
+func (repository *MediaRepository) GetUsage(filters ...func(db *gorm.DB) *gorm.DB) (usage models.MediaUsage, err error) {
+	err = repository.DB().Model(&models.Media{}).Scopes(filters...).
+		Select("COUNT(*) AS files, COALESCE(SUM(size), 0) AS bytes").Scan(&usage).Error
+	return
+}
+
+func (repository *MediaRepository) GetUsageByUser(limit int) (usages []models.MediaUserUsage, err error) {
+	err = repository.DB().Model(&models.Media{}).
+		Select("user_id, COUNT(*) AS files, COALESCE(SUM(size), 0) AS bytes").
+		Group("user_id").Order("bytes desc").Limit(limit).Scan(&usages).Error
+	return
+}
+
+func (repository *MediaRepository) GetUsageByMime() (usages []models.MediaMimeUsage, err error) {
+	err = repository.DB().Model(&models.Media{}).
+		Select("mime, COUNT(*) AS files, COALESCE(SUM(size), 0) AS bytes").
+		Group("mime").Order("bytes desc").Scan(&usages).Error
+	return
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *MediaRepository) Updates(media *models.Media, updates map[string]interface{}) (err error) {
+	return repository.DB().Model(media).Updates(updates).Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *MediaRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var media []models.Media
+	err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&media).Error
+	return media, err
+}
+
+func (repository *MediaRepository) StreamUserData(ctx context.Context, userID uint, write func(record interface{}) error) (err error) {
+	return repository.FindEach(ctx, exportBatchSize, func(media []models.Media) error {
+		for _, medium := range media {
+			if err := write(medium); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(db *gorm.DB) *gorm.DB {
+		return db.Where("user_id = ?", userID)
+	})
+}
+
+// EraseUserData removes the media rows of the user, the privacy service deletes their files first
+func (repository *MediaRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Where("user_id = ?", userID).Delete(&models.Media{}).Error
+}
@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"time"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IPolicyRepository interface {
+	Migratable
+	Seedable
+	Exportable
+	Erasable
+
+	GetPolicyByID(ID uint) (models.Policy, error)
+	GetCurrentPolicies(now time.Time) (policies []models.Policy, err error)
+	GetAcceptedPolicyIDs(userID uint, policyIDs []uint) (acceptedIDs []uint, err error)
+
+	// Create
+	Create(policy *models.Policy) (err error)
+	CreateConsent(consent *models.Consent) (err error)
+}
+
+type PolicyRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Seed
+ *
+ * @return error
+ */
+func (repository *PolicyRepository) Seed() (err error) {
+	for _, policyType := range []string{"terms", "privacy"} {
+		policy := models.Policy{
+			Type:        policyType,
+			Version:     "1.0",
+			PublishedAt: time.Now(),
+		}
+		if err = repository.DB().Where(models.Policy{Type: policy.Type, Version: policy.Version}).FirstOrCreate(&policy).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *PolicyRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Policy{}, models.Consent{})
+}
+
+func (repository *PolicyRepository) GetPolicyByID(ID uint) (policy models.Policy, err error) {
+	err = repository.DB().First(&policy, ID).Error
+	return
+}
+
+// GetCurrentPolicies returns the latest published version of every policy type
+func (repository *PolicyRepository) GetCurrentPolicies(now time.Time) (policies []models.Policy, err error) {
+	var published []models.Policy
+	err = repository.DB().Where("published_at <= ?", now).Order("type asc, published_at desc, id desc").Find(&published).Error
+	for _, policy := range published {
+		if len(policies) == 0 || policies[len(policies)-1].Type != policy.Type {
+			policies = append(policies, policy)
+		}
+	}
+	return
+}
+
+func (repository *PolicyRepository) GetAcceptedPolicyIDs(userID uint, policyIDs []uint) (acceptedIDs []uint, err error) {
+	err = repository.DB().Model(&models.Consent{}).Where("user_id = ? AND policy_id IN ?", userID, policyIDs).Pluck("policy_id", &acceptedIDs).Error
+	return
+}
+
+/**
+ * Create
+ *
+ */
+
+func (repository *PolicyRepository) Create(policy *models.Policy) (err error) {
+	return repository.DB().Create(policy).Error
+}
+
+func (repository *PolicyRepository) CreateConsent(consent *models.Consent) (err error) {
+	return repository.DB().Where(models.Consent{UserID: consent.UserID, PolicyID: consent.PolicyID}).FirstOrCreate(consent).Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *PolicyRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var consents []models.Consent
+	err = repository.DB().Preload("Policy").Where("user_id = ?", userID).Find(&consents).Error
+	return consents, err
+}
+
+func (repository *PolicyRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Where("user_id = ?", userID).Delete(&models.Consent{}).Error
+}
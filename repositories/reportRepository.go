@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/models"
+	"gotham/models/scopes"
+)
+
+type IReportRepository interface {
+	Migratable
+	Exportable
+	Erasable
+	IBaseRepository[models.Report]
+
+	GetReportByID(ID uint) (models.Report, error)
+	// GetQueue returns a page of the reports with the status, oldest first
+	GetQueue(status string, pagination scopes.GormPager) (reports []models.Report, totalCount int64, err error)
+	HasOpenReport(reporterID uint, reportableType string, reportableID uint) (bool, error)
+	// CountUpheld counts the reports against the offender resolved since the time
+	CountUpheld(offenderID uint, since time.Time) (count int64, err error)
+
+	// Updates
+	// Close sets the updates on an open report, false when it was closed meanwhile
+	Close(report *models.Report, updates map[string]interface{}) (closed bool, err error)
+}
+
+type ReportRepository struct {
+	BaseRepository[models.Report]
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *ReportRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Report{})
+}
+
+func (repository *ReportRepository) GetReportByID(ID uint) (report models.Report, err error) {
+	err = repository.DB().First(&report, ID).Error
+	return
+}
+
+func (repository *ReportRepository) GetQueue(status string, pagination scopes.GormPager) (reports []models.Report, totalCount int64, err error) {
+	return repository.List(pagination, func(db *gorm.DB) *gorm.DB {
+		return db.Where("status = ?", status).Order("id asc")
+	})
+}
+
+func (repository *ReportRepository) HasOpenReport(reporterID uint, reportableType string, reportableID uint) (bool, error) {
+	return repository.Exists(func(db *gorm.DB) *gorm.DB {
+		return db.Where("reporter_id = ? AND reportable_type = ? AND reportable_id = ? AND status = ?", reporterID, reportableType, reportableID, models.ReportOpen)
+	})
+}
+
+func (repository *ReportRepository) CountUpheld(offenderID uint, since time.Time) (count int64, err error) {
+	return repository.Count(func(db *gorm.DB) *gorm.DB {
+		return db.Where("offender_id = ? AND status = ? AND resolved_at >= ?", offenderID, models.ReportResolved, since)
+	})
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *ReportRepository) Close(report *models.Report, updates map[string]interface{}) (closed bool, err error) {
+	result := repository.DB().Model(report).Where("status = ?", models.ReportOpen).Updates(updates)
+	return result.RowsAffected > 0, result.Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *ReportRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var reports []models.Report
+	err = repository.DB().Where("reporter_id = ?", userID).Order("id asc").Find(&reports).Error
+	return reports, err
+}
+
+// EraseUserData removes the reports of and against the user, closed reports filed by the user stay without their
+// reporter so the offenses of others still count
+func (repository *ReportRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("offender_id = ? OR (reporter_id = ? AND status = ?)", userID, userID, models.ReportOpen).Delete(&models.Report{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Report{}).Where("reporter_id = ?", userID).Update("reporter_id", 0).Error
+	})
+}
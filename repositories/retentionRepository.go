@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"gotham/infrastructures"
+)
+
+type IRetentionRepository interface {
+	// Expired returns up to limit rows of the model whose column is before the time, in the order of their ids
+	Expired(model interface{}, column string, before time.Time, limit int) (rows []map[string]interface{}, err error)
+	// DeleteByIDs deletes the rows of the model for good, soft deletes included
+	DeleteByIDs(model interface{}, IDs []interface{}) (deleted int64, err error)
+}
+
+type RetentionRepository struct {
+	infrastructures.IGormDatabase
+}
+
+func (repository *RetentionRepository) Expired(model interface{}, column string, before time.Time, limit int) (rows []map[string]interface{}, err error) {
+	err = repository.DB().Model(model).Unscoped().
+		Where(clause.Lt{Column: clause.Column{Name: column}, Value: before}).
+		Order("id asc").Limit(limit).Find(&rows).Error
+	return
+}
+
+func (repository *RetentionRepository) DeleteByIDs(model interface{}, IDs []interface{}) (deleted int64, err error) {
+	if len(IDs) == 0 {
+		return 0, nil
+	}
+	result := repository.DB().Unscoped().Where("id IN ?", IDs).Delete(model)
+	return result.RowsAffected, result.Error
+}
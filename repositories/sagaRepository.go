@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type ISagaRepository interface {
+	Migratable
+
+	Create(saga *models.Saga) (err error)
+	// Save writes the progress of the saga
+	Save(saga *models.Saga) (err error)
+	// GetStalledSagas returns up to limit running or compensating sagas not saved since before, the oldest first
+	GetStalledSagas(before time.Time, limit int) (sagas []models.Saga, err error)
+	// Claim increments the attempts of the saga unless another instance did since it was read, false then
+	Claim(saga *models.Saga) (claimed bool, err error)
+}
+
+type SagaRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *SagaRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Saga{})
+}
+
+func (repository *SagaRepository) Create(saga *models.Saga) (err error) {
+	return repository.DB().Create(saga).Error
+}
+
+func (repository *SagaRepository) Save(saga *models.Saga) (err error) {
+	return repository.DB().Save(saga).Error
+}
+
+func (repository *SagaRepository) GetStalledSagas(before time.Time, limit int) (sagas []models.Saga, err error) {
+	err = repository.DB().
+		Where("status IN ? AND updated_at < ?", []string{models.SagaRunning, models.SagaCompensating}, before).
+		Order("updated_at asc").Limit(limit).Find(&sagas).Error
+	return
+}
+
+func (repository *SagaRepository) Claim(saga *models.Saga) (claimed bool, err error) {
+	result := repository.DB().Model(&models.Saga{}).
+		Where("id = ? AND attempts = ?", saga.ID, saga.Attempts).
+		Updates(map[string]interface{}{"attempts": gorm.Expr("attempts + 1"), "updated_at": time.Now()})
+	if result.Error != nil || result.RowsAffected == 0 {
+		return false, result.Error
+	}
+	saga.Attempts++
+	return true, nil
+}
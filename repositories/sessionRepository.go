@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type ISessionRepository interface {
+	Migratable
+	Exportable
+	Erasable
+
+	GetSessionByID(ID uint) (models.Session, error)
+
+	// Create
+	Create(session *models.Session) (err error)
+
+	// Updates
+	Rotate(session *models.Session, tokenHash string, expiresAt time.Time, now time.Time) (err error)
+	Revoke(session *models.Session, now time.Time) (err error)
+	RevokeAll(userID uint, now time.Time) (err error)
+}
+
+type SessionRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *SessionRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Session{})
+}
+
+func (repository *SessionRepository) GetSessionByID(ID uint) (session models.Session, err error) {
+	err = repository.DB().First(&session, ID).Error
+	return
+}
+
+/**
+ * Create
+ *
+ */
+
+func (repository *SessionRepository) Create(session *models.Session) (err error) {
+	return repository.DB().Create(session).Error
+}
+
+/**
+ * Updates
+ *
+ */
+
+// Rotate replaces the token, the update only matches the token the session was loaded with so two refreshes
+// racing with the same token can not both succeed
+func (repository *SessionRepository) Rotate(session *models.Session, tokenHash string, expiresAt time.Time, now time.Time) (err error) {
+	result := repository.DB().Model(&models.Session{}).
+		Where("id = ? AND token_hash = ? AND revoked_at IS NULL", session.ID, session.TokenHash).
+		Updates(map[string]interface{}{
+			"token_hash":          tokenHash,
+			"previous_token_hash": session.TokenHash,
+			"expires_at":          expiresAt,
+			"last_used_at":        now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	previous := session.TokenHash
+	session.PreviousTokenHash, session.TokenHash, session.ExpiresAt, session.LastUsedAt = &previous, tokenHash, expiresAt, now
+	return nil
+}
+
+func (repository *SessionRepository) Revoke(session *models.Session, now time.Time) (err error) {
+	session.RevokedAt = &now
+	return repository.DB().Model(session).Update("revoked_at", now).Error
+}
+
+func (repository *SessionRepository) RevokeAll(userID uint, now time.Time) (err error) {
+	return repository.DB().Model(&models.Session{}).Where("user_id = ? AND revoked_at IS NULL", userID).Update("revoked_at", now).Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *SessionRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var sessions []models.Session
+	err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&sessions).Error
+	return sessions, err
+}
+
+func (repository *SessionRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Where("user_id = ?", userID).Delete(&models.Session{}).Error
+}
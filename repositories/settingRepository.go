@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type ISettingRepository interface {
+	Migratable
+	Seedable
+
+	GetSettings() (settings []models.Setting, err error)
+	GetSettingByKey(key string) (models.Setting, error)
+
+	// Update
+	Save(setting *models.Setting) (err error)
+}
+
+type SettingRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Seed
+ *
+ * @return error
+ */
+func (repository *SettingRepository) Seed() (err error) {
+	for _, setting := range models.DefaultSettings {
+		if err = repository.DB().Where(models.Setting{Key: setting.Key}).FirstOrCreate(&setting).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *SettingRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Setting{})
+}
+
+func (repository *SettingRepository) GetSettings() (settings []models.Setting, err error) {
+	err = repository.DB().Find(&settings).Error
+	return
+}
+
+func (repository *SettingRepository) GetSettingByKey(key string) (setting models.Setting, err error) {
+	err = repository.DB().Where(models.Setting{Key: key}).First(&setting).Error
+	return
+}
+
+/**
+ * Update
+ *
+ */
+
+func (repository *SettingRepository) Save(setting *models.Setting) (err error) {
+	return repository.DB().Save(setting).Error
+}
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/models"
+)
+
+type ITagRepository interface {
+	Migratable
+	Erasable
+	IBaseRepository[models.Tag]
+
+	// FindOrCreateTags returns the tags by slug, creating the missing ones with their name
+	FindOrCreateTags(tags []models.Tag) (found []models.Tag, err error)
+	GetTagsBySlug(slugs []string) (tags []models.Tag, err error)
+	GetTags(taggableType string, taggableID uint) (tags []models.Tag, err error)
+	// GetPopularTags returns the tags attached to the most taggables of the type, of every type when it is empty
+	GetPopularTags(taggableType string, limit int) (tags []models.PopularTag, err error)
+
+	// Updates
+	Attach(taggableType string, taggableID uint, tagIDs []uint) (err error)
+	Detach(taggableType string, taggableID uint, tagIDs []uint) (err error)
+	// Sync leaves the taggable with exactly the tags
+	Sync(taggableType string, taggableID uint, tagIDs []uint) (err error)
+}
+
+type TagRepository struct {
+	BaseRepository[models.Tag]
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *TagRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Tag{}, models.Taggable{})
+}
+
+func (repository *TagRepository) FindOrCreateTags(tags []models.Tag) (found []models.Tag, err error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	// a tag created meanwhile by another request is skipped by the conflict clause and read below
+	if err = repository.DB().Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error; err != nil {
+		return nil, err
+	}
+	slugs := make([]string, len(tags))
+	for i := range tags {
+		slugs[i] = tags[i].Slug
+	}
+	return repository.GetTagsBySlug(slugs)
+}
+
+func (repository *TagRepository) GetTagsBySlug(slugs []string) (tags []models.Tag, err error) {
+	err = repository.DB().Where("slug IN ?", slugs).Order("name asc").Find(&tags).Error
+	return
+}
+
+func (repository *TagRepository) GetTags(taggableType string, taggableID uint) (tags []models.Tag, err error) {
+	err = repository.DB().Joins("JOIN taggables ON taggables.tag_id = tags.id").
+		Where("taggables.taggable_type = ? AND taggables.taggable_id = ?", taggableType, taggableID).
+		Order("tags.name asc").Find(&tags).Error
+	return
+}
+
+func (repository *TagRepository) GetPopularTags(taggableType string, limit int) (tags []models.PopularTag, err error) {
+	query := repository.DB().Model(&models.Tag{}).
+		Select("tags.*, COUNT(taggables.id) AS count").
+		Joins("JOIN taggables ON taggables.tag_id = tags.id")
+	if taggableType != "" {
+		query = query.Where("taggables.taggable_type = ?", taggableType)
+	}
+	err = query.Group("tags.id").Order("count desc").Order("tags.name asc").Limit(limit).Scan(&tags).Error
+	return
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *TagRepository) Attach(taggableType string, taggableID uint, tagIDs []uint) (err error) {
+	return attach(repository.DB(), taggableType, taggableID, tagIDs)
+}
+
+func attach(db *gorm.DB, taggableType string, taggableID uint, tagIDs []uint) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	taggables := make([]models.Taggable, len(tagIDs))
+	for i, tagID := range tagIDs {
+		taggables[i] = models.Taggable{TagID: tagID, TaggableType: taggableType, TaggableID: taggableID}
+	}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&taggables).Error
+}
+
+// Delete deletes the tag and detaches it from every taggable
+func (repository *TagRepository) Delete(tag *models.Tag) (err error) {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", tag.ID).Delete(&models.Taggable{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(tag).Error
+	})
+}
+
+func (repository *TagRepository) Detach(taggableType string, taggableID uint, tagIDs []uint) (err error) {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	return repository.DB().Where("taggable_type = ? AND taggable_id = ? AND tag_id IN ?", taggableType, taggableID, tagIDs).Delete(&models.Taggable{}).Error
+}
+
+func (repository *TagRepository) Sync(taggableType string, taggableID uint, tagIDs []uint) (err error) {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		query := tx.Where("taggable_type = ? AND taggable_id = ?", taggableType, taggableID)
+		if len(tagIDs) > 0 {
+			query = query.Where("tag_id NOT IN ?", tagIDs)
+		}
+		if err := query.Delete(&models.Taggable{}).Error; err != nil {
+			return err
+		}
+		return attach(tx, taggableType, taggableID, tagIDs)
+	})
+}
+
+/**
+ * Privacy
+ *
+ */
+
+// EraseUserData detaches the tags of the user, the tags stay for the other taggables
+func (repository *TagRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Where("taggable_type = ? AND taggable_id = ?", "users", userID).Delete(&models.Taggable{}).Error
+}
@@ -0,0 +1,153 @@
+package transactions
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/repositories"
+)
+
+/**
+ * RepoSet
+ * every repository bound to the same database handle
+ */
+type RepoSet struct {
+	Users         repositories.IUserRepository
+	DataExports   repositories.IDataExportRepository
+	Policies      repositories.IPolicyRepository
+	Invitations   repositories.IInvitationRepository
+	Usages        repositories.IUsageRepository
+	EmailChanges  repositories.IEmailChangeRepository
+	MagicLinks    repositories.IMagicLinkRepository
+	Sessions      repositories.ISessionRepository
+	Votes         repositories.IVoteRepository
+	Comments      repositories.ICommentRepository
+	Reports       repositories.IReportRepository
+	Tags          repositories.ITagRepository
+	Media         repositories.IMediaRepository
+	Follows       repositories.IFollowRepository
+	Conversations repositories.IConversationRepository
+	Devices       repositories.IDeviceRepository
+	Announcements repositories.IAnnouncementRepository
+	UserVersions  repositories.IUserVersionRepository
+	Sagas         repositories.ISagaRepository
+
+	database infrastructures.IGormDatabase
+}
+
+func NewRepoSet(gormDatabase infrastructures.IGormDatabase) RepoSet {
+	return RepoSet{
+		Users:         &repositories.UserRepository{BaseRepository: repositories.BaseRepository[models.User]{IGormDatabase: gormDatabase}},
+		DataExports:   &repositories.DataExportRepository{IGormDatabase: gormDatabase},
+		Policies:      &repositories.PolicyRepository{IGormDatabase: gormDatabase},
+		Invitations:   &repositories.InvitationRepository{IGormDatabase: gormDatabase},
+		Usages:        &repositories.UsageRepository{IGormDatabase: gormDatabase},
+		EmailChanges:  &repositories.EmailChangeRepository{IGormDatabase: gormDatabase},
+		MagicLinks:    &repositories.MagicLinkRepository{IGormDatabase: gormDatabase},
+		Sessions:      &repositories.SessionRepository{IGormDatabase: gormDatabase},
+		Votes:         &repositories.VoteRepository{IGormDatabase: gormDatabase},
+		Comments:      &repositories.CommentRepository{BaseRepository: repositories.BaseRepository[models.Comment]{IGormDatabase: gormDatabase}},
+		Reports:       &repositories.ReportRepository{BaseRepository: repositories.BaseRepository[models.Report]{IGormDatabase: gormDatabase}},
+		Tags:          &repositories.TagRepository{BaseRepository: repositories.BaseRepository[models.Tag]{IGormDatabase: gormDatabase}},
+		Media:         &repositories.MediaRepository{BaseRepository: repositories.BaseRepository[models.Media]{IGormDatabase: gormDatabase}},
+		Follows:       &repositories.FollowRepository{IGormDatabase: gormDatabase},
+		Conversations: &repositories.ConversationRepository{IGormDatabase: gormDatabase},
+		Devices:       &repositories.DeviceRepository{IGormDatabase: gormDatabase},
+		Announcements: &repositories.AnnouncementRepository{BaseRepository: repositories.BaseRepository[models.Announcement]{IGormDatabase: gormDatabase}},
+		UserVersions:  &repositories.UserVersionRepository{IGormDatabase: gormDatabase},
+		Sagas:         &repositories.SagaRepository{IGormDatabase: gormDatabase},
+		database:      gormDatabase,
+	}
+}
+
+/**
+ * Savepoint
+ * runs fn with repositories bound to a savepoint of the transaction of the set, only the changes of fn are rolled
+ * back when it returns an error, the transaction goes on
+ */
+func (repos RepoSet) Savepoint(fn func(repos RepoSet) error) error {
+	return repos.database.DB().Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepoSet(&infrastructures.GormDatabase{Database: tx}))
+	})
+}
+
+/**
+ * Erasables
+ * the repositories holding user data in the order an account is erased, the users repository is the last one but
+ * the history of the users, which gets a version from the anonymization
+ */
+func (repos RepoSet) Erasables() []repositories.Erasable {
+	return []repositories.Erasable{
+		repos.DataExports,
+		repos.Policies,
+		repos.Usages,
+		repos.EmailChanges,
+		repos.MagicLinks,
+		repos.Sessions,
+		repos.Votes,
+		repos.Comments,
+		repos.Reports,
+		repos.Tags,
+		repos.Media,
+		repos.Follows,
+		repos.Conversations,
+		repos.Devices,
+		repos.Announcements,
+		repos.Users,
+		repos.UserVersions,
+	}
+}
+
+/**
+ * IUnitOfWork
+ *
+ */
+type IUnitOfWork interface {
+	// WithinTransaction runs fn with repositories bound to one transaction, it is committed when fn returns nil
+	// and rolled back when it returns an error or panics. The transaction of a dry run (infrastructures.WithDryRun) is
+	// always rolled back, nil is returned when fn succeeded
+	WithinTransaction(ctx context.Context, fn func(repos RepoSet) error) error
+}
+
+type UnitOfWork struct {
+	infrastructures.IGormDatabase
+}
+
+// errDryRun rolls the transaction of a dry run back
+var errDryRun = errors.New("dry run")
+
+func (unitOfWork *UnitOfWork) WithinTransaction(ctx context.Context, fn func(repos RepoSet) error) error {
+	err := unitOfWork.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := fn(NewRepoSet(&infrastructures.GormDatabase{Database: tx})); err != nil {
+			return err
+		}
+		if infrastructures.IsDryRun(ctx) {
+			return errDryRun
+		}
+		return nil
+	})
+	if errors.Is(err, errDryRun) {
+		return nil
+	}
+	return err
+}
+
+/**
+ * RetryingUnitOfWork
+ * runs the transactions of the unit of work again when they fail on a deadlock, a serialization failure or a lost
+ * connection. fn is run once per attempt, the changes of a failed attempt are rolled back but its other effects are not
+ */
+type RetryingUnitOfWork struct {
+	IUnitOfWork
+	Retrier infrastructures.IDbRetrier
+}
+
+func (unitOfWork *RetryingUnitOfWork) WithinTransaction(ctx context.Context, fn func(repos RepoSet) error) error {
+	return unitOfWork.Retrier.Do(ctx, "transaction", func() error {
+		return unitOfWork.IUnitOfWork.WithinTransaction(ctx, fn)
+	})
+}
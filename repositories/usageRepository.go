@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IUsageRepository interface {
+	Migratable
+	Exportable
+	Erasable
+
+	// GetUsages returns the days of the user between from and to, both included
+	GetUsages(userID uint, from time.Time, to time.Time) (usages []models.ApiUsage, err error)
+
+	// Increment counts a request of the user on the day of now
+	Increment(userID uint, now time.Time) (err error)
+}
+
+type UsageRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *UsageRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.ApiUsage{})
+}
+
+func (repository *UsageRepository) GetUsages(userID uint, from time.Time, to time.Time) (usages []models.ApiUsage, err error) {
+	err = repository.DB().Where("user_id = ? AND day BETWEEN ? AND ?", userID, models.UsageDay(from), models.UsageDay(to)).Order("day asc").Find(&usages).Error
+	return
+}
+
+func (repository *UsageRepository) Increment(userID uint, now time.Time) (err error) {
+	return repository.DB().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "day"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"requests": gorm.Expr("requests + 1"), "updated_at": now}),
+	}).Create(&models.ApiUsage{UserID: userID, Day: models.UsageDay(now), Requests: 1}).Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *UsageRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var usages []models.ApiUsage
+	err = repository.DB().Where("user_id = ?", userID).Order("day asc").Find(&usages).Error
+	return usages, err
+}
+
+func (repository *UsageRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Where("user_id = ?", userID).Delete(&models.ApiUsage{}).Error
+}
@@ -1,57 +1,63 @@
 package repositories
 
 import (
-	"syreclabs.com/go/faker"
+	"fmt"
+	"math/rand"
+	"time"
 
-	"gotham/helpers"
-	"gotham/infrastructures"
+	"gorm.io/gorm"
+
+	"gotham/factories"
 	"gotham/models"
 	"gotham/models/scopes"
+	"gotham/pagination"
 )
 
 type IUserRepository interface {
 	Migratable
 	Seedable
+	Exportable
+	Erasable
+	IBaseRepository[models.User]
 
 	GetUserByID(ID uint) (models.User, error)
 	GetUserByEmail(email string) (models.User, error)
+	GetUserByPhone(phone string) (models.User, error)
+	// GetUserByVerificationToken finds the user by the hash of the token of its verification link
+	GetUserByVerificationToken(tokenHash string) (models.User, error)
 
 	// Getter Options
-	GetUsersWithPaginationAndOrder(pagination scopes.GormPager, order scopes.GormOrderer) (users []models.User, totalCount int64, err error)
+	GetUsersWithPaginationAndOrder(pagination scopes.GormPager, order scopes.GormOrderer, filters ...func(db *gorm.DB) *gorm.DB) (users []models.User, totalCount int64, err error)
+	Paginate(keyset pagination.Keyset, filters ...func(db *gorm.DB) *gorm.DB) (users []models.User, next *pagination.Cursor, err error)
 
-	// Create & Save & Updates & Delete
-	Create(user *models.User) (err error)
+	// Save & Updates
 	Save(user *models.User) (err error)
 	Updates(user *models.User, updates map[string]interface{}) (err error)
-	Delete(user *models.User) (err error)
 
 	// Getters
 	GetUserIDs() (userIDs []uint, err error)
+	GetUsersDueForDeletion(before time.Time) (users []models.User, err error)
+	GetUsersWithExpiredSuspension(now time.Time) (users []models.User, err error)
 }
 
 type UserRepository struct {
-	infrastructures.IGormDatabase
+	BaseRepository[models.User]
 }
 
 /**
  * Seed
+ * the users of the factory with a fixed seed, seeding again finds them by their email
  *
  * @return error
  */
 func (repository *UserRepository) Seed() (err error) {
+	users := factories.New(repository.DB(), rand.NewSource(1)).User().Admin().Verified()
 	for i := 0; i < 50; i++ {
-		hashedPassword, _ := helpers.Hash("password")
-		image := faker.Avatar().Url("jpg", 100, 200)
-		user := models.User{
-			Name:     faker.Name().FirstName(),
-			Email:    faker.Internet().Email(),
-			Password: string(hashedPassword),
-			Image:    &image,
-			Admin:    true,
-			Verified: true,
+		user, err := users.Make()
+		if err != nil {
+			return err
 		}
-
-		if err := repository.DB().Create(&user).Error; err != nil {
+		if err = repository.DB().Where(models.User{Email: user.Email}).FirstOrCreate(&user).Error; err != nil {
 			return err
 		}
 	}
@@ -68,14 +74,30 @@ func (repository *UserRepository) Migrate() (err error) {
 	return repository.DB().AutoMigrate(models.User{})
 }
 
-func (repository *UserRepository) GetUsersWithPaginationAndOrder(pagination scopes.GormPager, order scopes.GormOrderer) (users []models.User, totalCount int64, err error) {
-	err = repository.DB().Scopes(order.ToOrder(models.User{}.TableName(), "id", "id", "created_at", "updated_at")).Count(&totalCount).Scopes(pagination.ToPaginate()).Find(&users).Error
-	return
+func (repository *UserRepository) GetUsersWithPaginationAndOrder(pagination scopes.GormPager, order scopes.GormOrderer, filters ...func(db *gorm.DB) *gorm.DB) (users []models.User, totalCount int64, err error) {
+	return repository.List(pagination, append(filters, order.ToOrder(models.User{}.TableName(), "id", "id", "created_at", "updated_at"))...)
+}
+
+/**
+ * Paginate
+ * the keyset page of the users, seeking past the cursor instead of counting and skipping the previous pages. The
+ * cursor of the next page is nil on the last one
+ */
+func (repository *UserRepository) Paginate(keyset pagination.Keyset, filters ...func(db *gorm.DB) *gorm.DB) (users []models.User, next *pagination.Cursor, err error) {
+	if err = repository.query(append(filters, scopes.Keyset(models.User{}.TableName(), keyset))...).Find(&users).Error; err != nil {
+		return nil, nil, err
+	}
+	if keyset.More(len(users)) {
+		users = users[:keyset.Limit]
+		last := users[len(users)-1]
+		cursor := keyset.Next(last.CreatedAt, last.ID)
+		next = &cursor
+	}
+	return users, next, nil
 }
 
 func (repository *UserRepository) GetUserByID(ID uint) (user models.User, err error) {
-	err = repository.DB().First(&user, ID).Error
-	return
+	return repository.FindByID(ID)
 }
 
 func (repository *UserRepository) GetUserByEmail(email string) (user models.User, err error) {
@@ -83,25 +105,27 @@ func (repository *UserRepository) GetUserByEmail(email string) (user models.User
 	return
 }
 
+func (repository *UserRepository) GetUserByVerificationToken(tokenHash string) (user models.User, err error) {
+	err = repository.DB().Where("verification_token = ?", tokenHash).First(&user).Error
+	return
+}
+
+func (repository *UserRepository) GetUserByPhone(phone string) (user models.User, err error) {
+	err = repository.DB().Where("phone = ?", phone).First(&user).Error
+	return
+}
+
 /**
- * Create & Update & Delete
+ * Save & Updates
  *
  */
 
-func (repository *UserRepository) Create(user *models.User) (err error) {
-	return repository.DB().Create(user).Error
-}
-
 func (repository *UserRepository) Save(user *models.User) (err error) {
 	return repository.DB().Save(user).Error
 }
 
 func (repository *UserRepository) Updates(user *models.User, updates map[string]interface{}) (err error) {
-	return repository.DB().Model(user).Updates(updates).Error
-}
-
-func (repository *UserRepository) Delete(user *models.User) (err error) {
-	return repository.DB().Delete(user).Error
+	return repository.Update(user, updates)
 }
 
 /**
@@ -113,3 +137,45 @@ func (repository *UserRepository) GetUserIDs() (userIDs []uint, err error) {
 	err = repository.DB().Model(&models.User{}).Pluck("id", &userIDs).Error
 	return
 }
+
+func (repository *UserRepository) GetUsersDueForDeletion(before time.Time) (users []models.User, err error) {
+	err = repository.DB().Where("deletion_scheduled_at IS NOT NULL AND deletion_scheduled_at <= ?", before).Find(&users).Error
+	return
+}
+
+func (repository *UserRepository) GetUsersWithExpiredSuspension(now time.Time) (users []models.User, err error) {
+	err = repository.DB().Where("suspended_at IS NOT NULL AND suspended_until IS NOT NULL AND suspended_until <= ?", now).Find(&users).Error
+	return
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *UserRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var user models.User
+	err = repository.DB().First(&user, userID).Error
+	return user, err
+}
+
+func (repository *UserRepository) EraseUserData(userID uint) (err error) {
+	var user models.User
+	if err = repository.DB().Unscoped().First(&user, userID).Error; err != nil {
+		return err
+	}
+	// anonymize first so nothing personal survives in backups of a soft deleted row
+	if err = repository.DB().Unscoped().Model(&user).Updates(map[string]interface{}{
+		"name":               "Deleted User",
+		"email":              fmt.Sprintf("deleted-%d@deleted.invalid", user.ID),
+		"password":           "",
+		"image":              nil,
+		"verification_token": nil,
+		"suspension_reason":  nil,
+		"phone":              nil,
+		"phone_code_hash":    nil,
+	}).Error; err != nil {
+		return err
+	}
+	return repository.DB().Unscoped().Delete(&user).Error
+}
@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+)
+
+// UserViewFilter narrows the search of the users, the empty fields do not filter
+type UserViewFilter struct {
+	// Query is a part of the name or of the email
+	Query string
+	// Tag is the slug of a tag of the users
+	Tag       string
+	Verified  *bool
+	Suspended *bool
+}
+
+type IUserSearchViewRepository interface {
+	Migratable
+
+	// SearchUserViews returns a page of the views matching the filter, the latest users first
+	SearchUserViews(filter UserViewFilter, pagination scopes.GormPager) (views []models.UserSearchView, totalCount int64, err error)
+	// SaveUserView writes the view of its user over the previous one
+	SaveUserView(view *models.UserSearchView) (err error)
+	DeleteUserView(userID uint) (err error)
+	// DeleteUserViewsProjectedBefore deletes the views not projected since the time, the ones of the users gone after a rebuild
+	DeleteUserViewsProjectedBefore(before time.Time) (deleted int64, err error)
+}
+
+type UserSearchViewRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *UserSearchViewRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.UserSearchView{})
+}
+
+func (repository *UserSearchViewRepository) SearchUserViews(filter UserViewFilter, pagination scopes.GormPager) (views []models.UserSearchView, totalCount int64, err error) {
+	query := func() *gorm.DB {
+		db := repository.DB().Model(&models.UserSearchView{})
+		if filter.Query != "" {
+			like := "%" + strings.ToLower(filter.Query) + "%"
+			db = db.Where("LOWER(name) LIKE ? OR LOWER(email) LIKE ?", like, like)
+		}
+		if filter.Tag != "" {
+			db = db.Where("tag_slugs LIKE ?", "%,"+filter.Tag+",%")
+		}
+		if filter.Verified != nil {
+			db = db.Where("verified = ?", *filter.Verified)
+		}
+		if filter.Suspended != nil {
+			db = db.Where("suspended = ?", *filter.Suspended)
+		}
+		return db
+	}
+	if totalCount, err = countPage(pagination, query()); err != nil {
+		return
+	}
+	if err = query().Scopes(pagination.ToPaginate()).Order("created_at desc, user_id desc").Find(&views).Error; err != nil {
+		return
+	}
+	return trimPage(pagination, views), totalCount, nil
+}
+
+func (repository *UserSearchViewRepository) SaveUserView(view *models.UserSearchView) (err error) {
+	return repository.DB().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"name", "email", "verified", "admin", "suspended", "tag_names", "tag_slugs",
+			"followers_count", "following_count", "created_at", "projected_at",
+		}),
+	}).Create(view).Error
+}
+
+func (repository *UserSearchViewRepository) DeleteUserView(userID uint) (err error) {
+	return repository.DB().Where("user_id = ?", userID).Delete(&models.UserSearchView{}).Error
+}
+
+func (repository *UserSearchViewRepository) DeleteUserViewsProjectedBefore(before time.Time) (deleted int64, err error) {
+	result := repository.DB().Where("projected_at < ?", before).Delete(&models.UserSearchView{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+)
+
+type IUserVersionRepository interface {
+	Migratable
+	Exportable
+	Erasable
+
+	// GetUserVersions returns a page of the versions of the user, the latest first
+	GetUserVersions(userID uint, pagination scopes.GormPager) (versions []models.UserVersion, totalCount int64, err error)
+	GetUserVersion(userID uint, ID uint) (models.UserVersion, error)
+}
+
+type UserVersionRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *UserVersionRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.UserVersion{})
+}
+
+func (repository *UserVersionRepository) GetUserVersions(userID uint, pagination scopes.GormPager) (versions []models.UserVersion, totalCount int64, err error) {
+	query := func() *gorm.DB {
+		return repository.DB().Model(&models.UserVersion{}).Where("user_id = ?", userID)
+	}
+	if totalCount, err = countPage(pagination, query()); err != nil {
+		return
+	}
+	if err = query().Scopes(pagination.ToPaginate()).Order("id desc").Find(&versions).Error; err != nil {
+		return
+	}
+	return trimPage(pagination, versions), totalCount, nil
+}
+
+func (repository *UserVersionRepository) GetUserVersion(userID uint, ID uint) (version models.UserVersion, err error) {
+	err = repository.DB().Where("user_id = ?", userID).First(&version, ID).Error
+	return
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *UserVersionRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var versions []models.UserVersion
+	err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&versions).Error
+	return versions, err
+}
+
+// EraseUserData deletes the history of the user, it is erased after the user so the version of its anonymization goes too
+func (repository *UserVersionRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Where("user_id = ?", userID).Delete(&models.UserVersion{}).Error
+}
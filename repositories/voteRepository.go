@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gotham/infrastructures"
+	"gotham/models"
+)
+
+type IVoteRepository interface {
+	Migratable
+	Exportable
+	Erasable
+
+	GetVote(userID uint, votableType string, votableID uint) (models.Vote, error)
+	// GetTally returns the counters of the votable, zero counters when it has never been voted
+	GetTally(votableType string, votableID uint) (models.VoteTally, error)
+	// GetUnscoredTallies returns the tallies changed since their score was computed
+	GetUnscoredTallies(limit int) (tallies []models.VoteTally, err error)
+
+	// Updates
+	// Cast sets the vote of the user, value 0 removes it, the counters of the votable change in the same transaction
+	Cast(userID uint, votableType string, votableID uint, value int) (err error)
+	UpdateScore(tally *models.VoteTally, score float64, scoredAt time.Time) (err error)
+}
+
+type VoteRepository struct {
+	infrastructures.IGormDatabase
+}
+
+/**
+ * Migrate
+ *
+ * @return error
+ */
+func (repository *VoteRepository) Migrate() (err error) {
+	return repository.DB().AutoMigrate(models.Vote{}, models.VoteTally{})
+}
+
+func (repository *VoteRepository) GetVote(userID uint, votableType string, votableID uint) (vote models.Vote, err error) {
+	err = repository.DB().Where("user_id = ? AND votable_type = ? AND votable_id = ?", userID, votableType, votableID).First(&vote).Error
+	return
+}
+
+func (repository *VoteRepository) GetTally(votableType string, votableID uint) (tally models.VoteTally, err error) {
+	err = repository.DB().Where("votable_type = ? AND votable_id = ?", votableType, votableID).First(&tally).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.VoteTally{VotableType: votableType, VotableID: votableID}, nil
+	}
+	return
+}
+
+func (repository *VoteRepository) GetUnscoredTallies(limit int) (tallies []models.VoteTally, err error) {
+	err = repository.DB().Where("scored_at IS NULL OR updated_at > scored_at").Order("updated_at asc").Limit(limit).Find(&tallies).Error
+	return
+}
+
+/**
+ * Updates
+ *
+ */
+
+func (repository *VoteRepository) Cast(userID uint, votableType string, votableID uint, value int) (err error) {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		var vote models.Vote
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND votable_type = ? AND votable_id = ?", userID, votableType, votableID).
+			First(&vote).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		previous := vote.Value
+		if previous == value {
+			return nil
+		}
+
+		switch {
+		case value == 0:
+			err = tx.Delete(&vote).Error
+		case previous == 0:
+			err = tx.Create(&models.Vote{UserID: userID, VotableType: votableType, VotableID: votableID, Value: value}).Error
+		default:
+			err = tx.Model(&vote).Update("value", value).Error
+		}
+		if err != nil {
+			return err
+		}
+		return adjustTally(tx, votableType, votableID, previous, value)
+	})
+}
+
+// adjustTally moves the counters of a votable from the previous value of a vote to the new one
+func adjustTally(tx *gorm.DB, votableType string, votableID uint, previous int, value int) error {
+	tally := models.VoteTally{VotableType: votableType, VotableID: votableID}
+	if err := tx.Where("votable_type = ? AND votable_id = ?", votableType, votableID).FirstOrCreate(&tally).Error; err != nil {
+		return err
+	}
+	likes := voteCount(value, models.VoteLike) - voteCount(previous, models.VoteLike)
+	dislikes := voteCount(value, models.VoteDislike) - voteCount(previous, models.VoteDislike)
+	return tx.Model(&tally).Updates(map[string]interface{}{
+		"likes":    gorm.Expr("likes + ?", likes),
+		"dislikes": gorm.Expr("dislikes + ?", dislikes),
+	}).Error
+}
+
+func voteCount(value int, kind int) int {
+	if value == kind {
+		return 1
+	}
+	return 0
+}
+
+func (repository *VoteRepository) UpdateScore(tally *models.VoteTally, score float64, scoredAt time.Time) (err error) {
+	// the columns are set without touching updated_at, scoredAt is the updated_at the score was computed from so a
+	// vote cast meanwhile keeps the tally unscored
+	return repository.DB().Model(tally).UpdateColumns(map[string]interface{}{"score": score, "scored_at": scoredAt}).Error
+}
+
+/**
+ * Privacy
+ *
+ */
+
+func (repository *VoteRepository) ExportUserData(userID uint) (data interface{}, err error) {
+	var votes []models.Vote
+	err = repository.DB().Where("user_id = ?", userID).Order("id asc").Find(&votes).Error
+	return votes, err
+}
+
+// EraseUserData removes the votes of the user and takes them off the counters
+func (repository *VoteRepository) EraseUserData(userID uint) (err error) {
+	return repository.DB().Transaction(func(tx *gorm.DB) error {
+		var votes []models.Vote
+		if err := tx.Where("user_id = ?", userID).Find(&votes).Error; err != nil {
+			return err
+		}
+		for _, vote := range votes {
+			if err := tx.Delete(&vote).Error; err != nil {
+				return err
+			}
+			if err := adjustTally(tx, vote.VotableType, vote.VotableID, vote.Value, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
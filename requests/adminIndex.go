@@ -0,0 +1,30 @@
+package requests
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"gotham/utils"
+)
+
+type AdminIndexRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Resource string `param:"resource"`
+	}
+
+	/**
+	 * QueryParams
+	 * the filters of the resource are read from the query too
+	 */
+	QueryParams struct {
+		utils.Pagination
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
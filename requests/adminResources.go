@@ -0,0 +1,37 @@
+package requests
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/helpers"
+	"gotham/models"
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// the validations of the models administered through the admin resources
+
+func ValidatePlan(plan models.Plan) error {
+	return validation.ValidateStruct(&plan,
+		validation.Field(&plan.Slug, validation.Required, validation.Length(1, 50), validation.Match(slugPattern)),
+		validation.Field(&plan.Name, validation.Required, validation.Length(1, 100)),
+		validation.Field(&plan.Rank, validation.Min(0)),
+		validation.Field(&plan.Interval, validation.Required, validation.In("month", "year")),
+		validation.Field(&plan.Price, validation.By(func(value interface{}) error {
+			if price, _ := value.(helpers.Money); price.IsNegative() {
+				return errors.New("must not be negative")
+			}
+			return nil
+		})),
+	)
+}
+
+func ValidateTag(tag models.Tag) error {
+	return validation.ValidateStruct(&tag,
+		validation.Field(&tag.Name, validation.Required, validation.Length(1, 50)),
+		validation.Field(&tag.Slug, validation.Required, validation.Length(1, 50), validation.Match(slugPattern)),
+	)
+}
@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type AdminShowRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Resource string `param:"resource"`
+		ID       uint   `param:"id"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r AdminShowRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.ID, validation.Required),
+	)
+}
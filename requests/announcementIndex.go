@@ -0,0 +1,27 @@
+package requests
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"gotham/utils"
+)
+
+type AnnouncementIndexRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		utils.Pagination
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
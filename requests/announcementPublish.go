@@ -0,0 +1,36 @@
+package requests
+
+import (
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type AnnouncementPublishRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Announcement uint `param:"announcement"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		PublishAt *time.Time `json:"publish_at" form:"publish_at" xml:"publish_at"`
+	}
+}
+
+func (r AnnouncementPublishRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Announcement, validation.Required),
+	)
+}
@@ -0,0 +1,32 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type AnnouncementShowRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Announcement uint `param:"announcement"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r AnnouncementShowRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Announcement, validation.Required),
+	)
+}
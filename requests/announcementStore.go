@@ -0,0 +1,69 @@
+package requests
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/models"
+)
+
+type AnnouncementStoreRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Title     string     `json:"title" form:"title" xml:"title"`
+		Body      string     `json:"body" form:"body" xml:"body"`
+		Audience  string     `json:"audience" form:"audience" xml:"audience"`
+		Plan      *string    `json:"plan" form:"plan" xml:"plan"`
+		PublishAt *time.Time `json:"publish_at" form:"publish_at" xml:"publish_at"`
+		Draft     bool       `json:"draft" form:"draft" xml:"draft"`
+	}
+}
+
+// Announcement is the announcement of the body, a draft is not scheduled
+func (r AnnouncementStoreRequest) Announcement() models.Announcement {
+	announcement := models.Announcement{
+		Title:    r.Body.Title,
+		Body:     r.Body.Body,
+		Audience: r.Body.Audience,
+		PlanSlug: r.Body.Plan,
+	}
+	if r.Body.Draft {
+		announcement.PublishStatus = models.PublishDraft
+	}
+	if r.Body.PublishAt != nil {
+		publishAt := r.Body.PublishAt.UTC()
+		announcement.PublishAt = &publishAt
+	}
+	return announcement
+}
+
+func (r AnnouncementStoreRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Title, validation.Required, validation.Length(1, 150)),
+		validation.Field(&r.Body.Body, validation.Required, validation.Length(1, 10000)),
+		validation.Field(&r.Body.Audience, validation.Required, validation.In(models.Audiences...)),
+		validation.Field(&r.Body.Plan, validation.By(func(value interface{}) error {
+			plan, _ := value.(*string)
+			if r.Body.Audience == models.AudiencePlan && (plan == nil || *plan == "") {
+				return errors.New("is required for the plan audience")
+			}
+			return nil
+		})),
+	)
+}
@@ -0,0 +1,32 @@
+package requests
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type ApiKeyDestroyRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		ApiKey uint `param:"key"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r ApiKeyDestroyRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.ApiKey, validation.Required),
+	)
+}
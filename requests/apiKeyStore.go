@@ -0,0 +1,42 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/policies"
+)
+
+type ApiKeyStoreRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Name     string   `json:"name" form:"name" xml:"name"`
+		Scopes   []string `json:"scopes" form:"scopes" xml:"scopes"`
+		TTLHours int      `json:"ttl_hours" form:"ttl_hours" xml:"ttl_hours"`
+	}
+}
+
+func (r ApiKeyStoreRequest) Validate() error {
+	scopes := make([]interface{}, len(policies.Scopes))
+	for i, scope := range policies.Scopes {
+		scopes[i] = scope
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Name, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Body.Scopes, validation.Required, validation.Length(1, len(policies.Scopes)), validation.Each(validation.Required, validation.In(scopes...))),
+		validation.Field(&r.Body.TTLHours, validation.Min(0), validation.Max(24*365)),
+	)
+}
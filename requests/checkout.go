@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type CheckoutRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Plan   string `json:"plan" form:"plan" xml:"plan"`
+		Coupon string `json:"coupon" form:"coupon" xml:"coupon"`
+	}
+}
+
+func (r CheckoutRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Plan, validation.Required, validation.Length(1, 50)),
+		validation.Field(&r.Body.Coupon, validation.Length(0, 50)),
+	)
+}
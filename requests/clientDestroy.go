@@ -0,0 +1,32 @@
+package requests
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type ClientDestroyRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Client uint `param:"client"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r ClientDestroyRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Client, validation.Required),
+	)
+}
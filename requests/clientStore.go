@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/policies"
+)
+
+type ClientStoreRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Name   string   `json:"name" form:"name" xml:"name"`
+		Scopes []string `json:"scopes" form:"scopes" xml:"scopes"`
+		// RateLimit is the requests of the client within a window, without it the default of the config applies
+		RateLimit *int `json:"rate_limit" form:"rate_limit" xml:"rate_limit"`
+		// CertificateIdentity is the URI, DNS name or common name of the client certificate of the client
+		CertificateIdentity *string `json:"certificate_identity" form:"certificate_identity" xml:"certificate_identity"`
+	}
+}
+
+func (r ClientStoreRequest) Validate() error {
+	scopes := make([]interface{}, len(policies.Scopes))
+	for i, scope := range policies.Scopes {
+		scopes[i] = scope
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Name, validation.Required, validation.Length(1, 100)),
+		// a client has no user to be an admin of
+		validation.Field(&r.Body.Scopes, validation.Required, validation.Length(1, len(policies.Scopes)), validation.Each(validation.Required, validation.In(scopes...), validation.NotIn(policies.ScopeAdmin))),
+		validation.Field(&r.Body.RateLimit, validation.Min(0)),
+		validation.Field(&r.Body.CertificateIdentity, validation.NilOrNotEmpty, validation.Length(1, 255)),
+	)
+}
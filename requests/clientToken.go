@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+// ClientTokenRequest is the token request of the client credentials grant, RFC 6749 4.4. The credentials are sent with
+// HTTP Basic or in the body
+type ClientTokenRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		GrantType    string `json:"grant_type" form:"grant_type" xml:"grant_type"`
+		Scope        string `json:"scope" form:"scope" xml:"scope"`
+		ClientID     string `json:"client_id" form:"client_id" xml:"client_id"`
+		ClientSecret string `json:"client_secret" form:"client_secret" xml:"client_secret"`
+	}
+}
+
+func (r ClientTokenRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.GrantType, validation.Required),
+		validation.Field(&r.Body.Scope, validation.Length(0, 500)),
+		validation.Field(&r.Body.ClientID, validation.Length(0, 40)),
+		validation.Field(&r.Body.ClientSecret, validation.Length(0, 100)),
+	)
+}
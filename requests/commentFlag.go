@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type CommentFlagRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Comment uint `param:"comment"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Reason string `json:"reason" form:"reason" xml:"reason"`
+	}
+}
+
+func (r CommentFlagRequest) Validate() error {
+	if err := validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Comment, validation.Required),
+	); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Reason, validation.Length(0, 255)),
+	)
+}
@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/utils"
+)
+
+type CommentIndexRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Type string `param:"type"`
+		ID   uint   `param:"id"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		utils.Order
+		utils.Pagination
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r CommentIndexRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Type, validation.Required, validation.Length(1, 50)),
+		validation.Field(&r.PathParams.ID, validation.Required),
+	)
+}
@@ -0,0 +1,33 @@
+package requests
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// CommentModerateRequest is the request of the actions on a comment without a body, delete, approve and remove
+type CommentModerateRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Comment uint `param:"comment"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r CommentModerateRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Comment, validation.Required),
+	)
+}
@@ -0,0 +1,31 @@
+package requests
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"gotham/utils"
+)
+
+type CommentQueueRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		utils.Pagination
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r CommentQueueRequest) Validate() error {
+	return nil
+}
@@ -0,0 +1,43 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type CommentStoreRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Type string `param:"type"`
+		ID   uint   `param:"id"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Body     string `json:"body" form:"body" xml:"body"`
+		ParentID *uint  `json:"parent_id" form:"parent_id" xml:"parent_id"`
+	}
+}
+
+func (r CommentStoreRequest) Validate() error {
+	if err := validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Type, validation.Required, validation.Length(1, 50)),
+		validation.Field(&r.PathParams.ID, validation.Required),
+	); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Body, validation.Required, validation.Length(1, 5000)),
+		validation.Field(&r.Body.ParentID, validation.Min(uint(1))),
+	)
+}
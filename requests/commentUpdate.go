@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type CommentUpdateRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Comment uint `param:"comment"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Body string `json:"body" form:"body" xml:"body"`
+	}
+}
+
+func (r CommentUpdateRequest) Validate() error {
+	if err := validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Comment, validation.Required),
+	); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Body, validation.Required, validation.Length(1, 5000)),
+	)
+}
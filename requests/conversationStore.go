@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type ConversationStoreRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		UserIDs []uint `json:"user_ids" form:"user_ids" xml:"user_ids"`
+		Body    string `json:"body" form:"body" xml:"body"`
+	}
+}
+
+func (r ConversationStoreRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.UserIDs, validation.Required, validation.Length(1, 20), validation.Each(validation.Required)),
+		validation.Field(&r.Body.Body, validation.Length(0, 5000)),
+	)
+}
@@ -0,0 +1,32 @@
+package requests
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type CouponDestroyRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Coupon uint `param:"coupon"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r CouponDestroyRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Coupon, validation.Required),
+	)
+}
@@ -0,0 +1,92 @@
+package requests
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/helpers"
+)
+
+var couponCode = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+type CouponStoreRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Code           string     `json:"code" form:"code" xml:"code"`
+		PercentOff     int        `json:"percent_off" form:"percent_off" xml:"percent_off"`
+		AmountOff      string     `json:"amount_off" form:"amount_off" xml:"amount_off"`
+		Currency       string     `json:"currency" form:"currency" xml:"currency"`
+		PlanID         *uint      `json:"plan_id" form:"plan_id" xml:"plan_id"`
+		MaxRedemptions int        `json:"max_redemptions" form:"max_redemptions" xml:"max_redemptions"`
+		MaxPerUser     *int       `json:"max_per_user" form:"max_per_user" xml:"max_per_user"`
+		ExpiresAt      *time.Time `json:"expires_at" form:"expires_at" xml:"expires_at"`
+	}
+}
+
+// Amount is the fixed discount, nil for a percentage coupon
+func (r CouponStoreRequest) Amount() *helpers.Money {
+	if r.Body.AmountOff == "" {
+		return nil
+	}
+	amount, err := helpers.ParseMoney(r.Body.AmountOff, r.Body.Currency)
+	if err != nil {
+		return nil
+	}
+	return &amount
+}
+
+func (r CouponStoreRequest) Validate() error {
+	// a coupon is either a percentage or a fixed amount
+	percentRules := []validation.Rule{validation.Min(1), validation.Max(100)}
+	amountRules := []validation.Rule{validation.By(func(value interface{}) error {
+		if value.(string) == "" {
+			return nil
+		}
+		amount, err := helpers.ParseMoney(value.(string), r.Body.Currency)
+		if err != nil || amount.Amount <= 0 {
+			return errors.New("must be a positive amount of the currency")
+		}
+		return nil
+	})}
+	if r.Body.AmountOff == "" {
+		percentRules = append([]validation.Rule{validation.Required}, percentRules...)
+	} else {
+		percentRules = append(percentRules, validation.By(func(value interface{}) error {
+			if value.(int) != 0 {
+				return errors.New("can not be set with amount_off")
+			}
+			return nil
+		}))
+	}
+
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Code, validation.Required, validation.Length(3, 50), validation.Match(couponCode).Error("must only contain letters, digits, - and _")),
+		validation.Field(&r.Body.PercentOff, percentRules...),
+		validation.Field(&r.Body.AmountOff, amountRules...),
+		validation.Field(&r.Body.MaxRedemptions, validation.Min(0), validation.Max(1000000)),
+		validation.Field(&r.Body.MaxPerUser, validation.Min(0), validation.Max(1000)),
+		validation.Field(&r.Body.ExpiresAt, validation.By(func(value interface{}) error {
+			if expiresAt, _ := value.(*time.Time); expiresAt != nil && !expiresAt.After(time.Now()) {
+				return errors.New("must be in the future")
+			}
+			return nil
+		})),
+	)
+}
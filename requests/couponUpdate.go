@@ -0,0 +1,63 @@
+package requests
+
+import (
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type CouponUpdateRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Coupon uint `param:"coupon"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		MaxRedemptions *int       `json:"max_redemptions" form:"max_redemptions" xml:"max_redemptions"`
+		MaxPerUser     *int       `json:"max_per_user" form:"max_per_user" xml:"max_per_user"`
+		ExpiresAt      *time.Time `json:"expires_at" form:"expires_at" xml:"expires_at"`
+		Active         *bool      `json:"active" form:"active" xml:"active"`
+	}
+}
+
+// Updates are the columns of the fields sent, the discount of a coupon can not be changed
+func (r CouponUpdateRequest) Updates() map[string]interface{} {
+	updates := map[string]interface{}{}
+	if r.Body.MaxRedemptions != nil {
+		updates["max_redemptions"] = *r.Body.MaxRedemptions
+	}
+	if r.Body.MaxPerUser != nil {
+		updates["max_per_user"] = *r.Body.MaxPerUser
+	}
+	if r.Body.ExpiresAt != nil {
+		updates["expires_at"] = *r.Body.ExpiresAt
+	}
+	if r.Body.Active != nil {
+		updates["active"] = *r.Body.Active
+	}
+	return updates
+}
+
+func (r CouponUpdateRequest) Validate() error {
+	if err := validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Coupon, validation.Required),
+	); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.MaxRedemptions, validation.Min(0), validation.Max(1000000)),
+		validation.Field(&r.Body.MaxPerUser, validation.Min(0), validation.Max(1000)),
+	)
+}
@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type CouponValidateRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Code string `json:"code" form:"code" xml:"code"`
+		Plan string `json:"plan" form:"plan" xml:"plan"`
+	}
+}
+
+func (r CouponValidateRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Code, validation.Required, validation.Length(1, 50)),
+		validation.Field(&r.Body.Plan, validation.Required, validation.Length(1, 50)),
+	)
+}
@@ -0,0 +1,30 @@
+package requests
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type DataExportShowRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Export uint `param:"export"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r DataExportShowRequest) Validate() error {
+	return nil
+}
@@ -0,0 +1,61 @@
+package requests
+
+import (
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"gotham/models"
+	"gotham/repositories"
+	"gotham/utils"
+)
+
+// DeadLetterFilterParams are the query params narrowing the dead letters, the empty ones do not filter
+type DeadLetterFilterParams struct {
+	Kind   string `query:"kind"`
+	Source string `query:"source"`
+	// Before is a RFC 3339 time, the dead letters created before it
+	Before string `query:"before"`
+}
+
+// GetFilter is the filter of the params, validated
+func (p DeadLetterFilterParams) GetFilter() repositories.DeadLetterFilter {
+	filter := repositories.DeadLetterFilter{Kind: p.Kind, Source: p.Source}
+	if before, err := time.Parse(time.RFC3339, p.Before); err == nil {
+		filter.Before = &before
+	}
+	return filter
+}
+
+func (p DeadLetterFilterParams) Validate() error {
+	return validation.ValidateStruct(&p,
+		validation.Field(&p.Kind, validation.In(models.DeadLetterJob, models.DeadLetterWebhook)),
+		validation.Field(&p.Source, validation.Length(0, 50)),
+		validation.Field(&p.Before, validation.Date(time.RFC3339)),
+	)
+}
+
+type DeadLetterIndexRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		DeadLetterFilterParams
+		utils.Pagination
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r DeadLetterIndexRequest) Validate() error {
+	return r.QueryParams.DeadLetterFilterParams.Validate()
+}
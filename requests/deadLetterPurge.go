@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type DeadLetterPurgeRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		DeadLetterFilterParams
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r DeadLetterPurgeRequest) Validate() error {
+	return r.QueryParams.DeadLetterFilterParams.Validate()
+}
@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+	"gotham/models"
+	"gotham/repositories"
+)
+
+// DeadLetterReplayMax is the most dead letters replayed by a bulk request
+const DeadLetterReplayMax = 100
+
+type DeadLetterReplayRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		// IDs are the dead letters replayed, without them the oldest of the kind and the source
+		IDs    []uint `json:"ids" form:"ids" xml:"ids"`
+		Kind   string `json:"kind" form:"kind" xml:"kind"`
+		Source string `json:"source" form:"source" xml:"source"`
+	}
+}
+
+// GetFilter is the filter of the dead letters replayed without ids
+func (r DeadLetterReplayRequest) GetFilter() repositories.DeadLetterFilter {
+	return repositories.DeadLetterFilter{Kind: r.Body.Kind, Source: r.Body.Source}
+}
+
+func (r DeadLetterReplayRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.IDs, validation.Length(0, DeadLetterReplayMax), validation.Each(validation.Required)),
+		validation.Field(&r.Body.Kind, validation.In(models.DeadLetterJob, models.DeadLetterWebhook)),
+		validation.Field(&r.Body.Source, validation.Length(0, 50)),
+	)
+}
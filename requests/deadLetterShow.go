@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+// DeadLetterShowRequest is the request of showing, replaying and deleting a dead letter
+type DeadLetterShowRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		DeadLetter uint `param:"dead_letter"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r DeadLetterShowRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.DeadLetter, validation.Required),
+	)
+}
@@ -0,0 +1,43 @@
+package requests
+
+import (
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type DeprecationIndexRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		// Since is a RFC 3339 time, the callers calling since it
+		Since string `query:"since"`
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+// GetSince is the time of the oldest calls in the report, 30 days ago by default
+func (r DeprecationIndexRequest) GetSince(now time.Time) time.Time {
+	if since, err := time.Parse(time.RFC3339, r.QueryParams.Since); err == nil {
+		return since
+	}
+	return now.AddDate(0, 0, -30)
+}
+
+func (r DeprecationIndexRequest) Validate() error {
+	return validation.ValidateStruct(&r.QueryParams,
+		validation.Field(&r.QueryParams.Since, validation.Date(time.RFC3339)),
+	)
+}
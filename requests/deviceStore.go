@@ -0,0 +1,36 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/models"
+)
+
+type DeviceStoreRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Token    string `json:"token" form:"token" xml:"token"`
+		Platform string `json:"platform" form:"platform" xml:"platform"`
+	}
+}
+
+func (r DeviceStoreRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Token, validation.Required, validation.Length(1, 255)),
+		validation.Field(&r.Body.Platform, validation.Required, validation.In(models.DevicePlatforms...)),
+	)
+}
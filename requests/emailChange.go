@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+)
+
+type EmailChangeRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Email           string `json:"email" form:"email" xml:"email"`
+		CurrentPassword string `json:"current_password" form:"current_password" xml:"current_password"`
+	}
+}
+
+func (r EmailChangeRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Email, validation.Required, validation.Length(4, 50), is.Email),
+		validation.Field(&r.Body.CurrentPassword, validation.Required),
+	)
+}
@@ -0,0 +1,32 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type EmailChangeConfirmRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		Token string `query:"token"`
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r EmailChangeConfirmRequest) Validate() error {
+	return validation.ValidateStruct(&r.QueryParams,
+		validation.Field(&r.QueryParams.Token, validation.Required, validation.Length(1, 128)),
+	)
+}
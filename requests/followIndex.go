@@ -0,0 +1,35 @@
+package requests
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"gotham/utils"
+)
+
+type FollowIndexRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		User uint `param:"user"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		utils.Pagination
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r FollowIndexRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.User, validation.Required),
+	)
+}
@@ -0,0 +1,37 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+)
+
+type InvitationStoreRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		MaxUses  *int   `json:"max_uses" form:"max_uses" xml:"max_uses"`
+		TTLHours int    `json:"ttl_hours" form:"ttl_hours" xml:"ttl_hours"`
+		Email    string `json:"email" form:"email" xml:"email"`
+	}
+}
+
+func (r InvitationStoreRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.MaxUses, validation.Min(0), validation.Max(10000)),
+		validation.Field(&r.Body.TTLHours, validation.Min(0), validation.Max(24*365)),
+		validation.Field(&r.Body.Email, validation.Length(0, 100), is.Email),
+	)
+}
@@ -0,0 +1,41 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type InvoiceDownloadRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Invoice uint `param:"invoice"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		Expires   int64  `query:"expires"`
+		Signature string `query:"signature"`
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r InvoiceDownloadRequest) Validate() error {
+	if err := validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Invoice, validation.Required),
+	); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(&r.QueryParams,
+		validation.Field(&r.QueryParams.Expires, validation.Required),
+		validation.Field(&r.QueryParams.Signature, validation.Required, validation.Length(1, 128)),
+	)
+}
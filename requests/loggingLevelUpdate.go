@@ -0,0 +1,53 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/infrastructures"
+)
+
+type LoggingLevelUpdateRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Level   string            `json:"level" form:"level" xml:"level"`
+		Modules map[string]string `json:"modules" form:"modules" xml:"modules"`
+	}
+}
+
+func (r LoggingLevelUpdateRequest) Validate() error {
+	levels := make([]interface{}, 0, len(infrastructures.LogLevelNames))
+	for _, level := range infrastructures.LogLevelNames {
+		levels = append(levels, level)
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Level, validation.Required, validation.In(levels...)),
+		validation.Field(&r.Body.Modules, validation.Length(0, 50), validation.By(func(value interface{}) error {
+			modules, _ := value.(map[string]string)
+			for module, level := range modules {
+				if module == "" || len(module) > 64 {
+					return errors.New("the names of the modules must be 1 to 64 characters")
+				}
+				if validation.Validate(level, validation.In(levels...)) != nil {
+					return errors.New("the level of " + module + " must be debug, info, warn or error")
+				}
+			}
+			return nil
+		})),
+	)
+}
@@ -24,12 +24,25 @@ type LoginRequest struct {
 	Body struct {
 		Email    string `json:"email" form:"email" xml:"email"`
 		Password string `json:"password" form:"password" xml:"password"`
+
+		// RememberMe opens a session of the device returning a refresh token
+		RememberMe bool   `json:"remember_me" form:"remember_me" xml:"remember_me"`
+		DeviceID   string `json:"device_id" form:"device_id" xml:"device_id"`
+
+		// CaptchaToken is only checked after suspicious activity
+		CaptchaToken string `json:"captcha_token" form:"captcha_token" xml:"captcha_token"`
 	}
 }
 
 func (r LoginRequest) Validate() error {
+	deviceRules := []validation.Rule{validation.Length(0, 100)}
+	if r.Body.RememberMe {
+		deviceRules = append([]validation.Rule{validation.Required}, deviceRules...)
+	}
 	return validation.ValidateStruct(&r.Body,
 		validation.Field(&r.Body.Email, validation.Required, validation.Length(4, 50), is.Email),
 		validation.Field(&r.Body.Password, validation.Required, validation.Length(8, 50)),
+		validation.Field(&r.Body.DeviceID, deviceRules...),
+		validation.Field(&r.Body.CaptchaToken, validation.Length(0, 4096)),
 	)
 }
@@ -0,0 +1,32 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type LogoutRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		RefreshToken string `json:"refresh_token" form:"refresh_token" xml:"refresh_token"`
+	}
+}
+
+func (r LogoutRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.RefreshToken, validation.Required, validation.Length(1, 100)),
+	)
+}
@@ -0,0 +1,41 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type MediaAttachRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Media uint `param:"media"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Type string `json:"type" form:"type" xml:"type"`
+		ID   uint   `json:"id" form:"id" xml:"id"`
+	}
+}
+
+func (r MediaAttachRequest) Validate() error {
+	if err := validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Media, validation.Required),
+	); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Type, validation.Required, validation.Length(1, 50)),
+		validation.Field(&r.Body.ID, validation.Required),
+	)
+}
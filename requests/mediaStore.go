@@ -0,0 +1,43 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/models"
+)
+
+type MediaStoreRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 * the file is read from the multipart field "file"
+	 */
+	Body struct {
+		Visibility string `json:"visibility" form:"visibility" xml:"visibility"`
+	}
+}
+
+// GetVisibility is the visibility of the upload, private by default
+func (r MediaStoreRequest) GetVisibility() string {
+	if r.Body.Visibility == "" {
+		return models.MediaPrivate
+	}
+	return r.Body.Visibility
+}
+
+func (r MediaStoreRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Visibility, validation.In(models.MediaPublic, models.MediaPrivate)),
+	)
+}
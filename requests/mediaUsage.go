@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type MediaUsageRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		Limit int `query:"limit"`
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+// GetLimit is the number of users in the report, 20 by default
+func (r MediaUsageRequest) GetLimit() int {
+	if r.QueryParams.Limit == 0 {
+		return 20
+	}
+	return r.QueryParams.Limit
+}
+
+func (r MediaUsageRequest) Validate() error {
+	return validation.ValidateStruct(&r.QueryParams,
+		validation.Field(&r.QueryParams.Limit, validation.Min(0), validation.Max(100)),
+	)
+}
@@ -0,0 +1,48 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type MessageIndexRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Conversation uint `param:"conversation"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		Before uint `query:"before"`
+		Limit  int  `query:"limit"`
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+// GetLimit is the number of messages returned, 50 by default
+func (r MessageIndexRequest) GetLimit() int {
+	if r.QueryParams.Limit == 0 {
+		return 50
+	}
+	return r.QueryParams.Limit
+}
+
+func (r MessageIndexRequest) Validate() error {
+	if err := validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Conversation, validation.Required),
+	); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(&r.QueryParams,
+		validation.Field(&r.QueryParams.Limit, validation.Min(0), validation.Max(100)),
+	)
+}
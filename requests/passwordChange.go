@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type PasswordChangeRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Password             string `json:"password" form:"password" xml:"password"`
+		PasswordConfirmation string `json:"password_confirmation" form:"password_confirmation" xml:"password_confirmation"`
+	}
+}
+
+func (r PasswordChangeRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Password, validation.Required, validation.Length(8, 50)),
+		validation.Field(&r.Body.PasswordConfirmation, validation.Required, validation.In(r.Body.Password).Error("must be the same as the password")),
+	)
+}
@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+// E.164: a plus, a country code not starting with 0 and at most 15 digits
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// E164 validates a phone number normalized by NormalizePhone
+var E164 = validation.Match(e164Pattern).Error("must be a phone number in international format, like +14155552671")
+
+// NormalizePhone drops the formatting people type (spaces, dashes, dots, parentheses) and turns a 00 prefix into +,
+// the result still has to be validated with E164
+func NormalizePhone(phone string) string {
+	phone = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')', '\t':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(phone))
+	if strings.HasPrefix(phone, "00") {
+		phone = "+" + phone[2:]
+	}
+	return phone
+}
@@ -0,0 +1,37 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type PhoneUpdateRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Phone string `json:"phone" form:"phone" xml:"phone"`
+	}
+}
+
+// Normalize brings the phone to E.164 before it is validated
+func (r *PhoneUpdateRequest) Normalize() {
+	r.Body.Phone = NormalizePhone(r.Body.Phone)
+}
+
+func (r PhoneUpdateRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Phone, validation.Required, E164),
+	)
+}
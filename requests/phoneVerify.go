@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+)
+
+type PhoneVerifyRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Code string `json:"code" form:"code" xml:"code"`
+	}
+}
+
+func (r PhoneVerifyRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Code, validation.Required, validation.Length(6, 6), is.Digit),
+	)
+}
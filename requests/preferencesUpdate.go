@@ -0,0 +1,51 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/helpers"
+)
+
+type PreferencesUpdateRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Timezone string `json:"timezone" form:"timezone" xml:"timezone"`
+	}
+}
+
+func (r PreferencesUpdateRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Timezone, validation.Required, validation.Length(1, 64), timezoneRule),
+	)
+}
+
+// timezoneRule accepts the IANA timezones
+var timezoneRule = validation.By(func(value interface{}) error {
+	timezone, _ := value.(string)
+	if pointer, ok := value.(*string); ok && pointer != nil {
+		timezone = *pointer
+	}
+	// Local is the zone of the server, not a zone of the user
+	if timezone == "Local" {
+		return errors.New("must be an IANA timezone, like Europe/Istanbul")
+	} else if _, err := helpers.LoadLocation(timezone); err != nil {
+		return errors.New("must be an IANA timezone, like Europe/Istanbul")
+	}
+	return nil
+})
@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/helpers"
+	"gotham/models"
+)
+
+type PushPreferencesUpdateRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Categories map[string]bool `json:"categories" form:"categories" xml:"categories"`
+	}
+}
+
+func (r PushPreferencesUpdateRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Categories, validation.Required, validation.By(func(value interface{}) error {
+			categories, _ := value.(map[string]bool)
+			for category := range categories {
+				if !helpers.InArray(category, models.PushCategories) {
+					return errors.New("must only have known categories")
+				}
+			}
+			return nil
+		})),
+	)
+}
@@ -0,0 +1,120 @@
+package requests
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/labstack/echo/v4"
+
+	"gotham/problems"
+	"gotham/utils"
+)
+
+// the cursors of the api are url safe base64
+var cursorPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,512}$`)
+
+/**
+ * BindQuery
+ * binds the query parameters to the params struct. When it has a utils.Pagination field its page is parsed with
+ * ParsePagination, an invalid page is a 422
+ */
+func BindQuery(c echo.Context, params interface{}) error {
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, params); err != nil {
+		return err
+	}
+	if pagination := paginationOf(params); pagination != nil {
+		if err := ParsePagination(c, pagination); err != nil {
+			return problems.Validation(err)
+		}
+	}
+	return nil
+}
+
+// paginationOf the utils.Pagination field of the params struct, nil without one. The params embedding utils.Order
+// too do not implement utils.IPagination, both have a Get method, so the field is looked up
+func paginationOf(params interface{}) *utils.Pagination {
+	value := reflect.ValueOf(params)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	value = value.Elem()
+	for i := 0; i < value.NumField(); i++ {
+		if !value.Type().Field(i).IsExported() {
+			continue
+		}
+		if pagination, ok := value.Field(i).Addr().Interface().(*utils.Pagination); ok {
+			return pagination
+		}
+	}
+	return nil
+}
+
+/**
+ * ParsePagination
+ * reads page, per_page (or limit), cursor and lite. The page size defaults to default_page_size and can not exceed
+ * max_page_size of the settings, a page and a cursor are not combined. A lite page skips the count of the records,
+ * the default of the route set by the lite pagination middleware
+ */
+func ParsePagination(c echo.Context, pagination *utils.Pagination) error {
+	sizes := utils.DefaultPageSizes
+	if read, ok := c.Get(utils.PageSizesKey).(func() utils.PageSizes); ok {
+		sizes = read()
+	}
+	if sizes.Max <= 0 {
+		sizes.Max = utils.DefaultPageSizes.Max
+	}
+	if sizes.Default <= 0 || sizes.Default > sizes.Max {
+		sizes.Default = sizes.Max
+	}
+
+	query := c.QueryParams()
+	errs := validation.Errors{}
+
+	pagination.Page = 1
+	if value := query.Get("page"); value != "" {
+		page, err := strconv.Atoi(value)
+		if err != nil || page < 1 {
+			errs["page"] = errors.New("must be a positive integer")
+		}
+		pagination.Page = page
+	}
+
+	sizeKey, size := "per_page", query.Get("per_page")
+	if size == "" {
+		sizeKey, size = "limit", query.Get("limit")
+	}
+	pagination.Limit = sizes.Default
+	if size != "" {
+		limit, err := strconv.Atoi(size)
+		if err != nil || limit < 1 || limit > sizes.Max {
+			errs[sizeKey] = fmt.Errorf("must be between 1 and %d", sizes.Max)
+		}
+		pagination.Limit = limit
+	}
+
+	pagination.Cursor = query.Get("cursor")
+	if _, ok := query["cursor"]; ok && !cursorPattern.MatchString(pagination.Cursor) {
+		errs["cursor"] = errors.New("must be a cursor returned by the api")
+	}
+	if _, invalid := errs["page"]; !invalid && pagination.Cursor != "" && query.Get("page") != "" {
+		errs["page"] = errors.New("can not be combined with a cursor")
+	}
+
+	pagination.Lite, _ = c.Get(utils.LitePaginationKey).(bool)
+	if value := query.Get("lite"); value != "" {
+		lite, err := strconv.ParseBool(value)
+		if err != nil {
+			errs["lite"] = errors.New("must be a boolean")
+		}
+		pagination.Lite = lite
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type RefreshRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		RefreshToken string `json:"refresh_token" form:"refresh_token" xml:"refresh_token"`
+		DeviceID     string `json:"device_id" form:"device_id" xml:"device_id"`
+	}
+}
+
+func (r RefreshRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.RefreshToken, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Body.DeviceID, validation.Required, validation.Length(1, 100)),
+	)
+}
@@ -0,0 +1,41 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+)
+
+type RegisterRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Name           string `json:"name" form:"name" xml:"name"`
+		Email          string `json:"email" form:"email" xml:"email"`
+		Password       string `json:"password" form:"password" xml:"password"`
+		InvitationCode string `json:"invitation_code" form:"invitation_code" xml:"invitation_code"`
+		CaptchaToken   string `json:"captcha_token" form:"captcha_token" xml:"captcha_token"`
+	}
+}
+
+func (r RegisterRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Name, validation.Required, validation.Length(2, 255)),
+		validation.Field(&r.Body.Email, validation.Required, validation.Length(4, 50), is.Email),
+		validation.Field(&r.Body.Password, validation.Required, validation.Length(8, 50)),
+		validation.Field(&r.Body.InvitationCode, validation.Length(0, 64)),
+		validation.Field(&r.Body.CaptchaToken, validation.Length(0, 4096)),
+	)
+}
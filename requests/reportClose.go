@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+// ReportCloseRequest is the request of resolving and dismissing a report
+type ReportCloseRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Report uint `param:"report"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Note string `json:"note" form:"note" xml:"note"`
+	}
+}
+
+func (r ReportCloseRequest) Validate() error {
+	if err := validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Report, validation.Required),
+	); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Note, validation.Length(0, 1000)),
+	)
+}
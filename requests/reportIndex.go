@@ -0,0 +1,44 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/models"
+	"gotham/utils"
+)
+
+type ReportIndexRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		Status string `query:"status"`
+		utils.Pagination
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+// GetStatus is the status of the listed reports, the open ones by default
+func (r ReportIndexRequest) GetStatus() string {
+	if r.QueryParams.Status == "" {
+		return models.ReportOpen
+	}
+	return r.QueryParams.Status
+}
+
+func (r ReportIndexRequest) Validate() error {
+	return validation.ValidateStruct(&r.QueryParams,
+		validation.Field(&r.QueryParams.Status, validation.In(models.ReportOpen, models.ReportResolved, models.ReportDismissed)),
+	)
+}
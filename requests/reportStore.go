@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+
+	"gotham/models"
+)
+
+type ReportStoreRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Type string `param:"type"`
+		ID   uint   `param:"id"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Reason  string `json:"reason" form:"reason" xml:"reason"`
+		Details string `json:"details" form:"details" xml:"details"`
+	}
+}
+
+func (r ReportStoreRequest) Validate() error {
+	if err := validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Type, validation.Required, validation.Length(1, 50)),
+		validation.Field(&r.PathParams.ID, validation.Required),
+	); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Reason, validation.Required, validation.In(models.ReportReasons...)),
+		validation.Field(&r.Body.Details, validation.Length(0, 1000)),
+	)
+}
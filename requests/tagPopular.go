@@ -0,0 +1,42 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+type TagPopularRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		Type  string `query:"type"`
+		Limit int    `query:"limit"`
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+// GetLimit is the number of tags returned, 20 by default
+func (r TagPopularRequest) GetLimit() int {
+	if r.QueryParams.Limit == 0 {
+		return 20
+	}
+	return r.QueryParams.Limit
+}
+
+func (r TagPopularRequest) Validate() error {
+	return validation.ValidateStruct(&r.QueryParams,
+		validation.Field(&r.QueryParams.Type, validation.Length(0, 50)),
+		validation.Field(&r.QueryParams.Limit, validation.Min(0), validation.Max(100)),
+	)
+}
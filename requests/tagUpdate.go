@@ -0,0 +1,42 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+// TagUpdateRequest is the request of attaching, detaching and syncing the tags of a taggable
+type TagUpdateRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		Type string `param:"type"`
+		ID   uint   `param:"id"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Tags []string `json:"tags" form:"tags" xml:"tags"`
+	}
+}
+
+func (r TagUpdateRequest) Validate() error {
+	if err := validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.Type, validation.Required, validation.Length(1, 50)),
+		validation.Field(&r.PathParams.ID, validation.Required),
+	); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Tags, validation.Length(0, 20), validation.Each(validation.Required, validation.Length(1, 50))),
+	)
+}
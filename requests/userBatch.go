@@ -0,0 +1,89 @@
+package requests
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+// the most ids of a batch of users, a longer list than the one of the url is posted
+const (
+	UserBatchQueryMaxIDs = 100
+	UserBatchBodyMaxIDs  = 1000
+)
+
+type UserBatchRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		IDs string `query:"ids"`
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		IDs []uint `json:"ids" form:"ids" xml:"ids"`
+	}
+}
+
+/**
+ * GetIDs
+ * the ids of the query, or of the body when posted, without the duplicates in the order of their first occurrence
+ */
+func (r UserBatchRequest) GetIDs() (ids []uint) {
+	seen := map[uint]bool{}
+	for _, id := range r.ids() {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (r UserBatchRequest) ids() (ids []uint) {
+	if r.QueryParams.IDs == "" {
+		return r.Body.IDs
+	}
+	for _, value := range strings.Split(r.QueryParams.IDs, ",") {
+		id, _ := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		ids = append(ids, uint(id))
+	}
+	return ids
+}
+
+func (r UserBatchRequest) Validate() error {
+	ids := validation.By(func(value interface{}) error {
+		for _, id := range r.ids() {
+			if id == 0 {
+				return errors.New("must be positive integers")
+			}
+		}
+		return nil
+	})
+	if r.QueryParams.IDs != "" {
+		return validation.Errors{
+			"ids": validation.Validate(r.QueryParams.IDs, ids, validation.By(func(value interface{}) error {
+				if len(r.ids()) > UserBatchQueryMaxIDs {
+					return fmt.Errorf("can not have more than %d ids, post a longer list", UserBatchQueryMaxIDs)
+				}
+				return nil
+			})),
+		}.Filter()
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.IDs, validation.Required, validation.Length(1, UserBatchBodyMaxIDs), ids),
+	)
+}
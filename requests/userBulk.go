@@ -0,0 +1,98 @@
+package requests
+
+import (
+	"github.com/go-ozzo/ozzo-validation"
+)
+
+// UserBulkMaxOperations is the most operations of a bulk request on users
+const UserBulkMaxOperations = 100
+
+/**
+ * UserBulkUpdateOperation
+ * the changes of a user, the fields left out are not changed. It is validated on its own, an invalid one fails alone
+ */
+type UserBulkUpdateOperation struct {
+	ID           uint    `json:"id" form:"id" xml:"id"`
+	Name         *string `json:"name" form:"name" xml:"name"`
+	Verified     *bool   `json:"verified" form:"verified" xml:"verified"`
+	Timezone     *string `json:"timezone" form:"timezone" xml:"timezone"`
+	DailyQuota   *int    `json:"daily_quota" form:"daily_quota" xml:"daily_quota"`
+	MonthlyQuota *int    `json:"monthly_quota" form:"monthly_quota" xml:"monthly_quota"`
+}
+
+func (o UserBulkUpdateOperation) Validate() error {
+	return validation.ValidateStruct(&o,
+		validation.Field(&o.ID, validation.Required),
+		validation.Field(&o.Name, validation.NilOrNotEmpty, validation.Length(2, 255)),
+		validation.Field(&o.Timezone, validation.NilOrNotEmpty, validation.Length(1, 64), timezoneRule),
+		validation.Field(&o.DailyQuota, validation.Min(0)),
+		validation.Field(&o.MonthlyQuota, validation.Min(0)),
+	)
+}
+
+type UserBulkUpdateRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Operations []UserBulkUpdateOperation `json:"operations" form:"operations" xml:"operations"`
+	}
+}
+
+// Validate validates the list of the operations, each operation is validated with its own result
+func (r UserBulkUpdateRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Operations, validation.Required, validation.Length(1, UserBulkMaxOperations), validation.Skip),
+	)
+}
+
+// UserBulkDeleteOperation the user to delete
+type UserBulkDeleteOperation struct {
+	ID uint `json:"id" form:"id" xml:"id"`
+}
+
+func (o UserBulkDeleteOperation) Validate() error {
+	return validation.ValidateStruct(&o,
+		validation.Field(&o.ID, validation.Required),
+	)
+}
+
+type UserBulkDeleteRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Operations []UserBulkDeleteOperation `json:"operations" form:"operations" xml:"operations"`
+	}
+}
+
+// Validate validates the list of the operations, each operation is validated with its own result
+func (r UserBulkDeleteRequest) Validate() error {
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Operations, validation.Required, validation.Length(1, UserBulkMaxOperations), validation.Skip),
+	)
+}
@@ -1,7 +1,10 @@
 package requests
 
 import (
+	"strings"
+
 	validation "github.com/go-ozzo/ozzo-validation"
+	"gotham/helpers"
 	"gotham/utils"
 )
 
@@ -17,6 +20,7 @@ type UserIndexRequest struct {
 	 * QueryParams
 	 */
 	QueryParams struct {
+		Tags string `query:"tags"`
 		utils.Order
 		utils.Pagination
 	}
@@ -27,6 +31,16 @@ type UserIndexRequest struct {
 	Body struct{}
 }
 
+// GetTags are the slugs of the comma separated tags filter
+func (r UserIndexRequest) GetTags() (slugs []string) {
+	for _, tag := range strings.Split(r.QueryParams.Tags, ",") {
+		if slug := helpers.Slugify(tag); slug != "" {
+			slugs = append(slugs, slug)
+		}
+	}
+	return slugs
+}
+
 func (r UserIndexRequest) Validate() error {
 	return nil
 }
@@ -0,0 +1,143 @@
+package requests
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+
+	"gotham/models"
+)
+
+type UserPatchRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		User uint `param:"user"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 * the patch document, read as it is
+	 */
+	Body struct{}
+}
+
+func (r UserPatchRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.User, validation.Required),
+	)
+}
+
+/**
+ * UserPatch
+ * the document of a user a PATCH applies to, the fields of the user it can change
+ */
+type UserPatch struct {
+	Name         string  `json:"name"`
+	Image        *string `json:"image"`
+	Timezone     string  `json:"timezone"`
+	Verified     bool    `json:"verified"`
+	Admin        bool    `json:"admin"`
+	DailyQuota   *int    `json:"daily_quota"`
+	MonthlyQuota *int    `json:"monthly_quota"`
+}
+
+func NewUserPatch(user models.User) UserPatch {
+	return UserPatch{
+		Name:         user.Name,
+		Image:        user.Image,
+		Timezone:     user.Timezone,
+		Verified:     user.Verified,
+		Admin:        user.Admin,
+		DailyQuota:   user.DailyQuota,
+		MonthlyQuota: user.MonthlyQuota,
+	}
+}
+
+/**
+ * DecodeUserPatch
+ * the patched document of a user, a member that is not a field of the document or has another type is invalid like
+ * the fields failing the validation
+ */
+func DecodeUserPatch(document []byte) (patched UserPatch, err error) {
+	var members map[string]json.RawMessage
+	if err = json.Unmarshal(document, &members); err != nil || members == nil {
+		return patched, validation.Errors{"user": errors.New("must stay an object")}
+	}
+	fields := map[string]interface{}{
+		"name":          &patched.Name,
+		"image":         &patched.Image,
+		"timezone":      &patched.Timezone,
+		"verified":      &patched.Verified,
+		"admin":         &patched.Admin,
+		"daily_quota":   &patched.DailyQuota,
+		"monthly_quota": &patched.MonthlyQuota,
+	}
+	errs := validation.Errors{}
+	for member, value := range members {
+		field, ok := fields[member]
+		if !ok {
+			errs[member] = errors.New("is not a field of the user")
+		} else if err = json.Unmarshal(value, field); err != nil {
+			errs[member] = errors.New("has a value of another type")
+		}
+	}
+	if len(errs) > 0 {
+		return patched, errs
+	}
+	return patched, patched.Validate()
+}
+
+func (p UserPatch) Validate() error {
+	return validation.ValidateStruct(&p,
+		validation.Field(&p.Name, validation.Required, validation.Length(2, 255)),
+		validation.Field(&p.Image, validation.NilOrNotEmpty, validation.Length(1, 500), is.URL),
+		validation.Field(&p.Timezone, validation.Required, validation.Length(1, 64), timezoneRule),
+		validation.Field(&p.DailyQuota, validation.Min(0)),
+		validation.Field(&p.MonthlyQuota, validation.Min(0)),
+	)
+}
+
+// Changes are the columns of the fields the patched document changed, with their new values
+func (p UserPatch) Changes(patched UserPatch) map[string]interface{} {
+	changes := map[string]interface{}{}
+	if patched.Name != p.Name {
+		changes["name"] = patched.Name
+	}
+	if !samePointer(patched.Image, p.Image) {
+		changes["image"] = patched.Image
+	}
+	if patched.Timezone != p.Timezone {
+		changes["timezone"] = patched.Timezone
+	}
+	if patched.Verified != p.Verified {
+		changes["verified"] = patched.Verified
+	}
+	if patched.Admin != p.Admin {
+		changes["admin"] = patched.Admin
+	}
+	if !samePointer(patched.DailyQuota, p.DailyQuota) {
+		changes["daily_quota"] = patched.DailyQuota
+	}
+	if !samePointer(patched.MonthlyQuota, p.MonthlyQuota) {
+		changes["monthly_quota"] = patched.MonthlyQuota
+	}
+	return changes
+}
+
+func samePointer[T comparable](a *T, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
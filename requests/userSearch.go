@@ -0,0 +1,62 @@
+package requests
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"gotham/helpers"
+	"gotham/utils"
+)
+
+type UserSearchRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct{}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		Q         string `query:"q"`
+		Tag       string `query:"tag"`
+		Verified  string `query:"verified"`
+		Suspended string `query:"suspended"`
+		utils.Pagination
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+// GetTag is the slug of the tag filter
+func (r UserSearchRequest) GetTag() string {
+	return helpers.Slugify(r.QueryParams.Tag)
+}
+
+// GetVerified and GetSuspended are the boolean filters, nil when they are not given
+func (r UserSearchRequest) GetVerified() *bool {
+	return optionalBool(r.QueryParams.Verified)
+}
+
+func (r UserSearchRequest) GetSuspended() *bool {
+	return optionalBool(r.QueryParams.Suspended)
+}
+
+func optionalBool(value string) *bool {
+	if value == "" {
+		return nil
+	}
+	b := value == "true"
+	return &b
+}
+
+func (r UserSearchRequest) Validate() error {
+	return validation.ValidateStruct(&r.QueryParams,
+		validation.Field(&r.QueryParams.Q, validation.Length(0, 100)),
+		validation.Field(&r.QueryParams.Verified, validation.In("true", "false")),
+		validation.Field(&r.QueryParams.Suspended, validation.In("true", "false")),
+	)
+}
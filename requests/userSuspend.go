@@ -0,0 +1,49 @@
+package requests
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type UserSuspendRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		User uint `param:"user"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct{}
+
+	/**
+	 * Body
+	 */
+	Body struct {
+		Until  *time.Time `json:"until" form:"until" xml:"until"`
+		Reason string     `json:"reason" form:"reason" xml:"reason"`
+	}
+}
+
+func (r UserSuspendRequest) Validate() error {
+	if err := validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.User, validation.Required),
+	); err != nil {
+		return err
+	}
+	return validation.ValidateStruct(&r.Body,
+		validation.Field(&r.Body.Until, validation.By(func(value interface{}) error {
+			if until, _ := value.(*time.Time); until != nil && !until.After(time.Now()) {
+				return errors.New("must be in the future")
+			}
+			return nil
+		})),
+		validation.Field(&r.Body.Reason, validation.Length(0, 500)),
+	)
+}
@@ -0,0 +1,37 @@
+package requests
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type UserVersionDiffRequest struct {
+	validation.Validatable `json:"-" form:"-" query:"-"`
+
+	/**
+	 * PathParams
+	 */
+	PathParams struct {
+		User    uint `param:"user"`
+		Version uint `param:"version"`
+	}
+
+	/**
+	 * QueryParams
+	 */
+	QueryParams struct {
+		// With is the version compared to, the current user without it
+		With uint `query:"with"`
+	}
+
+	/**
+	 * Body
+	 */
+	Body struct{}
+}
+
+func (r UserVersionDiffRequest) Validate() error {
+	return validation.ValidateStruct(&r.PathParams,
+		validation.Field(&r.PathParams.User, validation.Required),
+		validation.Field(&r.PathParams.Version, validation.Required),
+	)
+}
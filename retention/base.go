@@ -0,0 +1,35 @@
+package retention
+
+import (
+	"time"
+
+	"gotham/app"
+	"gotham/models"
+	"gotham/services"
+)
+
+func Initialize() {
+	retention := app.Application.Container.GetRetentionService()
+
+	// sessions, archived for the history of the devices signed in
+	retention.Register(services.RetentionPolicy{Name: models.Session{}.TableName(), Model: models.Session{}, Column: "expires_at", Keep: 30 * 24 * time.Hour, Archive: true})
+
+	// magic links and email changes, useless once expired
+	retention.Register(services.RetentionPolicy{Name: models.MagicLink{}.TableName(), Model: models.MagicLink{}, Column: "expires_at", Keep: 7 * 24 * time.Hour})
+	retention.Register(services.RetentionPolicy{Name: models.EmailChange{}.TableName(), Model: models.EmailChange{}, Column: "expires_at", Keep: 7 * 24 * time.Hour})
+
+	// sagas, kept for a while to look into the failed ones
+	retention.Register(services.RetentionPolicy{Name: models.Saga{}.TableName(), Model: models.Saga{}, Column: "updated_at", Keep: 30 * 24 * time.Hour})
+
+	// dead letters, the ones nobody replayed or purged by then
+	retention.Register(services.RetentionPolicy{Name: models.DeadLetter{}.TableName(), Model: models.DeadLetter{}, Column: "created_at", Keep: 30 * 24 * time.Hour})
+
+	// deprecated calls, the callers that stopped calling a deprecated route
+	retention.Register(services.RetentionPolicy{Name: models.DeprecatedCall{}.TableName(), Model: models.DeprecatedCall{}, Column: "last_called_at", Keep: 90 * 24 * time.Hour})
+
+	// config snapshots, the configurations no instance loaded since
+	retention.Register(services.RetentionPolicy{Name: models.ConfigSnapshot{}.TableName(), Model: models.ConfigSnapshot{}, Column: "loaded_at", Keep: 90 * 24 * time.Hour})
+
+	// usage, archived for the billing disputes
+	retention.Register(services.RetentionPolicy{Name: models.ApiUsage{}.TableName(), Model: models.ApiUsage{}, Column: "created_at", Keep: 400 * 24 * time.Hour, Archive: true})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -15,9 +16,76 @@ import (
 	"gotham/controllers"
 	"gotham/docs"
 	GMiddleware "gotham/middlewares"
+	"gotham/policies"
+	"gotham/problems"
+	"gotham/services"
 )
 
 func Route(e *echo.Echo) {
+	Register(e)
+
+	server, redirect, err := Server(e, config.Conf.TLS)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	var redirectPort string
+	if redirect != nil {
+		redirectPort = config.Conf.TLS.RedirectPort
+	}
+	listener, redirectListener, err := Listeners(config.Conf.Listen, redirectPort)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	Listen(e, server, listener)
+
+	// Start server
+	go func() {
+		if err := e.StartServer(server); err != nil {
+			e.Logger.Info("shutting down the server")
+		}
+	}()
+	if redirect != nil {
+		go func() {
+			if err := redirect.Serve(redirectListener); err != nil {
+				e.Logger.Info("shutting down the redirect server")
+			}
+		}()
+	} else if redirectListener != nil {
+		_ = redirectListener.Close()
+		redirectListener = nil
+	}
+	if err := Ready(); err != nil {
+		e.Logger.Error(err)
+	}
+
+	// SIGHUP restarts gracefully, a new process takes the listeners over before this one drains
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+	for sig := range quit {
+		if sig != syscall.SIGHUP {
+			break
+		}
+		if err := Restart(listener, redirectListener, config.Conf.Listen.RestartTimeout); err != nil {
+			e.Logger.Error(err)
+			continue
+		}
+		e.Logger.Info("the new process took the listeners over, draining")
+		break
+	}
+
+	// the requests in flight finish, the workers stop with the app container in main
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := e.Shutdown(ctx); err != nil {
+		e.Logger.Fatal(err)
+	}
+}
+
+/**
+ * Register
+ * register middlewares and routes without starting the server
+ */
+func Register(e *echo.Echo) {
 	docs.SwaggerInfo.Title = "Gotham API"
 	docs.SwaggerInfo.Description = "..."
 	docs.SwaggerInfo.Version = "1.0"
@@ -25,48 +93,265 @@ func Route(e *echo.Echo) {
 	docs.SwaggerInfo.BasePath = "/"
 	docs.SwaggerInfo.Schemes = []string{"v1"}
 
-	e.Use(middleware.Logger())
-	e.Use(middleware.Recover())
+	e.HTTPErrorHandler = problems.HTTPErrorHandler
+
+	e.Use(app.Application.Container.GetAccessLogMiddleware().AccessLogMiddleware)
+	e.Use(app.Application.Container.GetRecoveryMiddleware().RecoveryMiddleware)
+	e.Use(app.Application.Container.GetSlowRequestMiddleware().SlowRequestMiddleware)
+	e.Use(app.Application.Container.GetSloMiddleware().SloMiddleware)
+	e.Use(app.Application.Container.GetPageSizesMiddleware().PageSizesMiddleware)
 	e.Use(middleware.CORS())
+	e.Use((&GMiddleware.Compression{Config: &config.Conf.Compression}).CompressionMiddleware)
+	e.Use(GMiddleware.Negotiation{}.NegotiationMiddleware)
+	e.Use(app.Application.Container.GetShadowMiddleware().ShadowMiddleware)
+	e.Use(app.Application.Container.GetRecorderMiddleware().RecorderMiddleware)
+	e.Use(app.Application.Container.GetAnalyticsMiddleware().AnalyticsMiddleware)
+	e.Use(app.Application.Container.GetBudgetMiddleware().BudgetMiddleware)
+	e.Use(app.Application.Container.GetContractMiddleware().ContractMiddleware)
+	// the routes running dry are allowed where they are registered
+	dryRun := GMiddleware.NewDryRun()
+	e.Use(dryRun.DryRunMiddleware)
+	// the routes deprecated are marked where they are registered, with deprecated.Deprecate(route, since, sunset, link)
+	deprecated := app.Application.Container.GetDeprecationMiddleware()
+	e.Use(deprecated.DeprecationMiddleware)
 
 	e.GET("/doc/*", echoSwagger.WrapHandler)
 
 	// server
 	e.GET("/status/ping", controllers.ServerController{}.Ping)
 	e.GET("/status/version", controllers.ServerController{}.Version)
+	e.GET("/status/metrics", app.Application.Container.GetMetricsController().Index)
+	e.GET("/errors", controllers.ServerController{}.Errors)
+
+	// admin ui
+	if config.Conf.AdminUI.Enabled {
+		e.GET("/admin/ui", app.Application.Container.GetAdminUiController().Serve)
+		e.GET("/admin/ui/*", app.Application.Container.GetAdminUiController().Serve)
+		e.HEAD("/admin/ui/*", app.Application.Container.GetAdminUiController().Serve)
+	}
 
 	v1 := e.Group("/v1")
 
 	// login
 	v1.POST("/login", app.Application.Container.GetAuthController().Login)
+	v1.POST("/register", app.Application.Container.GetAuthController().Register)
+	v1.POST("/auth/magic-link", app.Application.Container.GetAuthController().MagicLink)
+	v1.GET("/auth/magic-link/callback", app.Application.Container.GetAuthController().MagicLinkCallback)
+	v1.POST("/auth/refresh", app.Application.Container.GetAuthController().Refresh)
+	v1.POST("/auth/logout", app.Application.Container.GetAuthController().Logout)
+	v1.GET("/verifications/confirm", app.Application.Container.GetAuthController().Verify)
+	v1.GET("/email-changes/confirm", app.Application.Container.GetAccountController().ConfirmEmailChange)
+
+	// policies
+	v1.GET("/policies/current", app.Application.Container.GetConsentController().Current)
+
+	// billing
+	v1.GET("/billing/plans", app.Application.Container.GetBillingController().Plans)
+	v1.POST("/billing/webhook", app.Application.Container.GetBillingController().Webhook)
+	v1.GET("/billing/invoices/:invoice/download", app.Application.Container.GetBillingController().DownloadInvoice)
+	v1.HEAD("/billing/invoices/:invoice/download", app.Application.Container.GetBillingController().DownloadInvoice)
+
+	// oauth, the client credentials grant of the internal services
+	v1.POST("/oauth/token", app.Application.Container.GetClientController().Token)
 
 	r := v1.Group("/restricted")
+	// every route of the group declares its scope with scopes.Require, the scoped tokens are refused the others
+	scopes := GMiddleware.NewScopes("/v1/restricted")
 
 	c := middleware.JWTConfig{
 		Claims:     &config.JwtCustomClaims{},
 		SigningKey: []byte(config.Conf.SecretKey),
 	}
 
-	r.Use(middleware.JWTWithConfig(c))
+	// internal, the routes of the internal services are rate limited per client, apart from the quotas of the users. A
+	// client authenticates with a token or with a verified client certificate, its requests are accepted once with
+	// REPLAY_PROTECTION_ENABLED
+	certified := c
+	certified.Skipper = GMiddleware.HasClientCertificate
+
+	i := v1.Group("/internal", middleware.JWTWithConfig(certified), app.Application.Container.GetClientCertificateMiddleware().ClientCertificateMiddleware, app.Application.Container.GetClientMiddleware().ClientMiddleware, app.Application.Container.GetReplayMiddleware().ReplayMiddleware)
+	i.GET("/users/:user", app.Application.Container.GetInternalController().User, GMiddleware.And(GMiddleware.RequireScope{Scope: policies.ScopeUsersRead}))
+
+	// debug, the profiles and runtime stats of the instance for the admins
+	if config.Conf.Debug.Enabled {
+		d := e.Group("/debug", middleware.JWTWithConfig(c), app.Application.Container.GetAuthMiddleware().AuthMiddleware, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware()))
+		d.GET("/pprof/*", controllers.DebugController{}.Pprof)
+		d.POST("/pprof/symbol", controllers.DebugController{}.Pprof)
+		d.GET("/vars", controllers.DebugController{}.Vars)
+		d.GET("/stats", controllers.DebugController{}.Stats)
+	}
+
+	// machine clients authenticate with an api key instead of a bearer token
+	keyed := c
+	keyed.Skipper = GMiddleware.HasApiKey
+
+	r.Use(middleware.JWTWithConfig(keyed))
+	r.Use(app.Application.Container.GetApiKeyMiddleware().ApiKeyMiddleware)
+	r.Use(app.Application.Container.GetReplayMiddleware().ReplayMiddleware)
 	r.Use(app.Application.Container.GetAuthMiddleware().AuthMiddleware)
+	r.Use(scopes.ScopesMiddleware)
+	r.Use(GMiddleware.Suspension{}.SuspensionMiddleware)
+	r.Use(GMiddleware.Timezone{}.TimezoneMiddleware)
+	r.Use(app.Application.Container.GetQuotaMiddleware().QuotaMiddleware)
+	r.Use(app.Application.Container.GetFeaturesMiddleware().FeaturesMiddleware)
+	r.Use(app.Application.Container.GetConsentMiddleware().ConsentMiddleware)
+
+	// sensitive operations need a password confirmed within the sudo window
+	recentAuth := GMiddleware.RequireRecentAuth{MaxAge: config.Conf.Session.SudoTTL, Clock: app.Application.Container.GetClock()}
+
+	// auth
+	scopes.Require(policies.ScopeAccountWrite, r.POST("/auth/sudo", app.Application.Container.GetAuthController().Sudo, GMiddleware.And(GMiddleware.NotImpersonating{})))
 
 	// user
-	r.GET("/users/:user", app.Application.Container.GetUserController().Show, GMiddleware.Or(app.Application.Container.GetIsAdminMiddleware(), app.Application.Container.GetIsVerifiedMiddleware()))
-	r.GET("/users", app.Application.Container.GetUserController().Index)
+	scopes.Require(policies.ScopeUsersRead, r.GET("/users/:user", app.Application.Container.GetUserController().Show, GMiddleware.Or(app.Application.Container.GetIsAdminMiddleware(), app.Application.Container.GetIsVerifiedMiddleware()), app.Application.Container.GetAccessRulesMiddleware().Require("access_rule_users_show", policies.View, GMiddleware.UserResource(app.Application.Container.GetUserService())), app.Application.Container.GetResponseCacheMiddleware().CacheMiddleware(services.CacheTagUsers)))
+	dryRun.Allow(scopes.Require(policies.ScopeUsersWrite, r.PATCH("/users/:user", app.Application.Container.GetUserController().Patch, app.Application.Container.GetAccessRulesMiddleware().Require("access_rule_users_patch", policies.Update, GMiddleware.UserResource(app.Application.Container.GetUserService())))))
+	scopes.Require(policies.ScopeUsersRead, r.GET("/users", app.Application.Container.GetUserController().Index, app.Application.Container.GetResponseCacheMiddleware().CacheMiddleware(services.CacheTagUsers, services.CacheTagSettings, services.CacheTagTags)))
+	scopes.Require(policies.ScopeUsersRead, r.POST("/users/batch", app.Application.Container.GetUserController().Batch))
+	dryRun.Allow(scopes.Require(policies.ScopeUsersWrite, r.PATCH("/users/bulk", app.Application.Container.GetUserController().BulkUpdate, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware()))))
+	dryRun.Allow(scopes.Require(policies.ScopeUsersWrite, r.DELETE("/users/bulk", app.Application.Container.GetUserController().BulkDestroy, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware()))))
 
-	// Start server
-	go func() {
-		if err := e.Start(":" + config.Conf.Port); err != nil {
-			e.Logger.Info("shutting down the server")
-		}
-	}()
+	// follows
+	scopes.Require(policies.ScopeSocialWrite, r.POST("/users/:user/follow", app.Application.Container.GetFollowController().Follow, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeSocialWrite, r.DELETE("/users/:user/follow", app.Application.Container.GetFollowController().Unfollow, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeSocialRead, r.GET("/users/:user/relationship", app.Application.Container.GetFollowController().Relationship)).Name = "users.relationship"
+	scopes.Require(policies.ScopeSocialRead, r.GET("/users/:user/followers", app.Application.Container.GetFollowController().Followers)).Name = "users.followers"
+	scopes.Require(policies.ScopeSocialRead, r.GET("/users/:user/following", app.Application.Container.GetFollowController().Following)).Name = "users.following"
 
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt)
-	<-quit
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	if err := e.Shutdown(ctx); err != nil {
-		e.Logger.Fatal(err)
-	}
+	// conversations
+	scopes.Require(policies.ScopeConversationsRead, r.GET("/conversations", app.Application.Container.GetConversationController().Index))
+	scopes.Require(policies.ScopeConversationsWrite, r.POST("/conversations", app.Application.Container.GetConversationController().Store, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeConversationsRead, r.GET("/conversations/unread", app.Application.Container.GetConversationController().Unread))
+	scopes.Require(policies.ScopeConversationsRead, r.GET("/conversations/:conversation/messages", app.Application.Container.GetConversationController().Messages))
+	scopes.Require(policies.ScopeConversationsWrite, r.POST("/conversations/:conversation/messages", app.Application.Container.GetConversationController().Send, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeConversationsWrite, r.POST("/conversations/:conversation/read", app.Application.Container.GetConversationController().Read, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeConversationsRead, r.GET("/ws", app.Application.Container.GetSocketController().Connect))
+
+	// policies
+	scopes.Require(policies.ScopeAccountWrite, r.POST("/policies/:policy/accept", app.Application.Container.GetConsentController().Accept))
+
+	// account
+	scopes.Require(policies.ScopeAccountWrite, r.POST("/users/me/data-export", app.Application.Container.GetAccountController().RequestDataExport, GMiddleware.And(GMiddleware.NotImpersonating{}, recentAuth)))
+	scopes.Require(policies.ScopeAccountRead, r.GET("/users/me/data-exports/:export", app.Application.Container.GetAccountController().ShowDataExport))
+	scopes.Require(policies.ScopeAccountRead, r.GET("/users/me/data-exports/:export/download", app.Application.Container.GetAccountController().DownloadDataExport, GMiddleware.And(GMiddleware.NotImpersonating{}, recentAuth)))
+	scopes.Require(policies.ScopeAccountRead, r.HEAD("/users/me/data-exports/:export/download", app.Application.Container.GetAccountController().DownloadDataExport, GMiddleware.And(GMiddleware.NotImpersonating{}, recentAuth)))
+	scopes.Require(policies.ScopeAccountWrite, r.DELETE("/users/me", app.Application.Container.GetAccountController().Destroy, GMiddleware.And(GMiddleware.NotImpersonating{}, recentAuth)))
+	scopes.Require(policies.ScopeAccountWrite, r.POST("/users/me/restore", app.Application.Container.GetAccountController().Restore, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeAccountRead, r.GET("/users/me/usage", app.Application.Container.GetAccountController().Usage))
+	dryRun.Allow(scopes.Require(policies.ScopeAccountWrite, r.PUT("/users/me/preferences", app.Application.Container.GetAccountController().UpdatePreferences)))
+	scopes.Require(policies.ScopeAccountWrite, r.POST("/users/me/email", app.Application.Container.GetAccountController().ChangeEmail, GMiddleware.And(GMiddleware.NotImpersonating{}, recentAuth)))
+	scopes.Require(policies.ScopeAccountWrite, r.PUT("/users/me/password", app.Application.Container.GetAuthController().ChangePassword, GMiddleware.And(GMiddleware.NotImpersonating{}, recentAuth)))
+
+	// api keys, a key can not issue keys as it has no confirmed password
+	scopes.Require(policies.ScopeAccountRead, r.GET("/users/me/api-keys", app.Application.Container.GetApiKeyController().Index))
+	scopes.Require(policies.ScopeAccountWrite, r.POST("/users/me/api-keys", app.Application.Container.GetApiKeyController().Store, GMiddleware.And(GMiddleware.NotImpersonating{}, recentAuth)))
+	scopes.Require(policies.ScopeAccountWrite, r.DELETE("/users/me/api-keys/:key", app.Application.Container.GetApiKeyController().Destroy, GMiddleware.And(GMiddleware.NotImpersonating{})))
+
+	// notifications
+	scopes.Require(policies.ScopeAccountRead, r.GET("/users/me/devices", app.Application.Container.GetNotificationController().Devices))
+	scopes.Require(policies.ScopeAccountWrite, r.POST("/users/me/devices", app.Application.Container.GetNotificationController().StoreDevice, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeAccountWrite, r.DELETE("/users/me/devices/:device", app.Application.Container.GetNotificationController().DestroyDevice, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeAccountRead, r.GET("/users/me/push-preferences", app.Application.Container.GetNotificationController().PushPreferences))
+	scopes.Require(policies.ScopeAccountWrite, r.PUT("/users/me/push-preferences", app.Application.Container.GetNotificationController().UpdatePushPreferences, GMiddleware.And(GMiddleware.NotImpersonating{})))
+
+	// phone
+	scopes.Require(policies.ScopeAccountWrite, r.PUT("/users/me/phone", app.Application.Container.GetPhoneController().Update, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeAccountWrite, r.POST("/users/me/phone/code", app.Application.Container.GetPhoneController().SendCode, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeAccountWrite, r.POST("/users/me/phone/verify", app.Application.Container.GetPhoneController().Verify, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeAccountWrite, r.DELETE("/users/me/phone", app.Application.Container.GetPhoneController().Destroy, GMiddleware.And(GMiddleware.NotImpersonating{})))
+
+	// billing, premium routes are gated with GMiddleware.And(app.Application.Container.GetBillingMiddleware().RequirePlan("pro"))
+	scopes.Require(policies.ScopeBillingRead, r.GET("/billing/subscription", app.Application.Container.GetBillingController().Subscription))
+	scopes.Require(policies.ScopeBillingWrite, r.POST("/billing/checkout", app.Application.Container.GetBillingController().Checkout, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeBillingWrite, r.DELETE("/billing/subscription", app.Application.Container.GetBillingController().Cancel, GMiddleware.And(GMiddleware.NotImpersonating{}, recentAuth)))
+	scopes.Require(policies.ScopeBillingRead, r.GET("/billing/invoices", app.Application.Container.GetBillingController().Invoices))
+	scopes.Require(policies.ScopeBillingWrite, r.POST("/billing/coupons/validate", app.Application.Container.GetBillingController().ValidateCoupon))
+
+	// votes
+	scopes.Require(policies.ScopeSocialRead, r.GET("/votes/:type/:id", app.Application.Container.GetVoteController().Show))
+	scopes.Require(policies.ScopeSocialWrite, r.PUT("/votes/:type/:id/like", app.Application.Container.GetVoteController().Like, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeSocialWrite, r.PUT("/votes/:type/:id/dislike", app.Application.Container.GetVoteController().Dislike, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeSocialWrite, r.DELETE("/votes/:type/:id", app.Application.Container.GetVoteController().Destroy, GMiddleware.And(GMiddleware.NotImpersonating{})))
+
+	// comments
+	scopes.Require(policies.ScopeCommentsRead, r.GET("/threads/:type/:id/comments", app.Application.Container.GetCommentController().Index)).Name = "comments.index"
+	scopes.Require(policies.ScopeCommentsWrite, r.POST("/threads/:type/:id/comments", app.Application.Container.GetCommentController().Store, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeCommentsWrite, r.PUT("/comments/:comment", app.Application.Container.GetCommentController().Update, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeCommentsWrite, r.DELETE("/comments/:comment", app.Application.Container.GetCommentController().Destroy, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeCommentsWrite, r.POST("/comments/:comment/flag", app.Application.Container.GetCommentController().Flag, GMiddleware.And(GMiddleware.NotImpersonating{})))
+
+	// tags
+	scopes.Require(policies.ScopeSocialRead, r.GET("/tags/popular", app.Application.Container.GetTagController().Popular))
+	scopes.Require(policies.ScopeSocialRead, r.GET("/tags/:type/:id", app.Application.Container.GetTagController().Show))
+	scopes.Require(policies.ScopeSocialWrite, r.POST("/tags/:type/:id", app.Application.Container.GetTagController().Attach, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeSocialWrite, r.PUT("/tags/:type/:id", app.Application.Container.GetTagController().Sync, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeSocialWrite, r.DELETE("/tags/:type/:id", app.Application.Container.GetTagController().Detach, GMiddleware.And(GMiddleware.NotImpersonating{})))
+
+	// media
+	scopes.Require(policies.ScopeMediaRead, r.GET("/media", app.Application.Container.GetMediaController().Index))
+	scopes.Require(policies.ScopeMediaWrite, r.POST("/media", app.Application.Container.GetMediaController().Store, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeMediaRead, r.GET("/media/:media", app.Application.Container.GetMediaController().Show)).Name = "media.show"
+	scopes.Require(policies.ScopeMediaRead, r.GET("/media/:media/download", app.Application.Container.GetMediaController().Download)).Name = "media.download"
+	scopes.Require(policies.ScopeMediaRead, r.HEAD("/media/:media/download", app.Application.Container.GetMediaController().Download))
+	scopes.Require(policies.ScopeMediaWrite, r.PUT("/media/:media/attachment", app.Application.Container.GetMediaController().Attach, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeMediaWrite, r.DELETE("/media/:media/attachment", app.Application.Container.GetMediaController().Detach, GMiddleware.And(GMiddleware.NotImpersonating{})))
+	scopes.Require(policies.ScopeMediaWrite, r.DELETE("/media/:media", app.Application.Container.GetMediaController().Destroy, GMiddleware.And(GMiddleware.NotImpersonating{})))
+
+	// announcements
+	scopes.Require(policies.ScopeSocialRead, r.GET("/announcements", app.Application.Container.GetAnnouncementController().Index))
+	scopes.Require(policies.ScopeSocialWrite, r.POST("/announcements/:announcement/read", app.Application.Container.GetAnnouncementController().Read, GMiddleware.And(GMiddleware.NotImpersonating{})))
+
+	// reports
+	scopes.Require(policies.ScopeSocialWrite, r.POST("/reports/:type/:id", app.Application.Container.GetReportController().Store, GMiddleware.And(GMiddleware.NotImpersonating{})))
+
+	// settings
+	scopes.Require(policies.ScopeAdmin, r.GET("/settings", app.Application.Container.GetSettingController().Index, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware()), app.Application.Container.GetResponseCacheMiddleware().CacheMiddleware(services.CacheTagSettings)))
+	scopes.Require(policies.ScopeAdmin, r.PUT("/settings/:setting", app.Application.Container.GetSettingController().Update, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+
+	// invitations
+	scopes.Require(policies.ScopeAdmin, r.GET("/invitations", app.Application.Container.GetInvitationController().Index, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/invitations", app.Application.Container.GetInvitationController().Store, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.DELETE("/invitations/:invitation", app.Application.Container.GetInvitationController().Destroy, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+
+	// admin
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/users", app.Application.Container.GetUserController().Search, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/users/:user/impersonate", app.Application.Container.GetImpersonationController().Store, GMiddleware.And(GMiddleware.NotImpersonating{}, recentAuth, app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/users/:user/suspension", app.Application.Container.GetSuspensionController().Store, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.DELETE("/admin/users/:user/suspension", app.Application.Container.GetSuspensionController().Destroy, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/users/:user/versions", app.Application.Container.GetUserHistoryController().Index, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/users/:user/versions/:version/diff", app.Application.Container.GetUserHistoryController().Diff, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/users/:user/versions/:version/restore", app.Application.Container.GetUserHistoryController().Restore, GMiddleware.And(GMiddleware.NotImpersonating{}, recentAuth, app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/coupons", app.Application.Container.GetCouponController().Index, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/coupons", app.Application.Container.GetCouponController().Store, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.PUT("/admin/coupons/:coupon", app.Application.Container.GetCouponController().Update, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.DELETE("/admin/coupons/:coupon", app.Application.Container.GetCouponController().Destroy, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/comments", app.Application.Container.GetCommentController().Queue, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware()), GMiddleware.LitePagination{}.LitePaginationMiddleware))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/comments/:comment/approve", app.Application.Container.GetCommentController().Approve, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/comments/:comment/remove", app.Application.Container.GetCommentController().Remove, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/media/usage", app.Application.Container.GetMediaController().Usage, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/announcements", app.Application.Container.GetAnnouncementController().AdminIndex, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/announcements", app.Application.Container.GetAnnouncementController().Store, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/announcements/:announcement/publish", app.Application.Container.GetAnnouncementController().Publish, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.DELETE("/admin/announcements/:announcement", app.Application.Container.GetAnnouncementController().Destroy, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/reports", app.Application.Container.GetReportController().Index, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/reports/:report/resolve", app.Application.Container.GetReportController().Resolve, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/reports/:report/dismiss", app.Application.Container.GetReportController().Dismiss, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/resources/:resource", app.Application.Container.GetAdminController().Index, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/resources/:resource", app.Application.Container.GetAdminController().Store, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/resources/:resource/:id", app.Application.Container.GetAdminController().Show, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware()))).Name = "admin.resources.show"
+	scopes.Require(policies.ScopeAdmin, r.PUT("/admin/resources/:resource/:id", app.Application.Container.GetAdminController().Update, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.DELETE("/admin/resources/:resource/:id", app.Application.Container.GetAdminController().Destroy, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/logging/level", app.Application.Container.GetLoggingController().Show, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.PUT("/admin/logging/level", app.Application.Container.GetLoggingController().Update, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/clients", app.Application.Container.GetClientController().Index, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/clients", app.Application.Container.GetClientController().Store, GMiddleware.And(GMiddleware.NotImpersonating{}, recentAuth, app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.DELETE("/admin/clients/:client", app.Application.Container.GetClientController().Destroy, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/dead-letters", app.Application.Container.GetDeadLetterController().Index, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.DELETE("/admin/dead-letters", app.Application.Container.GetDeadLetterController().Purge, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/dead-letters/replay", app.Application.Container.GetDeadLetterController().BulkReplay, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/dead-letters/:dead_letter", app.Application.Container.GetDeadLetterController().Show, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.POST("/admin/dead-letters/:dead_letter/replay", app.Application.Container.GetDeadLetterController().Replay, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.DELETE("/admin/dead-letters/:dead_letter", app.Application.Container.GetDeadLetterController().Destroy, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/deprecations", app.Application.Container.GetDeprecationController().Index, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/slo", app.Application.Container.GetSloController().Index, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
+	scopes.Require(policies.ScopeAdmin, r.GET("/admin/config", app.Application.Container.GetConfigController().Show, GMiddleware.And(app.Application.Container.GetIsAdminMiddleware())))
 }
@@ -0,0 +1,152 @@
+//go:build sqlite
+// +build sqlite
+
+package routers_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+	GMiddleware "gotham/middlewares"
+	"gotham/policies"
+	"gotham/problems"
+	"gotham/testutil/httptest"
+	"gotham/testutil/snapshot"
+)
+
+// TestSnapshots matches the schema of the responses to the golden files of testdata/snapshots, run the tests with
+// UPDATE_SNAPSHOTS=1 to write them again once a change of the api is intended
+func TestSnapshots(t *testing.T) {
+	server := httptest.New(t, nil)
+
+	tests := []struct {
+		name    string
+		request *httptest.Request
+		status  int
+	}{
+		{snapshot.Name("GET", "/status/ping"), server.GET("/status/ping"), http.StatusOK},
+		{snapshot.Name("GET", "/status/version"), server.GET("/status/version"), http.StatusOK},
+		{snapshot.Name("GET", "/errors"), server.GET("/errors"), http.StatusOK},
+		{snapshot.Name("GET", "/v1/restricted/users", "invalid token"), server.GET("/v1/restricted/users").Header(echo.HeaderAuthorization, "Bearer invalid"), http.StatusUnauthorized},
+	}
+	// the responses fail the test of the server, not a subtest
+	for _, test := range tests {
+		test.request.Do().AssertStatus(test.status).AssertSnapshot(test.name)
+	}
+}
+
+// TestSnapshotRoutes matches every GET route of the api, the admin routes as the admin and the others as a user
+func TestSnapshotRoutes(t *testing.T) {
+	server := httptest.New(t, nil)
+	user := server.User()
+
+	server.SnapshotRoutes(map[string]string{"user": fmt.Sprint(user.ID), "resource": "plans"}, func(request *httptest.Request) *httptest.Request {
+		path := request.Path()
+		switch {
+		// the recorder of the tests can not be hijacked by a websocket
+		case path == "/v1/restricted/ws":
+			return nil
+		case path == "/v1/restricted/users", path == "/v1/restricted/settings", path == "/v1/restricted/invitations", strings.HasPrefix(path, "/v1/restricted/admin/"):
+			return request.AsAdmin()
+		}
+		return request.AsUser(user)
+	})
+}
+
+func TestScopes(t *testing.T) {
+	server := httptest.New(t, nil)
+	user := server.User()
+
+	// a users:read key reads the users
+	server.GET(fmt.Sprintf("/v1/restricted/users/%d", user.ID)).AsApiKey(user, policies.ScopeUsersRead).Do().AssertStatus(http.StatusOK)
+
+	// and nothing else, the routes of the other scopes included
+	for _, request := range []*httptest.Request{
+		server.PATCH(fmt.Sprintf("/v1/restricted/users/%d", user.ID)).JSON(map[string]string{"name": "Scoped"}),
+		server.GET("/v1/restricted/conversations"),
+		server.PUT("/v1/restricted/votes/comments/1/like"),
+		server.GET("/v1/restricted/tags/popular"),
+		server.POST("/v1/restricted/reports/comments/1").JSON(map[string]string{"reason": "spam"}),
+		server.POST(fmt.Sprintf("/v1/restricted/users/%d/follow", user.ID)),
+		server.DELETE("/v1/restricted/users/me/phone"),
+		server.PUT("/v1/restricted/users/me/preferences").JSON(map[string]string{"locale": "en"}),
+		server.POST("/v1/restricted/billing/checkout").JSON(map[string]string{"plan": "pro"}),
+		server.DELETE("/v1/restricted/users/me/api-keys/1"),
+		server.GET("/v1/restricted/users/me/devices"),
+		server.GET("/v1/restricted/admin/users"),
+	} {
+		request.AsApiKey(user, policies.ScopeUsersRead).Do().
+			AssertStatus(http.StatusForbidden).
+			AssertJSON("code", problems.InsufficientScope.Code)
+	}
+
+	// a sign in has every scope
+	server.GET("/v1/restricted/conversations").AsUser(user).Do().AssertStatus(http.StatusOK)
+	// the unknown paths are not found whatever the scopes
+	server.GET("/v1/restricted/unknown").AsApiKey(user, policies.ScopeUsersRead).Do().AssertStatus(http.StatusNotFound)
+}
+
+func TestContract(t *testing.T) {
+	server := httptest.New(t, nil)
+	previous := config.Conf.Contract
+	config.Conf.Contract = config.Contract{Enabled: true, Strict: true}
+	t.Cleanup(func() {
+		config.Conf.Contract = previous
+	})
+
+	server.GET("/status/ping").Do().AssertStatus(http.StatusOK)
+
+	// a route missing from the spec fails in strict mode
+	server.Echo.GET("/v1/undocumented", func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+	server.GET("/v1/undocumented").Do().
+		AssertStatus(http.StatusInternalServerError).
+		AssertJSON("code", problems.ContractUndocumented.Code)
+
+	// and is only logged otherwise
+	config.Conf.Contract.Strict = false
+	server.GET("/v1/undocumented").Do().AssertStatus(http.StatusNoContent)
+}
+
+func TestPatchTooLarge(t *testing.T) {
+	server := httptest.New(t, nil)
+	user := server.User()
+	path := fmt.Sprintf("/v1/restricted/users/%d", user.ID)
+
+	server.PATCH(path).JSON(map[string]string{"name": "Patched"}).AsUser(user).Do().AssertStatus(http.StatusOK)
+
+	// a patch document past 64KB is refused, not cut to an invalid one
+	server.PATCH(path).JSON(map[string]string{"name": strings.Repeat("a", 64<<10)}).AsUser(user).Do().
+		AssertStatus(http.StatusRequestEntityTooLarge).
+		AssertJSON("code", problems.PatchTooLarge.Code)
+}
+
+func TestDryRun(t *testing.T) {
+	server := httptest.New(t, nil)
+	user := server.User()
+	path := fmt.Sprintf("/v1/restricted/users/%d", user.ID)
+
+	// a dry run answers the change without keeping it
+	server.PATCH(path).JSON(map[string]string{"name": "Dry"}).Header(GMiddleware.HeaderDryRun, "true").AsUser(user).Do().
+		AssertStatus(http.StatusOK).
+		AssertHeader(GMiddleware.HeaderDryRun, "true").
+		AssertJSON("data.name", "Dry")
+	server.PUT("/v1/restricted/users/me/preferences").JSON(map[string]string{"timezone": "Asia/Tokyo"}).Header(GMiddleware.HeaderDryRun, "true").AsUser(user).Do().
+		AssertStatus(http.StatusOK).
+		AssertJSON("data.timezone", "Asia/Tokyo")
+	server.GET(path).AsUser(user).Do().
+		AssertStatus(http.StatusOK).
+		AssertJSON("data.name", user.Name).
+		AssertJSON("data.timezone", user.Timezone)
+
+	// the other mutating routes refuse it
+	server.POST(fmt.Sprintf("/v1/restricted/users/%d/follow", server.Admin().ID)).Header(GMiddleware.HeaderDryRun, "true").AsUser(user).Do().
+		AssertStatus(http.StatusBadRequest).
+		AssertJSON("code", problems.DryRunUnsupported.Code)
+}
@@ -0,0 +1,118 @@
+package routers
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/config"
+)
+
+// firstFD is the first file descriptor passed by systemd or by a restart, SD_LISTEN_FDS_START
+const firstFD = 3
+
+// the names of the sockets, the FileDescriptorName= of a systemd socket
+const (
+	listenerServer   = "server"
+	listenerRedirect = "redirect"
+)
+
+/**
+ * Listeners
+ * the listeners of the server and of the redirect server, the redirect one is nil without a redirect port. A process
+ * started by a graceful restart inherits the ones of the process it replaces
+ */
+func Listeners(conf config.Listen, redirectPort string) (server net.Listener, redirect net.Listener, err error) {
+	switch {
+	case os.Getenv(envRestartFDs) != "":
+		return inheritedListeners(envRestartFDs, envRestartFDNames)
+	case conf.Network == config.ListenSystemd:
+		pid, _ := strconv.Atoi(os.Getenv("LISTEN_PID"))
+		if pid != os.Getpid() {
+			return nil, nil, errors.New("listen: systemd passed no socket")
+		}
+		_ = os.Unsetenv("LISTEN_PID")
+		if server, redirect, err = inheritedListeners("LISTEN_FDS", "LISTEN_FDNAMES"); err != nil {
+			return nil, nil, err
+		}
+	case conf.Network == config.ListenUnix:
+		server, err = unixListener(conf.Socket, conf.SocketMode)
+	default:
+		server, err = net.Listen("tcp", ":"+config.Conf.Port)
+	}
+	if err == nil && redirect == nil && redirectPort != "" {
+		redirect, err = net.Listen("tcp", ":"+redirectPort)
+	}
+	return server, redirect, err
+}
+
+/**
+ * Listen
+ * the server accepts on the listener instead of its address, through TLS when it has a TLS config
+ */
+func Listen(e *echo.Echo, server *http.Server, listener net.Listener) {
+	if server.TLSConfig != nil {
+		e.TLSListener = tls.NewListener(listener, server.TLSConfig)
+		return
+	}
+	e.Listener = listener
+}
+
+func unixListener(path string, mode os.FileMode) (net.Listener, error) {
+	// the socket of a process that did not stop cleanly would fail the listen
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.Chmod(path, mode); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
+
+// inheritedListeners are the sockets passed from the first fd, the one named redirect serves the redirect server and
+// the first other one the server. The variables are unset, the sockets are not passed on to the processes started
+func inheritedListeners(fdsKey string, namesKey string) (server net.Listener, redirect net.Listener, err error) {
+	count, _ := strconv.Atoi(os.Getenv(fdsKey))
+	names := strings.Split(os.Getenv(namesKey), ":")
+	_ = os.Unsetenv(fdsKey)
+	_ = os.Unsetenv(namesKey)
+	if count < 1 {
+		return nil, nil, errors.New("listen: no socket was passed")
+	}
+
+	for i := 0; i < count; i++ {
+		var name string
+		if i < len(names) {
+			name = names[i]
+		}
+		file := os.NewFile(uintptr(firstFD+i), name)
+		listener, err := net.FileListener(file)
+		_ = file.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		switch {
+		case name == listenerRedirect && redirect == nil:
+			redirect = listener
+		case server == nil:
+			server = listener
+		default:
+			_ = listener.Close()
+		}
+	}
+	if server == nil {
+		return nil, nil, errors.New("listen: only the redirect socket was passed")
+	}
+	return server, redirect, nil
+}
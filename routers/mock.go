@@ -0,0 +1,48 @@
+package routers
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	echoSwagger "github.com/swaggo/echo-swagger"
+
+	"gotham/config"
+	_ "gotham/docs"
+	"gotham/factories"
+	"gotham/mockserver"
+	"gotham/openapi"
+	"gotham/problems"
+)
+
+/**
+ * Mock
+ * serves the examples of the swagger spec on PORT instead of the api, without a database nor authentication, so the
+ * frontends develop against realistic payloads. The users are made by their factory, the same ones on every start
+ */
+func Mock(e *echo.Echo) {
+	spec, err := openapi.ReadRegisteredSpec()
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	factory := factories.New(nil, rand.NewSource(1))
+	generator := &mockserver.Generator{
+		Spec: spec,
+		Now:  time.Now,
+		Factories: map[string]mockserver.Factory{
+			"models.User": func(sequence int) (interface{}, error) {
+				user, err := factory.User().Verified().Make()
+				user.ID = uint(sequence)
+				user.CreatedAt, user.UpdatedAt = factory.Now(), factory.Now()
+				return user, err
+			},
+		},
+	}
+
+	e.HTTPErrorHandler = problems.HTTPErrorHandler
+	e.Use(middleware.CORS())
+	e.GET("/doc/*", echoSwagger.WrapHandler)
+	mockserver.Register(e, spec, generator)
+	e.Logger.Fatal(e.Start(":" + config.Conf.Port))
+}
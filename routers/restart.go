@@ -0,0 +1,114 @@
+package routers
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the variables passing the sockets and the ready pipe to the process started by a restart
+const (
+	envRestartFDs     = "RESTART_LISTEN_FDS"
+	envRestartFDNames = "RESTART_LISTEN_FDNAMES"
+	envRestartReadyFD = "RESTART_READY_FD"
+)
+
+/**
+ * Restart
+ * starts a new process of the binary with the same arguments, handing it the listeners of the server and of the
+ * redirect server. It returns once the new process is ready, the caller then drains and exits. The new process is
+ * killed when it is not ready within the timeout and the caller keeps serving
+ */
+func Restart(server net.Listener, redirect net.Listener, timeout time.Duration) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	var files []*os.File
+	var names []string
+	for _, l := range []struct {
+		name     string
+		listener net.Listener
+	}{{listenerServer, server}, {listenerRedirect, redirect}} {
+		if l.listener == nil {
+			continue
+		}
+		filer, ok := l.listener.(interface{ File() (*os.File, error) })
+		if !ok {
+			return fmt.Errorf("restart: the %v listener can not be handed over", l.name)
+		}
+		file, err := filer.File()
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		files = append(files, file)
+		names = append(names, l.name)
+	}
+
+	ready, readyWriter, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	defer ready.Close()
+
+	cmd := exec.Command(executable, os.Args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	cmd.ExtraFiles = append(files, readyWriter)
+	cmd.Env = append(os.Environ(),
+		envRestartFDs+"="+strconv.Itoa(len(files)),
+		envRestartFDNames+"="+strings.Join(names, ":"),
+		envRestartReadyFD+"="+strconv.Itoa(firstFD+len(files)),
+	)
+	err = cmd.Start()
+	_ = readyWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	// the pipe is closed without a byte when the new process exits before being ready
+	readied := make(chan error, 1)
+	go func() {
+		_, err := ready.Read(make([]byte, 1))
+		readied <- err
+	}()
+	select {
+	case err = <-readied:
+	case <-time.After(timeout):
+		err = errors.New("the new process was not ready in time")
+	}
+	if err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return fmt.Errorf("restart: %w", err)
+	}
+	go func() { _ = cmd.Wait() }()
+
+	// the socket file is the new process's now, closing the listener must not remove it
+	if unix, ok := server.(*net.UnixListener); ok {
+		unix.SetUnlinkOnClose(false)
+	}
+	return nil
+}
+
+/**
+ * Ready
+ * tells the process that started this one by a restart that it serves, it has nothing to do otherwise
+ */
+func Ready() error {
+	fd, err := strconv.Atoi(os.Getenv(envRestartReadyFD))
+	if err != nil {
+		return nil
+	}
+	_ = os.Unsetenv(envRestartReadyFD)
+	pipe := os.NewFile(uintptr(fd), "ready")
+	defer pipe.Close()
+	_, err = pipe.Write([]byte{1})
+	return err
+}
@@ -0,0 +1,47 @@
+package routers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/app"
+)
+
+// route is an entry of the route registry, deprecated when it is marked with deprecated.Deprecate
+type route struct {
+	echo.Route
+	Deprecated bool `json:"deprecated,omitempty"`
+}
+
+/**
+ * PrintRoutes
+ * print the registered routes as json, the route registry sdkgen is checked against
+ */
+func PrintRoutes(e *echo.Echo) error {
+	Register(e)
+
+	registered := e.Routes()
+	sort.Slice(registered, func(i, j int) bool {
+		if registered[i].Path == registered[j].Path {
+			return registered[i].Method < registered[j].Method
+		}
+		return registered[i].Path < registered[j].Path
+	})
+
+	deprecations := app.Application.Container.GetDeprecationService()
+	routes := make([]route, 0, len(registered))
+	for _, r := range registered {
+		_, deprecated := deprecations.Get(r.Method, r.Path)
+		routes = append(routes, route{Route: *r, Deprecated: deprecated})
+	}
+
+	out, err := json.MarshalIndent(routes, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
@@ -0,0 +1,123 @@
+package routers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+
+	"gotham/config"
+)
+
+// cipherSuites are the TLS 1.2 suites with forward secrecy and authenticated encryption, TLS 1.3 picks its own
+var cipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+	tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+}
+
+/**
+ * Server
+ * the server of echo listening on the port, its TLS server when TLS is configured. The plain server then serves the
+ * redirects to https and the HTTP-01 challenges of autocert on the redirect port, nil when there is none. The servers
+ * of echo are the ones its Shutdown stops
+ */
+func Server(e *echo.Echo, conf config.TLS) (server *http.Server, redirect *http.Server, err error) {
+	if !conf.Enabled() {
+		e.Server.Addr = ":" + config.Conf.Port
+		return e.Server, nil, nil
+	}
+	server = e.TLSServer
+	server.Addr = ":" + config.Conf.Port
+	server.TLSConfig = &tls.Config{
+		MinVersion:               tls.VersionTLS12,
+		CipherSuites:             cipherSuites,
+		CurvePreferences:         []tls.CurveID{tls.X25519, tls.CurveP256},
+		PreferServerCipherSuites: true,
+		NextProtos:               []string{"http/1.1"},
+	}
+	if conf.MinVersion == "1.3" {
+		server.TLSConfig.MinVersion = tls.VersionTLS13
+	}
+	// the server speaks HTTP/2 to the clients negotiating it
+	if conf.HTTP2 {
+		server.TLSConfig.NextProtos = []string{"h2", "http/1.1"}
+	}
+
+	var challenges func(fallback http.Handler) http.Handler
+	if conf.Autocert() {
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(conf.AutocertDomains...),
+			Cache:      autocert.DirCache(conf.AutocertCacheDir),
+			Email:      conf.AutocertEmail,
+		}
+		server.TLSConfig.GetCertificate = manager.GetCertificate
+		// the TLS-ALPN-01 challenge works without the redirect port, the HTTP-01 one is answered on it
+		server.TLSConfig.NextProtos = append(server.TLSConfig.NextProtos, acme.ALPNProto)
+		challenges = manager.HTTPHandler
+	} else {
+		certificate, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		server.TLSConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if err = clientAuth(server.TLSConfig, conf); err != nil {
+		return nil, nil, err
+	}
+
+	if conf.RedirectPort == "" {
+		return server, nil, nil
+	}
+	redirect = e.Server
+	redirect.Addr = ":" + conf.RedirectPort
+	redirect.Handler = http.HandlerFunc(toHttps)
+	if challenges != nil {
+		redirect.Handler = challenges(redirect.Handler)
+	}
+	return server, redirect, nil
+}
+
+// clientAuth is mutual TLS, the client certificates signed by the authorities of the file are verified
+func clientAuth(tlsConfig *tls.Config, conf config.TLS) error {
+	if conf.ClientAuth == config.ClientAuthNone {
+		return nil
+	}
+	pem, err := os.ReadFile(conf.ClientCAFile)
+	if err != nil {
+		return err
+	}
+	authorities := x509.NewCertPool()
+	if !authorities.AppendCertsFromPEM(pem) {
+		return errors.New("tls: the client ca file has no certificate")
+	}
+	tlsConfig.ClientCAs = authorities
+	tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	if conf.ClientAuth == config.ClientAuthRequire {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return nil
+}
+
+// toHttps redirects the request to the same url on the https port
+func toHttps(w http.ResponseWriter, r *http.Request) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if config.Conf.Port != "443" {
+		host = net.JoinHostPort(host, config.Conf.Port)
+	}
+	http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+}
@@ -0,0 +1,12 @@
+package sagas
+
+import (
+	"gotham/app"
+)
+
+func Initialize() {
+	sagas := app.Application.Container.GetSagaService()
+
+	// registration
+	sagas.Register(app.Application.Container.GetRegistrationService().Saga())
+}
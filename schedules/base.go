@@ -0,0 +1,48 @@
+package schedules
+
+import (
+	"time"
+
+	"gotham/app"
+	"gotham/config"
+)
+
+func Initialize() {
+	scheduler := app.Application.Container.GetScheduler()
+
+	// database
+	scheduler.Every("database-supervisor", config.Conf.DbSupervisor.Interval, app.Application.Container.GetDatabaseSupervisor().Check)
+
+	// privacy
+	scheduler.Every("purge-due-deletions", time.Hour, app.Application.Container.GetPrivacyService().PurgeDueDeletions)
+	scheduler.Every("purge-expired-data-exports", time.Hour, app.Application.Container.GetPrivacyService().PurgeExpiredDataExports)
+
+	// retention
+	scheduler.Every("enforce-retention", time.Hour, app.Application.Container.GetRetentionService().Enforce)
+
+	// dead letters
+	scheduler.Every("count-dead-letters", time.Minute, app.Application.Container.GetDeadLetterService().Count)
+
+	// service level objectives, the burn rates of the requests of this instance
+	scheduler.Every("evaluate-slos", time.Minute, app.Application.Container.GetSloService().Evaluate)
+
+	// sagas
+	scheduler.Every("resume-sagas", time.Minute, app.Application.Container.GetSagaService().Resume)
+
+	// users
+	scheduler.Every("lift-expired-suspensions", time.Hour, app.Application.Container.GetSuspensionService().LiftExpiredSuspensions)
+
+	// billing
+	scheduler.Every("render-pending-invoices", 10*time.Minute, app.Application.Container.GetInvoiceService().RenderPending)
+
+	// votes
+	scheduler.Every("recompute-vote-scores", 5*time.Minute, app.Application.Container.GetVoteService().RecomputeScores)
+
+	// media
+	scheduler.Every("cleanup-orphan-media", time.Hour, app.Application.Container.GetMediaService().CleanupOrphans)
+
+	// publishing
+	scheduler.Every("publish-due-content", time.Minute, app.Application.Container.GetPublishingService().PublishDue)
+
+	scheduler.Start()
+}
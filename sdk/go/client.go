@@ -0,0 +1,3333 @@
+// Code generated by sdkgen from docs/swagger.json; DO NOT EDIT.
+
+// Package client is a typed client of the gotham api.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Client calls the gotham api, the token set with SetToken is sent as a bearer token
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+
+	token string
+}
+
+func New(baseURL string) *Client {
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+func (c *Client) SetToken(token string) {
+	c.token = token
+}
+
+func (c *Client) Token() string {
+	return c.token
+}
+
+// Problem is an application/problem+json error response, branch on Code
+type Problem struct {
+	Type   string                 `json:"type"`
+	Title  string                 `json:"title"`
+	Status int                    `json:"status"`
+	Detail string                 `json:"detail,omitempty"`
+	Code   string                 `json:"code"`
+	Errors map[string]interface{} `json:"errors,omitempty"`
+}
+
+func (p *Problem) Error() string {
+	if p.Detail != "" {
+		return p.Code + ": " + p.Detail
+	}
+	return p.Code + ": " + p.Title
+}
+
+type envelope struct {
+	Data interface{} `json:"data"`
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out interface{}) ([]byte, error) {
+	target := c.BaseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		request.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	response, err := c.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode >= 400 {
+		problem := &Problem{Status: response.StatusCode, Title: http.StatusText(response.StatusCode)}
+		_ = json.Unmarshal(data, problem)
+		return nil, problem
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return nil, fmt.Errorf("decode %v %v: %w", method, path, err)
+		}
+	}
+	return data, nil
+}
+
+type Announcement struct {
+	Audience      string `json:"audience,omitempty"`
+	Body          string `json:"body,omitempty"`
+	CreatedAt     string `json:"created_at,omitempty"`
+	CreatedBy     int64  `json:"created_by,omitempty"`
+	ID            int64  `json:"id,omitempty"`
+	PlanSlug      string `json:"plan_slug,omitempty"`
+	PublishAt     string `json:"publish_at,omitempty"`
+	PublishStatus string `json:"publish_status,omitempty"`
+	PublishedAt   string `json:"published_at,omitempty"`
+	Read          bool   `json:"read,omitempty"`
+	ReadCount     int64  `json:"read_count,omitempty"`
+	Title         string `json:"title,omitempty"`
+	UpdatedAt     string `json:"updated_at,omitempty"`
+}
+
+type ApiKey struct {
+	CreatedAt  string `json:"created_at,omitempty"`
+	ExpiresAt  string `json:"expires_at,omitempty"`
+	ID         int64  `json:"id,omitempty"`
+	LastUsedAt string `json:"last_used_at,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Prefix     string `json:"prefix,omitempty"`
+	RevokedAt  string `json:"revoked_at,omitempty"`
+	Scopes     string `json:"scopes,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+	UserID     int64  `json:"user_id,omitempty"`
+}
+
+type AttachMediaBody struct {
+	Type string `json:"type"`
+	ID   int64  `json:"id"`
+}
+
+type AttachTagsBody struct {
+	Tags []string `json:"tags"`
+}
+
+type BatchUsersBody struct {
+	Ids []int64 `json:"ids"`
+}
+
+type BatchUsersResult struct {
+	Missing []int64 `json:"missing,omitempty"`
+	Records []User  `json:"records,omitempty"`
+}
+
+type BillingCheckoutBody struct {
+	Plan   string `json:"plan"`
+	Coupon string `json:"coupon,omitempty"`
+}
+
+type BillingValidateCouponBody struct {
+	Code string `json:"code"`
+	Plan string `json:"plan"`
+}
+
+type Bulk struct {
+	Failed    int64        `json:"failed,omitempty"`
+	Results   []BulkResult `json:"results,omitempty"`
+	Succeeded int64        `json:"succeeded,omitempty"`
+}
+
+type BulkDeleteUsersBody struct {
+	Operations []UserBulkDeleteOperation `json:"operations"`
+}
+
+type BulkReplayDeadLettersBody struct {
+	Ids    []int64 `json:"ids,omitempty"`
+	Kind   string  `json:"kind,omitempty"`
+	Source string  `json:"source,omitempty"`
+}
+
+type BulkReplayDeadLettersResult struct {
+	Failed    int64                                    `json:"failed,omitempty"`
+	Results   []BulkReplayDeadLettersResultResultsItem `json:"results,omitempty"`
+	Succeeded int64                                    `json:"succeeded,omitempty"`
+}
+
+type BulkReplayDeadLettersResultResultsItem struct {
+	Error  ProblemsProblem `json:"error,omitempty"`
+	ID     int64           `json:"id,omitempty"`
+	Record DeadLetter      `json:"record,omitempty"`
+	Status int64           `json:"status,omitempty"`
+}
+
+type BulkResult struct {
+	Error  ProblemsProblem `json:"error,omitempty"`
+	ID     int64           `json:"id,omitempty"`
+	Record json.RawMessage `json:"record,omitempty"`
+	Status int64           `json:"status,omitempty"`
+}
+
+type BulkUpdateUsersBody struct {
+	Operations []UserBulkUpdateOperation `json:"operations"`
+}
+
+type BulkUpdateUsersResult struct {
+	Failed    int64                              `json:"failed,omitempty"`
+	Results   []BulkUpdateUsersResultResultsItem `json:"results,omitempty"`
+	Succeeded int64                              `json:"succeeded,omitempty"`
+}
+
+type BulkUpdateUsersResultResultsItem struct {
+	Error  ProblemsProblem `json:"error,omitempty"`
+	ID     int64           `json:"id,omitempty"`
+	Record User            `json:"record,omitempty"`
+	Status int64           `json:"status,omitempty"`
+}
+
+type ChangeEmailBody struct {
+	Email           string `json:"email"`
+	CurrentPassword string `json:"current_password"`
+}
+
+type ChangePasswordBody struct {
+	Password             string `json:"password"`
+	PasswordConfirmation string `json:"password_confirmation"`
+}
+
+type Checkout struct {
+	URL string `json:"url,omitempty"`
+}
+
+type ClientToken struct {
+	AccessToken string `json:"access_token,omitempty"`
+	ExpiresIn   int64  `json:"expires_in,omitempty"`
+	Scope       string `json:"scope,omitempty"`
+	TokenType   string `json:"token_type,omitempty"`
+}
+
+type ClientTokenBody struct {
+	GrantType    string `json:"grant_type"`
+	Scope        string `json:"scope,omitempty"`
+	ClientID     string `json:"client_id,omitempty"`
+	ClientSecret string `json:"client_secret,omitempty"`
+}
+
+type Code struct {
+	Code        string `json:"code,omitempty"`
+	Description string `json:"description,omitempty"`
+	Status      int64  `json:"status,omitempty"`
+	Title       string `json:"title,omitempty"`
+}
+
+type Comment struct {
+	Body            string    `json:"body,omitempty"`
+	CommentableID   int64     `json:"commentable_id,omitempty"`
+	CommentableType string    `json:"commentable_type,omitempty"`
+	CreatedAt       string    `json:"created_at,omitempty"`
+	DeletedAt       string    `json:"deleted_at,omitempty"`
+	Depth           int64     `json:"depth,omitempty"`
+	EditedAt        string    `json:"edited_at,omitempty"`
+	ID              int64     `json:"id,omitempty"`
+	ParentID        int64     `json:"parent_id,omitempty"`
+	Replies         []Comment `json:"replies,omitempty"`
+	RootID          int64     `json:"root_id,omitempty"`
+	Status          string    `json:"status,omitempty"`
+	UpdatedAt       string    `json:"updated_at,omitempty"`
+	UserID          int64     `json:"user_id,omitempty"`
+}
+
+type ConfigChange struct {
+	Current  Variable `json:"current,omitempty"`
+	Name     string   `json:"name,omitempty"`
+	Previous Variable `json:"previous,omitempty"`
+}
+
+type ConfigReport struct {
+	Diff      []ConfigChange  `json:"diff,omitempty"`
+	Effective json.RawMessage `json:"effective,omitempty"`
+	Previous  ConfigSnapshot  `json:"previous,omitempty"`
+	Snapshot  ConfigSnapshot  `json:"snapshot,omitempty"`
+	Variables []Variable      `json:"variables,omitempty"`
+}
+
+type ConfigSnapshot struct {
+	Checksum  string `json:"checksum,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	Host      string `json:"host,omitempty"`
+	ID        int64  `json:"id,omitempty"`
+	LoadedAt  string `json:"loaded_at,omitempty"`
+	Loads     int64  `json:"loads,omitempty"`
+	Version   string `json:"version,omitempty"`
+}
+
+type Consent struct {
+	AcceptedAt string `json:"accepted_at,omitempty"`
+	CreatedAt  string `json:"created_at,omitempty"`
+	ID         int64  `json:"id,omitempty"`
+	IP         string `json:"ip,omitempty"`
+	Policy     Policy `json:"policy,omitempty"`
+	PolicyID   int64  `json:"policy_id,omitempty"`
+	UserID     int64  `json:"user_id,omitempty"`
+}
+
+type Conversation struct {
+	CreatedAt     string                    `json:"created_at,omitempty"`
+	CreatedBy     int64                     `json:"created_by,omitempty"`
+	Direct        bool                      `json:"direct,omitempty"`
+	ID            int64                     `json:"id,omitempty"`
+	LastMessageAt string                    `json:"last_message_at,omitempty"`
+	Participants  []ConversationParticipant `json:"participants,omitempty"`
+	UnreadCount   int64                     `json:"unread_count,omitempty"`
+	UpdatedAt     string                    `json:"updated_at,omitempty"`
+}
+
+type ConversationParticipant struct {
+	ConversationID    int64  `json:"conversation_id,omitempty"`
+	CreatedAt         string `json:"created_at,omitempty"`
+	LastReadMessageID int64  `json:"last_read_message_id,omitempty"`
+	UserID            int64  `json:"user_id,omitempty"`
+}
+
+type ConversationUnread struct {
+	ConversationID int64 `json:"conversation_id,omitempty"`
+	Count          int64 `json:"count,omitempty"`
+}
+
+type Coupon struct {
+	Active         bool   `json:"active,omitempty"`
+	AmountOff      Money  `json:"amount_off,omitempty"`
+	Code           string `json:"code,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+	CreatedBy      int64  `json:"created_by,omitempty"`
+	ExpiresAt      string `json:"expires_at,omitempty"`
+	ID             int64  `json:"id,omitempty"`
+	MaxPerUser     int64  `json:"max_per_user,omitempty"`
+	MaxRedemptions int64  `json:"max_redemptions,omitempty"`
+	PercentOff     int64  `json:"percent_off,omitempty"`
+	PlanID         int64  `json:"plan_id,omitempty"`
+	Redemptions    int64  `json:"redemptions,omitempty"`
+	Type           string `json:"type,omitempty"`
+	UpdatedAt      string `json:"updated_at,omitempty"`
+}
+
+type CouponQuote struct {
+	Coupon   Coupon `json:"coupon,omitempty"`
+	Discount Money  `json:"discount,omitempty"`
+	Plan     string `json:"plan,omitempty"`
+	Price    Money  `json:"price,omitempty"`
+	Total    Money  `json:"total,omitempty"`
+}
+
+type CreateAnnouncementBody struct {
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	Audience  string `json:"audience"`
+	Plan      string `json:"plan,omitempty"`
+	PublishAt string `json:"publish_at,omitempty"`
+	Draft     bool   `json:"draft,omitempty"`
+}
+
+type CreateApiKeyBody struct {
+	Name     string   `json:"name"`
+	Scopes   []string `json:"scopes"`
+	TtlHours int64    `json:"ttl_hours,omitempty"`
+}
+
+type CreateApiKeyResult struct {
+	Key    string `json:"key,omitempty"`
+	Record ApiKey `json:"record,omitempty"`
+}
+
+type CreateClientBody struct {
+	Name                string   `json:"name"`
+	Scopes              []string `json:"scopes"`
+	RateLimit           int64    `json:"rate_limit,omitempty"`
+	CertificateIdentity string   `json:"certificate_identity,omitempty"`
+}
+
+type CreateClientResult struct {
+	ClientSecret string       `json:"client_secret,omitempty"`
+	Record       ModelsClient `json:"record,omitempty"`
+}
+
+type CreateCommentBody struct {
+	Body     string `json:"body"`
+	ParentID int64  `json:"parent_id,omitempty"`
+}
+
+type CreateCouponBody struct {
+	Code           string `json:"code"`
+	PercentOff     int64  `json:"percent_off,omitempty"`
+	AmountOff      string `json:"amount_off,omitempty"`
+	Currency       string `json:"currency,omitempty"`
+	PlanID         int64  `json:"plan_id,omitempty"`
+	MaxRedemptions int64  `json:"max_redemptions,omitempty"`
+	MaxPerUser     int64  `json:"max_per_user,omitempty"`
+	ExpiresAt      string `json:"expires_at,omitempty"`
+}
+
+type CreateInvitationBody struct {
+	MaxUses  int64  `json:"max_uses,omitempty"`
+	TtlHours int64  `json:"ttl_hours,omitempty"`
+	Email    string `json:"email,omitempty"`
+}
+
+type CreateReportBody struct {
+	Reason  string `json:"reason"`
+	Details string `json:"details,omitempty"`
+}
+
+type DataExport struct {
+	CreatedAt string `json:"created_at,omitempty"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+	ID        int64  `json:"id,omitempty"`
+	Status    string `json:"status,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	UserID    int64  `json:"user_id,omitempty"`
+}
+
+type DeadLetter struct {
+	Attempts  int64  `json:"attempts,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	Error     string `json:"error,omitempty"`
+	EventID   string `json:"event_id,omitempty"`
+	ID        int64  `json:"id,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	Payload   string `json:"payload,omitempty"`
+	Replays   int64  `json:"replays,omitempty"`
+	Source    string `json:"source,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+type DeadLetterPurge struct {
+	Deleted int64 `json:"deleted,omitempty"`
+}
+
+type DeprecatedCall struct {
+	Caller        string `json:"caller,omitempty"`
+	Calls         int64  `json:"calls,omitempty"`
+	FirstCalledAt string `json:"first_called_at,omitempty"`
+	LastCalledAt  string `json:"last_called_at,omitempty"`
+	Route         string `json:"route,omitempty"`
+}
+
+type DeprecationReport struct {
+	Callers      []DeprecatedCall `json:"callers,omitempty"`
+	Calls        int64            `json:"calls,omitempty"`
+	DeprecatedAt string           `json:"deprecated_at,omitempty"`
+	Link         string           `json:"link,omitempty"`
+	Method       string           `json:"method,omitempty"`
+	Path         string           `json:"path,omitempty"`
+	Sunset       string           `json:"sunset,omitempty"`
+}
+
+type DetachTagsBody struct {
+	Tags []string `json:"tags"`
+}
+
+type Device struct {
+	CreatedAt  string `json:"created_at,omitempty"`
+	ID         int64  `json:"id,omitempty"`
+	LastPushAt string `json:"last_push_at,omitempty"`
+	Platform   string `json:"platform,omitempty"`
+	Token      string `json:"token,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+	UserID     int64  `json:"user_id,omitempty"`
+}
+
+type DismissReportBody struct {
+	Note string `json:"note,omitempty"`
+}
+
+type EmailChange struct {
+	ConfirmedAt string `json:"confirmed_at,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
+	ID          int64  `json:"id,omitempty"`
+	NewEmail    string `json:"new_email,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	UserID      int64  `json:"user_id,omitempty"`
+}
+
+type FlagCommentBody struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type FollowersResult struct {
+	Links       Links  `json:"_links,omitempty"`
+	HasNext     bool   `json:"has_next,omitempty"`
+	Limit       int64  `json:"limit,omitempty"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	Page        int64  `json:"page,omitempty"`
+	Records     []User `json:"records,omitempty"`
+	TotalRecord int64  `json:"total_record,omitempty"`
+}
+
+type FollowingResult struct {
+	Links       Links  `json:"_links,omitempty"`
+	HasNext     bool   `json:"has_next,omitempty"`
+	Limit       int64  `json:"limit,omitempty"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	Page        int64  `json:"page,omitempty"`
+	Records     []User `json:"records,omitempty"`
+	TotalRecord int64  `json:"total_record,omitempty"`
+}
+
+type Impersonation struct {
+	AccessToken    string          `json:"access_token,omitempty"`
+	AccessTokenExp int64           `json:"access_token_exp,omitempty"`
+	ImpersonatedBy int64           `json:"impersonated_by,omitempty"`
+	User           json.RawMessage `json:"user,omitempty"`
+}
+
+type Invitation struct {
+	Code      string `json:"code,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	CreatedBy int64  `json:"created_by,omitempty"`
+	Email     string `json:"email,omitempty"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+	ID        int64  `json:"id,omitempty"`
+	MaxUses   int64  `json:"max_uses,omitempty"`
+	RevokedAt string `json:"revoked_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	Uses      int64  `json:"uses,omitempty"`
+}
+
+type Invoice struct {
+	CreatedAt   string        `json:"created_at,omitempty"`
+	DownloadURL string        `json:"download_url,omitempty"`
+	ID          int64         `json:"id,omitempty"`
+	Lines       []InvoiceLine `json:"lines,omitempty"`
+	Number      string        `json:"number,omitempty"`
+	PaidAt      string        `json:"paid_at,omitempty"`
+	PeriodEnd   string        `json:"period_end,omitempty"`
+	PeriodStart string        `json:"period_start,omitempty"`
+	Status      string        `json:"status,omitempty"`
+	Total       Money         `json:"total,omitempty"`
+	UpdatedAt   string        `json:"updated_at,omitempty"`
+	UserID      int64         `json:"user_id,omitempty"`
+}
+
+type InvoiceLine struct {
+	Amount      Money  `json:"amount,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type Links struct {
+}
+
+type ListAllAnnouncementsResult struct {
+	Links       Links          `json:"_links,omitempty"`
+	HasNext     bool           `json:"has_next,omitempty"`
+	Limit       int64          `json:"limit,omitempty"`
+	NextCursor  string         `json:"next_cursor,omitempty"`
+	Page        int64          `json:"page,omitempty"`
+	Records     []Announcement `json:"records,omitempty"`
+	TotalRecord int64          `json:"total_record,omitempty"`
+}
+
+type ListAnnouncementsResult struct {
+	Links       Links          `json:"_links,omitempty"`
+	HasNext     bool           `json:"has_next,omitempty"`
+	Limit       int64          `json:"limit,omitempty"`
+	NextCursor  string         `json:"next_cursor,omitempty"`
+	Page        int64          `json:"page,omitempty"`
+	Records     []Announcement `json:"records,omitempty"`
+	TotalRecord int64          `json:"total_record,omitempty"`
+}
+
+type ListCommentsResult struct {
+	Links       Links     `json:"_links,omitempty"`
+	HasNext     bool      `json:"has_next,omitempty"`
+	Limit       int64     `json:"limit,omitempty"`
+	NextCursor  string    `json:"next_cursor,omitempty"`
+	Page        int64     `json:"page,omitempty"`
+	Records     []Comment `json:"records,omitempty"`
+	TotalRecord int64     `json:"total_record,omitempty"`
+}
+
+type ListConversationsResult struct {
+	Links       Links          `json:"_links,omitempty"`
+	HasNext     bool           `json:"has_next,omitempty"`
+	Limit       int64          `json:"limit,omitempty"`
+	NextCursor  string         `json:"next_cursor,omitempty"`
+	Page        int64          `json:"page,omitempty"`
+	Records     []Conversation `json:"records,omitempty"`
+	TotalRecord int64          `json:"total_record,omitempty"`
+}
+
+type ListDeadLettersResult struct {
+	Links       Links        `json:"_links,omitempty"`
+	HasNext     bool         `json:"has_next,omitempty"`
+	Limit       int64        `json:"limit,omitempty"`
+	NextCursor  string       `json:"next_cursor,omitempty"`
+	Page        int64        `json:"page,omitempty"`
+	Records     []DeadLetter `json:"records,omitempty"`
+	TotalRecord int64        `json:"total_record,omitempty"`
+}
+
+type ListFlaggedCommentsResult struct {
+	Links       Links     `json:"_links,omitempty"`
+	HasNext     bool      `json:"has_next,omitempty"`
+	Limit       int64     `json:"limit,omitempty"`
+	NextCursor  string    `json:"next_cursor,omitempty"`
+	Page        int64     `json:"page,omitempty"`
+	Records     []Comment `json:"records,omitempty"`
+	TotalRecord int64     `json:"total_record,omitempty"`
+}
+
+type ListMediaResult struct {
+	Links       Links   `json:"_links,omitempty"`
+	HasNext     bool    `json:"has_next,omitempty"`
+	Limit       int64   `json:"limit,omitempty"`
+	NextCursor  string  `json:"next_cursor,omitempty"`
+	Page        int64   `json:"page,omitempty"`
+	Records     []Media `json:"records,omitempty"`
+	TotalRecord int64   `json:"total_record,omitempty"`
+}
+
+type ListMessagesResult struct {
+	Links      Links           `json:"_links,omitempty"`
+	Limit      int64           `json:"limit,omitempty"`
+	NextCursor int64           `json:"next_cursor,omitempty"`
+	Records    []ModelsMessage `json:"records,omitempty"`
+}
+
+type ListReportsResult struct {
+	Links       Links    `json:"_links,omitempty"`
+	HasNext     bool     `json:"has_next,omitempty"`
+	Limit       int64    `json:"limit,omitempty"`
+	NextCursor  string   `json:"next_cursor,omitempty"`
+	Page        int64    `json:"page,omitempty"`
+	Records     []Report `json:"records,omitempty"`
+	TotalRecord int64    `json:"total_record,omitempty"`
+}
+
+type ListUserVersionsResult struct {
+	Links       Links         `json:"_links,omitempty"`
+	HasNext     bool          `json:"has_next,omitempty"`
+	Limit       int64         `json:"limit,omitempty"`
+	NextCursor  string        `json:"next_cursor,omitempty"`
+	Page        int64         `json:"page,omitempty"`
+	Records     []UserVersion `json:"records,omitempty"`
+	TotalRecord int64         `json:"total_record,omitempty"`
+}
+
+type ListUsersResult struct {
+	Links       Links  `json:"_links,omitempty"`
+	HasNext     bool   `json:"has_next,omitempty"`
+	Limit       int64  `json:"limit,omitempty"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	Page        int64  `json:"page,omitempty"`
+	Records     []User `json:"records,omitempty"`
+	TotalRecord int64  `json:"total_record,omitempty"`
+}
+
+type LogLevels struct {
+	Level   string          `json:"level,omitempty"`
+	Modules json.RawMessage `json:"modules,omitempty"`
+}
+
+type Login struct {
+	AccessToken     string          `json:"access_token,omitempty"`
+	AccessTokenExp  int64           `json:"access_token_exp,omitempty"`
+	RefreshToken    string          `json:"refresh_token,omitempty"`
+	RefreshTokenExp int64           `json:"refresh_token_exp,omitempty"`
+	SudoUntil       int64           `json:"sudo_until,omitempty"`
+	User            json.RawMessage `json:"user,omitempty"`
+}
+
+type LoginBody struct {
+	Email        string `json:"email"`
+	Password     string `json:"password"`
+	RememberMe   bool   `json:"remember_me,omitempty"`
+	DeviceID     string `json:"device_id,omitempty"`
+	Platform     string `json:"platform"`
+	CaptchaToken string `json:"captcha_token,omitempty"`
+}
+
+type LogoutBody struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type MagicLinkBody struct {
+	Email string `json:"email"`
+}
+
+type Media struct {
+	AttachableID   int64  `json:"attachable_id,omitempty"`
+	AttachableType string `json:"attachable_type,omitempty"`
+	Checksum       string `json:"checksum,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+	Disk           string `json:"disk,omitempty"`
+	ID             int64  `json:"id,omitempty"`
+	Mime           string `json:"mime,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Size           int64  `json:"size,omitempty"`
+	UpdatedAt      string `json:"updated_at,omitempty"`
+	UserID         int64  `json:"user_id,omitempty"`
+	Visibility     string `json:"visibility,omitempty"`
+}
+
+type MediaMimeUsage struct {
+	Bytes int64  `json:"bytes,omitempty"`
+	Files int64  `json:"files,omitempty"`
+	Mime  string `json:"mime,omitempty"`
+}
+
+type MediaUsage struct {
+	Bytes int64 `json:"bytes,omitempty"`
+	Files int64 `json:"files,omitempty"`
+}
+
+type MediaUsageReport struct {
+	ByMime  []MediaMimeUsage `json:"by_mime,omitempty"`
+	ByUser  []MediaUserUsage `json:"by_user,omitempty"`
+	Orphans MediaUsage       `json:"orphans,omitempty"`
+	Total   MediaUsage       `json:"total,omitempty"`
+}
+
+type MediaUserUsage struct {
+	Bytes  int64 `json:"bytes,omitempty"`
+	Files  int64 `json:"files,omitempty"`
+	UserID int64 `json:"user_id,omitempty"`
+}
+
+type Message struct {
+	Message string `json:"message,omitempty"`
+}
+
+type ModelsClient struct {
+	CertificateIdentity string `json:"certificate_identity,omitempty"`
+	ClientID            string `json:"client_id,omitempty"`
+	CreatedAt           string `json:"created_at,omitempty"`
+	CreatedBy           int64  `json:"created_by,omitempty"`
+	ID                  int64  `json:"id,omitempty"`
+	LastUsedAt          string `json:"last_used_at,omitempty"`
+	Name                string `json:"name,omitempty"`
+	RateLimit           int64  `json:"rate_limit,omitempty"`
+	RevokedAt           string `json:"revoked_at,omitempty"`
+	Scopes              string `json:"scopes,omitempty"`
+	UpdatedAt           string `json:"updated_at,omitempty"`
+}
+
+type ModelsMessage struct {
+	Body           string `json:"body,omitempty"`
+	ConversationID int64  `json:"conversation_id,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+	ID             int64  `json:"id,omitempty"`
+	UserID         int64  `json:"user_id,omitempty"`
+}
+
+type Money struct {
+	Amount   int64  `json:"amount,omitempty"`
+	Currency string `json:"currency,omitempty"`
+}
+
+type MonthlyUsage struct {
+	Month    string `json:"month,omitempty"`
+	MonthID  int64  `json:"month_id,omitempty"`
+	Requests int64  `json:"requests,omitempty"`
+}
+
+type Paginator struct {
+	Links       Links           `json:"_links,omitempty"`
+	HasNext     bool            `json:"has_next,omitempty"`
+	Limit       int64           `json:"limit,omitempty"`
+	NextCursor  string          `json:"next_cursor,omitempty"`
+	Page        int64           `json:"page,omitempty"`
+	Records     json.RawMessage `json:"records,omitempty"`
+	TotalRecord int64           `json:"total_record,omitempty"`
+}
+
+type Plan struct {
+	Active    bool   `json:"active,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	ID        int64  `json:"id,omitempty"`
+	Interval  string `json:"interval,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Price     Money  `json:"price,omitempty"`
+	Rank      int64  `json:"rank,omitempty"`
+	Slug      string `json:"slug,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+type Policy struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	ID          int64  `json:"id,omitempty"`
+	PublishedAt string `json:"published_at,omitempty"`
+	Type        string `json:"type,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Version     string `json:"version,omitempty"`
+}
+
+type PopularTag struct {
+	Count     int64  `json:"count,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	ID        int64  `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Slug      string `json:"slug,omitempty"`
+}
+
+type ProblemsProblem struct {
+	Code     string `json:"code,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Status   int64  `json:"status,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Type     string `json:"type,omitempty"`
+}
+
+type PublishAnnouncementBody struct {
+	PublishAt string `json:"publish_at,omitempty"`
+}
+
+type PushPreferences struct {
+}
+
+type ReadConversationBody struct {
+	MessageID int64 `json:"message_id,omitempty"`
+}
+
+type RefreshBody struct {
+	RefreshToken string `json:"refresh_token"`
+	DeviceID     string `json:"device_id"`
+}
+
+type RegisterBody struct {
+	Name           string `json:"name"`
+	Email          string `json:"email"`
+	Password       string `json:"password"`
+	InvitationCode string `json:"invitation_code,omitempty"`
+	CaptchaToken   string `json:"captcha_token,omitempty"`
+}
+
+type RegisterDeviceBody struct {
+	Token    string `json:"token"`
+	Platform string `json:"platform"`
+}
+
+type Relationship struct {
+	FollowedBy bool  `json:"followed_by,omitempty"`
+	Followers  int64 `json:"followers,omitempty"`
+	Following  bool  `json:"following,omitempty"`
+	Followings int64 `json:"followings,omitempty"`
+	Mutual     bool  `json:"mutual,omitempty"`
+}
+
+type Report struct {
+	CreatedAt      string `json:"created_at,omitempty"`
+	Details        string `json:"details,omitempty"`
+	ID             int64  `json:"id,omitempty"`
+	Note           string `json:"note,omitempty"`
+	OffenderID     int64  `json:"offender_id,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+	ReportableID   int64  `json:"reportable_id,omitempty"`
+	ReportableType string `json:"reportable_type,omitempty"`
+	ReporterID     int64  `json:"reporter_id,omitempty"`
+	ResolvedAt     string `json:"resolved_at,omitempty"`
+	ResolvedByID   int64  `json:"resolved_by_id,omitempty"`
+	Status         string `json:"status,omitempty"`
+	UpdatedAt      string `json:"updated_at,omitempty"`
+}
+
+type ResolveReportBody struct {
+	Note string `json:"note,omitempty"`
+}
+
+type SearchUsersResult struct {
+	Links       Links            `json:"_links,omitempty"`
+	HasNext     bool             `json:"has_next,omitempty"`
+	Limit       int64            `json:"limit,omitempty"`
+	NextCursor  string           `json:"next_cursor,omitempty"`
+	Page        int64            `json:"page,omitempty"`
+	Records     []UserSearchView `json:"records,omitempty"`
+	TotalRecord int64            `json:"total_record,omitempty"`
+}
+
+type SendMessageBody struct {
+	Body string `json:"body"`
+}
+
+type Setting struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	Description string `json:"description,omitempty"`
+	Key         string `json:"key,omitempty"`
+	Options     string `json:"options,omitempty"`
+	Type        string `json:"type,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	Value       string `json:"value,omitempty"`
+}
+
+type SloReport struct {
+	BurnRate1h           float64 `json:"burn_rate_1h,omitempty"`
+	BurnRate5m           float64 `json:"burn_rate_5m,omitempty"`
+	Burning              bool    `json:"burning,omitempty"`
+	Compliance           float64 `json:"compliance,omitempty"`
+	ErrorBudgetRemaining float64 `json:"error_budget_remaining,omitempty"`
+	Good                 int64   `json:"good,omitempty"`
+	LatencyMs            int64   `json:"latency_ms,omitempty"`
+	Objective            float64 `json:"objective,omitempty"`
+	Requests             int64   `json:"requests,omitempty"`
+	Route                string  `json:"route,omitempty"`
+	Since                string  `json:"since,omitempty"`
+	Window               string  `json:"window,omitempty"`
+}
+
+type StartConversationBody struct {
+	UserIds []int64 `json:"user_ids"`
+	Body    string  `json:"body,omitempty"`
+}
+
+type Subscription struct {
+	CancelAtPeriodEnd bool   `json:"cancel_at_period_end,omitempty"`
+	CreatedAt         string `json:"created_at,omitempty"`
+	CurrentPeriodEnd  string `json:"current_period_end,omitempty"`
+	ID                int64  `json:"id,omitempty"`
+	Plan              Plan   `json:"plan,omitempty"`
+	PlanID            int64  `json:"plan_id,omitempty"`
+	Status            string `json:"status,omitempty"`
+	UpdatedAt         string `json:"updated_at,omitempty"`
+	UserID            int64  `json:"user_id,omitempty"`
+}
+
+type SudoBody struct {
+	Password string `json:"password"`
+}
+
+type SuspendUserBody struct {
+	Until  string `json:"until,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type SyncTagsBody struct {
+	Tags []string `json:"tags"`
+}
+
+type Tag struct {
+	CreatedAt string `json:"created_at,omitempty"`
+	ID        int64  `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Slug      string `json:"slug,omitempty"`
+}
+
+type UnreadCounters struct {
+	ByConversation []ConversationUnread `json:"by_conversation,omitempty"`
+	Conversations  int64                `json:"conversations,omitempty"`
+	Messages       int64                `json:"messages,omitempty"`
+}
+
+type UpdateCommentBody struct {
+	Body string `json:"body"`
+}
+
+type UpdateCouponBody struct {
+	MaxRedemptions int64  `json:"max_redemptions,omitempty"`
+	MaxPerUser     int64  `json:"max_per_user,omitempty"`
+	ExpiresAt      string `json:"expires_at,omitempty"`
+	Active         bool   `json:"active,omitempty"`
+}
+
+type UpdateLoggingLevelBody struct {
+	Level   string          `json:"level"`
+	Modules json.RawMessage `json:"modules,omitempty"`
+}
+
+type UpdatePhoneBody struct {
+	Phone string `json:"phone"`
+}
+
+type UpdatePreferencesBody struct {
+	Timezone string `json:"timezone"`
+}
+
+type UpdateSettingBody struct {
+	Value string `json:"value"`
+}
+
+type UploadMediaBody struct {
+	File       json.RawMessage `json:"file"`
+	Visibility string          `json:"visibility,omitempty"`
+}
+
+type Usage struct {
+	DailyQuota   int64          `json:"daily_quota,omitempty"`
+	MonthlyQuota int64          `json:"monthly_quota,omitempty"`
+	Months       []MonthlyUsage `json:"months,omitempty"`
+	Today        int64          `json:"today,omitempty"`
+	Year         int64          `json:"year,omitempty"`
+}
+
+type User struct {
+	Admin               bool   `json:"admin,omitempty"`
+	CreatedAt           string `json:"created_at,omitempty"`
+	DailyQuota          int64  `json:"daily_quota,omitempty"`
+	DeletionScheduledAt string `json:"deletion_scheduled_at,omitempty"`
+	Email               string `json:"email,omitempty"`
+	ID                  int64  `json:"id,omitempty"`
+	Image               string `json:"image,omitempty"`
+	MonthlyQuota        int64  `json:"monthly_quota,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Phone               string `json:"phone,omitempty"`
+	PhoneVerifiedAt     string `json:"phone_verified_at,omitempty"`
+	SuspendedAt         string `json:"suspended_at,omitempty"`
+	SuspendedUntil      string `json:"suspended_until,omitempty"`
+	SuspensionReason    string `json:"suspension_reason,omitempty"`
+	Timezone            string `json:"timezone,omitempty"`
+	UpdatedAt           string `json:"updated_at,omitempty"`
+	Verified            bool   `json:"verified,omitempty"`
+}
+
+type UserBulkDeleteOperation struct {
+	ID int64 `json:"id,omitempty"`
+}
+
+type UserBulkUpdateOperation struct {
+	DailyQuota   int64  `json:"daily_quota,omitempty"`
+	ID           int64  `json:"id,omitempty"`
+	MonthlyQuota int64  `json:"monthly_quota,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Timezone     string `json:"timezone,omitempty"`
+	Verified     bool   `json:"verified,omitempty"`
+}
+
+type UserChange struct {
+	Field string          `json:"field,omitempty"`
+	From  json.RawMessage `json:"from,omitempty"`
+	To    json.RawMessage `json:"to,omitempty"`
+}
+
+type UserSearchView struct {
+	Admin          bool   `json:"admin,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+	Email          string `json:"email,omitempty"`
+	FollowersCount int64  `json:"followers_count,omitempty"`
+	FollowingCount int64  `json:"following_count,omitempty"`
+	Name           string `json:"name,omitempty"`
+	ProjectedAt    string `json:"projected_at,omitempty"`
+	Suspended      bool   `json:"suspended,omitempty"`
+	TagNames       string `json:"tag_names,omitempty"`
+	UserID         int64  `json:"user_id,omitempty"`
+	Verified       bool   `json:"verified,omitempty"`
+}
+
+type UserVersion struct {
+	CreatedAt string          `json:"created_at,omitempty"`
+	ID        int64           `json:"id,omitempty"`
+	User      json.RawMessage `json:"user,omitempty"`
+	UserID    int64           `json:"user_id,omitempty"`
+}
+
+type Variable struct {
+	Name   string `json:"name,omitempty"`
+	Secret bool   `json:"secret,omitempty"`
+	Source string `json:"source,omitempty"`
+	Value  string `json:"value,omitempty"`
+}
+
+type VerifyPhoneBody struct {
+	Code string `json:"code"`
+}
+
+type VoteSummary struct {
+	Dislikes    int64   `json:"dislikes,omitempty"`
+	Likes       int64   `json:"likes,omitempty"`
+	Score       float64 `json:"score,omitempty"`
+	UpdatedAt   string  `json:"updated_at,omitempty"`
+	VotableID   int64   `json:"votable_id,omitempty"`
+	VotableType string  `json:"votable_type,omitempty"`
+	Vote        int64   `json:"vote,omitempty"`
+}
+
+// AcceptPolicy Accept the current version of a policy
+//
+//	POST /v1/restricted/policies/:policy/accept
+func (c *Client) AcceptPolicy(ctx context.Context, policy string) (*Consent, error) {
+	result := new(Consent)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/policies/"+url.PathEscape(policy)+"/accept", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ApproveComment Approve a comment
+//
+//	POST /v1/restricted/admin/comments/:comment/approve
+func (c *Client) ApproveComment(ctx context.Context, comment string) (*Comment, error) {
+	result := new(Comment)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/comments/"+url.PathEscape(comment)+"/approve", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AttachMedia Attach a media
+//
+//	PUT /v1/restricted/media/:media/attachment
+func (c *Client) AttachMedia(ctx context.Context, media string, body AttachMediaBody) (*Media, error) {
+	result := new(Media)
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/media/"+url.PathEscape(media)+"/attachment", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AttachTags Attach tags
+//
+//	POST /v1/restricted/tags/:type/:id
+func (c *Client) AttachTags(ctx context.Context, typeID string, id string, body AttachTagsBody) ([]Tag, error) {
+	var result []Tag
+	if _, err := c.do(ctx, "POST", "/v1/restricted/tags/"+url.PathEscape(typeID)+"/"+url.PathEscape(id), nil, body, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BatchUsers Get users by their ids
+//
+//	POST /v1/restricted/users/batch
+func (c *Client) BatchUsers(ctx context.Context, body BatchUsersBody) (*BatchUsersResult, error) {
+	result := new(BatchUsersResult)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/users/batch", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BillingCancel Cancel the subscription of the authenticated user
+//
+//	DELETE /v1/restricted/billing/subscription
+func (c *Client) BillingCancel(ctx context.Context) (*Subscription, error) {
+	result := new(Subscription)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/billing/subscription", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BillingCheckout Start the subscription to a plan
+//
+//	POST /v1/restricted/billing/checkout
+func (c *Client) BillingCheckout(ctx context.Context, body BillingCheckoutBody) (*Checkout, error) {
+	result := new(Checkout)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/billing/checkout", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BillingDownloadInvoiceParams are the query parameters of BillingDownloadInvoice
+type BillingDownloadInvoiceParams struct {
+	Expires   int64
+	Signature string
+}
+
+func (p BillingDownloadInvoiceParams) values() url.Values {
+	values := url.Values{}
+	if p.Expires != 0 {
+		values.Set("expires", strconv.FormatInt(p.Expires, 10))
+	}
+	if p.Signature != "" {
+		values.Set("signature", p.Signature)
+	}
+	return values
+}
+
+// BillingDownloadInvoice Download the pdf of an invoice
+//
+//	GET /v1/billing/invoices/:invoice/download
+func (c *Client) BillingDownloadInvoice(ctx context.Context, invoice string, params BillingDownloadInvoiceParams) (json.RawMessage, error) {
+	var result json.RawMessage
+	if _, err := c.do(ctx, "GET", "/v1/billing/invoices/"+url.PathEscape(invoice)+"/download", params.values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BillingInvoices Invoices of the authenticated user
+//
+//	GET /v1/restricted/billing/invoices
+func (c *Client) BillingInvoices(ctx context.Context) ([]Invoice, error) {
+	var result []Invoice
+	if _, err := c.do(ctx, "GET", "/v1/restricted/billing/invoices", nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BillingPlans Plans users can subscribe to
+//
+//	GET /v1/billing/plans
+func (c *Client) BillingPlans(ctx context.Context) ([]Plan, error) {
+	var result []Plan
+	if _, err := c.do(ctx, "GET", "/v1/billing/plans", nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BillingSubscription Subscription of the authenticated user
+//
+//	GET /v1/restricted/billing/subscription
+func (c *Client) BillingSubscription(ctx context.Context) (*Subscription, error) {
+	result := new(Subscription)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/billing/subscription", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BillingValidateCoupon Check a coupon for a plan
+//
+//	POST /v1/restricted/billing/coupons/validate
+func (c *Client) BillingValidateCoupon(ctx context.Context, body BillingValidateCouponBody) (*CouponQuote, error) {
+	result := new(CouponQuote)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/billing/coupons/validate", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BillingWebhook Events of the payment provider
+//
+//	POST /v1/billing/webhook
+func (c *Client) BillingWebhook(ctx context.Context) error {
+	_, err := c.do(ctx, "POST", "/v1/billing/webhook", nil, nil, nil)
+	return err
+}
+
+// BulkDeleteUsers Delete users in bulk
+//
+//	DELETE /v1/restricted/users/bulk
+func (c *Client) BulkDeleteUsers(ctx context.Context, body BulkDeleteUsersBody) (*Bulk, error) {
+	result := new(Bulk)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/users/bulk", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BulkReplayDeadLetters Replay dead letters in bulk
+//
+//	POST /v1/restricted/admin/dead-letters/replay
+func (c *Client) BulkReplayDeadLetters(ctx context.Context, body BulkReplayDeadLettersBody) (*BulkReplayDeadLettersResult, error) {
+	result := new(BulkReplayDeadLettersResult)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/dead-letters/replay", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BulkUpdateUsers Update users in bulk
+//
+//	PATCH /v1/restricted/users/bulk
+func (c *Client) BulkUpdateUsers(ctx context.Context, body BulkUpdateUsersBody) (*BulkUpdateUsersResult, error) {
+	result := new(BulkUpdateUsersResult)
+	if _, err := c.do(ctx, "PATCH", "/v1/restricted/users/bulk", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ChangeEmail Request a change of the email of the authenticated user
+//
+//	POST /v1/restricted/users/me/email
+func (c *Client) ChangeEmail(ctx context.Context, body ChangeEmailBody) (*EmailChange, error) {
+	result := new(EmailChange)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/users/me/email", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ChangePassword Change the password of the authenticated user
+//
+//	PUT /v1/restricted/users/me/password
+func (c *Client) ChangePassword(ctx context.Context, body ChangePasswordBody) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/users/me/password", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ClientToken Client credentials grant
+//
+//	POST /v1/oauth/token
+func (c *Client) ClientToken(ctx context.Context, body ClientTokenBody) (*ClientToken, error) {
+	result := new(ClientToken)
+	if _, err := c.do(ctx, "POST", "/v1/oauth/token", nil, body, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ConfirmEmailChangeParams are the query parameters of ConfirmEmailChange
+type ConfirmEmailChangeParams struct {
+	Token string
+}
+
+func (p ConfirmEmailChangeParams) values() url.Values {
+	values := url.Values{}
+	if p.Token != "" {
+		values.Set("token", p.Token)
+	}
+	return values
+}
+
+// ConfirmEmailChange Confirm a change of email
+//
+//	GET /v1/email-changes/confirm
+func (c *Client) ConfirmEmailChange(ctx context.Context, params ConfirmEmailChangeParams) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "GET", "/v1/email-changes/confirm", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateAdminRecord Create a record of a resource
+//
+//	POST /v1/restricted/admin/resources/:resource
+func (c *Client) CreateAdminRecord(ctx context.Context, resource string) (json.RawMessage, error) {
+	var result json.RawMessage
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/resources/"+url.PathEscape(resource), nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateAnnouncement Create an announcement
+//
+//	POST /v1/restricted/admin/announcements
+func (c *Client) CreateAnnouncement(ctx context.Context, body CreateAnnouncementBody) (*Announcement, error) {
+	result := new(Announcement)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/announcements", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateApiKey Create an api key
+//
+//	POST /v1/restricted/users/me/api-keys
+func (c *Client) CreateApiKey(ctx context.Context, body CreateApiKeyBody) (*CreateApiKeyResult, error) {
+	result := new(CreateApiKeyResult)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/users/me/api-keys", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateClient Register a client
+//
+//	POST /v1/restricted/admin/clients
+func (c *Client) CreateClient(ctx context.Context, body CreateClientBody) (*CreateClientResult, error) {
+	result := new(CreateClientResult)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/clients", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateComment Comment a commentable
+//
+//	POST /v1/restricted/threads/:type/:id/comments
+func (c *Client) CreateComment(ctx context.Context, typeID string, id string, body CreateCommentBody) (*Comment, error) {
+	result := new(Comment)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/threads/"+url.PathEscape(typeID)+"/"+url.PathEscape(id)+"/comments", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateCoupon Create a coupon
+//
+//	POST /v1/restricted/admin/coupons
+func (c *Client) CreateCoupon(ctx context.Context, body CreateCouponBody) (*Coupon, error) {
+	result := new(Coupon)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/coupons", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateInvitation Create an invitation
+//
+//	POST /v1/restricted/invitations
+func (c *Client) CreateInvitation(ctx context.Context, body CreateInvitationBody) (*Invitation, error) {
+	result := new(Invitation)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/invitations", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateReport Report a user or a content
+//
+//	POST /v1/restricted/reports/:type/:id
+func (c *Client) CreateReport(ctx context.Context, typeID string, id string, body CreateReportBody) (*Report, error) {
+	result := new(Report)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/reports/"+url.PathEscape(typeID)+"/"+url.PathEscape(id), nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CurrentPolicies Current version of every policy
+//
+//	GET /v1/policies/current
+func (c *Client) CurrentPolicies(ctx context.Context) ([]Policy, error) {
+	var result []Policy
+	if _, err := c.do(ctx, "GET", "/v1/policies/current", nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeactivateCoupon Deactivate a coupon
+//
+//	DELETE /v1/restricted/admin/coupons/:coupon
+func (c *Client) DeactivateCoupon(ctx context.Context, coupon string) (*Coupon, error) {
+	result := new(Coupon)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/admin/coupons/"+url.PathEscape(coupon), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteAccount Schedule the deletion of the authenticated user
+//
+//	DELETE /v1/restricted/users/me
+func (c *Client) DeleteAccount(ctx context.Context) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/users/me", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteAdminRecord Delete a record of a resource
+//
+//	DELETE /v1/restricted/admin/resources/:resource/:id
+func (c *Client) DeleteAdminRecord(ctx context.Context, resource string, id string) error {
+	_, err := c.do(ctx, "DELETE", "/v1/restricted/admin/resources/"+url.PathEscape(resource)+"/"+url.PathEscape(id), nil, nil, nil)
+	return err
+}
+
+// DeleteAnnouncement Delete an announcement
+//
+//	DELETE /v1/restricted/admin/announcements/:announcement
+func (c *Client) DeleteAnnouncement(ctx context.Context, announcement string) error {
+	_, err := c.do(ctx, "DELETE", "/v1/restricted/admin/announcements/"+url.PathEscape(announcement), nil, nil, nil)
+	return err
+}
+
+// DeleteComment Delete a comment
+//
+//	DELETE /v1/restricted/comments/:comment
+func (c *Client) DeleteComment(ctx context.Context, comment string) error {
+	_, err := c.do(ctx, "DELETE", "/v1/restricted/comments/"+url.PathEscape(comment), nil, nil, nil)
+	return err
+}
+
+// DeleteDeadLetter Delete a dead letter
+//
+//	DELETE /v1/restricted/admin/dead-letters/:dead_letter
+func (c *Client) DeleteDeadLetter(ctx context.Context, deadLetter string) error {
+	_, err := c.do(ctx, "DELETE", "/v1/restricted/admin/dead-letters/"+url.PathEscape(deadLetter), nil, nil, nil)
+	return err
+}
+
+// DeleteMedia Delete a media
+//
+//	DELETE /v1/restricted/media/:media
+func (c *Client) DeleteMedia(ctx context.Context, media string) error {
+	_, err := c.do(ctx, "DELETE", "/v1/restricted/media/"+url.PathEscape(media), nil, nil, nil)
+	return err
+}
+
+// DeletePhone Remove the phone of the authenticated user
+//
+//	DELETE /v1/restricted/users/me/phone
+func (c *Client) DeletePhone(ctx context.Context) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/users/me/phone", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DetachMedia Detach a media
+//
+//	DELETE /v1/restricted/media/:media/attachment
+func (c *Client) DetachMedia(ctx context.Context, media string) (*Media, error) {
+	result := new(Media)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/media/"+url.PathEscape(media)+"/attachment", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DetachTags Detach tags
+//
+//	DELETE /v1/restricted/tags/:type/:id
+func (c *Client) DetachTags(ctx context.Context, typeID string, id string, body DetachTagsBody) ([]Tag, error) {
+	var result []Tag
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/tags/"+url.PathEscape(typeID)+"/"+url.PathEscape(id), nil, body, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DiffUserVersionParams are the query parameters of DiffUserVersion
+type DiffUserVersionParams struct {
+	With int64
+}
+
+func (p DiffUserVersionParams) values() url.Values {
+	values := url.Values{}
+	if p.With != 0 {
+		values.Set("with", strconv.FormatInt(p.With, 10))
+	}
+	return values
+}
+
+// DiffUserVersion Changes of a user since a version
+//
+//	GET /v1/restricted/admin/users/:user/versions/:version/diff
+func (c *Client) DiffUserVersion(ctx context.Context, user string, version string, params DiffUserVersionParams) ([]UserChange, error) {
+	var result []UserChange
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/users/"+url.PathEscape(user)+"/versions/"+url.PathEscape(version)+"/diff", params.values(), nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Dislike Dislike a votable
+//
+//	PUT /v1/restricted/votes/:type/:id/dislike
+func (c *Client) Dislike(ctx context.Context, typeID string, id string) (*VoteSummary, error) {
+	result := new(VoteSummary)
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/votes/"+url.PathEscape(typeID)+"/"+url.PathEscape(id)+"/dislike", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DismissReport Dismiss a report
+//
+//	POST /v1/restricted/admin/reports/:report/dismiss
+func (c *Client) DismissReport(ctx context.Context, report string, body DismissReportBody) (*Report, error) {
+	result := new(Report)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/reports/"+url.PathEscape(report)+"/dismiss", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DownloadDataExport Download a data export archive
+//
+//	GET /v1/restricted/users/me/data-exports/:export/download
+func (c *Client) DownloadDataExport(ctx context.Context, export string) ([]byte, error) {
+	return c.do(ctx, "GET", "/v1/restricted/users/me/data-exports/"+url.PathEscape(export)+"/download", nil, nil, nil)
+}
+
+// DownloadMedia Download a media
+//
+//	GET /v1/restricted/media/:media/download
+func (c *Client) DownloadMedia(ctx context.Context, media string) (json.RawMessage, error) {
+	var result json.RawMessage
+	if _, err := c.do(ctx, "GET", "/v1/restricted/media/"+url.PathEscape(media)+"/download", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Errors Catalog of every error code
+//
+//	GET /errors
+func (c *Client) Errors(ctx context.Context) ([]Code, error) {
+	var result []Code
+	if _, err := c.do(ctx, "GET", "/errors", nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FlagComment Flag a comment
+//
+//	POST /v1/restricted/comments/:comment/flag
+func (c *Client) FlagComment(ctx context.Context, comment string, body FlagCommentBody) (json.RawMessage, error) {
+	var result json.RawMessage
+	if _, err := c.do(ctx, "POST", "/v1/restricted/comments/"+url.PathEscape(comment)+"/flag", nil, body, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Follow Follow a user
+//
+//	POST /v1/restricted/users/:user/follow
+func (c *Client) Follow(ctx context.Context, user string) (*Relationship, error) {
+	result := new(Relationship)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/users/"+url.PathEscape(user)+"/follow", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FollowersParams are the query parameters of Followers
+type FollowersParams struct {
+	Page  int64
+	Limit int64
+	Lite  bool
+}
+
+func (p FollowersParams) values() url.Values {
+	values := url.Values{}
+	if p.Page != 0 {
+		values.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Lite {
+		values.Set("lite", "true")
+	}
+	return values
+}
+
+// Followers Followers of a user
+//
+//	GET /v1/restricted/users/:user/followers
+func (c *Client) Followers(ctx context.Context, user string, params FollowersParams) (*FollowersResult, error) {
+	result := new(FollowersResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/users/"+url.PathEscape(user)+"/followers", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FollowersIterator walks every page of Followers
+type FollowersIterator struct {
+	client *Client
+	user   string
+	params FollowersParams
+	page   *FollowersResult
+	index  int
+	last   bool
+	err    error
+}
+
+// FollowersAll iterates over every User of Followers starting at params.Page
+func (c *Client) FollowersAll(user string, params FollowersParams) *FollowersIterator {
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	return &FollowersIterator{client: c, user: user, params: params}
+}
+
+// Next advances to the next item, the next page is fetched once the current one is exhausted
+func (it *FollowersIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.page == nil || it.index >= len(it.page.Records) {
+		if it.last {
+			return false
+		}
+		page, err := it.client.Followers(ctx, it.user, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+		it.last = len(page.Records) == 0 || page.Page*page.Limit >= page.TotalRecord
+		it.params.Page++
+	}
+	it.index++
+	return true
+}
+
+func (it *FollowersIterator) Value() User {
+	return it.page.Records[it.index-1]
+}
+
+func (it *FollowersIterator) Err() error {
+	return it.err
+}
+
+// FollowingParams are the query parameters of Following
+type FollowingParams struct {
+	Page  int64
+	Limit int64
+	Lite  bool
+}
+
+func (p FollowingParams) values() url.Values {
+	values := url.Values{}
+	if p.Page != 0 {
+		values.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Lite {
+		values.Set("lite", "true")
+	}
+	return values
+}
+
+// Following Users followed by a user
+//
+//	GET /v1/restricted/users/:user/following
+func (c *Client) Following(ctx context.Context, user string, params FollowingParams) (*FollowingResult, error) {
+	result := new(FollowingResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/users/"+url.PathEscape(user)+"/following", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FollowingIterator walks every page of Following
+type FollowingIterator struct {
+	client *Client
+	user   string
+	params FollowingParams
+	page   *FollowingResult
+	index  int
+	last   bool
+	err    error
+}
+
+// FollowingAll iterates over every User of Following starting at params.Page
+func (c *Client) FollowingAll(user string, params FollowingParams) *FollowingIterator {
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	return &FollowingIterator{client: c, user: user, params: params}
+}
+
+// Next advances to the next item, the next page is fetched once the current one is exhausted
+func (it *FollowingIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.page == nil || it.index >= len(it.page.Records) {
+		if it.last {
+			return false
+		}
+		page, err := it.client.Following(ctx, it.user, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+		it.last = len(page.Records) == 0 || page.Page*page.Limit >= page.TotalRecord
+		it.params.Page++
+	}
+	it.index++
+	return true
+}
+
+func (it *FollowingIterator) Value() User {
+	return it.page.Records[it.index-1]
+}
+
+func (it *FollowingIterator) Err() error {
+	return it.err
+}
+
+// ImpersonateUser Impersonate a user
+//
+//	POST /v1/restricted/admin/users/:user/impersonate
+func (c *Client) ImpersonateUser(ctx context.Context, user string) (*Impersonation, error) {
+	result := new(Impersonation)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/users/"+url.PathEscape(user)+"/impersonate", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// InternalShowUser Get User for an internal service
+//
+//	GET /v1/internal/users/:user
+func (c *Client) InternalShowUser(ctx context.Context, user string) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "GET", "/v1/internal/users/"+url.PathEscape(user), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Like Like a votable
+//
+//	PUT /v1/restricted/votes/:type/:id/like
+func (c *Client) Like(ctx context.Context, typeID string, id string) (*VoteSummary, error) {
+	result := new(VoteSummary)
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/votes/"+url.PathEscape(typeID)+"/"+url.PathEscape(id)+"/like", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListAdminRecordsParams are the query parameters of ListAdminRecords
+type ListAdminRecordsParams struct {
+	Page  int64
+	Limit int64
+	Lite  bool
+}
+
+func (p ListAdminRecordsParams) values() url.Values {
+	values := url.Values{}
+	if p.Page != 0 {
+		values.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Lite {
+		values.Set("lite", "true")
+	}
+	return values
+}
+
+// ListAdminRecords List the records of a resource
+//
+//	GET /v1/restricted/admin/resources/:resource
+func (c *Client) ListAdminRecords(ctx context.Context, resource string, params ListAdminRecordsParams) (*Paginator, error) {
+	result := new(Paginator)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/resources/"+url.PathEscape(resource), params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListAllAnnouncementsParams are the query parameters of ListAllAnnouncements
+type ListAllAnnouncementsParams struct {
+	Page  int64
+	Limit int64
+	Lite  bool
+}
+
+func (p ListAllAnnouncementsParams) values() url.Values {
+	values := url.Values{}
+	if p.Page != 0 {
+		values.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Lite {
+		values.Set("lite", "true")
+	}
+	return values
+}
+
+// ListAllAnnouncements Every announcement
+//
+//	GET /v1/restricted/admin/announcements
+func (c *Client) ListAllAnnouncements(ctx context.Context, params ListAllAnnouncementsParams) (*ListAllAnnouncementsResult, error) {
+	result := new(ListAllAnnouncementsResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/announcements", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListAllAnnouncementsIterator walks every page of ListAllAnnouncements
+type ListAllAnnouncementsIterator struct {
+	client *Client
+	params ListAllAnnouncementsParams
+	page   *ListAllAnnouncementsResult
+	index  int
+	last   bool
+	err    error
+}
+
+// ListAllAnnouncementsAll iterates over every Announcement of ListAllAnnouncements starting at params.Page
+func (c *Client) ListAllAnnouncementsAll(params ListAllAnnouncementsParams) *ListAllAnnouncementsIterator {
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	return &ListAllAnnouncementsIterator{client: c, params: params}
+}
+
+// Next advances to the next item, the next page is fetched once the current one is exhausted
+func (it *ListAllAnnouncementsIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.page == nil || it.index >= len(it.page.Records) {
+		if it.last {
+			return false
+		}
+		page, err := it.client.ListAllAnnouncements(ctx, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+		it.last = len(page.Records) == 0 || page.Page*page.Limit >= page.TotalRecord
+		it.params.Page++
+	}
+	it.index++
+	return true
+}
+
+func (it *ListAllAnnouncementsIterator) Value() Announcement {
+	return it.page.Records[it.index-1]
+}
+
+func (it *ListAllAnnouncementsIterator) Err() error {
+	return it.err
+}
+
+// ListAnnouncementsParams are the query parameters of ListAnnouncements
+type ListAnnouncementsParams struct {
+	Page  int64
+	Limit int64
+	Lite  bool
+}
+
+func (p ListAnnouncementsParams) values() url.Values {
+	values := url.Values{}
+	if p.Page != 0 {
+		values.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Lite {
+		values.Set("lite", "true")
+	}
+	return values
+}
+
+// ListAnnouncements Announcements of the user
+//
+//	GET /v1/restricted/announcements
+func (c *Client) ListAnnouncements(ctx context.Context, params ListAnnouncementsParams) (*ListAnnouncementsResult, error) {
+	result := new(ListAnnouncementsResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/announcements", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListAnnouncementsIterator walks every page of ListAnnouncements
+type ListAnnouncementsIterator struct {
+	client *Client
+	params ListAnnouncementsParams
+	page   *ListAnnouncementsResult
+	index  int
+	last   bool
+	err    error
+}
+
+// ListAnnouncementsAll iterates over every Announcement of ListAnnouncements starting at params.Page
+func (c *Client) ListAnnouncementsAll(params ListAnnouncementsParams) *ListAnnouncementsIterator {
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	return &ListAnnouncementsIterator{client: c, params: params}
+}
+
+// Next advances to the next item, the next page is fetched once the current one is exhausted
+func (it *ListAnnouncementsIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.page == nil || it.index >= len(it.page.Records) {
+		if it.last {
+			return false
+		}
+		page, err := it.client.ListAnnouncements(ctx, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+		it.last = len(page.Records) == 0 || page.Page*page.Limit >= page.TotalRecord
+		it.params.Page++
+	}
+	it.index++
+	return true
+}
+
+func (it *ListAnnouncementsIterator) Value() Announcement {
+	return it.page.Records[it.index-1]
+}
+
+func (it *ListAnnouncementsIterator) Err() error {
+	return it.err
+}
+
+// ListApiKeys Api keys of the user
+//
+//	GET /v1/restricted/users/me/api-keys
+func (c *Client) ListApiKeys(ctx context.Context) ([]ApiKey, error) {
+	var result []ApiKey
+	if _, err := c.do(ctx, "GET", "/v1/restricted/users/me/api-keys", nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListClients Clients
+//
+//	GET /v1/restricted/admin/clients
+func (c *Client) ListClients(ctx context.Context) ([]ModelsClient, error) {
+	var result []ModelsClient
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/clients", nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListComments Comments of a commentable
+//
+//	GET /v1/restricted/threads/:type/:id/comments
+func (c *Client) ListComments(ctx context.Context, typeID string, id string) (*ListCommentsResult, error) {
+	result := new(ListCommentsResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/threads/"+url.PathEscape(typeID)+"/"+url.PathEscape(id)+"/comments", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListConversationsParams are the query parameters of ListConversations
+type ListConversationsParams struct {
+	Page  int64
+	Limit int64
+	Lite  bool
+}
+
+func (p ListConversationsParams) values() url.Values {
+	values := url.Values{}
+	if p.Page != 0 {
+		values.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Lite {
+		values.Set("lite", "true")
+	}
+	return values
+}
+
+// ListConversations Conversations of the user
+//
+//	GET /v1/restricted/conversations
+func (c *Client) ListConversations(ctx context.Context, params ListConversationsParams) (*ListConversationsResult, error) {
+	result := new(ListConversationsResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/conversations", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListConversationsIterator walks every page of ListConversations
+type ListConversationsIterator struct {
+	client *Client
+	params ListConversationsParams
+	page   *ListConversationsResult
+	index  int
+	last   bool
+	err    error
+}
+
+// ListConversationsAll iterates over every Conversation of ListConversations starting at params.Page
+func (c *Client) ListConversationsAll(params ListConversationsParams) *ListConversationsIterator {
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	return &ListConversationsIterator{client: c, params: params}
+}
+
+// Next advances to the next item, the next page is fetched once the current one is exhausted
+func (it *ListConversationsIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.page == nil || it.index >= len(it.page.Records) {
+		if it.last {
+			return false
+		}
+		page, err := it.client.ListConversations(ctx, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+		it.last = len(page.Records) == 0 || page.Page*page.Limit >= page.TotalRecord
+		it.params.Page++
+	}
+	it.index++
+	return true
+}
+
+func (it *ListConversationsIterator) Value() Conversation {
+	return it.page.Records[it.index-1]
+}
+
+func (it *ListConversationsIterator) Err() error {
+	return it.err
+}
+
+// ListCoupons List of coupons
+//
+//	GET /v1/restricted/admin/coupons
+func (c *Client) ListCoupons(ctx context.Context) ([]Coupon, error) {
+	var result []Coupon
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/coupons", nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListDeadLettersParams are the query parameters of ListDeadLetters
+type ListDeadLettersParams struct {
+	Kind   string
+	Source string
+	Before string
+}
+
+func (p ListDeadLettersParams) values() url.Values {
+	values := url.Values{}
+	if p.Kind != "" {
+		values.Set("kind", p.Kind)
+	}
+	if p.Source != "" {
+		values.Set("source", p.Source)
+	}
+	if p.Before != "" {
+		values.Set("before", p.Before)
+	}
+	return values
+}
+
+// ListDeadLetters Dead letters
+//
+//	GET /v1/restricted/admin/dead-letters
+func (c *Client) ListDeadLetters(ctx context.Context, params ListDeadLettersParams) (*ListDeadLettersResult, error) {
+	result := new(ListDeadLettersResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/dead-letters", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListDeprecationsParams are the query parameters of ListDeprecations
+type ListDeprecationsParams struct {
+	Since string
+}
+
+func (p ListDeprecationsParams) values() url.Values {
+	values := url.Values{}
+	if p.Since != "" {
+		values.Set("since", p.Since)
+	}
+	return values
+}
+
+// ListDeprecations Deprecated routes
+//
+//	GET /v1/restricted/admin/deprecations
+func (c *Client) ListDeprecations(ctx context.Context, params ListDeprecationsParams) ([]DeprecationReport, error) {
+	var result []DeprecationReport
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/deprecations", params.values(), nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListDevices Devices of the user
+//
+//	GET /v1/restricted/users/me/devices
+func (c *Client) ListDevices(ctx context.Context) ([]Device, error) {
+	var result []Device
+	if _, err := c.do(ctx, "GET", "/v1/restricted/users/me/devices", nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListFlaggedCommentsParams are the query parameters of ListFlaggedComments
+type ListFlaggedCommentsParams struct {
+	Lite bool
+}
+
+func (p ListFlaggedCommentsParams) values() url.Values {
+	values := url.Values{}
+	if p.Lite {
+		values.Set("lite", "true")
+	}
+	return values
+}
+
+// ListFlaggedComments Moderation queue of comments
+//
+//	GET /v1/restricted/admin/comments
+func (c *Client) ListFlaggedComments(ctx context.Context, params ListFlaggedCommentsParams) (*ListFlaggedCommentsResult, error) {
+	result := new(ListFlaggedCommentsResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/comments", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListInvitations List of invitations
+//
+//	GET /v1/restricted/invitations
+func (c *Client) ListInvitations(ctx context.Context) ([]Invitation, error) {
+	var result []Invitation
+	if _, err := c.do(ctx, "GET", "/v1/restricted/invitations", nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListMediaParams are the query parameters of ListMedia
+type ListMediaParams struct {
+	Page  int64
+	Limit int64
+	Lite  bool
+}
+
+func (p ListMediaParams) values() url.Values {
+	values := url.Values{}
+	if p.Page != 0 {
+		values.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Lite {
+		values.Set("lite", "true")
+	}
+	return values
+}
+
+// ListMedia Media of the user
+//
+//	GET /v1/restricted/media
+func (c *Client) ListMedia(ctx context.Context, params ListMediaParams) (*ListMediaResult, error) {
+	result := new(ListMediaResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/media", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListMediaIterator walks every page of ListMedia
+type ListMediaIterator struct {
+	client *Client
+	params ListMediaParams
+	page   *ListMediaResult
+	index  int
+	last   bool
+	err    error
+}
+
+// ListMediaAll iterates over every Media of ListMedia starting at params.Page
+func (c *Client) ListMediaAll(params ListMediaParams) *ListMediaIterator {
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	return &ListMediaIterator{client: c, params: params}
+}
+
+// Next advances to the next item, the next page is fetched once the current one is exhausted
+func (it *ListMediaIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.page == nil || it.index >= len(it.page.Records) {
+		if it.last {
+			return false
+		}
+		page, err := it.client.ListMedia(ctx, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+		it.last = len(page.Records) == 0 || page.Page*page.Limit >= page.TotalRecord
+		it.params.Page++
+	}
+	it.index++
+	return true
+}
+
+func (it *ListMediaIterator) Value() Media {
+	return it.page.Records[it.index-1]
+}
+
+func (it *ListMediaIterator) Err() error {
+	return it.err
+}
+
+// ListMessagesParams are the query parameters of ListMessages
+type ListMessagesParams struct {
+	Before int64
+	Limit  int64
+}
+
+func (p ListMessagesParams) values() url.Values {
+	values := url.Values{}
+	if p.Before != 0 {
+		values.Set("before", strconv.FormatInt(p.Before, 10))
+	}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	return values
+}
+
+// ListMessages Messages of a conversation
+//
+//	GET /v1/restricted/conversations/:conversation/messages
+func (c *Client) ListMessages(ctx context.Context, conversation string, params ListMessagesParams) (*ListMessagesResult, error) {
+	result := new(ListMessagesResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/conversations/"+url.PathEscape(conversation)+"/messages", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListReportsParams are the query parameters of ListReports
+type ListReportsParams struct {
+	Status string
+}
+
+func (p ListReportsParams) values() url.Values {
+	values := url.Values{}
+	if p.Status != "" {
+		values.Set("status", p.Status)
+	}
+	return values
+}
+
+// ListReports Moderation queue of reports
+//
+//	GET /v1/restricted/admin/reports
+func (c *Client) ListReports(ctx context.Context, params ListReportsParams) (*ListReportsResult, error) {
+	result := new(ListReportsResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/reports", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListSettings List of settings
+//
+//	GET /v1/restricted/settings
+func (c *Client) ListSettings(ctx context.Context) ([]Setting, error) {
+	var result []Setting
+	if _, err := c.do(ctx, "GET", "/v1/restricted/settings", nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListSlos Service level objectives
+//
+//	GET /v1/restricted/admin/slo
+func (c *Client) ListSlos(ctx context.Context) ([]SloReport, error) {
+	var result []SloReport
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/slo", nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListUserVersions History of a user
+//
+//	GET /v1/restricted/admin/users/:user/versions
+func (c *Client) ListUserVersions(ctx context.Context, user string) (*ListUserVersionsResult, error) {
+	result := new(ListUserVersionsResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/users/"+url.PathEscape(user)+"/versions", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListUsersParams are the query parameters of ListUsers
+type ListUsersParams struct {
+	Page   int64
+	Limit  int64
+	Lite   bool
+	Cursor string
+	Tags   string
+	Ids    string
+}
+
+func (p ListUsersParams) values() url.Values {
+	values := url.Values{}
+	if p.Page != 0 {
+		values.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Lite {
+		values.Set("lite", "true")
+	}
+	if p.Cursor != "" {
+		values.Set("cursor", p.Cursor)
+	}
+	if p.Tags != "" {
+		values.Set("tags", p.Tags)
+	}
+	if p.Ids != "" {
+		values.Set("ids", p.Ids)
+	}
+	return values
+}
+
+// ListUsers List of users
+//
+//	GET /v1/restricted/users
+func (c *Client) ListUsers(ctx context.Context, params ListUsersParams) (*ListUsersResult, error) {
+	result := new(ListUsersResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/users", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListUsersIterator walks every page of ListUsers
+type ListUsersIterator struct {
+	client *Client
+	params ListUsersParams
+	page   *ListUsersResult
+	index  int
+	last   bool
+	err    error
+}
+
+// ListUsersAll iterates over every User of ListUsers starting at params.Page
+func (c *Client) ListUsersAll(params ListUsersParams) *ListUsersIterator {
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	return &ListUsersIterator{client: c, params: params}
+}
+
+// Next advances to the next item, the next page is fetched once the current one is exhausted
+func (it *ListUsersIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.page == nil || it.index >= len(it.page.Records) {
+		if it.last {
+			return false
+		}
+		page, err := it.client.ListUsers(ctx, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+		it.last = len(page.Records) == 0 || page.Page*page.Limit >= page.TotalRecord
+		it.params.Page++
+	}
+	it.index++
+	return true
+}
+
+func (it *ListUsersIterator) Value() User {
+	return it.page.Records[it.index-1]
+}
+
+func (it *ListUsersIterator) Err() error {
+	return it.err
+}
+
+// LoggingLevel Levels of the logger
+//
+//	GET /v1/restricted/admin/logging/level
+func (c *Client) LoggingLevel(ctx context.Context) (*LogLevels, error) {
+	result := new(LogLevels)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/logging/level", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Login
+//
+//	POST /v1/login
+func (c *Client) Login(ctx context.Context, body LoginBody) (*Login, error) {
+	result := new(Login)
+	if _, err := c.do(ctx, "POST", "/v1/login", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Logout End a remember me session
+//
+//	POST /v1/auth/logout
+func (c *Client) Logout(ctx context.Context, body LogoutBody) (json.RawMessage, error) {
+	var result json.RawMessage
+	if _, err := c.do(ctx, "POST", "/v1/auth/logout", nil, body, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MagicLink Mail a sign in link
+//
+//	POST /v1/auth/magic-link
+func (c *Client) MagicLink(ctx context.Context, body MagicLinkBody) (json.RawMessage, error) {
+	var result json.RawMessage
+	if _, err := c.do(ctx, "POST", "/v1/auth/magic-link", nil, body, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MagicLinkCallbackParams are the query parameters of MagicLinkCallback
+type MagicLinkCallbackParams struct {
+	Token string
+}
+
+func (p MagicLinkCallbackParams) values() url.Values {
+	values := url.Values{}
+	if p.Token != "" {
+		values.Set("token", p.Token)
+	}
+	return values
+}
+
+// MagicLinkCallback Sign in with a mailed link
+//
+//	GET /v1/auth/magic-link/callback
+func (c *Client) MagicLinkCallback(ctx context.Context, params MagicLinkCallbackParams) (*Login, error) {
+	result := new(Login)
+	if _, err := c.do(ctx, "GET", "/v1/auth/magic-link/callback", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MediaUsageParams are the query parameters of MediaUsage
+type MediaUsageParams struct {
+	Limit int64
+}
+
+func (p MediaUsageParams) values() url.Values {
+	values := url.Values{}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	return values
+}
+
+// MediaUsage Media usage report
+//
+//	GET /v1/restricted/admin/media/usage
+func (c *Client) MediaUsage(ctx context.Context, params MediaUsageParams) (*MediaUsageReport, error) {
+	result := new(MediaUsageReport)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/media/usage", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Metrics Metrics in the prometheus text format
+//
+//	GET /status/metrics
+func (c *Client) Metrics(ctx context.Context) ([]byte, error) {
+	return c.do(ctx, "GET", "/status/metrics", nil, nil, nil)
+}
+
+// PatchUser Patch a user
+//
+//	PATCH /v1/restricted/users/:user
+func (c *Client) PatchUser(ctx context.Context, user string) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "PATCH", "/v1/restricted/users/"+url.PathEscape(user), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Ping
+//
+//	GET /status/ping
+func (c *Client) Ping(ctx context.Context) (*Message, error) {
+	result := new(Message)
+	if _, err := c.do(ctx, "GET", "/status/ping", nil, nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PopularTagsParams are the query parameters of PopularTags
+type PopularTagsParams struct {
+	Type  string
+	Limit int64
+}
+
+func (p PopularTagsParams) values() url.Values {
+	values := url.Values{}
+	if p.Type != "" {
+		values.Set("type", p.Type)
+	}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	return values
+}
+
+// PopularTags Popular tags
+//
+//	GET /v1/restricted/tags/popular
+func (c *Client) PopularTags(ctx context.Context, params PopularTagsParams) ([]PopularTag, error) {
+	var result []PopularTag
+	if _, err := c.do(ctx, "GET", "/v1/restricted/tags/popular", params.values(), nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PublishAnnouncement Publish an announcement
+//
+//	POST /v1/restricted/admin/announcements/:announcement/publish
+func (c *Client) PublishAnnouncement(ctx context.Context, announcement string, body PublishAnnouncementBody) (*Announcement, error) {
+	result := new(Announcement)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/announcements/"+url.PathEscape(announcement)+"/publish", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PurgeDeadLettersParams are the query parameters of PurgeDeadLetters
+type PurgeDeadLettersParams struct {
+	Kind   string
+	Source string
+	Before string
+}
+
+func (p PurgeDeadLettersParams) values() url.Values {
+	values := url.Values{}
+	if p.Kind != "" {
+		values.Set("kind", p.Kind)
+	}
+	if p.Source != "" {
+		values.Set("source", p.Source)
+	}
+	if p.Before != "" {
+		values.Set("before", p.Before)
+	}
+	return values
+}
+
+// PurgeDeadLetters Purge dead letters
+//
+//	DELETE /v1/restricted/admin/dead-letters
+func (c *Client) PurgeDeadLetters(ctx context.Context, params PurgeDeadLettersParams) (*DeadLetterPurge, error) {
+	result := new(DeadLetterPurge)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/admin/dead-letters", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PushPreferences Push preferences
+//
+//	GET /v1/restricted/users/me/push-preferences
+func (c *Client) PushPreferences(ctx context.Context) (*PushPreferences, error) {
+	result := new(PushPreferences)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/users/me/push-preferences", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ReadAnnouncement Mark an announcement read
+//
+//	POST /v1/restricted/announcements/:announcement/read
+func (c *Client) ReadAnnouncement(ctx context.Context, announcement string) (*Announcement, error) {
+	result := new(Announcement)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/announcements/"+url.PathEscape(announcement)+"/read", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ReadConversation Mark a conversation read
+//
+//	POST /v1/restricted/conversations/:conversation/read
+func (c *Client) ReadConversation(ctx context.Context, conversation string, body ReadConversationBody) (*UnreadCounters, error) {
+	result := new(UnreadCounters)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/conversations/"+url.PathEscape(conversation)+"/read", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Refresh Exchange a remember me refresh token for a new access token
+//
+//	POST /v1/auth/refresh
+func (c *Client) Refresh(ctx context.Context, body RefreshBody) (*Login, error) {
+	result := new(Login)
+	if _, err := c.do(ctx, "POST", "/v1/auth/refresh", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Register
+//
+//	POST /v1/register
+func (c *Client) Register(ctx context.Context, body RegisterBody) (*Login, error) {
+	result := new(Login)
+	if _, err := c.do(ctx, "POST", "/v1/register", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RegisterDevice Register a device
+//
+//	POST /v1/restricted/users/me/devices
+func (c *Client) RegisterDevice(ctx context.Context, body RegisterDeviceBody) (*Device, error) {
+	result := new(Device)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/users/me/devices", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Relationship Relationship with a user
+//
+//	GET /v1/restricted/users/:user/relationship
+func (c *Client) Relationship(ctx context.Context, user string) (*Relationship, error) {
+	result := new(Relationship)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/users/"+url.PathEscape(user)+"/relationship", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RemoveComment Remove a comment
+//
+//	POST /v1/restricted/admin/comments/:comment/remove
+func (c *Client) RemoveComment(ctx context.Context, comment string) (*Comment, error) {
+	result := new(Comment)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/comments/"+url.PathEscape(comment)+"/remove", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ReplayDeadLetter Replay a dead letter
+//
+//	POST /v1/restricted/admin/dead-letters/:dead_letter/replay
+func (c *Client) ReplayDeadLetter(ctx context.Context, deadLetter string) (*DeadLetter, error) {
+	result := new(DeadLetter)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/dead-letters/"+url.PathEscape(deadLetter)+"/replay", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RequestDataExport Export all data of the authenticated user
+//
+//	POST /v1/restricted/users/me/data-export
+func (c *Client) RequestDataExport(ctx context.Context) (*DataExport, error) {
+	result := new(DataExport)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/users/me/data-export", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ResolveReport Resolve a report
+//
+//	POST /v1/restricted/admin/reports/:report/resolve
+func (c *Client) ResolveReport(ctx context.Context, report string, body ResolveReportBody) (*Report, error) {
+	result := new(Report)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/reports/"+url.PathEscape(report)+"/resolve", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RestoreAccount Cancel the scheduled deletion of the authenticated user
+//
+//	POST /v1/restricted/users/me/restore
+func (c *Client) RestoreAccount(ctx context.Context) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/users/me/restore", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RestoreUserVersion Restore a user to a version
+//
+//	POST /v1/restricted/admin/users/:user/versions/:version/restore
+func (c *Client) RestoreUserVersion(ctx context.Context, user string, version string) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/users/"+url.PathEscape(user)+"/versions/"+url.PathEscape(version)+"/restore", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RevokeApiKey Revoke an api key
+//
+//	DELETE /v1/restricted/users/me/api-keys/:key
+func (c *Client) RevokeApiKey(ctx context.Context, key string) (*ApiKey, error) {
+	result := new(ApiKey)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/users/me/api-keys/"+url.PathEscape(key), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RevokeClient Revoke a client
+//
+//	DELETE /v1/restricted/admin/clients/:client
+func (c *Client) RevokeClient(ctx context.Context, client string) (*ModelsClient, error) {
+	result := new(ModelsClient)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/admin/clients/"+url.PathEscape(client), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RevokeInvitation Revoke an invitation
+//
+//	DELETE /v1/restricted/invitations/:invitation
+func (c *Client) RevokeInvitation(ctx context.Context, invitation string) (*Invitation, error) {
+	result := new(Invitation)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/invitations/"+url.PathEscape(invitation), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SearchUsersParams are the query parameters of SearchUsers
+type SearchUsersParams struct {
+	Q         string
+	Tag       string
+	Verified  bool
+	Suspended bool
+	Page      int64
+	Limit     int64
+	Lite      bool
+}
+
+func (p SearchUsersParams) values() url.Values {
+	values := url.Values{}
+	if p.Q != "" {
+		values.Set("q", p.Q)
+	}
+	if p.Tag != "" {
+		values.Set("tag", p.Tag)
+	}
+	if p.Verified {
+		values.Set("verified", "true")
+	}
+	if p.Suspended {
+		values.Set("suspended", "true")
+	}
+	if p.Page != 0 {
+		values.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Limit != 0 {
+		values.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Lite {
+		values.Set("lite", "true")
+	}
+	return values
+}
+
+// SearchUsers Search the users
+//
+//	GET /v1/restricted/admin/users
+func (c *Client) SearchUsers(ctx context.Context, params SearchUsersParams) (*SearchUsersResult, error) {
+	result := new(SearchUsersResult)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/users", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SearchUsersIterator walks every page of SearchUsers
+type SearchUsersIterator struct {
+	client *Client
+	params SearchUsersParams
+	page   *SearchUsersResult
+	index  int
+	last   bool
+	err    error
+}
+
+// SearchUsersAll iterates over every UserSearchView of SearchUsers starting at params.Page
+func (c *Client) SearchUsersAll(params SearchUsersParams) *SearchUsersIterator {
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	return &SearchUsersIterator{client: c, params: params}
+}
+
+// Next advances to the next item, the next page is fetched once the current one is exhausted
+func (it *SearchUsersIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.page == nil || it.index >= len(it.page.Records) {
+		if it.last {
+			return false
+		}
+		page, err := it.client.SearchUsers(ctx, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+		it.last = len(page.Records) == 0 || page.Page*page.Limit >= page.TotalRecord
+		it.params.Page++
+	}
+	it.index++
+	return true
+}
+
+func (it *SearchUsersIterator) Value() UserSearchView {
+	return it.page.Records[it.index-1]
+}
+
+func (it *SearchUsersIterator) Err() error {
+	return it.err
+}
+
+// SendMessage Send a message
+//
+//	POST /v1/restricted/conversations/:conversation/messages
+func (c *Client) SendMessage(ctx context.Context, conversation string, body SendMessageBody) (*ModelsMessage, error) {
+	result := new(ModelsMessage)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/conversations/"+url.PathEscape(conversation)+"/messages", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SendPhoneCode Send a new verification code to the phone of the authenticated user
+//
+//	POST /v1/restricted/users/me/phone/code
+func (c *Client) SendPhoneCode(ctx context.Context) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/users/me/phone/code", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ShowAdminRecord Show a record of a resource
+//
+//	GET /v1/restricted/admin/resources/:resource/:id
+func (c *Client) ShowAdminRecord(ctx context.Context, resource string, id string) (json.RawMessage, error) {
+	var result json.RawMessage
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/resources/"+url.PathEscape(resource)+"/"+url.PathEscape(id), nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ShowConfig Runtime configuration
+//
+//	GET /v1/restricted/admin/config
+func (c *Client) ShowConfig(ctx context.Context) (*ConfigReport, error) {
+	result := new(ConfigReport)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/config", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ShowDataExport Get a data export of the authenticated user
+//
+//	GET /v1/restricted/users/me/data-exports/:export
+func (c *Client) ShowDataExport(ctx context.Context, export string) (*DataExport, error) {
+	result := new(DataExport)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/users/me/data-exports/"+url.PathEscape(export), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ShowDeadLetter Get a dead letter
+//
+//	GET /v1/restricted/admin/dead-letters/:dead_letter
+func (c *Client) ShowDeadLetter(ctx context.Context, deadLetter string) (*DeadLetter, error) {
+	result := new(DeadLetter)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/admin/dead-letters/"+url.PathEscape(deadLetter), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ShowMedia Get a media
+//
+//	GET /v1/restricted/media/:media
+func (c *Client) ShowMedia(ctx context.Context, media string) (*Media, error) {
+	result := new(Media)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/media/"+url.PathEscape(media), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ShowTags Tags of a taggable
+//
+//	GET /v1/restricted/tags/:type/:id
+func (c *Client) ShowTags(ctx context.Context, typeID string, id string) ([]Tag, error) {
+	var result []Tag
+	if _, err := c.do(ctx, "GET", "/v1/restricted/tags/"+url.PathEscape(typeID)+"/"+url.PathEscape(id), nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ShowUsageParams are the query parameters of ShowUsage
+type ShowUsageParams struct {
+	Year int64
+}
+
+func (p ShowUsageParams) values() url.Values {
+	values := url.Values{}
+	if p.Year != 0 {
+		values.Set("year", strconv.FormatInt(p.Year, 10))
+	}
+	return values
+}
+
+// ShowUsage Requests of the authenticated user by month
+//
+//	GET /v1/restricted/users/me/usage
+func (c *Client) ShowUsage(ctx context.Context, params ShowUsageParams) (*Usage, error) {
+	result := new(Usage)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/users/me/usage", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ShowUser Get User
+//
+//	GET /v1/restricted/users/:user
+func (c *Client) ShowUser(ctx context.Context, user string) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/users/"+url.PathEscape(user), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ShowVotes Votes of a votable
+//
+//	GET /v1/restricted/votes/:type/:id
+func (c *Client) ShowVotes(ctx context.Context, typeID string, id string) (*VoteSummary, error) {
+	result := new(VoteSummary)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/votes/"+url.PathEscape(typeID)+"/"+url.PathEscape(id), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StartConversation Start a conversation
+//
+//	POST /v1/restricted/conversations
+func (c *Client) StartConversation(ctx context.Context, body StartConversationBody) (*Conversation, error) {
+	result := new(Conversation)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/conversations", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Sudo Confirm the password to allow sensitive operations
+//
+//	POST /v1/restricted/auth/sudo
+func (c *Client) Sudo(ctx context.Context, body SudoBody) (*Login, error) {
+	result := new(Login)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/auth/sudo", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SuspendUser Suspend a user
+//
+//	POST /v1/restricted/admin/users/:user/suspension
+func (c *Client) SuspendUser(ctx context.Context, user string, body SuspendUserBody) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/admin/users/"+url.PathEscape(user)+"/suspension", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SyncTags Replace the tags
+//
+//	PUT /v1/restricted/tags/:type/:id
+func (c *Client) SyncTags(ctx context.Context, typeID string, id string, body SyncTagsBody) ([]Tag, error) {
+	var result []Tag
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/tags/"+url.PathEscape(typeID)+"/"+url.PathEscape(id), nil, body, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Unfollow Unfollow a user
+//
+//	DELETE /v1/restricted/users/:user/follow
+func (c *Client) Unfollow(ctx context.Context, user string) (*Relationship, error) {
+	result := new(Relationship)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/users/"+url.PathEscape(user)+"/follow", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UnreadMessages Unread counters
+//
+//	GET /v1/restricted/conversations/unread
+func (c *Client) UnreadMessages(ctx context.Context) (*UnreadCounters, error) {
+	result := new(UnreadCounters)
+	if _, err := c.do(ctx, "GET", "/v1/restricted/conversations/unread", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UnregisterDevice Unregister a device
+//
+//	DELETE /v1/restricted/users/me/devices/:device
+func (c *Client) UnregisterDevice(ctx context.Context, device string) error {
+	_, err := c.do(ctx, "DELETE", "/v1/restricted/users/me/devices/"+url.PathEscape(device), nil, nil, nil)
+	return err
+}
+
+// UnsuspendUser Unsuspend a user
+//
+//	DELETE /v1/restricted/admin/users/:user/suspension
+func (c *Client) UnsuspendUser(ctx context.Context, user string) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/admin/users/"+url.PathEscape(user)+"/suspension", nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Unvote Remove the vote on a votable
+//
+//	DELETE /v1/restricted/votes/:type/:id
+func (c *Client) Unvote(ctx context.Context, typeID string, id string) (*VoteSummary, error) {
+	result := new(VoteSummary)
+	if _, err := c.do(ctx, "DELETE", "/v1/restricted/votes/"+url.PathEscape(typeID)+"/"+url.PathEscape(id), nil, nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateAdminRecord Update a record of a resource
+//
+//	PUT /v1/restricted/admin/resources/:resource/:id
+func (c *Client) UpdateAdminRecord(ctx context.Context, resource string, id string) (json.RawMessage, error) {
+	var result json.RawMessage
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/admin/resources/"+url.PathEscape(resource)+"/"+url.PathEscape(id), nil, nil, &envelope{Data: &result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateComment Edit a comment
+//
+//	PUT /v1/restricted/comments/:comment
+func (c *Client) UpdateComment(ctx context.Context, comment string, body UpdateCommentBody) (*Comment, error) {
+	result := new(Comment)
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/comments/"+url.PathEscape(comment), nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateCoupon Update the limits of a coupon
+//
+//	PUT /v1/restricted/admin/coupons/:coupon
+func (c *Client) UpdateCoupon(ctx context.Context, coupon string, body UpdateCouponBody) (*Coupon, error) {
+	result := new(Coupon)
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/admin/coupons/"+url.PathEscape(coupon), nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateLoggingLevel Change the levels of the logger
+//
+//	PUT /v1/restricted/admin/logging/level
+func (c *Client) UpdateLoggingLevel(ctx context.Context, body UpdateLoggingLevelBody) (*LogLevels, error) {
+	result := new(LogLevels)
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/admin/logging/level", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdatePhone Set the phone number of the authenticated user
+//
+//	PUT /v1/restricted/users/me/phone
+func (c *Client) UpdatePhone(ctx context.Context, body UpdatePhoneBody) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/users/me/phone", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdatePreferences Update the preferences of the authenticated user
+//
+//	PUT /v1/restricted/users/me/preferences
+func (c *Client) UpdatePreferences(ctx context.Context, body UpdatePreferencesBody) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/users/me/preferences", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdatePushPreferences Update the push preferences
+//
+//	PUT /v1/restricted/users/me/push-preferences
+func (c *Client) UpdatePushPreferences(ctx context.Context, body PushPreferences) (*PushPreferences, error) {
+	result := new(PushPreferences)
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/users/me/push-preferences", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateSetting Update a setting
+//
+//	PUT /v1/restricted/settings/:setting
+func (c *Client) UpdateSetting(ctx context.Context, setting string, body UpdateSettingBody) (*Setting, error) {
+	result := new(Setting)
+	if _, err := c.do(ctx, "PUT", "/v1/restricted/settings/"+url.PathEscape(setting), nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UploadMedia Upload a file
+//
+//	POST /v1/restricted/media
+func (c *Client) UploadMedia(ctx context.Context, body UploadMediaBody) (*Media, error) {
+	result := new(Media)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/media", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// VerifyEmailParams are the query parameters of VerifyEmail
+type VerifyEmailParams struct {
+	Token string
+}
+
+func (p VerifyEmailParams) values() url.Values {
+	values := url.Values{}
+	if p.Token != "" {
+		values.Set("token", p.Token)
+	}
+	return values
+}
+
+// VerifyEmail Verify the email of a new user
+//
+//	GET /v1/verifications/confirm
+func (c *Client) VerifyEmail(ctx context.Context, params VerifyEmailParams) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "GET", "/v1/verifications/confirm", params.values(), nil, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// VerifyPhone Verify the phone of the authenticated user
+//
+//	POST /v1/restricted/users/me/phone/verify
+func (c *Client) VerifyPhone(ctx context.Context, body VerifyPhoneBody) (*User, error) {
+	result := new(User)
+	if _, err := c.do(ctx, "POST", "/v1/restricted/users/me/phone/verify", nil, body, &envelope{Data: result}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Version
+//
+//	GET /status/version
+func (c *Client) Version(ctx context.Context) (*Message, error) {
+	result := new(Message)
+	if _, err := c.do(ctx, "GET", "/status/version", nil, nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Authenticate calls Refresh and keeps the issued access token for the following calls
+func (c *Client) Authenticate(ctx context.Context, body RefreshBody) (*Login, error) {
+	result, err := c.Refresh(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	c.SetToken(result.AccessToken)
+	return result, nil
+}
@@ -0,0 +1,2500 @@
+// Code generated by sdkgen from docs/swagger.json; DO NOT EDIT.
+
+export interface Announcement {
+  audience?: string;
+  body?: string;
+  created_at?: string;
+  created_by?: number;
+  id?: number;
+  plan_slug?: string;
+  publish_at?: string;
+  publish_status?: string;
+  published_at?: string;
+  read?: boolean;
+  read_count?: number;
+  title?: string;
+  updated_at?: string;
+}
+
+export interface ApiKey {
+  created_at?: string;
+  expires_at?: string;
+  id?: number;
+  last_used_at?: string;
+  name?: string;
+  prefix?: string;
+  revoked_at?: string;
+  scopes?: string;
+  updated_at?: string;
+  user_id?: number;
+}
+
+export interface AttachMediaBody {
+  type: string;
+  id: number;
+}
+
+export interface AttachTagsBody {
+  tags: string[];
+}
+
+export interface BatchUsersBody {
+  ids: number[];
+}
+
+export interface BatchUsersResult {
+  missing?: number[];
+  records?: User[];
+}
+
+export interface BillingCheckoutBody {
+  plan: string;
+  coupon?: string;
+}
+
+export interface BillingValidateCouponBody {
+  code: string;
+  plan: string;
+}
+
+export interface Bulk {
+  failed?: number;
+  results?: BulkResult[];
+  succeeded?: number;
+}
+
+export interface BulkDeleteUsersBody {
+  operations: UserBulkDeleteOperation[];
+}
+
+export interface BulkReplayDeadLettersBody {
+  ids?: number[];
+  kind?: string;
+  source?: string;
+}
+
+export interface BulkReplayDeadLettersResult {
+  failed?: number;
+  results?: BulkReplayDeadLettersResultResultsItem[];
+  succeeded?: number;
+}
+
+export interface BulkReplayDeadLettersResultResultsItem {
+  error?: ProblemsProblem;
+  id?: number;
+  record?: DeadLetter;
+  status?: number;
+}
+
+export interface BulkResult {
+  error?: ProblemsProblem;
+  id?: number;
+  record?: unknown;
+  status?: number;
+}
+
+export interface BulkUpdateUsersBody {
+  operations: UserBulkUpdateOperation[];
+}
+
+export interface BulkUpdateUsersResult {
+  failed?: number;
+  results?: BulkUpdateUsersResultResultsItem[];
+  succeeded?: number;
+}
+
+export interface BulkUpdateUsersResultResultsItem {
+  error?: ProblemsProblem;
+  id?: number;
+  record?: User;
+  status?: number;
+}
+
+export interface ChangeEmailBody {
+  email: string;
+  current_password: string;
+}
+
+export interface ChangePasswordBody {
+  password: string;
+  password_confirmation: string;
+}
+
+export interface Checkout {
+  url?: string;
+}
+
+export interface ClientToken {
+  access_token?: string;
+  expires_in?: number;
+  scope?: string;
+  token_type?: string;
+}
+
+export interface ClientTokenBody {
+  grant_type: string;
+  scope?: string;
+  client_id?: string;
+  client_secret?: string;
+}
+
+export interface Code {
+  code?: string;
+  description?: string;
+  status?: number;
+  title?: string;
+}
+
+export interface Comment {
+  body?: string;
+  commentable_id?: number;
+  commentable_type?: string;
+  created_at?: string;
+  deleted_at?: string;
+  depth?: number;
+  edited_at?: string;
+  id?: number;
+  parent_id?: number;
+  replies?: Comment[];
+  root_id?: number;
+  status?: string;
+  updated_at?: string;
+  user_id?: number;
+}
+
+export interface ConfigChange {
+  current?: Variable;
+  name?: string;
+  previous?: Variable;
+}
+
+export interface ConfigReport {
+  diff?: ConfigChange[];
+  effective?: unknown;
+  previous?: ConfigSnapshot;
+  snapshot?: ConfigSnapshot;
+  variables?: Variable[];
+}
+
+export interface ConfigSnapshot {
+  checksum?: string;
+  created_at?: string;
+  host?: string;
+  id?: number;
+  loaded_at?: string;
+  loads?: number;
+  version?: string;
+}
+
+export interface Consent {
+  accepted_at?: string;
+  created_at?: string;
+  id?: number;
+  ip?: string;
+  policy?: Policy;
+  policy_id?: number;
+  user_id?: number;
+}
+
+export interface Conversation {
+  created_at?: string;
+  created_by?: number;
+  direct?: boolean;
+  id?: number;
+  last_message_at?: string;
+  participants?: ConversationParticipant[];
+  unread_count?: number;
+  updated_at?: string;
+}
+
+export interface ConversationParticipant {
+  conversation_id?: number;
+  created_at?: string;
+  last_read_message_id?: number;
+  user_id?: number;
+}
+
+export interface ConversationUnread {
+  conversation_id?: number;
+  count?: number;
+}
+
+export interface Coupon {
+  active?: boolean;
+  amount_off?: Money;
+  code?: string;
+  created_at?: string;
+  created_by?: number;
+  expires_at?: string;
+  id?: number;
+  max_per_user?: number;
+  max_redemptions?: number;
+  percent_off?: number;
+  plan_id?: number;
+  redemptions?: number;
+  type?: string;
+  updated_at?: string;
+}
+
+export interface CouponQuote {
+  coupon?: Coupon;
+  discount?: Money;
+  plan?: string;
+  price?: Money;
+  total?: Money;
+}
+
+export interface CreateAnnouncementBody {
+  title: string;
+  body: string;
+  audience: string;
+  plan?: string;
+  publish_at?: string;
+  draft?: boolean;
+}
+
+export interface CreateApiKeyBody {
+  name: string;
+  scopes: string[];
+  ttl_hours?: number;
+}
+
+export interface CreateApiKeyResult {
+  key?: string;
+  record?: ApiKey;
+}
+
+export interface CreateClientBody {
+  name: string;
+  scopes: string[];
+  rate_limit?: number;
+  certificate_identity?: string;
+}
+
+export interface CreateClientResult {
+  client_secret?: string;
+  record?: ModelsClient;
+}
+
+export interface CreateCommentBody {
+  body: string;
+  parent_id?: number;
+}
+
+export interface CreateCouponBody {
+  code: string;
+  percent_off?: number;
+  amount_off?: string;
+  currency?: string;
+  plan_id?: number;
+  max_redemptions?: number;
+  max_per_user?: number;
+  expires_at?: string;
+}
+
+export interface CreateInvitationBody {
+  max_uses?: number;
+  ttl_hours?: number;
+  email?: string;
+}
+
+export interface CreateReportBody {
+  reason: string;
+  details?: string;
+}
+
+export interface DataExport {
+  created_at?: string;
+  expires_at?: string;
+  id?: number;
+  status?: string;
+  updated_at?: string;
+  user_id?: number;
+}
+
+export interface DeadLetter {
+  attempts?: number;
+  created_at?: string;
+  error?: string;
+  event_id?: string;
+  id?: number;
+  kind?: string;
+  payload?: string;
+  replays?: number;
+  source?: string;
+  updated_at?: string;
+}
+
+export interface DeadLetterPurge {
+  deleted?: number;
+}
+
+export interface DeprecatedCall {
+  caller?: string;
+  calls?: number;
+  first_called_at?: string;
+  last_called_at?: string;
+  route?: string;
+}
+
+export interface DeprecationReport {
+  callers?: DeprecatedCall[];
+  calls?: number;
+  deprecated_at?: string;
+  link?: string;
+  method?: string;
+  path?: string;
+  sunset?: string;
+}
+
+export interface DetachTagsBody {
+  tags: string[];
+}
+
+export interface Device {
+  created_at?: string;
+  id?: number;
+  last_push_at?: string;
+  platform?: string;
+  token?: string;
+  updated_at?: string;
+  user_id?: number;
+}
+
+export interface DismissReportBody {
+  note?: string;
+}
+
+export interface EmailChange {
+  confirmed_at?: string;
+  created_at?: string;
+  expires_at?: string;
+  id?: number;
+  new_email?: string;
+  updated_at?: string;
+  user_id?: number;
+}
+
+export interface FlagCommentBody {
+  reason?: string;
+}
+
+export interface FollowersResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: User[];
+  total_record?: number;
+}
+
+export interface FollowingResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: User[];
+  total_record?: number;
+}
+
+export interface Impersonation {
+  access_token?: string;
+  access_token_exp?: number;
+  impersonated_by?: number;
+  user?: unknown;
+}
+
+export interface Invitation {
+  code?: string;
+  created_at?: string;
+  created_by?: number;
+  email?: string;
+  expires_at?: string;
+  id?: number;
+  max_uses?: number;
+  revoked_at?: string;
+  updated_at?: string;
+  uses?: number;
+}
+
+export interface Invoice {
+  created_at?: string;
+  download_url?: string;
+  id?: number;
+  lines?: InvoiceLine[];
+  number?: string;
+  paid_at?: string;
+  period_end?: string;
+  period_start?: string;
+  status?: string;
+  total?: Money;
+  updated_at?: string;
+  user_id?: number;
+}
+
+export interface InvoiceLine {
+  amount?: Money;
+  description?: string;
+}
+
+export interface Links {
+}
+
+export interface ListAllAnnouncementsResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: Announcement[];
+  total_record?: number;
+}
+
+export interface ListAnnouncementsResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: Announcement[];
+  total_record?: number;
+}
+
+export interface ListCommentsResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: Comment[];
+  total_record?: number;
+}
+
+export interface ListConversationsResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: Conversation[];
+  total_record?: number;
+}
+
+export interface ListDeadLettersResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: DeadLetter[];
+  total_record?: number;
+}
+
+export interface ListFlaggedCommentsResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: Comment[];
+  total_record?: number;
+}
+
+export interface ListMediaResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: Media[];
+  total_record?: number;
+}
+
+export interface ListMessagesResult {
+  _links?: Links;
+  limit?: number;
+  next_cursor?: number;
+  records?: ModelsMessage[];
+}
+
+export interface ListReportsResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: Report[];
+  total_record?: number;
+}
+
+export interface ListUserVersionsResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: UserVersion[];
+  total_record?: number;
+}
+
+export interface ListUsersResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: User[];
+  total_record?: number;
+}
+
+export interface LogLevels {
+  level?: string;
+  modules?: unknown;
+}
+
+export interface Login {
+  access_token?: string;
+  access_token_exp?: number;
+  refresh_token?: string;
+  refresh_token_exp?: number;
+  sudo_until?: number;
+  user?: unknown;
+}
+
+export interface LoginBody {
+  email: string;
+  password: string;
+  remember_me?: boolean;
+  device_id?: string;
+  platform: string;
+  captcha_token?: string;
+}
+
+export interface LogoutBody {
+  refresh_token: string;
+}
+
+export interface MagicLinkBody {
+  email: string;
+}
+
+export interface Media {
+  attachable_id?: number;
+  attachable_type?: string;
+  checksum?: string;
+  created_at?: string;
+  disk?: string;
+  id?: number;
+  mime?: string;
+  name?: string;
+  size?: number;
+  updated_at?: string;
+  user_id?: number;
+  visibility?: string;
+}
+
+export interface MediaMimeUsage {
+  bytes?: number;
+  files?: number;
+  mime?: string;
+}
+
+export interface MediaUsage {
+  bytes?: number;
+  files?: number;
+}
+
+export interface MediaUsageReport {
+  by_mime?: MediaMimeUsage[];
+  by_user?: MediaUserUsage[];
+  orphans?: MediaUsage;
+  total?: MediaUsage;
+}
+
+export interface MediaUserUsage {
+  bytes?: number;
+  files?: number;
+  user_id?: number;
+}
+
+export interface Message {
+  message?: string;
+}
+
+export interface ModelsClient {
+  certificate_identity?: string;
+  client_id?: string;
+  created_at?: string;
+  created_by?: number;
+  id?: number;
+  last_used_at?: string;
+  name?: string;
+  rate_limit?: number;
+  revoked_at?: string;
+  scopes?: string;
+  updated_at?: string;
+}
+
+export interface ModelsMessage {
+  body?: string;
+  conversation_id?: number;
+  created_at?: string;
+  id?: number;
+  user_id?: number;
+}
+
+export interface Money {
+  amount?: number;
+  currency?: string;
+}
+
+export interface MonthlyUsage {
+  month?: string;
+  month_id?: number;
+  requests?: number;
+}
+
+export interface Paginator {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: unknown;
+  total_record?: number;
+}
+
+export interface Plan {
+  active?: boolean;
+  created_at?: string;
+  id?: number;
+  interval?: string;
+  name?: string;
+  price?: Money;
+  rank?: number;
+  slug?: string;
+  updated_at?: string;
+}
+
+export interface Policy {
+  created_at?: string;
+  id?: number;
+  published_at?: string;
+  type?: string;
+  updated_at?: string;
+  url?: string;
+  version?: string;
+}
+
+export interface PopularTag {
+  count?: number;
+  created_at?: string;
+  id?: number;
+  name?: string;
+  slug?: string;
+}
+
+export interface ProblemsProblem {
+  code?: string;
+  detail?: string;
+  instance?: string;
+  status?: number;
+  title?: string;
+  type?: string;
+}
+
+export interface PublishAnnouncementBody {
+  publish_at?: string;
+}
+
+export interface PushPreferences {
+}
+
+export interface ReadConversationBody {
+  message_id?: number;
+}
+
+export interface RefreshBody {
+  refresh_token: string;
+  device_id: string;
+}
+
+export interface RegisterBody {
+  name: string;
+  email: string;
+  password: string;
+  invitation_code?: string;
+  captcha_token?: string;
+}
+
+export interface RegisterDeviceBody {
+  token: string;
+  platform: string;
+}
+
+export interface Relationship {
+  followed_by?: boolean;
+  followers?: number;
+  following?: boolean;
+  followings?: number;
+  mutual?: boolean;
+}
+
+export interface Report {
+  created_at?: string;
+  details?: string;
+  id?: number;
+  note?: string;
+  offender_id?: number;
+  reason?: string;
+  reportable_id?: number;
+  reportable_type?: string;
+  reporter_id?: number;
+  resolved_at?: string;
+  resolved_by_id?: number;
+  status?: string;
+  updated_at?: string;
+}
+
+export interface ResolveReportBody {
+  note?: string;
+}
+
+export interface SearchUsersResult {
+  _links?: Links;
+  has_next?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  records?: UserSearchView[];
+  total_record?: number;
+}
+
+export interface SendMessageBody {
+  body: string;
+}
+
+export interface Setting {
+  created_at?: string;
+  description?: string;
+  key?: string;
+  options?: string;
+  type?: string;
+  updated_at?: string;
+  value?: string;
+}
+
+export interface SloReport {
+  burn_rate_1h?: number;
+  burn_rate_5m?: number;
+  burning?: boolean;
+  compliance?: number;
+  error_budget_remaining?: number;
+  good?: number;
+  latency_ms?: number;
+  objective?: number;
+  requests?: number;
+  route?: string;
+  since?: string;
+  window?: string;
+}
+
+export interface StartConversationBody {
+  user_ids: number[];
+  body?: string;
+}
+
+export interface Subscription {
+  cancel_at_period_end?: boolean;
+  created_at?: string;
+  current_period_end?: string;
+  id?: number;
+  plan?: Plan;
+  plan_id?: number;
+  status?: string;
+  updated_at?: string;
+  user_id?: number;
+}
+
+export interface SudoBody {
+  password: string;
+}
+
+export interface SuspendUserBody {
+  until?: string;
+  reason?: string;
+}
+
+export interface SyncTagsBody {
+  tags: string[];
+}
+
+export interface Tag {
+  created_at?: string;
+  id?: number;
+  name?: string;
+  slug?: string;
+}
+
+export interface UnreadCounters {
+  by_conversation?: ConversationUnread[];
+  conversations?: number;
+  messages?: number;
+}
+
+export interface UpdateCommentBody {
+  body: string;
+}
+
+export interface UpdateCouponBody {
+  max_redemptions?: number;
+  max_per_user?: number;
+  expires_at?: string;
+  active?: boolean;
+}
+
+export interface UpdateLoggingLevelBody {
+  level: string;
+  modules?: unknown;
+}
+
+export interface UpdatePhoneBody {
+  phone: string;
+}
+
+export interface UpdatePreferencesBody {
+  timezone: string;
+}
+
+export interface UpdateSettingBody {
+  value: string;
+}
+
+export interface UploadMediaBody {
+  file: unknown;
+  visibility?: string;
+}
+
+export interface Usage {
+  daily_quota?: number;
+  monthly_quota?: number;
+  months?: MonthlyUsage[];
+  today?: number;
+  year?: number;
+}
+
+export interface User {
+  admin?: boolean;
+  created_at?: string;
+  daily_quota?: number;
+  deletion_scheduled_at?: string;
+  email?: string;
+  id?: number;
+  image?: string;
+  monthly_quota?: number;
+  name?: string;
+  phone?: string;
+  phone_verified_at?: string;
+  suspended_at?: string;
+  suspended_until?: string;
+  suspension_reason?: string;
+  timezone?: string;
+  updated_at?: string;
+  verified?: boolean;
+}
+
+export interface UserBulkDeleteOperation {
+  id?: number;
+}
+
+export interface UserBulkUpdateOperation {
+  daily_quota?: number;
+  id?: number;
+  monthly_quota?: number;
+  name?: string;
+  timezone?: string;
+  verified?: boolean;
+}
+
+export interface UserChange {
+  field?: string;
+  from?: unknown;
+  to?: unknown;
+}
+
+export interface UserSearchView {
+  admin?: boolean;
+  created_at?: string;
+  email?: string;
+  followers_count?: number;
+  following_count?: number;
+  name?: string;
+  projected_at?: string;
+  suspended?: boolean;
+  tag_names?: string;
+  user_id?: number;
+  verified?: boolean;
+}
+
+export interface UserVersion {
+  created_at?: string;
+  id?: number;
+  user?: unknown;
+  user_id?: number;
+}
+
+export interface Variable {
+  name?: string;
+  secret?: boolean;
+  source?: string;
+  value?: string;
+}
+
+export interface VerifyPhoneBody {
+  code: string;
+}
+
+export interface VoteSummary {
+  dislikes?: number;
+  likes?: number;
+  score?: number;
+  updated_at?: string;
+  votable_id?: number;
+  votable_type?: string;
+  vote?: number;
+}
+
+export interface BillingDownloadInvoiceParams {
+  expires: number;
+  signature: string;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ConfirmEmailChangeParams {
+  token: string;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface DiffUserVersionParams {
+  with?: number;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface FollowersParams {
+  page?: number;
+  limit?: number;
+  lite?: boolean;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface FollowingParams {
+  page?: number;
+  limit?: number;
+  lite?: boolean;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ListAdminRecordsParams {
+  page?: number;
+  limit?: number;
+  lite?: boolean;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ListAllAnnouncementsParams {
+  page?: number;
+  limit?: number;
+  lite?: boolean;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ListAnnouncementsParams {
+  page?: number;
+  limit?: number;
+  lite?: boolean;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ListConversationsParams {
+  page?: number;
+  limit?: number;
+  lite?: boolean;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ListDeadLettersParams {
+  kind?: string;
+  source?: string;
+  before?: string;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ListDeprecationsParams {
+  since?: string;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ListFlaggedCommentsParams {
+  lite?: boolean;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ListMediaParams {
+  page?: number;
+  limit?: number;
+  lite?: boolean;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ListMessagesParams {
+  before?: number;
+  limit?: number;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ListReportsParams {
+  status?: string;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ListUsersParams {
+  page?: number;
+  limit?: number;
+  lite?: boolean;
+  cursor?: string;
+  tags?: string;
+  ids?: string;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface MagicLinkCallbackParams {
+  token: string;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface MediaUsageParams {
+  limit?: number;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface PopularTagsParams {
+  type?: string;
+  limit?: number;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface PurgeDeadLettersParams {
+  kind?: string;
+  source?: string;
+  before?: string;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface SearchUsersParams {
+  q?: string;
+  tag?: string;
+  verified?: boolean;
+  suspended?: boolean;
+  page?: number;
+  limit?: number;
+  lite?: boolean;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface ShowUsageParams {
+  year?: number;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface VerifyEmailParams {
+  token: string;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface Problem {
+  type: string;
+  title: string;
+  status: number;
+  detail?: string;
+  code: string;
+  errors?: Record<string, unknown>;
+}
+
+/** ProblemError is thrown for every application/problem+json response, branch on problem.code */
+export class ProblemError extends Error {
+  constructor(public readonly problem: Problem) {
+    super(problem.detail ? problem.code + ': ' + problem.detail : problem.code + ': ' + problem.title);
+    this.name = 'ProblemError';
+  }
+}
+
+export interface ClientOptions {
+  token?: string;
+  fetch?: typeof fetch;
+}
+
+type Query = Record<string, string | number | boolean | undefined>;
+
+export class Client {
+  private token?: string;
+  private readonly fetcher: typeof fetch;
+
+  constructor(private readonly baseUrl: string, options: ClientOptions = {}) {
+    this.baseUrl = baseUrl.replace(/\/+$/, '');
+    this.token = options.token;
+    this.fetcher = options.fetch ?? fetch.bind(globalThis);
+  }
+
+  /** setToken sets the bearer token sent with every request */
+  setToken(token?: string): void {
+    this.token = token;
+  }
+
+  getToken(): string | undefined {
+    return this.token;
+  }
+
+  private async request(method: string, path: string, query?: Query, body?: unknown): Promise<Response> {
+    let url = this.baseUrl + path;
+    const search = new URLSearchParams();
+    for (const [key, value] of Object.entries(query ?? {})) {
+      if (value !== undefined) {
+        search.set(key, String(value));
+      }
+    }
+    if ([...search.keys()].length > 0) {
+      url += '?' + search.toString();
+    }
+
+    const headers: Record<string, string> = { Accept: 'application/json' };
+    if (body !== undefined) {
+      headers['Content-Type'] = 'application/json';
+    }
+    if (this.token) {
+      headers.Authorization = 'Bearer ' + this.token;
+    }
+
+    const response = await this.fetcher(url, {
+      method,
+      headers,
+      body: body === undefined ? undefined : JSON.stringify(body),
+    });
+    if (response.status >= 400) {
+      let problem: Problem = { type: 'about:blank', title: response.statusText, status: response.status, code: '' };
+      try {
+        problem = { ...problem, ...(await response.json()) };
+      } catch {
+        // the body is not a problem document
+      }
+      throw new ProblemError(problem);
+    }
+    return response;
+  }
+
+  /**
+   * Accept the current version of a policy
+   * POST /v1/restricted/policies/:policy/accept
+   */
+  async acceptPolicy(policy: string): Promise<Consent> {
+    const response = await this.request('POST', `/v1/restricted/policies/${encodeURIComponent(policy)}/accept`, undefined, undefined);
+    return (await response.json()).data as Consent;
+  }
+
+  /**
+   * Approve a comment
+   * POST /v1/restricted/admin/comments/:comment/approve
+   */
+  async approveComment(comment: string): Promise<Comment> {
+    const response = await this.request('POST', `/v1/restricted/admin/comments/${encodeURIComponent(comment)}/approve`, undefined, undefined);
+    return (await response.json()).data as Comment;
+  }
+
+  /**
+   * Attach a media
+   * PUT /v1/restricted/media/:media/attachment
+   */
+  async attachMedia(media: string, body: AttachMediaBody): Promise<Media> {
+    const response = await this.request('PUT', `/v1/restricted/media/${encodeURIComponent(media)}/attachment`, undefined, body);
+    return (await response.json()).data as Media;
+  }
+
+  /**
+   * Attach tags
+   * POST /v1/restricted/tags/:type/:id
+   */
+  async attachTags(type: string, id: string, body: AttachTagsBody): Promise<Tag[]> {
+    const response = await this.request('POST', `/v1/restricted/tags/${encodeURIComponent(type)}/${encodeURIComponent(id)}`, undefined, body);
+    return (await response.json()).data as Tag[];
+  }
+
+  /**
+   * Get users by their ids
+   * POST /v1/restricted/users/batch
+   */
+  async batchUsers(body: BatchUsersBody): Promise<BatchUsersResult> {
+    const response = await this.request('POST', '/v1/restricted/users/batch', undefined, body);
+    return (await response.json()).data as BatchUsersResult;
+  }
+
+  /**
+   * Cancel the subscription of the authenticated user
+   * DELETE /v1/restricted/billing/subscription
+   */
+  async billingCancel(): Promise<Subscription> {
+    const response = await this.request('DELETE', '/v1/restricted/billing/subscription', undefined, undefined);
+    return (await response.json()).data as Subscription;
+  }
+
+  /**
+   * Start the subscription to a plan
+   * POST /v1/restricted/billing/checkout
+   */
+  async billingCheckout(body: BillingCheckoutBody): Promise<Checkout> {
+    const response = await this.request('POST', '/v1/restricted/billing/checkout', undefined, body);
+    return (await response.json()).data as Checkout;
+  }
+
+  /**
+   * Download the pdf of an invoice
+   * GET /v1/billing/invoices/:invoice/download
+   */
+  async billingDownloadInvoice(invoice: string, params: BillingDownloadInvoiceParams = {}): Promise<unknown> {
+    const response = await this.request('GET', `/v1/billing/invoices/${encodeURIComponent(invoice)}/download`, params, undefined);
+    return (await response.json()) as unknown;
+  }
+
+  /**
+   * Invoices of the authenticated user
+   * GET /v1/restricted/billing/invoices
+   */
+  async billingInvoices(): Promise<Invoice[]> {
+    const response = await this.request('GET', '/v1/restricted/billing/invoices', undefined, undefined);
+    return (await response.json()).data as Invoice[];
+  }
+
+  /**
+   * Plans users can subscribe to
+   * GET /v1/billing/plans
+   */
+  async billingPlans(): Promise<Plan[]> {
+    const response = await this.request('GET', '/v1/billing/plans', undefined, undefined);
+    return (await response.json()).data as Plan[];
+  }
+
+  /**
+   * Subscription of the authenticated user
+   * GET /v1/restricted/billing/subscription
+   */
+  async billingSubscription(): Promise<Subscription> {
+    const response = await this.request('GET', '/v1/restricted/billing/subscription', undefined, undefined);
+    return (await response.json()).data as Subscription;
+  }
+
+  /**
+   * Check a coupon for a plan
+   * POST /v1/restricted/billing/coupons/validate
+   */
+  async billingValidateCoupon(body: BillingValidateCouponBody): Promise<CouponQuote> {
+    const response = await this.request('POST', '/v1/restricted/billing/coupons/validate', undefined, body);
+    return (await response.json()).data as CouponQuote;
+  }
+
+  /**
+   * Events of the payment provider
+   * POST /v1/billing/webhook
+   */
+  async billingWebhook(): Promise<void> {
+    await this.request('POST', '/v1/billing/webhook', undefined, undefined);
+  }
+
+  /**
+   * Delete users in bulk
+   * DELETE /v1/restricted/users/bulk
+   */
+  async bulkDeleteUsers(body: BulkDeleteUsersBody): Promise<Bulk> {
+    const response = await this.request('DELETE', '/v1/restricted/users/bulk', undefined, body);
+    return (await response.json()).data as Bulk;
+  }
+
+  /**
+   * Replay dead letters in bulk
+   * POST /v1/restricted/admin/dead-letters/replay
+   */
+  async bulkReplayDeadLetters(body: BulkReplayDeadLettersBody): Promise<BulkReplayDeadLettersResult> {
+    const response = await this.request('POST', '/v1/restricted/admin/dead-letters/replay', undefined, body);
+    return (await response.json()).data as BulkReplayDeadLettersResult;
+  }
+
+  /**
+   * Update users in bulk
+   * PATCH /v1/restricted/users/bulk
+   */
+  async bulkUpdateUsers(body: BulkUpdateUsersBody): Promise<BulkUpdateUsersResult> {
+    const response = await this.request('PATCH', '/v1/restricted/users/bulk', undefined, body);
+    return (await response.json()).data as BulkUpdateUsersResult;
+  }
+
+  /**
+   * Request a change of the email of the authenticated user
+   * POST /v1/restricted/users/me/email
+   */
+  async changeEmail(body: ChangeEmailBody): Promise<EmailChange> {
+    const response = await this.request('POST', '/v1/restricted/users/me/email', undefined, body);
+    return (await response.json()).data as EmailChange;
+  }
+
+  /**
+   * Change the password of the authenticated user
+   * PUT /v1/restricted/users/me/password
+   */
+  async changePassword(body: ChangePasswordBody): Promise<User> {
+    const response = await this.request('PUT', '/v1/restricted/users/me/password', undefined, body);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Client credentials grant
+   * POST /v1/oauth/token
+   */
+  async clientToken(body: ClientTokenBody): Promise<ClientToken> {
+    const response = await this.request('POST', '/v1/oauth/token', undefined, body);
+    return (await response.json()) as ClientToken;
+  }
+
+  /**
+   * Confirm a change of email
+   * GET /v1/email-changes/confirm
+   */
+  async confirmEmailChange(params: ConfirmEmailChangeParams = {}): Promise<User> {
+    const response = await this.request('GET', '/v1/email-changes/confirm', params, undefined);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Create a record of a resource
+   * POST /v1/restricted/admin/resources/:resource
+   */
+  async createAdminRecord(resource: string): Promise<unknown> {
+    const response = await this.request('POST', `/v1/restricted/admin/resources/${encodeURIComponent(resource)}`, undefined, undefined);
+    return (await response.json()).data as unknown;
+  }
+
+  /**
+   * Create an announcement
+   * POST /v1/restricted/admin/announcements
+   */
+  async createAnnouncement(body: CreateAnnouncementBody): Promise<Announcement> {
+    const response = await this.request('POST', '/v1/restricted/admin/announcements', undefined, body);
+    return (await response.json()).data as Announcement;
+  }
+
+  /**
+   * Create an api key
+   * POST /v1/restricted/users/me/api-keys
+   */
+  async createApiKey(body: CreateApiKeyBody): Promise<CreateApiKeyResult> {
+    const response = await this.request('POST', '/v1/restricted/users/me/api-keys', undefined, body);
+    return (await response.json()).data as CreateApiKeyResult;
+  }
+
+  /**
+   * Register a client
+   * POST /v1/restricted/admin/clients
+   */
+  async createClient(body: CreateClientBody): Promise<CreateClientResult> {
+    const response = await this.request('POST', '/v1/restricted/admin/clients', undefined, body);
+    return (await response.json()).data as CreateClientResult;
+  }
+
+  /**
+   * Comment a commentable
+   * POST /v1/restricted/threads/:type/:id/comments
+   */
+  async createComment(type: string, id: string, body: CreateCommentBody): Promise<Comment> {
+    const response = await this.request('POST', `/v1/restricted/threads/${encodeURIComponent(type)}/${encodeURIComponent(id)}/comments`, undefined, body);
+    return (await response.json()).data as Comment;
+  }
+
+  /**
+   * Create a coupon
+   * POST /v1/restricted/admin/coupons
+   */
+  async createCoupon(body: CreateCouponBody): Promise<Coupon> {
+    const response = await this.request('POST', '/v1/restricted/admin/coupons', undefined, body);
+    return (await response.json()).data as Coupon;
+  }
+
+  /**
+   * Create an invitation
+   * POST /v1/restricted/invitations
+   */
+  async createInvitation(body: CreateInvitationBody): Promise<Invitation> {
+    const response = await this.request('POST', '/v1/restricted/invitations', undefined, body);
+    return (await response.json()).data as Invitation;
+  }
+
+  /**
+   * Report a user or a content
+   * POST /v1/restricted/reports/:type/:id
+   */
+  async createReport(type: string, id: string, body: CreateReportBody): Promise<Report> {
+    const response = await this.request('POST', `/v1/restricted/reports/${encodeURIComponent(type)}/${encodeURIComponent(id)}`, undefined, body);
+    return (await response.json()).data as Report;
+  }
+
+  /**
+   * Current version of every policy
+   * GET /v1/policies/current
+   */
+  async currentPolicies(): Promise<Policy[]> {
+    const response = await this.request('GET', '/v1/policies/current', undefined, undefined);
+    return (await response.json()).data as Policy[];
+  }
+
+  /**
+   * Deactivate a coupon
+   * DELETE /v1/restricted/admin/coupons/:coupon
+   */
+  async deactivateCoupon(coupon: string): Promise<Coupon> {
+    const response = await this.request('DELETE', `/v1/restricted/admin/coupons/${encodeURIComponent(coupon)}`, undefined, undefined);
+    return (await response.json()).data as Coupon;
+  }
+
+  /**
+   * Schedule the deletion of the authenticated user
+   * DELETE /v1/restricted/users/me
+   */
+  async deleteAccount(): Promise<User> {
+    const response = await this.request('DELETE', '/v1/restricted/users/me', undefined, undefined);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Delete a record of a resource
+   * DELETE /v1/restricted/admin/resources/:resource/:id
+   */
+  async deleteAdminRecord(resource: string, id: string): Promise<void> {
+    await this.request('DELETE', `/v1/restricted/admin/resources/${encodeURIComponent(resource)}/${encodeURIComponent(id)}`, undefined, undefined);
+  }
+
+  /**
+   * Delete an announcement
+   * DELETE /v1/restricted/admin/announcements/:announcement
+   */
+  async deleteAnnouncement(announcement: string): Promise<void> {
+    await this.request('DELETE', `/v1/restricted/admin/announcements/${encodeURIComponent(announcement)}`, undefined, undefined);
+  }
+
+  /**
+   * Delete a comment
+   * DELETE /v1/restricted/comments/:comment
+   */
+  async deleteComment(comment: string): Promise<void> {
+    await this.request('DELETE', `/v1/restricted/comments/${encodeURIComponent(comment)}`, undefined, undefined);
+  }
+
+  /**
+   * Delete a dead letter
+   * DELETE /v1/restricted/admin/dead-letters/:dead_letter
+   */
+  async deleteDeadLetter(deadLetter: string): Promise<void> {
+    await this.request('DELETE', `/v1/restricted/admin/dead-letters/${encodeURIComponent(deadLetter)}`, undefined, undefined);
+  }
+
+  /**
+   * Delete a media
+   * DELETE /v1/restricted/media/:media
+   */
+  async deleteMedia(media: string): Promise<void> {
+    await this.request('DELETE', `/v1/restricted/media/${encodeURIComponent(media)}`, undefined, undefined);
+  }
+
+  /**
+   * Remove the phone of the authenticated user
+   * DELETE /v1/restricted/users/me/phone
+   */
+  async deletePhone(): Promise<User> {
+    const response = await this.request('DELETE', '/v1/restricted/users/me/phone', undefined, undefined);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Detach a media
+   * DELETE /v1/restricted/media/:media/attachment
+   */
+  async detachMedia(media: string): Promise<Media> {
+    const response = await this.request('DELETE', `/v1/restricted/media/${encodeURIComponent(media)}/attachment`, undefined, undefined);
+    return (await response.json()).data as Media;
+  }
+
+  /**
+   * Detach tags
+   * DELETE /v1/restricted/tags/:type/:id
+   */
+  async detachTags(type: string, id: string, body: DetachTagsBody): Promise<Tag[]> {
+    const response = await this.request('DELETE', `/v1/restricted/tags/${encodeURIComponent(type)}/${encodeURIComponent(id)}`, undefined, body);
+    return (await response.json()).data as Tag[];
+  }
+
+  /**
+   * Changes of a user since a version
+   * GET /v1/restricted/admin/users/:user/versions/:version/diff
+   */
+  async diffUserVersion(user: string, version: string, params: DiffUserVersionParams = {}): Promise<UserChange[]> {
+    const response = await this.request('GET', `/v1/restricted/admin/users/${encodeURIComponent(user)}/versions/${encodeURIComponent(version)}/diff`, params, undefined);
+    return (await response.json()).data as UserChange[];
+  }
+
+  /**
+   * Dislike a votable
+   * PUT /v1/restricted/votes/:type/:id/dislike
+   */
+  async dislike(type: string, id: string): Promise<VoteSummary> {
+    const response = await this.request('PUT', `/v1/restricted/votes/${encodeURIComponent(type)}/${encodeURIComponent(id)}/dislike`, undefined, undefined);
+    return (await response.json()).data as VoteSummary;
+  }
+
+  /**
+   * Dismiss a report
+   * POST /v1/restricted/admin/reports/:report/dismiss
+   */
+  async dismissReport(report: string, body: DismissReportBody): Promise<Report> {
+    const response = await this.request('POST', `/v1/restricted/admin/reports/${encodeURIComponent(report)}/dismiss`, undefined, body);
+    return (await response.json()).data as Report;
+  }
+
+  /**
+   * Download a data export archive
+   * GET /v1/restricted/users/me/data-exports/:export/download
+   */
+  async downloadDataExport(export: string): Promise<ArrayBuffer> {
+    const response = await this.request('GET', `/v1/restricted/users/me/data-exports/${encodeURIComponent(export)}/download`, undefined, undefined);
+    return response.arrayBuffer();
+  }
+
+  /**
+   * Download a media
+   * GET /v1/restricted/media/:media/download
+   */
+  async downloadMedia(media: string): Promise<unknown> {
+    const response = await this.request('GET', `/v1/restricted/media/${encodeURIComponent(media)}/download`, undefined, undefined);
+    return (await response.json()) as unknown;
+  }
+
+  /**
+   * Catalog of every error code
+   * GET /errors
+   */
+  async errors(): Promise<Code[]> {
+    const response = await this.request('GET', '/errors', undefined, undefined);
+    return (await response.json()).data as Code[];
+  }
+
+  /**
+   * Flag a comment
+   * POST /v1/restricted/comments/:comment/flag
+   */
+  async flagComment(comment: string, body: FlagCommentBody): Promise<unknown> {
+    const response = await this.request('POST', `/v1/restricted/comments/${encodeURIComponent(comment)}/flag`, undefined, body);
+    return (await response.json()).data as unknown;
+  }
+
+  /**
+   * Follow a user
+   * POST /v1/restricted/users/:user/follow
+   */
+  async follow(user: string): Promise<Relationship> {
+    const response = await this.request('POST', `/v1/restricted/users/${encodeURIComponent(user)}/follow`, undefined, undefined);
+    return (await response.json()).data as Relationship;
+  }
+
+  /**
+   * Followers of a user
+   * GET /v1/restricted/users/:user/followers
+   */
+  async followers(user: string, params: FollowersParams = {}): Promise<FollowersResult> {
+    const response = await this.request('GET', `/v1/restricted/users/${encodeURIComponent(user)}/followers`, params, undefined);
+    return (await response.json()).data as FollowersResult;
+  }
+
+  /** followersAll iterates over every User of followers, the next page is fetched once the current one is exhausted */
+  async *followersAll(user: string, params: FollowersParams = {}): AsyncGenerator<User> {
+    let page = params.page && params.page > 0 ? params.page : 1;
+    for (;;) {
+      const result = await this.followers(user, { ...params, page });
+      const records = result.records ?? [];
+      yield* records;
+      if (records.length === 0 || (result.page ?? page) * (result.limit ?? records.length) >= (result.total_record ?? 0)) {
+        return;
+      }
+      page++;
+    }
+  }
+
+  /**
+   * Users followed by a user
+   * GET /v1/restricted/users/:user/following
+   */
+  async following(user: string, params: FollowingParams = {}): Promise<FollowingResult> {
+    const response = await this.request('GET', `/v1/restricted/users/${encodeURIComponent(user)}/following`, params, undefined);
+    return (await response.json()).data as FollowingResult;
+  }
+
+  /** followingAll iterates over every User of following, the next page is fetched once the current one is exhausted */
+  async *followingAll(user: string, params: FollowingParams = {}): AsyncGenerator<User> {
+    let page = params.page && params.page > 0 ? params.page : 1;
+    for (;;) {
+      const result = await this.following(user, { ...params, page });
+      const records = result.records ?? [];
+      yield* records;
+      if (records.length === 0 || (result.page ?? page) * (result.limit ?? records.length) >= (result.total_record ?? 0)) {
+        return;
+      }
+      page++;
+    }
+  }
+
+  /**
+   * Impersonate a user
+   * POST /v1/restricted/admin/users/:user/impersonate
+   */
+  async impersonateUser(user: string): Promise<Impersonation> {
+    const response = await this.request('POST', `/v1/restricted/admin/users/${encodeURIComponent(user)}/impersonate`, undefined, undefined);
+    return (await response.json()).data as Impersonation;
+  }
+
+  /**
+   * Get User for an internal service
+   * GET /v1/internal/users/:user
+   */
+  async internalShowUser(user: string): Promise<User> {
+    const response = await this.request('GET', `/v1/internal/users/${encodeURIComponent(user)}`, undefined, undefined);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Like a votable
+   * PUT /v1/restricted/votes/:type/:id/like
+   */
+  async like(type: string, id: string): Promise<VoteSummary> {
+    const response = await this.request('PUT', `/v1/restricted/votes/${encodeURIComponent(type)}/${encodeURIComponent(id)}/like`, undefined, undefined);
+    return (await response.json()).data as VoteSummary;
+  }
+
+  /**
+   * List the records of a resource
+   * GET /v1/restricted/admin/resources/:resource
+   */
+  async listAdminRecords(resource: string, params: ListAdminRecordsParams = {}): Promise<Paginator> {
+    const response = await this.request('GET', `/v1/restricted/admin/resources/${encodeURIComponent(resource)}`, params, undefined);
+    return (await response.json()).data as Paginator;
+  }
+
+  /**
+   * Every announcement
+   * GET /v1/restricted/admin/announcements
+   */
+  async listAllAnnouncements(params: ListAllAnnouncementsParams = {}): Promise<ListAllAnnouncementsResult> {
+    const response = await this.request('GET', '/v1/restricted/admin/announcements', params, undefined);
+    return (await response.json()).data as ListAllAnnouncementsResult;
+  }
+
+  /** listAllAnnouncementsAll iterates over every Announcement of listAllAnnouncements, the next page is fetched once the current one is exhausted */
+  async *listAllAnnouncementsAll(params: ListAllAnnouncementsParams = {}): AsyncGenerator<Announcement> {
+    let page = params.page && params.page > 0 ? params.page : 1;
+    for (;;) {
+      const result = await this.listAllAnnouncements({ ...params, page });
+      const records = result.records ?? [];
+      yield* records;
+      if (records.length === 0 || (result.page ?? page) * (result.limit ?? records.length) >= (result.total_record ?? 0)) {
+        return;
+      }
+      page++;
+    }
+  }
+
+  /**
+   * Announcements of the user
+   * GET /v1/restricted/announcements
+   */
+  async listAnnouncements(params: ListAnnouncementsParams = {}): Promise<ListAnnouncementsResult> {
+    const response = await this.request('GET', '/v1/restricted/announcements', params, undefined);
+    return (await response.json()).data as ListAnnouncementsResult;
+  }
+
+  /** listAnnouncementsAll iterates over every Announcement of listAnnouncements, the next page is fetched once the current one is exhausted */
+  async *listAnnouncementsAll(params: ListAnnouncementsParams = {}): AsyncGenerator<Announcement> {
+    let page = params.page && params.page > 0 ? params.page : 1;
+    for (;;) {
+      const result = await this.listAnnouncements({ ...params, page });
+      const records = result.records ?? [];
+      yield* records;
+      if (records.length === 0 || (result.page ?? page) * (result.limit ?? records.length) >= (result.total_record ?? 0)) {
+        return;
+      }
+      page++;
+    }
+  }
+
+  /**
+   * Api keys of the user
+   * GET /v1/restricted/users/me/api-keys
+   */
+  async listApiKeys(): Promise<ApiKey[]> {
+    const response = await this.request('GET', '/v1/restricted/users/me/api-keys', undefined, undefined);
+    return (await response.json()).data as ApiKey[];
+  }
+
+  /**
+   * Clients
+   * GET /v1/restricted/admin/clients
+   */
+  async listClients(): Promise<ModelsClient[]> {
+    const response = await this.request('GET', '/v1/restricted/admin/clients', undefined, undefined);
+    return (await response.json()).data as ModelsClient[];
+  }
+
+  /**
+   * Comments of a commentable
+   * GET /v1/restricted/threads/:type/:id/comments
+   */
+  async listComments(type: string, id: string): Promise<ListCommentsResult> {
+    const response = await this.request('GET', `/v1/restricted/threads/${encodeURIComponent(type)}/${encodeURIComponent(id)}/comments`, undefined, undefined);
+    return (await response.json()).data as ListCommentsResult;
+  }
+
+  /**
+   * Conversations of the user
+   * GET /v1/restricted/conversations
+   */
+  async listConversations(params: ListConversationsParams = {}): Promise<ListConversationsResult> {
+    const response = await this.request('GET', '/v1/restricted/conversations', params, undefined);
+    return (await response.json()).data as ListConversationsResult;
+  }
+
+  /** listConversationsAll iterates over every Conversation of listConversations, the next page is fetched once the current one is exhausted */
+  async *listConversationsAll(params: ListConversationsParams = {}): AsyncGenerator<Conversation> {
+    let page = params.page && params.page > 0 ? params.page : 1;
+    for (;;) {
+      const result = await this.listConversations({ ...params, page });
+      const records = result.records ?? [];
+      yield* records;
+      if (records.length === 0 || (result.page ?? page) * (result.limit ?? records.length) >= (result.total_record ?? 0)) {
+        return;
+      }
+      page++;
+    }
+  }
+
+  /**
+   * List of coupons
+   * GET /v1/restricted/admin/coupons
+   */
+  async listCoupons(): Promise<Coupon[]> {
+    const response = await this.request('GET', '/v1/restricted/admin/coupons', undefined, undefined);
+    return (await response.json()).data as Coupon[];
+  }
+
+  /**
+   * Dead letters
+   * GET /v1/restricted/admin/dead-letters
+   */
+  async listDeadLetters(params: ListDeadLettersParams = {}): Promise<ListDeadLettersResult> {
+    const response = await this.request('GET', '/v1/restricted/admin/dead-letters', params, undefined);
+    return (await response.json()).data as ListDeadLettersResult;
+  }
+
+  /**
+   * Deprecated routes
+   * GET /v1/restricted/admin/deprecations
+   */
+  async listDeprecations(params: ListDeprecationsParams = {}): Promise<DeprecationReport[]> {
+    const response = await this.request('GET', '/v1/restricted/admin/deprecations', params, undefined);
+    return (await response.json()).data as DeprecationReport[];
+  }
+
+  /**
+   * Devices of the user
+   * GET /v1/restricted/users/me/devices
+   */
+  async listDevices(): Promise<Device[]> {
+    const response = await this.request('GET', '/v1/restricted/users/me/devices', undefined, undefined);
+    return (await response.json()).data as Device[];
+  }
+
+  /**
+   * Moderation queue of comments
+   * GET /v1/restricted/admin/comments
+   */
+  async listFlaggedComments(params: ListFlaggedCommentsParams = {}): Promise<ListFlaggedCommentsResult> {
+    const response = await this.request('GET', '/v1/restricted/admin/comments', params, undefined);
+    return (await response.json()).data as ListFlaggedCommentsResult;
+  }
+
+  /**
+   * List of invitations
+   * GET /v1/restricted/invitations
+   */
+  async listInvitations(): Promise<Invitation[]> {
+    const response = await this.request('GET', '/v1/restricted/invitations', undefined, undefined);
+    return (await response.json()).data as Invitation[];
+  }
+
+  /**
+   * Media of the user
+   * GET /v1/restricted/media
+   */
+  async listMedia(params: ListMediaParams = {}): Promise<ListMediaResult> {
+    const response = await this.request('GET', '/v1/restricted/media', params, undefined);
+    return (await response.json()).data as ListMediaResult;
+  }
+
+  /** listMediaAll iterates over every Media of listMedia, the next page is fetched once the current one is exhausted */
+  async *listMediaAll(params: ListMediaParams = {}): AsyncGenerator<Media> {
+    let page = params.page && params.page > 0 ? params.page : 1;
+    for (;;) {
+      const result = await this.listMedia({ ...params, page });
+      const records = result.records ?? [];
+      yield* records;
+      if (records.length === 0 || (result.page ?? page) * (result.limit ?? records.length) >= (result.total_record ?? 0)) {
+        return;
+      }
+      page++;
+    }
+  }
+
+  /**
+   * Messages of a conversation
+   * GET /v1/restricted/conversations/:conversation/messages
+   */
+  async listMessages(conversation: string, params: ListMessagesParams = {}): Promise<ListMessagesResult> {
+    const response = await this.request('GET', `/v1/restricted/conversations/${encodeURIComponent(conversation)}/messages`, params, undefined);
+    return (await response.json()).data as ListMessagesResult;
+  }
+
+  /**
+   * Moderation queue of reports
+   * GET /v1/restricted/admin/reports
+   */
+  async listReports(params: ListReportsParams = {}): Promise<ListReportsResult> {
+    const response = await this.request('GET', '/v1/restricted/admin/reports', params, undefined);
+    return (await response.json()).data as ListReportsResult;
+  }
+
+  /**
+   * List of settings
+   * GET /v1/restricted/settings
+   */
+  async listSettings(): Promise<Setting[]> {
+    const response = await this.request('GET', '/v1/restricted/settings', undefined, undefined);
+    return (await response.json()).data as Setting[];
+  }
+
+  /**
+   * Service level objectives
+   * GET /v1/restricted/admin/slo
+   */
+  async listSlos(): Promise<SloReport[]> {
+    const response = await this.request('GET', '/v1/restricted/admin/slo', undefined, undefined);
+    return (await response.json()).data as SloReport[];
+  }
+
+  /**
+   * History of a user
+   * GET /v1/restricted/admin/users/:user/versions
+   */
+  async listUserVersions(user: string): Promise<ListUserVersionsResult> {
+    const response = await this.request('GET', `/v1/restricted/admin/users/${encodeURIComponent(user)}/versions`, undefined, undefined);
+    return (await response.json()).data as ListUserVersionsResult;
+  }
+
+  /**
+   * List of users
+   * GET /v1/restricted/users
+   */
+  async listUsers(params: ListUsersParams = {}): Promise<ListUsersResult> {
+    const response = await this.request('GET', '/v1/restricted/users', params, undefined);
+    return (await response.json()).data as ListUsersResult;
+  }
+
+  /** listUsersAll iterates over every User of listUsers, the next page is fetched once the current one is exhausted */
+  async *listUsersAll(params: ListUsersParams = {}): AsyncGenerator<User> {
+    let page = params.page && params.page > 0 ? params.page : 1;
+    for (;;) {
+      const result = await this.listUsers({ ...params, page });
+      const records = result.records ?? [];
+      yield* records;
+      if (records.length === 0 || (result.page ?? page) * (result.limit ?? records.length) >= (result.total_record ?? 0)) {
+        return;
+      }
+      page++;
+    }
+  }
+
+  /**
+   * Levels of the logger
+   * GET /v1/restricted/admin/logging/level
+   */
+  async loggingLevel(): Promise<LogLevels> {
+    const response = await this.request('GET', '/v1/restricted/admin/logging/level', undefined, undefined);
+    return (await response.json()).data as LogLevels;
+  }
+
+  /**
+   * POST /v1/login
+   */
+  async login(body: LoginBody): Promise<Login> {
+    const response = await this.request('POST', '/v1/login', undefined, body);
+    return (await response.json()).data as Login;
+  }
+
+  /**
+   * End a remember me session
+   * POST /v1/auth/logout
+   */
+  async logout(body: LogoutBody): Promise<unknown> {
+    const response = await this.request('POST', '/v1/auth/logout', undefined, body);
+    return (await response.json()).data as unknown;
+  }
+
+  /**
+   * Mail a sign in link
+   * POST /v1/auth/magic-link
+   */
+  async magicLink(body: MagicLinkBody): Promise<unknown> {
+    const response = await this.request('POST', '/v1/auth/magic-link', undefined, body);
+    return (await response.json()).data as unknown;
+  }
+
+  /**
+   * Sign in with a mailed link
+   * GET /v1/auth/magic-link/callback
+   */
+  async magicLinkCallback(params: MagicLinkCallbackParams = {}): Promise<Login> {
+    const response = await this.request('GET', '/v1/auth/magic-link/callback', params, undefined);
+    return (await response.json()).data as Login;
+  }
+
+  /**
+   * Media usage report
+   * GET /v1/restricted/admin/media/usage
+   */
+  async mediaUsage(params: MediaUsageParams = {}): Promise<MediaUsageReport> {
+    const response = await this.request('GET', '/v1/restricted/admin/media/usage', params, undefined);
+    return (await response.json()).data as MediaUsageReport;
+  }
+
+  /**
+   * Metrics in the prometheus text format
+   * GET /status/metrics
+   */
+  async metrics(): Promise<ArrayBuffer> {
+    const response = await this.request('GET', '/status/metrics', undefined, undefined);
+    return response.arrayBuffer();
+  }
+
+  /**
+   * Patch a user
+   * PATCH /v1/restricted/users/:user
+   */
+  async patchUser(user: string): Promise<User> {
+    const response = await this.request('PATCH', `/v1/restricted/users/${encodeURIComponent(user)}`, undefined, undefined);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * GET /status/ping
+   */
+  async ping(): Promise<Message> {
+    const response = await this.request('GET', '/status/ping', undefined, undefined);
+    return (await response.json()) as Message;
+  }
+
+  /**
+   * Popular tags
+   * GET /v1/restricted/tags/popular
+   */
+  async popularTags(params: PopularTagsParams = {}): Promise<PopularTag[]> {
+    const response = await this.request('GET', '/v1/restricted/tags/popular', params, undefined);
+    return (await response.json()).data as PopularTag[];
+  }
+
+  /**
+   * Publish an announcement
+   * POST /v1/restricted/admin/announcements/:announcement/publish
+   */
+  async publishAnnouncement(announcement: string, body: PublishAnnouncementBody): Promise<Announcement> {
+    const response = await this.request('POST', `/v1/restricted/admin/announcements/${encodeURIComponent(announcement)}/publish`, undefined, body);
+    return (await response.json()).data as Announcement;
+  }
+
+  /**
+   * Purge dead letters
+   * DELETE /v1/restricted/admin/dead-letters
+   */
+  async purgeDeadLetters(params: PurgeDeadLettersParams = {}): Promise<DeadLetterPurge> {
+    const response = await this.request('DELETE', '/v1/restricted/admin/dead-letters', params, undefined);
+    return (await response.json()).data as DeadLetterPurge;
+  }
+
+  /**
+   * Push preferences
+   * GET /v1/restricted/users/me/push-preferences
+   */
+  async pushPreferences(): Promise<PushPreferences> {
+    const response = await this.request('GET', '/v1/restricted/users/me/push-preferences', undefined, undefined);
+    return (await response.json()).data as PushPreferences;
+  }
+
+  /**
+   * Mark an announcement read
+   * POST /v1/restricted/announcements/:announcement/read
+   */
+  async readAnnouncement(announcement: string): Promise<Announcement> {
+    const response = await this.request('POST', `/v1/restricted/announcements/${encodeURIComponent(announcement)}/read`, undefined, undefined);
+    return (await response.json()).data as Announcement;
+  }
+
+  /**
+   * Mark a conversation read
+   * POST /v1/restricted/conversations/:conversation/read
+   */
+  async readConversation(conversation: string, body: ReadConversationBody): Promise<UnreadCounters> {
+    const response = await this.request('POST', `/v1/restricted/conversations/${encodeURIComponent(conversation)}/read`, undefined, body);
+    return (await response.json()).data as UnreadCounters;
+  }
+
+  /**
+   * Exchange a remember me refresh token for a new access token
+   * POST /v1/auth/refresh
+   */
+  async refresh(body: RefreshBody): Promise<Login> {
+    const response = await this.request('POST', '/v1/auth/refresh', undefined, body);
+    return (await response.json()).data as Login;
+  }
+
+  /**
+   * POST /v1/register
+   */
+  async register(body: RegisterBody): Promise<Login> {
+    const response = await this.request('POST', '/v1/register', undefined, body);
+    return (await response.json()).data as Login;
+  }
+
+  /**
+   * Register a device
+   * POST /v1/restricted/users/me/devices
+   */
+  async registerDevice(body: RegisterDeviceBody): Promise<Device> {
+    const response = await this.request('POST', '/v1/restricted/users/me/devices', undefined, body);
+    return (await response.json()).data as Device;
+  }
+
+  /**
+   * Relationship with a user
+   * GET /v1/restricted/users/:user/relationship
+   */
+  async relationship(user: string): Promise<Relationship> {
+    const response = await this.request('GET', `/v1/restricted/users/${encodeURIComponent(user)}/relationship`, undefined, undefined);
+    return (await response.json()).data as Relationship;
+  }
+
+  /**
+   * Remove a comment
+   * POST /v1/restricted/admin/comments/:comment/remove
+   */
+  async removeComment(comment: string): Promise<Comment> {
+    const response = await this.request('POST', `/v1/restricted/admin/comments/${encodeURIComponent(comment)}/remove`, undefined, undefined);
+    return (await response.json()).data as Comment;
+  }
+
+  /**
+   * Replay a dead letter
+   * POST /v1/restricted/admin/dead-letters/:dead_letter/replay
+   */
+  async replayDeadLetter(deadLetter: string): Promise<DeadLetter> {
+    const response = await this.request('POST', `/v1/restricted/admin/dead-letters/${encodeURIComponent(deadLetter)}/replay`, undefined, undefined);
+    return (await response.json()).data as DeadLetter;
+  }
+
+  /**
+   * Export all data of the authenticated user
+   * POST /v1/restricted/users/me/data-export
+   */
+  async requestDataExport(): Promise<DataExport> {
+    const response = await this.request('POST', '/v1/restricted/users/me/data-export', undefined, undefined);
+    return (await response.json()).data as DataExport;
+  }
+
+  /**
+   * Resolve a report
+   * POST /v1/restricted/admin/reports/:report/resolve
+   */
+  async resolveReport(report: string, body: ResolveReportBody): Promise<Report> {
+    const response = await this.request('POST', `/v1/restricted/admin/reports/${encodeURIComponent(report)}/resolve`, undefined, body);
+    return (await response.json()).data as Report;
+  }
+
+  /**
+   * Cancel the scheduled deletion of the authenticated user
+   * POST /v1/restricted/users/me/restore
+   */
+  async restoreAccount(): Promise<User> {
+    const response = await this.request('POST', '/v1/restricted/users/me/restore', undefined, undefined);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Restore a user to a version
+   * POST /v1/restricted/admin/users/:user/versions/:version/restore
+   */
+  async restoreUserVersion(user: string, version: string): Promise<User> {
+    const response = await this.request('POST', `/v1/restricted/admin/users/${encodeURIComponent(user)}/versions/${encodeURIComponent(version)}/restore`, undefined, undefined);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Revoke an api key
+   * DELETE /v1/restricted/users/me/api-keys/:key
+   */
+  async revokeApiKey(key: string): Promise<ApiKey> {
+    const response = await this.request('DELETE', `/v1/restricted/users/me/api-keys/${encodeURIComponent(key)}`, undefined, undefined);
+    return (await response.json()).data as ApiKey;
+  }
+
+  /**
+   * Revoke a client
+   * DELETE /v1/restricted/admin/clients/:client
+   */
+  async revokeClient(client: string): Promise<ModelsClient> {
+    const response = await this.request('DELETE', `/v1/restricted/admin/clients/${encodeURIComponent(client)}`, undefined, undefined);
+    return (await response.json()).data as ModelsClient;
+  }
+
+  /**
+   * Revoke an invitation
+   * DELETE /v1/restricted/invitations/:invitation
+   */
+  async revokeInvitation(invitation: string): Promise<Invitation> {
+    const response = await this.request('DELETE', `/v1/restricted/invitations/${encodeURIComponent(invitation)}`, undefined, undefined);
+    return (await response.json()).data as Invitation;
+  }
+
+  /**
+   * Search the users
+   * GET /v1/restricted/admin/users
+   */
+  async searchUsers(params: SearchUsersParams = {}): Promise<SearchUsersResult> {
+    const response = await this.request('GET', '/v1/restricted/admin/users', params, undefined);
+    return (await response.json()).data as SearchUsersResult;
+  }
+
+  /** searchUsersAll iterates over every UserSearchView of searchUsers, the next page is fetched once the current one is exhausted */
+  async *searchUsersAll(params: SearchUsersParams = {}): AsyncGenerator<UserSearchView> {
+    let page = params.page && params.page > 0 ? params.page : 1;
+    for (;;) {
+      const result = await this.searchUsers({ ...params, page });
+      const records = result.records ?? [];
+      yield* records;
+      if (records.length === 0 || (result.page ?? page) * (result.limit ?? records.length) >= (result.total_record ?? 0)) {
+        return;
+      }
+      page++;
+    }
+  }
+
+  /**
+   * Send a message
+   * POST /v1/restricted/conversations/:conversation/messages
+   */
+  async sendMessage(conversation: string, body: SendMessageBody): Promise<ModelsMessage> {
+    const response = await this.request('POST', `/v1/restricted/conversations/${encodeURIComponent(conversation)}/messages`, undefined, body);
+    return (await response.json()).data as ModelsMessage;
+  }
+
+  /**
+   * Send a new verification code to the phone of the authenticated user
+   * POST /v1/restricted/users/me/phone/code
+   */
+  async sendPhoneCode(): Promise<User> {
+    const response = await this.request('POST', '/v1/restricted/users/me/phone/code', undefined, undefined);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Show a record of a resource
+   * GET /v1/restricted/admin/resources/:resource/:id
+   */
+  async showAdminRecord(resource: string, id: string): Promise<unknown> {
+    const response = await this.request('GET', `/v1/restricted/admin/resources/${encodeURIComponent(resource)}/${encodeURIComponent(id)}`, undefined, undefined);
+    return (await response.json()).data as unknown;
+  }
+
+  /**
+   * Runtime configuration
+   * GET /v1/restricted/admin/config
+   */
+  async showConfig(): Promise<ConfigReport> {
+    const response = await this.request('GET', '/v1/restricted/admin/config', undefined, undefined);
+    return (await response.json()).data as ConfigReport;
+  }
+
+  /**
+   * Get a data export of the authenticated user
+   * GET /v1/restricted/users/me/data-exports/:export
+   */
+  async showDataExport(export: string): Promise<DataExport> {
+    const response = await this.request('GET', `/v1/restricted/users/me/data-exports/${encodeURIComponent(export)}`, undefined, undefined);
+    return (await response.json()).data as DataExport;
+  }
+
+  /**
+   * Get a dead letter
+   * GET /v1/restricted/admin/dead-letters/:dead_letter
+   */
+  async showDeadLetter(deadLetter: string): Promise<DeadLetter> {
+    const response = await this.request('GET', `/v1/restricted/admin/dead-letters/${encodeURIComponent(deadLetter)}`, undefined, undefined);
+    return (await response.json()).data as DeadLetter;
+  }
+
+  /**
+   * Get a media
+   * GET /v1/restricted/media/:media
+   */
+  async showMedia(media: string): Promise<Media> {
+    const response = await this.request('GET', `/v1/restricted/media/${encodeURIComponent(media)}`, undefined, undefined);
+    return (await response.json()).data as Media;
+  }
+
+  /**
+   * Tags of a taggable
+   * GET /v1/restricted/tags/:type/:id
+   */
+  async showTags(type: string, id: string): Promise<Tag[]> {
+    const response = await this.request('GET', `/v1/restricted/tags/${encodeURIComponent(type)}/${encodeURIComponent(id)}`, undefined, undefined);
+    return (await response.json()).data as Tag[];
+  }
+
+  /**
+   * Requests of the authenticated user by month
+   * GET /v1/restricted/users/me/usage
+   */
+  async showUsage(params: ShowUsageParams = {}): Promise<Usage> {
+    const response = await this.request('GET', '/v1/restricted/users/me/usage', params, undefined);
+    return (await response.json()).data as Usage;
+  }
+
+  /**
+   * Get User
+   * GET /v1/restricted/users/:user
+   */
+  async showUser(user: string): Promise<User> {
+    const response = await this.request('GET', `/v1/restricted/users/${encodeURIComponent(user)}`, undefined, undefined);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Votes of a votable
+   * GET /v1/restricted/votes/:type/:id
+   */
+  async showVotes(type: string, id: string): Promise<VoteSummary> {
+    const response = await this.request('GET', `/v1/restricted/votes/${encodeURIComponent(type)}/${encodeURIComponent(id)}`, undefined, undefined);
+    return (await response.json()).data as VoteSummary;
+  }
+
+  /**
+   * Start a conversation
+   * POST /v1/restricted/conversations
+   */
+  async startConversation(body: StartConversationBody): Promise<Conversation> {
+    const response = await this.request('POST', '/v1/restricted/conversations', undefined, body);
+    return (await response.json()).data as Conversation;
+  }
+
+  /**
+   * Confirm the password to allow sensitive operations
+   * POST /v1/restricted/auth/sudo
+   */
+  async sudo(body: SudoBody): Promise<Login> {
+    const response = await this.request('POST', '/v1/restricted/auth/sudo', undefined, body);
+    return (await response.json()).data as Login;
+  }
+
+  /**
+   * Suspend a user
+   * POST /v1/restricted/admin/users/:user/suspension
+   */
+  async suspendUser(user: string, body: SuspendUserBody): Promise<User> {
+    const response = await this.request('POST', `/v1/restricted/admin/users/${encodeURIComponent(user)}/suspension`, undefined, body);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Replace the tags
+   * PUT /v1/restricted/tags/:type/:id
+   */
+  async syncTags(type: string, id: string, body: SyncTagsBody): Promise<Tag[]> {
+    const response = await this.request('PUT', `/v1/restricted/tags/${encodeURIComponent(type)}/${encodeURIComponent(id)}`, undefined, body);
+    return (await response.json()).data as Tag[];
+  }
+
+  /**
+   * Unfollow a user
+   * DELETE /v1/restricted/users/:user/follow
+   */
+  async unfollow(user: string): Promise<Relationship> {
+    const response = await this.request('DELETE', `/v1/restricted/users/${encodeURIComponent(user)}/follow`, undefined, undefined);
+    return (await response.json()).data as Relationship;
+  }
+
+  /**
+   * Unread counters
+   * GET /v1/restricted/conversations/unread
+   */
+  async unreadMessages(): Promise<UnreadCounters> {
+    const response = await this.request('GET', '/v1/restricted/conversations/unread', undefined, undefined);
+    return (await response.json()).data as UnreadCounters;
+  }
+
+  /**
+   * Unregister a device
+   * DELETE /v1/restricted/users/me/devices/:device
+   */
+  async unregisterDevice(device: string): Promise<void> {
+    await this.request('DELETE', `/v1/restricted/users/me/devices/${encodeURIComponent(device)}`, undefined, undefined);
+  }
+
+  /**
+   * Unsuspend a user
+   * DELETE /v1/restricted/admin/users/:user/suspension
+   */
+  async unsuspendUser(user: string): Promise<User> {
+    const response = await this.request('DELETE', `/v1/restricted/admin/users/${encodeURIComponent(user)}/suspension`, undefined, undefined);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Remove the vote on a votable
+   * DELETE /v1/restricted/votes/:type/:id
+   */
+  async unvote(type: string, id: string): Promise<VoteSummary> {
+    const response = await this.request('DELETE', `/v1/restricted/votes/${encodeURIComponent(type)}/${encodeURIComponent(id)}`, undefined, undefined);
+    return (await response.json()).data as VoteSummary;
+  }
+
+  /**
+   * Update a record of a resource
+   * PUT /v1/restricted/admin/resources/:resource/:id
+   */
+  async updateAdminRecord(resource: string, id: string): Promise<unknown> {
+    const response = await this.request('PUT', `/v1/restricted/admin/resources/${encodeURIComponent(resource)}/${encodeURIComponent(id)}`, undefined, undefined);
+    return (await response.json()).data as unknown;
+  }
+
+  /**
+   * Edit a comment
+   * PUT /v1/restricted/comments/:comment
+   */
+  async updateComment(comment: string, body: UpdateCommentBody): Promise<Comment> {
+    const response = await this.request('PUT', `/v1/restricted/comments/${encodeURIComponent(comment)}`, undefined, body);
+    return (await response.json()).data as Comment;
+  }
+
+  /**
+   * Update the limits of a coupon
+   * PUT /v1/restricted/admin/coupons/:coupon
+   */
+  async updateCoupon(coupon: string, body: UpdateCouponBody): Promise<Coupon> {
+    const response = await this.request('PUT', `/v1/restricted/admin/coupons/${encodeURIComponent(coupon)}`, undefined, body);
+    return (await response.json()).data as Coupon;
+  }
+
+  /**
+   * Change the levels of the logger
+   * PUT /v1/restricted/admin/logging/level
+   */
+  async updateLoggingLevel(body: UpdateLoggingLevelBody): Promise<LogLevels> {
+    const response = await this.request('PUT', '/v1/restricted/admin/logging/level', undefined, body);
+    return (await response.json()).data as LogLevels;
+  }
+
+  /**
+   * Set the phone number of the authenticated user
+   * PUT /v1/restricted/users/me/phone
+   */
+  async updatePhone(body: UpdatePhoneBody): Promise<User> {
+    const response = await this.request('PUT', '/v1/restricted/users/me/phone', undefined, body);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Update the preferences of the authenticated user
+   * PUT /v1/restricted/users/me/preferences
+   */
+  async updatePreferences(body: UpdatePreferencesBody): Promise<User> {
+    const response = await this.request('PUT', '/v1/restricted/users/me/preferences', undefined, body);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Update the push preferences
+   * PUT /v1/restricted/users/me/push-preferences
+   */
+  async updatePushPreferences(body: PushPreferences): Promise<PushPreferences> {
+    const response = await this.request('PUT', '/v1/restricted/users/me/push-preferences', undefined, body);
+    return (await response.json()).data as PushPreferences;
+  }
+
+  /**
+   * Update a setting
+   * PUT /v1/restricted/settings/:setting
+   */
+  async updateSetting(setting: string, body: UpdateSettingBody): Promise<Setting> {
+    const response = await this.request('PUT', `/v1/restricted/settings/${encodeURIComponent(setting)}`, undefined, body);
+    return (await response.json()).data as Setting;
+  }
+
+  /**
+   * Upload a file
+   * POST /v1/restricted/media
+   */
+  async uploadMedia(body: UploadMediaBody): Promise<Media> {
+    const response = await this.request('POST', '/v1/restricted/media', undefined, body);
+    return (await response.json()).data as Media;
+  }
+
+  /**
+   * Verify the email of a new user
+   * GET /v1/verifications/confirm
+   */
+  async verifyEmail(params: VerifyEmailParams = {}): Promise<User> {
+    const response = await this.request('GET', '/v1/verifications/confirm', params, undefined);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * Verify the phone of the authenticated user
+   * POST /v1/restricted/users/me/phone/verify
+   */
+  async verifyPhone(body: VerifyPhoneBody): Promise<User> {
+    const response = await this.request('POST', '/v1/restricted/users/me/phone/verify', undefined, body);
+    return (await response.json()).data as User;
+  }
+
+  /**
+   * GET /status/version
+   */
+  async version(): Promise<Message> {
+    const response = await this.request('GET', '/status/version', undefined, undefined);
+    return (await response.json()) as Message;
+  }
+
+  /** authenticate calls refresh and keeps the issued access token for the following calls */
+  async authenticate(body: RefreshBody): Promise<Login> {
+    const result = await this.refresh(body);
+    this.setToken(result.access_token);
+    return result;
+  }
+}
@@ -0,0 +1,232 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"gorm.io/gorm"
+
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/utils"
+)
+
+var (
+	ErrAdminRecordNotFound   = problems.Define(problems.AdminRecordNotFound, "record could not be found")
+	ErrAdminRecordInUse      = problems.Define(problems.AdminRecordInUse, "the record is in use and can not be deleted")
+	ErrAdminResourceNotFound = problems.Define(problems.AdminResourceNotFound, "resource could not be found")
+)
+
+// AdminResources are the administered models by their resource, an alias so the generated container names it as dingo does
+type AdminResources = map[string]IAdminService
+
+// IAdminService administers the records of a model through the standard admin endpoints
+type IAdminService interface {
+	// Resource is the name of the records in the urls and the audit logs, like "plans"
+	Resource() string
+	// Filters are the fields a list is filtered on
+	Filters() []string
+
+	// List returns a page of the records whose fields equal the filters, the latest first
+	List(filters map[string]string, pagination utils.IPagination) (records interface{}, totalCount int64, err error)
+	Show(ID uint) (interface{}, error)
+	// Create and Update set the fields of the body, a field that can not be set is a validation error
+	Create(admin models.User, fields map[string]interface{}) (interface{}, error)
+	Update(admin models.User, ID uint, fields map[string]interface{}) (interface{}, error)
+	Delete(admin models.User, ID uint) error
+}
+
+// AdminService is the IAdminService of the model T, the fields are named by their json names which are their columns
+type AdminService[T any] struct {
+	Name           string
+	Repository     repositories.IBaseRepository[T]
+	SettingService ISettingService
+	Logger         infrastructures.ILogger
+	// FilterFields are the fields a list is filtered on, by the query parameter equal to them
+	FilterFields []string
+	// Fields are the fields admins create and update
+	Fields []string
+	// Validate checks a record before it is created or updated, validation.Errors are returned to the admin
+	Validate func(record T) error
+	// Deletable tells if the record can be deleted, ErrAdminRecordInUse when it can not
+	Deletable func(record T) error
+}
+
+func (service *AdminService[T]) Resource() string {
+	return service.Name
+}
+
+func (service *AdminService[T]) Filters() []string {
+	return service.FilterFields
+}
+
+func (service *AdminService[T]) List(filters map[string]string, pagination utils.IPagination) (interface{}, int64, error) {
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && pagination.GetLimit() > max {
+		pagination.Get().Limit = max
+	}
+	conditions := map[string]interface{}{}
+	for _, field := range service.FilterFields {
+		value, ok := filters[field]
+		if !ok {
+			continue
+		}
+		converted, err := filterValue(new(T), field, value)
+		if err != nil {
+			return nil, 0, validation.Errors{field: err}
+		}
+		conditions[field] = converted
+	}
+	return service.Repository.List(&scopes.GormPagination{Pagination: pagination.Get()}, func(db *gorm.DB) *gorm.DB {
+		return db.Where(conditions).Order("id desc")
+	})
+}
+
+func (service *AdminService[T]) Show(ID uint) (interface{}, error) {
+	return service.get(ID)
+}
+
+func (service *AdminService[T]) Create(admin models.User, fields map[string]interface{}) (interface{}, error) {
+	var record T
+	if err := service.fill(&record, fields); err != nil {
+		return record, err
+	}
+	if err := service.Repository.Create(&record); err != nil {
+		return record, err
+	}
+	ID := recordID(record)
+	service.Logger.Info(service.Name+" created", infrastructures.Fields{"audit": true, "admin_id": admin.ID, "resource": service.Name, "record_id": ID, "fields": fieldNames(fields)})
+	return service.get(ID)
+}
+
+func (service *AdminService[T]) Update(admin models.User, ID uint, fields map[string]interface{}) (interface{}, error) {
+	record, err := service.get(ID)
+	if err != nil {
+		return record, err
+	}
+	if len(fields) == 0 {
+		return record, nil
+	}
+	if err = service.fill(&record, fields); err != nil {
+		return record, err
+	}
+	if err = service.Repository.UpdateFields(&record, fieldNames(fields)...); err != nil {
+		return record, err
+	}
+	service.Logger.Info(service.Name+" updated", infrastructures.Fields{"audit": true, "admin_id": admin.ID, "resource": service.Name, "record_id": ID, "fields": fieldNames(fields)})
+	return service.get(ID)
+}
+
+func (service *AdminService[T]) Delete(admin models.User, ID uint) error {
+	record, err := service.get(ID)
+	if err != nil {
+		return err
+	}
+	if service.Deletable != nil {
+		if err = service.Deletable(record); err != nil {
+			return err
+		}
+	}
+	if err = service.Repository.Delete(&record); err != nil {
+		return err
+	}
+	service.Logger.Info(service.Name+" deleted", infrastructures.Fields{"audit": true, "admin_id": admin.ID, "resource": service.Name, "record_id": ID})
+	return nil
+}
+
+// fill sets the fields on the record through its json decoding and validates it
+func (service *AdminService[T]) fill(record *T, fields map[string]interface{}) error {
+	invalid := validation.Errors{}
+	for field := range fields {
+		if !helpers.InArray(field, service.Fields) {
+			invalid[field] = errors.New("can not be set")
+		}
+	}
+	if len(invalid) > 0 {
+		return invalid
+	}
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(body, record); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return validation.Errors{typeErr.Field: fmt.Errorf("must be a %v", typeErr.Type.Kind())}
+		}
+		return validation.Errors{"body": errors.New("is not valid")}
+	}
+	if service.Validate != nil {
+		return service.Validate(*record)
+	}
+	return nil
+}
+
+func (service *AdminService[T]) get(ID uint) (record T, err error) {
+	record, err = service.Repository.FindByID(ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return record, ErrAdminRecordNotFound
+	}
+	return record, err
+}
+
+// filterValue converts the query value to the type of the field of the json name, so booleans and numbers compare
+// on every database
+func filterValue(record interface{}, field string, value string) (interface{}, error) {
+	for _, structField := range reflect.VisibleFields(reflect.TypeOf(record).Elem()) {
+		if strings.Split(structField.Tag.Get("json"), ",")[0] != field {
+			continue
+		}
+		kind := structField.Type.Kind()
+		if kind == reflect.Ptr {
+			kind = structField.Type.Elem().Kind()
+		}
+		switch kind {
+		case reflect.Bool:
+			converted, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, errors.New("must be a boolean")
+			}
+			return converted, nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			converted, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, errors.New("must be an integer")
+			}
+			return converted, nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			converted, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, errors.New("must be a positive integer")
+			}
+			return converted, nil
+		}
+		return value, nil
+	}
+	return value, nil
+}
+
+// recordID is the ID field of a model
+func recordID(record interface{}) uint {
+	value := reflect.Indirect(reflect.ValueOf(record)).FieldByName("ID")
+	if value.IsValid() && value.CanUint() {
+		return uint(value.Uint())
+	}
+	return 0
+}
+
+func fieldNames(fields map[string]interface{}) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	return names
+}
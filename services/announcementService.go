@@ -0,0 +1,310 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/utils"
+)
+
+const (
+	// written to the sockets of the audience with the announcement
+	EventAnnouncementPublished = "announcement.published"
+	// published on the event bus with the models.Announcement
+	EventAnnouncementDeleted = "announcement.deleted"
+	// users of the audience delivered per batch
+	announcementBatchSize = 500
+)
+
+var (
+	ErrAnnouncementNotFound     = problems.Define(problems.AnnouncementNotFound, "announcement could not be found")
+	ErrAnnouncementPlanNotFound = problems.Define(problems.AnnouncementPlanNotFound, "the plan of the audience could not be found")
+	ErrAnnouncementPublished    = problems.Define(problems.AnnouncementPublished, "announcement is already published")
+)
+
+type IAnnouncementService interface {
+	// Create saves a draft or schedules the announcement at the publish time, it is published right away when the time
+	// is nil or past
+	Create(admin models.User, announcement models.Announcement) (models.Announcement, error)
+	// Publish schedules a draft or a scheduled announcement at the publish time, right away when it is nil or past
+	Publish(admin models.User, announcementID uint, publishAt *time.Time) (models.Announcement, error)
+	// Announcements returns a page of every announcement with the number of users who read it
+	Announcements(pagination utils.IPagination) (announcements []models.Announcement, totalCount int64, err error)
+	Delete(admin models.User, announcementID uint) error
+
+	// UserAnnouncements returns a page of the published announcements of the audiences of the user
+	UserAnnouncements(user models.User, pagination utils.IPagination) (announcements []models.Announcement, totalCount int64, err error)
+	Read(user models.User, announcementID uint) (models.Announcement, error)
+
+	// Listeners
+	// Published delivers the announcement going live to its audience
+	Published(event infrastructures.Event) error
+}
+
+type AnnouncementService struct {
+	AnnouncementRepository repositories.IAnnouncementRepository
+	BillingRepository      repositories.IBillingRepository
+	NotificationService    INotificationService
+	SettingService         ISettingService
+	Hub                    infrastructures.IHub
+	Events                 infrastructures.IEventBus
+	Logger                 infrastructures.ILogger
+}
+
+func (service *AnnouncementService) Create(admin models.User, announcement models.Announcement) (models.Announcement, error) {
+	if announcement.Audience == models.AudiencePlan {
+		if _, err := service.plan(announcement); err != nil {
+			return announcement, err
+		}
+	} else {
+		announcement.PlanSlug = nil
+	}
+	now := time.Now()
+	if announcement.PublishStatus != models.PublishDraft {
+		announcement.PublishStatus = models.PublishScheduled
+		announcement.PublishAt = publishTime(announcement.PublishAt, now)
+	}
+	announcement.CreatedBy = admin.ID
+	if err := service.AnnouncementRepository.Create(&announcement); err != nil {
+		return announcement, err
+	}
+	service.Logger.Info("announcement created", infrastructures.Fields{"audit": true, "admin_id": admin.ID, "announcement_id": announcement.ID, "audience": announcement.Audience, "publish_status": announcement.PublishStatus})
+
+	if announcement.IsDue(now) {
+		return service.publishNow(announcement, now)
+	}
+	return announcement, nil
+}
+
+func (service *AnnouncementService) Publish(admin models.User, announcementID uint, publishAt *time.Time) (models.Announcement, error) {
+	announcement, err := service.getAnnouncement(announcementID)
+	if err != nil {
+		return announcement, err
+	}
+	if announcement.IsPublished() {
+		return announcement, ErrAnnouncementPublished
+	}
+	now := time.Now()
+	publishAt = publishTime(publishAt, now)
+	if err = service.AnnouncementRepository.Update(&announcement, map[string]interface{}{"publish_status": models.PublishScheduled, "publish_at": publishAt}); err != nil {
+		return announcement, err
+	}
+	announcement.PublishStatus, announcement.PublishAt = models.PublishScheduled, publishAt
+	service.Logger.Info("announcement scheduled", infrastructures.Fields{"audit": true, "admin_id": admin.ID, "announcement_id": announcement.ID, "publish_at": publishAt})
+
+	if announcement.IsDue(now) {
+		return service.publishNow(announcement, now)
+	}
+	return announcement, nil
+}
+
+func (service *AnnouncementService) Announcements(pagination utils.IPagination) (announcements []models.Announcement, totalCount int64, err error) {
+	announcements, totalCount, err = service.AnnouncementRepository.GetAnnouncements(service.pager(pagination))
+	if err != nil {
+		return nil, 0, err
+	}
+	counts, err := service.AnnouncementRepository.CountReads(announcementIDs(announcements))
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range announcements {
+		for _, count := range counts {
+			if count.AnnouncementID == announcements[i].ID {
+				announcements[i].ReadCount = count.Count
+			}
+		}
+	}
+	return announcements, totalCount, nil
+}
+
+func (service *AnnouncementService) Delete(admin models.User, announcementID uint) error {
+	announcement, err := service.getAnnouncement(announcementID)
+	if err != nil {
+		return err
+	}
+	if err = service.AnnouncementRepository.Delete(&announcement); err != nil {
+		return err
+	}
+	service.Logger.Info("announcement deleted", infrastructures.Fields{"audit": true, "admin_id": admin.ID, "announcement_id": announcement.ID})
+	service.Events.Publish(EventAnnouncementDeleted, announcement)
+	return nil
+}
+
+func (service *AnnouncementService) UserAnnouncements(user models.User, pagination utils.IPagination) (announcements []models.Announcement, totalCount int64, err error) {
+	planSlugs, err := service.planSlugs(user)
+	if err != nil {
+		return nil, 0, err
+	}
+	announcements, totalCount, err = service.AnnouncementRepository.GetUserAnnouncements(user.Verified, planSlugs, service.pager(pagination))
+	if err != nil {
+		return nil, 0, err
+	}
+	read, err := service.AnnouncementRepository.GetReadIDs(user.ID, announcementIDs(announcements))
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range announcements {
+		announcements[i].Read = helpers.InArray(announcements[i].ID, read)
+	}
+	return announcements, totalCount, nil
+}
+
+func (service *AnnouncementService) Read(user models.User, announcementID uint) (announcement models.Announcement, err error) {
+	if announcement, err = service.getAnnouncement(announcementID); err != nil {
+		return announcement, err
+	}
+	// an announcement of another audience is not found so its existence does not leak
+	planSlugs, err := service.planSlugs(user)
+	if err != nil {
+		return announcement, err
+	}
+	switch {
+	case !announcement.IsPublished():
+		return announcement, ErrAnnouncementNotFound
+	case announcement.Audience == models.AudienceVerified && !user.Verified:
+		return announcement, ErrAnnouncementNotFound
+	case announcement.Audience == models.AudiencePlan && (announcement.PlanSlug == nil || !helpers.InArray(*announcement.PlanSlug, planSlugs)):
+		return announcement, ErrAnnouncementNotFound
+	}
+	if err = service.AnnouncementRepository.MarkRead(announcement.ID, user.ID); err != nil {
+		return announcement, err
+	}
+	announcement.Read = true
+	return announcement, nil
+}
+
+func (service *AnnouncementService) Published(event infrastructures.Event) error {
+	announcement, ok := event.Payload.(models.Announcement)
+	if !ok {
+		return fmt.Errorf("unexpected payload %T", event.Payload)
+	}
+	service.deliver(announcement)
+	return nil
+}
+
+// publishNow publishes the due announcement, the publishing job may have published it meanwhile and only the one
+// that published it announces it so it is delivered once
+func (service *AnnouncementService) publishNow(announcement models.Announcement, now time.Time) (models.Announcement, error) {
+	published, err := service.AnnouncementRepository.Publish(&announcement, now)
+	if err != nil {
+		return announcement, err
+	}
+	announcement.PublishStatus, announcement.PublishedAt = models.PublishPublished, &now
+	if published {
+		service.Events.Publish(EventPublished(announcement.TableName()), announcement)
+	}
+	return announcement, nil
+}
+
+// deliver writes the published announcement to the sockets of its audience and pushes it
+func (service *AnnouncementService) deliver(announcement models.Announcement) {
+	planRank := 0
+	if announcement.Audience == models.AudiencePlan {
+		plan, err := service.plan(announcement)
+		if err != nil {
+			service.Logger.Error("announcement could not be delivered", infrastructures.Fields{"announcement_id": announcement.ID, "error": err.Error()})
+			return
+		}
+		if plan.IsFree() {
+			announcement.Audience = models.AudienceAll
+		}
+		planRank = plan.Rank
+	}
+
+	notification := infrastructures.PushNotification{
+		Title: announcement.Title,
+		Body:  helpers.Truncate(announcement.Body, pushExcerptLength),
+		Data: map[string]string{
+			"type":            EventAnnouncementPublished,
+			"announcement_id": strconv.FormatUint(uint64(announcement.ID), 10),
+		},
+	}
+	var afterID uint
+	for {
+		userIDs, err := service.AnnouncementRepository.GetAudience(announcement.Audience, planRank, afterID, announcementBatchSize)
+		if err != nil {
+			service.Logger.Error("announcement delivery stopped", infrastructures.Fields{"announcement_id": announcement.ID, "after_user_id": afterID, "error": err.Error()})
+			return
+		}
+		for _, userID := range userIDs {
+			service.Hub.Send(userID, infrastructures.HubMessage{Type: EventAnnouncementPublished, Data: announcement})
+			// failures are logged by the push, one device does not hold the others
+			_ = service.NotificationService.Push(userID, models.PushAnnouncements, notification)
+		}
+		if len(userIDs) < announcementBatchSize {
+			return
+		}
+		afterID = userIDs[len(userIDs)-1]
+	}
+}
+
+// planSlugs are the slugs of the plans included in the plan of the user, the free plans for every user
+func (service *AnnouncementService) planSlugs(user models.User) (slugs []string, err error) {
+	plans, err := service.BillingRepository.GetActivePlans()
+	if err != nil {
+		return nil, err
+	}
+	subscription, err := service.BillingRepository.GetSubscriptionByUserID(user.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	entitled := err == nil && subscription.IsEntitled(time.Now())
+	for _, plan := range plans {
+		if plan.IsFree() || (entitled && subscription.Plan.Includes(plan)) {
+			slugs = append(slugs, plan.Slug)
+		}
+	}
+	return slugs, nil
+}
+
+func (service *AnnouncementService) plan(announcement models.Announcement) (plan models.Plan, err error) {
+	if announcement.PlanSlug == nil {
+		return plan, ErrAnnouncementPlanNotFound
+	}
+	plan, err = service.BillingRepository.GetPlanBySlug(*announcement.PlanSlug)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return plan, ErrAnnouncementPlanNotFound
+	}
+	return plan, err
+}
+
+func (service *AnnouncementService) pager(pagination utils.IPagination) scopes.GormPager {
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && pagination.GetLimit() > max {
+		pagination.Get().Limit = max
+	}
+	return &scopes.GormPagination{Pagination: pagination.Get()}
+}
+
+func (service *AnnouncementService) getAnnouncement(announcementID uint) (announcement models.Announcement, err error) {
+	announcement, err = service.AnnouncementRepository.GetAnnouncementByID(announcementID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return announcement, ErrAnnouncementNotFound
+	}
+	return announcement, err
+}
+
+// publishTime is the publish time at, now when it is nil or past
+func publishTime(at *time.Time, now time.Time) *time.Time {
+	if at == nil || at.Before(now) {
+		return &now
+	}
+	return at
+}
+
+func announcementIDs(announcements []models.Announcement) []uint {
+	IDs := make([]uint, 0, len(announcements))
+	for _, announcement := range announcements {
+		IDs = append(IDs, announcement.ID)
+	}
+	return IDs
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/policies"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+// ApiKeyPrefix starts every api key, it tells them apart from the other secrets in logs and scanners
+const ApiKeyPrefix = "gk_"
+
+var (
+	ErrApiKeyNotFound    = problems.Define(problems.ApiKeyNotFound, "api key could not be found")
+	ErrApiKeyInvalid     = problems.Define(problems.Unauthenticated, "the api key is invalid, revoked or expired")
+	ErrScopeNotGrantable = problems.Define(problems.ScopeNotGrantable, "you can not grant these scopes")
+)
+
+type IApiKeyService interface {
+	GetApiKeys(user models.User) ([]models.ApiKey, error)
+	// Create issues a key of the user limited to the scopes, a ttl of 0 never expires. The key is only returned here
+	Create(user models.User, name string, scopes []string, ttl time.Duration) (apiKey models.ApiKey, key string, err error)
+	Revoke(user models.User, ID uint) (models.ApiKey, error)
+	// Authenticate is the active api key of the key, its last use is recorded
+	Authenticate(key string) (models.ApiKey, error)
+}
+
+type ApiKeyService struct {
+	ApiKeyRepository repositories.IApiKeyRepository
+	Clock            infrastructures.IClock
+	Random           infrastructures.IRandom
+}
+
+func (service *ApiKeyService) GetApiKeys(user models.User) ([]models.ApiKey, error) {
+	return service.ApiKeyRepository.GetApiKeysOfUser(user.ID)
+}
+
+func (service *ApiKeyService) Create(user models.User, name string, scopes []string, ttl time.Duration) (apiKey models.ApiKey, key string, err error) {
+	if !policies.Grantable(user, scopes) {
+		return apiKey, "", ErrScopeNotGrantable
+	}
+	var secret string
+	if secret, err = service.Random.Token(24); err != nil {
+		return apiKey, "", err
+	}
+	key = ApiKeyPrefix + secret
+
+	apiKey = models.ApiKey{
+		UserID:  user.ID,
+		Name:    strings.TrimSpace(name),
+		Prefix:  key[:len(ApiKeyPrefix)+8],
+		KeyHash: hashApiKey(key),
+		Scopes:  strings.Join(scopes, " "),
+	}
+	if ttl > 0 {
+		expiresAt := service.Clock.Now().Add(ttl)
+		apiKey.ExpiresAt = &expiresAt
+	}
+	if err = service.ApiKeyRepository.Create(&apiKey); err != nil {
+		return apiKey, "", err
+	}
+	return apiKey, key, nil
+}
+
+func (service *ApiKeyService) Revoke(user models.User, ID uint) (apiKey models.ApiKey, err error) {
+	apiKey, err = service.ApiKeyRepository.GetApiKeyOfUser(user.ID, ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apiKey, ErrApiKeyNotFound
+		}
+		return apiKey, err
+	}
+	if apiKey.RevokedAt != nil {
+		return apiKey, nil
+	}
+	err = service.ApiKeyRepository.Revoke(&apiKey, service.Clock.Now())
+	return apiKey, err
+}
+
+func (service *ApiKeyService) Authenticate(key string) (apiKey models.ApiKey, err error) {
+	if !strings.HasPrefix(key, ApiKeyPrefix) {
+		return apiKey, ErrApiKeyInvalid
+	}
+	apiKey, err = service.ApiKeyRepository.GetApiKeyByHash(hashApiKey(key))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apiKey, ErrApiKeyInvalid
+		}
+		return apiKey, err
+	}
+	now := service.Clock.Now()
+	if !apiKey.IsActive(now) {
+		return apiKey, ErrApiKeyInvalid
+	}
+	// the last use is informative, a failure to record it does not fail the request
+	_ = service.ApiKeyRepository.Touch(&apiKey, now)
+	return apiKey, nil
+}
+
+func hashApiKey(key string) string {
+	return helpers.ComputeHmacSha1(key, config.Conf.SecretKey)
+}
@@ -1,17 +1,46 @@
 package services
 
 import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
 	"gotham/models"
+	"gotham/policies"
+	"gotham/problems"
 	"gotham/repositories"
 )
 
+var ErrSudoLocked = problems.Define(problems.SudoLocked, "too many wrong passwords were confirmed, try again later")
+
 type IAuthService interface {
 	GetUserByEmail(email string) (user models.User, err error)
 	Check(email string, password string) (bool, error)
+	// IssueToken signs an access token for the user, an impersonator id marks an impersonation token
+	IssueToken(userID uint, impersonatorID uint, ttl time.Duration) (token string, expiresAt int64, err error)
+	// IssueSudoToken signs an access token of a user who confirmed the password at authAt, see RequireRecentAuth
+	IssueSudoToken(userID uint, ttl time.Duration, authAt time.Time) (token string, expiresAt int64, err error)
+	// IssueClientToken signs an access token of an internal service, it has no auth user
+	IssueClientToken(clientID uint, scopes []string, ttl time.Duration) (token string, expiresAt int64, err error)
+	// ConfirmPassword checks the password a user confirms for the sensitive operations, false when it is wrong. Once
+	// SUDO_MAX_ATTEMPTS wrong ones are confirmed within SUDO_LOCKOUT it returns ErrSudoLocked, so a stolen access token
+	// can not guess the password
+	ConfirmPassword(ctx context.Context, user models.User, password string) (bool, error)
+	// ChangePassword replaces the password of the user and ends its remember me sessions
+	ChangePassword(user models.User, password string) (models.User, error)
 }
 
 type AuthService struct {
-	UserRepository repositories.IUserRepository
+	UserRepository    repositories.IUserRepository
+	SessionRepository repositories.ISessionRepository
+	Cache             infrastructures.ICache
+	Clock             infrastructures.IClock
+	Config            *config.Session
 }
 
 func (service *AuthService) Check(email string, password string) (bool, error) {
@@ -25,3 +54,54 @@ func (service *AuthService) Check(email string, password string) (bool, error) {
 func (service *AuthService) GetUserByEmail(email string) (user models.User, err error) {
 	return service.UserRepository.GetUserByEmail(email)
 }
+
+func (service *AuthService) IssueToken(userID uint, impersonatorID uint, ttl time.Duration) (token string, expiresAt int64, err error) {
+	return service.sign(&config.JwtCustomClaims{AuthID: userID, ImpersonatorID: impersonatorID, Scopes: policies.Scopes}, ttl)
+}
+
+func (service *AuthService) ConfirmPassword(ctx context.Context, user models.User, password string) (bool, error) {
+	counter := windowCounter{Cache: service.Cache, Prefix: "sudo:", Window: service.Config.SudoLockout}
+	key := strconv.FormatUint(uint64(user.ID), 10)
+	failures, _, err := counter.Get(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if failures >= service.Config.SudoMaxAttempts {
+		return false, ErrSudoLocked
+	}
+	if !user.VerifyPassword(password) {
+		_, err = counter.Increment(ctx, key)
+		return false, err
+	}
+	return true, counter.Reset(ctx, key)
+}
+
+func (service *AuthService) IssueSudoToken(userID uint, ttl time.Duration, authAt time.Time) (token string, expiresAt int64, err error) {
+	return service.sign(&config.JwtCustomClaims{AuthID: userID, AuthAt: authAt.Unix(), Scopes: policies.Scopes}, ttl)
+}
+
+func (service *AuthService) IssueClientToken(clientID uint, scopes []string, ttl time.Duration) (token string, expiresAt int64, err error) {
+	return service.sign(&config.JwtCustomClaims{ClientID: clientID, Scopes: scopes}, ttl)
+}
+
+func (service *AuthService) ChangePassword(user models.User, password string) (models.User, error) {
+	hashedPassword, err := helpers.Hash(password)
+	if err != nil {
+		return user, err
+	}
+	if err = service.UserRepository.Updates(&user, map[string]interface{}{"password": string(hashedPassword)}); err != nil {
+		return user, err
+	}
+	user.Password = string(hashedPassword)
+	return user, service.SessionRepository.RevokeAll(user.ID, service.Clock.Now())
+}
+
+func (service *AuthService) sign(claims *config.JwtCustomClaims, ttl time.Duration) (token string, expiresAt int64, err error) {
+	expiresAt = service.Clock.Now().Add(ttl).Unix()
+	claims.StandardClaims = jwt.StandardClaims{
+		ExpiresAt: expiresAt,
+	}
+
+	token, err = jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(config.Conf.SecretKey))
+	return token, expiresAt, err
+}
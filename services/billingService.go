@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+var (
+	ErrPlanNotFound         = problems.Define(problems.PlanNotFound, "the plan could not be found")
+	ErrPlanNotPurchasable   = problems.Define(problems.PlanNotPurchasable, "the plan can not be subscribed to")
+	ErrAlreadySubscribed    = problems.Define(problems.AlreadySubscribed, "you already have a subscription, cancel it first")
+	ErrSubscriptionNotFound = problems.Define(problems.SubscriptionNotFound, "you have no subscription")
+	ErrWebhookInvalid       = problems.Define(problems.WebhookInvalid, "the webhook signature is invalid")
+)
+
+type IBillingService interface {
+	Plans() ([]models.Plan, error)
+	// Subscription returns the subscription of the user, ErrSubscriptionNotFound when it never subscribed
+	Subscription(user models.User) (models.Subscription, error)
+	// Checkout returns the url of the payment page of the plan, the subscription is stored once its webhook arrives,
+	// an empty coupon code applies no discount
+	Checkout(ctx context.Context, user models.User, planSlug string, couponCode string) (string, error)
+	// QuoteCoupon checks a coupon for the user and the plan
+	QuoteCoupon(user models.User, couponCode string, planSlug string) (CouponQuote, error)
+	// Cancel stops the renewal of the subscription, the plan is kept until the end of the paid period
+	Cancel(ctx context.Context, user models.User) (models.Subscription, error)
+	// HandleWebhook verifies and applies an event of the payment provider, events that are not about subscriptions or
+	// paid invoices are ignored
+	HandleWebhook(payload []byte, signature string) error
+	// ReplayWebhook applies again the event of a webhook dead letter, it was verified at its delivery
+	ReplayWebhook(ctx context.Context, payload []byte) error
+	// HasPlan tells if the plan of the user includes the plan of the slug, every user has the free plans
+	HasPlan(userID uint, planSlug string) (bool, error)
+}
+
+type BillingService struct {
+	BillingRepository repositories.IBillingRepository
+	Gateway           infrastructures.IPaymentGateway
+	InvoiceService    IInvoiceService
+	CouponService     ICouponService
+	DeadLetters       IDeadLetterService
+	Logger            infrastructures.ILogger
+	Clock             infrastructures.IClock
+	Config            *config.Billing
+}
+
+func (service *BillingService) Plans() ([]models.Plan, error) {
+	return service.BillingRepository.GetActivePlans()
+}
+
+func (service *BillingService) Subscription(user models.User) (subscription models.Subscription, err error) {
+	subscription, err = service.BillingRepository.GetSubscriptionByUserID(user.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return subscription, ErrSubscriptionNotFound
+	}
+	return subscription, err
+}
+
+func (service *BillingService) Checkout(ctx context.Context, user models.User, planSlug string, couponCode string) (string, error) {
+	plan, err := service.purchasablePlan(planSlug)
+	if err != nil {
+		return "", err
+	}
+
+	checkout := infrastructures.Checkout{
+		UserID:     user.ID,
+		Email:      user.Email,
+		PriceID:    *plan.StripePriceID,
+		SuccessURL: service.Config.SuccessURL,
+		CancelURL:  service.Config.CancelURL,
+	}
+	subscription, err := service.BillingRepository.GetSubscriptionByUserID(user.ID)
+	switch {
+	case err == nil:
+		if subscription.IsEntitled(service.Clock.Now()) {
+			return "", ErrAlreadySubscribed
+		}
+		// a returning customer keeps its payment methods and invoices
+		checkout.CustomerID = subscription.CustomerID
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return "", err
+	}
+
+	if couponCode != "" {
+		quote, err := service.CouponService.Quote(user, couponCode, plan)
+		if err != nil {
+			return "", err
+		}
+		checkout.CouponID, checkout.CouponCode = quote.Coupon.ExternalID, quote.Coupon.Code
+	}
+
+	return service.Gateway.CreateCheckout(ctx, checkout)
+}
+
+func (service *BillingService) QuoteCoupon(user models.User, couponCode string, planSlug string) (CouponQuote, error) {
+	plan, err := service.purchasablePlan(planSlug)
+	if err != nil {
+		return CouponQuote{}, err
+	}
+	return service.CouponService.Quote(user, couponCode, plan)
+}
+
+func (service *BillingService) purchasablePlan(slug string) (plan models.Plan, err error) {
+	if plan, err = service.BillingRepository.GetPlanBySlug(slug); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return plan, ErrPlanNotFound
+		}
+		return plan, err
+	}
+	if !plan.Active {
+		return plan, ErrPlanNotFound
+	}
+	if plan.IsFree() || plan.StripePriceID == nil {
+		return plan, ErrPlanNotPurchasable
+	}
+	return plan, nil
+}
+
+func (service *BillingService) Cancel(ctx context.Context, user models.User) (subscription models.Subscription, err error) {
+	if subscription, err = service.Subscription(user); err != nil {
+		return subscription, err
+	}
+	if !subscription.IsEntitled(service.Clock.Now()) {
+		return subscription, ErrSubscriptionNotFound
+	}
+	if subscription.CancelAtPeriodEnd {
+		return subscription, nil
+	}
+
+	if err = service.Gateway.CancelSubscription(ctx, subscription.ExternalID); err != nil {
+		return subscription, err
+	}
+	// the webhook of the cancellation confirms it
+	if err = service.BillingRepository.UpdateSubscription(&subscription, map[string]interface{}{"cancel_at_period_end": true}); err != nil {
+		return subscription, err
+	}
+	service.Logger.Info("subscription canceled", infrastructures.Fields{"audit": true, "user_id": user.ID, "subscription_id": subscription.ID})
+	return subscription, nil
+}
+
+// HandleWebhook stores the event it could not apply as a dead letter, the provider retries it too
+func (service *BillingService) HandleWebhook(payload []byte, signature string) error {
+	event, err := service.Gateway.ParseWebhook(payload, signature)
+	if err != nil {
+		if errors.Is(err, infrastructures.ErrWebhookSignature) {
+			return ErrWebhookInvalid
+		}
+		return err
+	}
+	if err = service.apply(event); err != nil {
+		if encoded, e := json.Marshal(event); e == nil {
+			service.DeadLetters.RecordWebhook(DeadLetterSourceBilling, event.ID, encoded, err)
+		}
+		return err
+	}
+	service.DeadLetters.ResolveWebhook(event.ID)
+	return nil
+}
+
+func (service *BillingService) ReplayWebhook(ctx context.Context, payload []byte) error {
+	var event infrastructures.PaymentEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return err
+	}
+	return service.apply(event)
+}
+
+// apply applies the event verified, the events are delivered at least once and out of order
+func (service *BillingService) apply(event infrastructures.PaymentEvent) error {
+	if event.Invoice != nil {
+		return service.InvoiceService.Record(*event.Invoice)
+	}
+	if event.Subscription == nil {
+		return nil
+	}
+	incoming := event.Subscription
+	fields := infrastructures.Fields{"event_id": event.ID, "event_type": event.Type, "subscription": incoming.ID}
+
+	// answered with a success, stripe would retry these forever
+	if incoming.UserID == 0 {
+		service.Logger.Warn("subscription event without a user", fields)
+		return nil
+	}
+	plan, err := service.BillingRepository.GetPlanByPriceID(incoming.PriceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			service.Logger.Warn("subscription event of an unknown price", fields)
+			return nil
+		}
+		return err
+	}
+
+	subscription, err := service.BillingRepository.GetSubscriptionByUserID(incoming.UserID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		subscription = models.Subscription{
+			UserID:            incoming.UserID,
+			PlanID:            plan.ID,
+			Status:            incoming.Status,
+			CustomerID:        incoming.CustomerID,
+			ExternalID:        incoming.ID,
+			CurrentPeriodEnd:  incoming.CurrentPeriodEnd,
+			CancelAtPeriodEnd: incoming.CancelAtPeriodEnd,
+			LastEventAt:       event.Created,
+		}
+		if err = service.BillingRepository.CreateSubscription(&subscription); err != nil {
+			return err
+		}
+		service.Logger.Info("subscription created", infrastructures.Fields{"audit": true, "user_id": incoming.UserID, "plan": plan.Slug, "status": incoming.Status})
+		service.redeemCoupon(incoming)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if event.Created.Before(subscription.LastEventAt) {
+		return nil
+	}
+	// the late events of a former subscription do not end the current one
+	if subscription.ExternalID != incoming.ID && subscription.IsEntitled(service.Clock.Now()) {
+		return nil
+	}
+	renewed := subscription.ExternalID != incoming.ID
+	if err = service.BillingRepository.UpdateSubscription(&subscription, map[string]interface{}{
+		"plan_id":              plan.ID,
+		"status":               incoming.Status,
+		"customer_id":          incoming.CustomerID,
+		"external_id":          incoming.ID,
+		"current_period_end":   incoming.CurrentPeriodEnd,
+		"cancel_at_period_end": incoming.CancelAtPeriodEnd,
+		"last_event_at":        event.Created,
+	}); err != nil {
+		return err
+	}
+	service.Logger.Info("subscription updated", infrastructures.Fields{"audit": true, "user_id": incoming.UserID, "plan": plan.Slug, "status": incoming.Status})
+	if renewed {
+		service.redeemCoupon(incoming)
+	}
+	return nil
+}
+
+// redeemCoupon records the coupon of a new subscription, the provider already applied it so a failure is only logged
+func (service *BillingService) redeemCoupon(incoming *infrastructures.PaymentSubscription) {
+	if incoming.CouponCode == "" {
+		return
+	}
+	if err := service.CouponService.Redeem(incoming.CouponCode, incoming.UserID, incoming.ID); err != nil {
+		service.Logger.Error("coupon redemption could not be recorded", infrastructures.Fields{"coupon": incoming.CouponCode, "user_id": incoming.UserID, "error": err.Error()})
+	}
+}
+
+func (service *BillingService) HasPlan(userID uint, planSlug string) (bool, error) {
+	required, err := service.BillingRepository.GetPlanBySlug(planSlug)
+	if err != nil {
+		return false, err
+	}
+	if required.IsFree() {
+		return true, nil
+	}
+
+	subscription, err := service.BillingRepository.GetSubscriptionByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return subscription.IsEntitled(service.Clock.Now()) && subscription.Plan.Includes(required), nil
+}
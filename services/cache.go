@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"gotham/infrastructures"
+)
+
+// tags of the cached responses, a service changing the data behind them invalidates them
+const (
+	CacheTagUsers    = "users"
+	CacheTagSettings = "settings"
+	CacheTagTags     = "tags"
+)
+
+// invalidateCache is best effort, the change is already stored and the entries expire with their ttl anyway
+func invalidateCache(cache infrastructures.ICache, tags ...string) {
+	if cache == nil {
+		return
+	}
+	if err := cache.InvalidateTags(context.Background(), tags...); err != nil {
+		log.Printf("cache: invalidating %v failed: %v", tags, err)
+	}
+}
+
+// windowCounter counts events per key in fixed windows, an entry holds the count and the end of its window.
+// Get and Increment are not atomic, a few concurrent events can be missed which is fine for throttling
+type windowCounter struct {
+	Cache  infrastructures.ICache
+	Prefix string
+	Window time.Duration
+}
+
+func (w windowCounter) Get(ctx context.Context, key string) (count int, windowEnd time.Time, err error) {
+	now := time.Now()
+	value, found, err := w.Cache.Get(ctx, w.Prefix+key)
+	if err != nil || !found {
+		return 0, now.Add(w.Window), err
+	}
+	var end int64
+	if _, err := fmt.Sscanf(string(value), "%d %d", &count, &end); err != nil || !now.Before(time.Unix(end, 0)) {
+		return 0, now.Add(w.Window), nil
+	}
+	return count, time.Unix(end, 0), nil
+}
+
+func (w windowCounter) Increment(ctx context.Context, key string) (count int, err error) {
+	count, windowEnd, err := w.Get(ctx, key)
+	if err != nil {
+		return count, err
+	}
+	count++
+	return count, w.Cache.Set(ctx, w.Prefix+key, []byte(fmt.Sprintf("%d %d", count, windowEnd.Unix())), time.Until(windowEnd))
+}
+
+func (w windowCounter) Reset(ctx context.Context, key string) error {
+	return w.Cache.Delete(ctx, w.Prefix+key)
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/problems"
+)
+
+var (
+	ErrCaptchaRequired = problems.Define(problems.CaptchaRequired, "solve the captcha to continue")
+	ErrCaptchaInvalid  = problems.Define(problems.CaptchaInvalid, "the captcha is invalid")
+)
+
+// challenged actions, each has its threshold setting
+const (
+	ChallengeLogin        = "login"
+	ChallengeRegistration = "registration"
+)
+
+// ChallengeSubject is who an event is counted for, the ip and (for logins) the email
+type ChallengeSubject struct {
+	IP    string
+	Email string
+}
+
+type IChallengeService interface {
+	// Required tells whether the subject has to solve a captcha for the action
+	Required(ctx context.Context, action string, subject ChallengeSubject) (bool, error)
+	// Check verifies the captcha token when one is required
+	Check(ctx context.Context, action string, subject ChallengeSubject, token string) error
+	// Record counts a suspicious event (a failed login, a registration) of the subject
+	Record(ctx context.Context, action string, subject ChallengeSubject) error
+	// Forget clears the events of the email after a successful login, the ip keeps its count
+	Forget(ctx context.Context, action string, subject ChallengeSubject) error
+}
+
+type ChallengeService struct {
+	Captcha        infrastructures.ICaptchaService
+	SettingService ISettingService
+	Cache          infrastructures.ICache
+	Config         *config.Captcha
+}
+
+var challengeThresholds = map[string]string{
+	ChallengeLogin:        "captcha_login_threshold",
+	ChallengeRegistration: "captcha_registration_threshold",
+}
+
+func (service *ChallengeService) Required(ctx context.Context, action string, subject ChallengeSubject) (bool, error) {
+	if !service.SettingService.Bool("captcha_enabled", false) {
+		return false, nil
+	}
+	threshold := service.SettingService.Int(challengeThresholds[action], 3)
+	counter := service.counter(action)
+	for _, key := range subjectKeys(subject) {
+		count, _, err := counter.Get(ctx, key)
+		if err != nil {
+			return false, err
+		}
+		if count >= threshold {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (service *ChallengeService) Check(ctx context.Context, action string, subject ChallengeSubject, token string) error {
+	required, err := service.Required(ctx, action, subject)
+	if err != nil || !required {
+		return err
+	}
+	if token == "" {
+		return ErrCaptchaRequired
+	}
+	valid, err := service.Captcha.Verify(ctx, token, subject.IP)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return ErrCaptchaInvalid
+	}
+	return nil
+}
+
+func (service *ChallengeService) Record(ctx context.Context, action string, subject ChallengeSubject) error {
+	counter := service.counter(action)
+	for _, key := range subjectKeys(subject) {
+		if _, err := counter.Increment(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (service *ChallengeService) Forget(ctx context.Context, action string, subject ChallengeSubject) error {
+	if subject.Email == "" {
+		return nil
+	}
+	return service.counter(action).Reset(ctx, emailKey(subject.Email))
+}
+
+func (service *ChallengeService) counter(action string) windowCounter {
+	return windowCounter{Cache: service.Cache, Prefix: "challenge:" + action + ":", Window: service.Config.Window}
+}
+
+func subjectKeys(subject ChallengeSubject) (keys []string) {
+	if subject.IP != "" {
+		keys = append(keys, "ip:"+subject.IP)
+	}
+	if subject.Email != "" {
+		keys = append(keys, emailKey(subject.Email))
+	}
+	return keys
+}
+
+// emails are hashed so the cache holds no addresses
+func emailKey(email string) string {
+	return "email:" + helpers.ComputeHmacSha1(strings.ToLower(strings.TrimSpace(email)), config.Conf.SecretKey)
+}
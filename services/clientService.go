@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+// ClientIDPrefix starts every client id, the secret of a client is a token of its own
+const ClientIDPrefix = "gc_"
+
+var (
+	ErrClientNotFound         = problems.Define(problems.ClientNotFound, "client could not be found")
+	ErrClientInvalid          = problems.Define(problems.ClientInvalid, "the client is unknown, revoked or its secret is wrong")
+	ErrClientScopeInvalid     = problems.Define(problems.ClientScopeInvalid, "the scope is not assigned to the client")
+	ErrClientRateLimitReached = problems.Define(problems.ClientRateLimitReached, "the client made too many requests")
+	ErrIdentityTaken          = problems.Define(problems.IdentityTaken, "the certificate identity belongs to another client")
+)
+
+// ClientToken is the access token of a client credentials grant
+type ClientToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+type IClientService interface {
+	GetClients() ([]models.Client, error)
+	// Register creates a client limited to the scopes, a nil rate limit uses the default one and a certificate identity
+	// lets it authenticate with a client certificate. The secret is only returned here
+	Register(creator models.User, name string, scopes []string, rateLimit *int, certificateIdentity *string) (client models.Client, secret string, err error)
+	Revoke(ID uint) (models.Client, error)
+	// Grant exchanges the credentials of a client for a token of the scopes, all its scopes when none is asked for
+	Grant(clientID string, secret string, scopes []string) (ClientToken, error)
+	// GetActiveClient is the client of a token, a revoked client is invalid
+	GetActiveClient(ID uint) (models.Client, error)
+	// AuthenticateCertificate is the active client of the first identity of a verified certificate that has one
+	AuthenticateCertificate(identities []string) (models.Client, error)
+	// Throttle counts a request of the client in its rate limit window, apart from the quotas of the users
+	Throttle(ctx context.Context, client models.Client) (QuotaStatus, error)
+}
+
+type ClientService struct {
+	ClientRepository repositories.IClientRepository
+	AuthService      IAuthService
+	Cache            infrastructures.ICache
+	Config           *config.Clients
+	Clock            infrastructures.IClock
+	Random           infrastructures.IRandom
+}
+
+func (service *ClientService) GetClients() ([]models.Client, error) {
+	return service.ClientRepository.GetClients()
+}
+
+func (service *ClientService) Register(creator models.User, name string, scopes []string, rateLimit *int, certificateIdentity *string) (client models.Client, secret string, err error) {
+	if certificateIdentity != nil {
+		if _, err = service.ClientRepository.GetClientByCertificateIdentity(*certificateIdentity); err == nil {
+			return client, "", ErrIdentityTaken
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return client, "", err
+		}
+	}
+
+	var id string
+	if id, err = service.Random.Token(16); err != nil {
+		return client, "", err
+	}
+	if secret, err = service.Random.Token(32); err != nil {
+		return client, "", err
+	}
+
+	client = models.Client{
+		Name:                strings.TrimSpace(name),
+		ClientID:            ClientIDPrefix + id,
+		SecretHash:          hashClientSecret(secret),
+		Scopes:              strings.Join(scopes, " "),
+		RateLimit:           rateLimit,
+		CreatedBy:           creator.ID,
+		CertificateIdentity: certificateIdentity,
+	}
+	if err = service.ClientRepository.Create(&client); err != nil {
+		return client, "", err
+	}
+	return client, secret, nil
+}
+
+func (service *ClientService) Revoke(ID uint) (client models.Client, err error) {
+	client, err = service.ClientRepository.GetClientByID(ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return client, ErrClientNotFound
+		}
+		return client, err
+	}
+	if !client.IsActive() {
+		return client, nil
+	}
+	err = service.ClientRepository.Revoke(&client, service.Clock.Now())
+	return client, err
+}
+
+func (service *ClientService) Grant(clientID string, secret string, scopes []string) (token ClientToken, err error) {
+	client, err := service.ClientRepository.GetClientByClientID(clientID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return token, ErrClientInvalid
+		}
+		return token, err
+	}
+	if !client.IsActive() || subtle.ConstantTimeCompare([]byte(client.SecretHash), []byte(hashClientSecret(secret))) != 1 {
+		return token, ErrClientInvalid
+	}
+
+	assigned := client.ScopeList()
+	if len(scopes) == 0 {
+		scopes = assigned
+	}
+	// a token without scopes would pass every scope, see policies.Scoped
+	if len(scopes) == 0 {
+		return token, ErrClientScopeInvalid
+	}
+	for _, scope := range scopes {
+		if !helpers.InArray(scope, assigned) {
+			return token, ErrClientScopeInvalid
+		}
+	}
+
+	accessToken, expiresAt, err := service.AuthService.IssueClientToken(client.ID, scopes, service.Config.TokenTTL)
+	if err != nil {
+		return token, err
+	}
+	// the last use is informative, a failure to record it does not fail the grant
+	_ = service.ClientRepository.Touch(&client, service.Clock.Now())
+	return ClientToken{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   expiresAt - service.Clock.Now().Unix(),
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+func (service *ClientService) GetActiveClient(ID uint) (client models.Client, err error) {
+	client, err = service.ClientRepository.GetClientByID(ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return client, ErrClientInvalid
+		}
+		return client, err
+	}
+	if !client.IsActive() {
+		return client, ErrClientInvalid
+	}
+	return client, nil
+}
+
+func (service *ClientService) AuthenticateCertificate(identities []string) (client models.Client, err error) {
+	for _, identity := range identities {
+		client, err = service.ClientRepository.GetClientByCertificateIdentity(identity)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		} else if err != nil {
+			return client, err
+		}
+		if !client.IsActive() || len(client.ScopeList()) == 0 {
+			return client, ErrClientInvalid
+		}
+		// the last use is informative, a failure to record it does not fail the request
+		_ = service.ClientRepository.Touch(&client, service.Clock.Now())
+		return client, nil
+	}
+	return models.Client{}, ErrClientInvalid
+}
+
+func (service *ClientService) Throttle(ctx context.Context, client models.Client) (status QuotaStatus, err error) {
+	limit := service.Config.RateLimit
+	if client.RateLimit != nil {
+		limit = *client.RateLimit
+	}
+	if limit == 0 {
+		return status, nil
+	}
+
+	counter := windowCounter{Cache: service.Cache, Prefix: "client-rate:", Window: service.Config.RateWindow}
+	key := strconv.FormatUint(uint64(client.ID), 10)
+	count, windowEnd, err := counter.Get(ctx, key)
+	if err != nil {
+		return status, err
+	}
+	status = QuotaStatus{Window: service.Config.RateWindow.String(), Limit: limit, Remaining: limit - count, Reset: windowEnd}
+	if status.Exceeded() {
+		return status, ErrClientRateLimitReached
+	}
+	if _, err = counter.Increment(ctx, key); err != nil {
+		return status, err
+	}
+	status.Remaining--
+	return status, nil
+}
+
+// hashClientSecret the secrets are random tokens, a keyed hash is enough to keep a leaked table from authenticating
+func hashClientSecret(secret string) string {
+	return helpers.ComputeHmacSha1(secret, config.Conf.SecretKey)
+}
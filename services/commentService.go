@@ -0,0 +1,232 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+	"gotham/policies"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/utils"
+)
+
+// replies nest up to this depth, top level comments are at depth 0
+const MaxCommentDepth = 5
+
+// published on the event bus with the models.Comment, an update is any change of its body or its status
+const (
+	EventCommentCreated = "comment.created"
+	EventCommentUpdated = "comment.updated"
+	EventCommentDeleted = "comment.deleted"
+)
+
+var (
+	ErrCommentNotFound     = problems.Define(problems.CommentNotFound, "comment could not be found")
+	ErrCommentableNotFound = problems.Define(problems.CommentableNotFound, "the commentable could not be found")
+	ErrCommentTooDeep      = problems.Define(problems.CommentTooDeep, "the comment can not be replied to")
+	ErrCommentNotEditable  = problems.Define(problems.CommentNotEditable, "the comment can not be changed")
+	ErrCommentFlagged      = problems.Define(problems.CommentFlagged, "you already flagged this comment")
+	ErrCommentNotFlaggable = problems.Define(problems.CommentNotFlaggable, "you can not flag your own comment")
+)
+
+// Commentable tells if the target of a comment exists
+type Commentable func(ID uint) (bool, error)
+
+type ICommentService interface {
+	// Threads returns a page of the top level comments of the commentable with their replies
+	Threads(viewer models.User, commentableType string, commentableID uint, pagination utils.IPagination, order utils.IOrder) (comments []models.Comment, totalCount int64, err error)
+	// Create comments the commentable, or replies to the parent comment when parentID is set
+	Create(user models.User, commentableType string, commentableID uint, parentID *uint, body string) (models.Comment, error)
+	Update(user models.User, commentID uint, body string) (models.Comment, error)
+	// Delete soft deletes the comment, its replies stay in the thread
+	Delete(user models.User, commentID uint) error
+	Flag(user models.User, commentID uint, reason string) error
+
+	// Moderation
+	ModerationQueue(pagination utils.IPagination) (comments []models.Comment, totalCount int64, err error)
+	// Approve makes the comment visible and clears it from the queue until it is flagged again
+	Approve(admin models.User, commentID uint) (models.Comment, error)
+	Remove(admin models.User, commentID uint) (models.Comment, error)
+}
+
+type CommentService struct {
+	CommentRepository repositories.ICommentRepository
+	SettingService    ISettingService
+	Events            infrastructures.IEventBus
+	Logger            infrastructures.ILogger
+	// Commentables are the types that can be commented, by the name used in the urls
+	Commentables map[string]Commentable
+}
+
+func (service *CommentService) Threads(viewer models.User, commentableType string, commentableID uint, pagination utils.IPagination, order utils.IOrder) (comments []models.Comment, totalCount int64, err error) {
+	if err = service.exists(commentableType, commentableID); err != nil {
+		return nil, 0, err
+	}
+	service.clamp(pagination)
+	comments, totalCount, err = service.CommentRepository.GetThreads(commentableType, commentableID, &scopes.GormPagination{Pagination: pagination.Get()}, &scopes.GormOrder{Order: order.Get()})
+	for i := range comments {
+		comments[i].Redact(viewer)
+	}
+	return
+}
+
+func (service *CommentService) Create(user models.User, commentableType string, commentableID uint, parentID *uint, body string) (comment models.Comment, err error) {
+	if err = service.exists(commentableType, commentableID); err != nil {
+		return comment, err
+	}
+	comment = models.Comment{
+		UserID:          user.ID,
+		CommentableType: commentableType,
+		CommentableID:   commentableID,
+		Body:            body,
+		Status:          models.CommentVisible,
+	}
+
+	if parentID != nil {
+		parent, err := service.getComment(*parentID)
+		if err != nil {
+			return comment, err
+		}
+		if parent.CommentableType != commentableType || parent.CommentableID != commentableID {
+			return comment, ErrCommentNotFound
+		}
+		if parent.Depth >= MaxCommentDepth || parent.Status == models.CommentRemoved {
+			return comment, ErrCommentTooDeep
+		}
+		rootID := parent.ID
+		if parent.RootID != nil {
+			rootID = *parent.RootID
+		}
+		comment.ParentID, comment.RootID, comment.Depth = &parent.ID, &rootID, parent.Depth+1
+	}
+
+	if err = service.CommentRepository.Create(&comment); err != nil {
+		return comment, err
+	}
+	service.Events.Publish(EventCommentCreated, comment)
+	return comment, nil
+}
+
+func (service *CommentService) Update(user models.User, commentID uint, body string) (comment models.Comment, err error) {
+	if comment, err = service.getComment(commentID); err != nil {
+		return comment, err
+	}
+	if !(policies.CommentPolicy{}).Can(user, policies.Update, comment) {
+		return comment, ErrCommentNotEditable
+	}
+
+	now := time.Now()
+	if err = service.CommentRepository.Updates(&comment, map[string]interface{}{"body": body, "edited_at": now}); err != nil {
+		return comment, err
+	}
+	comment.Body, comment.EditedAt = body, &now
+	service.Events.Publish(EventCommentUpdated, comment)
+	return comment, nil
+}
+
+func (service *CommentService) Delete(user models.User, commentID uint) error {
+	comment, err := service.getComment(commentID)
+	if err != nil {
+		return err
+	}
+	if !(policies.CommentPolicy{}).Can(user, policies.Delete, comment) {
+		return ErrCommentNotEditable
+	}
+	if err = service.CommentRepository.Delete(&comment); err != nil {
+		return err
+	}
+	service.Events.Publish(EventCommentDeleted, comment)
+	return nil
+}
+
+func (service *CommentService) Flag(user models.User, commentID uint, reason string) error {
+	comment, err := service.getComment(commentID)
+	if err != nil {
+		return err
+	}
+	if comment.UserID == user.ID {
+		return ErrCommentNotFlaggable
+	}
+	flagged, err := service.CommentRepository.HasFlagged(comment.ID, user.ID)
+	if err != nil {
+		return err
+	}
+	if flagged {
+		return ErrCommentFlagged
+	}
+
+	flag := models.CommentFlag{CommentID: comment.ID, UserID: user.ID, Reason: reason}
+	if err = service.CommentRepository.Flag(&comment, &flag, service.SettingService.Int("comment_flag_threshold", 3)); err != nil {
+		return err
+	}
+	// the flag may have hidden the comment
+	service.Events.Publish(EventCommentUpdated, comment)
+	return nil
+}
+
+/**
+ * Moderation
+ *
+ */
+
+func (service *CommentService) ModerationQueue(pagination utils.IPagination) (comments []models.Comment, totalCount int64, err error) {
+	service.clamp(pagination)
+	return service.CommentRepository.GetModerationQueue(&scopes.GormPagination{Pagination: pagination.Get()})
+}
+
+func (service *CommentService) Approve(admin models.User, commentID uint) (models.Comment, error) {
+	return service.review(admin, commentID, models.CommentVisible, "comment approved")
+}
+
+func (service *CommentService) Remove(admin models.User, commentID uint) (models.Comment, error) {
+	return service.review(admin, commentID, models.CommentRemoved, "comment removed")
+}
+
+func (service *CommentService) review(admin models.User, commentID uint, status string, message string) (comment models.Comment, err error) {
+	if comment, err = service.getComment(commentID); err != nil {
+		return comment, err
+	}
+
+	now := time.Now()
+	if err = service.CommentRepository.Updates(&comment, map[string]interface{}{"status": status, "flags": 0, "reviewed_at": now}); err != nil {
+		return comment, err
+	}
+	comment.Status, comment.Flags, comment.ReviewedAt = status, 0, &now
+	service.Logger.Info(message, infrastructures.Fields{"audit": true, "admin_id": admin.ID, "comment_id": comment.ID, "user_id": comment.UserID})
+	service.Events.Publish(EventCommentUpdated, comment)
+	return comment, nil
+}
+
+func (service *CommentService) getComment(commentID uint) (comment models.Comment, err error) {
+	comment, err = service.CommentRepository.GetCommentByID(commentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return comment, ErrCommentNotFound
+	}
+	return comment, err
+}
+
+func (service *CommentService) exists(commentableType string, commentableID uint) error {
+	commentable, ok := service.Commentables[commentableType]
+	if !ok {
+		return ErrCommentableNotFound
+	}
+	found, err := commentable(commentableID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrCommentableNotFound
+	}
+	return nil
+}
+
+func (service *CommentService) clamp(pagination utils.IPagination) {
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && pagination.GetLimit() > max {
+		pagination.Get().Limit = max
+	}
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"sort"
+	"sync"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/repositories"
+)
+
+// ConfigChange is a variable differing from the previous snapshot by its value or its source, Previous or Current is
+// nil when the variable was not read there
+type ConfigChange struct {
+	Name     string           `json:"name"`
+	Previous *config.Variable `json:"previous"`
+	Current  *config.Variable `json:"current"`
+}
+
+// ConfigReport is the configuration of the instance with the drift from the configuration loaded before it
+type ConfigReport struct {
+	// Effective is the configuration in use, the secrets redacted
+	Effective interface{}       `json:"effective"`
+	Variables []config.Variable `json:"variables"`
+	// Snapshot is the one the instance loaded at its start, nil when it could not be recorded
+	Snapshot *models.ConfigSnapshot `json:"snapshot"`
+	// Previous is the snapshot loaded last by other instances, nil without
+	Previous *models.ConfigSnapshot `json:"previous"`
+	Diff     []ConfigChange         `json:"diff"`
+}
+
+type IConfigService interface {
+	// Record stores the snapshot of the variables the instance loaded, called once started. A failure is only logged,
+	// the report has no diff then
+	Record()
+	// Report returns the effective configuration and its variables with their source, the secrets redacted, and the
+	// diff of the snapshot of the instance against the previous one
+	Report() (ConfigReport, error)
+}
+
+type ConfigService struct {
+	ConfigSnapshotRepository repositories.IConfigSnapshotRepository
+	Logger                   infrastructures.ILogger
+	Clock                    infrastructures.IClock
+	Config                   *config.Config
+
+	snapshot  *models.ConfigSnapshot
+	variables []config.Variable
+	mu        sync.RWMutex
+}
+
+func (service *ConfigService) Record() {
+	variables := config.Variables()
+	raw, err := json.Marshal(variables)
+	if err != nil {
+		service.Logger.Error("config snapshot not recorded", infrastructures.Fields{"error": err.Error()})
+		return
+	}
+	sum := sha256.Sum256(raw)
+	host, _ := os.Hostname()
+	snapshot := &models.ConfigSnapshot{
+		Checksum:  hex.EncodeToString(sum[:]),
+		Variables: raw,
+		Version:   os.Getenv("VERSION"),
+		Host:      host,
+		LoadedAt:  service.Clock.Now(),
+	}
+	if err = service.ConfigSnapshotRepository.Record(snapshot); err != nil {
+		service.Logger.Error("config snapshot not recorded", infrastructures.Fields{"error": err.Error()})
+		return
+	}
+	service.Logger.Info("config snapshot recorded", infrastructures.Fields{"config_snapshot_id": snapshot.ID, "checksum": snapshot.Checksum, "loads": snapshot.Loads})
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.snapshot, service.variables = snapshot, variables
+}
+
+func (service *ConfigService) Report() (ConfigReport, error) {
+	// the pool reads the database configuration itself, Conf.Db is not filled
+	effective := *service.Config
+	effective.Db = config.GetDbConfig()
+	report := ConfigReport{Effective: config.Redact(effective), Variables: config.Variables(), Diff: []ConfigChange{}}
+
+	service.mu.RLock()
+	snapshot, variables := service.snapshot, service.variables
+	service.mu.RUnlock()
+	if snapshot == nil {
+		return report, nil
+	}
+	report.Snapshot = snapshot
+
+	previous, err := service.ConfigSnapshotRepository.GetPrevious(snapshot.Checksum)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return report, nil
+	}
+	if err != nil {
+		return report, err
+	}
+	var previousVariables []config.Variable
+	if err = json.Unmarshal(previous.Variables, &previousVariables); err != nil {
+		return report, err
+	}
+	report.Previous = &previous
+	// the snapshots are compared, the variables read after the start are not in them
+	report.Diff = diffVariables(previousVariables, variables)
+	return report, nil
+}
+
+// diffVariables lists the variables added, removed or changed from previous to current, by name
+func diffVariables(previous []config.Variable, current []config.Variable) []ConfigChange {
+	changes := map[string]*ConfigChange{}
+	for i := range previous {
+		changes[previous[i].Name] = &ConfigChange{Name: previous[i].Name, Previous: &previous[i]}
+	}
+	for i := range current {
+		change, ok := changes[current[i].Name]
+		if !ok {
+			change = &ConfigChange{Name: current[i].Name}
+			changes[current[i].Name] = change
+		}
+		change.Current = &current[i]
+	}
+	diff := []ConfigChange{}
+	for _, change := range changes {
+		if change.Previous != nil && change.Current != nil && *change.Previous == *change.Current {
+			continue
+		}
+		diff = append(diff, *change)
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Name < diff[j].Name })
+	return diff
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"time"
+
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+var ErrPolicyNotCurrent = problems.Define(problems.PolicyNotCurrent, "only the current version of a policy can be accepted")
+
+type IConsentService interface {
+	GetCurrentPolicies() ([]models.Policy, error)
+	GetPendingPolicies(user models.User) ([]models.Policy, error)
+	Accept(user models.User, policyID uint, ip string) (models.Consent, error)
+}
+
+type ConsentService struct {
+	PolicyRepository repositories.IPolicyRepository
+}
+
+func (service *ConsentService) GetCurrentPolicies() ([]models.Policy, error) {
+	return service.PolicyRepository.GetCurrentPolicies(time.Now())
+}
+
+// GetPendingPolicies returns the current policies the user has not accepted yet
+func (service *ConsentService) GetPendingPolicies(user models.User) (pending []models.Policy, err error) {
+	var current []models.Policy
+	current, err = service.GetCurrentPolicies()
+	if err != nil || len(current) == 0 {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(current))
+	for _, policy := range current {
+		ids = append(ids, policy.ID)
+	}
+
+	var accepted []uint
+	accepted, err = service.PolicyRepository.GetAcceptedPolicyIDs(user.ID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptedSet := make(map[uint]bool, len(accepted))
+	for _, id := range accepted {
+		acceptedSet[id] = true
+	}
+	for _, policy := range current {
+		if !acceptedSet[policy.ID] {
+			pending = append(pending, policy)
+		}
+	}
+	return pending, nil
+}
+
+func (service *ConsentService) Accept(user models.User, policyID uint, ip string) (consent models.Consent, err error) {
+	var current []models.Policy
+	current, err = service.GetCurrentPolicies()
+	if err != nil {
+		return consent, err
+	}
+
+	for _, policy := range current {
+		if policy.ID == policyID {
+			consent = models.Consent{
+				UserID:     user.ID,
+				PolicyID:   policy.ID,
+				Ip:         ip,
+				AcceptedAt: time.Now(),
+			}
+			err = service.PolicyRepository.CreateConsent(&consent)
+			consent.Policy = policy
+			return consent, err
+		}
+	}
+	return consent, ErrPolicyNotCurrent
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/utils"
+)
+
+// published on the events bus with a MessageEvent and written to the sockets of the participants with the message
+const EventMessageCreated = "message.created"
+
+var (
+	ErrConversationNotFound          = problems.Define(problems.ConversationNotFound, "conversation could not be found")
+	ErrConversationInvalidRecipients = problems.Define(problems.ConversationInvalidRecipients, "the recipients could not be found")
+)
+
+// MessageEvent is published when a message is sent, the recipients are the participants but the sender
+type MessageEvent struct {
+	Message      models.Message `json:"message"`
+	RecipientIDs []uint         `json:"recipient_ids"`
+}
+
+// UnreadCounters are the unread messages of the user and the conversations they are in
+type UnreadCounters struct {
+	Messages       int64                       `json:"messages"`
+	Conversations  int64                       `json:"conversations"`
+	ByConversation []models.ConversationUnread `json:"by_conversation"`
+}
+
+type IConversationService interface {
+	// Start opens a conversation of the user with the recipients and sends the first message when the body is set,
+	// starting a conversation with a single recipient again returns the same direct conversation
+	Start(user models.User, recipientIDs []uint, body string) (models.Conversation, error)
+	Conversations(user models.User, pagination utils.IPagination) (conversations []models.Conversation, totalCount int64, err error)
+	// Messages returns up to limit messages sent before the message, the latest first
+	Messages(user models.User, conversationID uint, beforeID uint, limit int) ([]models.Message, error)
+	Send(user models.User, conversationID uint, body string) (models.Message, error)
+	// Read marks the messages read up to the message, up to the latest one when messageID is 0
+	Read(user models.User, conversationID uint, messageID uint) (UnreadCounters, error)
+	Unread(user models.User) (UnreadCounters, error)
+}
+
+type ConversationService struct {
+	ConversationRepository repositories.IConversationRepository
+	UserRepository         repositories.IUserRepository
+	SettingService         ISettingService
+	Hub                    infrastructures.IHub
+	Events                 infrastructures.IEventBus
+}
+
+func (service *ConversationService) Start(user models.User, recipientIDs []uint, body string) (conversation models.Conversation, err error) {
+	userIDs := []uint{user.ID}
+	for _, recipientID := range recipientIDs {
+		if !helpers.InArray(recipientID, userIDs) {
+			userIDs = append(userIDs, recipientID)
+		}
+	}
+	if len(userIDs) < 2 {
+		return conversation, ErrConversationInvalidRecipients
+	}
+	count, err := service.UserRepository.Count(func(db *gorm.DB) *gorm.DB {
+		return db.Where("id IN ?", userIDs)
+	})
+	if err != nil {
+		return conversation, err
+	}
+	if count != int64(len(userIDs)) {
+		return conversation, ErrConversationInvalidRecipients
+	}
+
+	conversation = models.Conversation{CreatedBy: user.ID}
+	if len(userIDs) == 2 {
+		sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+		key := fmt.Sprintf("%d:%d", userIDs[0], userIDs[1])
+		conversation.Direct, conversation.DirectKey = true, &key
+	}
+	if err = service.ConversationRepository.CreateConversation(&conversation, userIDs); err != nil {
+		return conversation, err
+	}
+	if body != "" {
+		if _, err = service.Send(user, conversation.ID, body); err != nil {
+			return conversation, err
+		}
+	}
+	return service.load(user, conversation)
+}
+
+func (service *ConversationService) Conversations(user models.User, pagination utils.IPagination) (conversations []models.Conversation, totalCount int64, err error) {
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && pagination.GetLimit() > max {
+		pagination.Get().Limit = max
+	}
+	conversations, totalCount, err = service.ConversationRepository.GetUserConversations(user.ID, &scopes.GormPagination{Pagination: pagination.Get()})
+	if err != nil || len(conversations) == 0 {
+		return conversations, totalCount, err
+	}
+	return conversations, totalCount, service.fill(user, conversations)
+}
+
+func (service *ConversationService) Messages(user models.User, conversationID uint, beforeID uint, limit int) ([]models.Message, error) {
+	if _, err := service.participant(user, conversationID); err != nil {
+		return nil, err
+	}
+	return service.ConversationRepository.GetMessages(conversationID, beforeID, limit)
+}
+
+func (service *ConversationService) Send(user models.User, conversationID uint, body string) (message models.Message, err error) {
+	if _, err = service.participant(user, conversationID); err != nil {
+		return message, err
+	}
+	message = models.Message{ConversationID: conversationID, UserID: user.ID, Body: body}
+	if err = service.ConversationRepository.CreateMessage(&message); err != nil {
+		return message, err
+	}
+
+	participants, err := service.ConversationRepository.GetParticipants(conversationID)
+	if err != nil {
+		return message, err
+	}
+	recipientIDs := make([]uint, 0, len(participants))
+	for _, participant := range participants {
+		// the sender gets it too, on the sockets of the other devices
+		service.Hub.Send(participant.UserID, infrastructures.HubMessage{Type: EventMessageCreated, Data: message})
+		if participant.UserID != user.ID {
+			recipientIDs = append(recipientIDs, participant.UserID)
+		}
+	}
+	service.Events.Publish(EventMessageCreated, MessageEvent{Message: message, RecipientIDs: recipientIDs})
+	return message, nil
+}
+
+func (service *ConversationService) Read(user models.User, conversationID uint, messageID uint) (counters UnreadCounters, err error) {
+	if _, err = service.participant(user, conversationID); err != nil {
+		return counters, err
+	}
+	if messageID == 0 {
+		if messageID, err = service.ConversationRepository.GetLatestMessageID(conversationID); err != nil {
+			return counters, err
+		}
+	}
+	if err = service.ConversationRepository.MarkRead(conversationID, user.ID, messageID); err != nil {
+		return counters, err
+	}
+	return service.Unread(user)
+}
+
+func (service *ConversationService) Unread(user models.User) (counters UnreadCounters, err error) {
+	if counters.ByConversation, err = service.ConversationRepository.GetUnreadCounts(user.ID); err != nil {
+		return counters, err
+	}
+	for _, unread := range counters.ByConversation {
+		counters.Messages += unread.Count
+	}
+	counters.Conversations = int64(len(counters.ByConversation))
+	return counters, nil
+}
+
+// participant returns the participation of the user, a conversation of others is not found so its existence does not leak
+func (service *ConversationService) participant(user models.User, conversationID uint) (participant models.ConversationParticipant, err error) {
+	participant, err = service.ConversationRepository.GetParticipant(conversationID, user.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return participant, ErrConversationNotFound
+	}
+	return participant, err
+}
+
+func (service *ConversationService) load(user models.User, conversation models.Conversation) (models.Conversation, error) {
+	conversations := []models.Conversation{conversation}
+	err := service.fill(user, conversations)
+	return conversations[0], err
+}
+
+// fill sets the participants and the unread counts of the user on the conversations
+func (service *ConversationService) fill(user models.User, conversations []models.Conversation) error {
+	IDs := make([]uint, 0, len(conversations))
+	for _, conversation := range conversations {
+		IDs = append(IDs, conversation.ID)
+	}
+	participants, err := service.ConversationRepository.GetParticipants(IDs...)
+	if err != nil {
+		return err
+	}
+	unread, err := service.ConversationRepository.GetUnreadCounts(user.ID, IDs...)
+	if err != nil {
+		return err
+	}
+	for i := range conversations {
+		for _, participant := range participants {
+			if participant.ConversationID == conversations[i].ID {
+				conversations[i].Participants = append(conversations[i].Participants, participant)
+			}
+		}
+		for _, count := range unread {
+			if count.ConversationID == conversations[i].ID {
+				conversations[i].UnreadCount = count.Count
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+var (
+	ErrCouponNotFound      = problems.Define(problems.CouponNotFound, "coupon could not be found")
+	ErrCouponInvalid       = problems.Define(problems.CouponInvalid, "the coupon is invalid, expired or used up")
+	ErrCouponNotApplicable = problems.Define(problems.CouponNotApplicable, "the coupon does not apply to this plan")
+	ErrCouponCodeTaken     = problems.Define(problems.CouponCodeTaken, "the coupon code is already used")
+)
+
+// CouponInput is a new coupon, PercentOff for a percentage coupon and AmountOff for a fixed one
+type CouponInput struct {
+	Code           string
+	PercentOff     int
+	AmountOff      *helpers.Money
+	PlanID         *uint
+	MaxRedemptions int
+	MaxPerUser     int
+	ExpiresAt      *time.Time
+}
+
+// CouponQuote is the price of a plan with a coupon
+type CouponQuote struct {
+	Coupon   models.Coupon `json:"coupon"`
+	Plan     string        `json:"plan"`
+	Price    helpers.Money `json:"price"`
+	Discount helpers.Money `json:"discount"`
+	Total    helpers.Money `json:"total"`
+}
+
+type ICouponService interface {
+	GetCoupons() ([]models.Coupon, error)
+	// Create creates the coupon at the payment provider too, its discount can not be changed afterwards
+	Create(ctx context.Context, admin models.User, input CouponInput) (models.Coupon, error)
+	// Update changes the limits of a coupon, the keys are max_redemptions, max_per_user, expires_at and active
+	Update(ID uint, updates map[string]interface{}) (models.Coupon, error)
+	// Deactivate stops the coupon, its redemptions are kept
+	Deactivate(ID uint) (models.Coupon, error)
+	// Quote checks the coupon for the user and the plan and returns the discounted price
+	Quote(user models.User, code string, plan models.Plan) (CouponQuote, error)
+	// Redeem records the use of the coupon by the new subscription of the user
+	Redeem(code string, userID uint, subscriptionID string) error
+}
+
+type CouponService struct {
+	CouponRepository repositories.ICouponRepository
+	Gateway          infrastructures.IPaymentGateway
+	Logger           infrastructures.ILogger
+	Clock            infrastructures.IClock
+}
+
+func (service *CouponService) GetCoupons() ([]models.Coupon, error) {
+	return service.CouponRepository.GetCoupons()
+}
+
+func (service *CouponService) Create(ctx context.Context, admin models.User, input CouponInput) (coupon models.Coupon, err error) {
+	coupon = models.Coupon{
+		Code:           strings.ToUpper(strings.TrimSpace(input.Code)),
+		Type:           models.CouponPercentage,
+		PercentOff:     input.PercentOff,
+		PlanID:         input.PlanID,
+		MaxRedemptions: input.MaxRedemptions,
+		MaxPerUser:     input.MaxPerUser,
+		ExpiresAt:      input.ExpiresAt,
+		Active:         true,
+		CreatedBy:      admin.ID,
+	}
+	if _, err = service.CouponRepository.GetCouponByCode(coupon.Code); err == nil {
+		return coupon, ErrCouponCodeTaken
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return coupon, err
+	}
+
+	paymentCoupon := infrastructures.PaymentCoupon{Code: coupon.Code, PercentOff: input.PercentOff}
+	if input.AmountOff != nil {
+		coupon.Type, coupon.PercentOff, coupon.AmountOff = models.CouponFixed, 0, input.AmountOff
+		paymentCoupon = infrastructures.PaymentCoupon{Code: coupon.Code, AmountOff: input.AmountOff.Amount, Currency: input.AmountOff.Currency}
+	}
+	if coupon.ExternalID, err = service.Gateway.CreateCoupon(ctx, paymentCoupon); err != nil {
+		return coupon, err
+	}
+	if err = service.CouponRepository.Create(&coupon); err != nil {
+		return coupon, err
+	}
+	service.Logger.Info("coupon created", infrastructures.Fields{"audit": true, "admin_id": admin.ID, "coupon": coupon.Code})
+	return coupon, nil
+}
+
+func (service *CouponService) Update(ID uint, updates map[string]interface{}) (coupon models.Coupon, err error) {
+	if coupon, err = service.get(ID); err != nil {
+		return coupon, err
+	}
+	if len(updates) == 0 {
+		return coupon, nil
+	}
+	if err = service.CouponRepository.Updates(&coupon, updates); err != nil {
+		return coupon, err
+	}
+	return service.get(ID)
+}
+
+func (service *CouponService) Deactivate(ID uint) (coupon models.Coupon, err error) {
+	if coupon, err = service.get(ID); err != nil {
+		return coupon, err
+	}
+	if !coupon.Active {
+		return coupon, nil
+	}
+	err = service.CouponRepository.Updates(&coupon, map[string]interface{}{"active": false})
+	coupon.Active = false
+	return coupon, err
+}
+
+func (service *CouponService) Quote(user models.User, code string, plan models.Plan) (quote CouponQuote, err error) {
+	var coupon models.Coupon
+	if coupon, err = service.CouponRepository.GetCouponByCode(code); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return quote, ErrCouponInvalid
+		}
+		return quote, err
+	}
+	if !coupon.IsUsable(service.Clock.Now()) {
+		return quote, ErrCouponInvalid
+	}
+	if coupon.MaxPerUser > 0 {
+		var count int64
+		if count, err = service.CouponRepository.CountRedemptions(coupon.ID, user.ID); err != nil {
+			return quote, err
+		}
+		if count >= int64(coupon.MaxPerUser) {
+			return quote, ErrCouponInvalid
+		}
+	}
+	if plan.IsFree() || (coupon.PlanID != nil && *coupon.PlanID != plan.ID) {
+		return quote, ErrCouponNotApplicable
+	}
+
+	quote = CouponQuote{Coupon: coupon, Plan: plan.Slug, Price: plan.Price}
+	if quote.Discount, err = coupon.Discount(plan.Price); err != nil {
+		if errors.Is(err, helpers.ErrCurrencyMismatch) {
+			return quote, ErrCouponNotApplicable
+		}
+		return quote, err
+	}
+	quote.Total, err = plan.Price.Sub(quote.Discount)
+	return quote, err
+}
+
+func (service *CouponService) Redeem(code string, userID uint, subscriptionID string) error {
+	coupon, err := service.CouponRepository.GetCouponByCode(code)
+	if err != nil {
+		return err
+	}
+	err = service.CouponRepository.Redeem(&coupon, &models.CouponRedemption{
+		UserID:         userID,
+		SubscriptionID: subscriptionID,
+		RedeemedAt:     service.Clock.Now(),
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// the provider already applied the discount, the redemption is only over the limit
+		service.Logger.Warn("coupon redeemed over its limit", infrastructures.Fields{"coupon": coupon.Code, "user_id": userID})
+		return nil
+	}
+	return err
+}
+
+func (service *CouponService) get(ID uint) (coupon models.Coupon, err error) {
+	coupon, err = service.CouponRepository.GetCouponByID(ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return coupon, ErrCouponNotFound
+	}
+	return coupon, err
+}
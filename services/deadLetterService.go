@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/utils"
+)
+
+// DeadLetterSourceBilling is the source of the webhooks of the payment provider
+const DeadLetterSourceBilling = "billing"
+
+var (
+	ErrDeadLetterNotFound      = problems.Define(problems.DeadLetterNotFound, "the dead letter could not be found")
+	ErrDeadLetterReplayFailed  = problems.Define(problems.DeadLetterReplayFailed, "the replay failed again, the error of the dead letter is updated")
+	ErrDeadLetterNotReplayable = problems.Define(problems.DeadLetterNotReplayable, "nothing replays the dead letters of this source")
+)
+
+// WebhookReplayer applies again the payload of a webhook dead letter, without the checks of its delivery
+type WebhookReplayer func(ctx context.Context, payload []byte) error
+
+// DeadLetterReplay is the outcome of the replay of a dead letter of a bulk, the dead letter or the error of its replay
+type DeadLetterReplay struct {
+	DeadLetter models.DeadLetter
+	Err        error
+}
+
+type IDeadLetterService interface {
+	// RegisterReplayer sets how the webhooks of the source are replayed, the jobs are pushed again to their queue
+	RegisterReplayer(source string, replayer WebhookReplayer)
+	// Guard wraps the handler of a queue, the job failing its last attempt is stored as a dead letter
+	Guard(handler infrastructures.JobHandler) infrastructures.JobHandler
+	// RecordWebhook stores the webhook of the event the source could not apply, a failed delivery of a stored event
+	// updates it
+	RecordWebhook(source string, eventID string, payload []byte, failure error)
+	// ResolveWebhook deletes the dead letter of the event once a delivery of the source applies it
+	ResolveWebhook(eventID string)
+
+	List(filter repositories.DeadLetterFilter, pagination utils.IPagination) (letters []models.DeadLetter, totalCount int64, err error)
+	Get(ID uint) (models.DeadLetter, error)
+	// Replay runs the dead letter again and deletes it, ErrDeadLetterReplayFailed when it fails again
+	Replay(ctx context.Context, ID uint) (models.DeadLetter, error)
+	// ReplayMany replays the dead letters of the ids, or up to limit of the oldest matching the filter without ids. A
+	// result for each dead letter in their order
+	ReplayMany(ctx context.Context, ids []uint, filter repositories.DeadLetterFilter, limit int) ([]DeadLetterReplay, error)
+	Delete(ID uint) error
+	// Purge deletes the dead letters matching the filter without replaying them
+	Purge(filter repositories.DeadLetterFilter) (int64, error)
+	// Count sets the dead_letters gauge of each source, scheduled
+	Count() error
+}
+
+type DeadLetterService struct {
+	DeadLetterRepository repositories.IDeadLetterRepository
+	SettingService       ISettingService
+	Queue                infrastructures.IQueue
+	Metrics              infrastructures.IMetrics
+	Logger               infrastructures.ILogger
+	Config               *config.Queue
+
+	replayers map[string]WebhookReplayer
+	// the sources counted last, a source without dead letters anymore is set to 0
+	counted map[repositories.DeadLetterCount]bool
+	mu      sync.RWMutex
+}
+
+func (service *DeadLetterService) RegisterReplayer(source string, replayer WebhookReplayer) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if service.replayers == nil {
+		service.replayers = map[string]WebhookReplayer{}
+	}
+	service.replayers[source] = replayer
+}
+
+func (service *DeadLetterService) Guard(handler infrastructures.JobHandler) infrastructures.JobHandler {
+	return func(job infrastructures.Job) (err error) {
+		// the queue recovers the panics of the handler, the last one is a dead letter too
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panicked: %v", r)
+			}
+			if err != nil && job.Attempts >= service.Config.MaxAttempts {
+				service.record(models.DeadLetter{Kind: models.DeadLetterJob, Source: job.Queue, Payload: string(job.Payload), Attempts: job.Attempts}, err)
+			}
+		}()
+		return handler(job)
+	}
+}
+
+func (service *DeadLetterService) RecordWebhook(source string, eventID string, payload []byte, failure error) {
+	service.record(models.DeadLetter{Kind: models.DeadLetterWebhook, Source: source, EventID: &eventID, Payload: string(payload), Attempts: 1}, failure)
+}
+
+func (service *DeadLetterService) ResolveWebhook(eventID string) {
+	if err := service.DeadLetterRepository.DeleteByEventID(models.DeadLetterWebhook, eventID); err != nil {
+		service.Logger.Error("dead letter not resolved", infrastructures.Fields{"event_id": eventID, "error": err.Error()})
+	}
+}
+
+// record logs the dead letter it could not store, its payload is lost then
+func (service *DeadLetterService) record(letter models.DeadLetter, failure error) {
+	letter.Error = truncateError(failure)
+	fields := infrastructures.Fields{"kind": letter.Kind, "source": letter.Source, "attempts": letter.Attempts, "error": letter.Error}
+	if err := service.DeadLetterRepository.Record(&letter); err != nil {
+		fields["record_error"] = err.Error()
+		service.Logger.Error("dead letter lost", fields)
+		return
+	}
+	service.Metrics.Inc("dead_letters_total", map[string]string{"kind": letter.Kind, "source": letter.Source}, 1)
+	service.Logger.Warn("dead letter", fields)
+}
+
+func (service *DeadLetterService) List(filter repositories.DeadLetterFilter, pagination utils.IPagination) (letters []models.DeadLetter, totalCount int64, err error) {
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && pagination.GetLimit() > max {
+		pagination.Get().Limit = max
+	}
+	return service.DeadLetterRepository.GetDeadLetters(filter, &scopes.GormPagination{Pagination: pagination.Get()})
+}
+
+func (service *DeadLetterService) Get(ID uint) (letter models.DeadLetter, err error) {
+	letter, err = service.DeadLetterRepository.GetDeadLetterByID(ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return letter, ErrDeadLetterNotFound
+	}
+	return letter, err
+}
+
+func (service *DeadLetterService) Replay(ctx context.Context, ID uint) (models.DeadLetter, error) {
+	letter, err := service.Get(ID)
+	if err != nil {
+		return letter, err
+	}
+	return letter, service.replay(ctx, &letter)
+}
+
+func (service *DeadLetterService) ReplayMany(ctx context.Context, ids []uint, filter repositories.DeadLetterFilter, limit int) ([]DeadLetterReplay, error) {
+	if len(ids) == 0 {
+		letters, err := service.DeadLetterRepository.GetOldestDeadLetters(filter, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, letter := range letters {
+			ids = append(ids, letter.ID)
+		}
+	}
+	// the replays are not undone, each one has its result whatever its error
+	results := make([]DeadLetterReplay, len(ids))
+	for i, id := range ids {
+		letter, err := service.Replay(ctx, id)
+		letter.ID = id
+		results[i] = DeadLetterReplay{DeadLetter: letter, Err: err}
+	}
+	return results, nil
+}
+
+// replay deletes the dead letter replayed, a failed replay updates its error and its replays
+func (service *DeadLetterService) replay(ctx context.Context, letter *models.DeadLetter) error {
+	var err error
+	switch letter.Kind {
+	case models.DeadLetterJob:
+		// the job starts over with its attempts, failing them all it is a dead letter again
+		err = service.Queue.Push(ctx, letter.Source, []byte(letter.Payload))
+	case models.DeadLetterWebhook:
+		service.mu.RLock()
+		replayer, ok := service.replayers[letter.Source]
+		service.mu.RUnlock()
+		if !ok {
+			return ErrDeadLetterNotReplayable
+		}
+		err = replayer(ctx, []byte(letter.Payload))
+	default:
+		return ErrDeadLetterNotReplayable
+	}
+	labels := map[string]string{"kind": letter.Kind, "source": letter.Source, "result": "succeeded"}
+	if err != nil {
+		labels["result"] = "failed"
+		service.Metrics.Inc("dead_letters_replayed_total", labels, 1)
+		service.Logger.Warn("dead letter replay failed", infrastructures.Fields{"dead_letter_id": letter.ID, "kind": letter.Kind, "source": letter.Source, "error": err.Error()})
+		letter.Error = truncateError(err)
+		letter.Replays++
+		if err = service.DeadLetterRepository.Save(letter); err != nil {
+			return err
+		}
+		return ErrDeadLetterReplayFailed
+	}
+	service.Metrics.Inc("dead_letters_replayed_total", labels, 1)
+	service.Logger.Info("dead letter replayed", infrastructures.Fields{"audit": true, "dead_letter_id": letter.ID, "kind": letter.Kind, "source": letter.Source})
+	return service.DeadLetterRepository.Delete(letter)
+}
+
+func (service *DeadLetterService) Delete(ID uint) error {
+	letter, err := service.Get(ID)
+	if err != nil {
+		return err
+	}
+	if err = service.DeadLetterRepository.Delete(&letter); err != nil {
+		return err
+	}
+	service.Metrics.Inc("dead_letters_purged_total", map[string]string{"kind": letter.Kind, "source": letter.Source}, 1)
+	return nil
+}
+
+func (service *DeadLetterService) Purge(filter repositories.DeadLetterFilter) (int64, error) {
+	deleted, err := service.DeadLetterRepository.Purge(filter)
+	if err != nil {
+		return deleted, err
+	}
+	service.Metrics.Inc("dead_letters_purged_total", map[string]string{"kind": filter.Kind, "source": filter.Source}, float64(deleted))
+	service.Logger.Info("dead letters purged", infrastructures.Fields{"audit": true, "kind": filter.Kind, "source": filter.Source, "deleted": deleted})
+	return deleted, nil
+}
+
+func (service *DeadLetterService) Count() error {
+	counts, err := service.DeadLetterRepository.CountBySource()
+	if err != nil {
+		return err
+	}
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	counted := map[repositories.DeadLetterCount]bool{}
+	for _, count := range counts {
+		service.Metrics.Set("dead_letters", map[string]string{"kind": count.Kind, "source": count.Source}, float64(count.Count))
+		counted[repositories.DeadLetterCount{Kind: count.Kind, Source: count.Source}] = true
+	}
+	for source := range service.counted {
+		if !counted[source] {
+			service.Metrics.Set("dead_letters", map[string]string{"kind": source.Kind, "source": source.Source}, 0)
+		}
+	}
+	service.counted = counted
+	return nil
+}
+
+// truncateError fits the error in the error column of a dead letter
+func truncateError(err error) string {
+	message := err.Error()
+	if len(message) > 1000 {
+		message = message[:1000]
+	}
+	return message
+}
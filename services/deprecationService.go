@@ -0,0 +1,129 @@
+package services
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/repositories"
+)
+
+// Deprecation marks a route deprecated, its callers are told with the Deprecation, Sunset and Link headers
+type Deprecation struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Since is when the route was deprecated
+	Since time.Time `json:"deprecated_at"`
+	// Sunset is when the route is removed, nil when it is not planned yet
+	Sunset *time.Time `json:"sunset"`
+	// Link is the documentation of the deprecation or the route replacing it
+	Link string `json:"link"`
+}
+
+// Route is the key of the route of the deprecation, "GET /v1/restricted/users/:user"
+func (d Deprecation) Route() string {
+	return d.Method + " " + d.Path
+}
+
+// DeprecationReport is a deprecated route with its callers
+type DeprecationReport struct {
+	Deprecation
+	Calls   int64                   `json:"calls"`
+	Callers []models.DeprecatedCall `json:"callers"`
+}
+
+type IDeprecationService interface {
+	// Register marks the route of the deprecation deprecated, it is called with the routes being registered
+	Register(deprecation Deprecation)
+	// Get returns the deprecation of the route, false when it is not deprecated
+	Get(method string, path string) (Deprecation, bool)
+	// Record counts a call of the caller to the deprecated route, a failure is only logged
+	Record(deprecation Deprecation, caller string)
+	// Report lists the deprecated routes with the callers calling them since, the closest sunset first
+	Report(since time.Time) ([]DeprecationReport, error)
+}
+
+type DeprecationService struct {
+	DeprecationRepository repositories.IDeprecationRepository
+	Metrics               infrastructures.IMetrics
+	Logger                infrastructures.ILogger
+
+	deprecations map[string]Deprecation
+	mu           sync.RWMutex
+}
+
+func (service *DeprecationService) Register(deprecation Deprecation) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if service.deprecations == nil {
+		service.deprecations = map[string]Deprecation{}
+	}
+	service.deprecations[deprecation.Route()] = deprecation
+}
+
+func (service *DeprecationService) Get(method string, path string) (Deprecation, bool) {
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+	deprecation, ok := service.deprecations[method+" "+path]
+	return deprecation, ok
+}
+
+func (service *DeprecationService) Record(deprecation Deprecation, caller string) {
+	fields := infrastructures.Fields{"route": deprecation.Route(), "caller": caller}
+	if deprecation.Sunset != nil {
+		fields["sunset"] = deprecation.Sunset.Format("2006-01-02")
+	}
+	service.Logger.Info("deprecated route called", fields)
+	service.Metrics.Inc("deprecated_requests_total", map[string]string{"route": deprecation.Route()}, 1)
+	if err := service.DeprecationRepository.Increment(deprecation.Route(), caller, time.Now()); err != nil {
+		fields["error"] = err.Error()
+		service.Logger.Error("deprecated call not recorded", fields)
+	}
+}
+
+func (service *DeprecationService) Report(since time.Time) ([]DeprecationReport, error) {
+	service.mu.RLock()
+	reports := make([]DeprecationReport, 0, len(service.deprecations))
+	routes := make([]string, 0, len(service.deprecations))
+	for route, deprecation := range service.deprecations {
+		reports = append(reports, DeprecationReport{Deprecation: deprecation, Callers: []models.DeprecatedCall{}})
+		routes = append(routes, route)
+	}
+	service.mu.RUnlock()
+	if len(reports) == 0 {
+		return reports, nil
+	}
+
+	calls, err := service.DeprecationRepository.GetCalls(routes, since)
+	if err != nil {
+		return nil, err
+	}
+	byRoute := map[string][]models.DeprecatedCall{}
+	for _, call := range calls {
+		byRoute[call.Route] = append(byRoute[call.Route], call)
+	}
+	for i := range reports {
+		for _, call := range byRoute[reports[i].Route()] {
+			reports[i].Calls += call.Calls
+			reports[i].Callers = append(reports[i].Callers, call)
+		}
+	}
+
+	// the routes without a sunset go last
+	sort.Slice(reports, func(i, j int) bool {
+		a, b := reports[i].Sunset, reports[j].Sunset
+		if a == nil || b == nil {
+			if a == nil && b == nil {
+				return reports[i].Route() < reports[j].Route()
+			}
+			return b == nil
+		}
+		if !a.Equal(*b) {
+			return a.Before(*b)
+		}
+		return reports[i].Route() < reports[j].Route()
+	})
+	return reports, nil
+}
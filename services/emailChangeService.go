@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/mails"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/repositories/transactions"
+)
+
+var (
+	ErrPasswordMismatch   = problems.Define(problems.InvalidCredentials, "the current password is incorrect")
+	ErrEmailUnchanged     = problems.Define(problems.EmailUnchanged, "the new email is the current email")
+	ErrEmailChangeInvalid = problems.Define(problems.EmailChangeInvalid, "the confirmation link is invalid or expired")
+)
+
+type IEmailChangeService interface {
+	// Request sends a confirmation link to the new address and a notice to the current one, the email is not changed yet
+	Request(user models.User, currentPassword string, newEmail string) (models.EmailChange, error)
+	// Confirm applies the change of the token, the new address counts as verified since the link was opened from it
+	Confirm(ctx context.Context, token string) (models.User, error)
+}
+
+type EmailChangeService struct {
+	UserRepository        repositories.IUserRepository
+	EmailChangeRepository repositories.IEmailChangeRepository
+	UnitOfWork            transactions.IUnitOfWork
+	Email                 infrastructures.IEmailService
+	ConfirmationMail      mails.IMailRenderer
+	NoticeMail            mails.IMailRenderer
+	Cache                 infrastructures.ICache
+	Logger                infrastructures.ILogger
+	Events                infrastructures.IEventBus
+	Config                *config.EmailChange
+	Clock                 infrastructures.IClock
+	Random                infrastructures.IRandom
+}
+
+func (service *EmailChangeService) Request(user models.User, currentPassword string, newEmail string) (emailChange models.EmailChange, err error) {
+	if !user.VerifyPassword(currentPassword) {
+		return emailChange, ErrPasswordMismatch
+	}
+	newEmail = strings.TrimSpace(newEmail)
+	if strings.EqualFold(newEmail, user.Email) {
+		return emailChange, ErrEmailUnchanged
+	}
+	if err = service.ensureEmailFree(service.UserRepository, newEmail); err != nil {
+		return emailChange, err
+	}
+
+	var token string
+	if token, err = service.Random.Token(32); err != nil {
+		return emailChange, err
+	}
+	emailChange = models.EmailChange{
+		UserID:    user.ID,
+		NewEmail:  newEmail,
+		TokenHash: hashEmailChangeToken(token),
+		ExpiresAt: service.Clock.Now().Add(service.Config.TTL),
+	}
+	if err = service.EmailChangeRepository.DeletePending(user.ID); err != nil {
+		return emailChange, err
+	}
+	if err = service.EmailChangeRepository.Create(&emailChange); err != nil {
+		return emailChange, err
+	}
+
+	if err = service.send(service.ConfirmationMail, []string{newEmail}, map[string]interface{}{
+		"url":        service.confirmUrl(token),
+		"email":      newEmail,
+		"expires_at": emailChange.ExpiresAt.UTC().Format(time.RFC1123),
+	}); err != nil {
+		return emailChange, err
+	}
+	// the change is already requested, a lost notice must not make the user request it again
+	if err := service.send(service.NoticeMail, []string{user.Email}, map[string]interface{}{"email": newEmail}); err != nil {
+		service.Logger.Error("email change notice could not be sent", infrastructures.Fields{"user_id": user.ID, "error": err.Error()})
+	}
+
+	service.Logger.Info("email change requested", infrastructures.Fields{"audit": true, "user_id": user.ID, "email_change_id": emailChange.ID})
+	return emailChange, nil
+}
+
+func (service *EmailChangeService) Confirm(ctx context.Context, token string) (user models.User, err error) {
+	err = service.UnitOfWork.WithinTransaction(ctx, func(repos transactions.RepoSet) error {
+		emailChange, err := repos.EmailChanges.GetEmailChangeByTokenHash(hashEmailChangeToken(token))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrEmailChangeInvalid
+			}
+			return err
+		}
+		now := service.Clock.Now()
+		if !emailChange.IsPending(now) {
+			return ErrEmailChangeInvalid
+		}
+		if user, err = repos.Users.GetUserByID(emailChange.UserID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrEmailChangeInvalid
+			}
+			return err
+		}
+		// the address may have been registered since the request
+		if err = service.ensureEmailFree(repos.Users, emailChange.NewEmail); err != nil {
+			return err
+		}
+		if err = repos.EmailChanges.Confirm(&emailChange, now); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrEmailChangeInvalid
+			}
+			return err
+		}
+
+		user.Email, user.Verified, user.VerificationToken = emailChange.NewEmail, true, nil
+		return repos.Users.Updates(&user, map[string]interface{}{
+			"email":              user.Email,
+			"verified":           true,
+			"verification_token": nil,
+		})
+	})
+	if err != nil {
+		return user, err
+	}
+	invalidateCache(service.Cache, CacheTagUsers)
+	service.Events.Publish(EventUserUpdated, UserEvent{UserID: user.ID})
+	service.Logger.Info("email changed", infrastructures.Fields{"audit": true, "user_id": user.ID})
+	return user, nil
+}
+
+func (service *EmailChangeService) ensureEmailFree(repository repositories.IUserRepository, email string) error {
+	if _, err := repository.GetUserByEmail(email); err == nil {
+		return ErrEmailTaken
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (service *EmailChangeService) send(renderer mails.IMailRenderer, to []string, data map[string]interface{}) error {
+	mail, err := renderer.Render(data, to)
+	if err != nil {
+		return err
+	}
+	return service.Email.Send(mail)
+}
+
+func (service *EmailChangeService) confirmUrl(token string) string {
+	return service.Config.ConfirmUrl + "?token=" + url.QueryEscape(token)
+}
+
+// hashEmailChangeToken only the hash of a token is stored, a leaked table can not confirm changes
+func hashEmailChangeToken(token string) string {
+	return helpers.ComputeHmacSha1(token, config.Conf.SecretKey)
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/utils"
+)
+
+// events of the social graph, their payload is a FollowEvent
+const (
+	EventFollowCreated = "follow.created"
+	EventFollowDeleted = "follow.deleted"
+)
+
+var ErrNotFollowable = problems.Define(problems.NotFollowable, "you can not follow yourself")
+
+// FollowEvent is published when a user follows or unfollows another, Mutual tells if the followed user follows back
+type FollowEvent struct {
+	FollowerID uint `json:"follower_id"`
+	FollowedID uint `json:"followed_id"`
+	Mutual     bool `json:"mutual"`
+}
+
+// Relationship is the follow state between the viewer and a user with the counters of the user
+type Relationship struct {
+	Following  bool  `json:"following"`
+	FollowedBy bool  `json:"followed_by"`
+	Mutual     bool  `json:"mutual"`
+	Followers  int64 `json:"followers"`
+	Followings int64 `json:"followings"`
+}
+
+type IFollowService interface {
+	Follow(user models.User, userID uint) (Relationship, error)
+	Unfollow(user models.User, userID uint) (Relationship, error)
+	Relationship(user models.User, userID uint) (Relationship, error)
+	Followers(userID uint, pagination utils.IPagination) (users []models.User, totalCount int64, err error)
+	Following(userID uint, pagination utils.IPagination) (users []models.User, totalCount int64, err error)
+}
+
+type FollowService struct {
+	FollowRepository repositories.IFollowRepository
+	UserRepository   repositories.IUserRepository
+	SettingService   ISettingService
+	Events           infrastructures.IEventBus
+}
+
+func (service *FollowService) Follow(user models.User, userID uint) (relationship Relationship, err error) {
+	if user.ID == userID {
+		return relationship, ErrNotFollowable
+	}
+	if err = service.exists(userID); err != nil {
+		return relationship, err
+	}
+	created, err := service.FollowRepository.Follow(user.ID, userID)
+	if err != nil {
+		return relationship, err
+	}
+	if relationship, err = service.Relationship(user, userID); err != nil {
+		return relationship, err
+	}
+	if created {
+		service.Events.Publish(EventFollowCreated, FollowEvent{FollowerID: user.ID, FollowedID: userID, Mutual: relationship.Mutual})
+	}
+	return relationship, nil
+}
+
+func (service *FollowService) Unfollow(user models.User, userID uint) (relationship Relationship, err error) {
+	if err = service.exists(userID); err != nil {
+		return relationship, err
+	}
+	deleted, err := service.FollowRepository.Unfollow(user.ID, userID)
+	if err != nil {
+		return relationship, err
+	}
+	if relationship, err = service.Relationship(user, userID); err != nil {
+		return relationship, err
+	}
+	if deleted {
+		service.Events.Publish(EventFollowDeleted, FollowEvent{FollowerID: user.ID, FollowedID: userID, Mutual: false})
+	}
+	return relationship, nil
+}
+
+func (service *FollowService) Relationship(user models.User, userID uint) (relationship Relationship, err error) {
+	if relationship.Following, err = service.FollowRepository.IsFollowing(user.ID, userID); err != nil {
+		return
+	}
+	if relationship.FollowedBy, err = service.FollowRepository.IsFollowing(userID, user.ID); err != nil {
+		return
+	}
+	relationship.Mutual = relationship.Following && relationship.FollowedBy
+	if relationship.Followers, err = service.FollowRepository.CountFollowers(userID); err != nil {
+		return
+	}
+	relationship.Followings, err = service.FollowRepository.CountFollowing(userID)
+	return
+}
+
+func (service *FollowService) Followers(userID uint, pagination utils.IPagination) (users []models.User, totalCount int64, err error) {
+	if err = service.exists(userID); err != nil {
+		return nil, 0, err
+	}
+	return service.FollowRepository.GetFollowers(userID, service.pager(pagination))
+}
+
+func (service *FollowService) Following(userID uint, pagination utils.IPagination) (users []models.User, totalCount int64, err error) {
+	if err = service.exists(userID); err != nil {
+		return nil, 0, err
+	}
+	return service.FollowRepository.GetFollowing(userID, service.pager(pagination))
+}
+
+func (service *FollowService) pager(pagination utils.IPagination) scopes.GormPager {
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && pagination.GetLimit() > max {
+		pagination.Get().Limit = max
+	}
+	return &scopes.GormPagination{Pagination: pagination.Get()}
+}
+
+func (service *FollowService) exists(userID uint) error {
+	_, err := service.UserRepository.GetUserByID(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrUserNotFound
+	}
+	return err
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+var ErrNotImpersonable = problems.Define(problems.NotImpersonable, "the user can not be impersonated")
+
+type Impersonation struct {
+	AccessToken    string
+	AccessTokenExp int64
+	User           models.User
+}
+
+type IImpersonationService interface {
+	// Impersonate issues a short lived token acting as the user, admins and the admin itself can not be impersonated
+	Impersonate(admin models.User, userID uint, ip string) (Impersonation, error)
+}
+
+type ImpersonationService struct {
+	UserRepository repositories.IUserRepository
+	AuthService    IAuthService
+	Logger         infrastructures.ILogger
+	Config         *config.Impersonation
+}
+
+func (service *ImpersonationService) Impersonate(admin models.User, userID uint, ip string) (impersonation Impersonation, err error) {
+	var user models.User
+	user, err = service.UserRepository.GetUserByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return impersonation, ErrUserNotFound
+		}
+		return impersonation, err
+	}
+	if user.ID == admin.ID || user.IsAdmin() {
+		service.Logger.Warn("impersonation refused", infrastructures.Fields{
+			"audit":           true,
+			"impersonator_id": admin.ID,
+			"user_id":         user.ID,
+			"ip":              ip,
+		})
+		return impersonation, ErrNotImpersonable
+	}
+
+	impersonation.User = user
+	impersonation.AccessToken, impersonation.AccessTokenExp, err = service.AuthService.IssueToken(user.ID, admin.ID, service.Config.TTL)
+	if err != nil {
+		return impersonation, err
+	}
+
+	service.Logger.Info("impersonation started", infrastructures.Fields{
+		"audit":           true,
+		"impersonator_id": admin.ID,
+		"user_id":         user.ID,
+		"ip":              ip,
+		"expires_at":      time.Unix(impersonation.AccessTokenExp, 0).Format(time.RFC3339),
+	})
+	return impersonation, nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+var ErrInvitationNotFound = problems.Define(problems.InvitationNotFound, "invitation could not be found")
+
+type IInvitationService interface {
+	GetInvitations() ([]models.Invitation, error)
+	// Create issues an invitation, a ttl of 0 never expires, an empty email lets anyone use it
+	Create(admin models.User, maxUses int, ttl time.Duration, email string) (models.Invitation, error)
+	Revoke(ID uint) (models.Invitation, error)
+}
+
+type InvitationService struct {
+	InvitationRepository repositories.IInvitationRepository
+	Clock                infrastructures.IClock
+	Random               infrastructures.IRandom
+}
+
+func (service *InvitationService) GetInvitations() ([]models.Invitation, error) {
+	return service.InvitationRepository.GetInvitations()
+}
+
+func (service *InvitationService) Create(admin models.User, maxUses int, ttl time.Duration, email string) (invitation models.Invitation, err error) {
+	var code string
+	if code, err = service.Random.Token(16); err != nil {
+		return invitation, err
+	}
+
+	invitation = models.Invitation{
+		Code:      code,
+		MaxUses:   maxUses,
+		CreatedBy: admin.ID,
+	}
+	if ttl > 0 {
+		expiresAt := service.Clock.Now().Add(ttl)
+		invitation.ExpiresAt = &expiresAt
+	}
+	if email = strings.ToLower(strings.TrimSpace(email)); email != "" {
+		invitation.Email = &email
+	}
+	err = service.InvitationRepository.Create(&invitation)
+	return invitation, err
+}
+
+func (service *InvitationService) Revoke(ID uint) (invitation models.Invitation, err error) {
+	invitation, err = service.InvitationRepository.GetInvitationByID(ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return invitation, ErrInvitationNotFound
+		}
+		return invitation, err
+	}
+	if invitation.RevokedAt != nil {
+		return invitation, nil
+	}
+	err = service.InvitationRepository.Revoke(&invitation, service.Clock.Now())
+	return invitation, err
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+// a failed invoice is rendered again by the scheduler up to this many times
+const invoiceMaxAttempts = 5
+
+var (
+	ErrInvoiceNotFound    = problems.Define(problems.InvoiceNotFound, "invoice could not be found")
+	ErrInvoiceNotReady    = problems.Define(problems.InvoiceNotReady, "the invoice is not rendered yet, try again in a few minutes")
+	ErrInvoiceLinkInvalid = problems.Define(problems.InvoiceLinkInvalid, "the download link is invalid or expired")
+)
+
+type IInvoiceService interface {
+	// Record stores a paid invoice of the payment provider once and renders its pdf in the background
+	Record(incoming infrastructures.PaymentInvoice) error
+	// Invoices returns the invoices of the user, the ready ones with a signed download url
+	Invoices(user models.User) ([]models.Invoice, error)
+	// Download checks the signature of a download url and returns the pdf of its invoice
+	Download(id uint, expires int64, signature string) (models.Invoice, infrastructures.StorageFile, error)
+	// RenderPending renders the pending invoices again, for the renders lost with a restart and the failed ones
+	RenderPending() error
+}
+
+type InvoiceService struct {
+	BillingRepository repositories.IBillingRepository
+	UserRepository    repositories.IUserRepository
+	Pdf               infrastructures.IPdfService
+	Storage           infrastructures.IStorageService
+	Logger            infrastructures.ILogger
+	Clock             infrastructures.IClock
+	Config            *config.Billing
+}
+
+func (service *InvoiceService) Record(incoming infrastructures.PaymentInvoice) (err error) {
+	fields := infrastructures.Fields{"invoice": incoming.ID}
+	// webhooks are delivered at least once
+	if _, err = service.BillingRepository.GetInvoiceByExternalID(incoming.ID); err == nil {
+		return nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	userID := incoming.UserID
+	if userID == 0 {
+		subscription, err := service.BillingRepository.GetSubscriptionByCustomerID(incoming.CustomerID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		userID = subscription.UserID
+	}
+	if userID == 0 {
+		service.Logger.Warn("invoice without a user", fields)
+		return nil
+	}
+
+	invoice := models.Invoice{
+		UserID:      userID,
+		ExternalID:  incoming.ID,
+		Number:      incoming.Number,
+		PeriodStart: incoming.PeriodStart,
+		PeriodEnd:   incoming.PeriodEnd,
+		PaidAt:      incoming.PaidAt,
+		Status:      models.InvoicePending,
+	}
+	if invoice.Total, err = helpers.NewMoney(incoming.AmountPaid, incoming.Currency); err != nil {
+		service.Logger.Warn("invoice in an unknown currency", fields)
+		return nil
+	}
+	for _, line := range incoming.Lines {
+		invoice.Lines = append(invoice.Lines, models.InvoiceLine{
+			Description: line.Description,
+			Amount:      helpers.Money{Amount: line.Amount, Currency: invoice.Total.Currency},
+		})
+	}
+	if err = service.BillingRepository.CreateInvoice(&invoice); err != nil {
+		return err
+	}
+
+	go service.render(invoice)
+	return nil
+}
+
+func (service *InvoiceService) render(invoice models.Invoice) {
+	path, err := service.renderFile(invoice)
+	if err != nil {
+		service.Logger.Error("invoice could not be rendered", infrastructures.Fields{"invoice_id": invoice.ID, "error": err.Error()})
+		_ = service.BillingRepository.UpdateInvoice(&invoice, map[string]interface{}{
+			"status":   models.InvoiceFailed,
+			"attempts": invoice.Attempts + 1,
+		})
+		return
+	}
+	_ = service.BillingRepository.UpdateInvoice(&invoice, map[string]interface{}{
+		"status":   models.InvoiceReady,
+		"path":     path,
+		"attempts": invoice.Attempts + 1,
+	})
+}
+
+func (service *InvoiceService) renderFile(invoice models.Invoice) (path string, err error) {
+	var user models.User
+	if user, err = service.UserRepository.GetUserByID(invoice.UserID); err != nil {
+		return "", err
+	}
+	lines := make([]map[string]string, len(invoice.Lines))
+	for i, line := range invoice.Lines {
+		lines[i] = map[string]string{"Description": line.Description, "Amount": line.Amount.String()}
+	}
+
+	var content []byte
+	content, err = service.Pdf.Render("views/invoice.txt", map[string]interface{}{
+		"Number":      invoice.Number,
+		"Name":        user.Name,
+		"Email":       user.Email,
+		"PaidAt":      invoice.PaidAt.UTC().Format("2006-01-02"),
+		"PeriodStart": invoice.PeriodStart.UTC().Format("2006-01-02"),
+		"PeriodEnd":   invoice.PeriodEnd.UTC().Format("2006-01-02"),
+		"Lines":       lines,
+		"Total":       invoice.Total.String(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	path = fmt.Sprintf("invoices/%d/%d.pdf", invoice.UserID, invoice.ID)
+	return path, service.Storage.Put(path, content)
+}
+
+func (service *InvoiceService) Invoices(user models.User) (invoices []models.Invoice, err error) {
+	if invoices, err = service.BillingRepository.GetInvoicesByUserID(user.ID); err != nil {
+		return nil, err
+	}
+	expires := service.Clock.Now().Add(service.Config.InvoiceUrlTTL).Unix()
+	for i := range invoices {
+		if invoices[i].IsReady() {
+			invoices[i].DownloadUrl = fmt.Sprintf("%s/v1/billing/invoices/%d/download?expires=%d&signature=%s",
+				config.Conf.BaseUrl, invoices[i].ID, expires, service.sign(invoices[i].ID, expires))
+		}
+	}
+	return invoices, nil
+}
+
+func (service *InvoiceService) Download(id uint, expires int64, signature string) (invoice models.Invoice, file infrastructures.StorageFile, err error) {
+	if service.Clock.Now().Unix() > expires || !hmac.Equal([]byte(signature), []byte(service.sign(id, expires))) {
+		return invoice, nil, ErrInvoiceLinkInvalid
+	}
+	if invoice, err = service.BillingRepository.GetInvoiceByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return invoice, nil, ErrInvoiceNotFound
+		}
+		return invoice, nil, err
+	}
+	if !invoice.IsReady() {
+		return invoice, nil, ErrInvoiceNotReady
+	}
+	file, err = service.Storage.Open(invoice.Path)
+	return invoice, file, err
+}
+
+func (service *InvoiceService) RenderPending() error {
+	// the renders started by Record get a minute before they are considered lost
+	invoices, err := service.BillingRepository.GetUnrenderedInvoices(service.Clock.Now().Add(-time.Minute), invoiceMaxAttempts)
+	if err != nil {
+		return err
+	}
+	for _, invoice := range invoices {
+		service.render(invoice)
+	}
+	return nil
+}
+
+func (service *InvoiceService) sign(id uint, expires int64) string {
+	return helpers.ComputeHmacSha1(fmt.Sprintf("invoice:%d:%d", id, expires), config.Conf.SecretKey)
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/mails"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/repositories/transactions"
+)
+
+var (
+	ErrMagicLinkThrottled = problems.Define(problems.MagicLinkThrottled, "too many sign in links were asked for this email")
+	ErrMagicLinkInvalid   = problems.Define(problems.MagicLinkInvalid, "the sign in link is invalid, expired or already used")
+)
+
+type IMagicLinkService interface {
+	// Send mails a sign in link when the email belongs to a user, unknown emails succeed silently so they can not be probed
+	Send(ctx context.Context, email string) error
+	// Exchange uses the link of the token and returns its user, every other unused link of the user is invalidated
+	Exchange(ctx context.Context, token string) (models.User, error)
+}
+
+type MagicLinkService struct {
+	UserRepository      repositories.IUserRepository
+	MagicLinkRepository repositories.IMagicLinkRepository
+	UnitOfWork          transactions.IUnitOfWork
+	Email               infrastructures.IEmailService
+	Mail                mails.IMailRenderer
+	Cache               infrastructures.ICache
+	Logger              infrastructures.ILogger
+	Config              *config.MagicLink
+	Clock               infrastructures.IClock
+	Random              infrastructures.IRandom
+}
+
+func (service *MagicLinkService) Send(ctx context.Context, email string) (err error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	// counted before the lookup, known and unknown emails are throttled alike
+	if err = service.throttle(ctx, email); err != nil {
+		return err
+	}
+
+	var user models.User
+	if user, err = service.UserRepository.GetUserByEmail(email); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	var token string
+	if token, err = service.Random.Token(32); err != nil {
+		return err
+	}
+	magicLink := models.MagicLink{
+		UserID:    user.ID,
+		TokenHash: hashMagicLinkToken(token),
+		ExpiresAt: service.Clock.Now().Add(service.Config.TTL),
+	}
+	if err = service.MagicLinkRepository.Create(&magicLink); err != nil {
+		return err
+	}
+
+	mail, err := service.Mail.Render(map[string]interface{}{
+		"url":        service.Config.Url + "?token=" + url.QueryEscape(token),
+		"expires_at": magicLink.ExpiresAt.UTC().Format(time.RFC1123),
+	}, []string{user.Email})
+	if err != nil {
+		return err
+	}
+	return service.Email.Send(mail)
+}
+
+func (service *MagicLinkService) Exchange(ctx context.Context, token string) (user models.User, err error) {
+	err = service.UnitOfWork.WithinTransaction(ctx, func(repos transactions.RepoSet) error {
+		magicLink, err := repos.MagicLinks.GetMagicLinkByTokenHash(hashMagicLinkToken(token))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMagicLinkInvalid
+			}
+			return err
+		}
+		now := service.Clock.Now()
+		if !magicLink.IsUsable(now) {
+			return ErrMagicLinkInvalid
+		}
+		if err = repos.MagicLinks.Use(&magicLink, now); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMagicLinkInvalid
+			}
+			return err
+		}
+		if err = repos.MagicLinks.Invalidate(magicLink.UserID, now); err != nil {
+			return err
+		}
+		if user, err = repos.Users.GetUserByID(magicLink.UserID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMagicLinkInvalid
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return user, err
+	}
+	service.Logger.Info("magic link used", infrastructures.Fields{"audit": true, "user_id": user.ID})
+	return user, nil
+}
+
+// throttle counts a link for the email
+func (service *MagicLinkService) throttle(ctx context.Context, email string) error {
+	counter := windowCounter{Cache: service.Cache, Prefix: "magic-link:", Window: service.Config.Window}
+	key := helpers.ComputeHmacSha1(email, config.Conf.SecretKey)
+
+	count, _, err := counter.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if count >= service.Config.MaxPerWindow {
+		return ErrMagicLinkThrottled
+	}
+	_, err = counter.Increment(ctx, key)
+	return err
+}
+
+// hashMagicLinkToken only the hash of a token is stored, a leaked table can not sign in
+func hashMagicLinkToken(token string) string {
+	return helpers.ComputeHmacSha1(token, config.Conf.SecretKey)
+}
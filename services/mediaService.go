@@ -0,0 +1,242 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+	"gotham/policies"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/utils"
+)
+
+// orphans deleted per batch of the cleanup job
+const orphanBatchSize = 100
+
+var (
+	ErrMediaNotFound           = problems.Define(problems.MediaNotFound, "media could not be found")
+	ErrMediaTooLarge           = problems.Define(problems.MediaTooLarge, "the file is too large")
+	ErrMediaTypeNotAllowed     = problems.Define(problems.MediaTypeNotAllowed, "the file type is not allowed")
+	ErrMediaAttachableNotFound = problems.Define(problems.MediaAttachableNotFound, "the attachable could not be found")
+	ErrMediaForbidden          = problems.Define(problems.MediaForbidden, "you can not change this media")
+)
+
+// Attachable returns the user owning the attachable, gorm.ErrRecordNotFound when it does not exist
+type Attachable func(ID uint) (ownerID uint, err error)
+
+// MediaUsageReport is the storage used by the media, the users using the most first
+type MediaUsageReport struct {
+	Total   models.MediaUsage       `json:"total"`
+	Orphans models.MediaUsage       `json:"orphans"`
+	ByUser  []models.MediaUserUsage `json:"by_user"`
+	ByMime  []models.MediaMimeUsage `json:"by_mime"`
+}
+
+type IMediaService interface {
+	// Upload stores the file of the user, its type is detected from the content
+	Upload(user models.User, name string, content io.Reader, visibility string) (models.Media, error)
+	MediaOfUser(user models.User, pagination utils.IPagination) (media []models.Media, totalCount int64, err error)
+	// Get returns a public media, or a private one to its owner and admins
+	Get(user models.User, mediaID uint) (models.Media, error)
+	// Content opens the file of a media Get returns, the caller closes it
+	Content(user models.User, mediaID uint) (models.Media, infrastructures.StorageFile, error)
+	Attach(user models.User, mediaID uint, attachableType string, attachableID uint) (models.Media, error)
+	// Detach makes the media an orphan again, it is cleaned up unless it is attached in time
+	Detach(user models.User, mediaID uint) (models.Media, error)
+	Delete(user models.User, mediaID uint) error
+
+	// CleanupOrphans deletes the media left unattached for longer than the orphan ttl, scheduled
+	CleanupOrphans() error
+	Usage(limit int) (MediaUsageReport, error)
+}
+
+type MediaService struct {
+	MediaRepository repositories.IMediaRepository
+	Storage         infrastructures.IStorageService
+	SettingService  ISettingService
+	Config          *config.Media
+	// Attachables are the types media can be attached to, by the name used in the urls
+	Attachables map[string]Attachable
+}
+
+func (service *MediaService) Upload(user models.User, name string, content io.Reader, visibility string) (media models.Media, err error) {
+	data, err := ioutil.ReadAll(io.LimitReader(content, service.Config.MaxSize+1))
+	if err != nil {
+		return media, err
+	}
+	if int64(len(data)) > service.Config.MaxSize {
+		return media, ErrMediaTooLarge
+	}
+	mimeType, _, err := mime.ParseMediaType(http.DetectContentType(data))
+	if err != nil || !helpers.InArray(mimeType, service.Config.AllowedTypes) {
+		return media, ErrMediaTypeNotAllowed
+	}
+
+	token, err := helpers.SecureToken(16)
+	if err != nil {
+		return media, err
+	}
+	checksum := sha256.Sum256(data)
+	if name = filepath.Base(name); len(name) > 255 {
+		name = name[:255]
+	}
+	media = models.Media{
+		UserID:     user.ID,
+		Name:       name,
+		Path:       fmt.Sprintf("media/%d/%v", user.ID, token),
+		Disk:       models.MediaDiskLocal,
+		Mime:       mimeType,
+		Size:       int64(len(data)),
+		Checksum:   hex.EncodeToString(checksum[:]),
+		Visibility: visibility,
+	}
+	if err = service.Storage.Put(media.Path, data); err != nil {
+		return media, err
+	}
+	if err = service.MediaRepository.Create(&media); err != nil {
+		_ = service.Storage.Delete(media.Path)
+		return media, err
+	}
+	return media, nil
+}
+
+func (service *MediaService) MediaOfUser(user models.User, pagination utils.IPagination) (media []models.Media, totalCount int64, err error) {
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && pagination.GetLimit() > max {
+		pagination.Get().Limit = max
+	}
+	return service.MediaRepository.GetUserMediaWithPagination(user.ID, &scopes.GormPagination{Pagination: pagination.Get()})
+}
+
+func (service *MediaService) Get(user models.User, mediaID uint) (media models.Media, err error) {
+	if media, err = service.getMedia(mediaID); err != nil {
+		return media, err
+	}
+	// a private media is not found rather than forbidden so its existence does not leak
+	if !(policies.MediaPolicy{}).Can(user, policies.View, media) {
+		return media, ErrMediaNotFound
+	}
+	return media, nil
+}
+
+func (service *MediaService) Content(user models.User, mediaID uint) (media models.Media, file infrastructures.StorageFile, err error) {
+	if media, err = service.Get(user, mediaID); err != nil {
+		return media, nil, err
+	}
+	file, err = service.Storage.Open(media.Path)
+	return media, file, err
+}
+
+func (service *MediaService) Attach(user models.User, mediaID uint, attachableType string, attachableID uint) (media models.Media, err error) {
+	if media, err = service.owned(user, mediaID); err != nil {
+		return media, err
+	}
+	attachable, ok := service.Attachables[attachableType]
+	if !ok {
+		return media, ErrMediaAttachableNotFound
+	}
+	ownerID, err := attachable(attachableID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return media, ErrMediaAttachableNotFound
+	}
+	if err != nil {
+		return media, err
+	}
+	if !policies.Owns(user, ownerID) {
+		return media, ErrMediaForbidden
+	}
+
+	err = service.MediaRepository.Updates(&media, map[string]interface{}{"attachable_type": attachableType, "attachable_id": attachableID})
+	media.AttachableType, media.AttachableID = &attachableType, &attachableID
+	return media, err
+}
+
+func (service *MediaService) Detach(user models.User, mediaID uint) (media models.Media, err error) {
+	if media, err = service.owned(user, mediaID); err != nil {
+		return media, err
+	}
+	err = service.MediaRepository.Updates(&media, map[string]interface{}{"attachable_type": nil, "attachable_id": nil})
+	media.AttachableType, media.AttachableID = nil, nil
+	return media, err
+}
+
+func (service *MediaService) Delete(user models.User, mediaID uint) error {
+	media, err := service.owned(user, mediaID)
+	if err != nil {
+		return err
+	}
+	return service.delete(&media)
+}
+
+func (service *MediaService) CleanupOrphans() error {
+	before := time.Now().Add(-service.Config.OrphanTTL)
+	for {
+		orphans, err := service.MediaRepository.GetOrphans(before, orphanBatchSize)
+		if err != nil {
+			return err
+		}
+		for i := range orphans {
+			if err = service.delete(&orphans[i]); err != nil {
+				return err
+			}
+		}
+		if len(orphans) < orphanBatchSize {
+			return nil
+		}
+	}
+}
+
+func (service *MediaService) Usage(limit int) (report MediaUsageReport, err error) {
+	if report.Total, err = service.MediaRepository.GetUsage(); err != nil {
+		return report, err
+	}
+	if report.Orphans, err = service.MediaRepository.GetUsage(repositories.Orphaned); err != nil {
+		return report, err
+	}
+	if report.ByUser, err = service.MediaRepository.GetUsageByUser(limit); err != nil {
+		return report, err
+	}
+	report.ByMime, err = service.MediaRepository.GetUsageByMime()
+	return report, err
+}
+
+// delete removes the file before the row, a failure leaves the row to be deleted again
+func (service *MediaService) delete(media *models.Media) error {
+	if err := service.Storage.Delete(media.Path); err != nil {
+		return err
+	}
+	return service.MediaRepository.Delete(media)
+}
+
+// owned returns the media when the user can change it, its owner or an admin
+func (service *MediaService) owned(user models.User, mediaID uint) (media models.Media, err error) {
+	if media, err = service.Get(user, mediaID); err != nil {
+		return media, err
+	}
+	if !(policies.MediaPolicy{}).Can(user, policies.Update, media) {
+		return media, ErrMediaForbidden
+	}
+	return media, nil
+}
+
+func (service *MediaService) getMedia(mediaID uint) (media models.Media, err error) {
+	media, err = service.MediaRepository.GetMediaByID(mediaID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return media, ErrMediaNotFound
+	}
+	return media, err
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+// the length of the message excerpt in a push notification
+const pushExcerptLength = 100
+
+var ErrDeviceNotFound = problems.Define(problems.DeviceNotFound, "device could not be found")
+
+// PushPreferences tells which categories of push notifications the user receives
+type PushPreferences map[string]bool
+
+type INotificationService interface {
+	// RegisterDevice stores the push token of the device of the user, a token of another user moves to the user
+	RegisterDevice(user models.User, token string, platform string) (models.Device, error)
+	Devices(user models.User) ([]models.Device, error)
+	UnregisterDevice(user models.User, deviceID uint) error
+	PushPreferences(user models.User) (PushPreferences, error)
+	UpdatePushPreferences(user models.User, preferences PushPreferences) (PushPreferences, error)
+
+	// Push sends the notification to every device of the user unless they opted out of its category,
+	// the tokens refused by the provider are deleted
+	Push(userID uint, category string, notification infrastructures.PushNotification) error
+
+	// Listeners
+	MessageCreated(event infrastructures.Event) error
+	FollowCreated(event infrastructures.Event) error
+}
+
+type NotificationService struct {
+	DeviceRepository repositories.IDeviceRepository
+	UserRepository   repositories.IUserRepository
+	PushService      infrastructures.IPushService
+	Hub              infrastructures.IHub
+	Logger           infrastructures.ILogger
+}
+
+func (service *NotificationService) RegisterDevice(user models.User, token string, platform string) (device models.Device, err error) {
+	device = models.Device{UserID: user.ID, Token: token, Platform: platform}
+	err = service.DeviceRepository.Register(&device)
+	return device, err
+}
+
+func (service *NotificationService) Devices(user models.User) ([]models.Device, error) {
+	return service.DeviceRepository.GetUserDevices(user.ID)
+}
+
+func (service *NotificationService) UnregisterDevice(user models.User, deviceID uint) error {
+	device, err := service.DeviceRepository.GetDeviceByID(deviceID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && device.UserID != user.ID) {
+		return ErrDeviceNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return service.DeviceRepository.Delete(&device)
+}
+
+func (service *NotificationService) PushPreferences(user models.User) (PushPreferences, error) {
+	optOuts, err := service.DeviceRepository.GetOptOuts(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	preferences := PushPreferences{}
+	for _, category := range models.PushCategories {
+		preferences[category.(string)] = !helpers.InArray(category, optOuts)
+	}
+	return preferences, nil
+}
+
+func (service *NotificationService) UpdatePushPreferences(user models.User, preferences PushPreferences) (PushPreferences, error) {
+	current, err := service.PushPreferences(user)
+	if err != nil {
+		return nil, err
+	}
+	// categories left out keep their preference
+	var optOuts []string
+	for category, enabled := range current {
+		if wanted, ok := preferences[category]; ok {
+			enabled = wanted
+		}
+		if !enabled {
+			optOuts = append(optOuts, category)
+		}
+	}
+	if err = service.DeviceRepository.SetOptOuts(user.ID, optOuts); err != nil {
+		return nil, err
+	}
+	return service.PushPreferences(user)
+}
+
+func (service *NotificationService) Push(userID uint, category string, notification infrastructures.PushNotification) error {
+	optOuts, err := service.DeviceRepository.GetOptOuts(userID)
+	if err != nil {
+		return err
+	}
+	if helpers.InArray(category, optOuts) {
+		return nil
+	}
+	devices, err := service.DeviceRepository.GetUserDevices(userID)
+	if err != nil {
+		return err
+	}
+
+	var failed error
+	for _, device := range devices {
+		err = service.PushService.Send(device.Token, notification)
+		if errors.Is(err, infrastructures.ErrPushTokenInvalid) {
+			service.Logger.Info("stale push token deleted", infrastructures.Fields{"user_id": userID, "device_id": device.ID})
+			if err = service.DeviceRepository.DeleteByToken(device.Token); err != nil && failed == nil {
+				failed = err
+			}
+			continue
+		}
+		if err != nil {
+			service.Logger.Warn("push failed", infrastructures.Fields{"user_id": userID, "device_id": device.ID, "error": err.Error()})
+			if failed == nil {
+				failed = err
+			}
+			continue
+		}
+		_ = service.DeviceRepository.Touch(device.ID, time.Now())
+	}
+	return failed
+}
+
+/**
+ * Listeners
+ *
+ */
+
+// MessageCreated pushes the message to the recipients without an open socket, the others already got it
+func (service *NotificationService) MessageCreated(event infrastructures.Event) error {
+	payload, ok := event.Payload.(MessageEvent)
+	if !ok {
+		return fmt.Errorf("unexpected payload %T", event.Payload)
+	}
+	sender, err := service.UserRepository.GetUserByID(payload.Message.UserID)
+	if err != nil {
+		return err
+	}
+	notification := infrastructures.PushNotification{
+		Title: sender.Name,
+		Body:  helpers.Truncate(payload.Message.Body, pushExcerptLength),
+		Data: map[string]string{
+			"type":            event.Name,
+			"conversation_id": strconv.FormatUint(uint64(payload.Message.ConversationID), 10),
+			"message_id":      strconv.FormatUint(uint64(payload.Message.ID), 10),
+		},
+	}
+	var failed error
+	for _, recipientID := range payload.RecipientIDs {
+		if service.Hub.Online(recipientID) {
+			continue
+		}
+		if err = service.Push(recipientID, models.PushMessages, notification); err != nil && failed == nil {
+			failed = err
+		}
+	}
+	return failed
+}
+
+func (service *NotificationService) FollowCreated(event infrastructures.Event) error {
+	payload, ok := event.Payload.(FollowEvent)
+	if !ok {
+		return fmt.Errorf("unexpected payload %T", event.Payload)
+	}
+	follower, err := service.UserRepository.GetUserByID(payload.FollowerID)
+	if err != nil {
+		return err
+	}
+	return service.Push(payload.FollowedID, models.PushFollows, infrastructures.PushNotification{
+		Title: "New follower",
+		Body:  fmt.Sprintf("%v follows you", follower.Name),
+		Data: map[string]string{
+			"type":    event.Name,
+			"user_id": strconv.FormatUint(uint64(follower.ID), 10),
+		},
+	})
+}
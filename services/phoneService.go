@@ -0,0 +1,145 @@
+package services
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+var (
+	ErrPhoneTaken       = problems.Define(problems.PhoneTaken, "the phone number is already used by another account")
+	ErrPhoneCodeInvalid = problems.Define(problems.PhoneCodeInvalid, "the verification code is invalid or expired")
+	ErrPhoneNotPending  = problems.Define(problems.PhoneNotPending, "there is no phone number waiting for verification")
+	ErrPhoneCodeTooSoon = problems.Define(problems.PhoneCodeTooSoon, "a verification code was sent recently")
+)
+
+type IPhoneService interface {
+	// Update sets the phone (E.164) of the user as unverified and sends it a verification code
+	Update(user models.User, phone string) (models.User, error)
+	// SendCode sends a new verification code to the unverified phone of the user
+	SendCode(user models.User) (models.User, error)
+	Verify(user models.User, code string) (models.User, error)
+	Remove(user models.User) (models.User, error)
+}
+
+type PhoneService struct {
+	UserRepository repositories.IUserRepository
+	Sms            infrastructures.ISmsService
+	Cache          infrastructures.ICache
+	Config         *config.Sms
+	Clock          infrastructures.IClock
+	Random         infrastructures.IRandom
+}
+
+func (service *PhoneService) Update(user models.User, phone string) (models.User, error) {
+	if user.Phone != nil && *user.Phone == phone && user.IsPhoneVerified() {
+		return user, nil
+	}
+	if owner, err := service.UserRepository.GetUserByPhone(phone); err == nil && owner.ID != user.ID {
+		return user, ErrPhoneTaken
+	} else if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return user, err
+	}
+
+	if err := service.UserRepository.Updates(&user, map[string]interface{}{
+		"phone":             phone,
+		"phone_verified_at": nil,
+	}); err != nil {
+		return user, err
+	}
+	user.Phone, user.PhoneVerifiedAt = &phone, nil
+	invalidateCache(service.Cache, CacheTagUsers)
+	return service.sendCode(user)
+}
+
+func (service *PhoneService) SendCode(user models.User) (models.User, error) {
+	if user.Phone == nil || user.IsPhoneVerified() {
+		return user, ErrPhoneNotPending
+	}
+	if user.PhoneCodeExpiresAt != nil && service.Clock.Now().Before(user.PhoneCodeExpiresAt.Add(service.Config.CodeResendInterval-service.Config.CodeTTL)) {
+		return user, ErrPhoneCodeTooSoon
+	}
+	return service.sendCode(user)
+}
+
+// sendCode replaces the pending code of the user, the previous one stops working
+func (service *PhoneService) sendCode(user models.User) (models.User, error) {
+	code, err := service.Random.Digits(6)
+	if err != nil {
+		return user, err
+	}
+	hash := hashPhoneCode(user.ID, code)
+	expiresAt := service.Clock.Now().Add(service.Config.CodeTTL)
+	if err = service.UserRepository.Updates(&user, map[string]interface{}{
+		"phone_code_hash":       hash,
+		"phone_code_expires_at": expiresAt,
+		"phone_code_attempts":   0,
+	}); err != nil {
+		return user, err
+	}
+	user.PhoneCodeHash, user.PhoneCodeExpiresAt, user.PhoneCodeAttempts = &hash, &expiresAt, 0
+
+	message := fmt.Sprintf("Your %s verification code is %s, it expires in %s.", config.Conf.Brand.ProjectName, code, service.Config.CodeTTL)
+	return user, service.Sms.Send(*user.Phone, message)
+}
+
+func (service *PhoneService) Verify(user models.User, code string) (models.User, error) {
+	if user.Phone == nil || user.IsPhoneVerified() {
+		return user, ErrPhoneNotPending
+	}
+	now := service.Clock.Now()
+	if user.PhoneCodeHash == nil || user.PhoneCodeExpiresAt == nil || !now.Before(*user.PhoneCodeExpiresAt) || user.PhoneCodeAttempts >= service.Config.CodeMaxAttempts {
+		return user, ErrPhoneCodeInvalid
+	}
+
+	if subtle.ConstantTimeCompare([]byte(*user.PhoneCodeHash), []byte(hashPhoneCode(user.ID, code))) != 1 {
+		// counted by the database so parallel guesses can not exceed the attempts
+		if err := service.UserRepository.Updates(&user, map[string]interface{}{
+			"phone_code_attempts": gorm.Expr("phone_code_attempts + 1"),
+		}); err != nil {
+			return user, err
+		}
+		return user, ErrPhoneCodeInvalid
+	}
+
+	if err := service.UserRepository.Updates(&user, map[string]interface{}{
+		"phone_verified_at":     now,
+		"phone_code_hash":       nil,
+		"phone_code_expires_at": nil,
+		"phone_code_attempts":   0,
+	}); err != nil {
+		return user, err
+	}
+	user.PhoneVerifiedAt, user.PhoneCodeHash, user.PhoneCodeExpiresAt, user.PhoneCodeAttempts = &now, nil, nil, 0
+	invalidateCache(service.Cache, CacheTagUsers)
+	return user, nil
+}
+
+func (service *PhoneService) Remove(user models.User) (models.User, error) {
+	if err := service.UserRepository.Updates(&user, map[string]interface{}{
+		"phone":                 nil,
+		"phone_verified_at":     nil,
+		"phone_code_hash":       nil,
+		"phone_code_expires_at": nil,
+		"phone_code_attempts":   0,
+	}); err != nil {
+		return user, err
+	}
+	user.Phone, user.PhoneVerifiedAt, user.PhoneCodeHash, user.PhoneCodeExpiresAt, user.PhoneCodeAttempts = nil, nil, nil, nil, 0
+	invalidateCache(service.Cache, CacheTagUsers)
+	return user, nil
+}
+
+// hashPhoneCode binds the code to the user, the same code of another user has another hash
+func hashPhoneCode(userID uint, code string) string {
+	return helpers.ComputeHmacSha1(fmt.Sprintf("%d:%s", userID, code), config.Conf.SecretKey)
+}
@@ -0,0 +1,287 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strconv"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/repositories"
+	"gotham/repositories/transactions"
+)
+
+// QueueDataExports is the queue of the data exports to build, the payload is the id of the export
+const QueueDataExports = "data-exports"
+
+type IPrivacyService interface {
+	RequestDataExport(user models.User) (models.DataExport, error)
+	// BuildDataExport is the worker of the QueueDataExports jobs, it builds the archive of the export
+	BuildDataExport(job infrastructures.Job) error
+	GetDataExportByID(id uint) (models.DataExport, error)
+	// GetDataExportFile opens the archive of a ready export, the caller closes it
+	GetDataExportFile(dataExport models.DataExport) (infrastructures.StorageFile, error)
+	ScheduleDeletion(user models.User) (models.User, error)
+	CancelDeletion(user models.User) (models.User, error)
+	PurgeDueDeletions() error
+	PurgeExpiredDataExports() error
+}
+
+type PrivacyService struct {
+	UserRepository       repositories.IUserRepository
+	DataExportRepository repositories.IDataExportRepository
+	MediaRepository      repositories.IMediaRepository
+	Storage              infrastructures.IStorageService
+	UnitOfWork           transactions.IUnitOfWork
+	Cache                infrastructures.ICache
+	Queue                infrastructures.IQueue
+	Events               infrastructures.IEventBus
+	Clock                infrastructures.IClock
+	Config               *config.Privacy
+
+	// every repository holding user data, keyed by its section name in the export
+	Exportables map[string]repositories.Exportable
+}
+
+// RequestDataExport creates a pending export and builds the archive in the background
+func (service *PrivacyService) RequestDataExport(user models.User) (dataExport models.DataExport, err error) {
+	dataExport = models.DataExport{
+		UserID: user.ID,
+		Status: models.DataExportPending,
+	}
+	if err = service.DataExportRepository.Create(&dataExport); err != nil {
+		return dataExport, err
+	}
+
+	err = service.Queue.Push(context.Background(), QueueDataExports, []byte(strconv.FormatUint(uint64(dataExport.ID), 10)))
+	return dataExport, err
+}
+
+func (service *PrivacyService) BuildDataExport(job infrastructures.Job) error {
+	id, err := strconv.ParseUint(string(job.Payload), 10, 64)
+	if err != nil {
+		return err
+	}
+	dataExport, err := service.DataExportRepository.GetDataExportByID(uint(id))
+	if err != nil {
+		return err
+	}
+	if err = service.buildDataExport(&dataExport); err != nil {
+		log.Printf("privacy: data export %v failed: %v", dataExport.ID, err)
+		dataExport.Status = models.DataExportFailed
+		_ = service.DataExportRepository.Save(&dataExport)
+		return err
+	}
+	return nil
+}
+
+func (service *PrivacyService) buildDataExport(dataExport *models.DataExport) (err error) {
+	var archive bytes.Buffer
+	writer := zip.NewWriter(&archive)
+	file, err := writer.Create("data.json")
+	if err != nil {
+		return err
+	}
+	if err = service.writeExport(context.Background(), file, dataExport.UserID); err != nil {
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("exports/%d/%d.zip", dataExport.UserID, dataExport.ID)
+	if err = service.Storage.Put(path, archive.Bytes()); err != nil {
+		return err
+	}
+
+	expiresAt := service.Clock.Now().Add(service.Config.ExportTTL)
+	dataExport.Path = path
+	dataExport.ExpiresAt = &expiresAt
+	dataExport.Status = models.DataExportReady
+	return service.DataExportRepository.Save(dataExport)
+}
+
+// writeExport writes the sections of the export as an indented json object in the order of their names, the sections
+// of the StreamExportable repositories record by record instead of loading them whole
+func (service *PrivacyService) writeExport(ctx context.Context, w io.Writer, userID uint) (err error) {
+	names := make([]string, 0, len(service.Exportables))
+	for name := range service.Exportables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if _, err = io.WriteString(w, "{"); err != nil {
+		return err
+	}
+	for i, name := range names {
+		key, _ := json.Marshal(name)
+		separator := ","
+		if i == 0 {
+			separator = ""
+		}
+		if _, err = fmt.Fprintf(w, "%s\n  %s: ", separator, key); err != nil {
+			return err
+		}
+		if streamable, ok := service.Exportables[name].(repositories.StreamExportable); ok {
+			err = writeExportArray(w, func(write func(record interface{}) error) error {
+				return streamable.StreamUserData(ctx, userID, write)
+			})
+		} else {
+			var data interface{}
+			if data, err = service.Exportables[name].ExportUserData(userID); err == nil {
+				err = writeExportValue(w, data, "  ")
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(w, "\n}")
+	return err
+}
+
+// writeExportArray writes the records of the stream as a json array, indented as a section of the export
+func writeExportArray(w io.Writer, stream func(write func(record interface{}) error) error) error {
+	count := 0
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	err := stream(func(record interface{}) error {
+		separator := ","
+		if count == 0 {
+			separator = ""
+		}
+		count++
+		if _, err := fmt.Fprintf(w, "%s\n    ", separator); err != nil {
+			return err
+		}
+		return writeExportValue(w, record, "    ")
+	})
+	if err != nil {
+		return err
+	}
+	closing := "]"
+	if count > 0 {
+		closing = "\n  ]"
+	}
+	_, err = io.WriteString(w, closing)
+	return err
+}
+
+func writeExportValue(w io.Writer, value interface{}, prefix string) error {
+	content, err := json.MarshalIndent(value, prefix, "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
+
+func (service *PrivacyService) GetDataExportByID(id uint) (models.DataExport, error) {
+	return service.DataExportRepository.GetDataExportByID(id)
+}
+
+func (service *PrivacyService) GetDataExportFile(dataExport models.DataExport) (infrastructures.StorageFile, error) {
+	return service.Storage.Open(dataExport.Path)
+}
+
+// ScheduleDeletion marks the account for deletion, it is purged once the grace period is over
+func (service *PrivacyService) ScheduleDeletion(user models.User) (models.User, error) {
+	if user.IsDeletionScheduled() {
+		return user, nil
+	}
+	scheduledAt := service.Clock.Now().Add(service.Config.DeletionGracePeriod)
+	err := service.UserRepository.Updates(&user, map[string]interface{}{"deletion_scheduled_at": scheduledAt})
+	user.DeletionScheduledAt = &scheduledAt
+	invalidateCache(service.Cache, CacheTagUsers)
+	if err == nil {
+		service.Events.Publish(EventUserUpdated, UserEvent{UserID: user.ID})
+	}
+	return user, err
+}
+
+func (service *PrivacyService) CancelDeletion(user models.User) (models.User, error) {
+	err := service.UserRepository.Updates(&user, map[string]interface{}{"deletion_scheduled_at": nil})
+	user.DeletionScheduledAt = nil
+	invalidateCache(service.Cache, CacheTagUsers)
+	if err == nil {
+		service.Events.Publish(EventUserUpdated, UserEvent{UserID: user.ID})
+	}
+	return user, err
+}
+
+// PurgeDueDeletions erases every account whose grace period is over
+func (service *PrivacyService) PurgeDueDeletions() error {
+	users, err := service.UserRepository.GetUsersDueForDeletion(service.Clock.Now())
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		if err = service.purge(user.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (service *PrivacyService) purge(userID uint) error {
+	dataExports, err := service.DataExportRepository.GetDataExportsByUserID(userID)
+	if err != nil {
+		return err
+	}
+	for _, dataExport := range dataExports {
+		if dataExport.Path == "" {
+			continue
+		}
+		if err = service.Storage.Delete(dataExport.Path); err != nil {
+			return err
+		}
+	}
+	media, err := service.MediaRepository.GetMediaByUserID(userID)
+	if err != nil {
+		return err
+	}
+	for _, file := range media {
+		if err = service.Storage.Delete(file.Path); err != nil {
+			return err
+		}
+	}
+	// the account is erased from every repository or from none of them
+	err = service.UnitOfWork.WithinTransaction(context.Background(), func(repos transactions.RepoSet) error {
+		for _, erasable := range repos.Erasables() {
+			if err := erasable.EraseUserData(userID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		invalidateCache(service.Cache, CacheTagUsers)
+		service.Events.Publish(EventUserDeleted, UserEvent{UserID: userID})
+	}
+	return err
+}
+
+// PurgeExpiredDataExports removes the archives whose download link has expired
+func (service *PrivacyService) PurgeExpiredDataExports() error {
+	dataExports, err := service.DataExportRepository.GetExpiredDataExports(service.Clock.Now())
+	if err != nil {
+		return err
+	}
+	for _, dataExport := range dataExports {
+		if err = service.Storage.Delete(dataExport.Path); err != nil {
+			return err
+		}
+		if err = service.DataExportRepository.Delete(&dataExport); err != nil {
+			return err
+		}
+	}
+	return nil
+}
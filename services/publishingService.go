@@ -0,0 +1,63 @@
+package services
+
+import (
+	"time"
+
+	"gotham/infrastructures"
+	"gotham/repositories"
+)
+
+// records published per batch of the publishing job
+const publishBatchSize = 100
+
+// EventPublished is the event published with the record when a publishable of the type goes live,
+// "announcements.published" for the announcements
+func EventPublished(publishableType string) string {
+	return publishableType + ".published"
+}
+
+// Publisher publishes up to limit due records of a type and returns them
+type Publisher func(now time.Time, limit int) (records []interface{}, err error)
+
+// PublisherOf is the Publisher of the records of a repository
+func PublisherOf[T any](repository repositories.IPublishableRepository[T]) Publisher {
+	return func(now time.Time, limit int) ([]interface{}, error) {
+		published, err := repository.PublishDue(now, limit)
+		records := make([]interface{}, 0, len(published))
+		for _, record := range published {
+			records = append(records, record)
+		}
+		return records, err
+	}
+}
+
+type IPublishingService interface {
+	// PublishDue publishes the scheduled records whose publish time has come, scheduled
+	PublishDue() error
+}
+
+type PublishingService struct {
+	Events infrastructures.IEventBus
+	// Publishables are the types going live on schedule, by the name of their event
+	Publishables map[string]Publisher
+	Clock        infrastructures.IClock
+}
+
+func (service *PublishingService) PublishDue() error {
+	now := service.Clock.Now()
+	for publishableType, publish := range service.Publishables {
+		for {
+			records, err := publish(now, publishBatchSize)
+			if err != nil {
+				return err
+			}
+			for _, record := range records {
+				service.Events.Publish(EventPublished(publishableType), record)
+			}
+			if len(records) < publishBatchSize {
+				break
+			}
+		}
+	}
+	return nil
+}
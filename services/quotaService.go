@@ -0,0 +1,127 @@
+package services
+
+import (
+	"time"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+var ErrQuotaExceeded = problems.Define(problems.QuotaExceeded, "the request quota is exhausted")
+
+// QuotaStatus is the tightest quota window of a user, Limit is 0 when the user is unlimited
+type QuotaStatus struct {
+	Window    string
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+func (status QuotaStatus) Exceeded() bool {
+	return status.Limit > 0 && status.Remaining <= 0
+}
+
+type MonthlyUsage struct {
+	Month    string `json:"month"`
+	MonthID  int    `json:"month_id"`
+	Requests int64  `json:"requests"`
+}
+
+type Usage struct {
+	Year         int            `json:"year"`
+	Today        int64          `json:"today"`
+	DailyQuota   int            `json:"daily_quota"`
+	MonthlyQuota int            `json:"monthly_quota"`
+	Months       []MonthlyUsage `json:"months"`
+}
+
+type IQuotaService interface {
+	// Limits are the daily and monthly quotas of the user, 0 is unlimited
+	Limits(user models.User) (daily int, monthly int)
+	Check(user models.User, now time.Time) (QuotaStatus, error)
+	Consume(user models.User, now time.Time) error
+	GetUsage(user models.User, year int) (Usage, error)
+}
+
+type QuotaService struct {
+	UsageRepository repositories.IUsageRepository
+	Config          *config.Quota
+}
+
+func (service *QuotaService) Limits(user models.User) (daily int, monthly int) {
+	daily, monthly = service.Config.Daily, service.Config.Monthly
+	if user.DailyQuota != nil {
+		daily = *user.DailyQuota
+	}
+	if user.MonthlyQuota != nil {
+		monthly = *user.MonthlyQuota
+	}
+	return
+}
+
+func (service *QuotaService) Check(user models.User, now time.Time) (status QuotaStatus, err error) {
+	daily, monthly := service.Limits(user)
+	if daily <= 0 && monthly <= 0 {
+		return status, nil
+	}
+
+	now = now.UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	var usages []models.ApiUsage
+	if usages, err = service.UsageRepository.GetUsages(user.ID, monthStart, now); err != nil {
+		return status, err
+	}
+
+	var today, month int64
+	for _, usage := range usages {
+		month += usage.Requests
+		if usage.Day == models.UsageDay(now) {
+			today = usage.Requests
+		}
+	}
+
+	if daily > 0 {
+		status = QuotaStatus{Window: "day", Limit: daily, Remaining: daily - int(today), Reset: time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)}
+	}
+	if monthly > 0 && (status.Limit == 0 || monthly-int(month) < status.Remaining) {
+		status = QuotaStatus{Window: "month", Limit: monthly, Remaining: monthly - int(month), Reset: monthStart.AddDate(0, 1, 0)}
+	}
+	if status.Remaining < 0 {
+		status.Remaining = 0
+	}
+	return status, nil
+}
+
+func (service *QuotaService) Consume(user models.User, now time.Time) error {
+	return service.UsageRepository.Increment(user.ID, now)
+}
+
+// GetUsage sums the requests of the user by month of the year
+func (service *QuotaService) GetUsage(user models.User, year int) (usage Usage, err error) {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var usages []models.ApiUsage
+	if usages, err = service.UsageRepository.GetUsages(user.ID, from, from.AddDate(1, 0, -1)); err != nil {
+		return usage, err
+	}
+
+	usage.Year = year
+	usage.DailyQuota, usage.MonthlyQuota = service.Limits(user)
+	usage.Months = make([]MonthlyUsage, 12)
+	for i := range usage.Months {
+		usage.Months[i] = MonthlyUsage{Month: helpers.GetMonthNameWithId(i + 1), MonthID: i + 1}
+	}
+
+	today := models.UsageDay(time.Now())
+	for _, record := range usages {
+		if record.Day == today {
+			usage.Today = record.Requests
+		}
+		if day, err := time.Parse("2006-01-02", record.Day); err == nil {
+			usage.Months[day.Month()-1].Requests += record.Requests
+		}
+	}
+	return usage, nil
+}
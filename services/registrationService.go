@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/mails"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/repositories/transactions"
+)
+
+var (
+	ErrRegistrationClosed  = problems.Define(problems.RegistrationClosed, "registration is closed")
+	ErrInvitationRequired  = problems.Define(problems.InvitationRequired, "registration requires an invitation")
+	ErrInvitationInvalid   = problems.Define(problems.InvitationInvalid, "the invitation is invalid, expired or used up")
+	ErrEmailTaken          = problems.Define(problems.EmailTaken, "the email is already registered")
+	ErrVerificationInvalid = problems.Define(problems.VerificationInvalid, "the verification link is invalid or already used")
+)
+
+// SagaRegistration is the saga of a registration: the user is created, given the default tags, then mailed the link
+// verifying its email
+const SagaRegistration = "registration"
+
+// registrationInput is the state of a registration until its user is created, the password is hashed already
+type registrationInput struct {
+	Name           string `json:"name"`
+	Email          string `json:"email"`
+	Password       string `json:"password"`
+	InvitationCode string `json:"invitation_code"`
+}
+
+type IRegistrationService interface {
+	// Mode is the registration mode in effect, closed when signup is disabled
+	Mode() string
+	Register(ctx context.Context, name string, email string, password string, invitationCode string) (models.User, error)
+	// Verify marks the email of the user of the token of a verification link as verified, the link works once
+	Verify(ctx context.Context, token string) (models.User, error)
+	// Saga is the definition of the SagaRegistration saga
+	Saga() SagaDefinition
+}
+
+type RegistrationService struct {
+	UserRepository repositories.IUserRepository
+	SettingService ISettingService
+	UnitOfWork     transactions.IUnitOfWork
+	Sagas          ISagaService
+	Email          infrastructures.IEmailService
+	WelcomeMail    mails.IMailRenderer
+	Cache          infrastructures.ICache
+	Events         infrastructures.IEventBus
+	Random         infrastructures.IRandom
+	Config         *config.Registration
+}
+
+func (service *RegistrationService) Mode() string {
+	if !service.SettingService.Bool("signup_enabled", true) {
+		return models.RegistrationClosed
+	}
+	return service.SettingService.String("registration_mode", models.RegistrationOpen)
+}
+
+// Register runs the registration saga, in invite only mode the invitation is redeemed with the creation of the user
+func (service *RegistrationService) Register(ctx context.Context, name string, email string, password string, invitationCode string) (user models.User, err error) {
+	mode := service.Mode()
+	switch mode {
+	case models.RegistrationOpen:
+		invitationCode = ""
+	case models.RegistrationInviteOnly:
+		if invitationCode == "" {
+			return user, ErrInvitationRequired
+		}
+	default:
+		return user, ErrRegistrationClosed
+	}
+
+	if _, err = service.UserRepository.GetUserByEmail(email); err == nil {
+		return user, ErrEmailTaken
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return user, err
+	}
+
+	var hashedPassword []byte
+	if hashedPassword, err = helpers.Hash(password); err != nil {
+		return user, err
+	}
+	state := SagaState{}
+	if err = state.Set("input", registrationInput{Name: name, Email: email, Password: string(hashedPassword), InvitationCode: invitationCode}); err != nil {
+		return user, err
+	}
+	if state, err = service.Sagas.Start(ctx, SagaRegistration, state); err != nil {
+		return user, err
+	}
+	var userID uint
+	if err = state.Get("user_id", &userID); err != nil {
+		return user, err
+	}
+	return service.UserRepository.GetUserByID(userID)
+}
+
+func (service *RegistrationService) Verify(ctx context.Context, token string) (user models.User, err error) {
+	user, err = service.UserRepository.GetUserByVerificationToken(hashVerificationToken(token))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return user, ErrVerificationInvalid
+	}
+	if err != nil {
+		return user, err
+	}
+	if err = service.UserRepository.Updates(&user, map[string]interface{}{"verified": true, "verification_token": nil}); err != nil {
+		return user, err
+	}
+	user.Verified, user.VerificationToken = true, nil
+	invalidateCache(service.Cache, CacheTagUsers)
+	service.Events.Publish(EventUserUpdated, UserEvent{UserID: user.ID})
+	return user, nil
+}
+
+func (service *RegistrationService) Saga() SagaDefinition {
+	return SagaDefinition{
+		Name: SagaRegistration,
+		Steps: []SagaStep{
+			{Name: "create-user", Run: service.createUser, Compensate: service.deleteUser},
+			{Name: "provision-defaults", Run: service.provisionDefaults, Compensate: service.removeDefaults},
+			// a mail can not be taken back, it goes last
+			{Name: "send-verification", Run: service.sendVerification},
+		},
+		Completed: func(state SagaState) {
+			var userID uint
+			if state.Get("user_id", &userID) == nil {
+				invalidateCache(service.Cache, CacheTagUsers)
+				service.Events.Publish(EventUserCreated, UserEvent{UserID: userID})
+			}
+		},
+	}
+}
+
+func (service *RegistrationService) createUser(ctx context.Context, repos transactions.RepoSet, state SagaState) error {
+	var input registrationInput
+	if err := state.Get("input", &input); err != nil {
+		return err
+	}
+	if input.InvitationCode != "" {
+		invitationID, err := redeemInvitation(repos.Invitations, input.InvitationCode, input.Email)
+		if err != nil {
+			return err
+		}
+		if err = state.Set("invitation_id", invitationID); err != nil {
+			return err
+		}
+	}
+	user := models.User{
+		Name:     input.Name,
+		Email:    input.Email,
+		Password: input.Password,
+	}
+	if err := repos.Users.Create(&user); err != nil {
+		return err
+	}
+	// the hash of the password is not kept in the saga once the user holds it
+	delete(state, "input")
+	return state.Set("user_id", user.ID)
+}
+
+// deleteUser erases the user as a purge does and gives back the use of its invitation
+func (service *RegistrationService) deleteUser(ctx context.Context, repos transactions.RepoSet, state SagaState) error {
+	var userID uint
+	if err := state.Get("user_id", &userID); err != nil {
+		return err
+	}
+	for _, erasable := range repos.Erasables() {
+		if err := erasable.EraseUserData(userID); err != nil {
+			return err
+		}
+	}
+	var invitationID uint
+	if state.Get("invitation_id", &invitationID) == nil {
+		return repos.Invitations.Release(invitationID)
+	}
+	return nil
+}
+
+// provisionDefaults tags the user with the comma separated tags of the default_user_tags setting
+func (service *RegistrationService) provisionDefaults(ctx context.Context, repos transactions.RepoSet, state SagaState) error {
+	var userID uint
+	if err := state.Get("user_id", &userID); err != nil {
+		return err
+	}
+	tags := normalizeTags(strings.Split(service.SettingService.String("default_user_tags", ""), ","))
+	if len(tags) == 0 {
+		return nil
+	}
+	tags, err := repos.Tags.FindOrCreateTags(tags)
+	if err != nil {
+		return err
+	}
+	tagIDs := make([]uint, len(tags))
+	for i, tag := range tags {
+		tagIDs[i] = tag.ID
+	}
+	return repos.Tags.Attach(models.User{}.TableName(), userID, tagIDs)
+}
+
+func (service *RegistrationService) removeDefaults(ctx context.Context, repos transactions.RepoSet, state SagaState) error {
+	var userID uint
+	if err := state.Get("user_id", &userID); err != nil {
+		return err
+	}
+	return repos.Tags.Sync(models.User{}.TableName(), userID, nil)
+}
+
+// sendVerification stores the hash of a new token and mails its link, a resume mails a new link
+func (service *RegistrationService) sendVerification(ctx context.Context, repos transactions.RepoSet, state SagaState) error {
+	var userID uint
+	if err := state.Get("user_id", &userID); err != nil {
+		return err
+	}
+	user, err := repos.Users.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+	token, err := service.Random.Token(32)
+	if err != nil {
+		return err
+	}
+	if err = repos.Users.Updates(&user, map[string]interface{}{"verification_token": hashVerificationToken(token)}); err != nil {
+		return err
+	}
+	mail, err := service.WelcomeMail.Render(map[string]interface{}{"url": service.Config.VerifyUrl + "?token=" + url.QueryEscape(token)}, []string{user.Email})
+	if err != nil {
+		return err
+	}
+	return service.Email.Send(mail)
+}
+
+// hashVerificationToken only the hash of a token is stored, like the ones of the email changes
+func hashVerificationToken(token string) string {
+	return helpers.ComputeHmacSha1(token, config.Conf.SecretKey)
+}
+
+// redeemInvitation counts a use of the invitation of the code and returns its id
+func redeemInvitation(repository repositories.IInvitationRepository, code string, email string) (uint, error) {
+	now := time.Now()
+	invitation, err := repository.GetInvitationByCode(code)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, ErrInvitationInvalid
+		}
+		return 0, err
+	}
+	if !invitation.IsUsable(now) || (invitation.Email != nil && !strings.EqualFold(*invitation.Email, email)) {
+		return 0, ErrInvitationInvalid
+	}
+	if err = repository.Redeem(&invitation, now); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, ErrInvitationInvalid
+		}
+		return 0, err
+	}
+	return invitation.ID, nil
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/utils"
+)
+
+var (
+	ErrReportNotFound     = problems.Define(problems.ReportNotFound, "report could not be found")
+	ErrReportableNotFound = problems.Define(problems.ReportableNotFound, "the reportable could not be found")
+	ErrAlreadyReported    = problems.Define(problems.AlreadyReported, "you already reported this")
+	ErrNotReportable      = problems.Define(problems.NotReportable, "you can not report yourself")
+	ErrReportClosed       = problems.Define(problems.ReportClosed, "the report is already closed")
+)
+
+// Reportable returns the user responsible for the reportable, gorm.ErrRecordNotFound when it does not exist
+type Reportable func(ID uint) (offenderID uint, err error)
+
+type IReportService interface {
+	Report(user models.User, reportableType string, reportableID uint, reason string, details string) (models.Report, error)
+
+	// Moderation
+	Queue(status string, pagination utils.IPagination) (reports []models.Report, totalCount int64, err error)
+	// Resolve upholds the report against the offender, suspends the offender when it reaches the suspension threshold
+	Resolve(admin models.User, reportID uint, note string) (models.Report, error)
+	Dismiss(admin models.User, reportID uint, note string) (models.Report, error)
+}
+
+type ReportService struct {
+	ReportRepository  repositories.IReportRepository
+	SuspensionService ISuspensionService
+	SettingService    ISettingService
+	Logger            infrastructures.ILogger
+	// Reportables are the types that can be reported, by the name used in the urls
+	Reportables map[string]Reportable
+}
+
+func (service *ReportService) Report(user models.User, reportableType string, reportableID uint, reason string, details string) (report models.Report, err error) {
+	reportable, ok := service.Reportables[reportableType]
+	if !ok {
+		return report, ErrReportableNotFound
+	}
+	offenderID, err := reportable(reportableID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return report, ErrReportableNotFound
+	}
+	if err != nil {
+		return report, err
+	}
+	if offenderID == user.ID {
+		return report, ErrNotReportable
+	}
+	reported, err := service.ReportRepository.HasOpenReport(user.ID, reportableType, reportableID)
+	if err != nil {
+		return report, err
+	}
+	if reported {
+		return report, ErrAlreadyReported
+	}
+
+	report = models.Report{
+		ReporterID:     user.ID,
+		ReportableType: reportableType,
+		ReportableID:   reportableID,
+		OffenderID:     offenderID,
+		Reason:         reason,
+		Details:        details,
+		Status:         models.ReportOpen,
+	}
+	err = service.ReportRepository.Create(&report)
+	return report, err
+}
+
+/**
+ * Moderation
+ *
+ */
+
+func (service *ReportService) Queue(status string, pagination utils.IPagination) (reports []models.Report, totalCount int64, err error) {
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && pagination.GetLimit() > max {
+		pagination.Get().Limit = max
+	}
+	return service.ReportRepository.GetQueue(status, &scopes.GormPagination{Pagination: pagination.Get()})
+}
+
+func (service *ReportService) Resolve(admin models.User, reportID uint, note string) (report models.Report, err error) {
+	if report, err = service.close(admin, reportID, models.ReportResolved, note); err != nil {
+		return report, err
+	}
+	// the report stays resolved when the suspension fails, the next upheld report checks the offender again
+	if err = service.checkOffender(admin, report.OffenderID); err != nil {
+		service.Logger.Error("repeat offender check failed", infrastructures.Fields{"offender_id": report.OffenderID, "error": err.Error()})
+	}
+	return report, nil
+}
+
+func (service *ReportService) Dismiss(admin models.User, reportID uint, note string) (models.Report, error) {
+	return service.close(admin, reportID, models.ReportDismissed, note)
+}
+
+func (service *ReportService) close(admin models.User, reportID uint, status string, note string) (report models.Report, err error) {
+	report, err = service.ReportRepository.GetReportByID(reportID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return report, ErrReportNotFound
+	}
+	if err != nil {
+		return report, err
+	}
+	if report.Status != models.ReportOpen {
+		return report, ErrReportClosed
+	}
+
+	now := time.Now()
+	updates := map[string]interface{}{"status": status, "resolved_by_id": admin.ID, "resolved_at": now, "note": nil}
+	report.Status, report.ResolvedByID, report.ResolvedAt, report.Note = status, &admin.ID, &now, nil
+	if note != "" {
+		updates["note"] = note
+		report.Note = &note
+	}
+	closed, err := service.ReportRepository.Close(&report, updates)
+	if err != nil {
+		return report, err
+	}
+	if !closed {
+		return report, ErrReportClosed
+	}
+	service.Logger.Info("report "+status, infrastructures.Fields{"audit": true, "admin_id": admin.ID, "report_id": report.ID, "offender_id": report.OffenderID})
+	return report, nil
+}
+
+// checkOffender suspends the offender each time its upheld reports within the offense window reach a multiple of
+// the threshold, the suspension lasts report_suspension_duration (indefinitely when 0)
+func (service *ReportService) checkOffender(admin models.User, offenderID uint) error {
+	threshold := service.SettingService.Int("report_suspension_threshold", 3)
+	if threshold <= 0 {
+		return nil
+	}
+	now := time.Now()
+	count, err := service.ReportRepository.CountUpheld(offenderID, now.Add(-service.SettingService.Duration("report_offense_window", 30*24*time.Hour)))
+	if err != nil {
+		return err
+	}
+	if count == 0 || count%int64(threshold) != 0 {
+		return nil
+	}
+
+	var until *time.Time
+	if duration := service.SettingService.Duration("report_suspension_duration", 7*24*time.Hour); duration > 0 {
+		end := now.Add(duration)
+		until = &end
+	}
+	_, err = service.SuspensionService.Suspend(admin, offenderID, until, fmt.Sprintf("%d upheld reports", count))
+	if errors.Is(err, ErrNotSuspendable) || errors.Is(err, ErrUserNotFound) {
+		service.Logger.Warn("repeat offender not suspendable", infrastructures.Fields{"audit": true, "offender_id": offenderID, "reports": count})
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	service.Logger.Info("repeat offender suspended", infrastructures.Fields{"audit": true, "admin_id": admin.ID, "offender_id": offenderID, "reports": count})
+	return nil
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/repositories"
+)
+
+// RetentionPolicy keeps the rows of a model for a while after the time of their column, the older ones are deleted
+type RetentionPolicy struct {
+	// Name names the policy in RETENTION_KEEP and its archives, the table of the model
+	Name   string
+	Model  interface{}
+	Column string
+	Keep   time.Duration
+	// Archive writes the rows to the storage before they are deleted
+	Archive bool
+}
+
+type IRetentionService interface {
+	// Register adds the policy of a module, a policy with the same name is replaced
+	Register(policy RetentionPolicy)
+	Policies() []RetentionPolicy
+	// Enforce archives and deletes the rows past the retention of every policy in batches, scheduled
+	Enforce() error
+}
+
+type RetentionService struct {
+	RetentionRepository repositories.IRetentionRepository
+	Storage             infrastructures.IStorageService
+	Clock               infrastructures.IClock
+	Logger              infrastructures.ILogger
+	Metrics             infrastructures.IMetrics
+	Config              *config.Retention
+
+	policies []RetentionPolicy
+	mu       sync.RWMutex
+}
+
+func (service *RetentionService) Register(policy RetentionPolicy) {
+	if keep, ok := service.Config.Keep[policy.Name]; ok {
+		policy.Keep = keep
+	}
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	for i := range service.policies {
+		if service.policies[i].Name == policy.Name {
+			service.policies[i] = policy
+			return
+		}
+	}
+	service.policies = append(service.policies, policy)
+}
+
+func (service *RetentionService) Policies() []RetentionPolicy {
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+	return append([]RetentionPolicy(nil), service.policies...)
+}
+
+// Enforce goes on with the other policies when one fails, the first error is returned
+func (service *RetentionService) Enforce() (err error) {
+	for _, policy := range service.Policies() {
+		deleted, e := service.enforce(policy)
+		if deleted > 0 {
+			service.Logger.Info("retention enforced", infrastructures.Fields{"policy": policy.Name, "deleted": deleted, "archived": policy.Archive})
+		}
+		if e != nil {
+			service.Logger.Error("retention failed", infrastructures.Fields{"policy": policy.Name, "error": e.Error()})
+			if err == nil {
+				err = e
+			}
+		}
+	}
+	return err
+}
+
+// enforce deletes the rows before the cutoff a batch at a time, a batch is only deleted once its archive is stored
+func (service *RetentionService) enforce(policy RetentionPolicy) (deleted int64, err error) {
+	if policy.Keep <= 0 {
+		return 0, nil
+	}
+	now := service.Clock.Now()
+	before := now.Add(-policy.Keep)
+	for {
+		rows, err := service.RetentionRepository.Expired(policy.Model, policy.Column, before, service.Config.BatchSize)
+		if err != nil || len(rows) == 0 {
+			return deleted, err
+		}
+		IDs := make([]interface{}, 0, len(rows))
+		for _, row := range rows {
+			IDs = append(IDs, row["id"])
+		}
+		if policy.Archive {
+			if err = service.archive(policy, now, rows); err != nil {
+				return deleted, err
+			}
+			service.Metrics.Inc("retention_archived_total", map[string]string{"policy": policy.Name}, float64(len(rows)))
+		}
+		count, err := service.RetentionRepository.DeleteByIDs(policy.Model, IDs)
+		deleted += count
+		service.Metrics.Inc("retention_deleted_total", map[string]string{"policy": policy.Name}, float64(count))
+		if err != nil || len(rows) < service.Config.BatchSize {
+			return deleted, err
+		}
+	}
+}
+
+// archive stores the rows as gzipped json lines, named after the day of the run and the ids of the batch so a batch
+// archived again after a failed delete replaces its archive
+func (service *RetentionService) archive(policy RetentionPolicy, now time.Time, rows []map[string]interface{}) error {
+	var content bytes.Buffer
+	writer := gzip.NewWriter(&content)
+	encoder := json.NewEncoder(writer)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%v-%v.jsonl.gz", rows[0]["id"], rows[len(rows)-1]["id"])
+	return service.Storage.Put(path.Join(service.Config.ArchivePath, policy.Name, now.UTC().Format("2006-01-02"), name), content.Bytes())
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/repositories"
+	"gotham/repositories/transactions"
+)
+
+// the stalled sagas resumed at a time
+const sagaResumeBatch = 100
+
+var ErrSagaNotFound = errors.New("saga is not registered")
+
+// SagaState holds the values the steps of a saga share by key, stored as json so a resumed saga gets them back
+type SagaState map[string]json.RawMessage
+
+// Get decodes the value of the key into value
+func (s SagaState) Get(key string, value interface{}) error {
+	raw, ok := s[key]
+	if !ok {
+		return fmt.Errorf("saga state has no %q", key)
+	}
+	return json.Unmarshal(raw, value)
+}
+
+func (s SagaState) Set(key string, value interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	s[key] = raw
+	return nil
+}
+
+/**
+ * SagaStep
+ * a step of a saga. Run and Compensate are committed in one transaction with the progress of the saga, their changes
+ * to the database through the repositories of the set are done once. Their other effects (a mail) are done again when
+ * a crash stops the saga between them and the commit, so they must bear it. Compensate undoes Run, nil when there is
+ * nothing to undo
+ */
+type SagaStep struct {
+	Name       string
+	Run        func(ctx context.Context, repos transactions.RepoSet, state SagaState) error
+	Compensate func(ctx context.Context, repos transactions.RepoSet, state SagaState) error
+}
+
+// SagaDefinition is a workflow, its steps run in order. The steps that can not be undone go last
+type SagaDefinition struct {
+	Name  string
+	Steps []SagaStep
+	// Completed is called once the last step is committed, by Start or by a resume
+	Completed func(state SagaState)
+}
+
+type ISagaService interface {
+	// Register adds the definition of a workflow, the sagas are resumed by its name
+	Register(definition SagaDefinition)
+	// Start runs the steps of the saga in order and returns the state after the last one. When a step fails the steps
+	// done are compensated in the reverse order and the error of the step is returned
+	Start(ctx context.Context, name string, state SagaState) (SagaState, error)
+	// Resume goes on with the sagas without progress for SAGA_STALL_AFTER, the running ones from their next step and the
+	// compensating ones from their last step done, scheduled
+	Resume() error
+}
+
+type SagaService struct {
+	SagaRepository repositories.ISagaRepository
+	UnitOfWork     transactions.IUnitOfWork
+	Logger         infrastructures.ILogger
+	Clock          infrastructures.IClock
+	Config         *config.Saga
+
+	definitions map[string]SagaDefinition
+	mu          sync.RWMutex
+}
+
+func (service *SagaService) Register(definition SagaDefinition) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if service.definitions == nil {
+		service.definitions = map[string]SagaDefinition{}
+	}
+	service.definitions[definition.Name] = definition
+}
+
+func (service *SagaService) Start(ctx context.Context, name string, state SagaState) (SagaState, error) {
+	definition, ok := service.definition(name)
+	if !ok {
+		return state, fmt.Errorf("%w %q", ErrSagaNotFound, name)
+	}
+	if state == nil {
+		state = SagaState{}
+	}
+	saga := models.Saga{Name: name, Status: models.SagaRunning}
+	if err := service.save(service.SagaRepository.Create, &saga, state); err != nil {
+		return state, err
+	}
+	return state, service.run(ctx, definition, &saga, state)
+}
+
+// Resume goes on with the other sagas when one fails, a saga resumed more than SAGA_MAX_ATTEMPTS times is failed
+func (service *SagaService) Resume() error {
+	sagas, err := service.SagaRepository.GetStalledSagas(service.Clock.Now().Add(-service.Config.StallAfter), sagaResumeBatch)
+	if err != nil {
+		return err
+	}
+	for i := range sagas {
+		saga := &sagas[i]
+		// another instance resumes it
+		if claimed, err := service.SagaRepository.Claim(saga); err != nil || !claimed {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if err = service.resume(saga); err != nil {
+			service.Logger.Error("saga resume failed", infrastructures.Fields{"saga": saga.Name, "saga_id": saga.ID, "error": err.Error()})
+		}
+	}
+	return nil
+}
+
+func (service *SagaService) resume(saga *models.Saga) error {
+	definition, ok := service.definition(saga.Name)
+	if !ok {
+		return fmt.Errorf("%w %q", ErrSagaNotFound, saga.Name)
+	}
+	if saga.Attempts > service.Config.MaxAttempts {
+		saga.Status = models.SagaFailed
+		service.Logger.Error("saga failed", infrastructures.Fields{"saga": saga.Name, "saga_id": saga.ID, "step": saga.Step, "attempts": saga.Attempts})
+		return service.SagaRepository.Save(saga)
+	}
+	state := SagaState{}
+	if err := json.Unmarshal(saga.State, &state); err != nil {
+		return err
+	}
+	service.Logger.Info("saga resumed", infrastructures.Fields{"saga": saga.Name, "saga_id": saga.ID, "status": saga.Status, "step": saga.Step})
+	if saga.Status == models.SagaCompensating {
+		return service.compensate(context.Background(), definition, saga, state)
+	}
+	return service.run(context.Background(), definition, saga, state)
+}
+
+// run runs the steps from the next one, a failed step turns the saga to compensating and compensates it
+func (service *SagaService) run(ctx context.Context, definition SagaDefinition, saga *models.Saga, state SagaState) error {
+	for saga.Step < len(definition.Steps) {
+		step := definition.Steps[saga.Step]
+		progress := *saga
+		progress.Step++
+		if progress.Step == len(definition.Steps) {
+			progress.Status = models.SagaCompleted
+		}
+		err := service.UnitOfWork.WithinTransaction(ctx, func(repos transactions.RepoSet) error {
+			if err := step.Run(ctx, repos, state); err != nil {
+				return err
+			}
+			return service.save(repos.Sagas.Save, &progress, state)
+		})
+		if err == nil {
+			*saga = progress
+			if saga.Status == models.SagaCompleted && definition.Completed != nil {
+				definition.Completed(state)
+			}
+			continue
+		}
+
+		service.Logger.Warn("saga step failed", infrastructures.Fields{"saga": saga.Name, "saga_id": saga.ID, "step": step.Name, "error": err.Error()})
+		// the changes of the failed step to the state are rolled back with its transaction
+		saved := SagaState{}
+		if e := json.Unmarshal(saga.State, &saved); e != nil {
+			return err
+		}
+		message := err.Error()
+		if len(message) > 1000 {
+			message = message[:1000]
+		}
+		saga.Status, saga.Error = models.SagaCompensating, &message
+		if e := service.SagaRepository.Save(saga); e != nil {
+			return err
+		}
+		if e := service.compensate(ctx, definition, saga, saved); e != nil {
+			service.Logger.Error("saga not compensated", infrastructures.Fields{"saga": saga.Name, "saga_id": saga.ID, "step": saga.Step, "error": e.Error()})
+		}
+		return err
+	}
+	return nil
+}
+
+// compensate undoes the steps done from the last one, a failed compensation leaves the saga compensating to be resumed
+func (service *SagaService) compensate(ctx context.Context, definition SagaDefinition, saga *models.Saga, state SagaState) error {
+	if saga.Step == 0 {
+		saga.Status = models.SagaCompensated
+		return service.SagaRepository.Save(saga)
+	}
+	for saga.Step > 0 {
+		step := definition.Steps[saga.Step-1]
+		progress := *saga
+		progress.Step--
+		if progress.Step == 0 {
+			progress.Status = models.SagaCompensated
+		}
+		err := service.UnitOfWork.WithinTransaction(ctx, func(repos transactions.RepoSet) error {
+			if step.Compensate != nil {
+				if err := step.Compensate(ctx, repos, state); err != nil {
+					return err
+				}
+			}
+			return service.save(repos.Sagas.Save, &progress, state)
+		})
+		if err != nil {
+			return fmt.Errorf("%v: %w", step.Name, err)
+		}
+		*saga = progress
+	}
+	service.Logger.Info("saga compensated", infrastructures.Fields{"saga": saga.Name, "saga_id": saga.ID})
+	return nil
+}
+
+// save writes the saga with the state through write, the Create or the Save of a repository
+func (service *SagaService) save(write func(saga *models.Saga) error, saga *models.Saga, state SagaState) (err error) {
+	if saga.State, err = json.Marshal(state); err != nil {
+		return err
+	}
+	return write(saga)
+}
+
+func (service *SagaService) definition(name string) (SagaDefinition, bool) {
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+	definition, ok := service.definitions[name]
+	return definition, ok
+}
@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/repositories"
+)
+
+var ErrSearchIndexNotFound = errors.New("search: unknown index")
+
+// SearchIndex reads the records of an index from the database
+type SearchIndex struct {
+	Count func() (int64, error)
+	Find  func(IDs []uint) ([]models.Searchable, error)
+	// Each hands the records to handle in batches of batchSize, in the order of their ids
+	Each func(ctx context.Context, batchSize int, handle func(records []models.Searchable) error) error
+}
+
+// SearchIndexOf is the SearchIndex of the records of a repository
+func SearchIndexOf[T models.Searchable](repository repositories.IBaseRepository[T]) SearchIndex {
+	searchables := func(records []T, err error) ([]models.Searchable, error) {
+		result := make([]models.Searchable, 0, len(records))
+		for _, record := range records {
+			result = append(result, record)
+		}
+		return result, err
+	}
+	return SearchIndex{
+		Count: func() (int64, error) {
+			return repository.Count()
+		},
+		Find: func(IDs []uint) ([]models.Searchable, error) {
+			return searchables(repository.FindByIDs(IDs))
+		},
+		Each: func(ctx context.Context, batchSize int, handle func(records []models.Searchable) error) error {
+			return repository.FindEach(ctx, batchSize, func(records []T) error {
+				batch, _ := searchables(records, nil)
+				return handle(batch)
+			})
+		},
+	}
+}
+
+// SearchProgress is reported after each batch of a reindex
+type SearchProgress struct {
+	Index string
+	Done  int64
+	Total int64
+}
+
+func (p SearchProgress) String() string {
+	percent := int64(100)
+	if p.Total > 0 {
+		percent = p.Done * 100 / p.Total
+	}
+	return fmt.Sprintf("%v: %v/%v (%v%%)", p.Index, p.Done, p.Total, percent)
+}
+
+type ISearchService interface {
+	// Indexes are the names of the indexes, the tables of their records
+	Indexes() []string
+	// Reindex indexes every record of the index in batches until the context is done, the records that must not be found
+	// are deleted from it
+	Reindex(ctx context.Context, index string, progress func(SearchProgress)) error
+
+	// Start starts the workers indexing the records changed since
+	Start()
+	Close() error
+
+	// Listeners
+	// Sync queues the models.Searchable of the event to be indexed again, or deleted from its index when it is gone
+	Sync(event infrastructures.Event) error
+}
+
+type searchChange struct {
+	index string
+	ID    uint
+}
+
+type SearchService struct {
+	Engine infrastructures.ISearchEngine
+	Config *config.Search
+	Logger infrastructures.ILogger
+	// Searchables are the indexed types, by the table of their records
+	Searchables map[string]SearchIndex
+
+	// a worker per queue, a record always goes to the same worker so its changes are indexed in order
+	queues []chan searchChange
+	wg     sync.WaitGroup
+	mu     sync.RWMutex
+}
+
+func (service *SearchService) Indexes() []string {
+	names := make([]string, 0, len(service.Searchables))
+	for name := range service.Searchables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (service *SearchService) Reindex(ctx context.Context, index string, progress func(SearchProgress)) error {
+	searchIndex, ok := service.Searchables[index]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrSearchIndexNotFound, index)
+	}
+	total, err := searchIndex.Count()
+	if err != nil {
+		return err
+	}
+	report := SearchProgress{Index: index, Total: total}
+	return searchIndex.Each(ctx, service.Config.BatchSize, func(records []models.Searchable) error {
+		if err := service.index(index, records, nil); err != nil {
+			return err
+		}
+		report.Done += int64(len(records))
+		if progress != nil {
+			progress(report)
+		}
+		return nil
+	})
+}
+
+func (service *SearchService) Start() {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if service.queues != nil {
+		return
+	}
+	for i := 0; i < service.Config.Workers; i++ {
+		queue := make(chan searchChange, service.Config.BatchSize)
+		service.queues = append(service.queues, queue)
+		service.wg.Add(1)
+		go service.work(queue)
+	}
+}
+
+// Close indexes the queued changes and stops the workers
+func (service *SearchService) Close() error {
+	service.mu.Lock()
+	for _, queue := range service.queues {
+		close(queue)
+	}
+	service.queues = nil
+	service.mu.Unlock()
+	service.wg.Wait()
+	return nil
+}
+
+func (service *SearchService) Sync(event infrastructures.Event) error {
+	record, ok := event.Payload.(models.Searchable)
+	if !ok {
+		return fmt.Errorf("unexpected payload %T", event.Payload)
+	}
+	if _, ok = service.Searchables[record.TableName()]; !ok {
+		return fmt.Errorf("%w %q", ErrSearchIndexNotFound, record.TableName())
+	}
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+	// changes made while the workers are stopped are caught up by a reindex
+	if len(service.queues) == 0 {
+		return nil
+	}
+	service.queues[record.SearchID()%uint(len(service.queues))] <- searchChange{index: record.TableName(), ID: record.SearchID()}
+	return nil
+}
+
+// work indexes the changes of its queue in batches, once the batch is full or the flush interval has passed
+func (service *SearchService) work(queue chan searchChange) {
+	defer service.wg.Done()
+	ticker := time.NewTicker(service.Config.FlushInterval)
+	defer ticker.Stop()
+
+	pending := map[string]map[uint]bool{}
+	size := 0
+	flush := func() {
+		for index, IDs := range pending {
+			if err := service.sync(index, IDs); err != nil {
+				service.Logger.Error("search index could not be synced", infrastructures.Fields{"index": index, "records": len(IDs), "error": err.Error()})
+			}
+		}
+		pending, size = map[string]map[uint]bool{}, 0
+	}
+	for {
+		select {
+		case change, ok := <-queue:
+			if !ok {
+				flush()
+				return
+			}
+			if pending[change.index] == nil {
+				pending[change.index] = map[uint]bool{}
+			}
+			if !pending[change.index][change.ID] {
+				pending[change.index][change.ID] = true
+				size++
+			}
+			if size >= service.Config.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			if size > 0 {
+				flush()
+			}
+		}
+	}
+}
+
+// sync indexes the records of the ids as they are now, the ones no longer found are deleted from the index
+func (service *SearchService) sync(index string, IDs map[uint]bool) error {
+	searchIndex, ok := service.Searchables[index]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrSearchIndexNotFound, index)
+	}
+	changed := make([]uint, 0, len(IDs))
+	for ID := range IDs {
+		changed = append(changed, ID)
+	}
+	records, err := searchIndex.Find(changed)
+	if err != nil {
+		return err
+	}
+	found := map[uint]bool{}
+	for _, record := range records {
+		found[record.SearchID()] = true
+	}
+	var gone []uint
+	for _, ID := range changed {
+		if !found[ID] {
+			gone = append(gone, ID)
+		}
+	}
+	return service.index(index, records, gone)
+}
+
+// index sends the documents of the records to the engine and deletes the ids and the records that must not be found
+func (service *SearchService) index(index string, records []models.Searchable, deleted []uint) error {
+	documents := make([]infrastructures.SearchDocument, 0, len(records))
+	for _, record := range records {
+		if document := record.SearchDocument(); document != nil {
+			documents = append(documents, document)
+		} else {
+			deleted = append(deleted, record.SearchID())
+		}
+	}
+	if err := service.Engine.Index(index, documents); err != nil {
+		return err
+	}
+	return service.Engine.Delete(index, deleted)
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+var ErrRefreshTokenInvalid = problems.Define(problems.RefreshTokenInvalid, "the refresh token is invalid or expired")
+
+type ISessionService interface {
+	// Start opens a remember me session for the device and returns its first refresh token
+	Start(user models.User, deviceID string, userAgent string) (session models.Session, refreshToken string, err error)
+	// Refresh rotates the refresh token and slides the expiry of the session, a token used twice revokes the session
+	Refresh(refreshToken string, deviceID string) (user models.User, session models.Session, newRefreshToken string, err error)
+	// Revoke ends the session of the refresh token
+	Revoke(refreshToken string) error
+}
+
+type SessionService struct {
+	SessionRepository repositories.ISessionRepository
+	UserRepository    repositories.IUserRepository
+	Logger            infrastructures.ILogger
+	Config            *config.Session
+	Clock             infrastructures.IClock
+	Random            infrastructures.IRandom
+}
+
+func (service *SessionService) Start(user models.User, deviceID string, userAgent string) (session models.Session, refreshToken string, err error) {
+	var secret string
+	if secret, err = service.Random.Token(32); err != nil {
+		return session, "", err
+	}
+	now := service.Clock.Now()
+	if len(userAgent) > 255 {
+		userAgent = userAgent[:255]
+	}
+	session = models.Session{
+		UserID:     user.ID,
+		DeviceID:   deviceID,
+		UserAgent:  userAgent,
+		TokenHash:  hashRefreshSecret(secret),
+		ExpiresAt:  now.Add(service.Config.RefreshTTL),
+		LastUsedAt: now,
+	}
+	if err = service.SessionRepository.Create(&session); err != nil {
+		return session, "", err
+	}
+	return session, formatRefreshToken(session.ID, secret), nil
+}
+
+func (service *SessionService) Refresh(refreshToken string, deviceID string) (user models.User, session models.Session, newRefreshToken string, err error) {
+	if session, err = service.sessionOf(refreshToken); err != nil {
+		return user, session, "", err
+	}
+	now := service.Clock.Now()
+	_, secret, _ := parseRefreshToken(refreshToken)
+	hash := hashRefreshSecret(secret)
+
+	if session.TokenHash != hash {
+		// a rotated token coming back means it was copied, the whole session is ended
+		if session.PreviousTokenHash != nil && *session.PreviousTokenHash == hash && session.RevokedAt == nil {
+			if err = service.SessionRepository.Revoke(&session, now); err != nil {
+				return user, session, "", err
+			}
+			service.Logger.Warn("refresh token reused, session revoked", infrastructures.Fields{"audit": true, "user_id": session.UserID, "session_id": session.ID})
+		}
+		return user, session, "", ErrRefreshTokenInvalid
+	}
+	if !session.IsActive(now) {
+		return user, session, "", ErrRefreshTokenInvalid
+	}
+	if session.DeviceID != deviceID {
+		if err = service.SessionRepository.Revoke(&session, now); err != nil {
+			return user, session, "", err
+		}
+		service.Logger.Warn("refresh token used from another device, session revoked", infrastructures.Fields{"audit": true, "user_id": session.UserID, "session_id": session.ID})
+		return user, session, "", ErrRefreshTokenInvalid
+	}
+
+	if user, err = service.UserRepository.GetUserByID(session.UserID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return user, session, "", ErrRefreshTokenInvalid
+		}
+		return user, session, "", err
+	}
+
+	if secret, err = service.Random.Token(32); err != nil {
+		return user, session, "", err
+	}
+	// sliding expiry, bounded by the lifetime of the session
+	expiresAt := now.Add(service.Config.RefreshTTL)
+	if limit := session.CreatedAt.Add(service.Config.MaxLifetime); expiresAt.After(limit) {
+		expiresAt = limit
+	}
+	if err = service.SessionRepository.Rotate(&session, hashRefreshSecret(secret), expiresAt, now); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return user, session, "", ErrRefreshTokenInvalid
+		}
+		return user, session, "", err
+	}
+	return user, session, formatRefreshToken(session.ID, secret), nil
+}
+
+func (service *SessionService) Revoke(refreshToken string) error {
+	session, err := service.sessionOf(refreshToken)
+	if err != nil {
+		return err
+	}
+	_, secret, _ := parseRefreshToken(refreshToken)
+	if session.TokenHash != hashRefreshSecret(secret) {
+		return ErrRefreshTokenInvalid
+	}
+	if session.RevokedAt != nil {
+		return nil
+	}
+	return service.SessionRepository.Revoke(&session, service.Clock.Now())
+}
+
+func (service *SessionService) sessionOf(refreshToken string) (session models.Session, err error) {
+	id, _, ok := parseRefreshToken(refreshToken)
+	if !ok {
+		return session, ErrRefreshTokenInvalid
+	}
+	if session, err = service.SessionRepository.GetSessionByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return session, ErrRefreshTokenInvalid
+		}
+		return session, err
+	}
+	return session, nil
+}
+
+// refresh tokens are "<session id>.<secret>", the id finds the session and only the hash of the secret is stored
+func formatRefreshToken(sessionID uint, secret string) string {
+	return fmt.Sprintf("%d.%s", sessionID, secret)
+}
+
+func parseRefreshToken(refreshToken string) (sessionID uint, secret string, ok bool) {
+	parts := strings.SplitN(refreshToken, ".", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return 0, "", false
+	}
+	id, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || id == 0 {
+		return 0, "", false
+	}
+	return uint(id), parts[1], true
+}
+
+func hashRefreshSecret(secret string) string {
+	return helpers.ComputeHmacSha1(secret, config.Conf.SecretKey)
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/config"
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/policies"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+var (
+	ErrSettingNotFound = problems.Define(problems.SettingNotFound, "setting could not be found")
+	ErrSettingInvalid  = problems.Define(problems.SettingInvalid, "the value does not match the type of the setting")
+)
+
+type ISettingService interface {
+	GetSettings() ([]models.Setting, error)
+	GetSetting(key string) (models.Setting, error)
+	Update(key string, value string) (models.Setting, error)
+	// Invalidate drops the cache, the next read loads the settings again
+	Invalidate()
+
+	// typed getters, the fallback is returned when the setting is missing or can not be parsed
+	String(key string, fallback string) string
+	Int(key string, fallback int) int
+	Bool(key string, fallback bool) bool
+	Duration(key string, fallback time.Duration) time.Duration
+}
+
+// SettingService keeps the settings in memory, a change made through another instance is seen once the cache expires
+type SettingService struct {
+	SettingRepository repositories.ISettingRepository
+	Cache             infrastructures.ICache
+	Config            *config.Settings
+
+	cache    map[string]models.Setting
+	loadedAt time.Time
+	mu       sync.RWMutex
+}
+
+func (service *SettingService) GetSettings() (settings []models.Setting, err error) {
+	var cache map[string]models.Setting
+	if cache, err = service.load(); err != nil {
+		return nil, err
+	}
+	settings = make([]models.Setting, 0, len(cache))
+	for _, setting := range cache {
+		settings = append(settings, setting)
+	}
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].Key < settings[j].Key
+	})
+	return settings, nil
+}
+
+func (service *SettingService) GetSetting(key string) (models.Setting, error) {
+	cache, err := service.load()
+	if err != nil {
+		return models.Setting{}, err
+	}
+	setting, ok := cache[key]
+	if !ok {
+		return setting, ErrSettingNotFound
+	}
+	return setting, nil
+}
+
+// Update validates the value against the type of the setting before it is stored
+func (service *SettingService) Update(key string, value string) (setting models.Setting, err error) {
+	setting, err = service.SettingRepository.GetSettingByKey(key)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return setting, ErrSettingNotFound
+		}
+		return setting, err
+	}
+	if !validSettingValue(setting, value) {
+		return setting, ErrSettingInvalid
+	}
+
+	setting.Value = value
+	if err = service.SettingRepository.Save(&setting); err != nil {
+		return setting, err
+	}
+	service.Invalidate()
+	invalidateCache(service.Cache, CacheTagSettings)
+	return setting, nil
+}
+
+func (service *SettingService) Invalidate() {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.cache = nil
+}
+
+func (service *SettingService) String(key string, fallback string) string {
+	if setting, err := service.GetSetting(key); err == nil {
+		return setting.Value
+	}
+	return fallback
+}
+
+func (service *SettingService) Int(key string, fallback int) int {
+	if setting, err := service.GetSetting(key); err == nil {
+		if value, err := strconv.Atoi(setting.Value); err == nil {
+			return value
+		}
+	}
+	return fallback
+}
+
+func (service *SettingService) Bool(key string, fallback bool) bool {
+	if setting, err := service.GetSetting(key); err == nil {
+		if value, err := strconv.ParseBool(setting.Value); err == nil {
+			return value
+		}
+	}
+	return fallback
+}
+
+func (service *SettingService) Duration(key string, fallback time.Duration) time.Duration {
+	if setting, err := service.GetSetting(key); err == nil {
+		if value, err := time.ParseDuration(setting.Value); err == nil {
+			return value
+		}
+	}
+	return fallback
+}
+
+// load returns the cached settings, loading them when the cache is empty or expired
+func (service *SettingService) load() (map[string]models.Setting, error) {
+	service.mu.RLock()
+	cache, loadedAt := service.cache, service.loadedAt
+	service.mu.RUnlock()
+	if cache != nil && time.Since(loadedAt) < service.Config.CacheTTL {
+		return cache, nil
+	}
+
+	settings, err := service.SettingRepository.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	cache = make(map[string]models.Setting, len(settings))
+	for _, setting := range settings {
+		cache[setting.Key] = setting
+	}
+
+	service.mu.Lock()
+	service.cache, service.loadedAt = cache, time.Now()
+	service.mu.Unlock()
+	return cache, nil
+}
+
+func validSettingValue(setting models.Setting, value string) (valid bool) {
+	if setting.Options != "" && !helpers.InArray(value, strings.Split(setting.Options, ",")) {
+		return false
+	}
+
+	var err error
+	switch setting.Type {
+	case models.SettingInt:
+		_, err = strconv.Atoi(value)
+	case models.SettingBool:
+		_, err = strconv.ParseBool(value)
+	case models.SettingDuration:
+		_, err = time.ParseDuration(value)
+	case models.SettingExpression:
+		if value != "" {
+			_, err = policies.ParseExpression(value)
+		}
+	}
+	return err == nil
+}
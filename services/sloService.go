@@ -0,0 +1,239 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"gotham/config"
+	"gotham/infrastructures"
+)
+
+const (
+	// EventSloBurning is published with the SloReport of a route burning its error budget too fast
+	EventSloBurning = "slo.burning"
+	// EventSloRecovered is published with the SloReport of a route that stopped burning
+	EventSloRecovered = "slo.recovered"
+)
+
+// the windows of the burn rates, a route burning too fast over both is alerted: over the long one it is not a spike,
+// over the short one it still goes on
+const (
+	sloLongWindow  = time.Hour
+	sloShortWindow = 5 * time.Minute
+)
+
+// SloReport is the compliance of a route to its objective over the requests of the instance in the window
+type SloReport struct {
+	Route     string  `json:"route"`
+	LatencyMs int64   `json:"latency_ms"`
+	Objective float64 `json:"objective"`
+	Window    string  `json:"window"`
+	// Since is the start of the counts, the start of the window or of the instance
+	Since    time.Time `json:"since"`
+	Requests int64     `json:"requests"`
+	Good     int64     `json:"good"`
+	// Compliance is the share of the good requests, 1 without requests
+	Compliance float64 `json:"compliance"`
+	// ErrorBudgetRemaining is the share of the errors allowed in the window not spent yet, negative once exhausted
+	ErrorBudgetRemaining float64 `json:"error_budget_remaining"`
+	BurnRateLong         float64 `json:"burn_rate_1h"`
+	BurnRateShort        float64 `json:"burn_rate_5m"`
+	Burning              bool    `json:"burning"`
+}
+
+type ISloService interface {
+	// Objective returns the objective of the route, false when it has none
+	Objective(route string) (config.SloObjective, bool)
+	// Track counts a request of the route, good when it is answered within the latency of its objective without a server
+	// error
+	Track(route string, latency time.Duration, status int)
+	// Report returns the compliance, the error budget and the burn rates of every objective
+	Report() []SloReport
+	// Evaluate publishes slo.burning once the error budget of a route burns SLO_BURN_RATE times too fast, and
+	// slo.recovered once it stops, scheduled
+	Evaluate() error
+}
+
+// sloBucket counts the requests of a minute
+type sloBucket struct {
+	minute   int64
+	requests int64
+	good     int64
+}
+
+// sloSeries are the buckets of the minutes of the window of a route, a ring
+type sloSeries struct {
+	buckets []sloBucket
+	since   time.Time
+	burning bool
+}
+
+type SloService struct {
+	Events  infrastructures.IEventBus
+	Metrics infrastructures.IMetrics
+	Logger  infrastructures.ILogger
+	Clock   infrastructures.IClock
+	Config  *config.Slo
+
+	series map[string]*sloSeries
+	mu     sync.Mutex
+}
+
+func (service *SloService) Objective(route string) (config.SloObjective, bool) {
+	objective, ok := service.Config.Routes[route]
+	return objective, ok
+}
+
+func (service *SloService) Track(route string, latency time.Duration, status int) {
+	objective, ok := service.Objective(route)
+	if !ok {
+		return
+	}
+	good := status < 500 && latency <= objective.Latency
+	service.Metrics.Inc("slo_requests_total", map[string]string{"route": route}, 1)
+	if good {
+		service.Metrics.Inc("slo_requests_good_total", map[string]string{"route": route}, 1)
+	}
+
+	now := service.Clock.Now()
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	bucket := service.seriesOf(route, now).bucket(now)
+	bucket.requests++
+	if good {
+		bucket.good++
+	}
+}
+
+func (service *SloService) Report() []SloReport {
+	now := service.Clock.Now()
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	reports := make([]SloReport, 0, len(service.Config.Routes))
+	for route, objective := range service.Config.Routes {
+		report, _ := service.report(route, objective, now)
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Route < reports[j].Route
+	})
+	return reports
+}
+
+func (service *SloService) Evaluate() error {
+	now := service.Clock.Now()
+	var burning, recovered []SloReport
+	service.mu.Lock()
+	for route, objective := range service.Config.Routes {
+		report, shortRequests := service.report(route, objective, now)
+		service.Metrics.Set("slo_burn_rate", map[string]string{"route": route, "window": "1h"}, report.BurnRateLong)
+		service.Metrics.Set("slo_burn_rate", map[string]string{"route": route, "window": "5m"}, report.BurnRateShort)
+		service.Metrics.Set("slo_error_budget_remaining", map[string]string{"route": route}, report.ErrorBudgetRemaining)
+
+		series := service.seriesOf(route, now)
+		report.Burning = shortRequests >= service.Config.MinRequests && report.BurnRateLong >= service.Config.BurnRate && report.BurnRateShort >= service.Config.BurnRate
+		if report.Burning && !series.burning {
+			burning = append(burning, report)
+		} else if !report.Burning && series.burning {
+			recovered = append(recovered, report)
+		}
+		series.burning = report.Burning
+	}
+	service.mu.Unlock()
+
+	for _, report := range burning {
+		service.Logger.Error("slo burning", infrastructures.Fields{"route": report.Route, "burn_rate_1h": report.BurnRateLong, "burn_rate_5m": report.BurnRateShort, "error_budget_remaining": report.ErrorBudgetRemaining})
+		service.Events.Publish(EventSloBurning, report)
+	}
+	for _, report := range recovered {
+		service.Logger.Info("slo recovered", infrastructures.Fields{"route": report.Route, "burn_rate_1h": report.BurnRateLong, "burn_rate_5m": report.BurnRateShort})
+		service.Events.Publish(EventSloRecovered, report)
+	}
+	return nil
+}
+
+// report is the report of the route at now with the requests of the short window, the lock is held
+func (service *SloService) report(route string, objective config.SloObjective, now time.Time) (SloReport, int64) {
+	report := SloReport{
+		Route:                route,
+		LatencyMs:            objective.Latency.Milliseconds(),
+		Objective:            objective.Objective,
+		Window:               service.Config.Window.String(),
+		Since:                now.Add(-service.Config.Window),
+		Compliance:           1,
+		ErrorBudgetRemaining: 1,
+	}
+	series, ok := service.series[route]
+	if !ok {
+		report.Since = now
+		return report, 0
+	}
+	if series.since.After(report.Since) {
+		report.Since = series.since
+	}
+	report.Requests, report.Good = series.sum(now, service.Config.Window)
+	report.Burning = series.burning
+	if report.Requests > 0 {
+		report.Compliance = roundRatio(float64(report.Good) / float64(report.Requests))
+		report.ErrorBudgetRemaining = roundRatio(1 - burnRate(report.Requests, report.Good, objective.Objective))
+	}
+	longRequests, longGood := series.sum(now, sloLongWindow)
+	shortRequests, shortGood := series.sum(now, sloShortWindow)
+	report.BurnRateLong = roundRatio(burnRate(longRequests, longGood, objective.Objective))
+	report.BurnRateShort = roundRatio(burnRate(shortRequests, shortGood, objective.Objective))
+	return report, shortRequests
+}
+
+// seriesOf is the series of the route, made on its first request, the lock is held
+func (service *SloService) seriesOf(route string, now time.Time) *sloSeries {
+	if service.series == nil {
+		service.series = map[string]*sloSeries{}
+	}
+	series, ok := service.series[route]
+	if !ok {
+		window := service.Config.Window
+		if window < sloLongWindow {
+			window = sloLongWindow
+		}
+		series = &sloSeries{buckets: make([]sloBucket, int(window/time.Minute)+1), since: now}
+		service.series[route] = series
+	}
+	return series
+}
+
+func (s *sloSeries) bucket(now time.Time) *sloBucket {
+	minute := now.Unix() / 60
+	bucket := &s.buckets[minute%int64(len(s.buckets))]
+	if bucket.minute != minute {
+		*bucket = sloBucket{minute: minute}
+	}
+	return bucket
+}
+
+// sum counts the requests of the minutes of the window up to now
+func (s *sloSeries) sum(now time.Time, window time.Duration) (requests int64, good int64) {
+	minute := now.Unix() / 60
+	from := minute - int64(window/time.Minute)
+	for _, bucket := range s.buckets {
+		if bucket.minute > from && bucket.minute <= minute {
+			requests += bucket.requests
+			good += bucket.good
+		}
+	}
+	return requests, good
+}
+
+// burnRate is how many times faster than allowed the errors spend the error budget, 1 spends it in exactly the window
+func burnRate(requests int64, good int64, objective float64) float64 {
+	if requests == 0 {
+		return 0
+	}
+	return (float64(requests-good) / float64(requests)) / (1 - objective)
+}
+
+// roundRatio keeps 4 decimals, a ratio of the report reads 0.9871
+func roundRatio(value float64) float64 {
+	return math.Round(value*10000) / 10000
+}
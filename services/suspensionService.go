@@ -0,0 +1,114 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+var ErrNotSuspendable = problems.Define(problems.NotSuspendable, "the user can not be suspended")
+
+type ISuspensionService interface {
+	// Suspend suspends the user until the given time, indefinitely when until is nil
+	Suspend(admin models.User, userID uint, until *time.Time, reason string) (models.User, error)
+	Unsuspend(admin models.User, userID uint) (models.User, error)
+	// LiftExpiredSuspensions clears the suspensions that are over, scheduled
+	LiftExpiredSuspensions() error
+}
+
+type SuspensionService struct {
+	UserRepository repositories.IUserRepository
+	Cache          infrastructures.ICache
+	Logger         infrastructures.ILogger
+	Events         infrastructures.IEventBus
+	Clock          infrastructures.IClock
+}
+
+func (service *SuspensionService) Suspend(admin models.User, userID uint, until *time.Time, reason string) (user models.User, err error) {
+	if user, err = service.getUser(userID); err != nil {
+		return user, err
+	}
+	if user.ID == admin.ID || user.IsAdmin() {
+		return user, ErrNotSuspendable
+	}
+
+	now := service.Clock.Now()
+	updates := map[string]interface{}{
+		"suspended_at":      now,
+		"suspended_until":   until,
+		"suspension_reason": nil,
+	}
+	user.SuspendedAt, user.SuspendedUntil, user.SuspensionReason = &now, until, nil
+	if reason != "" {
+		updates["suspension_reason"] = reason
+		user.SuspensionReason = &reason
+	}
+	if err = service.UserRepository.Updates(&user, updates); err != nil {
+		return user, err
+	}
+	invalidateCache(service.Cache, CacheTagUsers)
+	service.Events.Publish(EventUserUpdated, UserEvent{UserID: user.ID})
+
+	fields := infrastructures.Fields{"audit": true, "admin_id": admin.ID, "user_id": user.ID, "reason": reason}
+	if until != nil {
+		fields["suspended_until"] = until.Format(time.RFC3339)
+	}
+	service.Logger.Info("user suspended", fields)
+	return user, nil
+}
+
+func (service *SuspensionService) Unsuspend(admin models.User, userID uint) (user models.User, err error) {
+	if user, err = service.getUser(userID); err != nil {
+		return user, err
+	}
+	if user.SuspendedAt == nil {
+		return user, nil
+	}
+	if err = service.lift(&user); err != nil {
+		return user, err
+	}
+	service.Logger.Info("user unsuspended", infrastructures.Fields{"audit": true, "admin_id": admin.ID, "user_id": user.ID})
+	return user, nil
+}
+
+func (service *SuspensionService) LiftExpiredSuspensions() error {
+	users, err := service.UserRepository.GetUsersWithExpiredSuspension(service.Clock.Now())
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		if err = service.lift(&user); err != nil {
+			return err
+		}
+		service.Logger.Info("suspension expired", infrastructures.Fields{"audit": true, "user_id": user.ID})
+	}
+	return nil
+}
+
+func (service *SuspensionService) lift(user *models.User) error {
+	user.SuspendedAt, user.SuspendedUntil, user.SuspensionReason = nil, nil, nil
+	err := service.UserRepository.Updates(user, map[string]interface{}{
+		"suspended_at":      nil,
+		"suspended_until":   nil,
+		"suspension_reason": nil,
+	})
+	if err == nil {
+		invalidateCache(service.Cache, CacheTagUsers)
+		service.Events.Publish(EventUserUpdated, UserEvent{UserID: user.ID})
+	}
+	return err
+}
+
+func (service *SuspensionService) getUser(userID uint) (user models.User, err error) {
+	user, err = service.UserRepository.GetUserByID(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return user, ErrUserNotFound
+	}
+	return user, err
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/helpers"
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/policies"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+// how long the popular tags are cached, changing the tags invalidates them earlier
+const popularTagsTTL = 10 * time.Minute
+
+// EventTagsChanged is published when the tags of a taggable change, its payload is a TagsEvent
+const EventTagsChanged = "tags.changed"
+
+// TagsEvent tells the taggable whose tags changed
+type TagsEvent struct {
+	TaggableType string `json:"taggable_type"`
+	TaggableID   uint   `json:"taggable_id"`
+}
+
+var (
+	ErrTaggableNotFound = problems.Define(problems.TaggableNotFound, "the taggable could not be found")
+	ErrNotTaggable      = problems.Define(problems.NotTaggable, "you can not tag this")
+)
+
+// Taggable returns the user owning the taggable, gorm.ErrRecordNotFound when it does not exist
+type Taggable func(ID uint) (ownerID uint, err error)
+
+type ITagService interface {
+	Tags(taggableType string, taggableID uint) ([]models.Tag, error)
+	// Attach, Detach and Sync change the tags of a taggable owned by the user (any taggable for admins) and return
+	// its tags, tags are given by name and created on first use
+	Attach(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error)
+	Detach(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error)
+	Sync(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error)
+	// Popular returns the most used tags of the type, of every type when it is empty
+	Popular(taggableType string, limit int) ([]models.PopularTag, error)
+}
+
+type TagService struct {
+	TagRepository repositories.ITagRepository
+	Cache         infrastructures.ICache
+	Events        infrastructures.IEventBus
+	// Taggables are the types that can be tagged, by the name used in the urls
+	Taggables map[string]Taggable
+}
+
+func (service *TagService) Tags(taggableType string, taggableID uint) ([]models.Tag, error) {
+	if _, err := service.owner(taggableType, taggableID); err != nil {
+		return nil, err
+	}
+	return service.TagRepository.GetTags(taggableType, taggableID)
+}
+
+func (service *TagService) Attach(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error) {
+	return service.change(user, taggableType, taggableID, func() error {
+		tags, err := service.TagRepository.FindOrCreateTags(normalizeTags(names))
+		if err != nil {
+			return err
+		}
+		return service.TagRepository.Attach(taggableType, taggableID, tagIDs(tags))
+	})
+}
+
+func (service *TagService) Detach(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error) {
+	return service.change(user, taggableType, taggableID, func() error {
+		normalized := normalizeTags(names)
+		slugs := make([]string, len(normalized))
+		for i := range normalized {
+			slugs[i] = normalized[i].Slug
+		}
+		if len(slugs) == 0 {
+			return nil
+		}
+		tags, err := service.TagRepository.GetTagsBySlug(slugs)
+		if err != nil {
+			return err
+		}
+		return service.TagRepository.Detach(taggableType, taggableID, tagIDs(tags))
+	})
+}
+
+func (service *TagService) Sync(user models.User, taggableType string, taggableID uint, names []string) ([]models.Tag, error) {
+	return service.change(user, taggableType, taggableID, func() error {
+		tags, err := service.TagRepository.FindOrCreateTags(normalizeTags(names))
+		if err != nil {
+			return err
+		}
+		return service.TagRepository.Sync(taggableType, taggableID, tagIDs(tags))
+	})
+}
+
+func (service *TagService) Popular(taggableType string, limit int) (tags []models.PopularTag, err error) {
+	ctx := context.Background()
+	key := fmt.Sprintf("tags:popular:%v:%d", taggableType, limit)
+	if value, found, err := service.Cache.Get(ctx, key); err == nil && found && json.Unmarshal(value, &tags) == nil {
+		return tags, nil
+	}
+
+	if tags, err = service.TagRepository.GetPopularTags(taggableType, limit); err != nil {
+		return nil, err
+	}
+	// caching is best effort, the tags are returned either way
+	if value, err := json.Marshal(tags); err == nil && service.Cache.Set(ctx, key, value, popularTagsTTL) == nil {
+		_ = service.Cache.Tag(ctx, CacheTagTags, key)
+	}
+	return tags, nil
+}
+
+// change runs the change when the user can tag the taggable and returns its tags afterwards
+func (service *TagService) change(user models.User, taggableType string, taggableID uint, fn func() error) ([]models.Tag, error) {
+	ownerID, err := service.owner(taggableType, taggableID)
+	if err != nil {
+		return nil, err
+	}
+	if !policies.Owns(user, ownerID) {
+		return nil, ErrNotTaggable
+	}
+	if err = fn(); err != nil {
+		return nil, err
+	}
+	invalidateCache(service.Cache, CacheTagTags)
+	service.Events.Publish(EventTagsChanged, TagsEvent{TaggableType: taggableType, TaggableID: taggableID})
+	return service.TagRepository.GetTags(taggableType, taggableID)
+}
+
+func (service *TagService) owner(taggableType string, taggableID uint) (uint, error) {
+	taggable, ok := service.Taggables[taggableType]
+	if !ok {
+		return 0, ErrTaggableNotFound
+	}
+	ownerID, err := taggable(taggableID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, ErrTaggableNotFound
+	}
+	return ownerID, err
+}
+
+// normalizeTags turns the names into tags, names without a slug and repeated slugs are dropped
+func normalizeTags(names []string) (tags []models.Tag) {
+	seen := map[string]bool{}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		slug := helpers.Slugify(name)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		tags = append(tags, models.Tag{Name: name, Slug: slug})
+	}
+	return tags
+}
+
+func tagIDs(tags []models.Tag) []uint {
+	IDs := make([]uint, len(tags))
+	for i := range tags {
+		IDs[i] = tags[i].ID
+	}
+	return IDs
+}
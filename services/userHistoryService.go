@@ -0,0 +1,173 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+	"gotham/problems"
+	"gotham/repositories"
+	"gotham/utils"
+)
+
+var ErrUserVersionNotFound = problems.Define(problems.UserVersionNotFound, "the version of the user could not be found")
+
+// UserChange is a field of the user changed from a version to another
+type UserChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+type IUserHistoryService interface {
+	// Versions returns a page of the versions of the user, the latest first
+	Versions(userID uint, pagination utils.IPagination) (versions []models.UserVersion, totalCount int64, err error)
+	// Diff lists the fields changed from the version to the other one, to the current state of the user without it
+	Diff(userID uint, versionID uint, otherID *uint) (changes []UserChange, err error)
+	// Restore sets the restorable fields of the user back to the version, the restore is a new version
+	Restore(admin models.User, userID uint, versionID uint) (models.User, error)
+}
+
+type UserHistoryService struct {
+	UserRepository        repositories.IUserRepository
+	UserVersionRepository repositories.IUserVersionRepository
+	SettingService        ISettingService
+	Cache                 infrastructures.ICache
+	Logger                infrastructures.ILogger
+	Events                infrastructures.IEventBus
+}
+
+func (service *UserHistoryService) Versions(userID uint, pagination utils.IPagination) (versions []models.UserVersion, totalCount int64, err error) {
+	if _, err = findUser(service.UserRepository, userID); err != nil {
+		return nil, 0, err
+	}
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && pagination.GetLimit() > max {
+		pagination.Get().Limit = max
+	}
+	return service.UserVersionRepository.GetUserVersions(userID, &scopes.GormPagination{Pagination: pagination.Get()})
+}
+
+func (service *UserHistoryService) Diff(userID uint, versionID uint, otherID *uint) (changes []UserChange, err error) {
+	version, err := service.getVersion(userID, versionID)
+	if err != nil {
+		return nil, err
+	}
+	from, err := version.Fields()
+	if err != nil {
+		return nil, err
+	}
+
+	var to map[string]interface{}
+	if otherID != nil {
+		var other models.UserVersion
+		if other, err = service.getVersion(userID, *otherID); err != nil {
+			return nil, err
+		}
+		to, err = other.Fields()
+	} else {
+		var user models.User
+		if user, err = findUser(service.UserRepository, userID); err != nil {
+			return nil, err
+		}
+		to, err = fieldsOf(user)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return diffFields(from, to), nil
+}
+
+func (service *UserHistoryService) Restore(admin models.User, userID uint, versionID uint) (user models.User, err error) {
+	if user, err = findUser(service.UserRepository, userID); err != nil {
+		return user, err
+	}
+	version, err := service.getVersion(userID, versionID)
+	if err != nil {
+		return user, err
+	}
+	var restored models.User
+	if err = json.Unmarshal(version.Data, &restored); err != nil {
+		return user, err
+	}
+	updates := map[string]interface{}{}
+	current := restorableFields(user)
+	for field, value := range restorableFields(restored) {
+		if !reflect.DeepEqual(value, current[field]) {
+			updates[field] = value
+		}
+	}
+	if len(updates) == 0 {
+		return user, nil
+	}
+	if err = service.UserRepository.Updates(&user, updates); err != nil {
+		return user, err
+	}
+	invalidateCache(service.Cache, CacheTagUsers)
+	service.Events.Publish(EventUserUpdated, UserEvent{UserID: user.ID})
+
+	fields := make([]string, 0, len(updates))
+	for field := range updates {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	service.Logger.Info("user restored", infrastructures.Fields{"audit": true, "admin_id": admin.ID, "user_id": user.ID, "version_id": version.ID, "fields": fields})
+	return user, nil
+}
+
+func (service *UserHistoryService) getVersion(userID uint, versionID uint) (version models.UserVersion, err error) {
+	version, err = service.UserVersionRepository.GetUserVersion(userID, versionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return version, ErrUserVersionNotFound
+	}
+	return version, err
+}
+
+// restorableFields are the fields a restore sets back by their columns, the fields of a patch of the user
+func restorableFields(user models.User) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          user.Name,
+		"image":         user.Image,
+		"timezone":      user.Timezone,
+		"verified":      user.Verified,
+		"admin":         user.Admin,
+		"daily_quota":   user.DailyQuota,
+		"monthly_quota": user.MonthlyQuota,
+	}
+}
+
+// fieldsOf are the fields of the user by their json names, like the data of its versions
+func fieldsOf(user models.User) (fields map[string]interface{}, err error) {
+	data, err := json.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, &fields)
+	return
+}
+
+// diffFields lists the fields whose values differ, in the order of their names
+func diffFields(from map[string]interface{}, to map[string]interface{}) []UserChange {
+	names := map[string]bool{}
+	for name := range from {
+		names[name] = true
+	}
+	for name := range to {
+		names[name] = true
+	}
+	changes := []UserChange{}
+	for name := range names {
+		if !reflect.DeepEqual(from[name], to[name]) {
+			changes = append(changes, UserChange{Field: name, From: from[name], To: to[name]})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
+	"gotham/models"
+	"gotham/models/scopes"
+	"gotham/repositories"
+	"gotham/utils"
+)
+
+// QueueUserProjections is the queue of the users whose search view is projected again, the payload is the id of the user
+const QueueUserProjections = "user-projections"
+
+// the users projected at a time by a rebuild
+const projectionBatchSize = 500
+
+// ProjectionProgress is reported after each batch of a rebuild
+type ProjectionProgress struct {
+	Done  int64
+	Total int64
+}
+
+func (p ProjectionProgress) String() string {
+	percent := int64(100)
+	if p.Total > 0 {
+		percent = p.Done * 100 / p.Total
+	}
+	return fmt.Sprintf("users: %v/%v (%v%%)", p.Done, p.Total, percent)
+}
+
+type IUserProjectionService interface {
+	// Search lists the users from their search views, the latest first
+	Search(filter repositories.UserViewFilter, pagination utils.IPagination) (views []models.UserSearchView, totalCount int64, err error)
+	// Rebuild projects every user again in batches until the context is done, then deletes the views of the users gone
+	Rebuild(ctx context.Context, progress func(ProjectionProgress)) error
+
+	// Listeners
+	// Project queues the projection of the users changed by the user, tag or follow event
+	Project(event infrastructures.Event) error
+
+	// Workers
+	// ProjectUser is the worker of the QueueUserProjections jobs, it writes the view of the user or deletes it when the
+	// user is gone. The user is read again so the jobs of a user can run in any order
+	ProjectUser(job infrastructures.Job) error
+}
+
+type UserProjectionService struct {
+	UserRepository           repositories.IUserRepository
+	UserSearchViewRepository repositories.IUserSearchViewRepository
+	TagRepository            repositories.ITagRepository
+	FollowRepository         repositories.IFollowRepository
+	SettingService           ISettingService
+	Queue                    infrastructures.IQueue
+	Logger                   infrastructures.ILogger
+}
+
+func (service *UserProjectionService) Search(filter repositories.UserViewFilter, pagination utils.IPagination) (views []models.UserSearchView, totalCount int64, err error) {
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && pagination.GetLimit() > max {
+		pagination.Get().Limit = max
+	}
+	return service.UserSearchViewRepository.SearchUserViews(filter, &scopes.GormPagination{Pagination: pagination.Get()})
+}
+
+func (service *UserProjectionService) Rebuild(ctx context.Context, progress func(ProjectionProgress)) error {
+	total, err := service.UserRepository.Count()
+	if err != nil {
+		return err
+	}
+	// a second back, the views projected during the rebuild are kept whatever the precision of the column
+	startedAt := time.Now().Add(-time.Second)
+	report := ProjectionProgress{Total: total}
+	err = service.UserRepository.FindEach(ctx, projectionBatchSize, func(users []models.User) error {
+		for _, user := range users {
+			if err := service.project(user); err != nil {
+				return err
+			}
+		}
+		report.Done += int64(len(users))
+		if progress != nil {
+			progress(report)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	deleted, err := service.UserSearchViewRepository.DeleteUserViewsProjectedBefore(startedAt)
+	if err == nil {
+		service.Logger.Info("user views rebuilt", infrastructures.Fields{"projected": report.Done, "deleted": deleted})
+	}
+	return err
+}
+
+func (service *UserProjectionService) Project(event infrastructures.Event) error {
+	var userIDs []uint
+	switch payload := event.Payload.(type) {
+	case UserEvent:
+		userIDs = []uint{payload.UserID}
+	case TagsEvent:
+		if payload.TaggableType == (models.User{}).TableName() {
+			userIDs = []uint{payload.TaggableID}
+		}
+	case FollowEvent:
+		// the counts of both users change
+		userIDs = []uint{payload.FollowerID, payload.FollowedID}
+	default:
+		return fmt.Errorf("unexpected payload %T", event.Payload)
+	}
+	for _, userID := range userIDs {
+		if err := service.Queue.Push(context.Background(), QueueUserProjections, []byte(strconv.FormatUint(uint64(userID), 10))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (service *UserProjectionService) ProjectUser(job infrastructures.Job) error {
+	id, err := strconv.ParseUint(string(job.Payload), 10, 64)
+	if err != nil {
+		return err
+	}
+	user, err := service.UserRepository.GetUserByID(uint(id))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return service.UserSearchViewRepository.DeleteUserView(uint(id))
+	}
+	if err != nil {
+		return err
+	}
+	return service.project(user)
+}
+
+func (service *UserProjectionService) project(user models.User) (err error) {
+	view := models.UserSearchView{
+		UserID:      user.ID,
+		Name:        user.Name,
+		Email:       user.Email,
+		Verified:    user.Verified,
+		Admin:       user.Admin,
+		Suspended:   user.IsSuspended(time.Now()),
+		CreatedAt:   user.CreatedAt,
+		ProjectedAt: time.Now(),
+	}
+	tags, err := service.TagRepository.GetTags(user.TableName(), user.ID)
+	if err != nil {
+		return err
+	}
+	names, slugs := make([]string, 0, len(tags)), make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names, slugs = append(names, tag.Name), append(slugs, tag.Slug)
+	}
+	view.TagNames = strings.Join(names, ", ")
+	if len(slugs) > 0 {
+		view.TagSlugs = "," + strings.Join(slugs, ",") + ","
+	}
+	if view.FollowersCount, err = service.FollowRepository.CountFollowers(user.ID); err != nil {
+		return err
+	}
+	if view.FollowingCount, err = service.FollowRepository.CountFollowing(user.ID); err != nil {
+		return err
+	}
+	return service.UserSearchViewRepository.SaveUserView(&view)
+}
@@ -1,30 +1,264 @@
 package services
 
 import (
+	"context"
+	"errors"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"gotham/infrastructures"
 	"gotham/models"
 	"gotham/models/scopes"
+	"gotham/pagination"
+	"gotham/problems"
 	"gotham/repositories"
+	"gotham/repositories/transactions"
 	"gotham/utils"
 )
 
+// events of the users, their payload is a UserEvent. They are published once the change is committed
+const (
+	EventUserCreated = "user.created"
+	EventUserUpdated = "user.updated"
+	EventUserDeleted = "user.deleted"
+)
+
+// UserEvent is published when a user is created, changed or deleted, the listeners read the user again if they need it
+type UserEvent struct {
+	UserID uint `json:"user_id"`
+}
+
+var (
+	ErrUserNotFound     = problems.Define(problems.UserNotFound, "user could not be found")
+	ErrUserNotDeletable = problems.Define(problems.UserNotDeletable, "the user can not be deleted")
+)
+
+/**
+ * UserUpdate
+ * the changes of a user in a bulk update, the nil fields are left as they are
+ */
+type UserUpdate struct {
+	ID           uint
+	Name         *string
+	Verified     *bool
+	Timezone     *string
+	DailyQuota   *int
+	MonthlyQuota *int
+}
+
+func (u UserUpdate) updates() map[string]interface{} {
+	updates := map[string]interface{}{}
+	if u.Name != nil {
+		updates["name"] = *u.Name
+	}
+	if u.Verified != nil {
+		updates["verified"] = *u.Verified
+	}
+	if u.Timezone != nil {
+		updates["timezone"] = *u.Timezone
+	}
+	if u.DailyQuota != nil {
+		updates["daily_quota"] = *u.DailyQuota
+	}
+	if u.MonthlyQuota != nil {
+		updates["monthly_quota"] = *u.MonthlyQuota
+	}
+	return updates
+}
+
+// BulkResult is the outcome of an operation of a bulk, the user changed or the error of the operation
+type BulkResult struct {
+	User models.User
+	Err  error
+}
+
 type IUserService interface {
-	GetUsersWithPaginationAndOrder(pagination utils.IPagination, order utils.IOrder) (users []models.User, totalCount int64, err error)
+	// GetUsersWithPaginationAndOrder lists the users tagged with every tag slug
+	GetUsersWithPaginationAndOrder(pagination utils.IPagination, order utils.IOrder, tags []string) (users []models.User, totalCount int64, err error)
+	// PaginateUsers is the keyset page of the users tagged with every tag slug, next is nil on the last page
+	PaginateUsers(keyset pagination.Keyset, tags []string) (users []models.User, next *pagination.Cursor, err error)
 	GetUserByID(id uint) (models.User, error)
+	// GetUsersByIDs finds the users in the order of the ids with one query, the ids of the users not found are missing
+	GetUsersByIDs(ids []uint) (users []models.User, missing []uint, err error)
 	GetUserByEmail(email string) (models.User, error)
+	// UpdatePreferences stores the timezone (an IANA name) of the user
+	UpdatePreferences(ctx context.Context, user models.User, timezone string) (models.User, error)
+	// PatchUser stores the changes of a patch of the user by the auth user, keyed by column
+	PatchUser(ctx context.Context, auth models.User, user models.User, changes map[string]interface{}) (models.User, error)
+
+	// BulkUpdate changes the users in one transaction, a result for each update in their order
+	BulkUpdate(ctx context.Context, admin models.User, updates []UserUpdate) ([]BulkResult, error)
+	// BulkDelete deletes the users in one transaction, a result for each id in their order. The admins can not be deleted
+	BulkDelete(ctx context.Context, admin models.User, ids []uint) ([]BulkResult, error)
 }
 
 type UserService struct {
 	UserRepository repositories.IUserRepository
+	SettingService ISettingService
+	Cache          infrastructures.ICache
+	UnitOfWork     transactions.IUnitOfWork
+	Logger         infrastructures.ILogger
+	Events         infrastructures.IEventBus
 }
 
 func (service *UserService) GetUserByID(id uint) (user models.User, err error) {
 	return service.UserRepository.GetUserByID(id)
 }
 
+func (service *UserService) GetUsersByIDs(ids []uint) (users []models.User, missing []uint, err error) {
+	found, err := service.UserRepository.FindByIDs(ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[uint]models.User, len(found))
+	for _, user := range found {
+		byID[user.ID] = user
+	}
+	users, missing = make([]models.User, 0, len(found)), []uint{}
+	for _, id := range ids {
+		if user, ok := byID[id]; ok {
+			users = append(users, user)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	return users, missing, nil
+}
+
 func (service *UserService) GetUserByEmail(email string) (user models.User, err error) {
 	return service.UserRepository.GetUserByEmail(email)
 }
 
-func (service *UserService) GetUsersWithPaginationAndOrder(pagination utils.IPagination, order utils.IOrder) (users []models.User, totalCount int64, err error) {
-	return service.UserRepository.GetUsersWithPaginationAndOrder(&scopes.GormPagination{Pagination: pagination.Get()}, &scopes.GormOrder{Order: order.Get()})
+func (service *UserService) GetUsersWithPaginationAndOrder(pager utils.IPagination, order utils.IOrder, tags []string) (users []models.User, totalCount int64, err error) {
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && pager.GetLimit() > max {
+		pager.Get().Limit = max
+	}
+	// the keyset ordering, so the next pages can be read by keyset from the last user of this one
+	var orderer scopes.GormOrderer = &scopes.GormOrder{Order: order.Get()}
+	if descending, ok := pagination.Ordering(order); ok {
+		orderer = scopes.KeysetOrderer{Descending: descending}
+	}
+	return service.UserRepository.GetUsersWithPaginationAndOrder(&scopes.GormPagination{Pagination: pager.Get()}, orderer, scopes.TaggedWith(models.User{}.TableName(), "users", tags))
+}
+
+func (service *UserService) PaginateUsers(keyset pagination.Keyset, tags []string) (users []models.User, next *pagination.Cursor, err error) {
+	if max := service.SettingService.Int("max_page_size", 100); max > 0 && keyset.Limit > max {
+		keyset.Limit = max
+	}
+	return service.UserRepository.Paginate(keyset, scopes.TaggedWith(models.User{}.TableName(), "users", tags))
+}
+
+// UpdatePreferences returns the user updated without keeping the change in a dry run
+func (service *UserService) UpdatePreferences(ctx context.Context, user models.User, timezone string) (models.User, error) {
+	if err := service.UnitOfWork.WithinTransaction(ctx, func(repos transactions.RepoSet) error {
+		return repos.Users.Updates(&user, map[string]interface{}{"timezone": timezone})
+	}); err != nil {
+		return user, err
+	}
+	user.Timezone = timezone
+	if infrastructures.IsDryRun(ctx) {
+		return user, nil
+	}
+	invalidateCache(service.Cache, CacheTagUsers)
+	service.Events.Publish(EventUserUpdated, UserEvent{UserID: user.ID})
+	return user, nil
+}
+
+// PatchUser returns the user patched without keeping the changes in a dry run
+func (service *UserService) PatchUser(ctx context.Context, auth models.User, user models.User, changes map[string]interface{}) (models.User, error) {
+	if len(changes) == 0 {
+		return user, nil
+	}
+	if err := service.UnitOfWork.WithinTransaction(ctx, func(repos transactions.RepoSet) error {
+		return repos.Users.Updates(&user, changes)
+	}); err != nil {
+		return user, err
+	}
+	if infrastructures.IsDryRun(ctx) {
+		return user, nil
+	}
+	invalidateCache(service.Cache, CacheTagUsers)
+	service.Events.Publish(EventUserUpdated, UserEvent{UserID: user.ID})
+
+	if auth.Admin {
+		fields := make([]string, 0, len(changes))
+		for field := range changes {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		service.Logger.Info("user patched", infrastructures.Fields{"audit": true, "admin_id": auth.ID, "user_id": user.ID, "fields": fields})
+	}
+	return user, nil
+}
+
+func (service *UserService) BulkUpdate(ctx context.Context, admin models.User, updates []UserUpdate) ([]BulkResult, error) {
+	return service.bulk(ctx, admin, "users updated in bulk", EventUserUpdated, len(updates), func(users repositories.IUserRepository, i int) (user models.User, err error) {
+		if user, err = findUser(users, updates[i].ID); err != nil {
+			return user, err
+		}
+		err = users.Updates(&user, updates[i].updates())
+		return user, err
+	})
+}
+
+func (service *UserService) BulkDelete(ctx context.Context, admin models.User, ids []uint) ([]BulkResult, error) {
+	return service.bulk(ctx, admin, "users deleted in bulk", EventUserDeleted, len(ids), func(users repositories.IUserRepository, i int) (user models.User, err error) {
+		if user, err = findUser(users, ids[i]); err != nil {
+			return user, err
+		}
+		if user.ID == admin.ID || user.IsAdmin() {
+			return user, ErrUserNotDeletable
+		}
+		err = users.Delete(&user)
+		return user, err
+	})
+}
+
+/**
+ * bulk
+ * runs the operations in one transaction, each one in a savepoint: a failed operation is rolled back alone and its
+ * domain error is its result. Any other error aborts the bulk, none of the operations is kept. The event is published
+ * for each user changed once the transaction is committed, a dry run returns the results without keeping any
+ */
+func (service *UserService) bulk(ctx context.Context, admin models.User, message string, event string, count int, operation func(users repositories.IUserRepository, i int) (models.User, error)) (results []BulkResult, err error) {
+	var changed []uint
+	err = service.UnitOfWork.WithinTransaction(ctx, func(repos transactions.RepoSet) error {
+		results, changed = make([]BulkResult, count), nil
+		for i := range results {
+			var user models.User
+			err := repos.Savepoint(func(repos transactions.RepoSet) (err error) {
+				user, err = operation(repos.Users, i)
+				return err
+			})
+			var domainError *problems.DomainError
+			if err != nil && !errors.As(err, &domainError) {
+				return err
+			}
+			results[i] = BulkResult{User: user, Err: err}
+			if err == nil {
+				changed = append(changed, user.ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(changed) > 0 && !infrastructures.IsDryRun(ctx) {
+		invalidateCache(service.Cache, CacheTagUsers)
+		service.Logger.Info(message, infrastructures.Fields{"audit": true, "admin_id": admin.ID, "user_ids": changed})
+		for _, id := range changed {
+			service.Events.Publish(event, UserEvent{UserID: id})
+		}
+	}
+	return results, nil
+}
+
+func findUser(users repositories.IUserRepository, id uint) (user models.User, err error) {
+	user, err = users.GetUserByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return user, ErrUserNotFound
+	}
+	return user, err
 }
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"gotham/helpers"
+	"gotham/models"
+	"gotham/problems"
+	"gotham/repositories"
+)
+
+// tallies scored per batch of the score job
+const scoreBatchSize = 500
+
+var ErrVotableNotFound = problems.Define(problems.VotableNotFound, "the votable could not be found")
+
+// Votable tells if the target of a vote exists
+type Votable func(ID uint) (bool, error)
+
+// VoteSummary is the tally of a votable with the vote of the user, 1 like, -1 dislike and 0 none
+type VoteSummary struct {
+	models.VoteTally
+	Vote int `json:"vote"`
+}
+
+type IVoteService interface {
+	// Vote sets the vote of the user on the votable, 1 likes, -1 dislikes and 0 removes the vote
+	Vote(user models.User, votableType string, votableID uint, value int) (VoteSummary, error)
+	Summary(user models.User, votableType string, votableID uint) (VoteSummary, error)
+	// RecomputeScores scores the tallies changed since their last score
+	RecomputeScores() error
+}
+
+type VoteService struct {
+	VoteRepository repositories.IVoteRepository
+	// Votables are the types that can be voted, by the name used in the urls
+	Votables map[string]Votable
+}
+
+func (service *VoteService) Vote(user models.User, votableType string, votableID uint, value int) (summary VoteSummary, err error) {
+	if err = service.exists(votableType, votableID); err != nil {
+		return summary, err
+	}
+	if err = service.VoteRepository.Cast(user.ID, votableType, votableID, value); err != nil {
+		return summary, err
+	}
+	return service.summary(user, votableType, votableID)
+}
+
+func (service *VoteService) Summary(user models.User, votableType string, votableID uint) (summary VoteSummary, err error) {
+	if err = service.exists(votableType, votableID); err != nil {
+		return summary, err
+	}
+	return service.summary(user, votableType, votableID)
+}
+
+func (service *VoteService) RecomputeScores() error {
+	for {
+		tallies, err := service.VoteRepository.GetUnscoredTallies(scoreBatchSize)
+		if err != nil {
+			return err
+		}
+		for i := range tallies {
+			score := helpers.ScoreCalculate(tallies[i].Likes, tallies[i].Dislikes)
+			if err = service.VoteRepository.UpdateScore(&tallies[i], score, tallies[i].UpdatedAt); err != nil {
+				return err
+			}
+		}
+		if len(tallies) < scoreBatchSize {
+			return nil
+		}
+	}
+}
+
+func (service *VoteService) exists(votableType string, votableID uint) error {
+	votable, ok := service.Votables[votableType]
+	if !ok {
+		return ErrVotableNotFound
+	}
+	found, err := votable(votableID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrVotableNotFound
+	}
+	return nil
+}
+
+func (service *VoteService) summary(user models.User, votableType string, votableID uint) (summary VoteSummary, err error) {
+	if summary.VoteTally, err = service.VoteRepository.GetTally(votableType, votableID); err != nil {
+		return summary, err
+	}
+	vote, err := service.VoteRepository.GetVote(user.ID, votableType, votableID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return summary, err
+	}
+	summary.Vote = vote.Value
+	return summary, nil
+}
+
+// RecordExists adapts a lookup by id returning gorm.ErrRecordNotFound to a Votable or a Commentable
+func RecordExists(find func(ID uint) error) func(ID uint) (bool, error) {
+	return func(ID uint) (bool, error) {
+		err := find(ID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+}
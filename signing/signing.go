@@ -0,0 +1,177 @@
+package signing
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the headers of a signed request
+const (
+	HeaderKey       = "X-Signature-Key"
+	HeaderTimestamp = "X-Signature-Timestamp"
+	HeaderNonce     = "X-Signature-Nonce"
+	HeaderSignature = "X-Signature"
+)
+
+var (
+	ErrUnsigned   = errors.New("signing: the request is not signed")
+	ErrUnknownKey = errors.New("signing: the key is unknown")
+	ErrExpired    = errors.New("signing: the timestamp is out of the tolerance")
+	ErrInvalid    = errors.New("signing: the signature does not match")
+	ErrReplayed   = errors.New("signing: the nonce was used already")
+)
+
+/**
+ * Payload
+ * the signed string of a request, "<timestamp>.<nonce>.<method>.<path and query>.<hex sha256 of the body>" so neither
+ * the target nor the body of a signed request can be changed
+ */
+func Payload(timestamp string, nonce string, method string, uri string, body []byte) string {
+	sum := sha256.Sum256(body)
+	return strings.Join([]string{timestamp, nonce, strings.ToUpper(method), uri, hex.EncodeToString(sum[:])}, ".")
+}
+
+/**
+ * Sign
+ * the hex hmac sha256 of the payload with the secret
+ */
+func Sign(secret []byte, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+/**
+ * CheckTimestamp
+ * ErrExpired unless the unix timestamp, in seconds, is within the tolerance of now in both directions
+ */
+func CheckTimestamp(timestamp string, tolerance time.Duration, now time.Time) error {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrExpired
+	}
+	if age := now.Sub(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return ErrExpired
+	}
+	return nil
+}
+
+/**
+ * Signer
+ * signs the outgoing requests with the secret of its key, shared with the receiver
+ */
+type Signer struct {
+	KeyID  string
+	Secret []byte
+	Now    func() time.Time
+}
+
+/**
+ * Sign
+ * sets the signature headers of the request, with the current time and a random nonce, the body is read and restored
+ */
+func (s Signer) Sign(request *http.Request) error {
+	body, err := readBody(request)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, 16)
+	if _, err = rand.Read(nonce); err != nil {
+		return err
+	}
+	now := time.Now
+	if s.Now != nil {
+		now = s.Now
+	}
+	timestamp := strconv.FormatInt(now().Unix(), 10)
+	nonceHex := hex.EncodeToString(nonce)
+
+	request.Header.Set(HeaderKey, s.KeyID)
+	request.Header.Set(HeaderTimestamp, timestamp)
+	request.Header.Set(HeaderNonce, nonceHex)
+	request.Header.Set(HeaderSignature, Sign(s.Secret, Payload(timestamp, nonceHex, request.Method, request.URL.RequestURI(), body)))
+	return nil
+}
+
+/**
+ * Verifier
+ * verifies the signed incoming requests of the keys it knows, a request is accepted once within the tolerance
+ */
+type Verifier struct {
+	// Secrets are the secrets of the partners by their key
+	Secrets map[string][]byte
+	// Tolerance is how far the timestamp of a request may be from now, in both directions
+	Tolerance time.Duration
+	// Remember stores the nonce for the ttl and tells if it was not stored already, so a captured request is not
+	// replayed within the tolerance
+	Remember func(nonce string, ttl time.Duration) (fresh bool, err error)
+	Now      func() time.Time
+}
+
+/**
+ * Verify
+ * checks the signature of the request and its body, and returns the key it is signed with
+ */
+func (v Verifier) Verify(request *http.Request, body []byte) (keyID string, err error) {
+	keyID = request.Header.Get(HeaderKey)
+	timestamp := request.Header.Get(HeaderTimestamp)
+	nonce := request.Header.Get(HeaderNonce)
+	signature := request.Header.Get(HeaderSignature)
+	if keyID == "" || timestamp == "" || nonce == "" || signature == "" {
+		return keyID, ErrUnsigned
+	}
+	secret, ok := v.Secrets[keyID]
+	if !ok {
+		return keyID, ErrUnknownKey
+	}
+
+	now := time.Now
+	if v.Now != nil {
+		now = v.Now
+	}
+	if err = CheckTimestamp(timestamp, v.Tolerance, now()); err != nil {
+		return keyID, err
+	}
+
+	expected := Sign(secret, Payload(timestamp, nonce, request.Method, request.URL.RequestURI(), body))
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return keyID, ErrInvalid
+	}
+
+	// the nonce outlives the tolerance on both sides of now, past it the timestamp is refused anyway
+	fresh, err := v.Remember(keyID+":"+nonce, 2*v.Tolerance)
+	if err != nil {
+		return keyID, err
+	}
+	if !fresh {
+		return keyID, ErrReplayed
+	}
+	return keyID, nil
+}
+
+// readBody reads the body of the request and puts it back, so it is still sent or handled
+func readBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(request.Body)
+	_ = request.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+	return body, nil
+}
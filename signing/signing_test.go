@@ -0,0 +1,270 @@
+package signing
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+// memory is the Remember of a verifier, in memory
+type memory struct {
+	nonces map[string]time.Duration
+	err    error
+}
+
+func (m *memory) remember(nonce string, ttl time.Duration) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	if _, ok := m.nonces[nonce]; ok {
+		return false, nil
+	}
+	m.nonces[nonce] = ttl
+	return true, nil
+}
+
+func newVerifier() (Verifier, *memory) {
+	m := &memory{nonces: map[string]time.Duration{}}
+	return Verifier{
+		Secrets:   map[string][]byte{"partner": []byte("secret"), "other": []byte("other secret")},
+		Tolerance: 5 * time.Minute,
+		Remember:  m.remember,
+		Now:       func() time.Time { return now },
+	}, m
+}
+
+// signed is a request signed by the partner at the time, with its body
+func signed(t *testing.T, at time.Time, method string, target string, body string) (*http.Request, []byte) {
+	t.Helper()
+	request := httptest.NewRequest(method, target, strings.NewReader(body))
+	signer := Signer{KeyID: "partner", Secret: []byte("secret"), Now: func() time.Time { return at }}
+	if err := signer.Sign(request); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return request, read
+}
+
+func TestSignRestoresTheBody(t *testing.T) {
+	request, body := signed(t, now, http.MethodPost, "/v1/partners/orders?id=1", `{"amount": 10}`)
+	if string(body) != `{"amount": 10}` {
+		t.Fatalf("the body read after signing is %q", body)
+	}
+	again, err := request.GetBody()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := ioutil.ReadAll(again); string(content) != `{"amount": 10}` {
+		t.Fatalf("GetBody returns %q", content)
+	}
+	for _, header := range []string{HeaderKey, HeaderTimestamp, HeaderNonce, HeaderSignature} {
+		if request.Header.Get(header) == "" {
+			t.Fatalf("%v is not set", header)
+		}
+	}
+	if request.Header.Get(HeaderTimestamp) != strconv.FormatInt(now.Unix(), 10) {
+		t.Fatalf("the timestamp is %v", request.Header.Get(HeaderTimestamp))
+	}
+}
+
+func TestVerify(t *testing.T) {
+	verifier, m := newVerifier()
+	request, body := signed(t, now, http.MethodPost, "/v1/partners/orders?id=1", `{"amount": 10}`)
+	keyID, err := verifier.Verify(request, body)
+	if err != nil || keyID != "partner" {
+		t.Fatalf("%v %v", keyID, err)
+	}
+	// the nonce is kept until the timestamp is out of the tolerance on both sides
+	if ttl := m.nonces["partner:"+request.Header.Get(HeaderNonce)]; ttl != 2*verifier.Tolerance {
+		t.Fatalf("the nonce is kept %v", ttl)
+	}
+
+	request, body = signed(t, now, http.MethodGet, "/v1/partners/orders", "")
+	if _, err = verifier.Verify(request, body); err != nil {
+		t.Fatalf("without a body: %v", err)
+	}
+}
+
+func TestVerifyClockSkew(t *testing.T) {
+	tests := []struct {
+		name string
+		at   time.Time
+		err  error
+	}{
+		{"now", now, nil},
+		{"at the tolerance in the past", now.Add(-5 * time.Minute), nil},
+		{"at the tolerance in the future", now.Add(5 * time.Minute), nil},
+		{"past the tolerance in the past", now.Add(-5*time.Minute - time.Second), ErrExpired},
+		{"past the tolerance in the future", now.Add(5*time.Minute + time.Second), ErrExpired},
+		{"a day ago", now.Add(-24 * time.Hour), ErrExpired},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verifier, _ := newVerifier()
+			request, body := signed(t, test.at, http.MethodPost, "/v1/partners/orders", `{}`)
+			if _, err := verifier.Verify(request, body); !errors.Is(err, test.err) {
+				t.Fatalf("error %v, expected %v", err, test.err)
+			}
+		})
+	}
+
+	for _, timestamp := range []string{"", "abc", "1.5", "99999999999999999999"} {
+		if err := CheckTimestamp(timestamp, time.Minute, now); !errors.Is(err, ErrExpired) {
+			t.Errorf("timestamp %q: %v", timestamp, err)
+		}
+	}
+}
+
+func TestVerifyReplay(t *testing.T) {
+	verifier, _ := newVerifier()
+	request, body := signed(t, now, http.MethodPost, "/v1/partners/orders", `{"amount": 10}`)
+	if _, err := verifier.Verify(request, body); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifier.Verify(request, body); !errors.Is(err, ErrReplayed) {
+		t.Fatalf("a replayed request: %v", err)
+	}
+
+	// a new nonce with the same content is a new request
+	request, body = signed(t, now, http.MethodPost, "/v1/partners/orders", `{"amount": 10}`)
+	if _, err := verifier.Verify(request, body); err != nil {
+		t.Fatalf("a new nonce: %v", err)
+	}
+
+	// the nonces are remembered per key
+	other := httptest.NewRequest(http.MethodPost, "/v1/partners/orders", nil)
+	other.Header = request.Header.Clone()
+	other.Header.Set(HeaderKey, "other")
+	other.Header.Set(HeaderSignature, Sign([]byte("other secret"), Payload(request.Header.Get(HeaderTimestamp), request.Header.Get(HeaderNonce), http.MethodPost, "/v1/partners/orders", body)))
+	if _, err := verifier.Verify(other, body); err != nil {
+		t.Fatalf("the nonce of another key: %v", err)
+	}
+}
+
+func TestVerifyRememberFailure(t *testing.T) {
+	verifier, m := newVerifier()
+	m.err = errors.New("cache down")
+	request, body := signed(t, now, http.MethodPost, "/v1/partners/orders", `{}`)
+	if _, err := verifier.Verify(request, body); err != m.err {
+		t.Fatalf("error %v, expected the one of the cache", err)
+	}
+}
+
+func TestVerifyTampering(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(request *http.Request, body []byte) (*http.Request, []byte)
+	}{
+		{"body changed", func(request *http.Request, body []byte) (*http.Request, []byte) {
+			return request, []byte(`{"amount": 1000}`)
+		}},
+		{"body removed", func(request *http.Request, body []byte) (*http.Request, []byte) {
+			return request, nil
+		}},
+		{"body extended", func(request *http.Request, body []byte) (*http.Request, []byte) {
+			return request, append(body, ' ')
+		}},
+		{"method changed", func(request *http.Request, body []byte) (*http.Request, []byte) {
+			request.Method = http.MethodPut
+			return request, body
+		}},
+		{"path changed", func(request *http.Request, body []byte) (*http.Request, []byte) {
+			request.URL.Path = "/v1/partners/refunds"
+			return request, body
+		}},
+		{"query changed", func(request *http.Request, body []byte) (*http.Request, []byte) {
+			request.URL.RawQuery = "id=2"
+			return request, body
+		}},
+		{"timestamp changed", func(request *http.Request, body []byte) (*http.Request, []byte) {
+			request.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix()+1, 10))
+			return request, body
+		}},
+		{"nonce changed", func(request *http.Request, body []byte) (*http.Request, []byte) {
+			nonce := request.Header.Get(HeaderNonce)
+			request.Header.Set(HeaderNonce, flip(nonce[0])+nonce[1:])
+			return request, body
+		}},
+		{"signed with another key", func(request *http.Request, body []byte) (*http.Request, []byte) {
+			request.Header.Set(HeaderKey, "other")
+			return request, body
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verifier, m := newVerifier()
+			request, body := signed(t, now, http.MethodPost, "/v1/partners/orders?id=1", `{"amount": 10}`)
+			if _, err := verifier.Verify(test.tamper(request, body)); !errors.Is(err, ErrInvalid) {
+				t.Fatalf("error %v, expected an invalid signature", err)
+			}
+			// a request failing the signature does not spend its nonce
+			if len(m.nonces) != 0 {
+				t.Fatalf("the nonces %v were remembered", m.nonces)
+			}
+		})
+	}
+}
+
+func TestVerifySignatureComparison(t *testing.T) {
+	tests := []struct {
+		name      string
+		signature func(valid string) string
+		err       error
+	}{
+		{"valid", func(valid string) string { return valid }, nil},
+		{"last character differs", func(valid string) string { return valid[:len(valid)-1] + flip(valid[len(valid)-1]) }, ErrInvalid},
+		{"first character differs", func(valid string) string { return flip(valid[0]) + valid[1:] }, ErrInvalid},
+		{"shorter", func(valid string) string { return valid[:len(valid)-2] }, ErrInvalid},
+		{"longer", func(valid string) string { return valid + "00" }, ErrInvalid},
+		{"prefix only", func(valid string) string { return valid[:1] }, ErrInvalid},
+		{"upper case", func(valid string) string { return strings.ToUpper(valid) }, ErrInvalid},
+		{"not hex", func(valid string) string { return strings.Repeat("z", len(valid)) }, ErrInvalid},
+		{"missing", func(valid string) string { return "" }, ErrUnsigned},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verifier, _ := newVerifier()
+			request, body := signed(t, now, http.MethodPost, "/v1/partners/orders", `{}`)
+			request.Header.Set(HeaderSignature, test.signature(request.Header.Get(HeaderSignature)))
+			if _, err := verifier.Verify(request, body); !errors.Is(err, test.err) {
+				t.Fatalf("error %v, expected %v", err, test.err)
+			}
+		})
+	}
+}
+
+func TestVerifyUnsigned(t *testing.T) {
+	for _, header := range []string{HeaderKey, HeaderTimestamp, HeaderNonce, HeaderSignature} {
+		verifier, _ := newVerifier()
+		request, body := signed(t, now, http.MethodPost, "/v1/partners/orders", `{}`)
+		request.Header.Del(header)
+		if _, err := verifier.Verify(request, body); !errors.Is(err, ErrUnsigned) {
+			t.Errorf("without %v: %v", header, err)
+		}
+	}
+
+	verifier, _ := newVerifier()
+	request, body := signed(t, now, http.MethodPost, "/v1/partners/orders", `{}`)
+	request.Header.Set(HeaderKey, "stranger")
+	if keyID, err := verifier.Verify(request, body); !errors.Is(err, ErrUnknownKey) || keyID != "stranger" {
+		t.Errorf("an unknown key: %v %v", keyID, err)
+	}
+}
+
+// flip is another hex digit than c
+func flip(c byte) string {
+	if c == '0' {
+		return "1"
+	}
+	return "0"
+}
@@ -0,0 +1,107 @@
+package testkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"gotham/helpers"
+)
+
+// Difference is a single mismatch between two responses, Path is a json path like $.data.records[0].name
+type Difference struct {
+	Path      string      `json:"path"`
+	Base      interface{} `json:"base"`
+	Candidate interface{} `json:"candidate"`
+}
+
+// CompareResponses returns the differences between two responses, json keys listed in ignored are skipped at any depth
+func CompareResponses(baseStatus int, baseBody []byte, candidateStatus int, candidateBody []byte, ignored []string) (differences []Difference) {
+	if baseStatus != candidateStatus {
+		differences = append(differences, Difference{Path: "status", Base: baseStatus, Candidate: candidateStatus})
+	}
+
+	var base, candidate interface{}
+	baseErr := json.Unmarshal(baseBody, &base)
+	candidateErr := json.Unmarshal(candidateBody, &candidate)
+	if baseErr != nil || candidateErr != nil {
+		if string(baseBody) != string(candidateBody) {
+			differences = append(differences, Difference{Path: "body", Base: string(baseBody), Candidate: string(candidateBody)})
+		}
+		return differences
+	}
+	return append(differences, compareValues("$", base, candidate, ignored)...)
+}
+
+func compareValues(path string, base interface{}, candidate interface{}, ignored []string) (differences []Difference) {
+	switch b := base.(type) {
+	case map[string]interface{}:
+		c, ok := candidate.(map[string]interface{})
+		if !ok {
+			return []Difference{{Path: path, Base: base, Candidate: candidate}}
+		}
+		for _, key := range mergedKeys(b, c) {
+			if helpers.InArray(key, ignored) {
+				continue
+			}
+			differences = append(differences, compareValues(path+"."+key, b[key], c[key], ignored)...)
+		}
+		return differences
+	case []interface{}:
+		c, ok := candidate.([]interface{})
+		if !ok || len(b) != len(c) {
+			return []Difference{{Path: path, Base: base, Candidate: candidate}}
+		}
+		for i := range b {
+			differences = append(differences, compareValues(fmt.Sprintf("%v[%d]", path, i), b[i], c[i], ignored)...)
+		}
+		return differences
+	default:
+		if !reflect.DeepEqual(base, candidate) {
+			return []Difference{{Path: path, Base: base, Candidate: candidate}}
+		}
+		return nil
+	}
+}
+
+func mergedKeys(a map[string]interface{}, b map[string]interface{}) (keys []string) {
+	seen := map[string]bool{}
+	for _, m := range []map[string]interface{}{a, b} {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Mismatch is a replayed entry whose responses differ (or that could not be replayed at all)
+type Mismatch struct {
+	Entry       Entry        `json:"entry"`
+	Differences []Difference `json:"differences,omitempty"`
+	Error       string       `json:"error,omitempty"`
+}
+
+// Report is the result of replaying a whole corpus
+type Report struct {
+	Total  int        `json:"total"`
+	Failed []Mismatch `json:"failed"`
+}
+
+func (r Report) String() string {
+	out := fmt.Sprintf("replayed %d requests, %d differ\n", r.Total, len(r.Failed))
+	for _, mismatch := range r.Failed {
+		out += fmt.Sprintf("\n%v %v\n", mismatch.Entry.Method, mismatch.Entry.Uri)
+		if mismatch.Error != "" {
+			out += fmt.Sprintf("  error: %v\n", mismatch.Error)
+		}
+		for _, difference := range mismatch.Differences {
+			out += fmt.Sprintf("  %v: %v != %v\n", difference.Path, difference.Base, difference.Candidate)
+		}
+	}
+	return out
+}
@@ -0,0 +1,39 @@
+package testkit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+)
+
+// Entry is one recorded request of a corpus file (json lines)
+type Entry struct {
+	Method string              `json:"method"`
+	Uri    string              `json:"uri"`
+	Header map[string][]string `json:"header,omitempty"`
+	Body   string              `json:"body,omitempty"`
+}
+
+// ReadCorpus loads every entry of the corpus file at path
+func ReadCorpus(path string) (entries []Entry, err error) {
+	var file *os.File
+	file, err = os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry Entry
+		if err = json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
@@ -0,0 +1,31 @@
+package testkit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+)
+
+// Replay sends entry to baseUrl with the extra headers (e.g. a test token) and returns the response
+func Replay(client *http.Client, baseUrl string, entry Entry, header http.Header) (status int, body []byte, err error) {
+	var request *http.Request
+	request, err = http.NewRequest(entry.Method, baseUrl+entry.Uri, bytes.NewBufferString(entry.Body))
+	if err != nil {
+		return 0, nil, err
+	}
+	for key, values := range entry.Header {
+		request.Header[key] = values
+	}
+	for key, values := range header {
+		request.Header[key] = values
+	}
+
+	var response *http.Response
+	response, err = client.Do(request)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer response.Body.Close()
+	body, err = ioutil.ReadAll(response.Body)
+	return response.StatusCode, body, err
+}
@@ -0,0 +1,145 @@
+package testkit
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// the json types of a schema, a value is written as its type and the elements of an array as one merged element
+const (
+	SchemaString  = "string"
+	SchemaNumber  = "number"
+	SchemaBoolean = "boolean"
+	SchemaNull    = "null"
+)
+
+/**
+ * Schema
+ * the shape of a json body, its keys and the types of their values. Two responses of an endpoint have the same schema
+ * whatever their values, so a change of the schema is a change of the api
+ */
+func Schema(body []byte) (interface{}, error) {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil, err
+	}
+	return schemaOf(value), nil
+}
+
+func schemaOf(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		schema := make(map[string]interface{}, len(v))
+		for key, field := range v {
+			schema[key] = schemaOf(field)
+		}
+		return schema
+	case []interface{}:
+		var element interface{}
+		for _, item := range v {
+			element = mergeSchemas(element, schemaOf(item))
+		}
+		if element == nil {
+			return []interface{}{}
+		}
+		return []interface{}{element}
+	case string:
+		return SchemaString
+	case float64:
+		return SchemaNumber
+	case bool:
+		return SchemaBoolean
+	default:
+		return SchemaNull
+	}
+}
+
+// mergeSchemas is the schema of the elements of an array, the keys of all of them with the types that are not null
+func mergeSchemas(a interface{}, b interface{}) interface{} {
+	if a == nil || a == SchemaNull {
+		return b
+	}
+	if b == nil || b == SchemaNull {
+		return a
+	}
+	aObject, aOk := a.(map[string]interface{})
+	bObject, bOk := b.(map[string]interface{})
+	if aOk && bOk {
+		for key, field := range bObject {
+			aObject[key] = mergeSchemas(aObject[key], field)
+		}
+		return aObject
+	}
+	aArray, aOk := a.([]interface{})
+	bArray, bOk := b.([]interface{})
+	if aOk && bOk {
+		if len(aArray) == 0 {
+			return bArray
+		}
+		if len(bArray) == 0 {
+			return aArray
+		}
+		return []interface{}{mergeSchemas(aArray[0], bArray[0])}
+	}
+	return a
+}
+
+/**
+ * CompareSchemas
+ * the differences of the candidate schema from the base one, a key added or removed or a type changed. A null or an
+ * empty array says nothing of the type it stands for and matches any
+ */
+func CompareSchemas(base interface{}, candidate interface{}) []Difference {
+	return compareSchemas("$", base, candidate)
+}
+
+func compareSchemas(path string, base interface{}, candidate interface{}) (differences []Difference) {
+	if base == SchemaNull || candidate == SchemaNull {
+		return nil
+	}
+	switch b := base.(type) {
+	case map[string]interface{}:
+		c, ok := candidate.(map[string]interface{})
+		if !ok {
+			return []Difference{{Path: path, Base: describeSchema(base), Candidate: describeSchema(candidate)}}
+		}
+		for _, key := range mergedKeys(b, c) {
+			field := path + "." + key
+			bField, bOk := b[key]
+			cField, cOk := c[key]
+			switch {
+			case !cOk:
+				differences = append(differences, Difference{Path: field, Base: describeSchema(bField), Candidate: "removed"})
+			case !bOk:
+				differences = append(differences, Difference{Path: field, Base: "missing", Candidate: describeSchema(cField)})
+			default:
+				differences = append(differences, compareSchemas(field, bField, cField)...)
+			}
+		}
+		return differences
+	case []interface{}:
+		c, ok := candidate.([]interface{})
+		if !ok {
+			return []Difference{{Path: path, Base: describeSchema(base), Candidate: describeSchema(candidate)}}
+		}
+		if len(b) == 0 || len(c) == 0 {
+			return nil
+		}
+		return compareSchemas(path+"[]", b[0], c[0])
+	default:
+		if base != candidate {
+			return []Difference{{Path: path, Base: describeSchema(base), Candidate: describeSchema(candidate)}}
+		}
+		return nil
+	}
+}
+
+func describeSchema(schema interface{}) string {
+	switch schema.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	}
+	return fmt.Sprint(schema)
+}
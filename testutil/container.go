@@ -0,0 +1,103 @@
+package testutil
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	appContainer "gotham/app/container"
+	"gotham/app/container/dic"
+	"gotham/app/provider"
+	"gotham/config"
+	"gotham/infrastructures"
+	"gotham/repositories"
+)
+
+// Mocks are the objects replacing the definitions of the container by their name, e.g. a *mocks.EmailService for
+// "email" or a *mocks.UserRepository for "user-repository"
+type Mocks map[string]interface{}
+
+// databases names the in memory database of every container, so the tests running in parallel do not share one
+var databases uint64
+
+/**
+ * NewContainer
+ * builds the container of the "test" environment (log mailers, fake captcha and payments) on an in memory sqlite
+ * database of its own, with the tables of every repository that is not mocked, the mocks replacing their
+ * definitions. The sqlite driver is behind the sqlite build tag, run the tests with `go test -tags sqlite ./...`
+ */
+func NewContainer(mocks Mocks) (*dic.Container, error) {
+	if os.Getenv("APP_ENV") == "" {
+		_ = os.Setenv("APP_ENV", "test")
+	}
+	if config.Conf == nil {
+		config.Configurations()
+	}
+
+	overrides := map[string]interface{}{}
+	if _, ok := mocks["db-pool"]; !ok {
+		dbConfig := config.Conf.Db
+		dbConfig.DbConnection = "sqlite"
+		dbConfig.DbDatabase = fmt.Sprintf("file:testutil-%d?mode=memory&cache=shared", atomic.AddUint64(&databases, 1))
+		pool, err := infrastructures.NewGormDatabasePool(dbConfig)
+		if err != nil {
+			return nil, fmt.Errorf("testutil: %w, run the tests with -tags sqlite", err)
+		}
+		overrides["db-pool"] = pool
+	}
+	for name, mock := range mocks {
+		overrides[name] = mock
+	}
+
+	container, err := appContainer.NewTestContainer(overrides)
+	if err != nil {
+		return nil, err
+	}
+	if err = migrate(container, mocks); err != nil {
+		_ = container.Delete()
+		return nil, err
+	}
+	return container, nil
+}
+
+/**
+ * Container
+ * NewContainer for a test, which fails when the container can not be built, the container is deleted (and its
+ * database dropped) once the test is done
+ */
+func Container(t testing.TB, mocks Mocks) *dic.Container {
+	t.Helper()
+	container, err := NewContainer(mocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = container.Delete()
+	})
+	return container
+}
+
+// migrate creates the tables of the repositories, the mocked ones are skipped, their Migrate is not expected
+func migrate(container *dic.Container, mocks Mocks) error {
+	p := &provider.Provider{}
+	if err := p.Load(); err != nil {
+		return err
+	}
+	for _, name := range p.Names() {
+		if _, mocked := mocks[name]; mocked || !strings.HasSuffix(name, "-repository") {
+			continue
+		}
+		repository, err := container.SafeGet(name)
+		if err != nil {
+			return fmt.Errorf("testutil: %v: %w", name, err)
+		}
+		if migratable, ok := repository.(repositories.Migratable); ok {
+			if err = migratable.Migrate(); err != nil {
+				return fmt.Errorf("testutil: migrating %v: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
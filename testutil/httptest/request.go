@@ -0,0 +1,121 @@
+package httptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/labstack/echo/v4"
+
+	GMiddleware "gotham/middlewares"
+	"gotham/models"
+)
+
+/**
+ * Request
+ * built by the server and sent with Do, as a guest until it is sent as a user
+ */
+type Request struct {
+	server *Server
+	method string
+	path   string
+	header http.Header
+	body   []byte
+}
+
+func (s *Server) Request(method string, path string) *Request {
+	return &Request{server: s, method: method, path: path, header: http.Header{}}
+}
+
+func (s *Server) GET(path string) *Request {
+	return s.Request(http.MethodGet, path)
+}
+
+func (s *Server) POST(path string) *Request {
+	return s.Request(http.MethodPost, path)
+}
+
+func (s *Server) PUT(path string) *Request {
+	return s.Request(http.MethodPut, path)
+}
+
+func (s *Server) PATCH(path string) *Request {
+	return s.Request(http.MethodPatch, path)
+}
+
+func (s *Server) DELETE(path string) *Request {
+	return s.Request(http.MethodDelete, path)
+}
+
+// AsGuest sends the request without a token
+func (r *Request) AsGuest() *Request {
+	r.header.Del(echo.HeaderAuthorization)
+	return r
+}
+
+// AsUser sends the request with a token of the user
+func (r *Request) AsUser(user models.User) *Request {
+	r.header.Set(echo.HeaderAuthorization, "Bearer "+r.server.Token(user))
+	return r
+}
+
+// AsApiKey sends the request with an api key of the user limited to the scopes, instead of a token
+func (r *Request) AsApiKey(user models.User, scopes ...string) *Request {
+	r.header.Del(echo.HeaderAuthorization)
+	r.header.Set(GMiddleware.HeaderApiKey, r.server.ApiKey(user, scopes...))
+	return r
+}
+
+// AsAdmin sends the request with a token of the admin of the server
+func (r *Request) AsAdmin() *Request {
+	return r.AsUser(r.server.Admin())
+}
+
+// Path is the path of the request, with its query
+func (r *Request) Path() string {
+	return r.path
+}
+
+func (r *Request) Header(key string, value string) *Request {
+	r.header.Set(key, value)
+	return r
+}
+
+// JSON sets the body of the request to the json of body
+func (r *Request) JSON(body interface{}) *Request {
+	r.server.T.Helper()
+	content, err := json.Marshal(body)
+	if err != nil {
+		r.server.T.Fatalf("httptest: %v", err)
+	}
+	r.body = content
+	r.header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	return r
+}
+
+// Body sets the raw body of the request with its content type
+func (r *Request) Body(contentType string, body []byte) *Request {
+	r.body = body
+	r.header.Set(echo.HeaderContentType, contentType)
+	return r
+}
+
+/**
+ * Do
+ * serves the request and records the response
+ */
+func (r *Request) Do() *Response {
+	var body io.Reader = http.NoBody
+	if r.body != nil {
+		body = bytes.NewReader(r.body)
+	}
+	request := httptest.NewRequest(r.method, r.path, body)
+	for key, values := range r.header {
+		request.Header[key] = values
+	}
+	recorder := httptest.NewRecorder()
+	r.server.Echo.ServeHTTP(recorder, request)
+	return &Response{ResponseRecorder: recorder, t: r.server.T}
+}
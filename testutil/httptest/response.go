@@ -0,0 +1,116 @@
+package httptest
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gotham/testutil/snapshot"
+)
+
+/**
+ * Response
+ * the recorded response, the assertions fail the test and return the response so they are chained
+ */
+type Response struct {
+	*httptest.ResponseRecorder
+	t testing.TB
+}
+
+func (r *Response) AssertStatus(status int) *Response {
+	r.t.Helper()
+	if r.Code != status {
+		r.t.Fatalf("httptest: status %d, expected %d: %s", r.Code, status, r.Body.String())
+	}
+	return r
+}
+
+func (r *Response) AssertHeader(key string, value string) *Response {
+	r.t.Helper()
+	if got := r.Header().Get(key); got != value {
+		r.t.Fatalf("httptest: header %v is %q, expected %q", key, got, value)
+	}
+	return r
+}
+
+// Decode reads the json body into v
+func (r *Response) Decode(v interface{}) *Response {
+	r.t.Helper()
+	if err := json.Unmarshal(r.Body.Bytes(), v); err != nil {
+		r.t.Fatalf("httptest: %v: %s", err, r.Body.String())
+	}
+	return r
+}
+
+/**
+ * Value
+ * the json value at the path of the body, its keys and indexes separated by dots like data.records.0.email
+ */
+func (r *Response) Value(path string) (value interface{}, ok bool) {
+	r.t.Helper()
+	r.Decode(&value)
+	if path == "" {
+		return value, true
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch node := value.(type) {
+		case map[string]interface{}:
+			if value, ok = node[key]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, false
+			}
+			value = node[index]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+/**
+ * AssertJSON
+ * the json value at the path is the json of expected, numbers are compared whatever their go type
+ */
+func (r *Response) AssertJSON(path string, expected interface{}) *Response {
+	r.t.Helper()
+	value, ok := r.Value(path)
+	if !ok {
+		r.t.Fatalf("httptest: %v is missing: %s", path, r.Body.String())
+	}
+	content, err := json.Marshal(expected)
+	if err != nil {
+		r.t.Fatalf("httptest: %v", err)
+	}
+	var want interface{}
+	_ = json.Unmarshal(content, &want)
+	if !reflect.DeepEqual(value, want) {
+		r.t.Fatalf("httptest: %v is %v, expected %v", path, value, want)
+	}
+	return r
+}
+
+// AssertMissing fails when the body has a value at the path
+func (r *Response) AssertMissing(path string) *Response {
+	r.t.Helper()
+	if value, ok := r.Value(path); ok {
+		r.t.Fatalf("httptest: %v is %v, expected it missing", path, value)
+	}
+	return r
+}
+
+/**
+ * AssertSnapshot
+ * the schema of the json body is the one of its golden file, see snapshot.Match
+ */
+func (r *Response) AssertSnapshot(name string) *Response {
+	r.t.Helper()
+	snapshot.Match(r.t, name, r.Body.Bytes())
+	return r
+}
@@ -0,0 +1,198 @@
+package httptest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/app"
+	"gotham/app/container/dic"
+	"gotham/models"
+	"gotham/routers"
+	"gotham/testutil"
+	"gotham/testutil/snapshot"
+)
+
+/**
+ * Server
+ * the whole api, its middlewares and routes, on the container of testutil. The requests are served in process, no
+ * port is opened. The api reads its container from app.Application, so the tests booting a server do not run in
+ * parallel.
+ */
+type Server struct {
+	T         testing.TB
+	Echo      *echo.Echo
+	Container *dic.Container
+
+	admin *models.User
+	user  *models.User
+}
+
+/**
+ * New
+ * boots the api on a database of its own, which is dropped once the test is done
+ */
+func New(t testing.TB, mocks testutil.Mocks) *Server {
+	t.Helper()
+	container := testutil.Container(t, mocks)
+
+	previous := app.Application
+	app.Application = &app.App{Container: container}
+	t.Cleanup(func() {
+		app.Application = previous
+	})
+
+	e := echo.New()
+	routers.Register(e)
+	return &Server{T: t, Echo: e, Container: container}
+}
+
+/**
+ * Create
+ * inserts the records (pointers to models), their ids are set
+ */
+func (s *Server) Create(records ...interface{}) {
+	s.T.Helper()
+	db := s.Container.GetDb().DB()
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			s.T.Fatalf("httptest: creating %T: %v", record, err)
+		}
+	}
+}
+
+/**
+ * Load
+ * inserts the rows of a json fixture file by their table, {"users": [{"name": "...", ...}], "tags": [...]}. The tables
+ * are filled in the order of their name, name the files of the fixtures referring to each other accordingly
+ */
+func (s *Server) Load(path string) {
+	s.T.Helper()
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		s.T.Fatalf("httptest: %v", err)
+	}
+	var tables map[string][]map[string]interface{}
+	if err = json.Unmarshal(content, &tables); err != nil {
+		s.T.Fatalf("httptest: %v: %v", path, err)
+	}
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	db := s.Container.GetDb().DB()
+	for _, name := range names {
+		for _, row := range tables[name] {
+			if err = db.Table(name).Create(row).Error; err != nil {
+				s.T.Fatalf("httptest: %v: loading %v: %v", path, name, err)
+			}
+		}
+	}
+}
+
+/**
+ * Admin
+ * the admin the requests AsAdmin are sent by, created on its first use
+ */
+func (s *Server) Admin() models.User {
+	if s.admin == nil {
+		s.admin = &models.User{Name: "Admin", Email: "admin@gotham.test", Verified: true, Admin: true, Timezone: "UTC"}
+		s.Create(s.admin)
+	}
+	return *s.admin
+}
+
+/**
+ * User
+ * the verified user the requests AsUser are sent by, created on its first use
+ */
+func (s *Server) User() models.User {
+	if s.user == nil {
+		s.user = &models.User{Name: "User", Email: "user@gotham.test", Verified: true, Timezone: "UTC"}
+		s.Create(s.user)
+	}
+	return *s.user
+}
+
+/**
+ * Token
+ * an access token of the user
+ */
+func (s *Server) Token(user models.User) string {
+	s.T.Helper()
+	token, _, err := s.Container.GetAuthService().IssueToken(user.ID, 0, time.Hour)
+	if err != nil {
+		s.T.Fatalf("httptest: %v", err)
+	}
+	return token
+}
+
+/**
+ * ApiKey
+ * an api key of the user limited to the scopes
+ */
+func (s *Server) ApiKey(user models.User, scopes ...string) string {
+	s.T.Helper()
+	_, key, err := s.Container.GetApiKeyService().Create(user, "test", scopes, time.Hour)
+	if err != nil {
+		s.T.Fatalf("httptest: %v", err)
+	}
+	return key
+}
+
+/**
+ * SnapshotRoutes
+ * sends every GET route of the api and matches the schema of its json response to its golden file, named after the
+ * route. The parameters of the paths are taken from params, the routes with a parameter missing from it, the wildcard
+ * ones and the not found routes of echo are skipped, as, builds the requests (AsAdmin for the admin routes), or skips
+ * the route when it returns nil
+ */
+func (s *Server) SnapshotRoutes(params map[string]string, as func(request *Request) *Request) {
+	s.T.Helper()
+	routes := s.Echo.Routes()
+	sort.Slice(routes, func(i, j int) bool { return routes[i].Path < routes[j].Path })
+
+	for _, route := range routes {
+		if route.Method != http.MethodGet || strings.Contains(route.Path, "*") || strings.HasPrefix(route.Name, "github.com/labstack/echo/") {
+			continue
+		}
+		path, ok := fillPath(route.Path, params)
+		if !ok {
+			continue
+		}
+		request := as(s.GET(path))
+		if request == nil {
+			continue
+		}
+		response := request.Do()
+		contentType := response.Header().Get(echo.HeaderContentType)
+		if !strings.HasPrefix(contentType, echo.MIMEApplicationJSON) && !strings.HasPrefix(contentType, "application/problem+json") {
+			continue
+		}
+		response.AssertSnapshot(snapshot.Name(route.Method, route.Path))
+	}
+}
+
+// fillPath replaces the parameters of the path, false when one is not in params
+func fillPath(path string, params map[string]string) (string, bool) {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, ":") {
+			continue
+		}
+		value, ok := params[segment[1:]]
+		if !ok {
+			return "", false
+		}
+		segments[i] = value
+	}
+	return strings.Join(segments, "/"), true
+}
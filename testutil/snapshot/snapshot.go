@@ -0,0 +1,119 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"gotham/testkit"
+)
+
+// Dir holds the golden files, relative to the package of the test
+var Dir = filepath.Join("testdata", "snapshots")
+
+// UpdateEnv is the variable rewriting the golden files instead of comparing them when it is 1
+const UpdateEnv = "UPDATE_SNAPSHOTS"
+
+var unsafeName = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+/**
+ * Name
+ * the file name of a snapshot, "GET /v1/users/:user" is GET_v1_users_user
+ */
+func Name(parts ...string) string {
+	return strings.Trim(unsafeName.ReplaceAllString(strings.Join(parts, " "), "_"), "_")
+}
+
+/**
+ * Match
+ * compares the schema of the json body, its keys and the types of their values, to the golden file of the name. The
+ * golden files are written by running the tests with UPDATE_SNAPSHOTS=1, a missing one fails the test otherwise
+ */
+func Match(t testing.TB, name string, body []byte) {
+	t.Helper()
+	schema, err := testkit.Schema(body)
+	if err != nil {
+		t.Fatalf("snapshot %v: the body is not json: %v", name, err)
+	}
+	if updating() {
+		write(t, name, schema)
+		return
+	}
+	golden := read(t, name)
+	if differences := testkit.CompareSchemas(golden, schema); len(differences) > 0 {
+		t.Fatalf("snapshot %v: the schema changed, run the tests with %v=1 if it is intended\n%v", name, UpdateEnv, describe(differences))
+	}
+}
+
+/**
+ * MatchExact
+ * compares the json body itself to the golden file of the name, the keys ignored (ids, times) at any depth
+ */
+func MatchExact(t testing.TB, name string, body []byte, ignored ...string) {
+	t.Helper()
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		t.Fatalf("snapshot %v: the body is not json: %v", name, err)
+	}
+	if updating() {
+		write(t, name, value)
+		return
+	}
+	golden := read(t, name)
+	expected, _ := json.Marshal(golden)
+	if differences := testkit.CompareResponses(0, expected, 0, body, ignored); len(differences) > 0 {
+		t.Fatalf("snapshot %v: the body changed, run the tests with %v=1 if it is intended\n%v", name, UpdateEnv, describe(differences))
+	}
+}
+
+func updating() bool {
+	return os.Getenv(UpdateEnv) == "1"
+}
+
+func read(t testing.TB, name string) (golden interface{}) {
+	t.Helper()
+	path := filepath.Join(Dir, name+".json")
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		t.Fatalf("snapshot %v: %v is missing, run the tests with %v=1 to write it", name, path, UpdateEnv)
+	}
+	if err == nil {
+		err = json.Unmarshal(content, &golden)
+	}
+	if err != nil {
+		t.Fatalf("snapshot %v: %v", name, err)
+	}
+	return golden
+}
+
+func write(t testing.TB, name string, value interface{}) {
+	t.Helper()
+	var content bytes.Buffer
+	encoder := json.NewEncoder(&content)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(value)
+	if err == nil {
+		err = os.MkdirAll(Dir, 0755)
+	}
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(Dir, name+".json"), content.Bytes(), 0644)
+	}
+	if err != nil {
+		t.Fatalf("snapshot %v: %v", name, err)
+	}
+}
+
+func describe(differences []testkit.Difference) string {
+	lines := make([]string, 0, len(differences))
+	for _, difference := range differences {
+		lines = append(lines, fmt.Sprintf("  %v: %v != %v", difference.Path, difference.Base, difference.Candidate))
+	}
+	return strings.Join(lines, "\n")
+}
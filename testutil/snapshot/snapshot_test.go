@@ -0,0 +1,143 @@
+package snapshot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// recorder is a testing.TB whose Fatalf stops the goroutine of the check instead of the test
+type recorder struct {
+	testing.TB
+	failure string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.failure = fmt.Sprintf(format, args...)
+	runtime.Goexit()
+}
+
+// check runs the assertion on a recorder and returns its failure, empty when it passed
+func check(t *testing.T, assertion func(t testing.TB)) string {
+	r := &recorder{TB: t}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assertion(r)
+	}()
+	wg.Wait()
+	return r.failure
+}
+
+func useDir(t *testing.T) {
+	previous := Dir
+	Dir = t.TempDir()
+	t.Cleanup(func() {
+		Dir = previous
+	})
+}
+
+func writeGolden(t *testing.T, name string, content string) {
+	if err := ioutil.WriteFile(filepath.Join(Dir, name+".json"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestName(t *testing.T) {
+	if name := Name("GET", "/v1/users/:user"); name != "GET_v1_users_user" {
+		t.Fatalf("got %v", name)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	useDir(t)
+	writeGolden(t, "user", `{"data": {"id": "number", "name": "string", "tags": ["string"], "deleted_at": "null"}}`)
+
+	tests := []struct {
+		name   string
+		body   string
+		failed bool
+	}{
+		{"same schema other values", `{"data": {"id": 7, "name": "Bruce", "tags": ["a", "b"], "deleted_at": null}}`, false},
+		{"null matches any type", `{"data": {"id": 7, "name": "Bruce", "tags": [], "deleted_at": "2021-01-01"}}`, false},
+		{"key added", `{"data": {"id": 7, "name": "Bruce", "tags": [], "deleted_at": null, "email": "b@w.test"}}`, true},
+		{"key removed", `{"data": {"id": 7, "tags": [], "deleted_at": null}}`, true},
+		{"type changed", `{"data": {"id": "7", "name": "Bruce", "tags": [], "deleted_at": null}}`, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			failure := check(t, func(tb testing.TB) {
+				Match(tb, "user", []byte(test.body))
+			})
+			if failed := failure != ""; failed != test.failed {
+				t.Fatalf("failed %v, expected %v: %v", failed, test.failed, failure)
+			}
+		})
+	}
+}
+
+func TestMatchMissingGolden(t *testing.T) {
+	useDir(t)
+	t.Setenv(UpdateEnv, "")
+
+	failure := check(t, func(tb testing.TB) {
+		Match(tb, "missing", []byte(`{"message": "pong"}`))
+	})
+	if failure == "" {
+		t.Fatal("a missing golden file passed")
+	}
+	if _, err := ioutil.ReadFile(filepath.Join(Dir, "missing.json")); err == nil {
+		t.Fatal("a missing golden file was written without the update mode")
+	}
+}
+
+func TestMatchUpdate(t *testing.T) {
+	useDir(t)
+	writeGolden(t, "ping", `{"message": "number"}`)
+	t.Setenv(UpdateEnv, "1")
+
+	if failure := check(t, func(tb testing.TB) {
+		Match(tb, "ping", []byte(`{"message": "pong"}`))
+		Match(tb, "created", []byte(`{"id": 1}`))
+	}); failure != "" {
+		t.Fatal(failure)
+	}
+
+	t.Setenv(UpdateEnv, "")
+	if failure := check(t, func(tb testing.TB) {
+		Match(tb, "ping", []byte(`{"message": "pang"}`))
+		Match(tb, "created", []byte(`{"id": 2}`))
+	}); failure != "" {
+		t.Fatal(failure)
+	}
+}
+
+func TestMatchExact(t *testing.T) {
+	useDir(t)
+	writeGolden(t, "user", `{"id": 1, "name": "Bruce", "created_at": "2021-01-01T00:00:00Z"}`)
+
+	tests := []struct {
+		name   string
+		body   string
+		failed bool
+	}{
+		{"ignored keys differ", `{"id": 2, "name": "Bruce", "created_at": "2022-02-02T00:00:00Z"}`, false},
+		{"value changed", `{"id": 1, "name": "Alfred", "created_at": "2021-01-01T00:00:00Z"}`, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			failure := check(t, func(tb testing.TB) {
+				MatchExact(tb, "user", []byte(test.body), "id", "created_at")
+			})
+			if failed := failure != ""; failed != test.failed {
+				t.Fatalf("failed %v, expected %v: %v", failed, test.failed, failure)
+			}
+		})
+	}
+}
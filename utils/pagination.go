@@ -6,11 +6,35 @@ type IPagination interface {
 	GetLimit() int
 }
 
+/**
+ * Pagination
+ * the page of a paginated endpoint, parsed from page, per_page (or its alias limit), cursor and lite by
+ * requests.BindQuery instead of the binder, so an invalid value is a validation error
+ */
 type Pagination struct {
-	Page  int `query:"page"`
-	Limit int `query:"limit"`
+	Page   int    `query:"-"`
+	Limit  int    `query:"-"`
+	Cursor string `query:"-"`
+	// Lite skips the count of the records, HasNext is set instead when the page is read
+	Lite    bool `query:"-"`
+	HasNext bool `query:"-"`
 }
 
+// LitePaginationKey is the key in the echo context of the lite pagination of the route, the default of lite
+const LitePaginationKey = "lite_pagination"
+
+// PageSizesKey is the key of the page sizes in the echo context, set by the page sizes middleware
+const PageSizesKey = "page_sizes"
+
+// PageSizes are the page size of a request without one and the largest one a request can ask for
+type PageSizes struct {
+	Default int
+	Max     int
+}
+
+// DefaultPageSizes are the page sizes outside a request having the ones of the settings
+var DefaultPageSizes = PageSizes{Default: 20, Max: 100}
+
 func (p *Pagination) Get() *Pagination {
 	return p
 }
@@ -24,7 +48,18 @@ func (p *Pagination) GetPage() int {
 
 func (p *Pagination) GetLimit() int {
 	if p.Limit <= 0 {
-		p.Limit = 20
+		p.Limit = DefaultPageSizes.Default
 	}
 	return p.Limit
 }
+
+/**
+ * More
+ * whether a page follows this one, from the count of the records or, on a lite page, from HasNext
+ */
+func (p *Pagination) More(totalCount int64) bool {
+	if p.Lite {
+		return p.HasNext
+	}
+	return int64(p.GetPage())*int64(p.GetLimit()) < totalCount
+}
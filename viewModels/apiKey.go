@@ -0,0 +1,7 @@
+package viewModels
+
+// NewApiKey is a created api key with the key itself, it is only shown once
+type NewApiKey struct {
+	Record interface{} `json:"record"`
+	Key    string      `json:"key"`
+}
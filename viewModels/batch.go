@@ -0,0 +1,7 @@
+package viewModels
+
+// Batch is the records of a batch in the order of their ids, the ids of the records that were not found are missing
+type Batch struct {
+	Records interface{} `json:"records"`
+	Missing []uint      `json:"missing"`
+}
@@ -0,0 +1,6 @@
+package viewModels
+
+type Checkout struct {
+	// Url is the payment page the client redirects the user to
+	Url string `json:"url"`
+}
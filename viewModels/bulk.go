@@ -0,0 +1,30 @@
+package viewModels
+
+import (
+	"gotham/problems"
+)
+
+// BulkResult is the outcome of an operation of a bulk request with its own status, its record or its problem
+type BulkResult struct {
+	ID     uint              `json:"id"`
+	Status int               `json:"status"`
+	Record interface{}       `json:"record,omitempty"`
+	Error  *problems.Problem `json:"error,omitempty"`
+}
+
+// Bulk is the multi-status of a bulk request, the results are in the order of the operations
+type Bulk struct {
+	Succeeded int          `json:"succeeded"`
+	Failed    int          `json:"failed"`
+	Results   []BulkResult `json:"results"`
+}
+
+// Add adds the result of the next operation
+func (b *Bulk) Add(result BulkResult) {
+	if result.Error != nil {
+		b.Failed++
+	} else {
+		b.Succeeded++
+	}
+	b.Results = append(b.Results, result)
+}
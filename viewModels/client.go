@@ -0,0 +1,7 @@
+package viewModels
+
+// NewClient is a registered client with its secret, it is only shown once
+type NewClient struct {
+	Record interface{} `json:"record"`
+	Secret string      `json:"client_secret"`
+}
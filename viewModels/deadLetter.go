@@ -0,0 +1,6 @@
+package viewModels
+
+// DeadLetterPurge is the number of dead letters deleted by a purge
+type DeadLetterPurge struct {
+	Deleted int64 `json:"deleted"`
+}
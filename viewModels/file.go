@@ -0,0 +1,41 @@
+package viewModels
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/infrastructures"
+)
+
+// File is a stored file written as the response, in the ranges of the Range header and not at all to a HEAD request or
+// to a conditional request it has not changed for
+type File struct {
+	Name        string
+	ContentType string
+	// Disposition is inline or attachment
+	Disposition  string
+	CacheControl string
+}
+
+/**
+ * Serve
+ * writes the file with http.ServeContent, which answers Range with 206 and If-None-Match or If-Modified-Since with 304
+ * from the ETag and the Last-Modified of the file, and closes it
+ */
+func (f File) Serve(c echo.Context, file infrastructures.StorageFile) error {
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, f.ContentType)
+	header.Set(echo.HeaderContentDisposition, fmt.Sprintf("%s; filename=%q", f.Disposition, f.Name))
+	header.Set("Cache-Control", f.CacheControl)
+	header.Set("ETag", fmt.Sprintf(`"%x-%x"`, info.Size(), info.ModTime().UnixNano()))
+	http.ServeContent(c.Response(), c.Request(), f.Name, info.ModTime(), file)
+	return nil
+}
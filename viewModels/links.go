@@ -0,0 +1,111 @@
+package viewModels
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"gotham/helpers"
+	"gotham/utils"
+)
+
+// Link is a HAL link, relative to the host of the api
+type Link struct {
+	Href string `json:"href"`
+}
+
+// Links are the HAL _links of a response by their relation
+type Links map[string]Link
+
+// LinkBuilder builds the links of a response from the route registry of echo, routes are linked by their name
+type LinkBuilder struct {
+	c     echo.Context
+	links Links
+}
+
+/**
+ * NewLinks
+ * the links of the response of the request, starting with its self link
+ */
+func NewLinks(c echo.Context) *LinkBuilder {
+	return &LinkBuilder{c: c, links: Links{"self": {Href: c.Request().URL.RequestURI()}}}
+}
+
+/**
+ * Route
+ * links the route of the name with the params of its path, a route that is not registered is not linked
+ */
+func (b *LinkBuilder) Route(rel string, name string, params ...interface{}) *LinkBuilder {
+	if href := b.c.Echo().Reverse(name, params...); href != "" {
+		b.links[rel] = Link{Href: href}
+	}
+	return b
+}
+
+/**
+ * Pages
+ * links the first, previous, next and last pages of the request, a lite page is not counted so its last page is not
+ * known and it links the next one when it exists
+ */
+func (b *LinkBuilder) Pages(pagination utils.IPagination, totalCount int64) *LinkBuilder {
+	page, limit := pagination.GetPage(), pagination.GetLimit()
+	b.Query("first", "page", "1")
+	if page > 1 {
+		b.Query("prev", "page", strconv.Itoa(helpers.PrevPageCal(page)))
+	}
+	if pagination.Get().Lite {
+		if pagination.Get().HasNext {
+			b.Query("next", "page", strconv.Itoa(page+1))
+		}
+		return b
+	}
+	last := helpers.TotalPage(totalCount, limit)
+	if last < 1 {
+		last = 1
+	}
+	b.Query("last", "page", strconv.Itoa(last))
+	if page < last {
+		b.Query("next", "page", strconv.Itoa(helpers.NextPageCal(page, last)))
+	}
+	return b
+}
+
+/**
+ * Query
+ * links the request with the query parameter set to the value, like the cursor of the next page
+ */
+func (b *LinkBuilder) Query(rel string, key string, value string) *LinkBuilder {
+	url := *b.c.Request().URL
+	query := url.Query()
+	query.Set(key, value)
+	url.RawQuery = query.Encode()
+	b.links[rel] = Link{Href: url.RequestURI()}
+	return b
+}
+
+func (b *LinkBuilder) Links() Links {
+	return b.links
+}
+
+// Resource is a record with its links, serialized as the record with a _links member
+type Resource struct {
+	Record interface{}
+	Links  Links
+}
+
+func (r Resource) MarshalJSON() ([]byte, error) {
+	record, err := json.Marshal(r.Record)
+	if err != nil {
+		return nil, err
+	}
+	members := map[string]json.RawMessage{}
+	if err = json.Unmarshal(record, &members); err != nil {
+		// not an object, the links can not be added
+		return record, nil
+	}
+	if members["_links"], err = json.Marshal(r.Links); err != nil {
+		return nil, err
+	}
+	return json.Marshal(members)
+}
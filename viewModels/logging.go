@@ -0,0 +1,7 @@
+package viewModels
+
+type LogLevels struct {
+	Level string `json:"level"`
+	// Modules are the levels of the components having one
+	Modules map[string]string `json:"modules"`
+}
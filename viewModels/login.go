@@ -4,4 +4,18 @@ type Login struct {
 	AccessToken    string      `json:"access_token"`
 	AccessTokenExp int64       `json:"access_token_exp"`
 	User           interface{} `json:"user"`
+
+	// remember me
+	RefreshToken    string `json:"refresh_token,omitempty"`
+	RefreshTokenExp int64  `json:"refresh_token_exp,omitempty"`
+
+	// SudoUntil is when the token stops allowing sensitive operations with the default window
+	SudoUntil int64 `json:"sudo_until,omitempty"`
+}
+
+type Impersonation struct {
+	AccessToken    string      `json:"access_token"`
+	AccessTokenExp int64       `json:"access_token_exp"`
+	User           interface{} `json:"user"`
+	ImpersonatedBy uint        `json:"impersonated_by"`
 }
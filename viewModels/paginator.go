@@ -1,8 +1,56 @@
 package viewModels
 
+import (
+	"github.com/labstack/echo/v4"
+
+	"gotham/utils"
+)
+
+// Paginator is a page of records, a lite one has no total_record but has_next instead
 type Paginator struct {
-	TotalRecord int64       `json:"total_record"`
+	TotalRecord *int64      `json:"total_record,omitempty"`
+	HasNext     *bool       `json:"has_next,omitempty"`
 	Records     interface{} `json:"records"`
 	Limit       int         `json:"limit"`
 	Page        int         `json:"page"`
+	// NextCursor continues the listing by keyset pages after this one, on the endpoints having them
+	NextCursor string `json:"next_cursor,omitempty"`
+	Links      Links  `json:"_links,omitempty"`
+}
+
+/**
+ * NewPaginator
+ * the page of the records of the request with the links of its pages, from the count of the records or, on a lite
+ * page, whether a next page exists
+ */
+func NewPaginator(c echo.Context, pagination utils.IPagination, records interface{}, totalCount int64) Paginator {
+	page := Paginator{
+		Records: records,
+		Limit:   pagination.GetLimit(),
+		Page:    pagination.GetPage(),
+		Links:   NewLinks(c).Pages(pagination, totalCount).Links(),
+	}
+	if pagination.Get().Lite {
+		hasNext := pagination.Get().HasNext
+		page.HasNext = &hasNext
+	} else {
+		page.TotalRecord = &totalCount
+	}
+	return page
+}
+
+// CursorPaginator is a page of records continued by passing NextCursor back, none is left when it is empty
+type CursorPaginator struct {
+	Records    interface{} `json:"records"`
+	Limit      int         `json:"limit"`
+	NextCursor uint        `json:"next_cursor,omitempty"`
+	Links      Links       `json:"_links,omitempty"`
+}
+
+// KeysetPaginator is a keyset page of records continued by passing the opaque NextCursor back, none is left when it is empty
+type KeysetPaginator struct {
+	Records    interface{} `json:"records"`
+	Limit      int         `json:"limit"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+	Links      Links       `json:"_links,omitempty"`
 }
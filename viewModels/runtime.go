@@ -0,0 +1,34 @@
+package viewModels
+
+import "time"
+
+type RuntimeStats struct {
+	GoVersion  string    `json:"go_version"`
+	Goroutines int       `json:"goroutines"`
+	CPUs       int       `json:"cpus"`
+	Heap       HeapStats `json:"heap"`
+	GC         GCStats   `json:"gc"`
+	Uptime     float64   `json:"uptime_seconds"`
+	At         time.Time `json:"at"`
+}
+
+type HeapStats struct {
+	// Alloc is the bytes of the live objects, Sys the bytes obtained from the os for the heap
+	Alloc    uint64 `json:"alloc_bytes"`
+	Sys      uint64 `json:"sys_bytes"`
+	Idle     uint64 `json:"idle_bytes"`
+	InUse    uint64 `json:"in_use_bytes"`
+	Released uint64 `json:"released_bytes"`
+	Objects  uint64 `json:"objects"`
+}
+
+type GCStats struct {
+	Cycles uint32 `json:"cycles"`
+	// NextTarget is the heap size the next cycle starts at
+	NextTarget uint64  `json:"next_target_bytes"`
+	PauseTotal float64 `json:"pause_total_seconds"`
+	// Pauses are the last ones, the most recent first
+	Pauses      []float64  `json:"pauses_seconds"`
+	LastAt      *time.Time `json:"last_at"`
+	CPUFraction float64    `json:"cpu_fraction"`
+}
@@ -0,0 +1,302 @@
+package viewModels
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Serializer writes an encoded json response in another format
+type Serializer struct {
+	ContentType string
+	Serialize   func(body []byte) ([]byte, error)
+}
+
+// Serializers are the formats of the responses by the media types clients accept them as, json is written as is
+var Serializers = map[string]Serializer{
+	echo.MIMEApplicationJSON:    {ContentType: echo.MIMEApplicationJSONCharsetUTF8},
+	echo.MIMEApplicationXML:     {ContentType: echo.MIMEApplicationXMLCharsetUTF8, Serialize: XML},
+	echo.MIMETextXML:            {ContentType: echo.MIMEApplicationXMLCharsetUTF8, Serialize: XML},
+	echo.MIMEApplicationMsgpack: {ContentType: echo.MIMEApplicationMsgpack, Serialize: MessagePack},
+	"application/x-msgpack":     {ContentType: echo.MIMEApplicationMsgpack, Serialize: MessagePack},
+}
+
+/**
+ * Negotiate
+ * the serializer of the media type of the Accept header with the highest quality, json when none is supported
+ */
+func Negotiate(accept string) Serializer {
+	best, quality := Serializers[echo.MIMEApplicationJSON], 0.0
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		mime := strings.ToLower(strings.TrimSpace(params[0]))
+		q := 1.0
+		for _, param := range params[1:] {
+			if value := strings.TrimSpace(param); strings.HasPrefix(value, "q=") {
+				if parsed, err := strconv.ParseFloat(value[2:], 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		serializer, ok := Serializers[mime]
+		if mime == "*/*" || mime == "application/*" {
+			serializer, ok = Serializers[echo.MIMEApplicationJSON], true
+		}
+		// on equal quality the first listed wins
+		if !ok || q <= quality {
+			continue
+		}
+		best, quality = serializer, q
+	}
+	return best
+}
+
+// node is a decoded json value keeping the order of the fields of its objects
+type node struct {
+	value  interface{}
+	keys   []string
+	fields []node
+	items  []node
+	object bool
+	array  bool
+}
+
+func decode(decoder *json.Decoder) (node, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return node{}, err
+	}
+	switch token {
+	case json.Delim('{'):
+		n := node{object: true}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return n, err
+			}
+			field, err := decode(decoder)
+			if err != nil {
+				return n, err
+			}
+			n.keys = append(n.keys, key.(string))
+			n.fields = append(n.fields, field)
+		}
+		_, err = decoder.Token()
+		return n, err
+	case json.Delim('['):
+		n := node{array: true}
+		for decoder.More() {
+			item, err := decode(decoder)
+			if err != nil {
+				return n, err
+			}
+			n.items = append(n.items, item)
+		}
+		_, err = decoder.Token()
+		return n, err
+	}
+	return node{value: token}, nil
+}
+
+func parse(body []byte) (node, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	n, err := decode(decoder)
+	if err != nil {
+		return n, err
+	}
+	if _, err = decoder.Token(); err != io.EOF {
+		return n, errors.New("serializer: trailing data after the json document")
+	}
+	return n, nil
+}
+
+/**
+ * XML
+ * writes a json document as xml under a response element, the fields of an object are its elements and the items of
+ * an array are item elements. A field whose name is not an xml name is a field element with the name as attribute.
+ */
+func XML(body []byte) ([]byte, error) {
+	n, err := parse(body)
+	if err != nil {
+		return nil, err
+	}
+	var buffer bytes.Buffer
+	buffer.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&buffer)
+	if err = writeXML(encoder, xml.StartElement{Name: xml.Name{Local: "response"}}, n); err != nil {
+		return nil, err
+	}
+	if err = encoder.Flush(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func writeXML(encoder *xml.Encoder, start xml.StartElement, n node) error {
+	if err := encoder.EncodeToken(start); err != nil {
+		return err
+	}
+	switch {
+	case n.object:
+		for i, key := range n.keys {
+			element := xml.StartElement{Name: xml.Name{Local: key}}
+			if !isXMLName(key) {
+				element = xml.StartElement{Name: xml.Name{Local: "field"}, Attr: []xml.Attr{{Name: xml.Name{Local: "name"}, Value: key}}}
+			}
+			if err := writeXML(encoder, element, n.fields[i]); err != nil {
+				return err
+			}
+		}
+	case n.array:
+		for _, item := range n.items {
+			if err := writeXML(encoder, xml.StartElement{Name: xml.Name{Local: "item"}}, item); err != nil {
+				return err
+			}
+		}
+	case n.value != nil:
+		text := ""
+		switch value := n.value.(type) {
+		case string:
+			text = value
+		case json.Number:
+			text = value.String()
+		case bool:
+			text = strconv.FormatBool(value)
+		}
+		if err := encoder.EncodeToken(xml.CharData(text)); err != nil {
+			return err
+		}
+	}
+	return encoder.EncodeToken(start.End())
+}
+
+func isXMLName(name string) bool {
+	if name == "" || strings.HasPrefix(strings.ToLower(name), "xml") {
+		return false
+	}
+	for i, r := range name {
+		letter := r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if !letter && (i == 0 || !(r == '-' || r == '.' || (r >= '0' && r <= '9'))) {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+ * MessagePack
+ * writes a json document as MessagePack, integers take the smallest integer format and other numbers are float 64
+ */
+func MessagePack(body []byte) ([]byte, error) {
+	n, err := parse(body)
+	if err != nil {
+		return nil, err
+	}
+	var buffer bytes.Buffer
+	writeMessagePack(&buffer, n)
+	return buffer.Bytes(), nil
+}
+
+func writeMessagePack(buffer *bytes.Buffer, n node) {
+	switch {
+	case n.object:
+		writeMessagePackHeader(buffer, len(n.keys), 0x80, 0xde, 0xdf)
+		for i, key := range n.keys {
+			writeMessagePackString(buffer, key)
+			writeMessagePack(buffer, n.fields[i])
+		}
+	case n.array:
+		writeMessagePackHeader(buffer, len(n.items), 0x90, 0xdc, 0xdd)
+		for _, item := range n.items {
+			writeMessagePack(buffer, item)
+		}
+	default:
+		switch value := n.value.(type) {
+		case nil:
+			buffer.WriteByte(0xc0)
+		case bool:
+			if value {
+				buffer.WriteByte(0xc3)
+			} else {
+				buffer.WriteByte(0xc2)
+			}
+		case string:
+			writeMessagePackString(buffer, value)
+		case json.Number:
+			writeMessagePackNumber(buffer, value)
+		}
+	}
+}
+
+// writeMessagePackHeader writes the length of a map or an array in its fix, 16 or 32 bits format
+func writeMessagePackHeader(buffer *bytes.Buffer, length int, fix byte, format16 byte, format32 byte) {
+	switch {
+	case length < 16:
+		buffer.WriteByte(fix | byte(length))
+	case length <= math.MaxUint16:
+		buffer.WriteByte(format16)
+		_ = binary.Write(buffer, binary.BigEndian, uint16(length))
+	default:
+		buffer.WriteByte(format32)
+		_ = binary.Write(buffer, binary.BigEndian, uint32(length))
+	}
+}
+
+func writeMessagePackString(buffer *bytes.Buffer, value string) {
+	length := len(value)
+	switch {
+	case length < 32:
+		buffer.WriteByte(0xa0 | byte(length))
+	case length <= math.MaxUint8:
+		buffer.WriteByte(0xd9)
+		buffer.WriteByte(byte(length))
+	case length <= math.MaxUint16:
+		buffer.WriteByte(0xda)
+		_ = binary.Write(buffer, binary.BigEndian, uint16(length))
+	default:
+		buffer.WriteByte(0xdb)
+		_ = binary.Write(buffer, binary.BigEndian, uint32(length))
+	}
+	buffer.WriteString(value)
+}
+
+func writeMessagePackNumber(buffer *bytes.Buffer, value json.Number) {
+	if integer, err := strconv.ParseInt(value.String(), 10, 64); err == nil {
+		switch {
+		case integer >= 0 && integer < 128:
+			buffer.WriteByte(byte(integer))
+		case integer < 0 && integer >= -32:
+			buffer.WriteByte(byte(int8(integer)))
+		case integer >= math.MinInt8 && integer <= math.MaxInt8:
+			buffer.WriteByte(0xd0)
+			buffer.WriteByte(byte(int8(integer)))
+		case integer >= math.MinInt16 && integer <= math.MaxInt16:
+			buffer.WriteByte(0xd1)
+			_ = binary.Write(buffer, binary.BigEndian, int16(integer))
+		case integer >= math.MinInt32 && integer <= math.MaxInt32:
+			buffer.WriteByte(0xd2)
+			_ = binary.Write(buffer, binary.BigEndian, int32(integer))
+		default:
+			buffer.WriteByte(0xd3)
+			_ = binary.Write(buffer, binary.BigEndian, integer)
+		}
+		return
+	}
+	if unsigned, err := strconv.ParseUint(value.String(), 10, 64); err == nil {
+		buffer.WriteByte(0xcf)
+		_ = binary.Write(buffer, binary.BigEndian, unsigned)
+		return
+	}
+	float, _ := value.Float64()
+	buffer.WriteByte(0xcb)
+	_ = binary.Write(buffer, binary.BigEndian, float)
+}
@@ -0,0 +1,27 @@
+package viewModels
+
+import (
+	"encoding/json"
+)
+
+// Visible is a record serialized without the fields its policy hides from the viewer
+type Visible struct {
+	Record interface{}
+	Hidden []string
+}
+
+func (v Visible) MarshalJSON() ([]byte, error) {
+	record, err := json.Marshal(v.Record)
+	if err != nil || len(v.Hidden) == 0 {
+		return record, err
+	}
+	members := map[string]json.RawMessage{}
+	if err = json.Unmarshal(record, &members); err != nil {
+		// not an object, it has no fields to hide
+		return record, nil
+	}
+	for _, field := range v.Hidden {
+		delete(members, field)
+	}
+	return json.Marshal(members)
+}
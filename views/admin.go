@@ -0,0 +1,8 @@
+package views
+
+import "embed"
+
+// Admin is the admin frontend served under /admin/ui, built into the binary so a deployment is a single file
+//
+//go:embed admin
+var Admin embed.FS
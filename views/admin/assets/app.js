@@ -0,0 +1,92 @@
+// the admin frontend, routed in the browser under /admin/ui and reading the admin resources api with the token of an admin
+(function () {
+    var base = '/admin/ui';
+    var app = document.getElementById('app');
+
+    function token() {
+        return sessionStorage.getItem('token') || '';
+    }
+
+    function api(path) {
+        return fetch('/v1/restricted' + path, {
+            headers: {'Authorization': 'Bearer ' + token(), 'Accept': 'application/json'}
+        }).then(function (response) {
+            return response.json().then(function (body) {
+                if (!response.ok) {
+                    throw new Error(body.detail || body.title || response.statusText);
+                }
+                return body.data;
+            });
+        });
+    }
+
+    function text(tag, value, className) {
+        var element = document.createElement(tag);
+        element.textContent = value;
+        if (className) {
+            element.className = className;
+        }
+        return element;
+    }
+
+    function table(records) {
+        var element = document.createElement('table');
+        if (!records.length) {
+            element.appendChild(text('caption', 'No records'));
+            return element;
+        }
+        var fields = Object.keys(records[0]).filter(function (field) {
+            return typeof records[0][field] !== 'object' || records[0][field] === null;
+        });
+        var head = element.appendChild(document.createElement('tr'));
+        fields.forEach(function (field) {
+            head.appendChild(text('th', field));
+        });
+        records.forEach(function (record) {
+            var row = element.appendChild(document.createElement('tr'));
+            fields.forEach(function (field) {
+                row.appendChild(text('td', record[field] === null ? '' : String(record[field])));
+            });
+        });
+        return element;
+    }
+
+    function render() {
+        var path = location.pathname.slice(base.length).replace(/^\/+|\/+$/g, '');
+        var parts = path.split('/');
+        app.replaceChildren();
+        if (!token()) {
+            app.appendChild(text('p', 'Enter the bearer token of an admin.'));
+            return;
+        }
+        if (parts[0] !== 'resources' || !parts[1]) {
+            app.appendChild(text('p', 'Pick a resource.'));
+            return;
+        }
+        app.appendChild(text('h1', parts[1]));
+        api('/admin/resources/' + encodeURIComponent(parts[1]) + location.search).then(function (page) {
+            app.appendChild(table(page.records || []));
+            app.appendChild(text('p', page.total_record + ' records'));
+        }).catch(function (error) {
+            app.appendChild(text('p', error.message, 'error'));
+        });
+    }
+
+    document.addEventListener('click', function (event) {
+        var link = event.target.closest('a[data-route]');
+        if (!link) {
+            return;
+        }
+        event.preventDefault();
+        history.pushState(null, '', link.getAttribute('href'));
+        render();
+    });
+    window.addEventListener('popstate', render);
+    document.getElementById('token').addEventListener('submit', function (event) {
+        event.preventDefault();
+        sessionStorage.setItem('token', event.target.elements.token.value);
+        event.target.reset();
+        render();
+    });
+    render();
+})();
@@ -0,0 +1,21 @@
+package workers
+
+import (
+	"gotham/app"
+	"gotham/services"
+)
+
+func Initialize() {
+	queue := app.Application.Container.GetQueue()
+	// the jobs failing their last attempt are kept as dead letters
+	deadLetters := app.Application.Container.GetDeadLetterService()
+
+	// privacy
+	queue.Work(services.QueueDataExports, 1, deadLetters.Guard(app.Application.Container.GetPrivacyService().BuildDataExport))
+
+	// read models, one worker so two projections of a user do not race
+	queue.Work(services.QueueUserProjections, 1, deadLetters.Guard(app.Application.Container.GetUserProjectionService().ProjectUser))
+
+	// the webhooks of the dead letters, replayed by the admins
+	deadLetters.RegisterReplayer(services.DeadLetterSourceBilling, app.Application.Container.GetBillingService().ReplayWebhook)
+}